@@ -1,5 +1,7 @@
 package apiconfig
 
+import "strings"
+
 type Config struct {
 	Api                 ApiConfig             `koanf:"api"`
 	Nodes               []InferenceNodeConfig `koanf:"nodes"`
@@ -17,6 +19,19 @@ type Config struct {
 	LastUsedVersion     string                `koanf:"last_used_version"`
 	ValidationParams    ValidationParamsCache `koanf:"validation_params"`
 	BandwidthParams     BandwidthParamsCache  `koanf:"bandwidth_params"`
+	Indexer             IndexerConfig         `koanf:"indexer"`
+}
+
+// IndexerConfig controls the chain indexer that keeps inference, validation, reward and performance history
+// in its own SQLite database for the /v1/history endpoints.
+type IndexerConfig struct {
+	Enabled bool `koanf:"enabled"`
+	// DbPath defaults to indexer.db next to the dapi database.
+	DbPath string `koanf:"db_path"`
+	// StartHeight is the first block indexed into an empty database. 0 starts at the earliest block the node has.
+	StartHeight int64 `koanf:"start_height"`
+	// PollIntervalSec is how often the indexer looks for new blocks once it has caught up.
+	PollIntervalSec int `koanf:"poll_interval_sec"`
 }
 
 type NatsServerConfig struct {
@@ -48,6 +63,40 @@ type ApiConfig struct {
 	AdminServerPort       int    `koanf:"admin_server_port"`
 	MlGrpcServerPort      int    `koanf:"ml_grpc_server_port"`
 	TestMode              bool   `koanf:"test_mode"`
+
+	// AdminAuth protects the admin server. While no token and no client certificate is configured,
+	// anonymous callers only get read-only access unless AllowUnauthenticated is set.
+	AdminAuth AdminAuthConfig `koanf:"admin_auth"`
+}
+
+type AdminAuthConfig struct {
+	// Tokens are static bearer tokens accepted in the Authorization header.
+	Tokens []AdminTokenConfig `koanf:"tokens"`
+	// EnvTokens are the tokens set through ADMIN_TOKEN_* environment variables. They are never written to the config file.
+	EnvTokens []AdminTokenConfig `koanf:"-"`
+	// ClientCerts grant scopes to mTLS clients by certificate common name.
+	ClientCerts []AdminClientCertConfig `koanf:"client_certs"`
+	// TLSCertFile and TLSKeyFile switch the admin server to HTTPS.
+	TLSCertFile string `koanf:"tls_cert_file"`
+	TLSKeyFile  string `koanf:"tls_key_file"`
+	// ClientCAFile enables mTLS: client certificates must be signed by this CA.
+	ClientCAFile string `koanf:"client_ca_file"`
+	// AuditLogPath is a JSON-lines file receiving an entry for every mutating call. Entries are always logged as well.
+	AuditLogPath string `koanf:"audit_log_path"`
+	// AllowUnauthenticated gives anonymous callers every scope while no credential is configured.
+	// Only meant for local and test networks where the admin port is not reachable from outside.
+	AllowUnauthenticated bool `koanf:"allow_unauthenticated"`
+}
+
+type AdminTokenConfig struct {
+	Name   string   `koanf:"name"`
+	Token  string   `koanf:"token"`
+	Scopes []string `koanf:"scopes"`
+}
+
+type AdminClientCertConfig struct {
+	CommonName string   `koanf:"common_name"`
+	Scopes     []string `koanf:"scopes"`
 }
 
 type ChainNodeConfig struct {
@@ -59,6 +108,34 @@ type ChainNodeConfig struct {
 	KeyringBackend   string `koanf:"keyring_backend"`
 	KeyringDir       string `koanf:"keyring_dir"`
 	KeyringPassword  string
+
+	// FallbackUrls are additional RPC endpoints of the same chain. Url stays the primary endpoint,
+	// reads, broadcasts and the event subscription move to a fallback while it is unhealthy.
+	FallbackUrls []string `koanf:"fallback_urls"`
+	// BroadcastFanout additionally sends every signed transaction to all other healthy endpoints.
+	BroadcastFanout bool `koanf:"broadcast_fanout"`
+	// MaxHeightLag is how many blocks an endpoint may trail the best known height and still be used.
+	MaxHeightLag int64 `koanf:"max_height_lag"`
+	// HealthCheckIntervalSec is the period of endpoint health and sync-height probes.
+	HealthCheckIntervalSec int `koanf:"health_check_interval_sec"`
+}
+
+// RpcUrls returns the primary endpoint followed by the fallback endpoints, without duplicates.
+// Fallback entries may also be comma-separated lists, as they are when set through DAPI_CHAIN_NODE__FALLBACK_URLS.
+func (c ChainNodeConfig) RpcUrls() []string {
+	urls := make([]string, 0, len(c.FallbackUrls)+1)
+	seen := make(map[string]bool)
+	for _, entry := range append([]string{c.Url}, c.FallbackUrls...) {
+		for _, u := range strings.Split(entry, ",") {
+			u = strings.TrimSpace(u)
+			if u == "" || seen[u] {
+				continue
+			}
+			seen[u] = true
+			urls = append(urls, u)
+		}
+	}
+	return urls
 }
 
 type MLNodeKeyConfig struct {
@@ -122,3 +199,27 @@ type BandwidthParamsCache struct {
 	KbPerInputToken           float64 `koanf:"kb_per_input_token"`
 	KbPerOutputToken          float64 `koanf:"kb_per_output_token"`
 }
+
+const redacted = "[redacted]"
+
+// Redacted returns a copy of the config with every credential replaced, safe to hand out over the admin API.
+func (c Config) Redacted() Config {
+	if c.ChainNode.KeyringPassword != "" {
+		c.ChainNode.KeyringPassword = redacted
+	}
+	if c.MLNodeKeyConfig.WorkerPrivateKey != "" {
+		c.MLNodeKeyConfig.WorkerPrivateKey = redacted
+	}
+	c.Api.AdminAuth.Tokens = redactTokens(c.Api.AdminAuth.Tokens)
+	c.Api.AdminAuth.EnvTokens = redactTokens(c.Api.AdminAuth.EnvTokens)
+	return c
+}
+
+func redactTokens(tokens []AdminTokenConfig) []AdminTokenConfig {
+	redactedTokens := make([]AdminTokenConfig, len(tokens))
+	for i, token := range tokens {
+		token.Token = redacted
+		redactedTokens[i] = token
+	}
+	return redactedTokens
+}
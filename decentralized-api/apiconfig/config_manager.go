@@ -6,6 +6,7 @@ import (
 	"decentralized-api/logging"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"io"
 	"log"
 	"os"
@@ -30,6 +31,8 @@ type ConfigManager struct {
 	WriterProvider WriteCloserProvider
 	sqlDb          SqlDatabase
 	mutex          sync.Mutex
+	// flushMutex serializes writers of dynamic state to the DB (auto-flush and imports)
+	flushMutex     sync.Mutex
 	configDumpPath string
 	sqlitePath     string
 }
@@ -98,6 +101,7 @@ func LoadConfigManagerWithPaths(configPath, sqlitePath, nodeConfigPath string) (
 	sanitized.PreviousSeed.Seed = 0
 	sanitized.UpcomingSeed.Seed = 0
 	sanitized.MLNodeKeyConfig.WorkerPrivateKey = ""
+	sanitized.Api.AdminAuth.Tokens = nil
 	if cfgBytes, err := json.MarshalIndent(sanitized, "", "  "); err != nil {
 		log.Printf("Error marshaling final config to JSON: %+v", err)
 	} else {
@@ -139,6 +143,15 @@ func (cm *ConfigManager) GetNatsConfig() NatsServerConfig {
 	return cm.currentConfig.Nats
 }
 
+// GetIndexerConfig returns the indexer configuration with DbPath resolved next to the dapi database if unset.
+func (cm *ConfigManager) GetIndexerConfig() IndexerConfig {
+	config := cm.currentConfig.Indexer
+	if config.DbPath == "" {
+		config.DbPath = filepath.Join(filepath.Dir(cm.sqlitePath), "indexer.db")
+	}
+	return config
+}
+
 func (cm *ConfigManager) GetNodes() []InferenceNodeConfig {
 	nodes := make([]InferenceNodeConfig, len(cm.currentConfig.Nodes))
 	copy(nodes, cm.currentConfig.Nodes)
@@ -454,9 +467,36 @@ func readConfig(provider koanf.Provider) (Config, error) {
 		log.Printf("Warning: KEYRING_PASSWORD environment variable not set - keyring operations may fail")
 	}
 
+	config.Api.AdminAuth.EnvTokens = adminTokensFromEnv()
+
 	return config, nil
 }
 
+// adminTokenEnvVars are the environment variables that each set an admin token with the scope next to them
+var adminTokenEnvVars = []struct {
+	name  string
+	scope string
+}{
+	{"ADMIN_TOKEN_READ_ONLY", "read-only"},
+	{"ADMIN_TOKEN_NODE_OPERATOR", "node-operator"},
+	{"ADMIN_TOKEN_TREASURY", "treasury"},
+}
+
+// adminTokensFromEnv reads the admin tokens set through the environment, so that they can come from a
+// secret store instead of the config file
+func adminTokensFromEnv() []AdminTokenConfig {
+	var tokens []AdminTokenConfig
+	for _, envVar := range adminTokenEnvVars {
+		token, found := os.LookupEnv(envVar.name)
+		if !found || strings.TrimSpace(token) == "" {
+			continue
+		}
+		tokens = append(tokens, AdminTokenConfig{Name: "env-" + envVar.scope, Token: strings.TrimSpace(token), Scopes: []string{envVar.scope}})
+		log.Printf("Loaded %s", envVar.name)
+	}
+	return tokens
+}
+
 func writeConfig(config Config, writerProvider WriteCloserProvider) error {
 	// Skip writing in tests where WriterProvider is nil
 	if writerProvider == nil {
@@ -735,6 +775,8 @@ func (cm *ConfigManager) flushToDB(ctx context.Context) error {
 	if err := cm.ensureDbReady(ctx); err != nil {
 		return err
 	}
+	cm.flushMutex.Lock()
+	defer cm.flushMutex.Unlock()
 	cm.mutex.Lock()
 	cfg := cm.currentConfig
 	cm.mutex.Unlock()
@@ -781,6 +823,48 @@ func (cm *ConfigManager) flushToDB(ctx context.Context) error {
 	return nil
 }
 
+// ImportDb restores a dump produced by ExportAllDb (see apiconfig.ImportDb) and reloads the
+// in-memory dynamic state from the DB, so the next auto-flush doesn't undo the import.
+// The DB is backed up before anything is written.
+func (cm *ConfigManager) ImportDb(ctx context.Context, dump map[string][]map[string]any, options ImportOptions) (ImportReport, error) {
+	if err := cm.ensureDbReady(ctx); err != nil {
+		return ImportReport{}, err
+	}
+	// Persist pending in-memory changes first so the diff is computed against the real state
+	if err := cm.flushToDB(ctx); err != nil {
+		return ImportReport{}, err
+	}
+	cm.flushMutex.Lock()
+	defer cm.flushMutex.Unlock()
+	db := cm.sqlDb.GetDb()
+
+	report, err := ImportDb(ctx, db, dump, ImportOptions{Mode: options.Mode, DryRun: true})
+	if err != nil || options.DryRun || len(report.Changes) == 0 {
+		return report, err
+	}
+	backupPath := ""
+	if isFileDatabase(cm.sqlitePath) {
+		if backupPath, err = BackupSQLite(ctx, db, cm.sqlitePath, report.SchemaVersion); err != nil {
+			return ImportReport{}, fmt.Errorf("backup before import: %w", err)
+		}
+	}
+	report, err = ImportDb(ctx, db, dump, options)
+	if err != nil {
+		return ImportReport{}, err
+	}
+	report.BackupPath = backupPath
+	logging.Info("Imported DB dump", types.Config, "mode", options.Mode, "changes", len(report.Changes), "backup", backupPath)
+
+	// Entries removed by the import must not survive in memory, so start from the static config
+	cm.mutex.Lock()
+	cm.currentConfig = cm.getStaticConfigCopyUnsafe()
+	cm.mutex.Unlock()
+	if err := cm.HydrateFromDB(ctx); err != nil {
+		return report, err
+	}
+	return report, nil
+}
+
 // setSeedsAtomic writes all three seeds in a single transaction to keep them consistent.
 func setSeedsAtomic(ctx context.Context, db *sql.DB, cfg Config) error {
 	tx, err := db.BeginTx(ctx, &sql.TxOptions{})
@@ -25,6 +25,20 @@ func TestConfigLoad(t *testing.T) {
 	require.Equal(t, "/root/.inference", testManager.GetChainNodeConfig().KeyringDir)
 }
 
+func TestChainNodeFallbackUrlsEnvOverride(t *testing.T) {
+	testManager := &apiconfig.ConfigManager{
+		KoanProvider: rawbytes.Provider([]byte(testYaml)),
+	}
+
+	t.Setenv("DAPI_CHAIN_NODE__FALLBACK_URLS", "http://sentry-1:26657,http://join1-node:26657, http://sentry-2:26657")
+	t.Setenv("DAPI_CHAIN_NODE__BROADCAST_FANOUT", "true")
+	err := testManager.Load()
+	require.NoError(t, err)
+	chainNode := testManager.GetChainNodeConfig()
+	require.True(t, chainNode.BroadcastFanout)
+	require.Equal(t, []string{"http://join1-node:26657", "http://sentry-1:26657", "http://sentry-2:26657"}, chainNode.RpcUrls())
+}
+
 func TestNodeVersion(t *testing.T) {
 	testManager := &apiconfig.ConfigManager{
 		KoanProvider:   rawbytes.Provider([]byte(testYaml)),
@@ -210,3 +224,43 @@ upgrade_plan:
     binaries: {}
 current_node_version: "v3.0.8"
 `
+
+func TestConfigRedacted(t *testing.T) {
+	config := apiconfig.Config{
+		Api: apiconfig.ApiConfig{AdminAuth: apiconfig.AdminAuthConfig{
+			Tokens:    []apiconfig.AdminTokenConfig{{Name: "finance", Token: "treasury-token", Scopes: []string{"treasury"}}},
+			EnvTokens: []apiconfig.AdminTokenConfig{{Name: "env-node-operator", Token: "ops-token", Scopes: []string{"node-operator"}}},
+		}},
+		ChainNode:       apiconfig.ChainNodeConfig{KeyringPassword: "password"},
+		MLNodeKeyConfig: apiconfig.MLNodeKeyConfig{WorkerPublicKey: "public", WorkerPrivateKey: "private"},
+	}
+
+	redacted := config.Redacted()
+	require.Equal(t, "finance", redacted.Api.AdminAuth.Tokens[0].Name)
+	require.NotContains(t, redacted.Api.AdminAuth.Tokens[0].Token, "treasury-token")
+	require.NotContains(t, redacted.Api.AdminAuth.EnvTokens[0].Token, "ops-token")
+	require.NotEqual(t, "password", redacted.ChainNode.KeyringPassword)
+	require.NotEqual(t, "private", redacted.MLNodeKeyConfig.WorkerPrivateKey)
+	require.Equal(t, "public", redacted.MLNodeKeyConfig.WorkerPublicKey)
+	// The original keeps its credentials
+	require.Equal(t, "treasury-token", config.Api.AdminAuth.Tokens[0].Token)
+}
+
+func TestConfigAdminTokensFromEnv(t *testing.T) {
+	t.Setenv("ADMIN_TOKEN_NODE_OPERATOR", "ops-token")
+	t.Setenv("ADMIN_TOKEN_TREASURY", " ")
+	writeCapture := &CaptureWriterProvider{}
+	testManager := &apiconfig.ConfigManager{
+		KoanProvider:   rawbytes.Provider([]byte(testYaml)),
+		WriterProvider: writeCapture,
+	}
+	require.NoError(t, testManager.Load())
+
+	// Blank variables are ignored
+	tokens := testManager.GetApiConfig().AdminAuth.EnvTokens
+	require.Equal(t, []apiconfig.AdminTokenConfig{{Name: "env-node-operator", Token: "ops-token", Scopes: []string{"node-operator"}}}, tokens)
+
+	// Tokens from the environment never reach the config file
+	require.NoError(t, testManager.Write())
+	require.NotContains(t, writeCapture.CapturedData, "ops-token")
+}
@@ -0,0 +1,394 @@
+package apiconfig
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type ImportMode string
+
+const (
+	// ImportModeMerge upserts the rows of the dump and keeps rows that are only in the database
+	ImportModeMerge ImportMode = "merge"
+	// ImportModeReplace makes nodes, active seeds and KV entries exactly match the dump
+	ImportModeReplace ImportMode = "replace"
+)
+
+type ImportOptions struct {
+	Mode   ImportMode
+	DryRun bool
+}
+
+// RowChange describes what an import does to one row. Before is nil for added rows, After for removed ones.
+type RowChange struct {
+	Table  string         `json:"table"`
+	Key    string         `json:"key"`
+	Action string         `json:"action"` // "add", "update" or "remove"
+	Before map[string]any `json:"before,omitempty"`
+	After  map[string]any `json:"after,omitempty"`
+}
+
+type ImportReport struct {
+	SchemaVersion int         `json:"schema_version"`
+	Mode          ImportMode  `json:"mode"`
+	DryRun        bool        `json:"dry_run"`
+	Applied       bool        `json:"applied"`
+	BackupPath    string      `json:"backup_path,omitempty"`
+	Unchanged     int         `json:"unchanged"`
+	Changes       []RowChange `json:"changes"`
+}
+
+// importTable describes how rows of an exported table are matched and restored.
+// Only content columns take part in diffs, bookkeeping columns (ids, timestamps) are regenerated.
+type importTable struct {
+	name    string
+	key     func(row map[string]any) string
+	columns []string
+	// include filters rows of the dump and of the database that take part in the import
+	include func(row map[string]any) bool
+}
+
+var importTables = []importTable{
+	{
+		name:    "inference_nodes",
+		key:     func(row map[string]any) string { return fmt.Sprint(row["id"]) },
+		columns: []string{"id", "host", "inference_segment", "inference_port", "poc_segment", "poc_port", "max_concurrent", "models_json", "hardware_json"},
+	},
+	{
+		// Seed history is kept, only the active seed of each type is restored
+		name:    "seed_info",
+		key:     func(row map[string]any) string { return fmt.Sprint(row["type"]) },
+		columns: []string{"type", "seed", "epoch_index", "signature", "claimed"},
+		include: func(row map[string]any) bool { return isTruthy(row["is_active"]) },
+	},
+	{
+		name:    "kv_config",
+		key:     func(row map[string]any) string { return fmt.Sprint(row["key"]) },
+		columns: []string{"key", "value_json"},
+	},
+}
+
+// tables of the export that are validated but never restored
+var importIgnoredTables = map[string]bool{
+	"schema_version":  true,
+	"sqlite_sequence": true,
+}
+
+// ParseDbDump decodes the output of ExportAllDb, keeping numbers exact (seeds don't fit into a float64).
+func ParseDbDump(data []byte) (map[string][]map[string]any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var dump map[string][]map[string]any
+	if err := decoder.Decode(&dump); err != nil {
+		return nil, fmt.Errorf("invalid dump: %w", err)
+	}
+	return dump, nil
+}
+
+func dumpSchemaVersion(dump map[string][]map[string]any) (int, error) {
+	version := 0
+	for _, row := range dump["schema_version"] {
+		number, ok := row["version"].(json.Number)
+		if !ok {
+			return 0, fmt.Errorf("invalid schema_version row: %v", row)
+		}
+		v, err := number.Int64()
+		if err != nil {
+			return 0, fmt.Errorf("invalid schema_version row: %w", err)
+		}
+		version = max(version, int(v))
+	}
+	return version, nil
+}
+
+// ImportDb restores nodes, active seeds and KV entries from a dump produced by ExportAllDb.
+// The dump must have the same schema version as the database. All changes are applied in a
+// single transaction; with DryRun set the changes are only computed and reported.
+// Callers running next to a ConfigManager must use ConfigManager.ImportDb so in-memory state follows.
+func ImportDb(ctx context.Context, db *sql.DB, dump map[string][]map[string]any, options ImportOptions) (ImportReport, error) {
+	if options.Mode == "" {
+		options.Mode = ImportModeMerge
+	}
+	if options.Mode != ImportModeMerge && options.Mode != ImportModeReplace {
+		return ImportReport{}, fmt.Errorf("unknown import mode %q", options.Mode)
+	}
+
+	status, err := GetSchemaStatus(ctx, db)
+	if err != nil {
+		return ImportReport{}, err
+	}
+	dumpVersion, err := dumpSchemaVersion(dump)
+	if err != nil {
+		return ImportReport{}, err
+	}
+	if dumpVersion != status.CurrentVersion {
+		return ImportReport{}, fmt.Errorf("dump schema version %d does not match database schema version %d", dumpVersion, status.CurrentVersion)
+	}
+	known := map[string]bool{}
+	for _, table := range importTables {
+		known[table.name] = true
+	}
+	for name := range dump {
+		if !known[name] && !importIgnoredTables[name] {
+			return ImportReport{}, fmt.Errorf("unknown table %q in dump", name)
+		}
+	}
+
+	report := ImportReport{
+		SchemaVersion: status.CurrentVersion,
+		Mode:          options.Mode,
+		DryRun:        options.DryRun,
+		Changes:       []RowChange{},
+	}
+	for _, table := range importTables {
+		changes, unchanged, err := diffTable(ctx, db, table, dump[table.name], options.Mode)
+		if err != nil {
+			return ImportReport{}, fmt.Errorf("diff table %s: %w", table.name, err)
+		}
+		report.Changes = append(report.Changes, changes...)
+		report.Unchanged += unchanged
+	}
+	if options.DryRun || len(report.Changes) == 0 {
+		return report, nil
+	}
+
+	if err := applyImport(ctx, db, report.Changes); err != nil {
+		return ImportReport{}, err
+	}
+	report.Applied = true
+	return report, nil
+}
+
+func diffTable(ctx context.Context, db *sql.DB, table importTable, dumpRows []map[string]any, mode ImportMode) ([]RowChange, int, error) {
+	currentRows, err := dumpTable(ctx, db, table.name)
+	if err != nil {
+		return nil, 0, err
+	}
+	current, err := indexRows(table, currentRows)
+	if err != nil {
+		return nil, 0, err
+	}
+	incoming, err := indexRows(table, dumpRows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	keys := make([]string, 0, len(incoming))
+	for key := range incoming {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var changes []RowChange
+	unchanged := 0
+	for _, key := range keys {
+		after := incoming[key]
+		before, exists := current[key]
+		switch {
+		case !exists:
+			changes = append(changes, RowChange{Table: table.name, Key: key, Action: "add", After: after})
+		case sameRow(before, after):
+			unchanged++
+		default:
+			changes = append(changes, RowChange{Table: table.name, Key: key, Action: "update", Before: before, After: after})
+		}
+	}
+
+	if mode == ImportModeReplace {
+		removed := make([]string, 0)
+		for key := range current {
+			if _, ok := incoming[key]; !ok {
+				removed = append(removed, key)
+			}
+		}
+		sort.Strings(removed)
+		for _, key := range removed {
+			changes = append(changes, RowChange{Table: table.name, Key: key, Action: "remove", Before: current[key]})
+		}
+	}
+	return changes, unchanged, nil
+}
+
+// indexRows keys rows by their natural key, keeping only content columns normalized to JSON values.
+func indexRows(table importTable, rows []map[string]any) (map[string]map[string]any, error) {
+	out := make(map[string]map[string]any, len(rows))
+	for _, row := range rows {
+		if table.include != nil && !table.include(row) {
+			continue
+		}
+		content := make(map[string]any, len(table.columns))
+		for _, column := range table.columns {
+			value, ok := row[column]
+			if !ok {
+				return nil, fmt.Errorf("row is missing column %q", column)
+			}
+			normalized, err := normalizeValue(value)
+			if err != nil {
+				return nil, fmt.Errorf("column %q: %w", column, err)
+			}
+			content[column] = normalized
+		}
+		key := table.key(row)
+		if _, duplicate := out[key]; duplicate {
+			return nil, fmt.Errorf("duplicate row %q", key)
+		}
+		out[key] = content
+	}
+	return out, nil
+}
+
+// normalizeValue round-trips a value through JSON so database values and dump values compare equal.
+func normalizeValue(value any) (any, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var out any
+	if err := decoder.Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func sameRow(a, b map[string]any) bool {
+	left, _ := json.Marshal(a)
+	right, _ := json.Marshal(b)
+	return bytes.Equal(left, right)
+}
+
+func applyImport(ctx context.Context, db *sql.DB, changes []RowChange) error {
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, change := range changes {
+		if err := applyRowChange(ctx, tx, change); err != nil {
+			return fmt.Errorf("%s %s %q: %w", change.Action, change.Table, change.Key, err)
+		}
+	}
+	return tx.Commit()
+}
+
+func applyRowChange(ctx context.Context, tx *sql.Tx, change RowChange) error {
+	switch change.Table {
+	case "inference_nodes":
+		if change.Action == "remove" {
+			_, err := tx.ExecContext(ctx, `DELETE FROM inference_nodes WHERE id = ?`, change.Key)
+			return err
+		}
+		row := change.After
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO inference_nodes (
+  id, host, inference_segment, inference_port, poc_segment, poc_port, max_concurrent, models_json, hardware_json
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(id) DO UPDATE SET
+  host = excluded.host,
+  inference_segment = excluded.inference_segment,
+  inference_port = excluded.inference_port,
+  poc_segment = excluded.poc_segment,
+  poc_port = excluded.poc_port,
+  max_concurrent = excluded.max_concurrent,
+  models_json = excluded.models_json,
+  hardware_json = excluded.hardware_json,
+  updated_at = (STRFTIME('%Y-%m-%d %H:%M:%f','now'))`,
+			row["id"], row["host"], row["inference_segment"], sqlValue(row["inference_port"]), row["poc_segment"],
+			sqlValue(row["poc_port"]), sqlValue(row["max_concurrent"]), row["models_json"], row["hardware_json"])
+		return err
+	case "seed_info":
+		if _, err := tx.ExecContext(ctx, `UPDATE seed_info SET is_active = 0 WHERE type = ? AND is_active = 1`, change.Key); err != nil {
+			return err
+		}
+		if change.Action == "remove" {
+			return nil
+		}
+		row := change.After
+		_, err := tx.ExecContext(ctx, `INSERT INTO seed_info(type, seed, epoch_index, signature, claimed, is_active) VALUES(?, ?, ?, ?, ?, 1)`,
+			change.Key, sqlValue(row["seed"]), sqlValue(row["epoch_index"]), row["signature"], isTruthy(row["claimed"]))
+		return err
+	case "kv_config":
+		if change.Action == "remove" {
+			_, err := tx.ExecContext(ctx, `DELETE FROM kv_config WHERE key = ?`, change.Key)
+			return err
+		}
+		value, err := json.Marshal(change.After["value_json"])
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+INSERT INTO kv_config(key, value_json) VALUES(?, ?)
+ON CONFLICT(key) DO UPDATE SET value_json = excluded.value_json, updated_at = (STRFTIME('%Y-%m-%d %H:%M:%f','now'))`,
+			change.Key, string(value))
+		return err
+	default:
+		return fmt.Errorf("table is not importable")
+	}
+}
+
+// sqlValue converts numbers decoded with UseNumber into int64 where possible.
+func sqlValue(value any) any {
+	if number, ok := value.(json.Number); ok {
+		if v, err := number.Int64(); err == nil {
+			return v
+		}
+		if v, err := number.Float64(); err == nil {
+			return v
+		}
+	}
+	return value
+}
+
+func isTruthy(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case json.Number:
+		return v.String() != "0"
+	case int64:
+		return v != 0
+	case string:
+		return v == "1" || strings.EqualFold(v, "true")
+	default:
+		return false
+	}
+}
+
+// ImportDbFile imports a dump into the database at dbPath (the default dapi database if empty),
+// creating and migrating it if needed. The dapi must not be running, or its next flush would
+// overwrite the imported state; use the admin endpoint in that case.
+func ImportDbFile(ctx context.Context, dbPath string, dump map[string][]map[string]any, options ImportOptions) (ImportReport, error) {
+	if dbPath == "" {
+		dbPath = getSqlitePath()
+	}
+	db, err := OpenSQLite(SqliteConfig{Path: dbPath})
+	if err != nil {
+		return ImportReport{}, err
+	}
+	defer db.Close()
+	if err := MigrateSchema(ctx, db, dbPath); err != nil {
+		return ImportReport{}, err
+	}
+
+	report, err := ImportDb(ctx, db, dump, ImportOptions{Mode: options.Mode, DryRun: true})
+	if err != nil || options.DryRun || len(report.Changes) == 0 {
+		return report, err
+	}
+	backupPath, err := BackupSQLite(ctx, db, dbPath, report.SchemaVersion)
+	if err != nil {
+		return ImportReport{}, fmt.Errorf("backup before import: %w", err)
+	}
+	report, err = ImportDb(ctx, db, dump, options)
+	if err != nil {
+		return ImportReport{}, err
+	}
+	report.BackupPath = backupPath
+	return report, nil
+}
@@ -0,0 +1,132 @@
+package apiconfig_test
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newImportTestManager(t *testing.T, name string) *apiconfig.ConfigManager {
+	tmp := t.TempDir()
+	cfgPath := filepath.Join(tmp, "config.yaml")
+	require.NoError(t, os.WriteFile(cfgPath, []byte("api:\n  port: 8080\n"), 0644))
+	mgr, err := apiconfig.LoadConfigManagerWithPaths(cfgPath, filepath.Join(tmp, name), "")
+	require.NoError(t, err)
+	return mgr
+}
+
+func exportDump(t *testing.T, mgr *apiconfig.ConfigManager) map[string][]map[string]any {
+	ctx := context.Background()
+	require.NoError(t, mgr.FlushNow(ctx))
+	exported, err := apiconfig.ExportAllDb(ctx, mgr.SqlDb().GetDb())
+	require.NoError(t, err)
+	data, err := json.Marshal(exported)
+	require.NoError(t, err)
+	dump, err := apiconfig.ParseDbDump(data)
+	require.NoError(t, err)
+	return dump
+}
+
+func TestImportDb_MigratesToNewHardware(t *testing.T) {
+	ctx := context.Background()
+	source := newImportTestManager(t, "source.db")
+	require.NoError(t, source.SetNodes([]apiconfig.InferenceNodeConfig{
+		{Id: "node-1", Host: "10.0.0.1", InferencePort: 5000, PoCPort: 8080, MaxConcurrent: 4},
+		{Id: "node-2", Host: "10.0.0.2", InferencePort: 5000, PoCPort: 8080, MaxConcurrent: 8},
+	}))
+	// Seeds are random int64 values that don't survive a float64 round-trip
+	require.NoError(t, source.SetCurrentSeed(apiconfig.SeedInfo{Seed: 7263849216553712345, EpochIndex: 12, Signature: "sig"}))
+	require.NoError(t, source.SetLastUsedVersion("v0.2.0"))
+	dump := exportDump(t, source)
+
+	target := newImportTestManager(t, "target.db")
+	require.NoError(t, target.SetNodes([]apiconfig.InferenceNodeConfig{
+		{Id: "node-1", Host: "old-host", InferencePort: 5000, PoCPort: 8080, MaxConcurrent: 4},
+		{Id: "stale", Host: "10.0.0.9", InferencePort: 5000, PoCPort: 8080, MaxConcurrent: 1},
+	}))
+	require.NoError(t, target.FlushNow(ctx))
+
+	// Dry run reports row diffs without touching anything
+	report, err := target.ImportDb(ctx, dump, apiconfig.ImportOptions{Mode: apiconfig.ImportModeReplace, DryRun: true})
+	require.NoError(t, err)
+	require.False(t, report.Applied)
+	actions := map[string]string{}
+	for _, change := range report.Changes {
+		actions[change.Table+"/"+change.Key] = change.Action
+	}
+	require.Equal(t, "update", actions["inference_nodes/node-1"])
+	require.Equal(t, "add", actions["inference_nodes/node-2"])
+	require.Equal(t, "remove", actions["inference_nodes/stale"])
+	require.Equal(t, "update", actions["seed_info/current"])
+	require.Len(t, target.GetNodes(), 2)
+	require.Equal(t, "old-host", target.GetNodes()[0].Host)
+
+	report, err = target.ImportDb(ctx, dump, apiconfig.ImportOptions{Mode: apiconfig.ImportModeReplace})
+	require.NoError(t, err)
+	require.True(t, report.Applied)
+	require.NotEmpty(t, report.BackupPath)
+	require.FileExists(t, report.BackupPath)
+
+	// In-memory state follows the import and survives the next flush
+	require.NoError(t, target.FlushNow(ctx))
+	nodes := target.GetNodes()
+	require.Len(t, nodes, 2)
+	require.Equal(t, "10.0.0.1", nodes[0].Host)
+	require.Equal(t, "node-2", nodes[1].Id)
+	require.Equal(t, int64(7263849216553712345), target.GetCurrentSeed().Seed)
+	require.Equal(t, "v0.2.0", target.GetLastUsedVersion())
+
+	// Importing the same dump again changes nothing
+	report, err = target.ImportDb(ctx, dump, apiconfig.ImportOptions{Mode: apiconfig.ImportModeReplace})
+	require.NoError(t, err)
+	require.False(t, report.Applied)
+	require.Empty(t, report.Changes)
+}
+
+func TestImportDb_MergeKeepsLocalRows(t *testing.T) {
+	ctx := context.Background()
+	source := newImportTestManager(t, "source.db")
+	require.NoError(t, source.SetNodes([]apiconfig.InferenceNodeConfig{{Id: "node-1", Host: "10.0.0.1"}}))
+	dump := exportDump(t, source)
+
+	target := newImportTestManager(t, "target.db")
+	require.NoError(t, target.SetNodes([]apiconfig.InferenceNodeConfig{{Id: "local", Host: "10.0.0.5"}}))
+
+	report, err := target.ImportDb(ctx, dump, apiconfig.ImportOptions{Mode: apiconfig.ImportModeMerge})
+	require.NoError(t, err)
+	require.True(t, report.Applied)
+	for _, change := range report.Changes {
+		require.NotEqual(t, "remove", change.Action)
+	}
+	nodes := target.GetNodes()
+	require.Len(t, nodes, 2)
+	require.Equal(t, "local", nodes[0].Id)
+	require.Equal(t, "node-1", nodes[1].Id)
+}
+
+func TestImportDb_RejectsMismatchedDumps(t *testing.T) {
+	ctx := context.Background()
+	mgr := newImportTestManager(t, "test.db")
+	dump := exportDump(t, mgr)
+
+	stale := map[string][]map[string]any{}
+	for table, rows := range dump {
+		stale[table] = rows
+	}
+	stale["schema_version"] = stale["schema_version"][:1]
+	_, err := mgr.ImportDb(ctx, stale, apiconfig.ImportOptions{})
+	require.ErrorContains(t, err, "does not match database schema version")
+
+	dump["unknown_table"] = []map[string]any{}
+	_, err = mgr.ImportDb(ctx, dump, apiconfig.ImportOptions{})
+	require.ErrorContains(t, err, "unknown table")
+
+	delete(dump, "unknown_table")
+	_, err = mgr.ImportDb(ctx, dump, apiconfig.ImportOptions{Mode: "overwrite"})
+	require.ErrorContains(t, err, "unknown import mode")
+}
@@ -0,0 +1,256 @@
+package apiconfig
+
+import (
+	"context"
+	"database/sql"
+	"decentralized-api/logging"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// Migration is a numbered, forward-only change to the SQLite schema.
+// Migrations are applied in order, each in its own transaction together with its schema_version row.
+// Never edit a migration that has been released, add a new one instead.
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(ctx context.Context, tx *sql.Tx) error
+}
+
+// AppliedMigration is a row of the schema_version table.
+type AppliedMigration struct {
+	Version   int    `json:"version"`
+	Name      string `json:"name"`
+	AppliedAt string `json:"applied_at"`
+}
+
+type PendingMigration struct {
+	Version int    `json:"version"`
+	Name    string `json:"name"`
+}
+
+type SchemaStatus struct {
+	CurrentVersion int                `json:"current_version"`
+	LatestVersion  int                `json:"latest_version"`
+	Applied        []AppliedMigration `json:"applied"`
+	Pending        []PendingMigration `json:"pending"`
+}
+
+func execMigration(statements string) func(ctx context.Context, tx *sql.Tx) error {
+	return func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, statements)
+		return err
+	}
+}
+
+// migrations lists every schema change of the dapi database. Append only.
+var migrations = []Migration{
+	{
+		// Databases created before versioning already have these tables, hence IF NOT EXISTS
+		Version: 1,
+		Name:    "initial_schema",
+		Up: execMigration(`
+CREATE TABLE IF NOT EXISTS inference_nodes (
+  id TEXT PRIMARY KEY,
+  host TEXT NOT NULL,
+  inference_segment TEXT NOT NULL,
+  inference_port INTEGER NOT NULL,
+  poc_segment TEXT NOT NULL,
+  poc_port INTEGER NOT NULL,
+  max_concurrent INTEGER NOT NULL,
+  models_json TEXT NOT NULL,
+  hardware_json TEXT NOT NULL,
+  updated_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now')),
+  created_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now'))
+);
+
+CREATE TABLE IF NOT EXISTS kv_config (
+  key TEXT PRIMARY KEY,
+  value_json TEXT NOT NULL,
+  updated_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now')),
+  created_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now'))
+);
+
+CREATE TABLE IF NOT EXISTS seed_info (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  type TEXT NOT NULL, -- 'current', 'previous', 'upcoming'
+  seed INTEGER NOT NULL,
+  epoch_index INTEGER NOT NULL,
+  signature TEXT NOT NULL,
+  claimed BOOLEAN NOT NULL DEFAULT 0,
+  is_active BOOLEAN NOT NULL DEFAULT 1,
+  created_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now'))
+);`),
+	},
+	{
+		// Every seed lookup filters on the active row of a type
+		Version: 2,
+		Name:    "seed_info_active_index",
+		Up:      execMigration(`CREATE INDEX IF NOT EXISTS idx_seed_info_type_active ON seed_info(type, is_active);`),
+	},
+}
+
+// LatestSchemaVersion is the version the database has after all known migrations are applied.
+func LatestSchemaVersion() int {
+	return migrations[len(migrations)-1].Version
+}
+
+func ensureSchemaVersionTable(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `
+CREATE TABLE IF NOT EXISTS schema_version (
+  version INTEGER PRIMARY KEY,
+  name TEXT NOT NULL,
+  applied_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now'))
+);`)
+	return err
+}
+
+func readAppliedMigrations(ctx context.Context, db *sql.DB) ([]AppliedMigration, error) {
+	var exists int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM sqlite_schema WHERE type = 'table' AND name = 'schema_version'`).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if exists == 0 {
+		return nil, nil
+	}
+	rows, err := db.QueryContext(ctx, `SELECT version, name, applied_at FROM schema_version ORDER BY version`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []AppliedMigration
+	for rows.Next() {
+		var m AppliedMigration
+		if err := rows.Scan(&m.Version, &m.Name, &m.AppliedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	return out, rows.Err()
+}
+
+// GetSchemaStatus reports the applied and pending migrations without changing the database.
+func GetSchemaStatus(ctx context.Context, db *sql.DB) (SchemaStatus, error) {
+	return getSchemaStatus(ctx, db, migrations)
+}
+
+func getSchemaStatus(ctx context.Context, db *sql.DB, list []Migration) (SchemaStatus, error) {
+	applied, err := readAppliedMigrations(ctx, db)
+	if err != nil {
+		return SchemaStatus{}, err
+	}
+	status := SchemaStatus{
+		LatestVersion: list[len(list)-1].Version,
+		Applied:       applied,
+		Pending:       []PendingMigration{},
+	}
+	if len(applied) > 0 {
+		status.CurrentVersion = applied[len(applied)-1].Version
+	}
+	for _, m := range list {
+		if m.Version > status.CurrentVersion {
+			status.Pending = append(status.Pending, PendingMigration{Version: m.Version, Name: m.Name})
+		}
+	}
+	return status, nil
+}
+
+// MigrateSchema applies all pending migrations. If the database already holds data, it is first
+// copied next to dbPath (see BackupSQLite) so a failed upgrade can be rolled back by hand.
+// A database written by a newer dapi version is refused rather than silently used.
+func MigrateSchema(ctx context.Context, db *sql.DB, dbPath string) error {
+	return MigrateSchemaWith(ctx, db, dbPath, migrations)
+}
+
+// MigrateSchemaWith is MigrateSchema for another database of the dapi, with its own list of migrations.
+func MigrateSchemaWith(ctx context.Context, db *sql.DB, dbPath string, list []Migration) error {
+	status, err := getSchemaStatus(ctx, db, list)
+	if err != nil {
+		return fmt.Errorf("read schema version: %w", err)
+	}
+	if status.CurrentVersion > status.LatestVersion {
+		return fmt.Errorf("database schema version %d is newer than the latest known version %d", status.CurrentVersion, status.LatestVersion)
+	}
+	if len(status.Pending) == 0 {
+		return nil
+	}
+
+	tables, err := listUserTables(ctx, db)
+	if err != nil {
+		return err
+	}
+	if len(tables) > 0 && isFileDatabase(dbPath) {
+		backupPath, err := BackupSQLite(ctx, db, dbPath, status.CurrentVersion)
+		if err != nil {
+			return fmt.Errorf("backup before migration: %w", err)
+		}
+		logging.Info("Backed up database before migration", types.Config, "path", backupPath, "from_version", status.CurrentVersion)
+	}
+
+	if err := ensureSchemaVersionTable(ctx, db); err != nil {
+		return err
+	}
+	for _, m := range list {
+		if m.Version <= status.CurrentVersion {
+			continue
+		}
+		if err := applyMigration(ctx, db, m); err != nil {
+			return fmt.Errorf("migration %d (%s): %w", m.Version, m.Name, err)
+		}
+		logging.Info("Applied database migration", types.Config, "version", m.Version, "name", m.Name)
+	}
+	return nil
+}
+
+func applyMigration(ctx context.Context, db *sql.DB, m Migration) error {
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := m.Up(ctx, tx); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO schema_version(version, name) VALUES(?, ?)`, m.Version, m.Name); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// BackupSQLite writes a consistent copy of the database to "<dbPath>.v<version>-<timestamp>.bak".
+func BackupSQLite(ctx context.Context, db *sql.DB, dbPath string, version int) (string, error) {
+	backupPath := fmt.Sprintf("%s.v%d-%s.bak", dbPath, version, time.Now().UTC().Format("20060102T150405"))
+	if _, err := os.Stat(backupPath); err == nil {
+		return "", fmt.Errorf("backup %s already exists", backupPath)
+	}
+	if _, err := db.ExecContext(ctx, `VACUUM INTO ?`, backupPath); err != nil {
+		return "", err
+	}
+	return backupPath, nil
+}
+
+func isFileDatabase(path string) bool {
+	return path != "" && path != ":memory:" && !strings.HasPrefix(path, "file:")
+}
+
+// InspectSchema opens the database at path (the default dapi database if empty) and reports its
+// migration status without applying anything.
+func InspectSchema(ctx context.Context, path string) (SchemaStatus, error) {
+	if path == "" {
+		path = getSqlitePath()
+	}
+	if _, err := os.Stat(path); err != nil {
+		return SchemaStatus{}, err
+	}
+	db, err := OpenSQLite(SqliteConfig{Path: path})
+	if err != nil {
+		return SchemaStatus{}, err
+	}
+	defer db.Close()
+	return GetSchemaStatus(ctx, db)
+}
@@ -0,0 +1,117 @@
+package apiconfig
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateSchema_FreshDatabase(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "fresh.db")
+	db, err := OpenSQLite(SqliteConfig{Path: dbPath})
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, MigrateSchema(ctx, db, dbPath))
+
+	status, err := GetSchemaStatus(ctx, db)
+	require.NoError(t, err)
+	require.Equal(t, LatestSchemaVersion(), status.CurrentVersion)
+	require.Len(t, status.Applied, len(migrations))
+	require.Empty(t, status.Pending)
+
+	// Nothing to back up in an empty database
+	backups, err := filepath.Glob(dbPath + ".v*.bak")
+	require.NoError(t, err)
+	require.Empty(t, backups)
+
+	// Running again is a no-op
+	require.NoError(t, MigrateSchema(ctx, db, dbPath))
+}
+
+func TestMigrateSchema_LegacyDatabaseIsBackedUp(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "legacy.db")
+	db, err := OpenSQLite(SqliteConfig{Path: dbPath})
+	require.NoError(t, err)
+	defer db.Close()
+
+	// A database created before versioning: tables exist, schema_version doesn't
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	require.NoError(t, migrations[0].Up(ctx, tx))
+	require.NoError(t, tx.Commit())
+	require.NoError(t, KVSetString(ctx, db, "last_used_version", "v1"))
+
+	status, err := GetSchemaStatus(ctx, db)
+	require.NoError(t, err)
+	require.Equal(t, 0, status.CurrentVersion)
+	require.Len(t, status.Pending, len(migrations))
+
+	require.NoError(t, MigrateSchema(ctx, db, dbPath))
+
+	value, ok, err := KVGetString(ctx, db, "last_used_version")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "v1", value)
+
+	backups, err := filepath.Glob(dbPath + ".v0-*.bak")
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	backup, err := OpenSQLite(SqliteConfig{Path: backups[0]})
+	require.NoError(t, err)
+	defer backup.Close()
+	value, ok, err = KVGetString(ctx, backup, "last_used_version")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "v1", value)
+}
+
+func TestMigrateSchema_FailedMigrationIsRolledBack(t *testing.T) {
+	ctx := context.Background()
+	db, err := OpenSQLite(SqliteConfig{Path: filepath.Join(t.TempDir(), "rollback.db")})
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, EnsureSchema(ctx, db))
+
+	original := migrations
+	t.Cleanup(func() { migrations = original })
+	migrations = append(append([]Migration{}, original...), Migration{
+		Version: LatestSchemaVersion() + 1,
+		Name:    "broken",
+		Up: func(ctx context.Context, tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, `CREATE TABLE half_done (id INTEGER)`); err != nil {
+				return err
+			}
+			return errors.New("boom")
+		},
+	})
+
+	require.ErrorContains(t, EnsureSchema(ctx, db), "boom")
+
+	status, err := GetSchemaStatus(ctx, db)
+	require.NoError(t, err)
+	require.Equal(t, original[len(original)-1].Version, status.CurrentVersion)
+	require.Len(t, status.Pending, 1)
+	tables, err := listUserTables(ctx, db)
+	require.NoError(t, err)
+	require.NotContains(t, tables, "half_done")
+}
+
+func TestMigrateSchema_RefusesNewerDatabase(t *testing.T) {
+	ctx := context.Background()
+	db, err := OpenSQLite(SqliteConfig{Path: filepath.Join(t.TempDir(), "newer.db")})
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, EnsureSchema(ctx, db))
+
+	_, err = db.ExecContext(ctx, `INSERT INTO schema_version(version, name) VALUES(?, 'from_the_future')`, LatestSchemaVersion()+1)
+	require.NoError(t, err)
+
+	require.ErrorContains(t, EnsureSchema(ctx, db), "newer than the latest known version")
+}
@@ -35,7 +35,7 @@ func (d *SqliteDb) BootstrapLocal(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	if err := EnsureSchema(ctx, db); err != nil {
+	if err := MigrateSchema(ctx, db, d.config.Path); err != nil {
 		_ = db.Close()
 		return err
 	}
@@ -70,42 +70,9 @@ func OpenSQLite(cfg SqliteConfig) (*sql.DB, error) {
 	return db, nil
 }
 
-// EnsureSchema creates the minimal tables for storing dynamic config: inference nodes.
+// EnsureSchema applies all pending schema migrations without taking a backup.
 func EnsureSchema(ctx context.Context, db *sql.DB) error {
-	stmt := `
-CREATE TABLE IF NOT EXISTS inference_nodes (
-  id TEXT PRIMARY KEY,
-  host TEXT NOT NULL,
-  inference_segment TEXT NOT NULL,
-  inference_port INTEGER NOT NULL,
-  poc_segment TEXT NOT NULL,
-  poc_port INTEGER NOT NULL,
-  max_concurrent INTEGER NOT NULL,
-  models_json TEXT NOT NULL,
-  hardware_json TEXT NOT NULL,
-  updated_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now')),
-  created_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now'))
-);
-
-CREATE TABLE IF NOT EXISTS kv_config (
-  key TEXT PRIMARY KEY,
-  value_json TEXT NOT NULL,
-  updated_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now')),
-  created_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now'))
-);
-
-CREATE TABLE IF NOT EXISTS seed_info (
-  id INTEGER PRIMARY KEY AUTOINCREMENT,
-  type TEXT NOT NULL, -- 'current', 'previous', 'upcoming'
-  seed INTEGER NOT NULL,
-  epoch_index INTEGER NOT NULL,
-  signature TEXT NOT NULL,
-  claimed BOOLEAN NOT NULL DEFAULT 0,
-  is_active BOOLEAN NOT NULL DEFAULT 1,
-  created_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now'))
-);`
-	_, err := db.ExecContext(ctx, stmt)
-	return err
+	return MigrateSchema(ctx, db, "")
 }
 
 // UpsertInferenceNodes replaces or inserts the given nodes by id.
@@ -446,11 +413,11 @@ func dumpTable(ctx context.Context, db *sql.DB, table string) ([]map[string]any,
 	results := make([]map[string]any, 0, 64)
 	for rows.Next() {
 		scanHolders := make([]any, len(cols))
-		// temporary holders
-		intH := make([]sql.NullInt64, 0)
-		floatH := make([]sql.NullFloat64, 0)
-		strH := make([]sql.NullString, 0)
-		rawH := make([][]byte, 0)
+		// temporary holders, sized up front so appends never move the elements scanHolders point to
+		intH := make([]sql.NullInt64, 0, len(cols))
+		floatH := make([]sql.NullFloat64, 0, len(cols))
+		strH := make([]sql.NullString, 0, len(cols))
+		rawH := make([][]byte, 0, len(cols))
 		holderKinds := make([]string, len(cols))
 		// build holders per column
 		for i, c := range cols {
@@ -44,12 +44,11 @@ type BrokerChainBridge interface {
 }
 
 type BrokerChainBridgeImpl struct {
-	client       cosmosclient.CosmosMessageClient
-	chainNodeUrl string
+	client cosmosclient.CosmosMessageClient
 }
 
-func NewBrokerChainBridgeImpl(client cosmosclient.CosmosMessageClient, chainNodeUrl string) BrokerChainBridge {
-	return &BrokerChainBridgeImpl{client: client, chainNodeUrl: chainNodeUrl}
+func NewBrokerChainBridgeImpl(client cosmosclient.CosmosMessageClient) BrokerChainBridge {
+	return &BrokerChainBridgeImpl{client: client}
 }
 
 func (b *BrokerChainBridgeImpl) GetHardwareNodes() (*types.QueryHardwareNodesResponse, error) {
@@ -66,12 +65,7 @@ func (b *BrokerChainBridgeImpl) SubmitHardwareDiff(diff *types.MsgSubmitHardware
 }
 
 func (b *BrokerChainBridgeImpl) GetBlockHash(height int64) (string, error) {
-	client, err := cosmosclient.NewRpcClient(b.chainNodeUrl)
-	if err != nil {
-		return "", err
-	}
-
-	block, err := client.Block(context.Background(), &height)
+	block, err := b.client.GetClientContext().Client.Block(context.Background(), &height)
 	if err != nil {
 		return "", err
 	}
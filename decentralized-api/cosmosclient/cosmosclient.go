@@ -42,6 +42,8 @@ type InferenceCosmosClient struct {
 	apiAccount *apiconfig.ApiAccount
 	Address    string
 	manager    tx_manager.TxManager
+	endpoints  *EndpointPool
+	rpcClient  *FailoverRPCClient
 }
 
 func NewInferenceCosmosClientWithRetry(
@@ -109,12 +111,21 @@ func NewInferenceCosmosClient(ctx context.Context, addressPrefix string, config
 	}
 
 	log.Printf("Initializing cosmos Client."+
-		"NodeUrl = %s. KeyringBackend = %s. KeyringDir = %s", nodeConfig.Url, nodeConfig.KeyringBackend, keyringDir)
+		"NodeUrls = %v. KeyringBackend = %s. KeyringDir = %s", nodeConfig.RpcUrls(), nodeConfig.KeyringBackend, keyringDir)
+	endpoints, err := NewEndpointPool(nodeConfig)
+	if err != nil {
+		log.Printf("Error creating chain endpoint pool: %s", err)
+		return nil, err
+	}
+	endpoints.Start(ctx)
+	rpcClient := NewFailoverRPCClient(endpoints, nodeConfig.BroadcastFanout)
+
 	cosmoclient, err := cosmosclient.New(
 		ctx,
 		cosmosclient.WithAddressPrefix(addressPrefix),
 		cosmosclient.WithKeyringServiceName("inferenced"),
 		cosmosclient.WithNodeAddress(nodeConfig.Url),
+		cosmosclient.WithRPCClient(rpcClient),
 		cosmosclient.WithKeyringDir(keyringDir),
 		cosmosclient.WithGasPrices("0ngonka"),
 		cosmosclient.WithFees("0ngonka"),
@@ -159,6 +170,8 @@ func NewInferenceCosmosClient(ctx context.Context, addressPrefix string, config
 		Address:    accAddress,
 		apiAccount: apiAccount,
 		manager:    mn,
+		endpoints:  endpoints,
+		rpcClient:  rpcClient,
 	}, nil
 }
 
@@ -214,6 +227,16 @@ func (icc *InferenceCosmosClient) Status(ctx context.Context) (*ctypes.ResultSta
 	return icc.manager.Status(ctx)
 }
 
+// GetEndpointPool returns the health state of the configured chain RPC endpoints.
+func (icc *InferenceCosmosClient) GetEndpointPool() *EndpointPool {
+	return icc.endpoints
+}
+
+// GetRpcClient returns the CometBFT RPC client that fails over between the configured chain endpoints.
+func (icc *InferenceCosmosClient) GetRpcClient() *FailoverRPCClient {
+	return icc.rpcClient
+}
+
 func (icc *InferenceCosmosClient) GetContext() context.Context {
 	return icc.ctx
 }
@@ -0,0 +1,267 @@
+package cosmosclient
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+	"errors"
+	"sync"
+	"time"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	defaultMaxHeightLag        = 5
+	defaultHealthCheckInterval = 10 * time.Second
+	probeTimeout               = 5 * time.Second
+)
+
+// EndpointStatus is the last observed state of one chain RPC endpoint.
+type EndpointStatus struct {
+	Url          string    `json:"url"`
+	Current      bool      `json:"current"`
+	Healthy      bool      `json:"healthy"`
+	CatchingUp   bool      `json:"catching_up"`
+	LatestHeight int64     `json:"latest_height"`
+	LastChecked  time.Time `json:"last_checked"`
+	LastError    string    `json:"last_error,omitempty"`
+}
+
+type endpoint struct {
+	client rpcclient.Client
+	status EndpointStatus
+}
+
+type StatusProber func(ctx context.Context, client rpcclient.Client) (*coretypes.ResultStatus, error)
+
+func defaultStatusProber(ctx context.Context, client rpcclient.Client) (*coretypes.ResultStatus, error) {
+	return client.Status(ctx)
+}
+
+// EndpointPool keeps track of the health and sync height of all configured chain RPC endpoints
+// and decides which one is currently used. The earliest configured endpoint that is reachable,
+// not catching up and within MaxHeightLag of the best known height wins, so traffic returns to
+// the primary endpoint as soon as it has recovered.
+type EndpointPool struct {
+	mu           sync.RWMutex
+	endpoints    []*endpoint
+	current      int
+	maxHeightLag int64
+	interval     time.Duration
+	prober       StatusProber
+	onSwitch     []func(oldUrl, newUrl string)
+}
+
+func NewEndpointPool(config apiconfig.ChainNodeConfig) (*EndpointPool, error) {
+	urls := config.RpcUrls()
+	if len(urls) == 0 {
+		return nil, errors.New("no chain node url configured")
+	}
+	clients := make([]rpcclient.Client, 0, len(urls))
+	for _, url := range urls {
+		client, err := NewRpcClient(url)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+	}
+	pool := NewEndpointPoolWithClients(urls, clients, defaultStatusProber)
+	if config.MaxHeightLag > 0 {
+		pool.maxHeightLag = config.MaxHeightLag
+	}
+	if config.HealthCheckIntervalSec > 0 {
+		pool.interval = time.Duration(config.HealthCheckIntervalSec) * time.Second
+	}
+	return pool, nil
+}
+
+// NewEndpointPoolWithClients allows injecting custom RPC clients and a status prober (used in tests)
+func NewEndpointPoolWithClients(urls []string, clients []rpcclient.Client, prober StatusProber) *EndpointPool {
+	endpoints := make([]*endpoint, len(urls))
+	for i, url := range urls {
+		endpoints[i] = &endpoint{
+			client: clients[i],
+			// Endpoints are assumed healthy until the first probe says otherwise
+			status: EndpointStatus{Url: url, Healthy: true},
+		}
+	}
+	return &EndpointPool{
+		endpoints:    endpoints,
+		maxHeightLag: defaultMaxHeightLag,
+		interval:     defaultHealthCheckInterval,
+		prober:       prober,
+	}
+}
+
+// Start probes all endpoints once and then periodically until ctx is done.
+func (p *EndpointPool) Start(ctx context.Context) {
+	p.ProbeAll(ctx)
+	go func() {
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.ProbeAll(ctx)
+			}
+		}
+	}()
+}
+
+// OnSwitch registers a callback invoked whenever the current endpoint changes.
+func (p *EndpointPool) OnSwitch(callback func(oldUrl, newUrl string)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.onSwitch = append(p.onSwitch, callback)
+}
+
+func (p *EndpointPool) ProbeAll(ctx context.Context) {
+	p.mu.RLock()
+	targets := make([]candidate, len(p.endpoints))
+	for i, ep := range p.endpoints {
+		targets[i] = candidate{url: ep.status.Url, client: ep.client}
+	}
+	p.mu.RUnlock()
+
+	results := make([]EndpointStatus, len(targets))
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target candidate) {
+			defer wg.Done()
+			probeCtx, cancel := context.WithTimeout(ctx, probeTimeout)
+			defer cancel()
+			result := EndpointStatus{Url: target.url, LastChecked: time.Now()}
+			status, err := p.prober(probeCtx, target.client)
+			if err != nil {
+				result.LastError = err.Error()
+			} else {
+				result.Healthy = true
+				result.CatchingUp = status.SyncInfo.CatchingUp
+				result.LatestHeight = status.SyncInfo.LatestBlockHeight
+			}
+			results[i] = result
+		}(i, target)
+	}
+	wg.Wait()
+
+	p.mu.Lock()
+	for i, ep := range p.endpoints {
+		if !results[i].Healthy {
+			logging.Warn("Chain endpoint probe failed", types.System, "url", results[i].Url, "error", results[i].LastError)
+		}
+		ep.status = results[i]
+	}
+	p.selectLocked()
+}
+
+// ReportFailure marks an endpoint as unhealthy after a failed call and switches to another one if possible.
+// The endpoint is reconsidered at the next probe.
+func (p *EndpointPool) ReportFailure(url string, err error) {
+	p.mu.Lock()
+	for _, ep := range p.endpoints {
+		if ep.status.Url == url {
+			ep.status.Healthy = false
+			ep.status.LastError = err.Error()
+		}
+	}
+	logging.Warn("Chain endpoint call failed", types.System, "url", url, "error", err)
+	p.selectLocked()
+}
+
+// selectLocked picks the current endpoint and releases the lock before notifying switch listeners.
+func (p *EndpointPool) selectLocked() {
+	var bestHeight int64
+	for _, ep := range p.endpoints {
+		if ep.status.Healthy && ep.status.LatestHeight > bestHeight {
+			bestHeight = ep.status.LatestHeight
+		}
+	}
+
+	next := -1
+	for i, ep := range p.endpoints {
+		if ep.status.Healthy && !ep.status.CatchingUp && bestHeight-ep.status.LatestHeight <= p.maxHeightLag {
+			next = i
+			break
+		}
+	}
+	if next == -1 {
+		// Nothing is in sync, fall back to anything that still answers
+		for i, ep := range p.endpoints {
+			if ep.status.Healthy {
+				next = i
+				break
+			}
+		}
+	}
+
+	oldUrl := p.endpoints[p.current].status.Url
+	switched := next != -1 && next != p.current
+	if switched {
+		p.current = next
+	}
+	newUrl := p.endpoints[p.current].status.Url
+	callbacks := append([]func(string, string){}, p.onSwitch...)
+	p.mu.Unlock()
+
+	if switched {
+		logging.Warn("Switching chain endpoint", types.System, "from", oldUrl, "to", newUrl)
+		for _, callback := range callbacks {
+			callback(oldUrl, newUrl)
+		}
+	}
+}
+
+// Current returns the URL of the endpoint currently in use.
+func (p *EndpointPool) Current() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.endpoints[p.current].status.Url
+}
+
+// Primary returns the RPC client of the first configured endpoint.
+func (p *EndpointPool) Primary() rpcclient.Client {
+	return p.endpoints[0].client
+}
+
+// Statuses returns a snapshot of all endpoints in configuration order.
+func (p *EndpointPool) Statuses() []EndpointStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	statuses := make([]EndpointStatus, len(p.endpoints))
+	for i, ep := range p.endpoints {
+		statuses[i] = ep.status
+		statuses[i].Current = i == p.current
+	}
+	return statuses
+}
+
+type candidate struct {
+	url     string
+	client  rpcclient.Client
+	healthy bool
+}
+
+// candidates returns the current endpoint first, followed by the other healthy and then unhealthy ones.
+func (p *EndpointPool) candidates() []candidate {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	result := make([]candidate, 0, len(p.endpoints))
+	add := func(ep *endpoint) {
+		result = append(result, candidate{url: ep.status.Url, client: ep.client, healthy: ep.status.Healthy})
+	}
+	add(p.endpoints[p.current])
+	for _, healthy := range []bool{true, false} {
+		for i, ep := range p.endpoints {
+			if i != p.current && ep.status.Healthy == healthy {
+				add(ep)
+			}
+		}
+	}
+	return result
+}
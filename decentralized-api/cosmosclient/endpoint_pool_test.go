@@ -0,0 +1,139 @@
+package cosmosclient
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEndpointClient struct {
+	rpcclient.Client
+	height     int64
+	catchingUp bool
+	down       bool
+	queries    atomic.Int32
+	broadcasts atomic.Int32
+}
+
+func (f *fakeEndpointClient) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	if f.down {
+		return nil, errors.New("connection refused")
+	}
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: f.height, CatchingUp: f.catchingUp}}, nil
+}
+
+func (f *fakeEndpointClient) ABCIQuery(ctx context.Context, path string, data bytes.HexBytes) (*coretypes.ResultABCIQuery, error) {
+	f.queries.Add(1)
+	if f.down {
+		return nil, errors.New("connection refused")
+	}
+	if path == "/missing" {
+		return nil, &rpctypes.RPCError{Code: -32603, Message: "not found"}
+	}
+	return &coretypes.ResultABCIQuery{}, nil
+}
+
+func (f *fakeEndpointClient) BroadcastTxSync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	f.broadcasts.Add(1)
+	return &coretypes.ResultBroadcastTx{}, nil
+}
+
+func (f *fakeEndpointClient) BroadcastTxAsync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	f.broadcasts.Add(1)
+	return &coretypes.ResultBroadcastTx{}, nil
+}
+
+func newTestPool(clients ...*fakeEndpointClient) *EndpointPool {
+	urls := []string{"http://primary:26657", "http://sentry-1:26657", "http://sentry-2:26657"}[:len(clients)]
+	rpcClients := make([]rpcclient.Client, len(clients))
+	for i, c := range clients {
+		rpcClients[i] = c
+	}
+	return NewEndpointPoolWithClients(urls, rpcClients, defaultStatusProber)
+}
+
+func TestEndpointPool_PrefersPrimaryWhenInSync(t *testing.T) {
+	pool := newTestPool(&fakeEndpointClient{height: 100}, &fakeEndpointClient{height: 102})
+	pool.ProbeAll(context.Background())
+	require.Equal(t, "http://primary:26657", pool.Current())
+}
+
+func TestEndpointPool_SwitchesAwayFromLaggingOrDownPrimary(t *testing.T) {
+	primary := &fakeEndpointClient{height: 80}
+	pool := newTestPool(primary, &fakeEndpointClient{height: 100, catchingUp: true}, &fakeEndpointClient{height: 100})
+
+	var switches []string
+	pool.OnSwitch(func(oldUrl, newUrl string) {
+		switches = append(switches, oldUrl+"->"+newUrl)
+	})
+
+	pool.ProbeAll(context.Background())
+	require.Equal(t, "http://sentry-2:26657", pool.Current())
+
+	primary.height = 100
+	pool.ProbeAll(context.Background())
+	require.Equal(t, "http://primary:26657", pool.Current())
+
+	primary.down = true
+	pool.ProbeAll(context.Background())
+	require.Equal(t, "http://sentry-2:26657", pool.Current())
+
+	require.Equal(t, []string{
+		"http://primary:26657->http://sentry-2:26657",
+		"http://sentry-2:26657->http://primary:26657",
+		"http://primary:26657->http://sentry-2:26657",
+	}, switches)
+
+	statuses := pool.Statuses()
+	require.False(t, statuses[0].Healthy)
+	require.NotEmpty(t, statuses[0].LastError)
+	require.True(t, statuses[2].Current)
+}
+
+func TestFailoverRPCClient_RetriesOnUnreachableEndpoint(t *testing.T) {
+	primary := &fakeEndpointClient{height: 100}
+	fallback := &fakeEndpointClient{height: 100}
+	pool := newTestPool(primary, fallback)
+	pool.ProbeAll(context.Background())
+
+	primary.down = true
+	client := NewFailoverRPCClient(pool, false)
+	_, err := client.ABCIQuery(context.Background(), "/store", nil)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), primary.queries.Load())
+	require.Equal(t, int32(1), fallback.queries.Load())
+	require.Equal(t, "http://sentry-1:26657", pool.Current())
+}
+
+func TestFailoverRPCClient_DoesNotRetryNodeErrors(t *testing.T) {
+	primary := &fakeEndpointClient{height: 100}
+	fallback := &fakeEndpointClient{height: 100}
+	pool := newTestPool(primary, fallback)
+
+	client := NewFailoverRPCClient(pool, false)
+	_, err := client.ABCIQuery(context.Background(), "/missing", nil)
+	require.Error(t, err)
+	require.Equal(t, int32(0), fallback.queries.Load())
+	require.Equal(t, "http://primary:26657", pool.Current())
+}
+
+func TestFailoverRPCClient_BroadcastFanout(t *testing.T) {
+	primary := &fakeEndpointClient{height: 100}
+	fallback := &fakeEndpointClient{height: 100}
+	pool := newTestPool(primary, fallback)
+
+	client := NewFailoverRPCClient(pool, true)
+	_, err := client.BroadcastTxSync(context.Background(), cmttypes.Tx("tx"))
+	require.NoError(t, err)
+	require.Equal(t, int32(1), primary.broadcasts.Load())
+	require.Eventually(t, func() bool { return fallback.broadcasts.Load() == 1 }, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,177 @@
+package cosmosclient
+
+import (
+	"context"
+	"decentralized-api/logging"
+	"errors"
+
+	"github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// FailoverRPCClient is a CometBFT RPC client backed by an EndpointPool.
+// Reads are sent to the current endpoint and retried on the others when the endpoint is unreachable,
+// broadcasts go to the current endpoint and, with fanout enabled, to every other healthy endpoint as well.
+// Methods not overridden here (websocket subscriptions, service lifecycle, ...) use the primary endpoint.
+type FailoverRPCClient struct {
+	rpcclient.Client
+	pool   *EndpointPool
+	fanout bool
+}
+
+func NewFailoverRPCClient(pool *EndpointPool, fanout bool) *FailoverRPCClient {
+	return &FailoverRPCClient{
+		Client: pool.Primary(),
+		pool:   pool,
+		fanout: fanout,
+	}
+}
+
+// isEndpointFailure tells transport problems apart from errors the node returned on purpose
+// (e.g. "tx not found"), which would be the same on every other endpoint.
+func isEndpointFailure(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	var rpcErr *rpctypes.RPCError
+	return !errors.As(err, &rpcErr)
+}
+
+func withFailover[T any](ctx context.Context, pool *EndpointPool, call func(client rpcclient.Client) (T, error)) (T, error) {
+	var result T
+	var err error
+	for _, ep := range pool.candidates() {
+		result, err = call(ep.client)
+		if !isEndpointFailure(ctx, err) {
+			return result, err
+		}
+		pool.ReportFailure(ep.url, err)
+	}
+	return result, err
+}
+
+func (c *FailoverRPCClient) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	return withFailover(ctx, c.pool, func(client rpcclient.Client) (*coretypes.ResultStatus, error) {
+		return client.Status(ctx)
+	})
+}
+
+func (c *FailoverRPCClient) ABCIInfo(ctx context.Context) (*coretypes.ResultABCIInfo, error) {
+	return withFailover(ctx, c.pool, func(client rpcclient.Client) (*coretypes.ResultABCIInfo, error) {
+		return client.ABCIInfo(ctx)
+	})
+}
+
+func (c *FailoverRPCClient) ABCIQuery(ctx context.Context, path string, data bytes.HexBytes) (*coretypes.ResultABCIQuery, error) {
+	return withFailover(ctx, c.pool, func(client rpcclient.Client) (*coretypes.ResultABCIQuery, error) {
+		return client.ABCIQuery(ctx, path, data)
+	})
+}
+
+func (c *FailoverRPCClient) ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	return withFailover(ctx, c.pool, func(client rpcclient.Client) (*coretypes.ResultABCIQuery, error) {
+		return client.ABCIQueryWithOptions(ctx, path, data, opts)
+	})
+}
+
+func (c *FailoverRPCClient) Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	return withFailover(ctx, c.pool, func(client rpcclient.Client) (*coretypes.ResultBlock, error) {
+		return client.Block(ctx, height)
+	})
+}
+
+func (c *FailoverRPCClient) BlockByHash(ctx context.Context, hash []byte) (*coretypes.ResultBlock, error) {
+	return withFailover(ctx, c.pool, func(client rpcclient.Client) (*coretypes.ResultBlock, error) {
+		return client.BlockByHash(ctx, hash)
+	})
+}
+
+func (c *FailoverRPCClient) BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	return withFailover(ctx, c.pool, func(client rpcclient.Client) (*coretypes.ResultBlockResults, error) {
+		return client.BlockResults(ctx, height)
+	})
+}
+
+func (c *FailoverRPCClient) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*coretypes.ResultBlockchainInfo, error) {
+	return withFailover(ctx, c.pool, func(client rpcclient.Client) (*coretypes.ResultBlockchainInfo, error) {
+		return client.BlockchainInfo(ctx, minHeight, maxHeight)
+	})
+}
+
+func (c *FailoverRPCClient) Commit(ctx context.Context, height *int64) (*coretypes.ResultCommit, error) {
+	return withFailover(ctx, c.pool, func(client rpcclient.Client) (*coretypes.ResultCommit, error) {
+		return client.Commit(ctx, height)
+	})
+}
+
+func (c *FailoverRPCClient) Validators(ctx context.Context, height *int64, page, perPage *int) (*coretypes.ResultValidators, error) {
+	return withFailover(ctx, c.pool, func(client rpcclient.Client) (*coretypes.ResultValidators, error) {
+		return client.Validators(ctx, height, page, perPage)
+	})
+}
+
+func (c *FailoverRPCClient) Tx(ctx context.Context, hash []byte, prove bool) (*coretypes.ResultTx, error) {
+	return withFailover(ctx, c.pool, func(client rpcclient.Client) (*coretypes.ResultTx, error) {
+		return client.Tx(ctx, hash, prove)
+	})
+}
+
+func (c *FailoverRPCClient) TxSearch(ctx context.Context, query string, prove bool, page, perPage *int, orderBy string) (*coretypes.ResultTxSearch, error) {
+	return withFailover(ctx, c.pool, func(client rpcclient.Client) (*coretypes.ResultTxSearch, error) {
+		return client.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	})
+}
+
+func (c *FailoverRPCClient) BlockSearch(ctx context.Context, query string, page, perPage *int, orderBy string) (*coretypes.ResultBlockSearch, error) {
+	return withFailover(ctx, c.pool, func(client rpcclient.Client) (*coretypes.ResultBlockSearch, error) {
+		return client.BlockSearch(ctx, query, page, perPage, orderBy)
+	})
+}
+
+func (c *FailoverRPCClient) BroadcastTxSync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	result, err := withFailover(ctx, c.pool, func(client rpcclient.Client) (*coretypes.ResultBroadcastTx, error) {
+		return client.BroadcastTxSync(ctx, tx)
+	})
+	c.fanOut(tx)
+	return result, err
+}
+
+func (c *FailoverRPCClient) BroadcastTxAsync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	result, err := withFailover(ctx, c.pool, func(client rpcclient.Client) (*coretypes.ResultBroadcastTx, error) {
+		return client.BroadcastTxAsync(ctx, tx)
+	})
+	c.fanOut(tx)
+	return result, err
+}
+
+func (c *FailoverRPCClient) BroadcastTxCommit(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
+	result, err := withFailover(ctx, c.pool, func(client rpcclient.Client) (*coretypes.ResultBroadcastTxCommit, error) {
+		return client.BroadcastTxCommit(ctx, tx)
+	})
+	c.fanOut(tx)
+	return result, err
+}
+
+// fanOut sends the transaction to every healthy endpoint except the current one, without waiting for results.
+// Duplicates are harmless: the mempool of each node deduplicates by hash.
+func (c *FailoverRPCClient) fanOut(tx cmttypes.Tx) {
+	if !c.fanout {
+		return
+	}
+	for _, ep := range c.pool.candidates()[1:] {
+		if !ep.healthy {
+			continue
+		}
+		go func(ep candidate) {
+			ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+			defer cancel()
+			if _, err := ep.client.BroadcastTxAsync(ctx, tx); err != nil {
+				logging.Debug("Fanout broadcast failed", types.Messages, "url", ep.url, "error", err)
+			}
+		}(ep)
+	}
+}
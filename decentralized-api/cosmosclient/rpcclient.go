@@ -4,10 +4,13 @@ import (
 	"context"
 	"github.com/cometbft/cometbft/rpc/client/http"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
 )
 
 type TendermintClient struct {
 	ChainNodeUrl string
+	// Rpc is used instead of ChainNodeUrl when set, e.g. the failover client of InferenceCosmosClient
+	Rpc sdkclient.CometRPC
 }
 
 // NewRpcClient Can be used to query Block, Validators, and other data from the Cosmos SDK node.
@@ -16,6 +19,9 @@ func NewRpcClient(address string) (*http.HTTP, error) {
 }
 
 func (c *TendermintClient) Status() (*coretypes.ResultStatus, error) {
+	if c.Rpc != nil {
+		return c.Rpc.Status(context.Background())
+	}
 	client, err := NewRpcClient(c.ChainNodeUrl)
 	if err != nil {
 		return nil, err
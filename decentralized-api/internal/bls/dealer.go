@@ -15,14 +15,10 @@ package bls
 
 import (
 	"crypto/rand"
-	"decentralized-api/internal/event_listener/chainevents"
-	"decentralized-api/internal/utils"
 	"decentralized-api/logging"
 	"encoding/base64"
-	"encoding/json"
 	"fmt"
 	"math/big"
-	"strconv"
 
 	"github.com/productscience/inference/x/bls/types"
 	inferenceTypes "github.com/productscience/inference/x/inference/types"
@@ -36,61 +32,15 @@ import (
 // DEALER METHODS - All methods operate on BlsManager
 
 // ProcessKeyGenerationInitiated handles the EventKeyGenerationInitiated event
-func (bm *BlsManager) ProcessKeyGenerationInitiated(event *chainevents.JSONRPCResponse) error {
-	// Extract event data from chain event (typed event from EmitTypedEvent)
-	epochIDs, ok := event.Result.Events["inference.bls.EventKeyGenerationInitiated.epoch_id"]
-	if !ok || len(epochIDs) == 0 {
-		return fmt.Errorf("epoch_id not found in key generation initiated event")
-	}
-
-	// Unquote the epoch_id value (handles JSON-encoded strings like "\"1\"")
-	unquotedEpochID, err := utils.UnquoteEventValue(epochIDs[0])
-	if err != nil {
-		return fmt.Errorf("failed to unquote epoch_id: %w", err)
-	}
-
-	epochID, err := strconv.ParseUint(unquotedEpochID, 10, 64)
-	if err != nil {
-		return fmt.Errorf("failed to parse epoch_id: %w", err)
-	}
-
-	totalSlotsStrs, ok := event.Result.Events["inference.bls.EventKeyGenerationInitiated.i_total_slots"]
-	if !ok || len(totalSlotsStrs) == 0 {
-		return fmt.Errorf("i_total_slots not found in event")
-	}
-
-	// Unquote the total_slots value
-	unquotedTotalSlots, err := utils.UnquoteEventValue(totalSlotsStrs[0])
-	if err != nil {
-		return fmt.Errorf("failed to unquote i_total_slots: %w", err)
-	}
-
-	totalSlots, err := strconv.ParseUint(unquotedTotalSlots, 10, 32)
-	if err != nil {
-		return fmt.Errorf("failed to parse i_total_slots: %w", err)
-	}
-
-	tDegreesStrs, ok := event.Result.Events["inference.bls.EventKeyGenerationInitiated.t_slots_degree"]
-	if !ok || len(tDegreesStrs) == 0 {
-		return fmt.Errorf("t_slots_degree not found in event")
-	}
-
-	// Unquote the t_slots_degree value
-	unquotedTDegree, err := utils.UnquoteEventValue(tDegreesStrs[0])
-	if err != nil {
-		return fmt.Errorf("failed to unquote t_slots_degree: %w", err)
-	}
-
-	tDegree, err := strconv.ParseUint(unquotedTDegree, 10, 32)
-	if err != nil {
-		return fmt.Errorf("failed to parse t_slots_degree: %w", err)
-	}
+func (bm *BlsManager) ProcessKeyGenerationInitiated(event *types.EventKeyGenerationInitiated) error {
+	epochID := event.EpochId
+	totalSlots := event.ITotalSlots
+	tDegree := event.TSlotsDegree
 
 	logging.Debug("Processing DKG key generation initiated", inferenceTypes.BLS,
 		"epochID", epochID, "totalSlots", totalSlots, "tDegree", tDegree, "dealer", bm.cosmosClient.GetAddress())
 
-	// Parse participants from event
-	participants, err := bm.parseParticipantsFromEvent(event)
+	participants, err := participantsFromEvent(event)
 	if err != nil {
 		return fmt.Errorf("failed to parse participants: %w", err)
 	}
@@ -114,7 +64,7 @@ func (bm *BlsManager) ProcessKeyGenerationInitiated(event *chainevents.JSONRPCRe
 		"epochID", epochID, "participantCount", len(participants))
 
 	// Generate dealer part
-	dealerPart, err := bm.generateDealerPart(epochID, uint32(totalSlots), uint32(tDegree), participants)
+	dealerPart, err := bm.generateDealerPart(epochID, totalSlots, tDegree, participants)
 	if err != nil {
 		return fmt.Errorf("failed to generate dealer part: %w", err)
 	}
@@ -131,35 +81,14 @@ func (bm *BlsManager) ProcessKeyGenerationInitiated(event *chainevents.JSONRPCRe
 	return nil
 }
 
-// parseParticipantsFromEvent extracts participant information from the event
-func (bm *BlsManager) parseParticipantsFromEvent(event *chainevents.JSONRPCResponse) ([]ParticipantInfo, error) {
-	// Get the participants field - this should be a JSON-encoded array
-	participantStrs, ok := event.Result.Events["inference.bls.EventKeyGenerationInitiated.participants"]
-	if !ok || len(participantStrs) == 0 {
-		return nil, fmt.Errorf("participants not found in event")
-	}
-
-	// The participants field should be a JSON-encoded array of BLSParticipantInfo objects
-	// First, unquote the JSON string if it's quoted
-	unquotedParticipants, err := utils.UnquoteEventValue(participantStrs[0])
-	if err != nil {
-		return nil, fmt.Errorf("failed to unquote participants: %w", err)
-	}
-
-	// Parse the JSON array into BLSParticipantInfo objects
-	var blsParticipants []types.BLSParticipantInfo
-	err = json.Unmarshal([]byte(unquotedParticipants), &blsParticipants)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal participants JSON: %w", err)
-	}
-
-	if len(blsParticipants) == 0 {
+// participantsFromEvent converts the participants of the event into ParticipantInfo
+func participantsFromEvent(event *types.EventKeyGenerationInitiated) ([]ParticipantInfo, error) {
+	if len(event.Participants) == 0 {
 		return nil, fmt.Errorf("no participants found in event")
 	}
 
-	// Convert BLSParticipantInfo to ParticipantInfo
-	participants := make([]ParticipantInfo, len(blsParticipants))
-	for i, blsParticipant := range blsParticipants {
+	participants := make([]ParticipantInfo, len(event.Participants))
+	for i, blsParticipant := range event.Participants {
 		participants[i] = ParticipantInfo{
 			Address:            blsParticipant.Address,
 			Secp256K1PublicKey: blsParticipant.Secp256K1PublicKey,
@@ -173,9 +102,6 @@ func (bm *BlsManager) parseParticipantsFromEvent(event *chainevents.JSONRPCRespo
 			"slotEnd", blsParticipant.SlotEndIndex)
 	}
 
-	logging.Info("Successfully parsed participants from event", inferenceTypes.BLS,
-		"participantCount", len(participants))
-
 	return participants, nil
 }
 
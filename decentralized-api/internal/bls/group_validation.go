@@ -1,13 +1,10 @@
 package bls
 
 import (
-	"decentralized-api/internal/event_listener/chainevents"
-	"decentralized-api/internal/utils"
 	"decentralized-api/logging"
 	"encoding/binary"
 	"fmt"
 	"math/big"
-	"strconv"
 
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
@@ -22,24 +19,9 @@ const (
 
 // GROUP KEY VALIDATION METHODS - All methods operate on BlsManager
 
-// ProcessGroupPublicKeyGenerated handles validation signing when a new group public key is generated
-func (bm *BlsManager) ProcessGroupPublicKeyGeneratedToSign(event *chainevents.JSONRPCResponse) error {
-	// Extract epochID from event
-	epochIDs, ok := event.Result.Events["inference.bls.EventGroupPublicKeyGenerated.epoch_id"]
-	if !ok || len(epochIDs) == 0 {
-		return fmt.Errorf("epoch_id not found in group public key generated event")
-	}
-
-	// Unquote the epoch_id value
-	unquotedEpochID, err := utils.UnquoteEventValue(epochIDs[0])
-	if err != nil {
-		return fmt.Errorf("failed to unquote epoch_id: %w", err)
-	}
-
-	newEpochID, err := strconv.ParseUint(unquotedEpochID, 10, 64)
-	if err != nil {
-		return fmt.Errorf("failed to parse epoch_id: %w", err)
-	}
+// ProcessGroupPublicKeyGeneratedToSign handles validation signing when a new group public key is generated
+func (bm *BlsManager) ProcessGroupPublicKeyGeneratedToSign(event *blstypes.EventGroupPublicKeyGenerated) error {
+	newEpochID := event.EpochId
 
 	logging.Debug(validatorLogTag+"Processing group key validation", inferenceTypes.BLS, "newEpochID", newEpochID)
 
@@ -60,37 +42,11 @@ func (bm *BlsManager) ProcessGroupPublicKeyGeneratedToSign(event *chainevents.JS
 		return nil
 	}
 
-	// Extract new group public key from event
-	groupPublicKeyStrs, ok := event.Result.Events["inference.bls.EventGroupPublicKeyGenerated.group_public_key"]
-	if !ok || len(groupPublicKeyStrs) == 0 {
-		return fmt.Errorf("group_public_key not found in event")
-	}
-
-	// Unquote and decode the group public key
-	unquotedGroupPublicKey, err := utils.UnquoteEventValue(groupPublicKeyStrs[0])
-	if err != nil {
-		return fmt.Errorf("failed to unquote group_public_key: %w", err)
-	}
-
-	// The group public key should be base64 encoded
-	groupPublicKeyBytes, err := utils.DecodeBase64IfPossible(unquotedGroupPublicKey)
-	if err != nil {
-		return fmt.Errorf("failed to decode group public key: %w", err)
-	}
-
+	groupPublicKeyBytes := event.GroupPublicKey
 	if len(groupPublicKeyBytes) != 96 {
 		return fmt.Errorf("invalid group public key length: expected 96 bytes, got %d", len(groupPublicKeyBytes))
 	}
-
-	// Extract chain ID from event
-	chainIDs, ok := event.Result.Events["inference.bls.EventGroupPublicKeyGenerated.chain_id"]
-	if !ok || len(chainIDs) == 0 {
-		return fmt.Errorf("chain_id not found in group public key generated event")
-	}
-	chainID, err := utils.UnquoteEventValue(chainIDs[0])
-	if err != nil {
-		return fmt.Errorf("failed to unquote chain_id: %w", err)
-	}
+	chainID := event.ChainId
 
 	// Compute the validation message hash
 	messageHash, err := bm.computeValidationMessageHash(groupPublicKeyBytes, previousEpochID, newEpochID, chainID)
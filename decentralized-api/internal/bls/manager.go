@@ -3,7 +3,6 @@ package bls
 import (
 	"context"
 	"decentralized-api/cosmosclient"
-	"decentralized-api/internal/event_listener/chainevents"
 	"decentralized-api/logging"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
@@ -162,7 +161,7 @@ func (bm *BlsManager) storeVerificationResult(result *VerificationResult) {
 }
 
 // ProcessGroupPublicKeyGenerated handles the DKG completion event
-func (bm *BlsManager) ProcessGroupPublicKeyGenerated(event *chainevents.JSONRPCResponse) error {
+func (bm *BlsManager) ProcessGroupPublicKeyGenerated(event *types.EventGroupPublicKeyGenerated) error {
 	// Process for verification (updating cache with completed result)
 	err := bm.ProcessGroupPublicKeyGeneratedToVerify(event)
 	if err != nil {
@@ -1,15 +1,13 @@
 package bls
 
 import (
-	"decentralized-api/internal/event_listener/chainevents"
-	"decentralized-api/internal/utils"
 	"decentralized-api/logging"
 	"fmt"
 	"math/big"
-	"strconv"
 
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/productscience/inference/x/bls/types"
 	inferenceTypes "github.com/productscience/inference/x/inference/types"
 )
 
@@ -18,41 +16,13 @@ const (
 )
 
 // ProcessThresholdSigningRequested handles EventThresholdSigningRequested events
-func (bm *BlsManager) ProcessThresholdSigningRequested(event *chainevents.JSONRPCResponse) error {
+func (bm *BlsManager) ProcessThresholdSigningRequested(event *types.EventThresholdSigningRequested) error {
 	logging.Debug(thresholdSigningLogTag+"Processing threshold signing requested event", inferenceTypes.BLS)
 
-	// Extract event data
-	requestIdBytes, err := bm.extractEventData(event, "inference.bls.EventThresholdSigningRequested.request_id")
-	if err != nil {
-		return fmt.Errorf("failed to extract request_id: %w", err)
-	}
-
-	epochIdStr, err := bm.extractEventString(event, "inference.bls.EventThresholdSigningRequested.current_epoch_id")
-	if err != nil {
-		return fmt.Errorf("failed to extract current_epoch_id: %w", err)
-	}
-
-	messageHashBytes, err := bm.extractEventData(event, "inference.bls.EventThresholdSigningRequested.message_hash")
-	if err != nil {
-		return fmt.Errorf("failed to extract message_hash: %w", err)
-	}
-
-	deadlineStr, err := bm.extractEventString(event, "inference.bls.EventThresholdSigningRequested.deadline_block_height")
-	if err != nil {
-		return fmt.Errorf("failed to extract deadline_block_height: %w", err)
-	}
-
-	// Parse epoch ID
-	epochId, err := strconv.ParseUint(epochIdStr, 10, 64)
-	if err != nil {
-		return fmt.Errorf("failed to parse epoch_id: %w", err)
-	}
-
-	// Parse deadline
-	deadline, err := strconv.ParseInt(deadlineStr, 10, 64)
-	if err != nil {
-		return fmt.Errorf("failed to parse deadline: %w", err)
-	}
+	requestIdBytes := event.RequestId
+	epochId := event.CurrentEpochId
+	messageHashBytes := event.MessageHash
+	deadline := event.DeadlineBlockHeight
 
 	logging.Info(thresholdSigningLogTag+"Received threshold signing request", inferenceTypes.BLS,
 		"request_id", fmt.Sprintf("%x", requestIdBytes),
@@ -78,7 +48,7 @@ func (bm *BlsManager) ProcessThresholdSigningRequested(event *chainevents.JSONRP
 	}
 
 	// Compute partial signatures for our slot range
-	err = bm.submitPartialSignatures(epochId, requestIdBytes, messageHashBytes, result)
+	err := bm.submitPartialSignatures(epochId, requestIdBytes, messageHashBytes, result)
 	if err != nil {
 		return fmt.Errorf("failed to submit partial signatures: %w", err)
 	}
@@ -146,41 +116,3 @@ func (bm *BlsManager) computePartialSignature(messageHash []byte, result *Verifi
 	signatureBytes := signature.Bytes()
 	return signatureBytes[:], nil
 }
-
-// extractEventData extracts byte data from event (base64, hex, or raw string)
-func (bm *BlsManager) extractEventData(event *chainevents.JSONRPCResponse, key string) ([]byte, error) {
-	values := event.Result.Events[key]
-	if len(values) == 0 {
-		return nil, fmt.Errorf("key %s not found in event", key)
-	}
-
-	// Tendermint may wrap values in quotes. Remove them first.
-	unquoted, _ := utils.UnquoteEventValue(values[0])
-
-	// 1) Try base-64
-	if data, err := utils.DecodeBase64IfPossible(unquoted); err == nil {
-		return data, nil
-	}
-
-	// 2) Try hex
-	if data, err := utils.DecodeHex(unquoted); err == nil {
-		return data, nil
-	}
-
-	// 3) Fallback to raw bytes of the string
-	return []byte(unquoted), nil
-}
-
-// extractEventString extracts string data from event and removes extra JSON quotes if present
-func (bm *BlsManager) extractEventString(event *chainevents.JSONRPCResponse, key string) (string, error) {
-	values := event.Result.Events[key]
-	if len(values) == 0 {
-		return "", fmt.Errorf("key %s not found in event", key)
-	}
-
-	// Tendermint sometimes stores values as quoted JSON strings (e.g. "\"2\"").
-	if unquoted, err := utils.UnquoteEventValue(values[0]); err == nil {
-		return unquoted, nil
-	}
-	return values[0], nil
-}
@@ -1,13 +1,9 @@
 package bls
 
 import (
-	"decentralized-api/internal/event_listener/chainevents"
-	"decentralized-api/internal/utils"
 	"decentralized-api/logging"
-	"encoding/json"
 	"fmt"
 	"math/big"
-	"strconv"
 
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
@@ -18,23 +14,8 @@ import (
 const verifierLogTag = "[bls-verifier] "
 
 // ProcessVerifyingPhaseStarted handles the EventVerifyingPhaseStarted event
-func (bm *BlsManager) ProcessVerifyingPhaseStarted(event *chainevents.JSONRPCResponse) error {
-	// Extract event data from chain event (typed event from EmitTypedEvent)
-	epochIDs, ok := event.Result.Events["inference.bls.EventVerifyingPhaseStarted.epoch_id"]
-	if !ok || len(epochIDs) == 0 {
-		return fmt.Errorf("epoch_id not found in verifying phase started event")
-	}
-
-	// Unquote the epoch_id value (handles JSON-encoded strings like "\"1\"")
-	unquotedEpochID, err := utils.UnquoteEventValue(epochIDs[0])
-	if err != nil {
-		return fmt.Errorf("failed to unquote epoch_id: %w", err)
-	}
-
-	epochID, err := strconv.ParseUint(unquotedEpochID, 10, 64)
-	if err != nil {
-		return fmt.Errorf("failed to parse epoch_id: %w", err)
-	}
+func (bm *BlsManager) ProcessVerifyingPhaseStarted(event *types.EventVerifyingPhaseStarted) error {
+	epochID := event.EpochId
 
 	existingResult := bm.GetVerificationResult(epochID)
 	if existingResult != nil &&
@@ -48,31 +29,11 @@ func (bm *BlsManager) ProcessVerifyingPhaseStarted(event *chainevents.JSONRPCRes
 		return nil
 	}
 
-	// Now access the rest of the event fields as before
-	deadlineStrs, ok := event.Result.Events["inference.bls.EventVerifyingPhaseStarted.verifying_phase_deadline_block"]
-	if !ok || len(deadlineStrs) == 0 {
-		return fmt.Errorf("verifying_phase_deadline_block not found in event")
-	}
-
-	// Unquote the deadline value
-	unquotedDeadline, err := utils.UnquoteEventValue(deadlineStrs[0])
-	if err != nil {
-		return fmt.Errorf("failed to unquote verifying_phase_deadline_block: %w", err)
-	}
-
-	deadlineBlock, err := strconv.ParseUint(unquotedDeadline, 10, 64)
-	if err != nil {
-		return fmt.Errorf("failed to parse verifying_phase_deadline_block: %w", err)
-	}
-
 	logging.Info(verifierLogTag+"Processing DKG verifying phase started", inferenceTypes.BLS,
-		"epochID", epochID, "deadlineBlock", deadlineBlock, "verifier", bm.cosmosClient.GetAccountAddress())
+		"epochID", epochID, "deadlineBlock", event.VerifyingPhaseDeadlineBlock, "verifier", bm.cosmosClient.GetAccountAddress())
 
-	// Extract epoch data from event instead of querying chain
-	epochData, err := bm.extractEpochDataFromVerifyingEvent(event)
-	if err != nil {
-		return fmt.Errorf("failed to extract epoch data from event: %w", err)
-	}
+	// The event carries the epoch data, no need to query the chain
+	epochData := &event.EpochData
 
 	// Setup, perform verification, and store result for this epoch using event data
 	completed, err := bm.setupAndPerformVerification(epochID, epochData)
@@ -479,24 +440,9 @@ func countTrueValues(values []bool) int {
 	return count
 }
 
-// ProcessGroupPublicKeyGenerated handles the DKG completion event
-func (bm *BlsManager) ProcessGroupPublicKeyGeneratedToVerify(event *chainevents.JSONRPCResponse) error {
-	// Extract epochID from event
-	epochIDs, ok := event.Result.Events["inference.bls.EventGroupPublicKeyGenerated.epoch_id"]
-	if !ok || len(epochIDs) == 0 {
-		return fmt.Errorf("epoch_id not found in group public key generated event")
-	}
-
-	// Unquote the epoch_id value (handles JSON-encoded strings like "\"1\"")
-	unquotedEpochID, err := utils.UnquoteEventValue(epochIDs[0])
-	if err != nil {
-		return fmt.Errorf("failed to unquote epoch_id: %w", err)
-	}
-
-	epochID, err := strconv.ParseUint(unquotedEpochID, 10, 64)
-	if err != nil {
-		return fmt.Errorf("failed to parse epoch_id: %w", err)
-	}
+// ProcessGroupPublicKeyGeneratedToVerify handles the DKG completion event
+func (bm *BlsManager) ProcessGroupPublicKeyGeneratedToVerify(event *types.EventGroupPublicKeyGenerated) error {
+	epochID := event.EpochId
 
 	logging.Debug(verifierLogTag+"Processing group public key generated", inferenceTypes.BLS, "epochID", epochID)
 
@@ -509,11 +455,8 @@ func (bm *BlsManager) ProcessGroupPublicKeyGeneratedToVerify(event *chainevents.
 		return nil
 	}
 
-	// Extract epoch data from event instead of querying chain
-	epochData, err := bm.extractEpochDataFromGroupPublicKeyEvent(event)
-	if err != nil {
-		return fmt.Errorf("failed to extract epoch data from event: %w", err)
-	}
+	// The event carries the epoch data, no need to query the chain
+	epochData := &event.EpochData
 
 	// Validate we're in the correct phase
 	if epochData.DkgPhase != types.DKGPhase_DKG_PHASE_COMPLETED && epochData.DkgPhase != types.DKGPhase_DKG_PHASE_SIGNED {
@@ -590,128 +533,3 @@ func (bm *BlsManager) ProcessGroupPublicKeyGeneratedToVerify(event *chainevents.
 
 	return nil
 }
-
-// extractEpochDataFromGroupPublicKeyEvent extracts epoch data from a group public key generated event
-func (bm *BlsManager) extractEpochDataFromGroupPublicKeyEvent(event *chainevents.JSONRPCResponse) (*types.EpochBLSData, error) {
-	// Extract epoch data from event - this should be a JSON-encoded object
-	epochDataStrs, ok := event.Result.Events["inference.bls.EventGroupPublicKeyGenerated.epoch_data"]
-	if !ok || len(epochDataStrs) == 0 {
-		return nil, fmt.Errorf("epoch_data not found in group public key generated event")
-	}
-
-	// The epoch_data field should be a JSON-encoded EpochBLSData object
-	// First, unquote the JSON string if it's quoted
-	unquotedEpochData, err := utils.UnquoteEventValue(epochDataStrs[0])
-	if err != nil {
-		return nil, fmt.Errorf("failed to unquote epoch_data: %w", err)
-	}
-
-	// Parse the epoch data using the helper function that handles type conversions
-	epochData, err := bm.parseEpochDataFromJSON(unquotedEpochData)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse epoch_data: %w", err)
-	}
-
-	return epochData, nil
-}
-
-// parseEpochDataFromJSON parses epoch data from JSON with explicit type conversion for protobuf fields
-func (bm *BlsManager) parseEpochDataFromJSON(jsonStr string) (*types.EpochBLSData, error) {
-	// Parse the JSON into a map first to handle type conversions
-	var epochDataMap map[string]interface{}
-	err := json.Unmarshal([]byte(jsonStr), &epochDataMap)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON to map: %w", err)
-	}
-
-	// Manually convert string numbers to proper types for protobuf fields
-	if epochIDStr, ok := epochDataMap["epoch_id"].(string); ok {
-		if epochID, err := strconv.ParseUint(epochIDStr, 10, 64); err == nil {
-			epochDataMap["epoch_id"] = epochID
-		}
-	}
-
-	if iTotalSlotsStr, ok := epochDataMap["i_total_slots"].(string); ok {
-		if iTotalSlots, err := strconv.ParseUint(iTotalSlotsStr, 10, 32); err == nil {
-			epochDataMap["i_total_slots"] = uint32(iTotalSlots)
-		}
-	}
-
-	if tSlotsDegreeStr, ok := epochDataMap["t_slots_degree"].(string); ok {
-		if tSlotsDegree, err := strconv.ParseUint(tSlotsDegreeStr, 10, 32); err == nil {
-			epochDataMap["t_slots_degree"] = uint32(tSlotsDegree)
-		}
-	}
-
-	// Handle DKGPhase enum conversion
-	if dkgPhaseStr, ok := epochDataMap["dkg_phase"].(string); ok {
-		switch dkgPhaseStr {
-		case "DKG_PHASE_UNDEFINED":
-			epochDataMap["dkg_phase"] = int32(0)
-		case "DKG_PHASE_DEALING":
-			epochDataMap["dkg_phase"] = int32(1)
-		case "DKG_PHASE_VERIFYING":
-			epochDataMap["dkg_phase"] = int32(2)
-		case "DKG_PHASE_COMPLETED":
-			epochDataMap["dkg_phase"] = int32(3)
-		case "DKG_PHASE_FAILED":
-			epochDataMap["dkg_phase"] = int32(4)
-		default:
-			// Try to parse as number if it's a numeric string
-			if dkgPhaseNum, err := strconv.ParseUint(dkgPhaseStr, 10, 32); err == nil {
-				epochDataMap["dkg_phase"] = int32(dkgPhaseNum)
-			}
-		}
-	}
-
-	if dealingDeadlineStr, ok := epochDataMap["dealing_phase_deadline_block"].(string); ok {
-		if dealingDeadline, err := strconv.ParseInt(dealingDeadlineStr, 10, 64); err == nil {
-			epochDataMap["dealing_phase_deadline_block"] = dealingDeadline
-		}
-	}
-
-	if verifyingDeadlineStr, ok := epochDataMap["verifying_phase_deadline_block"].(string); ok {
-		if verifyingDeadline, err := strconv.ParseInt(verifyingDeadlineStr, 10, 64); err == nil {
-			epochDataMap["verifying_phase_deadline_block"] = verifyingDeadline
-		}
-	}
-
-	// Convert the map back to JSON with proper type handling
-	convertedJSON, err := json.Marshal(epochDataMap)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal converted epoch_data: %w", err)
-	}
-
-	// Now parse into the actual EpochBLSData struct
-	var epochData types.EpochBLSData
-	err = json.Unmarshal(convertedJSON, &epochData)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal epoch_data JSON to struct: %w", err)
-	}
-
-	return &epochData, nil
-}
-
-// extractEpochDataFromVerifyingEvent extracts epoch data from a verifying event
-func (bm *BlsManager) extractEpochDataFromVerifyingEvent(event *chainevents.JSONRPCResponse) (*types.EpochBLSData, error) {
-	// Extract epoch data from event - this should be a JSON-encoded object
-	epochDataStrs, ok := event.Result.Events["inference.bls.EventVerifyingPhaseStarted.epoch_data"]
-	if !ok || len(epochDataStrs) == 0 {
-		return nil, fmt.Errorf("epoch_data not found in verifying phase started event")
-	}
-
-	// The epoch_data field should be a JSON-encoded EpochBLSData object
-	// First, unquote the JSON string if it's quoted
-	unquotedEpochData, err := utils.UnquoteEventValue(epochDataStrs[0])
-	if err != nil {
-		return nil, fmt.Errorf("failed to unquote epoch_data: %w", err)
-	}
-
-	// Parse the epoch data using the helper function that handles type conversions
-	epochData, err := bm.parseEpochDataFromJSON(unquotedEpochData)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse epoch_data: %w", err)
-	}
-
-	return epochData, nil
-}
@@ -1,11 +1,9 @@
 package bls
 
 import (
-	"strings"
 	"testing"
 
 	"decentralized-api/cosmosclient"
-	"decentralized-api/internal/event_listener/chainevents"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	"github.com/productscience/inference/x/bls/types"
@@ -253,34 +251,18 @@ func TestProcessVerifyingPhaseStartedWithExistingResult(t *testing.T) {
 	blsManager := NewBlsManager(createMockCosmosClient())
 
 	// Mock event for epoch 5 with complete and proper mock data
-	completeEpochData := `{
-		"epoch_id": "5",
-		"i_total_slots": "100",
-		"t_slots_degree": "50",
-		"dkg_phase": "DKG_PHASE_VERIFYING",
-		"dealing_phase_deadline_block": "950",
-		"verifying_phase_deadline_block": "1000",
-		"participants": [
-			{
-				"address": "cosmos1test",
-				"percentage_weight": "0.5",
-				"secp256k1_public_key": "AqAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA",
-				"slot_start_index": "0",
-				"slot_end_index": "49"
-			}
-		],
-		"dealer_parts": [],
-		"verification_submissions": [],
-		"group_public_key": "",
-		"valid_dealers": []
-	}`
-
-	event := &chainevents.JSONRPCResponse{
-		Result: chainevents.Result{
-			Events: map[string][]string{
-				"inference.bls.EventVerifyingPhaseStarted.epoch_id":                       {"5"},
-				"inference.bls.EventVerifyingPhaseStarted.verifying_phase_deadline_block": {"1000"},
-				"inference.bls.EventVerifyingPhaseStarted.epoch_data":                     {completeEpochData},
+	event := &types.EventVerifyingPhaseStarted{
+		EpochId:                     5,
+		VerifyingPhaseDeadlineBlock: 1000,
+		EpochData: types.EpochBLSData{
+			EpochId:                     5,
+			ITotalSlots:                 100,
+			TSlotsDegree:                50,
+			DkgPhase:                    types.DKGPhase_DKG_PHASE_VERIFYING,
+			DealingPhaseDeadlineBlock:   950,
+			VerifyingPhaseDeadlineBlock: 1000,
+			Participants: []types.BLSParticipantInfo{
+				{Address: "cosmos1test", SlotStartIndex: 0, SlotEndIndex: 49},
 			},
 		},
 	}
@@ -316,13 +298,9 @@ func TestProcessGroupPublicKeyGeneratedWithExistingResult(t *testing.T) {
 	blsManager := NewBlsManager(createMockCosmosClient())
 
 	// Mock event for epoch 10
-	event := &chainevents.JSONRPCResponse{
-		Result: chainevents.Result{
-			Events: map[string][]string{
-				"inference.bls.EventGroupPublicKeyGenerated.epoch_id":         {"10"},
-				"inference.bls.EventGroupPublicKeyGenerated.group_public_key": {"0123456789abcdef" + strings.Repeat("00", 88)}, // 96 bytes hex
-			},
-		},
+	event := &types.EventGroupPublicKeyGenerated{
+		EpochId:        10,
+		GroupPublicKey: make([]byte, 96),
 	}
 
 	// Store a COMPLETED result and verify it skips processing
@@ -344,32 +322,20 @@ func TestProcessGroupPublicKeyGeneratedWithExistingResult(t *testing.T) {
 	assert.Equal(t, types.DKGPhase_DKG_PHASE_COMPLETED, stored.DkgPhase)
 }
 
-func TestProcessGroupPublicKeyGeneratedEventParsing(t *testing.T) {
+func TestProcessGroupPublicKeyGeneratedNotCompleted(t *testing.T) {
 	blsManager := NewBlsManager(createMockCosmosClient())
 
-	// Test invalid event - missing epoch_id
-	invalidEvent := &chainevents.JSONRPCResponse{
-		Result: chainevents.Result{
-			Events: map[string][]string{
-				"inference.bls.EventGroupPublicKeyGenerated.group_public_key": {"test"},
-			},
-		},
-	}
-
-	err := blsManager.ProcessGroupPublicKeyGeneratedToVerify(invalidEvent)
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "epoch_id not found")
-
-	// Test invalid epoch_id format
-	invalidEpochEvent := &chainevents.JSONRPCResponse{
-		Result: chainevents.Result{
-			Events: map[string][]string{
-				"inference.bls.EventGroupPublicKeyGenerated.epoch_id": {"invalid"},
-			},
+	// The epoch data of the event still says the DKG is verifying
+	event := &types.EventGroupPublicKeyGenerated{
+		EpochId:        7,
+		GroupPublicKey: make([]byte, 96),
+		EpochData: types.EpochBLSData{
+			EpochId:  7,
+			DkgPhase: types.DKGPhase_DKG_PHASE_VERIFYING,
 		},
 	}
 
-	err = blsManager.ProcessGroupPublicKeyGeneratedToVerify(invalidEpochEvent)
+	err := blsManager.ProcessGroupPublicKeyGeneratedToVerify(event)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "failed to parse epoch_id")
+	assert.Contains(t, err.Error(), "is not in COMPLETED or SIGNED phase")
 }
@@ -7,7 +7,6 @@ import (
 	"strconv"
 
 	"context"
-	"decentralized-api/cosmosclient"
 
 	"sync/atomic"
 
@@ -32,18 +31,13 @@ type TmHTTPClient interface {
 	Status(ctx context.Context) (*coretypes.ResultStatus, error)
 }
 
-func NewBlockObserver(manager *apiconfig.ConfigManager) *BlockObserver {
+func NewBlockObserver(manager *apiconfig.ConfigManager, tmClient TmHTTPClient) *BlockObserver {
 	queue := NewUnboundedQueue[*chainevents.JSONRPCResponse]()
-	// Initialize Tendermint RPC client
-	httpClient, err := cosmosclient.NewRpcClient(manager.GetChainNodeConfig().Url)
-	if err != nil {
-		logging.Error("Failed to create Tendermint RPC client for BlockObserver", types.EventProcessing, "error", err)
-	}
 
 	bo := &BlockObserver{
 		ConfigManager: manager,
 		Queue:         queue,
-		tmClient:      httpClient,
+		tmClient:      tmClient,
 		notify:        make(chan struct{}, 1),
 	}
 
@@ -0,0 +1,205 @@
+package chainevents
+
+import (
+	"context"
+	"decentralized-api/logging"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// EventMeta carries the context a typed event was received in.
+type EventMeta struct {
+	Height   int64
+	DataType string
+	Raw      *JSONRPCResponse
+}
+
+type HandlerOptions struct {
+	// Concurrency is the maximum number of invocations of the handler running at the same time
+	// across all event listener workers. Defaults to 1, i.e. events are handled one at a time.
+	Concurrency int
+}
+
+// HandlerStats are the counters of one subscription, exposed for monitoring.
+type HandlerStats struct {
+	Name         string    `json:"name"`
+	EventType    string    `json:"event_type"`
+	Handled      uint64    `json:"handled"`
+	Failed       uint64    `json:"failed"`
+	DecodeErrors uint64    `json:"decode_errors"`
+	InFlight     int64     `json:"in_flight"`
+	LastError    string    `json:"last_error,omitempty"`
+	LastErrorAt  time.Time `json:"last_error_at,omitempty"`
+}
+
+type subscription struct {
+	name      string
+	eventType string
+	semaphore chan struct{}
+	handle    func(ctx context.Context, message proto.Message, meta EventMeta) error
+
+	handled      atomic.Uint64
+	failed       atomic.Uint64
+	decodeErrors atomic.Uint64
+	inFlight     atomic.Int64
+
+	mu          sync.Mutex
+	lastError   string
+	lastErrorAt time.Time
+}
+
+func (s *subscription) recordError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastError = err.Error()
+	s.lastErrorAt = time.Now()
+}
+
+// Registry dispatches typed chain events (see DecodeTypedEvents) to the handlers subscribed to them.
+type Registry struct {
+	mu            sync.RWMutex
+	subscriptions map[string][]*subscription
+}
+
+func NewRegistry() *Registry {
+	return &Registry{subscriptions: make(map[string][]*subscription)}
+}
+
+// Subscribe registers handler for every event of type T. The event type name is taken from the
+// protobuf registration of T, so only events emitted with EmitTypedEvent can be subscribed to.
+func Subscribe[T proto.Message](r *Registry, name string, options HandlerOptions, handler func(ctx context.Context, event T, meta EventMeta) error) {
+	var zero T
+	eventType := proto.MessageName(zero)
+	if eventType == "" {
+		panic(fmt.Sprintf("chainevents: %T is not a registered proto message", zero))
+	}
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	sub := &subscription{
+		name:      name,
+		eventType: eventType,
+		semaphore: make(chan struct{}, concurrency),
+		handle: func(ctx context.Context, message proto.Message, meta EventMeta) error {
+			event, ok := message.(T)
+			if !ok {
+				return fmt.Errorf("unexpected event type %T", message)
+			}
+			return handler(ctx, event, meta)
+		},
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subscriptions[eventType] = append(r.subscriptions[eventType], sub)
+}
+
+// CanHandle reports whether any handler is subscribed to an event contained in the response.
+func (r *Registry) CanHandle(event *JSONRPCResponse) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for eventType := range r.subscriptions {
+		if HasEvent(event, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// Dispatch decodes the typed events contained in the response and passes them to their handlers.
+// Handlers run on the calling goroutine, waiting for a free slot if the handler is at its concurrency limit.
+// Errors are logged and counted; they never stop other handlers from running.
+func (r *Registry) Dispatch(ctx context.Context, event *JSONRPCResponse) {
+	r.mu.RLock()
+	eventTypes := make([]string, 0, len(r.subscriptions))
+	for eventType := range r.subscriptions {
+		if HasEvent(event, eventType) {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+	r.mu.RUnlock()
+	sort.Strings(eventTypes)
+
+	meta := EventMeta{Height: Height(event), DataType: event.Result.Data.Type, Raw: event}
+	for _, eventType := range eventTypes {
+		r.mu.RLock()
+		subs := r.subscriptions[eventType]
+		r.mu.RUnlock()
+
+		messages, err := DecodeTypedEvents(event, eventType)
+		if err != nil {
+			logging.Error("Failed to decode typed event", types.EventProcessing, "type", eventType, "height", meta.Height, "error", err)
+			for _, sub := range subs {
+				sub.decodeErrors.Add(1)
+				sub.recordError(err)
+			}
+			continue
+		}
+
+		for _, message := range messages {
+			for _, sub := range subs {
+				r.run(ctx, sub, message, meta)
+			}
+		}
+	}
+}
+
+func (r *Registry) run(ctx context.Context, sub *subscription, message proto.Message, meta EventMeta) {
+	select {
+	case sub.semaphore <- struct{}{}:
+	case <-ctx.Done():
+		return
+	}
+	defer func() { <-sub.semaphore }()
+
+	sub.inFlight.Add(1)
+	defer sub.inFlight.Add(-1)
+
+	logging.Info("Handling typed event", types.EventProcessing, "handler", sub.name, "type", sub.eventType, "height", meta.Height)
+	if err := sub.handle(ctx, message, meta); err != nil {
+		sub.failed.Add(1)
+		sub.recordError(err)
+		logging.Error("Typed event handler failed", types.EventProcessing, "handler", sub.name, "type", sub.eventType, "height", meta.Height, "error", err)
+		return
+	}
+	sub.handled.Add(1)
+}
+
+// Stats returns the counters of all subscriptions, ordered by event type and registration.
+func (r *Registry) Stats() []HandlerStats {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	eventTypes := make([]string, 0, len(r.subscriptions))
+	for eventType := range r.subscriptions {
+		eventTypes = append(eventTypes, eventType)
+	}
+	sort.Strings(eventTypes)
+
+	var stats []HandlerStats
+	for _, eventType := range eventTypes {
+		for _, sub := range r.subscriptions[eventType] {
+			sub.mu.Lock()
+			lastError, lastErrorAt := sub.lastError, sub.lastErrorAt
+			sub.mu.Unlock()
+			stats = append(stats, HandlerStats{
+				Name:         sub.name,
+				EventType:    sub.eventType,
+				Handled:      sub.handled.Load(),
+				Failed:       sub.failed.Load(),
+				DecodeErrors: sub.decodeErrors.Load(),
+				InFlight:     sub.inFlight.Load(),
+				LastError:    lastError,
+				LastErrorAt:  lastErrorAt,
+			})
+		}
+	}
+	return stats
+}
@@ -0,0 +1,186 @@
+package chainevents
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	blstypes "github.com/productscience/inference/x/bls/types"
+	inferencetypes "github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeTypedEvents_RoundTrip(t *testing.T) {
+	first := &blstypes.EventThresholdSigningRequested{
+		RequestId:           []byte("request-1"),
+		CurrentEpochId:      7,
+		MessageHash:         make([]byte, 32),
+		DeadlineBlockHeight: 120,
+	}
+	second := &blstypes.EventThresholdSigningRequested{
+		RequestId:           []byte("request-2"),
+		CurrentEpochId:      7,
+		MessageHash:         make([]byte, 32),
+		DeadlineBlockHeight: 130,
+	}
+	event, err := NewTypedEventResponse("tendermint/event/Tx", 100, first, second)
+	require.NoError(t, err)
+
+	messages, err := DecodeTypedEvents(event, "inference.bls.EventThresholdSigningRequested")
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	require.Equal(t, first, messages[0])
+	require.Equal(t, second, messages[1])
+	require.Equal(t, int64(100), Height(event))
+}
+
+func TestDecodeTypedEvents_RepeatedFields(t *testing.T) {
+	dispute := &inferencetypes.EventInferenceDisputed{
+		InferenceId: "inference-1",
+		Developer:   "gonka1dev",
+		Executor:    "gonka1exec",
+		Bond:        1000,
+		Validators:  []string{"gonka1abc", "gonka1def"},
+	}
+	event, err := NewTypedEventResponse("tendermint/event/Tx", 5, dispute)
+	require.NoError(t, err)
+
+	messages, err := DecodeTypedEvents(event, "inference.inference.EventInferenceDisputed")
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	require.Equal(t, dispute, messages[0])
+}
+
+func TestDecodeTypedEvents_DefaultValues(t *testing.T) {
+	// Fields left at their zero value must stay aligned across the events of one response
+	invalidated := &inferencetypes.EventInferenceValidation{InferenceId: "inference-1", Validator: "gonka1abc", NeedsRevalidation: true}
+	passed := &inferencetypes.EventInferenceValidation{InferenceId: "inference-2", Validator: "gonka1abc", Passed: true}
+	event, err := NewTypedEventResponse("tendermint/event/Tx", 9, invalidated, passed)
+	require.NoError(t, err)
+	// The legacy untyped event is emitted next to the typed one
+	event.Result.Events["inference_validation.inference_id"] = []string{"inference-1", "inference-2"}
+
+	messages, err := DecodeTypedEvents(event, "inference.inference.EventInferenceValidation")
+	require.NoError(t, err)
+	require.Equal(t, []proto.Message{invalidated, passed}, messages)
+}
+
+func TestDecodeTypedEvents_NestedMessages(t *testing.T) {
+	keyGen := &blstypes.EventKeyGenerationInitiated{
+		EpochId:      3,
+		ITotalSlots:  100,
+		TSlotsDegree: 50,
+		Participants: []blstypes.BLSParticipantInfo{
+			{Address: "gonka1abc", Secp256K1PublicKey: []byte{1, 2, 3}, SlotStartIndex: 0, SlotEndIndex: 49},
+			{Address: "gonka1def", Secp256K1PublicKey: []byte{4, 5, 6}, SlotStartIndex: 50, SlotEndIndex: 99},
+		},
+	}
+	event, err := NewTypedEventResponse("tendermint/event/NewBlock", 42, keyGen)
+	require.NoError(t, err)
+
+	messages, err := DecodeTypedEvents(event, "inference.bls.EventKeyGenerationInitiated")
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	decoded := messages[0].(*blstypes.EventKeyGenerationInitiated)
+	require.Equal(t, keyGen.EpochId, decoded.EpochId)
+	require.Equal(t, keyGen.ITotalSlots, decoded.ITotalSlots)
+	require.Equal(t, keyGen.TSlotsDegree, decoded.TSlotsDegree)
+	require.Len(t, decoded.Participants, 2)
+	for i, participant := range keyGen.Participants {
+		require.Equal(t, participant.Address, decoded.Participants[i].Address)
+		require.Equal(t, participant.Secp256K1PublicKey, decoded.Participants[i].Secp256K1PublicKey)
+		require.Equal(t, participant.SlotStartIndex, decoded.Participants[i].SlotStartIndex)
+		require.Equal(t, participant.SlotEndIndex, decoded.Participants[i].SlotEndIndex)
+	}
+	require.Equal(t, int64(42), Height(event))
+}
+
+func TestRegistry_DispatchAndStats(t *testing.T) {
+	registry := NewRegistry()
+	var received []uint64
+	Subscribe(registry, "signing", HandlerOptions{}, func(ctx context.Context, event *blstypes.EventThresholdSigningRequested, meta EventMeta) error {
+		received = append(received, event.CurrentEpochId)
+		require.Equal(t, int64(10), meta.Height)
+		if event.CurrentEpochId == 2 {
+			return errors.New("no verification result")
+		}
+		return nil
+	})
+	Subscribe(registry, "key_generation", HandlerOptions{}, func(ctx context.Context, event *blstypes.EventKeyGenerationInitiated, meta EventMeta) error {
+		t.Fatal("unexpected key generation event")
+		return nil
+	})
+
+	event, err := NewTypedEventResponse("tendermint/event/Tx", 10,
+		&blstypes.EventThresholdSigningRequested{CurrentEpochId: 1},
+		&blstypes.EventThresholdSigningRequested{CurrentEpochId: 2},
+	)
+	require.NoError(t, err)
+	require.True(t, registry.CanHandle(event))
+
+	registry.Dispatch(context.Background(), event)
+	require.Equal(t, []uint64{1, 2}, received)
+
+	stats := registry.Stats()
+	require.Len(t, stats, 2)
+	require.Equal(t, "key_generation", stats[0].Name)
+	require.Equal(t, uint64(0), stats[0].Handled)
+	require.Equal(t, "signing", stats[1].Name)
+	require.Equal(t, uint64(1), stats[1].Handled)
+	require.Equal(t, uint64(1), stats[1].Failed)
+	require.Equal(t, "no verification result", stats[1].LastError)
+}
+
+func TestRegistry_DecodeErrorsAreCounted(t *testing.T) {
+	registry := NewRegistry()
+	Subscribe(registry, "signing", HandlerOptions{}, func(ctx context.Context, event *blstypes.EventThresholdSigningRequested, meta EventMeta) error {
+		t.Fatal("handler must not run for an undecodable event")
+		return nil
+	})
+
+	event := &JSONRPCResponse{Result: Result{Events: map[string][]string{
+		"inference.bls.EventThresholdSigningRequested.current_epoch_id": {"not-a-number"},
+	}}}
+	registry.Dispatch(context.Background(), event)
+
+	stats := registry.Stats()
+	require.Equal(t, uint64(1), stats[0].DecodeErrors)
+	require.NotEmpty(t, stats[0].LastError)
+}
+
+func TestRegistry_ConcurrencyLimit(t *testing.T) {
+	registry := NewRegistry()
+	var running, peak atomic.Int32
+	Subscribe(registry, "signing", HandlerOptions{Concurrency: 2}, func(ctx context.Context, event *blstypes.EventThresholdSigningRequested, meta EventMeta) error {
+		current := running.Add(1)
+		for {
+			old := peak.Load()
+			if current <= old || peak.CompareAndSwap(old, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		running.Add(-1)
+		return nil
+	})
+
+	event, err := NewTypedEventResponse("tendermint/event/Tx", 1, &blstypes.EventThresholdSigningRequested{CurrentEpochId: 1})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			registry.Dispatch(context.Background(), event)
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(2), peak.Load())
+	require.Equal(t, uint64(6), registry.Stats()[0].Handled)
+}
@@ -0,0 +1,128 @@
+package chainevents
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// DecodeTypedEvents rebuilds every event of the given type (a proto message name such as
+// "inference.bls.EventThresholdSigningRequested") from the flattened "<type>.<attribute>" map
+// CometBFT sends over the websocket and decodes it into its protobuf struct.
+// Only events emitted with EmitTypedEvent can be decoded this way.
+func DecodeTypedEvents(event *JSONRPCResponse, eventType string) ([]proto.Message, error) {
+	prefix := eventType + "."
+	attributes := make(map[string][]string)
+	count := 0
+	for key, values := range event.Result.Events {
+		attribute, found := strings.CutPrefix(key, prefix)
+		if !found {
+			continue
+		}
+		attributes[attribute] = values
+		count = max(count, len(values))
+	}
+	if count == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	messages := make([]proto.Message, 0, count)
+	for i := 0; i < count; i++ {
+		abciEvent := abci.Event{Type: eventType}
+		for _, key := range keys {
+			values := attributes[key]
+			// Attributes are only aligned across events when every event has them,
+			// a field the chain omitted in some events can't be attributed reliably.
+			if len(values) != count {
+				continue
+			}
+			abciEvent.Attributes = append(abciEvent.Attributes, abci.EventAttribute{Key: key, Value: values[i]})
+		}
+		message, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s event: %w", eventType, err)
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+// HasEvent reports whether the response contains at least one event of the given type.
+func HasEvent(event *JSONRPCResponse, eventType string) bool {
+	prefix := eventType + "."
+	for key, values := range event.Result.Events {
+		if strings.HasPrefix(key, prefix) && len(values) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Height returns the block height the response belongs to, or 0 if it can't be determined.
+func Height(event *JSONRPCResponse) int64 {
+	if heights := event.Result.Events["tx.height"]; len(heights) > 0 {
+		if height, err := strconv.ParseInt(heights[0], 10, 64); err == nil {
+			return height
+		}
+	}
+	block, ok := event.Result.Data.Value["block"].(map[string]interface{})
+	if !ok {
+		return 0
+	}
+	header, ok := block["header"].(map[string]interface{})
+	if !ok {
+		return 0
+	}
+	heightString, ok := header["height"].(string)
+	if !ok {
+		return 0
+	}
+	height, err := strconv.ParseInt(heightString, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return height
+}
+
+// NewTypedEventResponse synthesizes a websocket response carrying the given typed events,
+// flattened the same way CometBFT does it. Meant for tests of event handlers.
+func NewTypedEventResponse(dataType string, height int64, events ...proto.Message) (*JSONRPCResponse, error) {
+	response := &JSONRPCResponse{
+		JSONRPC: "2.0",
+		Result: Result{
+			Data:   Data{Type: dataType, Value: map[string]interface{}{}},
+			Events: map[string][]string{},
+		},
+	}
+	heightString := strconv.FormatInt(height, 10)
+	switch dataType {
+	case "tendermint/event/NewBlock":
+		response.Result.Data.Value["block"] = map[string]interface{}{
+			"header": map[string]interface{}{"height": heightString},
+		}
+	default:
+		response.Result.Events["tx.height"] = []string{heightString}
+	}
+	for _, event := range events {
+		sdkEvent, err := sdk.TypedEventToEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		for _, attribute := range sdkEvent.Attributes {
+			key := sdkEvent.Type + "." + attribute.Key
+			response.Result.Events[key] = append(response.Result.Events[key], attribute.Value)
+		}
+	}
+	return response, nil
+}
@@ -15,24 +15,19 @@ import (
 	"decentralized-api/training"
 	"decentralized-api/upgrade"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"log"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
+	blstypes "github.com/productscience/inference/x/bls/types"
 	"github.com/productscience/inference/x/inference/types"
 )
 
 const (
-	// BLS Typed Event Types (from EmitTypedEvent)
-	blsKeyGenerationInitiatedEvent    = "inference.bls.EventKeyGenerationInitiated"
-	blsVerifyingPhaseStartedEvent     = "inference.bls.EventVerifyingPhaseStarted"
-	blsGroupPublicKeyGeneratedEvent   = "inference.bls.EventGroupPublicKeyGenerated"
-	blsThresholdSigningRequestedEvent = "inference.bls.EventThresholdSigningRequested"
-
 	newBlockEventType      = "tendermint/event/NewBlock"
 	txEventType            = "tendermint/event/Tx"
 	systemBarrierEventType = "decentralized-api/event/Barrier"
@@ -52,9 +47,10 @@ type EventListener struct {
 	cancelFunc            context.CancelFunc
 	rewardRecoveryChecker *startup.RewardRecoveryChecker
 
-	eventHandlers []EventHandler
+	typedHandlers *chainevents.Registry
 
 	ws            *websocket.Conn
+	wsMutex       sync.Mutex
 	blockObserver *BlockObserver
 }
 
@@ -80,15 +76,48 @@ func NewEventListener(
 		validator,
 	)
 
-	eventHandlers := []EventHandler{
-		&BlsTransactionEventHandler{},
-		&InferenceFinishedEventHandler{},
-		&InferenceValidationEventHandler{},
-		&SubmitProposalEventHandler{},
-		&TrainingTaskAssignedEventHandler{},
-	}
-
-	bo := NewBlockObserver(configManager)
+	// Handlers only run while the node is synced, see processEvent
+	typedHandlers := chainevents.NewRegistry()
+	chainevents.Subscribe(typedHandlers, "inference_finished", chainevents.HandlerOptions{Concurrency: txEventWorkers},
+		func(ctx context.Context, event *types.EventInferenceFinished, meta chainevents.EventMeta) error {
+			validator.SampleInferenceToValidate([]string{event.InferenceId}, transactionRecorder)
+			return nil
+		})
+	chainevents.Subscribe(typedHandlers, "inference_validation", chainevents.HandlerOptions{Concurrency: txEventWorkers},
+		func(ctx context.Context, event *types.EventInferenceValidation, meta chainevents.EventMeta) error {
+			if event.NeedsRevalidation {
+				validator.VerifyInvalidation(event, transactionRecorder)
+			}
+			return nil
+		})
+	chainevents.Subscribe(typedHandlers, "training_task_assigned", chainevents.HandlerOptions{},
+		func(ctx context.Context, event *types.EventTrainingTaskAssigned, meta chainevents.EventMeta) error {
+			trainingExecutor.ProcessTaskAssignedEvent(event.TaskId)
+			return nil
+		})
+	chainevents.Subscribe(typedHandlers, "bls_key_generation", chainevents.HandlerOptions{},
+		func(ctx context.Context, event *blstypes.EventKeyGenerationInitiated, meta chainevents.EventMeta) error {
+			return blsManager.ProcessKeyGenerationInitiated(event)
+		})
+	chainevents.Subscribe(typedHandlers, "bls_threshold_signing", chainevents.HandlerOptions{Concurrency: 4},
+		func(ctx context.Context, event *blstypes.EventThresholdSigningRequested, meta chainevents.EventMeta) error {
+			return blsManager.ProcessThresholdSigningRequested(event)
+		})
+	chainevents.Subscribe(typedHandlers, "bls_verifying_phase", chainevents.HandlerOptions{},
+		func(ctx context.Context, event *blstypes.EventVerifyingPhaseStarted, meta chainevents.EventMeta) error {
+			return blsManager.ProcessVerifyingPhaseStarted(event)
+		})
+	chainevents.Subscribe(typedHandlers, "bls_group_public_key", chainevents.HandlerOptions{},
+		func(ctx context.Context, event *blstypes.EventGroupPublicKeyGenerated, meta chainevents.EventMeta) error {
+			return blsManager.ProcessGroupPublicKeyGenerated(event)
+		})
+	chainevents.Subscribe(typedHandlers, "inference_dispute", chainevents.HandlerOptions{},
+		func(ctx context.Context, event *types.EventInferenceDisputed, meta chainevents.EventMeta) error {
+			validator.VerifyDispute(event, transactionRecorder)
+			return nil
+		})
+
+	bo := NewBlockObserver(configManager, transactionRecorder.GetRpcClient())
 
 	return &EventListener{
 		nodeBroker:            nodeBroker,
@@ -100,32 +129,51 @@ func NewEventListener(
 		dispatcher:            dispatcher,
 		cancelFunc:            cancelFunc,
 		blsManager:            blsManager,
-		eventHandlers:         eventHandlers,
+		typedHandlers:         typedHandlers,
 		blockObserver:         bo,
 		rewardRecoveryChecker: startup.NewRewardRecoveryChecker(phaseTracker, &transactionRecorder, validator, configManager),
 	}
 }
 
+// openWsConnAndSubscribe connects to the current chain endpoint, moving on to the next one if it can't be dialed.
 func (el *EventListener) openWsConnAndSubscribe() {
-	websocketUrl := getWebsocketUrl(el.configManager.GetChainNodeConfig().Url)
-	logging.Info("Connecting to websocket at", types.EventProcessing, "url", websocketUrl)
-
-	ws, _, err := websocket.DefaultDialer.Dial(websocketUrl, nil)
+	endpoints := el.transactionRecorder.GetEndpointPool()
+	var ws *websocket.Conn
+	var err error
+	for attempt := 0; attempt < len(endpoints.Statuses()); attempt++ {
+		chainNodeUrl := endpoints.Current()
+		websocketUrl := getWebsocketUrl(chainNodeUrl)
+		logging.Info("Connecting to websocket at", types.EventProcessing, "url", websocketUrl)
+
+		ws, _, err = websocket.DefaultDialer.Dial(websocketUrl, nil)
+		if err == nil {
+			break
+		}
+		logging.Error("Failed to connect to websocket", types.EventProcessing, "url", websocketUrl, "error", err)
+		endpoints.ReportFailure(chainNodeUrl, err)
+	}
 	if err != nil {
-		logging.Error("Failed to connect to websocket", types.EventProcessing, "error", err)
 		log.Fatal("dial:", err)
 	}
+	el.wsMutex.Lock()
 	el.ws = ws
+	el.wsMutex.Unlock()
 
 	// Subscribe only to NewBlock events; all Tx events will be polled via BlockObserver
-	subscribeToEvents(el.ws, 1, "tm.event='NewBlock'")
+	subscribeToEvents(ws, 1, "tm.event='NewBlock'")
 
 	logging.Info("Subscribed to NewBlock only; Tx will be polled by BlockObserver.", types.EventProcessing)
 }
 
 func (el *EventListener) Start(ctx context.Context) {
 	el.openWsConnAndSubscribe()
-	defer el.ws.Close()
+	defer el.closeWsConn()
+
+	// Closing the connection makes listen reconnect, which picks up the new endpoint
+	el.transactionRecorder.GetEndpointPool().OnSwitch(func(oldUrl, newUrl string) {
+		logging.Warn("Chain endpoint switched, reconnecting websocket", types.EventProcessing, "from", oldUrl, "to", newUrl)
+		el.closeWsConn()
+	})
 
 	go el.startSyncStatusChecker()
 
@@ -167,9 +215,11 @@ func worker(
 	}()
 }
 
+// txEventWorkers is the number of workers handling Tx events
+const txEventWorkers = 10
+
 func (el *EventListener) processEvents(ctx context.Context, mainQueue *UnboundedQueue[*chainevents.JSONRPCResponse]) {
-	const numWorkers = 10
-	for i := 0; i < numWorkers; i++ {
+	for i := 0; i < txEventWorkers; i++ {
 		worker(ctx, mainQueue, el.processEvent, "process_events_"+strconv.Itoa(i))
 	}
 }
@@ -204,7 +254,7 @@ func (el *EventListener) listen(ctx context.Context, blockQueue, mainQueue *Unbo
 				}
 
 				logging.Warn("Close websocket connection", types.EventProcessing)
-				el.ws.Close()
+				el.closeWsConn()
 
 				logging.Warn("Reopen websocket", types.EventProcessing)
 				time.Sleep(10 * time.Second)
@@ -242,15 +292,22 @@ func (el *EventListener) listen(ctx context.Context, blockQueue, mainQueue *Unbo
 	}
 }
 
+func (el *EventListener) closeWsConn() {
+	el.wsMutex.Lock()
+	defer el.wsMutex.Unlock()
+	if el.ws != nil {
+		el.ws.Close()
+	}
+}
+
 func (el *EventListener) startSyncStatusChecker() {
-	chainNodeUrl := el.configManager.GetChainNodeConfig().Url
 	hasTriedVersionSync := false
 
 	ticker := time.NewTicker(5 * time.Second)
 	defer ticker.Stop()
 
 	for range ticker.C {
-		status, err := getStatus(chainNodeUrl)
+		status, err := el.transactionRecorder.Status(context.Background())
 		if err != nil {
 			logging.Error("Error getting node status", types.EventProcessing, "error", err)
 			continue
@@ -292,8 +349,8 @@ func (el *EventListener) processEvent(event *chainevents.JSONRPCResponse, worker
 		logging.Debug("New block event received", types.EventProcessing, "type", event.Result.Data.Type, "worker", workerName)
 
 		if el.isNodeSynced() {
-			// Check for BLS events in NewBlock events (emitted from EndBlocker)
-			el.handleBLSEvents(event, workerName)
+			// Typed events emitted from EndBlocker arrive with the NewBlock event
+			el.typedHandlers.Dispatch(context.Background(), event)
 		}
 
 		// Parse the event into NewBlockInfo
@@ -314,13 +371,13 @@ func (el *EventListener) processEvent(event *chainevents.JSONRPCResponse, worker
 		}
 
 		// Still handle upgrade processing separately
-		upgrade.ProcessNewBlockEvent(event, el.transactionRecorder, el.configManager)
+		upgrade.ProcessNewBlockEvent(el.transactionRecorder, el.configManager)
 		if el.isNodeSynced() {
 			el.rewardRecoveryChecker.RecoverIfNeeded(blockInfo.Height)
 		}
 
 	case txEventType:
-		if el.hasHandler(event) {
+		if el.typedHandlers.CanHandle(event) {
 			el.handleMessage(event, workerName)
 		}
 	case systemBarrierEventType:
@@ -338,161 +395,20 @@ func (el *EventListener) processEvent(event *chainevents.JSONRPCResponse, worker
 	}
 }
 
-func (el *EventListener) hasHandler(event *chainevents.JSONRPCResponse) bool {
-	for _, handler := range el.eventHandlers {
-		if handler.CanHandle(event) {
-			return true
-		}
-	}
-	return false
-}
-
-func (el *EventListener) handleBLSEvents(event *chainevents.JSONRPCResponse, workerName string) {
-	// Check for BLS events in NewBlock events (emitted from EndBlocker)
-	// Note: Threshold signing events are handled separately in handleBLSTransactionEvents
-
-	if epochIdValues := event.Result.Events[blsKeyGenerationInitiatedEvent+".epoch_id"]; len(epochIdValues) > 0 {
-		logging.Info("Key generation initiated event received", types.EventProcessing, "worker", workerName)
-		err := el.blsManager.ProcessKeyGenerationInitiated(event)
-		if err != nil {
-			logging.Error("Failed to process key generation initiated event", types.EventProcessing, "error", err, "worker", workerName)
-		}
-	}
-
-	if epochIdValues := event.Result.Events[blsVerifyingPhaseStartedEvent+".epoch_id"]; len(epochIdValues) > 0 {
-		logging.Info("Verifying phase started event received", types.EventProcessing, "worker", workerName)
-		err := el.blsManager.ProcessVerifyingPhaseStarted(event)
-		if err != nil {
-			logging.Error("Failed to process verifying phase started event", types.EventProcessing, "error", err, "worker", workerName)
-		}
-	}
-
-	if epochIdValues := event.Result.Events[blsGroupPublicKeyGeneratedEvent+".epoch_id"]; len(epochIdValues) > 0 {
-		logging.Info("Group public key generated event received", types.EventProcessing, "worker", workerName)
-		err := el.blsManager.ProcessGroupPublicKeyGenerated(event)
-		if err != nil {
-			logging.Error("Failed to process group public key generated event", types.EventProcessing, "error", err, "worker", workerName)
-		}
-	}
-}
-
 func (el *EventListener) handleMessage(event *chainevents.JSONRPCResponse, name string) {
 	if waitForEventHeight(event, el.configManager, name) {
 		logging.Warn("Event height not reached yet, skipping", types.EventProcessing, "event", event)
 		return
 	}
 
-	for _, handler := range el.eventHandlers {
-		if handler.CanHandle(event) {
-			logging.Info("Handling event", types.EventProcessing, "event", event, "handler", handler.GetName(), "worker", name)
-			err := handler.Handle(event, el)
-			if err != nil {
-				logging.Error("Failed to handle event", types.EventProcessing, "error", err, "event", event)
-			}
-		}
-	}
-}
-
-type EventHandler interface {
-	GetName() string
-	CanHandle(event *chainevents.JSONRPCResponse) bool
-	Handle(event *chainevents.JSONRPCResponse, el *EventListener) error
-}
-type BlsTransactionEventHandler struct{}
-
-func (e *BlsTransactionEventHandler) GetName() string {
-	return "bls_transaction"
-}
-
-func (e *BlsTransactionEventHandler) CanHandle(event *chainevents.JSONRPCResponse) bool {
-	return len(event.Result.Events[blsThresholdSigningRequestedEvent+".request_id"]) > 0
-}
-
-func (e *BlsTransactionEventHandler) Handle(event *chainevents.JSONRPCResponse, el *EventListener) error {
-	if el.isNodeSynced() {
-		return el.blsManager.ProcessThresholdSigningRequested(event)
-	}
-	return nil
-}
-
-type InferenceFinishedEventHandler struct {
-}
-
-func (e *InferenceFinishedEventHandler) GetName() string {
-	return "inference_finished"
-}
-
-func (e *InferenceFinishedEventHandler) CanHandle(event *chainevents.JSONRPCResponse) bool {
-	return len(event.Result.Events["inference_finished.inference_id"]) > 0
-}
-
-func (e *InferenceFinishedEventHandler) Handle(event *chainevents.JSONRPCResponse, el *EventListener) error {
 	if el.isNodeSynced() {
-		el.validator.SampleInferenceToValidate(event.Result.Events["inference_finished.inference_id"], el.transactionRecorder)
+		el.typedHandlers.Dispatch(context.Background(), event)
 	}
-	return nil
-}
-
-type InferenceValidationEventHandler struct {
-}
-
-func (e *InferenceValidationEventHandler) GetName() string {
-	return "inference_validation"
-}
-
-func (e *InferenceValidationEventHandler) CanHandle(event *chainevents.JSONRPCResponse) bool {
-	needsRevalidation := event.Result.Events["inference_validation.needs_revalidation"]
-	return len(needsRevalidation) > 0 && needsRevalidation[0] == "true"
 }
 
-func (e *InferenceValidationEventHandler) Handle(event *chainevents.JSONRPCResponse, el *EventListener) error {
-	if el.isNodeSynced() {
-		el.validator.VerifyInvalidation(event.Result.Events, el.transactionRecorder)
-	}
-	return nil
-}
-
-type SubmitProposalEventHandler struct{}
-
-func (e *SubmitProposalEventHandler) GetName() string {
-	return "submit_proposal"
-}
-
-func (e *SubmitProposalEventHandler) CanHandle(event *chainevents.JSONRPCResponse) bool {
-	return len(event.Result.Events["submit_proposal.proposal_id"]) > 0
-}
-
-func (e *SubmitProposalEventHandler) Handle(event *chainevents.JSONRPCResponse, el *EventListener) error {
-	proposalIds := event.Result.Events["submit_proposal.proposal_id"]
-	if len(proposalIds) == 0 {
-		return errors.New("proposal_id not found in event")
-	}
-	logging.Debug("Handling `submit_proposal` event", types.EventProcessing, "proposalId", proposalIds[0])
-	return nil
-}
-
-type TrainingTaskAssignedEventHandler struct{}
-
-func (e *TrainingTaskAssignedEventHandler) GetName() string {
-	return "training_task_assigned"
-}
-
-func (e *TrainingTaskAssignedEventHandler) CanHandle(event *chainevents.JSONRPCResponse) bool {
-	return len(event.Result.Events["training_task_assigned.task_id"]) > 0
-}
-
-func (e *TrainingTaskAssignedEventHandler) Handle(event *chainevents.JSONRPCResponse, el *EventListener) error {
-	if el.isNodeSynced() {
-		for _, taskId := range event.Result.Events["training_task_assigned.task_id"] {
-			taskIdUint, err := strconv.ParseUint(taskId, 10, 64)
-			if err != nil {
-				logging.Error("Failed to parse task ID", types.Training, "error", err)
-				continue // Continue to the next task ID
-			}
-			el.trainingExecutor.ProcessTaskAssignedEvent(taskIdUint)
-		}
-	}
-	return nil
+// TypedHandlers returns the registry of typed chain event handlers, e.g. to read their stats.
+func (el *EventListener) TypedHandlers() *chainevents.Registry {
+	return el.typedHandlers
 }
 
 func waitForEventHeight(event *chainevents.JSONRPCResponse, currentConfig *apiconfig.ConfigManager, name string) bool {
@@ -0,0 +1,312 @@
+package indexer
+
+import (
+	"context"
+	"decentralized-api/logging"
+	"fmt"
+	"strconv"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultPollInterval = 5 * time.Second
+	performancePageSize = 1000
+)
+
+// ChainClient is the part of the CometBFT RPC the indexer reads blocks through
+type ChainClient interface {
+	Status(ctx context.Context) (*coretypes.ResultStatus, error)
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+	BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
+}
+
+// PerformanceClient is the part of the inference query client used to snapshot epoch performance
+type PerformanceClient interface {
+	GetCurrentEpoch(ctx context.Context, in *types.QueryGetCurrentEpochRequest, opts ...grpc.CallOption) (*types.QueryGetCurrentEpochResponse, error)
+	EpochPerformanceSummaryAll(ctx context.Context, in *types.QueryAllEpochPerformanceSummaryRequest, opts ...grpc.CallOption) (*types.QueryAllEpochPerformanceSummaryResponse, error)
+}
+
+// Indexer follows the chain block by block and writes inference, validation and reward history into a Store.
+// Performance summaries are not derivable from blocks, so they are copied from chain state whenever the epoch changes.
+type Indexer struct {
+	store        *Store
+	chain        ChainClient
+	performance  PerformanceClient
+	txDecoder    sdk.TxDecoder
+	startHeight  int64
+	pollInterval time.Duration
+}
+
+func NewIndexer(store *Store, chain ChainClient, performance PerformanceClient, txDecoder sdk.TxDecoder, startHeight int64, pollInterval time.Duration) *Indexer {
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+	return &Indexer{
+		store:        store,
+		chain:        chain,
+		performance:  performance,
+		txDecoder:    txDecoder,
+		startHeight:  startHeight,
+		pollInterval: pollInterval,
+	}
+}
+
+// Run indexes until ctx is cancelled, waiting pollInterval between catch-ups
+func (ix *Indexer) Run(ctx context.Context) {
+	logging.Info("Chain indexer started", types.EventProcessing, "startHeight", ix.startHeight, "pollInterval", ix.pollInterval)
+	for {
+		if err := ix.CatchUp(ctx); err != nil && ctx.Err() == nil {
+			logging.Warn("Chain indexer failed to catch up", types.EventProcessing, "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(ix.pollInterval):
+		}
+	}
+}
+
+// CatchUp indexes every block up to the latest one and then refreshes epoch performance if the epoch changed
+func (ix *Indexer) CatchUp(ctx context.Context) error {
+	status, err := ix.chain.Status(ctx)
+	if err != nil {
+		return err
+	}
+	last, err := ix.store.LastHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	earliest := status.SyncInfo.EarliestBlockHeight
+	next := last + 1
+	if last == 0 {
+		next = max(ix.startHeight, earliest, 1)
+	}
+	if next < earliest {
+		logging.Warn("Chain node no longer has the next block, skipping to its earliest block", types.EventProcessing,
+			"next", next, "earliest", earliest)
+		next = earliest
+	}
+
+	for height := next; height <= status.SyncInfo.LatestBlockHeight; height++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := ix.IndexBlock(ctx, height); err != nil {
+			return fmt.Errorf("block %d: %w", height, err)
+		}
+	}
+	return ix.syncPerformance(ctx)
+}
+
+func (ix *Indexer) IndexBlock(ctx context.Context, height int64) error {
+	block, err := ix.chain.Block(ctx, &height)
+	if err != nil {
+		return err
+	}
+	results, err := ix.chain.BlockResults(ctx, &height)
+	if err != nil {
+		return err
+	}
+	return ix.store.ApplyBlock(ctx, ix.extractBlock(block, results))
+}
+
+func (ix *Indexer) extractBlock(block *coretypes.ResultBlock, results *coretypes.ResultBlockResults) BlockChanges {
+	changes := BlockChanges{
+		Height: block.Block.Height,
+		Time:   block.Block.Time,
+	}
+	for i, txBytes := range block.Block.Txs {
+		if i >= len(results.TxsResults) || results.TxsResults[i].Code != 0 {
+			continue
+		}
+		ix.extractTx(&changes, txBytes, results.TxsResults[i])
+	}
+	// Expiry happens in EndBlock
+	changes.StatusChanges = append(changes.StatusChanges, statusChanges(results.FinalizeBlockEvents)...)
+	return changes
+}
+
+func (ix *Indexer) extractTx(changes *BlockChanges, txBytes cmttypes.Tx, result *abcitypes.ExecTxResult) {
+	txHash := fmt.Sprintf("%X", txBytes.Hash())
+	tx, err := ix.txDecoder(txBytes)
+	if err != nil {
+		logging.Warn("Chain indexer failed to decode tx", types.EventProcessing, "height", changes.Height, "txHash", txHash, "error", err)
+		return
+	}
+	var msgData sdk.TxMsgData
+	if err := msgData.Unmarshal(result.Data); err != nil {
+		logging.Warn("Chain indexer failed to decode tx result", types.EventProcessing, "height", changes.Height, "txHash", txHash, "error", err)
+	}
+	outcomes := validationOutcomes(result.Events)
+
+	for i, msg := range tx.GetMsgs() {
+		var response []byte
+		if i < len(msgData.MsgResponses) {
+			response = msgData.MsgResponses[i].Value
+		}
+		ix.extractMsg(changes, msg, response, txHash, outcomes)
+	}
+	// Invalidations and revalidations are executed by group proposals, so they only show up as events
+	changes.StatusChanges = append(changes.StatusChanges, statusChanges(result.Events)...)
+}
+
+// extractMsg records what a single message changed. response is the encoded response of the message, if any.
+// Messages signed with a warm key arrive wrapped in authz MsgExec, whose response carries the nested responses.
+func (ix *Indexer) extractMsg(changes *BlockChanges, msg sdk.Msg, response []byte, txHash string, outcomes map[string]validationOutcome) {
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		nested, err := msg.GetMessages()
+		if err != nil {
+			logging.Warn("Chain indexer failed to unpack authz exec", types.EventProcessing, "height", changes.Height, "txHash", txHash, "error", err)
+			return
+		}
+		var execResponse authz.MsgExecResponse
+		if response != nil {
+			if err := execResponse.Unmarshal(response); err != nil {
+				logging.Warn("Chain indexer failed to decode authz exec result", types.EventProcessing, "height", changes.Height, "txHash", txHash, "error", err)
+			}
+		}
+		for i, nestedMsg := range nested {
+			var nestedResponse []byte
+			if i < len(execResponse.Results) {
+				nestedResponse = execResponse.Results[i]
+			}
+			ix.extractMsg(changes, nestedMsg, nestedResponse, txHash, outcomes)
+		}
+	case *types.MsgStartInference:
+		changes.Starts = append(changes.Starts, InferenceStart{
+			InferenceId:      msg.InferenceId,
+			Requester:        msg.RequestedBy,
+			TransferAgent:    msg.Creator,
+			AssignedTo:       msg.AssignedTo,
+			Model:            msg.Model,
+			PromptTokenCount: msg.PromptTokenCount,
+		})
+	case *types.MsgFinishInference:
+		changes.Finishes = append(changes.Finishes, InferenceFinish{
+			InferenceId:          msg.InferenceId,
+			Requester:            msg.RequestedBy,
+			TransferAgent:        msg.TransferredBy,
+			Executor:             msg.ExecutedBy,
+			Model:                msg.Model,
+			PromptTokenCount:     msg.PromptTokenCount,
+			CompletionTokenCount: msg.CompletionTokenCount,
+		})
+	case *types.MsgValidation:
+		outcome := outcomes[msg.InferenceId+"/"+msg.Creator]
+		changes.Validations = append(changes.Validations, Validation{
+			InferenceId:       msg.InferenceId,
+			Validator:         msg.Creator,
+			Value:             msg.Value,
+			Revalidation:      msg.Revalidation,
+			Passed:            outcome.passed,
+			NeedsRevalidation: outcome.needsRevalidation,
+			TxHash:            txHash,
+		})
+	case *types.MsgClaimRewards:
+		payout := RewardPayout{
+			Participant: msg.Creator,
+			EpochIndex:  msg.EpochIndex,
+			TxHash:      txHash,
+		}
+		if response != nil {
+			var claimResponse types.MsgClaimRewardsResponse
+			if err := claimResponse.Unmarshal(response); err == nil {
+				payout.Amount = claimResponse.Amount
+				payout.Result = claimResponse.Result
+			}
+		}
+		changes.Payouts = append(changes.Payouts, payout)
+	}
+}
+
+type validationOutcome struct {
+	passed            bool
+	needsRevalidation bool
+}
+
+// validationOutcomes reads the inference_validation events of a tx, keyed by inference id and validator
+func validationOutcomes(events []abcitypes.Event) map[string]validationOutcome {
+	outcomes := make(map[string]validationOutcome)
+	for _, event := range events {
+		if event.Type != "inference_validation" {
+			continue
+		}
+		attrs := eventAttributes(event)
+		passed, _ := strconv.ParseBool(attrs["passed"])
+		needsRevalidation, _ := strconv.ParseBool(attrs["needs_revalidation"])
+		outcomes[attrs["inference_id"]+"/"+attrs["validator"]] = validationOutcome{
+			passed:            passed,
+			needsRevalidation: needsRevalidation,
+		}
+	}
+	return outcomes
+}
+
+func statusChanges(events []abcitypes.Event) []InferenceStatusChange {
+	var changes []InferenceStatusChange
+	for _, event := range events {
+		if event.Type != "inference_status" {
+			continue
+		}
+		attrs := eventAttributes(event)
+		if attrs["inference_id"] == "" || attrs["status"] == "" {
+			continue
+		}
+		changes = append(changes, InferenceStatusChange{InferenceId: attrs["inference_id"], Status: attrs["status"]})
+	}
+	return changes
+}
+
+func eventAttributes(event abcitypes.Event) map[string]string {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	return attrs
+}
+
+func (ix *Indexer) syncPerformance(ctx context.Context) error {
+	if ix.performance == nil {
+		return nil
+	}
+	current, err := ix.performance.GetCurrentEpoch(ctx, &types.QueryGetCurrentEpochRequest{})
+	if err != nil {
+		return err
+	}
+	synced, err := ix.store.PerformanceEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	if synced == current.Epoch {
+		return nil
+	}
+
+	var summaries []types.EpochPerformanceSummary
+	var key []byte
+	for {
+		response, err := ix.performance.EpochPerformanceSummaryAll(ctx, &types.QueryAllEpochPerformanceSummaryRequest{
+			Pagination: &query.PageRequest{Key: key, Limit: performancePageSize},
+		})
+		if err != nil {
+			return err
+		}
+		summaries = append(summaries, response.EpochPerformanceSummary...)
+		if response.Pagination == nil || len(response.Pagination.NextKey) == 0 {
+			break
+		}
+		key = response.Pagination.NextKey
+	}
+	logging.Info("Chain indexer synced epoch performance", types.EventProcessing, "epoch", current.Epoch, "summaries", len(summaries))
+	return ix.store.ReplaceEpochPerformance(ctx, summaries, current.Epoch)
+}
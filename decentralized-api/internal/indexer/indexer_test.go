@@ -0,0 +1,370 @@
+package indexer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const (
+	requester = "gonka1requester"
+	executor  = "gonka1executor"
+	validator = "gonka1validator"
+)
+
+type fakeBlock struct {
+	txs     []cmttypes.Tx
+	results []*abcitypes.ExecTxResult
+	events  []abcitypes.Event
+}
+
+type fakeChain struct {
+	earliest int64
+	blocks   map[int64]fakeBlock
+	latest   int64
+}
+
+func (c *fakeChain) add(block fakeBlock) {
+	c.latest++
+	c.blocks[c.latest] = block
+}
+
+func (c *fakeChain) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{
+		EarliestBlockHeight: c.earliest,
+		LatestBlockHeight:   c.latest,
+	}}, nil
+}
+
+func (c *fakeChain) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	return &coretypes.ResultBlock{Block: &cmttypes.Block{
+		Header: cmttypes.Header{Height: *height, Time: time.Unix(1_700_000_000+*height, 0)},
+		Data:   cmttypes.Data{Txs: c.blocks[*height].txs},
+	}}, nil
+}
+
+func (c *fakeChain) BlockResults(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	block := c.blocks[*height]
+	return &coretypes.ResultBlockResults{
+		Height:              *height,
+		TxsResults:          block.results,
+		FinalizeBlockEvents: block.events,
+	}, nil
+}
+
+type fakePerformance struct {
+	epoch     uint64
+	summaries []types.EpochPerformanceSummary
+	calls     int
+}
+
+func (p *fakePerformance) GetCurrentEpoch(context.Context, *types.QueryGetCurrentEpochRequest, ...grpc.CallOption) (*types.QueryGetCurrentEpochResponse, error) {
+	return &types.QueryGetCurrentEpochResponse{Epoch: p.epoch}, nil
+}
+
+// EpochPerformanceSummaryAll returns one summary per page to exercise pagination
+func (p *fakePerformance) EpochPerformanceSummaryAll(_ context.Context, req *types.QueryAllEpochPerformanceSummaryRequest, _ ...grpc.CallOption) (*types.QueryAllEpochPerformanceSummaryResponse, error) {
+	p.calls++
+	offset := 0
+	if len(req.Pagination.Key) > 0 {
+		offset = int(req.Pagination.Key[0])
+	}
+	response := &types.QueryAllEpochPerformanceSummaryResponse{Pagination: &query.PageResponse{}}
+	if offset < len(p.summaries) {
+		response.EpochPerformanceSummary = p.summaries[offset : offset+1]
+	}
+	if offset+1 < len(p.summaries) {
+		response.Pagination.NextKey = []byte{byte(offset + 1)}
+	}
+	return response, nil
+}
+
+type testEnv struct {
+	store       *Store
+	chain       *fakeChain
+	performance *fakePerformance
+	indexer     *Indexer
+	encoding    moduletestutil.TestEncodingConfig
+}
+
+func newTestEnv(t *testing.T, path string) *testEnv {
+	encoding := moduletestutil.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encoding.InterfaceRegistry)
+	authz.RegisterInterfaces(encoding.InterfaceRegistry)
+	store, err := OpenStore(context.Background(), path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	env := &testEnv{
+		store:       store,
+		chain:       &fakeChain{earliest: 1, blocks: map[int64]fakeBlock{}},
+		performance: &fakePerformance{},
+		encoding:    encoding,
+	}
+	env.indexer = NewIndexer(store, env.chain, env.performance, encoding.TxConfig.TxDecoder(), 0, time.Second)
+	return env
+}
+
+func (e *testEnv) tx(t *testing.T, msgs ...sdk.Msg) cmttypes.Tx {
+	builder := e.encoding.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	bz, err := e.encoding.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return bz
+}
+
+func ok(events ...abcitypes.Event) *abcitypes.ExecTxResult {
+	return &abcitypes.ExecTxResult{Code: 0, Events: events}
+}
+
+func event(eventType string, attrs ...string) abcitypes.Event {
+	event := abcitypes.Event{Type: eventType}
+	for i := 0; i < len(attrs); i += 2 {
+		event.Attributes = append(event.Attributes, abcitypes.EventAttribute{Key: attrs[i], Value: attrs[i+1]})
+	}
+	return event
+}
+
+func claimResult(t *testing.T, amount uint64, result string, events ...abcitypes.Event) *abcitypes.ExecTxResult {
+	response, err := codectypes.NewAnyWithValue(&types.MsgClaimRewardsResponse{Amount: amount, Result: result})
+	require.NoError(t, err)
+	data, err := (&sdk.TxMsgData{MsgResponses: []*codectypes.Any{response}}).Marshal()
+	require.NoError(t, err)
+	return &abcitypes.ExecTxResult{Code: 0, Data: data, Events: events}
+}
+
+func start(id string) *types.MsgStartInference {
+	return &types.MsgStartInference{
+		Creator:          executor,
+		InferenceId:      id,
+		Model:            "Qwen/Qwen2.5-7B-Instruct",
+		RequestedBy:      requester,
+		AssignedTo:       executor,
+		PromptTokenCount: 10,
+	}
+}
+
+func finish(id string) *types.MsgFinishInference {
+	return &types.MsgFinishInference{
+		Creator:              executor,
+		InferenceId:          id,
+		PromptTokenCount:     12,
+		CompletionTokenCount: 30,
+		ExecutedBy:           executor,
+		TransferredBy:        executor,
+		RequestedBy:          requester,
+		Model:                "Qwen/Qwen2.5-7B-Instruct",
+	}
+}
+
+func TestIndexerFollowsInferenceLifecycle(t *testing.T) {
+	env := newTestEnv(t, filepath.Join(t.TempDir(), "indexer.db"))
+	ctx := context.Background()
+
+	// Block 1: the finish of inf-a lands before its start, inf-b only starts, and a failed tx is ignored
+	env.chain.add(fakeBlock{
+		txs: []cmttypes.Tx{
+			env.tx(t, finish("inf-a")),
+			env.tx(t, start("inf-a"), start("inf-b")),
+			env.tx(t, start("inf-failed")),
+		},
+		results: []*abcitypes.ExecTxResult{ok(), ok(), {Code: 5}},
+	})
+	// Block 2: inf-a passes validation, inf-b expires in EndBlock
+	env.chain.add(fakeBlock{
+		txs: []cmttypes.Tx{env.tx(t, &types.MsgValidation{Creator: validator, InferenceId: "inf-a", Value: 0.99})},
+		results: []*abcitypes.ExecTxResult{ok(event("inference_validation",
+			"inference_id", "inf-a", "validator", validator, "needs_revalidation", "false", "passed", "true"))},
+		events: []abcitypes.Event{event("inference_status", "inference_id", "inf-b", "status", "EXPIRED")},
+	})
+	// Block 3: inf-c fails validation and goes to a vote, which invalidates it in block 4 next to a reward claim
+	env.chain.add(fakeBlock{
+		txs: []cmttypes.Tx{env.tx(t, start("inf-c"), finish("inf-c"), &types.MsgValidation{Creator: validator, InferenceId: "inf-c", Value: 0.1})},
+		results: []*abcitypes.ExecTxResult{ok(event("inference_validation",
+			"inference_id", "inf-c", "validator", validator, "needs_revalidation", "true", "passed", "false"))},
+	})
+	env.chain.add(fakeBlock{
+		txs: []cmttypes.Tx{env.tx(t, &types.MsgClaimRewards{Creator: executor, EpochIndex: 7, Seed: 1})},
+		results: []*abcitypes.ExecTxResult{claimResult(t, 1500, "Rewards claimed",
+			event("inference_status", "inference_id", "inf-c", "status", "INVALIDATED"))},
+	})
+
+	env.performance.epoch = 8
+	env.performance.summaries = []types.EpochPerformanceSummary{
+		{EpochIndex: 7, ParticipantId: executor, InferenceCount: 3, EarnedCoins: 1500},
+		{EpochIndex: 7, ParticipantId: validator, InferenceCount: 0},
+	}
+
+	require.NoError(t, env.indexer.CatchUp(ctx))
+
+	height, err := env.store.LastHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), height)
+
+	a, found, err := env.store.GetInference(ctx, "inf-a")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "VALIDATED", a.Status)
+	require.Equal(t, requester, a.Requester)
+	require.Equal(t, executor, a.Executor)
+	require.Equal(t, uint64(12), a.PromptTokenCount)
+	require.Equal(t, uint64(30), a.CompletionTokenCount)
+	require.Equal(t, int64(1), a.StartHeight)
+	require.Equal(t, int64(1), a.FinishHeight)
+
+	statuses := map[string]string{}
+	all, err := env.store.ListInferences(ctx, InferenceFilter{}, Page{})
+	require.NoError(t, err)
+	for _, inference := range all {
+		statuses[inference.InferenceId] = inference.Status
+	}
+	require.Equal(t, map[string]string{"inf-a": "VALIDATED", "inf-b": "EXPIRED", "inf-c": "INVALIDATED"}, statuses)
+
+	validations, err := env.store.ListValidations(ctx, ValidationFilter{Validator: validator}, Page{})
+	require.NoError(t, err)
+	require.Len(t, validations, 2)
+	require.Equal(t, "inf-c", validations[0].InferenceId)
+	require.True(t, validations[0].NeedsRevalidation)
+	require.True(t, validations[1].Passed)
+	require.NotEmpty(t, validations[1].TxHash)
+
+	payouts, err := env.store.ListRewardPayouts(ctx, RewardFilter{Participant: executor}, Page{})
+	require.NoError(t, err)
+	require.Len(t, payouts, 1)
+	require.Equal(t, uint64(1500), payouts[0].Amount)
+	require.Equal(t, uint64(7), payouts[0].EpochIndex)
+
+	epoch := uint64(7)
+	performance, err := env.store.ListEpochPerformance(ctx, PerformanceFilter{EpochIndex: &epoch}, Page{})
+	require.NoError(t, err)
+	require.Len(t, performance, 2)
+	require.Equal(t, 2, env.performance.calls)
+
+	// Nothing changed, so neither blocks nor performance are read again
+	require.NoError(t, env.indexer.CatchUp(ctx))
+	require.Equal(t, 2, env.performance.calls)
+}
+
+func TestIndexerUnwrapsAuthzExec(t *testing.T) {
+	env := newTestEnv(t, filepath.Join(t.TempDir(), "indexer.db"))
+	ctx := context.Background()
+	exec := func(msgs ...sdk.Msg) *authz.MsgExec {
+		msg := authz.NewMsgExec(sdk.AccAddress("warm-key"), msgs)
+		return &msg
+	}
+
+	// The dapi signs with a warm key, so everything arrives wrapped in MsgExec
+	env.chain.add(fakeBlock{
+		txs:     []cmttypes.Tx{env.tx(t, exec(start("inf-a"), finish("inf-a")))},
+		results: []*abcitypes.ExecTxResult{ok()},
+	})
+	claimResponse, err := (&types.MsgClaimRewardsResponse{Amount: 700, Result: "Rewards claimed"}).Marshal()
+	require.NoError(t, err)
+	execResponse, err := codectypes.NewAnyWithValue(&authz.MsgExecResponse{Results: [][]byte{nil, claimResponse}})
+	require.NoError(t, err)
+	data, err := (&sdk.TxMsgData{MsgResponses: []*codectypes.Any{execResponse}}).Marshal()
+	require.NoError(t, err)
+	env.chain.add(fakeBlock{
+		txs: []cmttypes.Tx{env.tx(t, exec(
+			&types.MsgValidation{Creator: validator, InferenceId: "inf-a", Value: 0.99},
+			&types.MsgClaimRewards{Creator: executor, EpochIndex: 3, Seed: 1},
+		))},
+		results: []*abcitypes.ExecTxResult{{Code: 0, Data: data, Events: []abcitypes.Event{event("inference_validation",
+			"inference_id", "inf-a", "validator", validator, "needs_revalidation", "false", "passed", "true")}}},
+	})
+
+	require.NoError(t, env.indexer.CatchUp(ctx))
+
+	a, found, err := env.store.GetInference(ctx, "inf-a")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, executor, a.Executor)
+	require.Equal(t, uint64(30), a.CompletionTokenCount)
+	require.Equal(t, int64(1), a.StartHeight)
+
+	validations, err := env.store.ListValidations(ctx, ValidationFilter{Validator: validator}, Page{})
+	require.NoError(t, err)
+	require.Len(t, validations, 1)
+	require.True(t, validations[0].Passed)
+
+	payouts, err := env.store.ListRewardPayouts(ctx, RewardFilter{Participant: executor}, Page{})
+	require.NoError(t, err)
+	require.Len(t, payouts, 1)
+	require.Equal(t, uint64(700), payouts[0].Amount)
+	require.Equal(t, "Rewards claimed", payouts[0].Result)
+}
+
+func TestIndexerResumesAndPaginates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "indexer.db")
+	env := newTestEnv(t, path)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		env.chain.add(fakeBlock{
+			txs:     []cmttypes.Tx{env.tx(t, start(string(rune('a'+i))))},
+			results: []*abcitypes.ExecTxResult{ok()},
+		})
+	}
+	require.NoError(t, env.indexer.CatchUp(ctx))
+	require.NoError(t, env.store.Close())
+
+	// Reopening picks up at the next block instead of indexing the first five again
+	reopened := newTestEnv(t, path)
+	reopened.chain = env.chain
+	reopened.indexer.chain = env.chain
+	env.chain.add(fakeBlock{
+		txs:     []cmttypes.Tx{env.tx(t, start("f"))},
+		results: []*abcitypes.ExecTxResult{ok()},
+	})
+	require.NoError(t, reopened.indexer.CatchUp(ctx))
+
+	var ids []string
+	page := Page{Limit: 4}
+	for {
+		items, err := reopened.store.ListInferences(ctx, InferenceFilter{Requester: requester}, page)
+		require.NoError(t, err)
+		for _, item := range items {
+			ids = append(ids, item.InferenceId)
+		}
+		if len(items) < page.Limit {
+			break
+		}
+		page.Before = items[len(items)-1].Id
+	}
+	require.Equal(t, []string{"f", "e", "d", "c", "b", "a"}, ids)
+
+	none, err := reopened.store.ListInferences(ctx, InferenceFilter{Requester: "gonka1other"}, Page{})
+	require.NoError(t, err)
+	require.Empty(t, none)
+}
+
+func TestIndexerSkipsBlocksTheNodePruned(t *testing.T) {
+	env := newTestEnv(t, filepath.Join(t.TempDir(), "indexer.db"))
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		env.chain.add(fakeBlock{})
+	}
+	env.indexer.startHeight = 2
+	require.NoError(t, env.indexer.CatchUp(ctx))
+
+	env.chain.earliest = 10
+	for i := 0; i < 8; i++ {
+		env.chain.add(fakeBlock{})
+	}
+	require.NoError(t, env.indexer.CatchUp(ctx))
+	height, err := env.store.LastHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), height)
+}
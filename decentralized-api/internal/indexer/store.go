@@ -0,0 +1,593 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"decentralized-api/apiconfig"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// migrations lists every schema change of the indexer database. Append only.
+var migrations = []apiconfig.Migration{
+	{
+		Version: 1,
+		Name:    "initial_schema",
+		Up: execMigration(`
+CREATE TABLE inferences (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  inference_id TEXT NOT NULL UNIQUE,
+  requester TEXT NOT NULL DEFAULT '',
+  executor TEXT NOT NULL DEFAULT '',
+  transfer_agent TEXT NOT NULL DEFAULT '',
+  model TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL,
+  prompt_token_count INTEGER NOT NULL DEFAULT 0,
+  completion_token_count INTEGER NOT NULL DEFAULT 0,
+  start_height INTEGER NOT NULL DEFAULT 0,
+  start_time TEXT NOT NULL DEFAULT '',
+  finish_height INTEGER NOT NULL DEFAULT 0,
+  finish_time TEXT NOT NULL DEFAULT '',
+  updated_height INTEGER NOT NULL
+);
+CREATE INDEX idx_inferences_requester ON inferences(requester, id);
+CREATE INDEX idx_inferences_executor ON inferences(executor, id);
+CREATE INDEX idx_inferences_model ON inferences(model, id);
+CREATE INDEX idx_inferences_status ON inferences(status, id);
+
+CREATE TABLE validations (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  inference_id TEXT NOT NULL,
+  validator TEXT NOT NULL,
+  value REAL NOT NULL,
+  revalidation INTEGER NOT NULL,
+  passed INTEGER NOT NULL,
+  needs_revalidation INTEGER NOT NULL,
+  height INTEGER NOT NULL,
+  time TEXT NOT NULL,
+  tx_hash TEXT NOT NULL
+);
+CREATE INDEX idx_validations_inference ON validations(inference_id, id);
+CREATE INDEX idx_validations_validator ON validations(validator, id);
+
+CREATE TABLE reward_payouts (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  participant TEXT NOT NULL,
+  epoch_index INTEGER NOT NULL,
+  amount INTEGER NOT NULL,
+  result TEXT NOT NULL,
+  height INTEGER NOT NULL,
+  time TEXT NOT NULL,
+  tx_hash TEXT NOT NULL
+);
+CREATE INDEX idx_reward_payouts_participant ON reward_payouts(participant, id);
+CREATE INDEX idx_reward_payouts_epoch ON reward_payouts(epoch_index, id);
+
+CREATE TABLE epoch_performance (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  epoch_index INTEGER NOT NULL,
+  participant TEXT NOT NULL,
+  inference_count INTEGER NOT NULL,
+  missed_requests INTEGER NOT NULL,
+  earned_coins INTEGER NOT NULL,
+  rewarded_coins INTEGER NOT NULL,
+  burned_coins INTEGER NOT NULL,
+  validated_inferences INTEGER NOT NULL,
+  invalidated_inferences INTEGER NOT NULL,
+  claimed INTEGER NOT NULL,
+  UNIQUE (epoch_index, participant)
+);
+CREATE INDEX idx_epoch_performance_participant ON epoch_performance(participant, id);
+
+CREATE TABLE indexer_state (
+  key TEXT PRIMARY KEY,
+  value INTEGER NOT NULL
+);
+`),
+	},
+}
+
+func execMigration(statements string) func(ctx context.Context, tx *sql.Tx) error {
+	return func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, statements)
+		return err
+	}
+}
+
+const (
+	stateLastHeight       = "last_height"
+	statePerformanceEpoch = "performance_epoch"
+
+	DefaultPageLimit = 100
+	MaxPageLimit     = 1000
+)
+
+var (
+	statusStarted   = types.InferenceStatus_STARTED.String()
+	statusFinished  = types.InferenceStatus_FINISHED.String()
+	statusValidated = types.InferenceStatus_VALIDATED.String()
+	statusVoting    = types.InferenceStatus_VOTING.String()
+)
+
+// Store is the SQLite database the indexer writes and the history endpoints read
+type Store struct {
+	db *sql.DB
+}
+
+// OpenStore opens the indexer database at path and brings its schema up to date
+func OpenStore(ctx context.Context, path string) (*Store, error) {
+	db, err := apiconfig.OpenSQLite(apiconfig.SqliteConfig{Path: path})
+	if err != nil {
+		return nil, err
+	}
+	if err := apiconfig.MigrateSchemaWith(ctx, db, path, migrations); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return &Store{db: db}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+type InferenceStart struct {
+	InferenceId      string
+	Requester        string
+	TransferAgent    string
+	AssignedTo       string
+	Model            string
+	PromptTokenCount uint64
+}
+
+type InferenceFinish struct {
+	InferenceId          string
+	Requester            string
+	TransferAgent        string
+	Executor             string
+	Model                string
+	PromptTokenCount     uint64
+	CompletionTokenCount uint64
+}
+
+// InferenceStatusChange is a status set by the chain outside of the start, finish and validation messages
+type InferenceStatusChange struct {
+	InferenceId string
+	Status      string
+}
+
+type Validation struct {
+	Id                int64   `json:"id"`
+	InferenceId       string  `json:"inference_id"`
+	Validator         string  `json:"validator"`
+	Value             float64 `json:"value"`
+	Revalidation      bool    `json:"revalidation"`
+	Passed            bool    `json:"passed"`
+	NeedsRevalidation bool    `json:"needs_revalidation"`
+	Height            int64   `json:"height"`
+	Time              string  `json:"time"`
+	TxHash            string  `json:"tx_hash"`
+}
+
+type RewardPayout struct {
+	Id          int64  `json:"id"`
+	Participant string `json:"participant"`
+	EpochIndex  uint64 `json:"epoch_index"`
+	Amount      uint64 `json:"amount"`
+	Result      string `json:"result"`
+	Height      int64  `json:"height"`
+	Time        string `json:"time"`
+	TxHash      string `json:"tx_hash"`
+}
+
+type Inference struct {
+	Id                   int64  `json:"id"`
+	InferenceId          string `json:"inference_id"`
+	Requester            string `json:"requester"`
+	Executor             string `json:"executor"`
+	TransferAgent        string `json:"transfer_agent"`
+	Model                string `json:"model"`
+	Status               string `json:"status"`
+	PromptTokenCount     uint64 `json:"prompt_token_count"`
+	CompletionTokenCount uint64 `json:"completion_token_count"`
+	StartHeight          int64  `json:"start_height"`
+	StartTime            string `json:"start_time"`
+	FinishHeight         int64  `json:"finish_height"`
+	FinishTime           string `json:"finish_time"`
+	UpdatedHeight        int64  `json:"updated_height"`
+}
+
+type EpochPerformance struct {
+	Id                    int64  `json:"id"`
+	EpochIndex            uint64 `json:"epoch_index"`
+	Participant           string `json:"participant"`
+	InferenceCount        uint64 `json:"inference_count"`
+	MissedRequests        uint64 `json:"missed_requests"`
+	EarnedCoins           uint64 `json:"earned_coins"`
+	RewardedCoins         uint64 `json:"rewarded_coins"`
+	BurnedCoins           uint64 `json:"burned_coins"`
+	ValidatedInferences   uint64 `json:"validated_inferences"`
+	InvalidatedInferences uint64 `json:"invalidated_inferences"`
+	Claimed               bool   `json:"claimed"`
+}
+
+// BlockChanges is everything the indexer extracted from one block. ApplyBlock writes them kind by kind, which
+// the upserts make safe for a start and finish landing in the same block.
+type BlockChanges struct {
+	Height        int64
+	Time          time.Time
+	Starts        []InferenceStart
+	Finishes      []InferenceFinish
+	Validations   []Validation
+	Payouts       []RewardPayout
+	StatusChanges []InferenceStatusChange
+}
+
+// LastHeight is the last block applied, 0 for an empty database
+func (s *Store) LastHeight(ctx context.Context) (int64, error) {
+	return s.getState(ctx, stateLastHeight)
+}
+
+// PerformanceEpoch is the current epoch at the last performance sync
+func (s *Store) PerformanceEpoch(ctx context.Context) (uint64, error) {
+	epoch, err := s.getState(ctx, statePerformanceEpoch)
+	return uint64(epoch), err
+}
+
+func (s *Store) getState(ctx context.Context, key string) (int64, error) {
+	var value int64
+	err := s.db.QueryRowContext(ctx, `SELECT value FROM indexer_state WHERE key = ?`, key).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return value, err
+}
+
+func setState(ctx context.Context, tx *sql.Tx, key string, value int64) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO indexer_state (key, value) VALUES (?, ?)
+ON CONFLICT(key) DO UPDATE SET value = excluded.value`, key, value)
+	return err
+}
+
+// ApplyBlock writes the changes of a block and advances the cursor to its height in one transaction,
+// so a block is never applied twice or half
+func (s *Store) ApplyBlock(ctx context.Context, block BlockChanges) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	blockTime := block.Time.UTC().Format(time.RFC3339Nano)
+	for _, start := range block.Starts {
+		// Start and finish can land in either order, so neither overwrites what the other already set
+		_, err := tx.ExecContext(ctx, `INSERT INTO inferences
+  (inference_id, requester, transfer_agent, executor, model, status, prompt_token_count, start_height, start_time, updated_height)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(inference_id) DO UPDATE SET
+  requester = CASE WHEN inferences.requester = '' THEN excluded.requester ELSE inferences.requester END,
+  transfer_agent = CASE WHEN inferences.transfer_agent = '' THEN excluded.transfer_agent ELSE inferences.transfer_agent END,
+  executor = CASE WHEN inferences.executor = '' THEN excluded.executor ELSE inferences.executor END,
+  model = CASE WHEN inferences.model = '' THEN excluded.model ELSE inferences.model END,
+  prompt_token_count = CASE WHEN inferences.prompt_token_count = 0 THEN excluded.prompt_token_count ELSE inferences.prompt_token_count END,
+  start_height = excluded.start_height,
+  start_time = excluded.start_time,
+  updated_height = excluded.updated_height`,
+			start.InferenceId, start.Requester, start.TransferAgent, start.AssignedTo, start.Model, statusStarted,
+			start.PromptTokenCount, block.Height, blockTime, block.Height)
+		if err != nil {
+			return fmt.Errorf("inference start %s: %w", start.InferenceId, err)
+		}
+	}
+	for _, finish := range block.Finishes {
+		_, err := tx.ExecContext(ctx, `INSERT INTO inferences
+  (inference_id, requester, transfer_agent, executor, model, status, prompt_token_count, completion_token_count, finish_height, finish_time, updated_height)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(inference_id) DO UPDATE SET
+  requester = CASE WHEN inferences.requester = '' THEN excluded.requester ELSE inferences.requester END,
+  transfer_agent = CASE WHEN inferences.transfer_agent = '' THEN excluded.transfer_agent ELSE inferences.transfer_agent END,
+  executor = excluded.executor,
+  model = CASE WHEN inferences.model = '' THEN excluded.model ELSE inferences.model END,
+  prompt_token_count = excluded.prompt_token_count,
+  completion_token_count = excluded.completion_token_count,
+  status = CASE WHEN inferences.status = ? THEN excluded.status ELSE inferences.status END,
+  finish_height = excluded.finish_height,
+  finish_time = excluded.finish_time,
+  updated_height = excluded.updated_height`,
+			finish.InferenceId, finish.Requester, finish.TransferAgent, finish.Executor, finish.Model, statusFinished,
+			finish.PromptTokenCount, finish.CompletionTokenCount, block.Height, blockTime, block.Height, statusStarted)
+		if err != nil {
+			return fmt.Errorf("inference finish %s: %w", finish.InferenceId, err)
+		}
+	}
+	for _, validation := range block.Validations {
+		_, err := tx.ExecContext(ctx, `INSERT INTO validations
+  (inference_id, validator, value, revalidation, passed, needs_revalidation, height, time, tx_hash)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			validation.InferenceId, validation.Validator, validation.Value, validation.Revalidation, validation.Passed,
+			validation.NeedsRevalidation, block.Height, blockTime, validation.TxHash)
+		if err != nil {
+			return fmt.Errorf("validation of %s: %w", validation.InferenceId, err)
+		}
+		// Mirrors the transitions of MsgValidation. Votes end in an inference_status event.
+		if validation.Revalidation {
+			continue
+		}
+		if validation.Passed {
+			err = updateStatus(ctx, tx, validation.InferenceId, statusValidated, block.Height, statusStarted, statusFinished)
+		} else if validation.NeedsRevalidation {
+			err = updateStatus(ctx, tx, validation.InferenceId, statusVoting, block.Height, statusStarted, statusFinished)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, change := range block.StatusChanges {
+		if err := updateStatus(ctx, tx, change.InferenceId, change.Status, block.Height); err != nil {
+			return err
+		}
+	}
+	for _, payout := range block.Payouts {
+		_, err := tx.ExecContext(ctx, `INSERT INTO reward_payouts
+  (participant, epoch_index, amount, result, height, time, tx_hash)
+VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			payout.Participant, payout.EpochIndex, payout.Amount, payout.Result, block.Height, blockTime, payout.TxHash)
+		if err != nil {
+			return fmt.Errorf("reward payout to %s: %w", payout.Participant, err)
+		}
+		if payout.Amount > 0 {
+			_, err = tx.ExecContext(ctx, `UPDATE epoch_performance SET claimed = 1 WHERE epoch_index = ? AND participant = ?`,
+				payout.EpochIndex, payout.Participant)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if err := setState(ctx, tx, stateLastHeight, block.Height); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// updateStatus sets the status of an inference, only if its current status is one of from when from is given
+func updateStatus(ctx context.Context, tx *sql.Tx, inferenceId string, status string, height int64, from ...string) error {
+	query := `UPDATE inferences SET status = ?, updated_height = ? WHERE inference_id = ?`
+	args := []any{status, height, inferenceId}
+	if len(from) > 0 {
+		query += ` AND status IN (` + strings.TrimSuffix(strings.Repeat("?, ", len(from)), ", ") + `)`
+		for _, f := range from {
+			args = append(args, f)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("status of %s: %w", inferenceId, err)
+	}
+	return nil
+}
+
+// ReplaceEpochPerformance stores the performance summaries read from the chain and records currentEpoch as synced
+func (s *Store) ReplaceEpochPerformance(ctx context.Context, summaries []types.EpochPerformanceSummary, currentEpoch uint64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, summary := range summaries {
+		_, err := tx.ExecContext(ctx, `INSERT INTO epoch_performance
+  (epoch_index, participant, inference_count, missed_requests, earned_coins, rewarded_coins, burned_coins,
+   validated_inferences, invalidated_inferences, claimed)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(epoch_index, participant) DO UPDATE SET
+  inference_count = excluded.inference_count,
+  missed_requests = excluded.missed_requests,
+  earned_coins = excluded.earned_coins,
+  rewarded_coins = excluded.rewarded_coins,
+  burned_coins = excluded.burned_coins,
+  validated_inferences = excluded.validated_inferences,
+  invalidated_inferences = excluded.invalidated_inferences,
+  claimed = MAX(epoch_performance.claimed, excluded.claimed)`,
+			summary.EpochIndex, summary.ParticipantId, summary.InferenceCount, summary.MissedRequests, summary.EarnedCoins,
+			summary.RewardedCoins, summary.BurnedCoins, summary.ValidatedInferences, summary.InvalidatedInferences, summary.Claimed)
+		if err != nil {
+			return fmt.Errorf("performance of %s in epoch %d: %w", summary.ParticipantId, summary.EpochIndex, err)
+		}
+	}
+	if err := setState(ctx, tx, statePerformanceEpoch, int64(currentEpoch)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Page selects rows newest first. Before is the id of the last row of the previous page, 0 for the first page.
+type Page struct {
+	Limit  int
+	Before int64
+}
+
+func (p Page) limit() int {
+	if p.Limit <= 0 {
+		return DefaultPageLimit
+	}
+	return min(p.Limit, MaxPageLimit)
+}
+
+// columnFilter is an equality condition on a column. Empty values are ignored.
+type columnFilter struct {
+	Column string
+	Value  any
+}
+
+type InferenceFilter struct {
+	Requester string
+	Executor  string
+	Model     string
+	Status    string
+}
+
+type ValidationFilter struct {
+	InferenceId string
+	Validator   string
+}
+
+type RewardFilter struct {
+	Participant string
+	EpochIndex  *uint64
+}
+
+type PerformanceFilter struct {
+	Participant string
+	EpochIndex  *uint64
+}
+
+// pageQuery builds the WHERE, ORDER and LIMIT clauses shared by all list queries
+func pageQuery(filters []columnFilter, page Page) (string, []any) {
+	var conditions []string
+	var args []any
+	for _, f := range filters {
+		if s, ok := f.Value.(string); ok && s == "" {
+			continue
+		}
+		if f.Value == nil {
+			continue
+		}
+		conditions = append(conditions, f.Column+" = ?")
+		args = append(args, f.Value)
+	}
+	if page.Before > 0 {
+		conditions = append(conditions, "id < ?")
+		args = append(args, page.Before)
+	}
+	clause := ""
+	if len(conditions) > 0 {
+		clause = " WHERE " + strings.Join(conditions, " AND ")
+	}
+	clause += " ORDER BY id DESC LIMIT ?"
+	args = append(args, page.limit())
+	return clause, args
+}
+
+func optionalEpoch(epoch *uint64) any {
+	if epoch == nil {
+		return nil
+	}
+	return *epoch
+}
+
+const inferenceColumns = `id, inference_id, requester, executor, transfer_agent, model, status, prompt_token_count,
+  completion_token_count, start_height, start_time, finish_height, finish_time, updated_height`
+
+func scanInference(row interface{ Scan(...any) error }) (Inference, error) {
+	var i Inference
+	err := row.Scan(&i.Id, &i.InferenceId, &i.Requester, &i.Executor, &i.TransferAgent, &i.Model, &i.Status,
+		&i.PromptTokenCount, &i.CompletionTokenCount, &i.StartHeight, &i.StartTime, &i.FinishHeight, &i.FinishTime,
+		&i.UpdatedHeight)
+	return i, err
+}
+
+func (s *Store) ListInferences(ctx context.Context, filter InferenceFilter, page Page) ([]Inference, error) {
+	clause, args := pageQuery([]columnFilter{
+		{Column: "requester", Value: filter.Requester},
+		{Column: "executor", Value: filter.Executor},
+		{Column: "model", Value: filter.Model},
+		{Column: "status", Value: filter.Status},
+	}, page)
+	rows, err := s.db.QueryContext(ctx, `SELECT `+inferenceColumns+` FROM inferences`+clause, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := []Inference{}
+	for rows.Next() {
+		inference, err := scanInference(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, inference)
+	}
+	return result, rows.Err()
+}
+
+// GetInference returns the indexed inference, false if it was never indexed
+func (s *Store) GetInference(ctx context.Context, inferenceId string) (Inference, bool, error) {
+	inference, err := scanInference(s.db.QueryRowContext(ctx, `SELECT `+inferenceColumns+` FROM inferences WHERE inference_id = ?`, inferenceId))
+	if errors.Is(err, sql.ErrNoRows) {
+		return Inference{}, false, nil
+	}
+	if err != nil {
+		return Inference{}, false, err
+	}
+	return inference, true, nil
+}
+
+func (s *Store) ListValidations(ctx context.Context, filter ValidationFilter, page Page) ([]Validation, error) {
+	clause, args := pageQuery([]columnFilter{
+		{Column: "inference_id", Value: filter.InferenceId},
+		{Column: "validator", Value: filter.Validator},
+	}, page)
+	rows, err := s.db.QueryContext(ctx, `SELECT id, inference_id, validator, value, revalidation, passed, needs_revalidation,
+  height, time, tx_hash FROM validations`+clause, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := []Validation{}
+	for rows.Next() {
+		var v Validation
+		if err := rows.Scan(&v.Id, &v.InferenceId, &v.Validator, &v.Value, &v.Revalidation, &v.Passed, &v.NeedsRevalidation,
+			&v.Height, &v.Time, &v.TxHash); err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+	return result, rows.Err()
+}
+
+func (s *Store) ListRewardPayouts(ctx context.Context, filter RewardFilter, page Page) ([]RewardPayout, error) {
+	clause, args := pageQuery([]columnFilter{
+		{Column: "participant", Value: filter.Participant},
+		{Column: "epoch_index", Value: optionalEpoch(filter.EpochIndex)},
+	}, page)
+	rows, err := s.db.QueryContext(ctx, `SELECT id, participant, epoch_index, amount, result, height, time, tx_hash
+FROM reward_payouts`+clause, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := []RewardPayout{}
+	for rows.Next() {
+		var p RewardPayout
+		if err := rows.Scan(&p.Id, &p.Participant, &p.EpochIndex, &p.Amount, &p.Result, &p.Height, &p.Time, &p.TxHash); err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+	return result, rows.Err()
+}
+
+func (s *Store) ListEpochPerformance(ctx context.Context, filter PerformanceFilter, page Page) ([]EpochPerformance, error) {
+	clause, args := pageQuery([]columnFilter{
+		{Column: "participant", Value: filter.Participant},
+		{Column: "epoch_index", Value: optionalEpoch(filter.EpochIndex)},
+	}, page)
+	rows, err := s.db.QueryContext(ctx, `SELECT id, epoch_index, participant, inference_count, missed_requests, earned_coins,
+  rewarded_coins, burned_coins, validated_inferences, invalidated_inferences, claimed FROM epoch_performance`+clause, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := []EpochPerformance{}
+	for rows.Next() {
+		var p EpochPerformance
+		if err := rows.Scan(&p.Id, &p.EpochIndex, &p.Participant, &p.InferenceCount, &p.MissedRequests, &p.EarnedCoins,
+			&p.RewardedCoins, &p.BurnedCoins, &p.ValidatedInferences, &p.InvalidatedInferences, &p.Claimed); err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+	return result, rows.Err()
+}
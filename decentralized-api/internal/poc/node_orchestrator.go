@@ -36,7 +36,6 @@ type OrchestratorChainBridge interface {
 
 type OrchestratorChainBridgeImpl struct {
 	cosmosClient cosmos_client.CosmosMessageClient
-	chainNodeUrl string
 }
 
 func (b *OrchestratorChainBridgeImpl) PoCBatchesForStage(startPoCBlockHeight int64) (*types.QueryPocBatchesForStageResponse, error) {
@@ -59,12 +58,7 @@ func (b *OrchestratorChainBridgeImpl) GetPocParams() (*types.PocParams, error) {
 }
 
 func (b *OrchestratorChainBridgeImpl) GetBlockHash(height int64) (string, error) {
-	client, err := cosmos_client.NewRpcClient(b.chainNodeUrl)
-	if err != nil {
-		return "", err
-	}
-
-	block, err := client.Block(context.Background(), &height)
+	block, err := b.cosmosClient.GetClientContext().Client.Block(context.Background(), &height)
 	if err != nil {
 		return "", err
 	}
@@ -72,14 +66,13 @@ func (b *OrchestratorChainBridgeImpl) GetBlockHash(height int64) (string, error)
 	return block.Block.Hash().String(), err
 }
 
-func NewNodePoCOrchestratorForCosmosChain(pubKey string, nodeBroker *broker.Broker, callbackUrl string, chainNodeUrl string, cosmosClient cosmos_client.CosmosMessageClient, phaseTracker *chainphase.ChainPhaseTracker) NodePoCOrchestrator {
+func NewNodePoCOrchestratorForCosmosChain(pubKey string, nodeBroker *broker.Broker, callbackUrl string, cosmosClient cosmos_client.CosmosMessageClient, phaseTracker *chainphase.ChainPhaseTracker) NodePoCOrchestrator {
 	return &NodePoCOrchestratorImpl{
 		pubKey:      pubKey,
 		nodeBroker:  nodeBroker,
 		callbackUrl: callbackUrl,
 		chainBridge: &OrchestratorChainBridgeImpl{
 			cosmosClient: cosmosClient,
-			chainNodeUrl: chainNodeUrl,
 		},
 		phaseTracker: phaseTracker,
 	}
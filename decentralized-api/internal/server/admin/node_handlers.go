@@ -5,6 +5,8 @@ import (
 	"decentralized-api/broker"
 	"decentralized-api/logging"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -238,3 +240,97 @@ func (s *Server) exportDb(c echo.Context) error {
 	}
 	return c.JSON(http.StatusOK, payload)
 }
+
+// importDb restores nodes, seeds and KV entries from a dump produced by exportDb
+func (s *Server) importDb(c echo.Context) error {
+	ctx := c.Request().Context()
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	dump, err := apiconfig.ParseDbDump(body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	options := apiconfig.ImportOptions{
+		Mode:   apiconfig.ImportMode(c.QueryParam("mode")),
+		DryRun: c.QueryParam("dry_run") == "true",
+	}
+	report, err := s.configManager.ImportDb(ctx, dump, options)
+	if err != nil {
+		logging.Error("Failed to import DB state", types.Nodes, "error", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if report.Applied {
+		logging.Info("Imported DB state, reloading nodes", types.Nodes, "changes", len(report.Changes))
+		if err := s.reloadNodesFromConfig(); err != nil {
+			logging.Error("Failed to reload nodes after import", types.Nodes, "error", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+// reloadNodesFromConfig makes the broker match the nodes of the config, e.g. after an import
+func (s *Server) reloadNodesFromConfig() error {
+	current, err := s.nodeBroker.GetNodes()
+	if err != nil {
+		return err
+	}
+	wanted := make(map[string]bool)
+	for _, node := range s.configManager.GetNodes() {
+		wanted[node.Id] = true
+	}
+	for _, n := range current {
+		if wanted[n.Node.Id] {
+			continue
+		}
+		response := make(chan bool, 2)
+		if err := s.nodeBroker.QueueMessage(broker.RemoveNode{NodeId: n.Node.Id, Response: response}); err != nil {
+			return err
+		}
+		<-response
+	}
+
+	existing := make(map[string]bool)
+	for _, n := range current {
+		existing[n.Node.Id] = true
+	}
+	for _, node := range s.configManager.GetNodes() {
+		if existing[node.Id] {
+			command := broker.NewUpdateNodeCommand(node)
+			if err := s.nodeBroker.QueueMessage(command); err != nil {
+				return err
+			}
+			if <-command.Response == nil {
+				return fmt.Errorf("failed to update node %s", node.Id)
+			}
+			continue
+		}
+		response := make(chan *apiconfig.InferenceNodeConfig, 2)
+		if err := s.nodeBroker.QueueMessage(broker.RegisterNode{Node: node, Response: response}); err != nil {
+			return err
+		}
+		if <-response == nil {
+			return fmt.Errorf("failed to register node %s", node.Id)
+		}
+	}
+	syncNodesWithConfig(s.nodeBroker, s.configManager)
+	return nil
+}
+
+// getDbMigrations returns the applied and pending SQLite schema migrations
+func (s *Server) getDbMigrations(c echo.Context) error {
+	ctx := c.Request().Context()
+	db := s.configManager.SqlDb()
+	if db == nil || db.GetDb() == nil {
+		logging.Error("DB not initialized", types.Nodes)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "db not initialized"})
+	}
+	status, err := apiconfig.GetSchemaStatus(ctx, db.GetDb())
+	if err != nil {
+		logging.Error("Failed to read schema status", types.Nodes, "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, status)
+}
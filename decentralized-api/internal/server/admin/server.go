@@ -1,11 +1,17 @@
 package admin
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"decentralized-api/apiconfig"
 	"decentralized-api/broker"
 	cosmos_client "decentralized-api/cosmosclient"
+	"decentralized-api/internal/event_listener/chainevents"
 	"decentralized-api/internal/server/middleware"
 	"decentralized-api/internal/validation"
+	"fmt"
+	"net/http"
+	"os"
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 	blstypes "github.com/productscience/inference/x/bls/types"
@@ -27,17 +33,28 @@ type Server struct {
 	nodeBroker    *broker.Broker
 	configManager *apiconfig.ConfigManager
 	recorder      cosmos_client.CosmosMessageClient
+	endpoints     *cosmos_client.EndpointPool
 	validator     *validation.InferenceValidator
 	cdc           *codec.ProtoCodec
+	typedEvents   *chainevents.Registry
+	authConfig    apiconfig.AdminAuthConfig
 }
 
 func NewServer(
 	recorder cosmos_client.CosmosMessageClient,
 	nodeBroker *broker.Broker,
 	configManager *apiconfig.ConfigManager,
-	validator *validation.InferenceValidator) *Server {
+	validator *validation.InferenceValidator,
+	typedEvents *chainevents.Registry,
+	endpoints *cosmos_client.EndpointPool) (*Server, error) {
 	cdc := getCodec()
 
+	authConfig := configManager.GetApiConfig().AdminAuth
+	auth, err := middleware.NewAdminAuthenticator(authConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	e := echo.New()
 	e.HTTPErrorHandler = middleware.TransparentErrorHandler
 	s := &Server{
@@ -45,47 +62,65 @@ func NewServer(
 		nodeBroker:    nodeBroker,
 		configManager: configManager,
 		recorder:      recorder,
+		endpoints:     endpoints,
 		validator:     validator,
 		cdc:           cdc,
+		typedEvents:   typedEvents,
+		authConfig:    authConfig,
 	}
 
 	e.Use(middleware.LoggingMiddleware)
-	g := e.Group("/admin/v1/")
+	e.Use(auth.Audit)
+	g := e.Group("/admin/v1/", auth.Authenticate)
 
-	g.POST("nodes", s.createNewNode)
-	g.POST("nodes/batch", s.createNewNodes)
+	readOnly := middleware.Require(middleware.ScopeReadOnly)
+	nodeOperator := middleware.Require(middleware.ScopeNodeOperator)
+	// Everything that signs transactions with the participant's keys
+	treasury := middleware.Require(middleware.ScopeTreasury)
+
+	g.POST("nodes", s.createNewNode, nodeOperator)
+	g.POST("nodes/batch", s.createNewNodes, nodeOperator)
 	// For explicit updates, also allow PUT on a single node
-	g.PUT("nodes/:id", s.createNewNode)
-	g.GET("nodes/upgrade-status", s.getUpgradeStatus)
-	g.POST("nodes/version-status", s.postVersionStatus)
-	g.GET("nodes", s.getNodes)
-	g.DELETE("nodes/:id", s.deleteNode)
-	g.POST("nodes/:id/enable", s.enableNode)
-	g.POST("nodes/:id/disable", s.disableNode)
+	g.PUT("nodes/:id", s.createNewNode, nodeOperator)
+	g.GET("nodes/upgrade-status", s.getUpgradeStatus, readOnly)
+	g.POST("nodes/version-status", s.postVersionStatus, nodeOperator)
+	g.GET("nodes", s.getNodes, readOnly)
+	g.DELETE("nodes/:id", s.deleteNode, nodeOperator)
+	g.POST("nodes/:id/enable", s.enableNode, nodeOperator)
+	g.POST("nodes/:id/disable", s.disableNode, nodeOperator)
+
+	g.POST("unit-of-compute-price-proposal", s.postUnitOfComputePriceProposal, treasury)
+	g.GET("unit-of-compute-price-proposal", s.getUnitOfComputePriceProposal, readOnly)
 
-	g.POST("unit-of-compute-price-proposal", s.postUnitOfComputePriceProposal)
-	g.GET("unit-of-compute-price-proposal", s.getUnitOfComputePriceProposal)
+	g.POST("models", s.registerModel, treasury)
+	g.POST("tx/send", s.sendTransaction, treasury)
 
-	g.POST("models", s.registerModel)
-	g.POST("tx/send", s.sendTransaction)
+	g.POST("bls/request", s.postRequestThresholdSignature, treasury)
 
-	g.POST("bls/request", s.postRequestThresholdSignature)
+	g.POST("debug/create-dummy-training-task", s.postDummyTrainingTask, treasury)
 
-	g.POST("debug/create-dummy-training-task", s.postDummyTrainingTask)
+	// Export DB state (human-readable JSON) for admin purposes. Contains the ML worker key, so it needs treasury.
+	g.GET("export/db", s.exportDb, treasury)
+	// Restore an export, see apiconfig.ImportDb. Query params: mode=merge|replace, dry_run=true
+	// The report returns the rows it changes, ML worker key included, and an import can replace that key
+	g.POST("import/db", s.importDb, treasury)
 
-	// Export DB state (human-readable JSON) for admin purposes
-	g.GET("export/db", s.exportDb)
+	// Applied and pending SQLite schema migrations
+	g.GET("db/migrations", s.getDbMigrations, readOnly)
 
-	// Return current unsanitized config as JSON
-	g.GET("config", s.getConfig)
+	// Counters of the typed chain event handlers
+	g.GET("event-handlers", s.getEventHandlerStats, readOnly)
+
+	// Return current config as JSON, with credentials redacted
+	g.GET("config", s.getConfig, nodeOperator)
 
 	// Manual validation recovery and claim endpoint
-	g.POST("claim-reward/recover", s.postClaimRewardRecover)
+	g.POST("claim-reward/recover", s.postClaimRewardRecover, treasury)
 
 	// EXPERIMENTAL: Setup and health report endpoint for participant onboarding
-	g.GET("setup/report", s.getSetupReport)
+	g.GET("setup/report", s.getSetupReport, readOnly)
 
-	return s
+	return s, nil
 }
 
 func getCodec() *codec.ProtoCodec {
@@ -103,12 +138,58 @@ func getCodec() *codec.ProtoCodec {
 	return cdc
 }
 
-func (s *Server) Start(addr string) {
-	go s.e.Start(addr)
+// Start serves plain HTTP, or HTTPS if a certificate is configured. With a client CA configured,
+// clients must present a certificate signed by it (mTLS).
+func (s *Server) Start(addr string) error {
+	if s.authConfig.TLSCertFile == "" {
+		go s.e.Start(addr)
+		return nil
+	}
+	tlsConfig, err := s.tlsConfig()
+	if err != nil {
+		return err
+	}
+	server := &http.Server{Addr: addr, TLSConfig: tlsConfig}
+	go s.e.StartServer(server)
+	return nil
 }
 
-// getConfig returns the current configuration as JSON (unsanitized)
+func (s *Server) tlsConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(s.authConfig.TLSCertFile, s.authConfig.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load admin TLS certificate: %w", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if s.authConfig.ClientCAFile != "" {
+		caPem, err := os.ReadFile(s.authConfig.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read admin client CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPem) {
+			return nil, fmt.Errorf("no certificates found in %s", s.authConfig.ClientCAFile)
+		}
+		tlsConfig.ClientCAs = pool
+		// Token callers stay possible next to certificate callers, so certificates are verified but optional
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return tlsConfig, nil
+}
+
+// getConfig returns the current configuration as JSON. Credentials are redacted, so a node-operator
+// caller cannot read the tokens of higher scopes.
 func (s *Server) getConfig(c echo.Context) error {
-	cfg := s.configManager.GetConfig()
+	cfg := s.configManager.GetConfig().Redacted()
 	return c.JSONPretty(200, cfg, "  ")
 }
+
+// getEventHandlerStats returns the counters of the typed chain event handlers
+func (s *Server) getEventHandlerStats(c echo.Context) error {
+	if s.typedEvents == nil {
+		return c.JSON(200, []chainevents.HandlerStats{})
+	}
+	return c.JSON(200, s.typedEvents.Stats())
+}
@@ -57,7 +57,8 @@ func setupTestServer(t *testing.T) (*Server, *apiconfig.ConfigManager, *mlnodecl
 	assert.NoError(t, err)
 	t.Cleanup(func() { os.Remove(tmpFile.Name()) })
 
-	_, err = tmpFile.Write([]byte("nodes: []"))
+	// The tests call node-operator endpoints without credentials
+	_, err = tmpFile.Write([]byte("nodes: []\napi:\n  admin_auth:\n    allow_unauthenticated: true\n"))
 	assert.NoError(t, err)
 	tmpFile.Close()
 
@@ -73,7 +74,7 @@ func setupTestServer(t *testing.T) (*Server, *apiconfig.ConfigManager, *mlnodecl
 	mockQueryClient := &mockInferenceQueryClient{}
 	mockQueryClient.On("ModelsAll", mock.Anything, mock.Anything).Return(&types.QueryModelsAllResponse{Model: []types.Model{}}, nil)
 	mockCosmos.On("NewInferenceQueryClient").Return(mockQueryClient)
-	bridge := broker.NewBrokerChainBridgeImpl(mockCosmos, "")
+	bridge := broker.NewBrokerChainBridgeImpl(mockCosmos)
 	mockParticipant := &mockParticipantInfo{}
 	mockClientFactory := mlnodeclient.NewMockClientFactory()
 
@@ -84,7 +85,8 @@ func setupTestServer(t *testing.T) (*Server, *apiconfig.ConfigManager, *mlnodecl
 	nodeBroker := broker.NewBroker(bridge, nil, mockParticipant, "", mockClientFactory, configManager)
 
 	// 4. Server
-	s := NewServer(mockCosmos, nodeBroker, configManager, nil)
+	s, err := NewServer(mockCosmos, nodeBroker, configManager, nil, nil, nil)
+	assert.NoError(t, err)
 
 	return s, configManager, mockClientFactory
 }
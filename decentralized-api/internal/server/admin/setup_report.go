@@ -318,9 +318,7 @@ func (s *Server) checkPermissions(ctx context.Context) Check {
 func (s *Server) checkConsensusKey(ctx context.Context) []Check {
 	checks := []Check{}
 
-	// Get consensus key from local node
-	chainNodeUrl := s.configManager.GetChainNodeConfig().Url
-	rpcClient, err := cosmosclient.NewRpcClient(chainNodeUrl)
+	rpcClient, err := cosmosclient.NewRpcClient(s.chainNodeUrl())
 	if err != nil {
 		checks = append(checks, Check{
 			ID:      "consensus_key_match",
@@ -330,7 +328,8 @@ func (s *Server) checkConsensusKey(ctx context.Context) []Check {
 		return checks
 	}
 
-	status, err := rpcClient.Status(context.Background())
+	// Get consensus key from local node, which is the primary endpoint. A fallback endpoint has a key of its own.
+	status, err := s.endpoints.Primary().Status(ctx)
 	if err != nil {
 		checks = append(checks, Check{
 			ID:      "consensus_key_match",
@@ -628,18 +627,17 @@ func (s *Server) checkMLNodes(ctx context.Context) []Check {
 	return checks
 }
 
-func (s *Server) checkBlockSync(ctx context.Context) Check {
-	chainNodeUrl := s.configManager.GetChainNodeConfig().Url
-	rpcClient, err := cosmosclient.NewRpcClient(chainNodeUrl)
-	if err != nil {
-		return Check{
-			ID:      "block_sync",
-			Status:  UNAVAILABLE,
-			Message: fmt.Sprintf("Failed to connect to chain node: %s", err.Error()),
-		}
+// chainNodeUrl returns the chain endpoint currently in use
+func (s *Server) chainNodeUrl() string {
+	if s.endpoints != nil {
+		return s.endpoints.Current()
 	}
+	return s.configManager.GetChainNodeConfig().Url
+}
 
-	status, err := rpcClient.Status(context.Background())
+func (s *Server) checkBlockSync(ctx context.Context) Check {
+	// The recorder fails over between the configured chain endpoints
+	status, err := s.recorder.Status(ctx)
 	if err != nil {
 		return Check{
 			ID:      "block_sync",
@@ -673,6 +671,7 @@ func (s *Server) checkBlockSync(ctx context.Context) Check {
 			"latest_height":       latestBlockHeight,
 			"latest_block_time":   latestBlockTime,
 			"seconds_since_block": int(timeSinceLastBlock.Seconds()),
+			"endpoint":            s.chainNodeUrl(),
 		},
 	}
 }
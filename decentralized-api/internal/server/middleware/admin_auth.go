@@ -0,0 +1,276 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+type Scope string
+
+const (
+	// ScopeReadOnly allows reading non-sensitive state
+	ScopeReadOnly Scope = "read-only"
+	// ScopeNodeOperator allows managing ML nodes and the local database
+	ScopeNodeOperator Scope = "node-operator"
+	// ScopeTreasury allows everything that signs and broadcasts transactions with the participant's keys,
+	// and exporting or importing the database, which holds the ML worker key
+	ScopeTreasury Scope = "treasury"
+)
+
+var knownScopes = map[Scope]bool{ScopeReadOnly: true, ScopeNodeOperator: true, ScopeTreasury: true}
+
+const identityContextKey = "admin_identity"
+
+// Identity is the authenticated caller of an admin request.
+type Identity struct {
+	Name   string
+	Method string // "token", "mtls" or "none"
+	Scopes []Scope
+}
+
+// Has reports whether the identity may call an endpoint requiring scope.
+// Every scope implies read-only access.
+func (i Identity) Has(scope Scope) bool {
+	for _, granted := range i.Scopes {
+		if granted == scope || scope == ScopeReadOnly {
+			return true
+		}
+	}
+	return false
+}
+
+type tokenEntry struct {
+	name   string
+	digest [sha256.Size]byte
+	scopes []Scope
+}
+
+// AdminAuthenticator identifies admin API callers by bearer token or mTLS client certificate.
+type AdminAuthenticator struct {
+	tokens               []tokenEntry
+	clientCerts          map[string][]Scope
+	allowUnauthenticated bool
+	audit                *AuditLog
+}
+
+func NewAdminAuthenticator(config apiconfig.AdminAuthConfig) (*AdminAuthenticator, error) {
+	auth := &AdminAuthenticator{clientCerts: make(map[string][]Scope), allowUnauthenticated: config.AllowUnauthenticated}
+	for _, token := range slices.Concat(config.Tokens, config.EnvTokens) {
+		if token.Token == "" {
+			return nil, fmt.Errorf("admin token %q is empty", token.Name)
+		}
+		scopes, err := parseScopes(token.Scopes)
+		if err != nil {
+			return nil, fmt.Errorf("admin token %q: %w", token.Name, err)
+		}
+		auth.tokens = append(auth.tokens, tokenEntry{name: token.Name, digest: sha256.Sum256([]byte(token.Token)), scopes: scopes})
+	}
+	for _, cert := range config.ClientCerts {
+		scopes, err := parseScopes(cert.Scopes)
+		if err != nil {
+			return nil, fmt.Errorf("admin client cert %q: %w", cert.CommonName, err)
+		}
+		auth.clientCerts[cert.CommonName] = scopes
+	}
+	audit, err := NewAuditLog(config.AuditLogPath)
+	if err != nil {
+		return nil, err
+	}
+	auth.audit = audit
+
+	switch {
+	case auth.Enabled():
+	case auth.allowUnauthenticated:
+		logging.Warn("Admin API authentication is disabled by api.admin_auth.allow_unauthenticated: every caller has every scope", types.Server)
+	default:
+		logging.Warn("Admin API has no credentials configured: anonymous callers are read-only, configure api.admin_auth tokens or client certificates", types.Server)
+	}
+	return auth, nil
+}
+
+func parseScopes(values []string) ([]Scope, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no scopes")
+	}
+	scopes := make([]Scope, len(values))
+	for i, value := range values {
+		scope := Scope(value)
+		if !knownScopes[scope] {
+			return nil, fmt.Errorf("unknown scope %q", value)
+		}
+		scopes[i] = scope
+	}
+	return scopes, nil
+}
+
+// Enabled reports whether any credential is configured. Without credentials anonymous callers are read-only,
+// or have every scope if unauthenticated access was allowed explicitly.
+func (a *AdminAuthenticator) Enabled() bool {
+	return len(a.tokens) > 0 || len(a.clientCerts) > 0
+}
+
+// Authenticate resolves the caller identity and rejects unauthenticated requests with 401.
+func (a *AdminAuthenticator) Authenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		identity, ok := a.identify(c.Request())
+		if !ok {
+			c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer realm="admin"`)
+			return echo.NewHTTPError(http.StatusUnauthorized, "missing or invalid credentials")
+		}
+		c.Set(identityContextKey, identity)
+		return next(c)
+	}
+}
+
+func (a *AdminAuthenticator) identify(req *http.Request) (Identity, bool) {
+	if !a.Enabled() {
+		if a.allowUnauthenticated {
+			return Identity{Name: "anonymous", Method: "none", Scopes: []Scope{ScopeNodeOperator, ScopeTreasury}}, true
+		}
+		return Identity{Name: "anonymous", Method: "none", Scopes: []Scope{ScopeReadOnly}}, true
+	}
+	if header := req.Header.Get(echo.HeaderAuthorization); header != "" {
+		token, found := strings.CutPrefix(header, "Bearer ")
+		if !found {
+			return Identity{}, false
+		}
+		digest := sha256.Sum256([]byte(strings.TrimSpace(token)))
+		for _, entry := range a.tokens {
+			if subtle.ConstantTimeCompare(digest[:], entry.digest[:]) == 1 {
+				return Identity{Name: entry.name, Method: "token", Scopes: entry.scopes}, true
+			}
+		}
+		return Identity{}, false
+	}
+	// The TLS handshake already verified the chain against the client CA
+	if req.TLS != nil && len(req.TLS.PeerCertificates) > 0 {
+		commonName := req.TLS.PeerCertificates[0].Subject.CommonName
+		if scopes, ok := a.clientCerts[commonName]; ok {
+			return Identity{Name: commonName, Method: "mtls", Scopes: scopes}, true
+		}
+	}
+	return Identity{}, false
+}
+
+// Require rejects callers lacking scope with 403. Must run after Authenticate.
+func Require(scope Scope) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			identity := GetIdentity(c)
+			if !identity.Has(scope) {
+				logging.Warn("Admin request denied", types.Server, "caller", identity.Name, "path", c.Path(), "required_scope", scope)
+				return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("scope %q required", scope))
+			}
+			return next(c)
+		}
+	}
+}
+
+// GetIdentity returns the caller resolved by Authenticate.
+func GetIdentity(c echo.Context) Identity {
+	identity, _ := c.Get(identityContextKey).(Identity)
+	return identity
+}
+
+// Audit records every mutating request after it was handled, including rejected ones.
+func (a *AdminAuthenticator) Audit(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		method := c.Request().Method
+		if method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions {
+			return next(c)
+		}
+		err := next(c)
+		status := c.Response().Status
+		if err != nil {
+			status, _ = ExtractError(err)
+		}
+		identity := GetIdentity(c)
+		if identity.Name == "" {
+			identity.Name = "unauthenticated"
+		}
+		// The connection address rather than RealIP, whose X-Forwarded-For the caller can set itself
+		entry := AuditEntry{
+			Time:       time.Now().UTC(),
+			Caller:     identity.Name,
+			AuthMethod: identity.Method,
+			Method:     method,
+			Path:       c.Request().URL.Path,
+			Route:      c.Path(),
+			RemoteIP:   remoteHost(c.Request().RemoteAddr),
+			Status:     status,
+		}
+		if err != nil {
+			entry.Error = err.Error()
+		}
+		a.audit.Record(entry)
+		return err
+	}
+}
+
+func remoteHost(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
+
+type AuditEntry struct {
+	Time       time.Time `json:"time"`
+	Caller     string    `json:"caller"`
+	AuthMethod string    `json:"auth_method"`
+	Method     string    `json:"method"`
+	Path       string    `json:"path"`
+	Route      string    `json:"route"`
+	RemoteIP   string    `json:"remote_ip"`
+	Status     int       `json:"status"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// AuditLog writes audit entries to the log and, if configured, appends them to a JSON-lines file.
+type AuditLog struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func NewAuditLog(path string) (*AuditLog, error) {
+	if path == "" {
+		return &AuditLog{}, nil
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open audit log: %w", err)
+	}
+	return &AuditLog{file: file}, nil
+}
+
+func (l *AuditLog) Record(entry AuditEntry) {
+	logging.Info("Admin audit", types.Server, "caller", entry.Caller, "auth", entry.AuthMethod,
+		"method", entry.Method, "path", entry.Path, "status", entry.Status, "remote_ip", entry.RemoteIP)
+	if l.file == nil {
+		return
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.file.Write(append(line, '\n')); err != nil {
+		logging.Error("Failed to write audit log", types.Server, "error", err)
+	}
+}
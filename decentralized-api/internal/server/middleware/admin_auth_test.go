@@ -0,0 +1,173 @@
+package middleware_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"decentralized-api/apiconfig"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"decentralized-api/internal/server/middleware"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func newAuthTestServer(t *testing.T, config apiconfig.AdminAuthConfig) *echo.Echo {
+	auth, err := middleware.NewAdminAuthenticator(config)
+	require.NoError(t, err)
+
+	e := echo.New()
+	e.HTTPErrorHandler = middleware.TransparentErrorHandler
+	e.Use(auth.Audit)
+	g := e.Group("/admin/v1/", auth.Authenticate)
+	ok := func(c echo.Context) error {
+		return c.String(http.StatusOK, middleware.GetIdentity(c).Name)
+	}
+	g.GET("nodes", ok, middleware.Require(middleware.ScopeReadOnly))
+	g.DELETE("nodes/:id", ok, middleware.Require(middleware.ScopeNodeOperator))
+	g.POST("tx/send", ok, middleware.Require(middleware.ScopeTreasury))
+	return e
+}
+
+func call(e *echo.Echo, method, path, token string, configure ...func(*http.Request)) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	for _, c := range configure {
+		c(req)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAdminAuth_Scopes(t *testing.T) {
+	auditPath := filepath.Join(t.TempDir(), "audit.log")
+	e := newAuthTestServer(t, apiconfig.AdminAuthConfig{
+		Tokens: []apiconfig.AdminTokenConfig{
+			{Name: "monitoring", Token: "read-token", Scopes: []string{"read-only"}},
+			{Name: "ops", Token: "ops-token", Scopes: []string{"node-operator"}},
+			{Name: "finance", Token: "treasury-token", Scopes: []string{"treasury"}},
+		},
+		AuditLogPath: auditPath,
+	})
+
+	require.Equal(t, http.StatusUnauthorized, call(e, http.MethodGet, "/admin/v1/nodes", "").Code)
+	require.Equal(t, http.StatusUnauthorized, call(e, http.MethodGet, "/admin/v1/nodes", "wrong").Code)
+
+	for _, token := range []string{"read-token", "ops-token", "treasury-token"} {
+		require.Equal(t, http.StatusOK, call(e, http.MethodGet, "/admin/v1/nodes", token).Code, token)
+	}
+
+	require.Equal(t, http.StatusForbidden, call(e, http.MethodDelete, "/admin/v1/nodes/n1", "read-token").Code)
+	require.Equal(t, http.StatusForbidden, call(e, http.MethodDelete, "/admin/v1/nodes/n1", "treasury-token").Code)
+	require.Equal(t, http.StatusOK, call(e, http.MethodDelete, "/admin/v1/nodes/n1", "ops-token").Code)
+
+	require.Equal(t, http.StatusForbidden, call(e, http.MethodPost, "/admin/v1/tx/send", "ops-token").Code)
+	rec := call(e, http.MethodPost, "/admin/v1/tx/send", "treasury-token")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "finance", rec.Body.String())
+
+	// Only mutating calls are audited, rejected ones included
+	data, err := os.ReadFile(auditPath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 5)
+	var entries []middleware.AuditEntry
+	for _, line := range lines {
+		var entry middleware.AuditEntry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+	require.Equal(t, "monitoring", entries[0].Caller)
+	require.Equal(t, http.StatusForbidden, entries[0].Status)
+	require.Equal(t, "ops", entries[2].Caller)
+	require.Equal(t, "/admin/v1/nodes/:id", entries[2].Route)
+	require.Equal(t, http.StatusOK, entries[2].Status)
+	require.Equal(t, "finance", entries[4].Caller)
+	require.Equal(t, "token", entries[4].AuthMethod)
+}
+
+func TestAdminAuth_ClientCertificate(t *testing.T) {
+	e := newAuthTestServer(t, apiconfig.AdminAuthConfig{
+		ClientCerts: []apiconfig.AdminClientCertConfig{{CommonName: "ops-laptop", Scopes: []string{"node-operator"}}},
+	})
+	withCert := func(commonName string) func(*http.Request) {
+		return func(req *http.Request) {
+			req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: commonName}}}}
+		}
+	}
+
+	rec := call(e, http.MethodDelete, "/admin/v1/nodes/n1", "", withCert("ops-laptop"))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "ops-laptop", rec.Body.String())
+	require.Equal(t, http.StatusForbidden, call(e, http.MethodPost, "/admin/v1/tx/send", "", withCert("ops-laptop")).Code)
+	require.Equal(t, http.StatusUnauthorized, call(e, http.MethodGet, "/admin/v1/nodes", "", withCert("stranger")).Code)
+}
+
+func TestAdminAuth_ReadOnlyWithoutCredentials(t *testing.T) {
+	e := newAuthTestServer(t, apiconfig.AdminAuthConfig{})
+	require.Equal(t, http.StatusOK, call(e, http.MethodGet, "/admin/v1/nodes", "").Code)
+	require.Equal(t, http.StatusForbidden, call(e, http.MethodDelete, "/admin/v1/nodes/n1", "").Code)
+	require.Equal(t, http.StatusForbidden, call(e, http.MethodPost, "/admin/v1/tx/send", "").Code)
+}
+
+func TestAdminAuth_AllowUnauthenticated(t *testing.T) {
+	e := newAuthTestServer(t, apiconfig.AdminAuthConfig{AllowUnauthenticated: true})
+	require.Equal(t, http.StatusOK, call(e, http.MethodPost, "/admin/v1/tx/send", "").Code)
+
+	// Configured credentials always win over the flag
+	e = newAuthTestServer(t, apiconfig.AdminAuthConfig{
+		AllowUnauthenticated: true,
+		Tokens:               []apiconfig.AdminTokenConfig{{Name: "ops", Token: "ops-token", Scopes: []string{"node-operator"}}},
+	})
+	require.Equal(t, http.StatusUnauthorized, call(e, http.MethodPost, "/admin/v1/tx/send", "").Code)
+}
+
+func TestAdminAuth_EnvTokens(t *testing.T) {
+	// Tokens from the environment count as credentials like configured ones
+	e := newAuthTestServer(t, apiconfig.AdminAuthConfig{
+		AllowUnauthenticated: true,
+		EnvTokens:            []apiconfig.AdminTokenConfig{{Name: "env-treasury", Token: "treasury-token", Scopes: []string{"treasury"}}},
+	})
+	require.Equal(t, http.StatusOK, call(e, http.MethodPost, "/admin/v1/tx/send", "treasury-token").Code)
+	require.Equal(t, http.StatusUnauthorized, call(e, http.MethodPost, "/admin/v1/tx/send", "").Code)
+}
+
+func TestAdminAuth_AuditIgnoresForwardedFor(t *testing.T) {
+	auditPath := filepath.Join(t.TempDir(), "audit.log")
+	e := newAuthTestServer(t, apiconfig.AdminAuthConfig{
+		Tokens:       []apiconfig.AdminTokenConfig{{Name: "ops", Token: "ops-token", Scopes: []string{"node-operator"}}},
+		AuditLogPath: auditPath,
+	})
+	call(e, http.MethodDelete, "/admin/v1/nodes/n1", "ops-token", func(req *http.Request) {
+		req.RemoteAddr = "10.1.2.3:5555"
+		req.Header.Set("X-Forwarded-For", "1.1.1.1")
+	})
+
+	data, err := os.ReadFile(auditPath)
+	require.NoError(t, err)
+	var entry middleware.AuditEntry
+	require.NoError(t, json.Unmarshal(data, &entry))
+	require.Equal(t, "10.1.2.3", entry.RemoteIP)
+}
+
+func TestAdminAuth_InvalidConfig(t *testing.T) {
+	_, err := middleware.NewAdminAuthenticator(apiconfig.AdminAuthConfig{
+		Tokens: []apiconfig.AdminTokenConfig{{Name: "bad", Token: "t", Scopes: []string{"root"}}},
+	})
+	require.ErrorContains(t, err, "unknown scope")
+
+	_, err = middleware.NewAdminAuthenticator(apiconfig.AdminAuthConfig{
+		Tokens: []apiconfig.AdminTokenConfig{{Name: "empty", Scopes: []string{"read-only"}}},
+	})
+	require.ErrorContains(t, err, "is empty")
+}
@@ -29,7 +29,7 @@ func (s *Server) debugVerify(ctx echo.Context) error {
 	}
 
 	logging.Debug("Verifying block signatures", types.System, "height", height)
-	if err := merkleproof.VerifyBlockSignatures(s.configManager.GetChainNodeConfig().Url, height); err != nil {
+	if err := merkleproof.VerifyBlockSignatures(s.chainNodeUrl(), height); err != nil {
 		logging.Error("Failed to verify block signatures", types.Participants, "error", err)
 		return err
 	}
@@ -16,6 +16,8 @@ type ChatRequest struct {
 	Seed              string
 	InferenceId       string
 	RequesterAddress  string // address of participant, who signed inference request
+	RequesterKey      string // address of the inference key that signed for RequesterAddress, if any
+	PriceQuoteId      uint64 // price quote of RequesterAddress to charge the inference with, 0 for the current price
 	TransferAddress   string
 	Timestamp         int64  // timestamp of the request
 	TransferSignature string // signature of the transfer address
@@ -60,6 +62,18 @@ type ParticipantDto struct {
 	Balance     int64   `json:"balance"`
 	VotingPower int64   `json:"voting_power"`
 	Reputation  float32 `json:"reputation"`
+
+	Profile *ParticipantProfileDto `json:"profile,omitempty"`
+}
+
+type ParticipantProfileDto struct {
+	DisplayName        string `json:"display_name"`
+	Website            string `json:"website"`
+	Contact            string `json:"contact"`
+	RegionCode         string `json:"region_code"`
+	SupportedProtocols uint32 `json:"supported_protocols"`
+	PendingUrl         string `json:"pending_url,omitempty"`
+	UrlEffectiveHeight int64  `json:"url_effective_height,omitempty"`
 }
 
 type ParticipantsDto struct {
@@ -16,4 +16,6 @@ var (
 	ErrInvalidTrainingJobId = echo.NewHTTPError(http.StatusBadRequest, "Invalid training job id")
 	ErrEpochIsNotReached    = echo.NewHTTPError(http.StatusBadRequest, "Epoch is not reached")
 	ErrInferenceNotFound    = echo.NewHTTPError(http.StatusNotFound, "Inference not found")
+	ErrInvalidPageParams    = echo.NewHTTPError(http.StatusBadRequest, "Invalid limit or before")
+	ErrHistoryDisabled      = echo.NewHTTPError(http.StatusServiceUnavailable, "Chain indexer is not enabled")
 )
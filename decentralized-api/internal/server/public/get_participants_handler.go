@@ -104,7 +104,7 @@ func (s *Server) getParticipants(epoch uint64) (*ActiveParticipantWithProof, err
 
 	cdc := codec.NewProtoCodec(interfaceRegistry)
 
-	rpcClient, err := cosmos_client.NewRpcClient(s.configManager.GetChainNodeConfig().Url)
+	rpcClient, err := cosmos_client.NewRpcClient(s.chainNodeUrl())
 	if err != nil {
 		logging.Error("Failed to create rpc client", types.System, "error", err)
 		return nil, err
@@ -242,6 +242,7 @@ func (s *Server) getAllParticipants(ctx echo.Context) error {
 				CoinsOwed:   p.CoinBalance,
 				Balance:     pBalance,
 				VotingPower: int64(p.Weight),
+				Profile:     toParticipantProfileDto(p.Profile),
 			})
 		}
 		if resp.Pagination != nil {
@@ -281,6 +282,7 @@ func (s *Server) getAllParticipants(ctx echo.Context) error {
 				CoinsOwed:   p.CoinBalance,
 				Balance:     pBalance,
 				VotingPower: int64(p.Weight),
+				Profile:     toParticipantProfileDto(p.Profile),
 			})
 		}
 
@@ -296,6 +298,21 @@ func (s *Server) getAllParticipants(ctx echo.Context) error {
 	})
 }
 
+func toParticipantProfileDto(profile *types.ParticipantProfile) *ParticipantProfileDto {
+	if profile == nil {
+		return nil
+	}
+	return &ParticipantProfileDto{
+		DisplayName:        profile.DisplayName,
+		Website:            profile.Website,
+		Contact:            profile.Contact,
+		RegionCode:         profile.RegionCode,
+		SupportedProtocols: profile.SupportedProtocols,
+		PendingUrl:         profile.PendingInferenceUrl,
+		UrlEffectiveHeight: profile.InferenceUrlEffectiveHeight,
+	}
+}
+
 func queryActiveParticipants(rpcClient *rpcclient.HTTP, cdc *codec.ProtoCodec, epoch uint64) (*coretypes.ResultABCIQuery, error) {
 	dataKey := types.ActiveParticipantsFullKey(epoch)
 	result, err := cosmos_client.QueryByKey(rpcClient, "inference", dataKey)
@@ -68,6 +68,7 @@ func TestGetAllParticipants_PaginationAndPinnedHeight(t *testing.T) {
 	for i := 0; i < 100; i++ {
 		first[i] = types.Participant{Address: fmt.Sprintf("addr%03d", i), InferenceUrl: "http://node", CoinBalance: int64(i), Weight: int32(i)}
 	}
+	first[0].Profile = &types.ParticipantProfile{DisplayName: "Host", RegionCode: "DE", PendingInferenceUrl: "https://new-node", InferenceUrlEffectiveHeight: 12400}
 	for i := 0; i < 50; i++ {
 		second[i] = types.Participant{Address: fmt.Sprintf("addr%03d", 100+i), InferenceUrl: "http://node", CoinBalance: int64(100 + i), Weight: int32(100 + i)}
 	}
@@ -95,6 +96,8 @@ func TestGetAllParticipants_PaginationAndPinnedHeight(t *testing.T) {
 	require.Len(t, dto.Participants, 150)
 	require.Equal(t, "addr000", dto.Participants[0].Id)
 	require.Equal(t, int64(42), dto.Participants[0].Balance)
+	require.Equal(t, &ParticipantProfileDto{DisplayName: "Host", RegionCode: "DE", PendingUrl: "https://new-node", UrlEffectiveHeight: 12400}, dto.Participants[0].Profile)
+	require.Nil(t, dto.Participants[1].Profile)
 	last := dto.Participants[len(dto.Participants)-1]
 	require.Equal(t, "addr149", last.Id)
 
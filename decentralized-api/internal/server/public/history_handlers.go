@@ -0,0 +1,198 @@
+package public
+
+import (
+	"decentralized-api/internal/indexer"
+	"decentralized-api/logging"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// HistoryPage is one page of indexed history, newest first. Pass Next as `before` to get the following page.
+type HistoryPage[T any] struct {
+	Items []T   `json:"items"`
+	Next  int64 `json:"next,omitempty"`
+}
+
+type HistoryStatusDto struct {
+	LastIndexedHeight int64  `json:"last_indexed_height"`
+	PerformanceEpoch  uint64 `json:"performance_epoch"`
+}
+
+func newHistoryPage[T any](items []T, page indexer.Page, id func(T) int64) HistoryPage[T] {
+	result := HistoryPage[T]{Items: items}
+	limit := page.Limit
+	if limit <= 0 {
+		limit = indexer.DefaultPageLimit
+	}
+	if len(items) > 0 && len(items) >= min(limit, indexer.MaxPageLimit) {
+		result.Next = id(items[len(items)-1])
+	}
+	return result
+}
+
+func historyPageParams(ctx echo.Context) (indexer.Page, error) {
+	var page indexer.Page
+	if limit := ctx.QueryParam("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil || value <= 0 {
+			return page, ErrInvalidPageParams
+		}
+		page.Limit = value
+	}
+	if before := ctx.QueryParam("before"); before != "" {
+		value, err := strconv.ParseInt(before, 10, 64)
+		if err != nil || value <= 0 {
+			return page, ErrInvalidPageParams
+		}
+		page.Before = value
+	}
+	return page, nil
+}
+
+func optionalEpochParam(value string) (*uint64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	epoch, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidEpochId
+	}
+	return &epoch, nil
+}
+
+func (s *Server) getHistoryStatus(ctx echo.Context) error {
+	if s.history == nil {
+		return ErrHistoryDisabled
+	}
+	height, err := s.history.LastHeight(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+	epoch, err := s.history.PerformanceEpoch(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, HistoryStatusDto{LastIndexedHeight: height, PerformanceEpoch: epoch})
+}
+
+func (s *Server) getHistoryInferences(ctx echo.Context) error {
+	if s.history == nil {
+		return ErrHistoryDisabled
+	}
+	page, err := historyPageParams(ctx)
+	if err != nil {
+		return err
+	}
+	status := ctx.QueryParam("status")
+	if _, ok := types.InferenceStatus_value[status]; status != "" && !ok {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid inference status")
+	}
+	items, err := s.history.ListInferences(ctx.Request().Context(), indexer.InferenceFilter{
+		Requester: ctx.QueryParam("requester"),
+		Executor:  ctx.QueryParam("executor"),
+		Model:     ctx.QueryParam("model"),
+		Status:    status,
+	}, page)
+	if err != nil {
+		logging.Error("Failed to list indexed inferences", types.Inferences, "error", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, newHistoryPage(items, page, func(i indexer.Inference) int64 { return i.Id }))
+}
+
+func (s *Server) getHistoryInference(ctx echo.Context) error {
+	if s.history == nil {
+		return ErrHistoryDisabled
+	}
+	id, err := url.QueryUnescape(ctx.Param("id"))
+	if err != nil || id == "" {
+		return ErrIdRequired
+	}
+	inference, found, err := s.history.GetInference(ctx.Request().Context(), id)
+	if err != nil {
+		logging.Error("Failed to get indexed inference", types.Inferences, "id", id, "error", err)
+		return err
+	}
+	if !found {
+		return ErrInferenceNotFound
+	}
+	return ctx.JSON(http.StatusOK, inference)
+}
+
+func (s *Server) getHistoryValidations(ctx echo.Context) error {
+	if s.history == nil {
+		return ErrHistoryDisabled
+	}
+	page, err := historyPageParams(ctx)
+	if err != nil {
+		return err
+	}
+	items, err := s.history.ListValidations(ctx.Request().Context(), indexer.ValidationFilter{
+		InferenceId: ctx.QueryParam("inference_id"),
+		Validator:   ctx.QueryParam("validator"),
+	}, page)
+	if err != nil {
+		logging.Error("Failed to list indexed validations", types.Validation, "error", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, newHistoryPage(items, page, func(v indexer.Validation) int64 { return v.Id }))
+}
+
+func (s *Server) getHistoryRewards(ctx echo.Context) error {
+	if s.history == nil {
+		return ErrHistoryDisabled
+	}
+	page, err := historyPageParams(ctx)
+	if err != nil {
+		return err
+	}
+	epoch, err := optionalEpochParam(ctx.QueryParam("epoch"))
+	if err != nil {
+		return err
+	}
+	items, err := s.history.ListRewardPayouts(ctx.Request().Context(), indexer.RewardFilter{
+		Participant: ctx.QueryParam("participant"),
+		EpochIndex:  epoch,
+	}, page)
+	if err != nil {
+		logging.Error("Failed to list indexed reward payouts", types.Claims, "error", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, newHistoryPage(items, page, func(p indexer.RewardPayout) int64 { return p.Id }))
+}
+
+func (s *Server) getHistoryEpochPerformance(ctx echo.Context) error {
+	epoch, err := optionalEpochParam(ctx.Param("epoch"))
+	if err != nil || epoch == nil {
+		return ErrInvalidEpochId
+	}
+	return s.listHistoryPerformance(ctx, indexer.PerformanceFilter{EpochIndex: epoch})
+}
+
+func (s *Server) getHistoryParticipantPerformance(ctx echo.Context) error {
+	address := ctx.Param("address")
+	if address == "" {
+		return ErrAddressRequired
+	}
+	return s.listHistoryPerformance(ctx, indexer.PerformanceFilter{Participant: address})
+}
+
+func (s *Server) listHistoryPerformance(ctx echo.Context, filter indexer.PerformanceFilter) error {
+	if s.history == nil {
+		return ErrHistoryDisabled
+	}
+	page, err := historyPageParams(ctx)
+	if err != nil {
+		return err
+	}
+	items, err := s.history.ListEpochPerformance(ctx.Request().Context(), filter, page)
+	if err != nil {
+		logging.Error("Failed to list indexed epoch performance", types.Participants, "error", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, newHistoryPage(items, page, func(p indexer.EpochPerformance) int64 { return p.Id }))
+}
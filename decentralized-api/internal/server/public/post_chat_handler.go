@@ -272,6 +272,9 @@ func (s *Server) handleTransferRequest(ctx echo.Context, request *ChatRequest) e
 	req.Header.Set(utils.XTimestampHeader, strconv.FormatInt(request.Timestamp, 10))
 	req.Header.Set(utils.XTransferAddressHeader, request.TransferAddress)
 	req.Header.Set(utils.XRequesterAddressHeader, request.RequesterAddress)
+	if request.RequesterKey != "" {
+		req.Header.Set(utils.XRequesterKeyHeader, request.RequesterKey)
+	}
 	req.Header.Set(utils.XTASignatureHeader, inferenceRequest.TransferSignature)
 	req.Header.Set("Content-Type", request.Request.Header.Get("Content-Type"))
 
@@ -285,7 +288,17 @@ func (s *Server) handleTransferRequest(ctx echo.Context, request *ChatRequest) e
 	logging.Info("Proxying response from executor", types.Inferences,
 		"inferenceId", inferenceUUID,
 		"executor", executor.Address)
-	proxyResponse(resp, ctx.Response().Writer, false, nil, inferenceUUID)
+	w := ctx.Response().Writer
+	for trailer := range resp.Trailer {
+		w.Header().Add("Trailer", trailer)
+	}
+	proxyResponse(resp, w, false, nil, inferenceUUID)
+	// The executor's trailers are only filled in once its body was read to the end
+	for trailer, values := range resp.Trailer {
+		for _, value := range values {
+			w.Header().Add(trailer, value)
+		}
+	}
 	return nil
 }
 
@@ -437,6 +450,10 @@ func (s *Server) handleExecutorRequest(ctx echo.Context, request *ChatRequest, w
 
 	responseProcessor := completionapi.NewExecutorResponseProcessor(request.InferenceId)
 	logging.Debug("Proxying response from inference node", types.Inferences, "inferenceId", request.InferenceId)
+	// The signed execution is only known once the whole response went out, so it is sent as trailers
+	for _, trailer := range executionTrailers {
+		w.Header().Add("Trailer", trailer)
+	}
 	proxyResponse(resp, w, true, responseProcessor, inferenceId)
 
 	logging.Debug("Processing response from inference node", types.Inferences, "inferenceId", request.InferenceId)
@@ -446,6 +463,7 @@ func (s *Server) handleExecutorRequest(ctx echo.Context, request *ChatRequest, w
 		logging.Error("Failed to parse response data into CompletionResponse", types.Inferences, "error", err)
 		return err
 	}
+	s.setExecutionTrailers(w, request, int64(seed), completionResponse)
 
 	err = s.sendInferenceTransaction(request.InferenceId, completionResponse, request.Body, s.recorder.GetAccountAddress(), request)
 	if err != nil {
@@ -496,7 +514,15 @@ func (s *Server) validateFullRequest(ctx echo.Context, request *ChatRequest) err
 	}
 	logging.Info("Transfer pubkeys", types.Inferences, "pubkeys", transferPubkeys)
 
-	if err := validateTransferRequest(request, dev.Pubkey); err != nil {
+	devPubkey := dev.Pubkey
+	if request.RequesterKey != "" {
+		inferenceKey, err := s.getInferenceKey(ctx.Request().Context(), request)
+		if err != nil {
+			return err
+		}
+		devPubkey = inferenceKey.PubKey
+	}
+	if err := validateTransferRequest(request, devPubkey); err != nil {
 		logging.Error("Unable to validate request against PubKey", types.Inferences, "error", err)
 		return echo.NewHTTPError(http.StatusUnauthorized, "Unable to validate request against PubKey:"+err.Error())
 	}
@@ -588,16 +614,10 @@ func (s *Server) calculateSignature(payload string, timestamp int64, transferAdd
 		ExecutorAddress: executorAddress,
 	}
 
-	signerAddressStr := s.recorder.GetSignerAddress()
-	signerAddress, err := sdk.AccAddressFromBech32(signerAddressStr)
+	accountSigner, err := s.accountSigner()
 	if err != nil {
-		logging.Error("Failed to parse address", types.Inferences, "address", signerAddressStr, "error", err)
 		return "", err
 	}
-	accountSigner := &cmd.AccountSigner{
-		Addr:    signerAddress,
-		Keyring: s.recorder.GetKeyring(),
-	}
 
 	signature, err := calculations.Sign(accountSigner, components, agentType)
 	if err != nil {
@@ -608,6 +628,51 @@ func (s *Server) calculateSignature(payload string, timestamp int64, transferAdd
 	return signature, nil
 }
 
+func (s *Server) accountSigner() (*cmd.AccountSigner, error) {
+	signerAddressStr := s.recorder.GetSignerAddress()
+	signerAddress, err := sdk.AccAddressFromBech32(signerAddressStr)
+	if err != nil {
+		logging.Error("Failed to parse address", types.Inferences, "address", signerAddressStr, "error", err)
+		return nil, err
+	}
+	return &cmd.AccountSigner{
+		Addr:    signerAddress,
+		Keyring: s.recorder.GetKeyring(),
+	}, nil
+}
+
+// signExecution signs the executor's statement that it produced responseHash for the inference, in exactly the
+// form MsgSubmitInferenceEvidence verifies on chain.
+func signExecution(signer calculations.Signer, inferenceId string, seed int64, responseHash string, timestamp int64, transferAddress string, executorAddress string) (string, error) {
+	components := calculations.GetExecutionSignatureComponents(inferenceId, seed, responseHash, timestamp, transferAddress, executorAddress)
+	return calculations.Sign(signer, components, calculations.ExecutionAttestation)
+}
+
+// setExecutionTrailers hands the requester the signed statement of the response it received, so that a
+// different response reported or served for the same inference and seed can be proven.
+func (s *Server) setExecutionTrailers(w http.ResponseWriter, request *ChatRequest, seed int64, response completionapi.CompletionResponse) {
+	responseHash, err := response.GetHash()
+	if err != nil || responseHash == "" {
+		logging.Warn("No response hash to sign the execution", types.Inferences, "inferenceId", request.InferenceId, "error", err)
+		return
+	}
+	signer, err := s.accountSigner()
+	if err != nil {
+		return
+	}
+	signature, err := signExecution(signer, request.InferenceId, seed, responseHash, request.Timestamp, request.TransferAddress, s.recorder.GetAccountAddress())
+	if err != nil {
+		logging.Error("Failed to sign execution", types.Inferences, "inferenceId", request.InferenceId, "error", err)
+		return
+	}
+	w.Header().Set(utils.XInferenceIdHeader, request.InferenceId)
+	w.Header().Set(utils.XSeedHeader, strconv.FormatInt(seed, 10))
+	w.Header().Set(utils.XExecutionResponseHashHeader, responseHash)
+	w.Header().Set(utils.XExecutionSignatureHeader, signature)
+}
+
+var executionTrailers = []string{utils.XInferenceIdHeader, utils.XSeedHeader, utils.XExecutionResponseHashHeader, utils.XExecutionSignatureHeader}
+
 func (s *Server) sendInferenceTransaction(inferenceId string, response completionapi.CompletionResponse, requestBody []byte, executorAddress string, request *ChatRequest) error {
 	responseHash, err := response.GetHash()
 	if err != nil || responseHash == "" {
@@ -675,6 +740,7 @@ func (s *Server) sendInferenceTransaction(inferenceId string, response completio
 			ExecutorSignature:    executorSignature,
 			RequestTimestamp:     request.Timestamp,
 			RequestedBy:          request.RequesterAddress,
+			RequesterKey:         request.RequesterKey,
 			OriginalPrompt:       string(request.Body),
 			Model:                model,
 		}
@@ -720,6 +786,8 @@ func createInferenceStartRequest(s *Server, request *ChatRequest, seed int32, in
 		PromptHash:       promptHash,
 		PromptPayload:    promptPayload,
 		RequestedBy:      request.RequesterAddress,
+		RequesterKey:     request.RequesterKey,
+		PriceQuoteId:     request.PriceQuoteId,
 		Model:            request.OpenAiRequest.Model,
 		AssignedTo:       executor.Address,
 		NodeVersion:      nodeVersion,
@@ -769,6 +837,13 @@ func readRequest(request *http.Request, transferAddress string) (*ChatRequest, e
 	if request.Header.Get(utils.XTransferAddressHeader) != "" {
 		transferAddress = request.Header.Get(utils.XTransferAddressHeader)
 	}
+	var priceQuoteId uint64
+	if quoteHeader := request.Header.Get(utils.XPriceQuoteIdHeader); quoteHeader != "" {
+		priceQuoteId, err = strconv.ParseUint(quoteHeader, 10, 64)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "Invalid "+utils.XPriceQuoteIdHeader+" header")
+		}
+	}
 
 	return &ChatRequest{
 		Body:              body,
@@ -778,6 +853,8 @@ func readRequest(request *http.Request, transferAddress string) (*ChatRequest, e
 		Seed:              request.Header.Get(utils.XSeedHeader),
 		InferenceId:       request.Header.Get(utils.XInferenceIdHeader),
 		RequesterAddress:  request.Header.Get(utils.XRequesterAddressHeader),
+		RequesterKey:      request.Header.Get(utils.XRequesterKeyHeader),
+		PriceQuoteId:      priceQuoteId,
 		Timestamp:         timestamp,
 		TransferAddress:   transferAddress,
 		TransferSignature: request.Header.Get(utils.XTASignatureHeader),
@@ -800,7 +877,18 @@ func (s *Server) validateRequester(ctx context.Context, request *ChatRequest, re
 		return ErrInferenceParticipantNotFound
 	}
 
-	err := validateTransferRequest(request, requester.Pubkey)
+	requesterPubkey := requester.Pubkey
+	var inferenceKey *types.QueryInferenceKeyResponse
+	if request.RequesterKey != "" {
+		var err error
+		inferenceKey, err = s.getInferenceKey(ctx, request)
+		if err != nil {
+			return err
+		}
+		requesterPubkey = inferenceKey.PubKey
+	}
+
+	err := validateTransferRequest(request, requesterPubkey)
 	if err != nil {
 		logging.Error("Unable to validate request against PubKey", types.Inferences, "error", err)
 		return echo.NewHTTPError(http.StatusUnauthorized, "Unable to validate request against PubKey:"+err.Error())
@@ -819,7 +907,17 @@ func (s *Server) validateRequester(ctx context.Context, request *ChatRequest, re
 		ModelId: request.OpenAiRequest.Model,
 	})
 
-	if err == nil && priceResponse.Found {
+	if request.PriceQuoteId != 0 {
+		quote, err := s.getPriceQuote(ctx, request)
+		if err != nil {
+			return err
+		}
+		perTokenPrice = quote.PerTokenPrice
+
+		logging.Debug("Using quoted pricing", types.Inferences,
+			"perTokenPrice", perTokenPrice,
+			"quoteId", quote.Id)
+	} else if err == nil && priceResponse.Found {
 		// Use dynamic pricing
 		perTokenPrice = priceResponse.Price
 
@@ -846,9 +944,65 @@ func (s *Server) validateRequester(ctx context.Context, request *ChatRequest, re
 		"maxTokens", request.OpenAiRequest.MaxTokens,
 		"totalTokens", totalTokens)
 
+	if inferenceKey != nil {
+		if err := validateInferenceKeyLimits(request, inferenceKey, escrowNeeded); err != nil {
+			return err
+		}
+	}
+
 	logging.Debug("Client balance", types.Inferences, "balance", requester.Balance)
 	if requester.Balance < int64(escrowNeeded) {
 		return ErrInsufficientBalance
 	}
 	return nil
 }
+
+// getPriceQuote looks up the quote the request wants to be charged with. Expiry and the token budget are
+// enforced by MsgStartInference.
+func (s *Server) getPriceQuote(ctx context.Context, request *ChatRequest) (*types.PriceQuote, error) {
+	queryClient := s.recorder.NewInferenceQueryClient()
+	response, err := queryClient.PriceQuote(ctx, &types.QueryPriceQuoteRequest{Id: request.PriceQuoteId})
+	if err != nil {
+		logging.Warn("Price quote not found", types.Inferences, "quoteId", request.PriceQuoteId, "error", err)
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Price quote not found: "+strconv.FormatUint(request.PriceQuoteId, 10))
+	}
+	quote := response.Quote
+	if quote.Developer != request.RequesterAddress || quote.ModelId != request.OpenAiRequest.Model {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, types.ErrPriceQuoteMismatch.Error())
+	}
+	return &quote, nil
+}
+
+// getInferenceKey looks up the inference key that signed the request for the requester. Only keys the requester
+// granted are found.
+func (s *Server) getInferenceKey(ctx context.Context, request *ChatRequest) (*types.QueryInferenceKeyResponse, error) {
+	queryClient := s.recorder.NewInferenceQueryClient()
+	inferenceKey, err := queryClient.InferenceKey(ctx, &types.QueryInferenceKeyRequest{
+		Developer:  request.RequesterAddress,
+		KeyAddress: request.RequesterKey,
+	})
+	if err != nil {
+		logging.Warn("Inference key not found", types.Inferences, "requester", request.RequesterAddress, "key", request.RequesterKey, "error", err)
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "Inference key not granted by requester: "+request.RequesterKey)
+	}
+	if inferenceKey.PubKey == "" {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "Inference key has no public key on chain: "+request.RequesterKey)
+	}
+	return inferenceKey, nil
+}
+
+// validateInferenceKeyLimits applies the same limits MsgStartInference enforces, so requests that would be
+// rejected on chain are not executed.
+func validateInferenceKeyLimits(request *ChatRequest, inferenceKey *types.QueryInferenceKeyResponse, escrowNeeded uint64) error {
+	grant := inferenceKey.Grant
+	if err := grant.CheckRequest(request.OpenAiRequest.Model, uint64(request.OpenAiRequest.MaxTokens), time.Now().Unix()); err != nil {
+		logging.Warn("Request outside of inference key limits", types.Inferences, "key", request.RequesterKey, "error", err)
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	}
+	if grant.EpochSpendLimit != 0 && inferenceKey.Usage.EpochSpent+escrowNeeded > grant.EpochSpendLimit {
+		logging.Warn("Inference key epoch spend limit reached", types.Inferences, "key", request.RequesterKey,
+			"epochSpent", inferenceKey.Usage.EpochSpent, "escrowNeeded", escrowNeeded, "limit", grant.EpochSpendLimit)
+		return echo.NewHTTPError(http.StatusPaymentRequired, types.ErrInferenceKeySpendLimitExceeded.Error())
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package public
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/cmd/inferenced/cmd"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateInferenceKeyLimits(t *testing.T) {
+	inferenceKey := &types.QueryInferenceKeyResponse{
+		Grant: types.InferenceKeyGrant{
+			EpochSpendLimit:     1_000,
+			AllowedModels:       []string{"allowed-model"},
+			MaxTokensPerRequest: 100,
+		},
+		Usage: types.InferenceKeyUsage{EpochSpent: 600},
+	}
+	request := func(model string, maxTokens int32) *ChatRequest {
+		return &ChatRequest{RequesterKey: "key", OpenAiRequest: OpenAiRequest{Model: model, MaxTokens: maxTokens}}
+	}
+	statusOf := func(err error) int {
+		var httpErr *echo.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		return httpErr.Code
+	}
+
+	require.NoError(t, validateInferenceKeyLimits(request("allowed-model", 100), inferenceKey, 400))
+	require.Equal(t, http.StatusPaymentRequired, statusOf(validateInferenceKeyLimits(request("allowed-model", 100), inferenceKey, 401)))
+	require.Equal(t, http.StatusForbidden, statusOf(validateInferenceKeyLimits(request("other-model", 100), inferenceKey, 1)))
+	require.Equal(t, http.StatusForbidden, statusOf(validateInferenceKeyLimits(request("allowed-model", 101), inferenceKey, 1)))
+
+	inferenceKey.Grant.Expiration = 1
+	require.Equal(t, http.StatusForbidden, statusOf(validateInferenceKeyLimits(request("allowed-model", 100), inferenceKey, 1)))
+}
+
+// The execution signed here must be the one MsgSubmitInferenceEvidence accepts on chain
+func TestSignExecution_VerifiesOnChain(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &secp256k1.PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &secp256k1.PrivKey{})
+	kr := keyring.NewInMemory(codec.NewProtoCodec(registry))
+	record, _, err := kr.NewMnemonic("executor", keyring.English, sdk.FullFundraiserPath, "", hd.Secp256k1)
+	require.NoError(t, err)
+	executorAddress, err := record.GetAddress()
+	require.NoError(t, err)
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	executorKeys := []string{base64.StdEncoding.EncodeToString(pubKey.Bytes())}
+
+	execution := types.SignedExecution{
+		InferenceId:      "inference-1",
+		Seed:             42,
+		ResponseHash:     "response-hash",
+		RequestTimestamp: 1700000000,
+		TransferredBy:    "transfer-agent",
+	}
+	signer := &cmd.AccountSigner{Addr: executorAddress, Keyring: &kr}
+	execution.ExecutionSignature, err = signExecution(signer, execution.InferenceId, execution.Seed, execution.ResponseHash,
+		execution.RequestTimestamp, execution.TransferredBy, executorAddress.String())
+	require.NoError(t, err)
+
+	require.NoError(t, calculations.VerifyExecutionSignature(execution, executorAddress.String(), executorKeys))
+
+	// A different response is not covered by the signature
+	tampered := execution
+	tampered.ResponseHash = "other-response-hash"
+	require.Error(t, calculations.VerifyExecutionSignature(tampered, executorAddress.String(), executorKeys))
+
+	// A request signature over the same components does not pass for an execution attestation
+	components := calculations.GetExecutionSignatureComponents(execution.InferenceId, execution.Seed, execution.ResponseHash,
+		execution.RequestTimestamp, execution.TransferredBy, executorAddress.String())
+	requestSigned := execution
+	requestSigned.ExecutionSignature, err = calculations.Sign(signer, components, calculations.ExecutorAgent)
+	require.NoError(t, err)
+	require.Error(t, calculations.VerifyExecutionSignature(requestSigned, executorAddress.String(), executorKeys))
+}
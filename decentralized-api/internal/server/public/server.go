@@ -6,6 +6,7 @@ import (
 	"decentralized-api/chainphase"
 	"decentralized-api/cosmosclient"
 	"decentralized-api/internal"
+	"decentralized-api/internal/indexer"
 	"decentralized-api/internal/server/middleware"
 	"decentralized-api/training"
 	"net/http"
@@ -21,6 +22,8 @@ type Server struct {
 	trainingExecutor *training.Executor
 	blockQueue       *BridgeQueue
 	bandwidthLimiter *internal.BandwidthLimiter
+	chainEndpoints   *cosmosclient.EndpointPool
+	history          *indexer.Store
 }
 
 // TODO: think about rate limits
@@ -30,7 +33,9 @@ func NewServer(
 	recorder cosmosclient.CosmosMessageClient,
 	trainingExecutor *training.Executor,
 	blockQueue *BridgeQueue,
-	phaseTracker *chainphase.ChainPhaseTracker) *Server {
+	phaseTracker *chainphase.ChainPhaseTracker,
+	chainEndpoints *cosmosclient.EndpointPool,
+	history *indexer.Store) *Server {
 	e := echo.New()
 	e.HTTPErrorHandler = middleware.TransparentErrorHandler
 
@@ -44,6 +49,8 @@ func NewServer(
 		recorder:         recorder,
 		trainingExecutor: trainingExecutor,
 		blockQueue:       blockQueue,
+		chainEndpoints:   chainEndpoints,
+		history:          history,
 	}
 
 	s.bandwidthLimiter = internal.NewBandwidthLimiterFromConfig(configManager, recorder, phaseTracker)
@@ -84,6 +91,10 @@ func NewServer(
 
 	g.GET("epochs/:epoch", s.getEpochById)
 	g.GET("epochs/:epoch/participants", s.getParticipantsByEpoch)
+	g.GET("epochs/:epoch/validator-stats", s.getEpochValidatorStats)
+
+	g.GET("validators/:address/stats", s.getValidatorStatsHistory)
+	g.GET("validators/:address/stats/:epoch", s.getValidatorEpochStats)
 
 	// BLS Query Endpoints
 	blsGroup := g.Group("bls/")
@@ -95,6 +106,16 @@ func NewServer(
 	g.GET("restrictions/exemptions", s.getRestrictionsExemptions)
 	g.GET("restrictions/exemptions/:id/usage/:account", s.getRestrictionsExemptionUsage)
 
+	// Indexed history, served from the chain indexer database when it is enabled
+	historyGroup := g.Group("history/")
+	historyGroup.GET("status", s.getHistoryStatus)
+	historyGroup.GET("inferences", s.getHistoryInferences)
+	historyGroup.GET("inferences/:id", s.getHistoryInference)
+	historyGroup.GET("validations", s.getHistoryValidations)
+	historyGroup.GET("rewards", s.getHistoryRewards)
+	historyGroup.GET("epochs/:epoch/performance", s.getHistoryEpochPerformance)
+	historyGroup.GET("participants/:address/performance", s.getHistoryParticipantPerformance)
+
 	return s
 }
 
@@ -102,8 +123,29 @@ func (s *Server) Start(addr string) {
 	go s.e.Start(addr)
 }
 
+// chainNodeUrl returns the chain RPC endpoint currently in use, falling back to the configured one.
+func (s *Server) chainNodeUrl() string {
+	if s.chainEndpoints != nil {
+		return s.chainEndpoints.Current()
+	}
+	return s.configManager.GetChainNodeConfig().Url
+}
+
+type ChainNodeStatusDto struct {
+	CurrentUrl string                        `json:"current_url"`
+	Endpoints  []cosmosclient.EndpointStatus `json:"endpoints"`
+}
+
 func (s *Server) getStatus(ctx echo.Context) error {
+	var chainNode *ChainNodeStatusDto
+	if s.chainEndpoints != nil {
+		chainNode = &ChainNodeStatusDto{
+			CurrentUrl: s.chainEndpoints.Current(),
+			Endpoints:  s.chainEndpoints.Statuses(),
+		}
+	}
 	return ctx.JSON(http.StatusOK, struct {
-		Status string `json:"status"`
-	}{Status: "ok"})
+		Status    string              `json:"status"`
+		ChainNode *ChainNodeStatusDto `json:"chain_node,omitempty"`
+	}{Status: "ok", ChainNode: chainNode})
 }
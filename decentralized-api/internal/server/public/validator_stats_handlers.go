@@ -0,0 +1,104 @@
+package public
+
+import (
+	"decentralized-api/logging"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+type ValidatorEpochStatsDto struct {
+	Stats   types.ValidatorEpochStats    `json:"stats"`
+	Targets []types.ValidatorTargetStats `json:"targets"`
+}
+
+func (s *Server) getValidatorStatsHistory(ctx echo.Context) error {
+	address := ctx.Param("address")
+	if address == "" {
+		return ErrAddressRequired
+	}
+	pagination, err := pageRequestFromQuery(ctx)
+	if err != nil {
+		return err
+	}
+	queryClient := s.recorder.NewInferenceQueryClient()
+	response, err := queryClient.ValidatorStatsHistory(ctx.Request().Context(), &types.QueryValidatorStatsHistoryRequest{Validator: address, Pagination: pagination})
+	if err != nil {
+		logging.Error("Failed to get validator stats history", types.Validation, "validator", address, "error", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (s *Server) getValidatorEpochStats(ctx echo.Context) error {
+	address := ctx.Param("address")
+	if address == "" {
+		return ErrAddressRequired
+	}
+	epoch, err := strconv.ParseUint(ctx.Param("epoch"), 10, 64)
+	if err != nil {
+		return ErrInvalidEpochId
+	}
+	queryClient := s.recorder.NewInferenceQueryClient()
+	stats, err := queryClient.ValidatorStats(ctx.Request().Context(), &types.QueryValidatorStatsRequest{Validator: address, EpochIndex: epoch})
+	if err != nil {
+		logging.Error("Failed to get validator stats", types.Validation, "validator", address, "epoch", epoch, "error", err)
+		return err
+	}
+	targets, err := queryClient.ValidatorTargetStats(ctx.Request().Context(), &types.QueryValidatorTargetStatsRequest{Validator: address, EpochIndex: epoch})
+	if err != nil {
+		logging.Error("Failed to get validator target stats", types.Validation, "validator", address, "epoch", epoch, "error", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, ValidatorEpochStatsDto{Stats: stats.Stats, Targets: targets.Stats})
+}
+
+// getEpochValidatorStats ranks the validators of an epoch. rank_by is one of validations, invalidations,
+// upheld_invalidations, overturned_invalidations or missed and defaults to validations.
+func (s *Server) getEpochValidatorStats(ctx echo.Context) error {
+	epoch, err := strconv.ParseUint(ctx.Param("epoch"), 10, 64)
+	if err != nil {
+		return ErrInvalidEpochId
+	}
+	pagination, err := pageRequestFromQuery(ctx)
+	if err != nil {
+		return err
+	}
+	request := &types.QueryValidatorStatsRankedRequest{EpochIndex: epoch, Pagination: pagination}
+	if rankBy := ctx.QueryParam("rank_by"); rankBy != "" {
+		ranking, ok := types.ValidatorStatsRanking_value["RANK_BY_"+strings.ToUpper(rankBy)]
+		if !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid rank_by")
+		}
+		request.RankBy = types.ValidatorStatsRanking(ranking)
+	}
+
+	queryClient := s.recorder.NewInferenceQueryClient()
+	response, err := queryClient.ValidatorStatsRanked(ctx.Request().Context(), request)
+	if err != nil {
+		logging.Error("Failed to get ranked validator stats", types.Validation, "epoch", epoch, "error", err)
+		return err
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// pageRequestFromQuery reads the offset and limit query parameters, both optional
+func pageRequestFromQuery(ctx echo.Context) (*query.PageRequest, error) {
+	pagination := &query.PageRequest{}
+	var err error
+	if offset := ctx.QueryParam("offset"); offset != "" {
+		if pagination.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "Invalid offset")
+		}
+	}
+	if limit := ctx.QueryParam("limit"); limit != "" {
+		if pagination.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "Invalid limit")
+		}
+	}
+	return pagination, nil
+}
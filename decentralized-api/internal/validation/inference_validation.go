@@ -16,6 +16,7 @@ import (
 	"math"
 	"net/http"
 	"net/url"
+	"slices"
 	"sort"
 	"sync"
 	"time"
@@ -49,13 +50,8 @@ func NewInferenceValidator(
 	}
 }
 
-func (s *InferenceValidator) VerifyInvalidation(events map[string][]string, recorder cosmosclient.InferenceCosmosClient) {
-	inferenceIds, ok := events["inference_validation.inference_id"]
-	if !ok || len(inferenceIds) == 0 {
-		logging.Error("No inference_id found in events", types.Validation)
-		return
-	}
-	inferenceId := inferenceIds[0]
+func (s *InferenceValidator) VerifyInvalidation(event *types.EventInferenceValidation, recorder cosmosclient.InferenceCosmosClient) {
+	inferenceId := event.InferenceId
 
 	logging.Debug("Verifying invalidation", types.Validation, "inference_id", inferenceId)
 
@@ -75,6 +71,24 @@ func (s *InferenceValidator) VerifyInvalidation(events map[string][]string, reco
 
 }
 
+// VerifyDispute re-runs a disputed inference if this participant was chosen to judge it, regardless of validation sampling.
+func (s *InferenceValidator) VerifyDispute(event *types.EventInferenceDisputed, recorder cosmosclient.InferenceCosmosClient) {
+	if !slices.Contains(event.Validators, recorder.GetAddress()) {
+		return
+	}
+
+	queryClient := recorder.NewInferenceQueryClient()
+	r, err := queryClient.Inference(recorder.GetContext(), &types.QueryGetInferenceRequest{Index: event.InferenceId})
+	if err != nil {
+		logging.Warn("Failed to query disputed Inference.", types.Validation, "inference_id", event.InferenceId, "error", err)
+		return
+	}
+	logging.Info("Chosen to validate disputed inference", types.Validation, "inference_id", event.InferenceId)
+	go func() {
+		s.validateInferenceAndSendValMessage(r.Inference, recorder, true)
+	}()
+}
+
 // shouldValidateInference determines if the current participant should validate a specific inference
 // This function extracts the core validation decision logic for reuse in recovery scenarios
 func (s *InferenceValidator) shouldValidateInference(
@@ -8,6 +8,7 @@ import (
 	"decentralized-api/cosmosclient"
 	"decentralized-api/internal/bls"
 	"decentralized-api/internal/event_listener"
+	"decentralized-api/internal/indexer"
 	"decentralized-api/internal/modelmanager"
 	"decentralized-api/internal/nats/server"
 	"decentralized-api/internal/poc"
@@ -26,6 +27,7 @@ import (
 	"decentralized-api/participant"
 	"decentralized-api/training"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"log"
 	"log/slog"
@@ -50,6 +52,14 @@ func main() {
 
 		return
 	}
+	if len(os.Args) >= 2 && os.Args[1] == "migrations" {
+		returnMigrationStatus()
+		return
+	}
+	if len(os.Args) >= 2 && os.Args[1] == "import-db" {
+		importDb(os.Args[2:])
+		return
+	}
 	if len(os.Args) >= 2 && os.Args[1] == "pre-upgrade" {
 		os.Exit(1)
 	}
@@ -96,7 +106,7 @@ func main() {
 		logging.Error("Failed to get participant info", types.Participants, "error", err)
 		return
 	}
-	chainBridge := broker.NewBrokerChainBridgeImpl(recorder, config.GetChainNodeConfig().Url)
+	chainBridge := broker.NewBrokerChainBridgeImpl(recorder)
 	nodeBroker := broker.NewBroker(chainBridge, chainPhaseTracker, participantInfo, config.GetApiConfig().PoCCallbackUrl, &mlnodeclient.HttpClientFactory{}, config)
 
 	nodes := config.GetNodes()
@@ -104,7 +114,7 @@ func main() {
 		nodeBroker.LoadNodeToBroker(&node)
 	}
 
-	if err := participant.RegisterParticipantIfNeeded(recorder, config); err != nil {
+	if err := participant.RegisterParticipantIfNeeded(recorder, config, recorder.GetEndpointPool()); err != nil {
 		logging.Error("Failed to register participant", types.Participants, "error", err)
 		return
 	}
@@ -118,7 +128,6 @@ func main() {
 		participantInfo.GetPubKey(),
 		nodeBroker,
 		config.GetApiConfig().PoCCallbackUrl,
-		config.GetChainNodeConfig().Url,
 		recorder,
 		chainPhaseTracker,
 	)
@@ -126,6 +135,7 @@ func main() {
 
 	tendermintClient := cosmosclient.TendermintClient{
 		ChainNodeUrl: config.GetChainNodeConfig().Url,
+		Rpc:          recorder.GetRpcClient(),
 	}
 	// Create a cancellable context for the entire system
 	ctx, cancel := context.WithCancel(context.Background())
@@ -158,7 +168,25 @@ func main() {
 	// Bridge external block queue
 	blockQueue := pserver.NewBlockQueue(recorder)
 
-	publicServer := pserver.NewServer(nodeBroker, config, recorder, trainingExecutor, blockQueue, chainPhaseTracker)
+	var historyStore *indexer.Store
+	if indexerConfig := config.GetIndexerConfig(); indexerConfig.Enabled {
+		historyStore, err = indexer.OpenStore(ctx, indexerConfig.DbPath)
+		if err != nil {
+			log.Fatalf("Error opening indexer database: %v", err)
+		}
+		defer historyStore.Close()
+		chainIndexer := indexer.NewIndexer(
+			historyStore,
+			recorder.GetRpcClient(),
+			recorder.NewInferenceQueryClient(),
+			recorder.GetClientContext().TxConfig.TxDecoder(),
+			indexerConfig.StartHeight,
+			time.Duration(indexerConfig.PollIntervalSec)*time.Second,
+		)
+		go chainIndexer.Run(ctx)
+	}
+
+	publicServer := pserver.NewServer(nodeBroker, config, recorder, trainingExecutor, blockQueue, chainPhaseTracker, recorder.GetEndpointPool(), historyStore)
 	publicServer.Start(addr)
 
 	addr = fmt.Sprintf(":%v", config.GetApiConfig().MLServerPort)
@@ -168,8 +196,13 @@ func main() {
 
 	addr = fmt.Sprintf(":%v", config.GetApiConfig().AdminServerPort)
 	logging.Info("start admin server on addr", types.Server, "addr", addr)
-	adminServer := adminserver.NewServer(recorder, nodeBroker, config, validator)
-	adminServer.Start(addr)
+	adminServer, err := adminserver.NewServer(recorder, nodeBroker, config, validator, listener.TypedHandlers(), recorder.GetEndpointPool())
+	if err != nil {
+		log.Fatalf("Error creating admin server: %v", err)
+	}
+	if err := adminServer.Start(addr); err != nil {
+		log.Fatalf("Error starting admin server: %v", err)
+	}
 
 	mlGrpcServerPort := config.GetApiConfig().MlGrpcServerPort
 	if mlGrpcServerPort == 0 {
@@ -224,6 +257,62 @@ func returnStatus(config *apiconfig.ConfigManager) {
 	os.Exit(0)
 }
 
+// returnMigrationStatus prints the applied and pending SQLite migrations without applying them.
+// An optional second argument overrides the database path (API_SQLITE_PATH by default).
+func returnMigrationStatus() {
+	path := ""
+	if len(os.Args) >= 3 {
+		path = os.Args[2]
+	}
+	status, err := apiconfig.InspectSchema(context.Background(), path)
+	if err != nil {
+		log.Fatalf("Error reading schema status: %v", err)
+	}
+	jsonData, err := json.MarshalIndent(status, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(jsonData))
+	if len(status.Pending) > 0 {
+		os.Exit(2)
+	}
+	os.Exit(0)
+}
+
+// importDb restores a dump of GET /admin/v1/export/db into the local database while the dapi is stopped.
+// Usage: import-db [--mode=merge|replace] [--dry-run] [--db=path] <dump.json>
+func importDb(args []string) {
+	flags := flag.NewFlagSet("import-db", flag.ExitOnError)
+	mode := flags.String("mode", string(apiconfig.ImportModeMerge), "merge or replace")
+	dryRun := flags.Bool("dry-run", false, "only print the changes")
+	dbPath := flags.String("db", "", "database path (defaults to API_SQLITE_PATH)")
+	_ = flags.Parse(args)
+	if flags.NArg() != 1 {
+		log.Fatalf("Usage: import-db [--mode=merge|replace] [--dry-run] [--db=path] <dump.json>")
+	}
+
+	data, err := os.ReadFile(flags.Arg(0))
+	if err != nil {
+		log.Fatalf("Error reading dump: %v", err)
+	}
+	dump, err := apiconfig.ParseDbDump(data)
+	if err != nil {
+		log.Fatalf("Error parsing dump: %v", err)
+	}
+	report, err := apiconfig.ImportDbFile(context.Background(), *dbPath, dump, apiconfig.ImportOptions{
+		Mode:   apiconfig.ImportMode(*mode),
+		DryRun: *dryRun,
+	})
+	if err != nil {
+		log.Fatalf("Error importing dump: %v", err)
+	}
+	jsonData, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(jsonData))
+}
+
 func getParams(ctx context.Context, transactionRecorder cosmosclient.InferenceCosmosClient) (*types.QueryParamsResponse, error) {
 	var params *types.QueryParamsResponse
 	var err error
@@ -17,16 +17,13 @@ import (
 	"time"
 
 	"github.com/cometbft/cometbft/crypto"
-	rpcclient "github.com/cometbft/cometbft/rpc/client/http"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/productscience/inference/x/inference/types"
 )
 
-func participantExistsWithWait(recorder cosmosclient.CosmosMessageClient, chainNodeUrl string) (bool, error) {
-	client, err := cosmosclient.NewRpcClient(chainNodeUrl)
-	if err != nil {
-		return false, fmt.Errorf("failed to create tendermint RPC client: %w", err)
-	}
-	if err := waitForFirstBlock(client, 1*time.Minute); err != nil {
+func participantExistsWithWait(recorder cosmosclient.CosmosMessageClient) (bool, error) {
+	// The recorder fails over between the configured chain endpoints
+	if err := waitForFirstBlock(recorder, 1*time.Minute); err != nil {
 		return false, fmt.Errorf("chain failed to start: %w", err)
 	}
 
@@ -59,7 +56,7 @@ func participantExists(recorder cosmosclient.CosmosMessageClient) (bool, error)
 //	and let the chain decide if it's a new or existing participant?
 //
 // Or if it's a genesis participant just submit it again if error is "block < 0"?
-func waitForFirstBlock(client *rpcclient.HTTP, timeout time.Duration) error {
+func waitForFirstBlock(client rpcclient.StatusClient, timeout time.Duration) error {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
@@ -82,7 +79,7 @@ func waitForFirstBlock(client *rpcclient.HTTP, timeout time.Duration) error {
 	}
 }
 
-func RegisterParticipantIfNeeded(recorder cosmosclient.CosmosMessageClient, config *apiconfig.ConfigManager) error {
+func RegisterParticipantIfNeeded(recorder cosmosclient.CosmosMessageClient, config *apiconfig.ConfigManager, endpoints *cosmosclient.EndpointPool) error {
 	isTest := os.Getenv("TESTS") == "true"
 	if !isTest {
 		return nil
@@ -96,19 +93,20 @@ func RegisterParticipantIfNeeded(recorder cosmosclient.CosmosMessageClient, conf
 		logging.Info("Genesis participant registration disabled - participants are pre-registered in genesis", types.Participants)
 		return nil
 	} else {
-		return registerJoiningParticipant(recorder, config)
+		return registerJoiningParticipant(recorder, config, endpoints)
 	}
 }
 
-func registerJoiningParticipant(recorder cosmosclient.CosmosMessageClient, configManager *apiconfig.ConfigManager) error {
-	if exists, err := participantExistsWithWait(recorder, configManager.GetChainNodeConfig().Url); exists {
+func registerJoiningParticipant(recorder cosmosclient.CosmosMessageClient, configManager *apiconfig.ConfigManager, endpoints *cosmosclient.EndpointPool) error {
+	if exists, err := participantExistsWithWait(recorder); exists {
 		logging.Info("Participant already exists, skipping registration", types.Participants)
 		return nil
 	} else if err != nil {
 		return fmt.Errorf("Failed to check if participant exists: %w", err)
 	}
 
-	validatorKey, err := getValidatorKey(configManager.GetChainNodeConfig().Url)
+	// The consensus key is the one of our own node, never of a fallback endpoint
+	validatorKey, err := getValidatorKey(endpoints.Primary())
 	if err != nil {
 		return err
 	}
@@ -184,13 +182,7 @@ func keyToStringFromBytes(keyBytes []byte) string {
 	return base64.StdEncoding.EncodeToString(keyBytes)
 }
 
-func getValidatorKey(chainNodeUrl string) (crypto.PubKey, error) {
-	// Get validator key through RPC
-	client, err := cosmosclient.NewRpcClient(chainNodeUrl)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create tendermint RPC client: %w", err)
-	}
-
+func getValidatorKey(client rpcclient.StatusClient) (crypto.PubKey, error) {
 	// Get validator info
 	result, err := client.Status(context.Background())
 	if err != nil {
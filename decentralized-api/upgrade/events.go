@@ -3,7 +3,6 @@ package upgrade
 import (
 	"decentralized-api/apiconfig"
 	"decentralized-api/cosmosclient"
-	"decentralized-api/internal/event_listener/chainevents"
 	"decentralized-api/logging"
 	"encoding/json"
 	"os"
@@ -12,16 +11,11 @@ import (
 	"github.com/productscience/inference/x/inference/types"
 )
 
+// ProcessNewBlockEvent picks up scheduled upgrades and switches the node version once they are due. Called on every new block.
 func ProcessNewBlockEvent(
-	event *chainevents.JSONRPCResponse,
 	transactionRecorder cosmosclient.InferenceCosmosClient,
 	configManager *apiconfig.ConfigManager,
 ) {
-	if event.Result.Data.Type != "tendermint/event/NewBlock" {
-		logging.Error("Expected tendermint/event/NewBlock event", types.Upgrades, "event", event.Result.Data.Type)
-		return
-	}
-
 	checkForPartialUpgradesScheduled(transactionRecorder, configManager)
 	checkForFullUpgradesScheduled(transactionRecorder, configManager)
 
@@ -5,7 +5,14 @@ const (
 	XSeedHeader             = "X-Seed"
 	XInferenceIdHeader      = "X-Inference-Id"
 	XRequesterAddressHeader = "X-Requester-Address"
+	XRequesterKeyHeader     = "X-Requester-Key"
+	XPriceQuoteIdHeader     = "X-Price-Quote-Id"
 	XTimestampHeader        = "X-Timestamp"
 	XTransferAddressHeader  = "X-Transfer-Address"
 	XTASignatureHeader      = "X-TA-Signature"
+
+	// Response trailers with the executor's signed statement of the response it produced,
+	// usable as evidence in MsgSubmitInferenceEvidence
+	XExecutionResponseHashHeader = "X-Execution-Response-Hash"
+	XExecutionSignatureHeader    = "X-Execution-Signature"
 )
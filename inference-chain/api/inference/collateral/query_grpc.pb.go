@@ -24,6 +24,9 @@ const (
 	Query_AllCollaterals_FullMethodName          = "/inference.collateral.Query/AllCollaterals"
 	Query_UnbondingCollateral_FullMethodName     = "/inference.collateral.Query/UnbondingCollateral"
 	Query_AllUnbondingCollaterals_FullMethodName = "/inference.collateral.Query/AllUnbondingCollaterals"
+	Query_HostDelegations_FullMethodName         = "/inference.collateral.Query/HostDelegations"
+	Query_DelegatorDelegations_FullMethodName    = "/inference.collateral.Query/DelegatorDelegations"
+	Query_JailHistory_FullMethodName             = "/inference.collateral.Query/JailHistory"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +43,12 @@ type QueryClient interface {
 	UnbondingCollateral(ctx context.Context, in *QueryUnbondingCollateralRequest, opts ...grpc.CallOption) (*QueryUnbondingCollateralResponse, error)
 	// Queries all unbonding collateral.
 	AllUnbondingCollaterals(ctx context.Context, in *QueryAllUnbondingCollateralsRequest, opts ...grpc.CallOption) (*QueryAllUnbondingCollateralsResponse, error)
+	// Queries the delegations backing a host, together with its commission.
+	HostDelegations(ctx context.Context, in *QueryHostDelegationsRequest, opts ...grpc.CallOption) (*QueryHostDelegationsResponse, error)
+	// Queries all delegations made by a delegator.
+	DelegatorDelegations(ctx context.Context, in *QueryDelegatorDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorDelegationsResponse, error)
+	// Queries a participant's jail history, oldest first.
+	JailHistory(ctx context.Context, in *QueryJailHistoryRequest, opts ...grpc.CallOption) (*QueryJailHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -95,6 +104,33 @@ func (c *queryClient) AllUnbondingCollaterals(ctx context.Context, in *QueryAllU
 	return out, nil
 }
 
+func (c *queryClient) HostDelegations(ctx context.Context, in *QueryHostDelegationsRequest, opts ...grpc.CallOption) (*QueryHostDelegationsResponse, error) {
+	out := new(QueryHostDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_HostDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorDelegations(ctx context.Context, in *QueryDelegatorDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorDelegationsResponse, error) {
+	out := new(QueryDelegatorDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) JailHistory(ctx context.Context, in *QueryJailHistoryRequest, opts ...grpc.CallOption) (*QueryJailHistoryResponse, error) {
+	out := new(QueryJailHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_JailHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -109,6 +145,12 @@ type QueryServer interface {
 	UnbondingCollateral(context.Context, *QueryUnbondingCollateralRequest) (*QueryUnbondingCollateralResponse, error)
 	// Queries all unbonding collateral.
 	AllUnbondingCollaterals(context.Context, *QueryAllUnbondingCollateralsRequest) (*QueryAllUnbondingCollateralsResponse, error)
+	// Queries the delegations backing a host, together with its commission.
+	HostDelegations(context.Context, *QueryHostDelegationsRequest) (*QueryHostDelegationsResponse, error)
+	// Queries all delegations made by a delegator.
+	DelegatorDelegations(context.Context, *QueryDelegatorDelegationsRequest) (*QueryDelegatorDelegationsResponse, error)
+	// Queries a participant's jail history, oldest first.
+	JailHistory(context.Context, *QueryJailHistoryRequest) (*QueryJailHistoryResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -131,6 +173,15 @@ func (UnimplementedQueryServer) UnbondingCollateral(context.Context, *QueryUnbon
 func (UnimplementedQueryServer) AllUnbondingCollaterals(context.Context, *QueryAllUnbondingCollateralsRequest) (*QueryAllUnbondingCollateralsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllUnbondingCollaterals not implemented")
 }
+func (UnimplementedQueryServer) HostDelegations(context.Context, *QueryHostDelegationsRequest) (*QueryHostDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostDelegations not implemented")
+}
+func (UnimplementedQueryServer) DelegatorDelegations(context.Context, *QueryDelegatorDelegationsRequest) (*QueryDelegatorDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorDelegations not implemented")
+}
+func (UnimplementedQueryServer) JailHistory(context.Context, *QueryJailHistoryRequest) (*QueryJailHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JailHistory not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -234,6 +285,60 @@ func _Query_AllUnbondingCollaterals_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HostDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HostDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostDelegations(ctx, req.(*QueryHostDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorDelegations(ctx, req.(*QueryDelegatorDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_JailHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryJailHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).JailHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_JailHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).JailHistory(ctx, req.(*QueryJailHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,6 +366,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllUnbondingCollaterals",
 			Handler:    _Query_AllUnbondingCollaterals_Handler,
 		},
+		{
+			MethodName: "HostDelegations",
+			Handler:    _Query_HostDelegations_Handler,
+		},
+		{
+			MethodName: "DelegatorDelegations",
+			Handler:    _Query_DelegatorDelegations_Handler,
+		},
+		{
+			MethodName: "JailHistory",
+			Handler:    _Query_JailHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/collateral/query.proto",
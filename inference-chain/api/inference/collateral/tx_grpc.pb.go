@@ -19,9 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName       = "/inference.collateral.Msg/UpdateParams"
-	Msg_DepositCollateral_FullMethodName  = "/inference.collateral.Msg/DepositCollateral"
-	Msg_WithdrawCollateral_FullMethodName = "/inference.collateral.Msg/WithdrawCollateral"
+	Msg_UpdateParams_FullMethodName              = "/inference.collateral.Msg/UpdateParams"
+	Msg_DepositCollateral_FullMethodName         = "/inference.collateral.Msg/DepositCollateral"
+	Msg_WithdrawCollateral_FullMethodName        = "/inference.collateral.Msg/WithdrawCollateral"
+	Msg_CancelUnbondingCollateral_FullMethodName = "/inference.collateral.Msg/CancelUnbondingCollateral"
+	Msg_DelegateCollateral_FullMethodName        = "/inference.collateral.Msg/DelegateCollateral"
+	Msg_UndelegateCollateral_FullMethodName      = "/inference.collateral.Msg/UndelegateCollateral"
+	Msg_SetCollateralCommission_FullMethodName   = "/inference.collateral.Msg/SetCollateralCommission"
+	Msg_Unjail_FullMethodName                    = "/inference.collateral.Msg/Unjail"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +40,17 @@ type MsgClient interface {
 	DepositCollateral(ctx context.Context, in *MsgDepositCollateral, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error)
 	// WithdrawCollateral initiates withdrawal of collateral (subject to unbonding period)
 	WithdrawCollateral(ctx context.Context, in *MsgWithdrawCollateral, opts ...grpc.CallOption) (*MsgWithdrawCollateralResponse, error)
+	// CancelUnbondingCollateral returns some or all of a pending unbonding entry to active collateral
+	CancelUnbondingCollateral(ctx context.Context, in *MsgCancelUnbondingCollateral, opts ...grpc.CallOption) (*MsgCancelUnbondingCollateralResponse, error)
+	// DelegateCollateral backs a host's collateral with the delegator's funds
+	DelegateCollateral(ctx context.Context, in *MsgDelegateCollateral, opts ...grpc.CallOption) (*MsgDelegateCollateralResponse, error)
+	// UndelegateCollateral moves delegated collateral back to the delegator (subject to unbonding period)
+	UndelegateCollateral(ctx context.Context, in *MsgUndelegateCollateral, opts ...grpc.CallOption) (*MsgUndelegateCollateralResponse, error)
+	// SetCollateralCommission sets the share of delegators' rewards the host keeps
+	SetCollateralCommission(ctx context.Context, in *MsgSetCollateralCommission, opts ...grpc.CallOption) (*MsgSetCollateralCommissionResponse, error)
+	// Unjail releases a participant jailed by the inference module once it has served its minimum
+	// jail time and restored its collateral
+	Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error)
 }
 
 type msgClient struct {
@@ -72,6 +88,51 @@ func (c *msgClient) WithdrawCollateral(ctx context.Context, in *MsgWithdrawColla
 	return out, nil
 }
 
+func (c *msgClient) CancelUnbondingCollateral(ctx context.Context, in *MsgCancelUnbondingCollateral, opts ...grpc.CallOption) (*MsgCancelUnbondingCollateralResponse, error) {
+	out := new(MsgCancelUnbondingCollateralResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelUnbondingCollateral_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateCollateral(ctx context.Context, in *MsgDelegateCollateral, opts ...grpc.CallOption) (*MsgDelegateCollateralResponse, error) {
+	out := new(MsgDelegateCollateralResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateCollateral_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateCollateral(ctx context.Context, in *MsgUndelegateCollateral, opts ...grpc.CallOption) (*MsgUndelegateCollateralResponse, error) {
+	out := new(MsgUndelegateCollateralResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateCollateral_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCollateralCommission(ctx context.Context, in *MsgSetCollateralCommission, opts ...grpc.CallOption) (*MsgSetCollateralCommissionResponse, error) {
+	out := new(MsgSetCollateralCommissionResponse)
+	err := c.cc.Invoke(ctx, Msg_SetCollateralCommission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error) {
+	out := new(MsgUnjailResponse)
+	err := c.cc.Invoke(ctx, Msg_Unjail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -83,6 +144,17 @@ type MsgServer interface {
 	DepositCollateral(context.Context, *MsgDepositCollateral) (*MsgDepositCollateralResponse, error)
 	// WithdrawCollateral initiates withdrawal of collateral (subject to unbonding period)
 	WithdrawCollateral(context.Context, *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error)
+	// CancelUnbondingCollateral returns some or all of a pending unbonding entry to active collateral
+	CancelUnbondingCollateral(context.Context, *MsgCancelUnbondingCollateral) (*MsgCancelUnbondingCollateralResponse, error)
+	// DelegateCollateral backs a host's collateral with the delegator's funds
+	DelegateCollateral(context.Context, *MsgDelegateCollateral) (*MsgDelegateCollateralResponse, error)
+	// UndelegateCollateral moves delegated collateral back to the delegator (subject to unbonding period)
+	UndelegateCollateral(context.Context, *MsgUndelegateCollateral) (*MsgUndelegateCollateralResponse, error)
+	// SetCollateralCommission sets the share of delegators' rewards the host keeps
+	SetCollateralCommission(context.Context, *MsgSetCollateralCommission) (*MsgSetCollateralCommissionResponse, error)
+	// Unjail releases a participant jailed by the inference module once it has served its minimum
+	// jail time and restored its collateral
+	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -99,6 +171,21 @@ func (UnimplementedMsgServer) DepositCollateral(context.Context, *MsgDepositColl
 func (UnimplementedMsgServer) WithdrawCollateral(context.Context, *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCollateral not implemented")
 }
+func (UnimplementedMsgServer) CancelUnbondingCollateral(context.Context, *MsgCancelUnbondingCollateral) (*MsgCancelUnbondingCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUnbondingCollateral not implemented")
+}
+func (UnimplementedMsgServer) DelegateCollateral(context.Context, *MsgDelegateCollateral) (*MsgDelegateCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateCollateral not implemented")
+}
+func (UnimplementedMsgServer) UndelegateCollateral(context.Context, *MsgUndelegateCollateral) (*MsgUndelegateCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateCollateral not implemented")
+}
+func (UnimplementedMsgServer) SetCollateralCommission(context.Context, *MsgSetCollateralCommission) (*MsgSetCollateralCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollateralCommission not implemented")
+}
+func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -166,6 +253,96 @@ func _Msg_WithdrawCollateral_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelUnbondingCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUnbondingCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUnbondingCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelUnbondingCollateral_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUnbondingCollateral(ctx, req.(*MsgCancelUnbondingCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateCollateral_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateCollateral(ctx, req.(*MsgDelegateCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateCollateral_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateCollateral(ctx, req.(*MsgUndelegateCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCollateralCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCollateralCommission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCollateralCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetCollateralCommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCollateralCommission(ctx, req.(*MsgSetCollateralCommission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Unjail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unjail(ctx, req.(*MsgUnjail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -185,6 +362,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawCollateral",
 			Handler:    _Msg_WithdrawCollateral_Handler,
 		},
+		{
+			MethodName: "CancelUnbondingCollateral",
+			Handler:    _Msg_CancelUnbondingCollateral_Handler,
+		},
+		{
+			MethodName: "DelegateCollateral",
+			Handler:    _Msg_DelegateCollateral_Handler,
+		},
+		{
+			MethodName: "UndelegateCollateral",
+			Handler:    _Msg_UndelegateCollateral_Handler,
+		},
+		{
+			MethodName: "SetCollateralCommission",
+			Handler:    _Msg_SetCollateralCommission_Handler,
+		},
+		{
+			MethodName: "Unjail",
+			Handler:    _Msg_Unjail_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/collateral/tx.proto",
@@ -24,6 +24,8 @@ const (
 	Query_TransferHistory_FullMethodName     = "/inference.genesistransfer.Query/TransferHistory"
 	Query_AllowedAccounts_FullMethodName     = "/inference.genesistransfer.Query/AllowedAccounts"
 	Query_TransferEligibility_FullMethodName = "/inference.genesistransfer.Query/TransferEligibility"
+	Query_SimulateTransfer_FullMethodName    = "/inference.genesistransfer.Query/SimulateTransfer"
+	Query_PendingTransfers_FullMethodName    = "/inference.genesistransfer.Query/PendingTransfers"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +42,11 @@ type QueryClient interface {
 	AllowedAccounts(ctx context.Context, in *QueryAllowedAccountsRequest, opts ...grpc.CallOption) (*QueryAllowedAccountsResponse, error)
 	// TransferEligibility validates whether a specific account can be transferred.
 	TransferEligibility(ctx context.Context, in *QueryTransferEligibilityRequest, opts ...grpc.CallOption) (*QueryTransferEligibilityResponse, error)
+	// SimulateTransfer reports what a transfer would move, without executing it: the balances, the vesting
+	// type and the vesting schedule the recipient would receive.
+	SimulateTransfer(ctx context.Context, in *QuerySimulateTransferRequest, opts ...grpc.CallOption) (*QuerySimulateTransferResponse, error)
+	// PendingTransfers queries the transfers waiting for their recipients to accept them
+	PendingTransfers(ctx context.Context, in *QueryPendingTransfersRequest, opts ...grpc.CallOption) (*QueryPendingTransfersResponse, error)
 }
 
 type queryClient struct {
@@ -95,6 +102,24 @@ func (c *queryClient) TransferEligibility(ctx context.Context, in *QueryTransfer
 	return out, nil
 }
 
+func (c *queryClient) SimulateTransfer(ctx context.Context, in *QuerySimulateTransferRequest, opts ...grpc.CallOption) (*QuerySimulateTransferResponse, error) {
+	out := new(QuerySimulateTransferResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingTransfers(ctx context.Context, in *QueryPendingTransfersRequest, opts ...grpc.CallOption) (*QueryPendingTransfersResponse, error) {
+	out := new(QueryPendingTransfersResponse)
+	err := c.cc.Invoke(ctx, Query_PendingTransfers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -109,6 +134,11 @@ type QueryServer interface {
 	AllowedAccounts(context.Context, *QueryAllowedAccountsRequest) (*QueryAllowedAccountsResponse, error)
 	// TransferEligibility validates whether a specific account can be transferred.
 	TransferEligibility(context.Context, *QueryTransferEligibilityRequest) (*QueryTransferEligibilityResponse, error)
+	// SimulateTransfer reports what a transfer would move, without executing it: the balances, the vesting
+	// type and the vesting schedule the recipient would receive.
+	SimulateTransfer(context.Context, *QuerySimulateTransferRequest) (*QuerySimulateTransferResponse, error)
+	// PendingTransfers queries the transfers waiting for their recipients to accept them
+	PendingTransfers(context.Context, *QueryPendingTransfersRequest) (*QueryPendingTransfersResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -131,6 +161,12 @@ func (UnimplementedQueryServer) AllowedAccounts(context.Context, *QueryAllowedAc
 func (UnimplementedQueryServer) TransferEligibility(context.Context, *QueryTransferEligibilityRequest) (*QueryTransferEligibilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferEligibility not implemented")
 }
+func (UnimplementedQueryServer) SimulateTransfer(context.Context, *QuerySimulateTransferRequest) (*QuerySimulateTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTransfer not implemented")
+}
+func (UnimplementedQueryServer) PendingTransfers(context.Context, *QueryPendingTransfersRequest) (*QueryPendingTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTransfers not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -234,6 +270,42 @@ func _Query_TransferEligibility_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateTransfer(ctx, req.(*QuerySimulateTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingTransfers(ctx, req.(*QueryPendingTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,6 +333,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TransferEligibility",
 			Handler:    _Query_TransferEligibility_Handler,
 		},
+		{
+			MethodName: "SimulateTransfer",
+			Handler:    _Query_SimulateTransfer_Handler,
+		},
+		{
+			MethodName: "PendingTransfers",
+			Handler:    _Query_PendingTransfers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/genesistransfer/query.proto",
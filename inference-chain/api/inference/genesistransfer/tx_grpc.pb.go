@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName      = "/inference.genesistransfer.Msg/UpdateParams"
-	Msg_TransferOwnership_FullMethodName = "/inference.genesistransfer.Msg/TransferOwnership"
+	Msg_UpdateParams_FullMethodName            = "/inference.genesistransfer.Msg/UpdateParams"
+	Msg_TransferOwnership_FullMethodName       = "/inference.genesistransfer.Msg/TransferOwnership"
+	Msg_BatchTransferOwnership_FullMethodName  = "/inference.genesistransfer.Msg/BatchTransferOwnership"
+	Msg_AcceptOwnershipTransfer_FullMethodName = "/inference.genesistransfer.Msg/AcceptOwnershipTransfer"
 )
 
 // MsgClient is the client API for Msg service.
@@ -33,6 +35,11 @@ type MsgClient interface {
 	// TransferOwnership transfers complete ownership of a genesis account including
 	// all liquid balances and vesting schedules to the recipient account
 	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
+	// BatchTransferOwnership transfers many genesis accounts at once, all or none of them. With an
+	// acceptance window the transfers wait for their recipients to accept them instead.
+	BatchTransferOwnership(ctx context.Context, in *MsgBatchTransferOwnership, opts ...grpc.CallOption) (*MsgBatchTransferOwnershipResponse, error)
+	// AcceptOwnershipTransfer executes pending transfers to the signing recipient
+	AcceptOwnershipTransfer(ctx context.Context, in *MsgAcceptOwnershipTransfer, opts ...grpc.CallOption) (*MsgAcceptOwnershipTransferResponse, error)
 }
 
 type msgClient struct {
@@ -61,6 +68,24 @@ func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwners
 	return out, nil
 }
 
+func (c *msgClient) BatchTransferOwnership(ctx context.Context, in *MsgBatchTransferOwnership, opts ...grpc.CallOption) (*MsgBatchTransferOwnershipResponse, error) {
+	out := new(MsgBatchTransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchTransferOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptOwnershipTransfer(ctx context.Context, in *MsgAcceptOwnershipTransfer, opts ...grpc.CallOption) (*MsgAcceptOwnershipTransferResponse, error) {
+	out := new(MsgAcceptOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptOwnershipTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -71,6 +96,11 @@ type MsgServer interface {
 	// TransferOwnership transfers complete ownership of a genesis account including
 	// all liquid balances and vesting schedules to the recipient account
 	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
+	// BatchTransferOwnership transfers many genesis accounts at once, all or none of them. With an
+	// acceptance window the transfers wait for their recipients to accept them instead.
+	BatchTransferOwnership(context.Context, *MsgBatchTransferOwnership) (*MsgBatchTransferOwnershipResponse, error)
+	// AcceptOwnershipTransfer executes pending transfers to the signing recipient
+	AcceptOwnershipTransfer(context.Context, *MsgAcceptOwnershipTransfer) (*MsgAcceptOwnershipTransferResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -84,6 +114,12 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
 }
+func (UnimplementedMsgServer) BatchTransferOwnership(context.Context, *MsgBatchTransferOwnership) (*MsgBatchTransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTransferOwnership not implemented")
+}
+func (UnimplementedMsgServer) AcceptOwnershipTransfer(context.Context, *MsgAcceptOwnershipTransfer) (*MsgAcceptOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwnershipTransfer not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -133,6 +169,42 @@ func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BatchTransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchTransferOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchTransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchTransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchTransferOwnership(ctx, req.(*MsgBatchTransferOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptOwnershipTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptOwnershipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptOwnershipTransfer(ctx, req.(*MsgAcceptOwnershipTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -148,6 +220,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TransferOwnership",
 			Handler:    _Msg_TransferOwnership_Handler,
 		},
+		{
+			MethodName: "BatchTransferOwnership",
+			Handler:    _Msg_BatchTransferOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptOwnershipTransfer",
+			Handler:    _Msg_AcceptOwnershipTransfer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/genesistransfer/tx.proto",
@@ -608,6 +608,10 @@ var (
 	fd_Inference_execution_signature          protoreflect.FieldDescriptor
 	fd_Inference_original_prompt              protoreflect.FieldDescriptor
 	fd_Inference_per_token_price              protoreflect.FieldDescriptor
+	fd_Inference_paid_from_credit             protoreflect.FieldDescriptor
+	fd_Inference_requester_key                protoreflect.FieldDescriptor
+	fd_Inference_price_quote_id               protoreflect.FieldDescriptor
+	fd_Inference_requester_key_epoch          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -645,6 +649,10 @@ func init() {
 	fd_Inference_execution_signature = md_Inference.Fields().ByName("execution_signature")
 	fd_Inference_original_prompt = md_Inference.Fields().ByName("original_prompt")
 	fd_Inference_per_token_price = md_Inference.Fields().ByName("per_token_price")
+	fd_Inference_paid_from_credit = md_Inference.Fields().ByName("paid_from_credit")
+	fd_Inference_requester_key = md_Inference.Fields().ByName("requester_key")
+	fd_Inference_price_quote_id = md_Inference.Fields().ByName("price_quote_id")
+	fd_Inference_requester_key_epoch = md_Inference.Fields().ByName("requester_key_epoch")
 }
 
 var _ protoreflect.Message = (*fastReflection_Inference)(nil)
@@ -904,6 +912,30 @@ func (x *fastReflection_Inference) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if x.PaidFromCredit != false {
+		value := protoreflect.ValueOfBool(x.PaidFromCredit)
+		if !f(fd_Inference_paid_from_credit, value) {
+			return
+		}
+	}
+	if x.RequesterKey != "" {
+		value := protoreflect.ValueOfString(x.RequesterKey)
+		if !f(fd_Inference_requester_key, value) {
+			return
+		}
+	}
+	if x.PriceQuoteId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PriceQuoteId)
+		if !f(fd_Inference_price_quote_id, value) {
+			return
+		}
+	}
+	if x.RequesterKeyEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequesterKeyEpoch)
+		if !f(fd_Inference_requester_key_epoch, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -983,6 +1015,14 @@ func (x *fastReflection_Inference) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.OriginalPrompt != ""
 	case "inference.inference.Inference.per_token_price":
 		return x.PerTokenPrice != uint64(0)
+	case "inference.inference.Inference.paid_from_credit":
+		return x.PaidFromCredit != false
+	case "inference.inference.Inference.requester_key":
+		return x.RequesterKey != ""
+	case "inference.inference.Inference.price_quote_id":
+		return x.PriceQuoteId != uint64(0)
+	case "inference.inference.Inference.requester_key_epoch":
+		return x.RequesterKeyEpoch != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1063,6 +1103,14 @@ func (x *fastReflection_Inference) Clear(fd protoreflect.FieldDescriptor) {
 		x.OriginalPrompt = ""
 	case "inference.inference.Inference.per_token_price":
 		x.PerTokenPrice = uint64(0)
+	case "inference.inference.Inference.paid_from_credit":
+		x.PaidFromCredit = false
+	case "inference.inference.Inference.requester_key":
+		x.RequesterKey = ""
+	case "inference.inference.Inference.price_quote_id":
+		x.PriceQuoteId = uint64(0)
+	case "inference.inference.Inference.requester_key_epoch":
+		x.RequesterKeyEpoch = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1178,6 +1226,18 @@ func (x *fastReflection_Inference) Get(descriptor protoreflect.FieldDescriptor)
 	case "inference.inference.Inference.per_token_price":
 		value := x.PerTokenPrice
 		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.Inference.paid_from_credit":
+		value := x.PaidFromCredit
+		return protoreflect.ValueOfBool(value)
+	case "inference.inference.Inference.requester_key":
+		value := x.RequesterKey
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.Inference.price_quote_id":
+		value := x.PriceQuoteId
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.Inference.requester_key_epoch":
+		value := x.RequesterKeyEpoch
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1264,6 +1324,14 @@ func (x *fastReflection_Inference) Set(fd protoreflect.FieldDescriptor, value pr
 		x.OriginalPrompt = value.Interface().(string)
 	case "inference.inference.Inference.per_token_price":
 		x.PerTokenPrice = value.Uint()
+	case "inference.inference.Inference.paid_from_credit":
+		x.PaidFromCredit = value.Bool()
+	case "inference.inference.Inference.requester_key":
+		x.RequesterKey = value.Interface().(string)
+	case "inference.inference.Inference.price_quote_id":
+		x.PriceQuoteId = value.Uint()
+	case "inference.inference.Inference.requester_key_epoch":
+		x.RequesterKeyEpoch = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1355,6 +1423,14 @@ func (x *fastReflection_Inference) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field original_prompt of message inference.inference.Inference is not mutable"))
 	case "inference.inference.Inference.per_token_price":
 		panic(fmt.Errorf("field per_token_price of message inference.inference.Inference is not mutable"))
+	case "inference.inference.Inference.paid_from_credit":
+		panic(fmt.Errorf("field paid_from_credit of message inference.inference.Inference is not mutable"))
+	case "inference.inference.Inference.requester_key":
+		panic(fmt.Errorf("field requester_key of message inference.inference.Inference is not mutable"))
+	case "inference.inference.Inference.price_quote_id":
+		panic(fmt.Errorf("field price_quote_id of message inference.inference.Inference is not mutable"))
+	case "inference.inference.Inference.requester_key_epoch":
+		panic(fmt.Errorf("field requester_key_epoch of message inference.inference.Inference is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1434,6 +1510,14 @@ func (x *fastReflection_Inference) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfString("")
 	case "inference.inference.Inference.per_token_price":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.Inference.paid_from_credit":
+		return protoreflect.ValueOfBool(false)
+	case "inference.inference.Inference.requester_key":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.Inference.price_quote_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.Inference.requester_key_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.Inference"))
@@ -1618,6 +1702,19 @@ func (x *fastReflection_Inference) ProtoMethods() *protoiface.Methods {
 		if x.PerTokenPrice != 0 {
 			n += 2 + runtime.Sov(uint64(x.PerTokenPrice))
 		}
+		if x.PaidFromCredit {
+			n += 3
+		}
+		l = len(x.RequesterKey)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.PriceQuoteId != 0 {
+			n += 2 + runtime.Sov(uint64(x.PriceQuoteId))
+		}
+		if x.RequesterKeyEpoch != 0 {
+			n += 2 + runtime.Sov(uint64(x.RequesterKeyEpoch))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1647,6 +1744,41 @@ func (x *fastReflection_Inference) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.RequesterKeyEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequesterKeyEpoch))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa0
+		}
+		if x.PriceQuoteId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PriceQuoteId))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x98
+		}
+		if len(x.RequesterKey) > 0 {
+			i -= len(x.RequesterKey)
+			copy(dAtA[i:], x.RequesterKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequesterKey)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+		if x.PaidFromCredit {
+			i--
+			if x.PaidFromCredit {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x88
+		}
 		if x.PerTokenPrice != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.PerTokenPrice))
 			i--
@@ -2766,6 +2898,96 @@ func (x *fastReflection_Inference) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 33:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PaidFromCredit", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.PaidFromCredit = bool(v != 0)
+			case 34:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequesterKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequesterKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 35:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceQuoteId", wireType)
+				}
+				x.PriceQuoteId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PriceQuoteId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 36:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequesterKeyEpoch", wireType)
+				}
+				x.RequesterKeyEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequesterKeyEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2959,7 +3181,11 @@ type Inference struct {
 	TransferSignature        string           `protobuf:"bytes,29,opt,name=transfer_signature,json=transferSignature,proto3" json:"transfer_signature,omitempty"`
 	ExecutionSignature       string           `protobuf:"bytes,30,opt,name=execution_signature,json=executionSignature,proto3" json:"execution_signature,omitempty"`
 	OriginalPrompt           string           `protobuf:"bytes,31,opt,name=original_prompt,json=originalPrompt,proto3" json:"original_prompt,omitempty"`
-	PerTokenPrice            uint64           `protobuf:"varint,32,opt,name=per_token_price,json=perTokenPrice,proto3" json:"per_token_price,omitempty"` // Locked-in per-token price when inference started (for dynamic pricing)
+	PerTokenPrice            uint64           `protobuf:"varint,32,opt,name=per_token_price,json=perTokenPrice,proto3" json:"per_token_price,omitempty"`             // Locked-in per-token price when inference started (for dynamic pricing)
+	PaidFromCredit           bool             `protobuf:"varint,33,opt,name=paid_from_credit,json=paidFromCredit,proto3" json:"paid_from_credit,omitempty"`          // Escrow was drawn from the requester's DeveloperCredit instead of their account
+	RequesterKey             string           `protobuf:"bytes,34,opt,name=requester_key,json=requesterKey,proto3" json:"requester_key,omitempty"`                   // Inference key that signed for the requester, if any
+	PriceQuoteId             uint64           `protobuf:"varint,35,opt,name=price_quote_id,json=priceQuoteId,proto3" json:"price_quote_id,omitempty"`                // PriceQuote the per_token_price was taken from, if any
+	RequesterKeyEpoch        uint64           `protobuf:"varint,36,opt,name=requester_key_epoch,json=requesterKeyEpoch,proto3" json:"requester_key_epoch,omitempty"` // Epoch the inference was charged to the spend limit of its requester_key in
 }
 
 func (x *Inference) Reset() {
@@ -3206,6 +3432,34 @@ func (x *Inference) GetPerTokenPrice() uint64 {
 	return 0
 }
 
+func (x *Inference) GetPaidFromCredit() bool {
+	if x != nil {
+		return x.PaidFromCredit
+	}
+	return false
+}
+
+func (x *Inference) GetRequesterKey() string {
+	if x != nil {
+		return x.RequesterKey
+	}
+	return ""
+}
+
+func (x *Inference) GetPriceQuoteId() uint64 {
+	if x != nil {
+		return x.PriceQuoteId
+	}
+	return 0
+}
+
+func (x *Inference) GetRequesterKeyEpoch() uint64 {
+	if x != nil {
+		return x.RequesterKeyEpoch
+	}
+	return 0
+}
+
 var File_inference_inference_inference_proto protoreflect.FileDescriptor
 
 var file_inference_inference_inference_proto_rawDesc = []byte{
@@ -3222,7 +3476,7 @@ var file_inference_inference_inference_proto_rawDesc = []byte{
 	0x12, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
 	0x79, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x61, 0x64,
 	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xb7, 0x0a, 0x0a, 0x09, 0x49,
+	0x69, 0x63, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xdc, 0x0b, 0x0a, 0x09, 0x49,
 	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
 	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21,
 	0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
@@ -3306,26 +3560,36 @@ var file_inference_inference_inference_proto_rawDesc = []byte{
 	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x26, 0x0a, 0x0f,
 	0x70, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
 	0x20, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x2a, 0x65, 0x0a, 0x0f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x41, 0x52, 0x54,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44,
-	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10,
-	0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x44,
-	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0b,
-	0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x42, 0xbc, 0x01, 0x0a, 0x17,
-	0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2,
-	0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a,
-	0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x70, 0x61, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x23, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x24, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x4b, 0x65, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x2a, 0x65, 0x0a, 0x0f, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4e,
+	0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x4f, 0x54, 0x49, 0x4e,
+	0x47, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05,
+	0x42, 0xbc, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0e, 0x49, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0xca, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
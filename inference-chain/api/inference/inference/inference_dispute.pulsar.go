@@ -0,0 +1,1299 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package inference
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_InferenceDispute_5_list)(nil)
+
+type _InferenceDispute_5_list struct {
+	list *[]string
+}
+
+func (x *_InferenceDispute_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_InferenceDispute_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_InferenceDispute_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_InferenceDispute_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_InferenceDispute_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message InferenceDispute at list field Validators as it is not of Message kind"))
+}
+
+func (x *_InferenceDispute_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_InferenceDispute_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_InferenceDispute_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_InferenceDispute_6_list)(nil)
+
+type _InferenceDispute_6_list struct {
+	list *[]string
+}
+
+func (x *_InferenceDispute_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_InferenceDispute_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_InferenceDispute_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_InferenceDispute_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_InferenceDispute_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message InferenceDispute at list field PassedVotes as it is not of Message kind"))
+}
+
+func (x *_InferenceDispute_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_InferenceDispute_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_InferenceDispute_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_InferenceDispute_7_list)(nil)
+
+type _InferenceDispute_7_list struct {
+	list *[]string
+}
+
+func (x *_InferenceDispute_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_InferenceDispute_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_InferenceDispute_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_InferenceDispute_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_InferenceDispute_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message InferenceDispute at list field FailedVotes as it is not of Message kind"))
+}
+
+func (x *_InferenceDispute_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_InferenceDispute_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_InferenceDispute_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_InferenceDispute                       protoreflect.MessageDescriptor
+	fd_InferenceDispute_inference_id          protoreflect.FieldDescriptor
+	fd_InferenceDispute_developer             protoreflect.FieldDescriptor
+	fd_InferenceDispute_executor              protoreflect.FieldDescriptor
+	fd_InferenceDispute_bond                  protoreflect.FieldDescriptor
+	fd_InferenceDispute_validators            protoreflect.FieldDescriptor
+	fd_InferenceDispute_passed_votes          protoreflect.FieldDescriptor
+	fd_InferenceDispute_failed_votes          protoreflect.FieldDescriptor
+	fd_InferenceDispute_created_block_height  protoreflect.FieldDescriptor
+	fd_InferenceDispute_deadline_block_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_inference_dispute_proto_init()
+	md_InferenceDispute = File_inference_inference_inference_dispute_proto.Messages().ByName("InferenceDispute")
+	fd_InferenceDispute_inference_id = md_InferenceDispute.Fields().ByName("inference_id")
+	fd_InferenceDispute_developer = md_InferenceDispute.Fields().ByName("developer")
+	fd_InferenceDispute_executor = md_InferenceDispute.Fields().ByName("executor")
+	fd_InferenceDispute_bond = md_InferenceDispute.Fields().ByName("bond")
+	fd_InferenceDispute_validators = md_InferenceDispute.Fields().ByName("validators")
+	fd_InferenceDispute_passed_votes = md_InferenceDispute.Fields().ByName("passed_votes")
+	fd_InferenceDispute_failed_votes = md_InferenceDispute.Fields().ByName("failed_votes")
+	fd_InferenceDispute_created_block_height = md_InferenceDispute.Fields().ByName("created_block_height")
+	fd_InferenceDispute_deadline_block_height = md_InferenceDispute.Fields().ByName("deadline_block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_InferenceDispute)(nil)
+
+type fastReflection_InferenceDispute InferenceDispute
+
+func (x *InferenceDispute) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InferenceDispute)(x)
+}
+
+func (x *InferenceDispute) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_inference_dispute_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InferenceDispute_messageType fastReflection_InferenceDispute_messageType
+var _ protoreflect.MessageType = fastReflection_InferenceDispute_messageType{}
+
+type fastReflection_InferenceDispute_messageType struct{}
+
+func (x fastReflection_InferenceDispute_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InferenceDispute)(nil)
+}
+func (x fastReflection_InferenceDispute_messageType) New() protoreflect.Message {
+	return new(fastReflection_InferenceDispute)
+}
+func (x fastReflection_InferenceDispute_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InferenceDispute
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InferenceDispute) Descriptor() protoreflect.MessageDescriptor {
+	return md_InferenceDispute
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InferenceDispute) Type() protoreflect.MessageType {
+	return _fastReflection_InferenceDispute_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InferenceDispute) New() protoreflect.Message {
+	return new(fastReflection_InferenceDispute)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InferenceDispute) Interface() protoreflect.ProtoMessage {
+	return (*InferenceDispute)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InferenceDispute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InferenceId != "" {
+		value := protoreflect.ValueOfString(x.InferenceId)
+		if !f(fd_InferenceDispute_inference_id, value) {
+			return
+		}
+	}
+	if x.Developer != "" {
+		value := protoreflect.ValueOfString(x.Developer)
+		if !f(fd_InferenceDispute_developer, value) {
+			return
+		}
+	}
+	if x.Executor != "" {
+		value := protoreflect.ValueOfString(x.Executor)
+		if !f(fd_InferenceDispute_executor, value) {
+			return
+		}
+	}
+	if x.Bond != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Bond)
+		if !f(fd_InferenceDispute_bond, value) {
+			return
+		}
+	}
+	if len(x.Validators) != 0 {
+		value := protoreflect.ValueOfList(&_InferenceDispute_5_list{list: &x.Validators})
+		if !f(fd_InferenceDispute_validators, value) {
+			return
+		}
+	}
+	if len(x.PassedVotes) != 0 {
+		value := protoreflect.ValueOfList(&_InferenceDispute_6_list{list: &x.PassedVotes})
+		if !f(fd_InferenceDispute_passed_votes, value) {
+			return
+		}
+	}
+	if len(x.FailedVotes) != 0 {
+		value := protoreflect.ValueOfList(&_InferenceDispute_7_list{list: &x.FailedVotes})
+		if !f(fd_InferenceDispute_failed_votes, value) {
+			return
+		}
+	}
+	if x.CreatedBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedBlockHeight)
+		if !f(fd_InferenceDispute_created_block_height, value) {
+			return
+		}
+	}
+	if x.DeadlineBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DeadlineBlockHeight)
+		if !f(fd_InferenceDispute_deadline_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InferenceDispute) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.InferenceDispute.inference_id":
+		return x.InferenceId != ""
+	case "inference.inference.InferenceDispute.developer":
+		return x.Developer != ""
+	case "inference.inference.InferenceDispute.executor":
+		return x.Executor != ""
+	case "inference.inference.InferenceDispute.bond":
+		return x.Bond != uint64(0)
+	case "inference.inference.InferenceDispute.validators":
+		return len(x.Validators) != 0
+	case "inference.inference.InferenceDispute.passed_votes":
+		return len(x.PassedVotes) != 0
+	case "inference.inference.InferenceDispute.failed_votes":
+		return len(x.FailedVotes) != 0
+	case "inference.inference.InferenceDispute.created_block_height":
+		return x.CreatedBlockHeight != int64(0)
+	case "inference.inference.InferenceDispute.deadline_block_height":
+		return x.DeadlineBlockHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceDispute"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InferenceDispute) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.InferenceDispute.inference_id":
+		x.InferenceId = ""
+	case "inference.inference.InferenceDispute.developer":
+		x.Developer = ""
+	case "inference.inference.InferenceDispute.executor":
+		x.Executor = ""
+	case "inference.inference.InferenceDispute.bond":
+		x.Bond = uint64(0)
+	case "inference.inference.InferenceDispute.validators":
+		x.Validators = nil
+	case "inference.inference.InferenceDispute.passed_votes":
+		x.PassedVotes = nil
+	case "inference.inference.InferenceDispute.failed_votes":
+		x.FailedVotes = nil
+	case "inference.inference.InferenceDispute.created_block_height":
+		x.CreatedBlockHeight = int64(0)
+	case "inference.inference.InferenceDispute.deadline_block_height":
+		x.DeadlineBlockHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceDispute"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InferenceDispute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.InferenceDispute.inference_id":
+		value := x.InferenceId
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.InferenceDispute.developer":
+		value := x.Developer
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.InferenceDispute.executor":
+		value := x.Executor
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.InferenceDispute.bond":
+		value := x.Bond
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.InferenceDispute.validators":
+		if len(x.Validators) == 0 {
+			return protoreflect.ValueOfList(&_InferenceDispute_5_list{})
+		}
+		listValue := &_InferenceDispute_5_list{list: &x.Validators}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.InferenceDispute.passed_votes":
+		if len(x.PassedVotes) == 0 {
+			return protoreflect.ValueOfList(&_InferenceDispute_6_list{})
+		}
+		listValue := &_InferenceDispute_6_list{list: &x.PassedVotes}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.InferenceDispute.failed_votes":
+		if len(x.FailedVotes) == 0 {
+			return protoreflect.ValueOfList(&_InferenceDispute_7_list{})
+		}
+		listValue := &_InferenceDispute_7_list{list: &x.FailedVotes}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.InferenceDispute.created_block_height":
+		value := x.CreatedBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.InferenceDispute.deadline_block_height":
+		value := x.DeadlineBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceDispute"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceDispute does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InferenceDispute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.InferenceDispute.inference_id":
+		x.InferenceId = value.Interface().(string)
+	case "inference.inference.InferenceDispute.developer":
+		x.Developer = value.Interface().(string)
+	case "inference.inference.InferenceDispute.executor":
+		x.Executor = value.Interface().(string)
+	case "inference.inference.InferenceDispute.bond":
+		x.Bond = value.Uint()
+	case "inference.inference.InferenceDispute.validators":
+		lv := value.List()
+		clv := lv.(*_InferenceDispute_5_list)
+		x.Validators = *clv.list
+	case "inference.inference.InferenceDispute.passed_votes":
+		lv := value.List()
+		clv := lv.(*_InferenceDispute_6_list)
+		x.PassedVotes = *clv.list
+	case "inference.inference.InferenceDispute.failed_votes":
+		lv := value.List()
+		clv := lv.(*_InferenceDispute_7_list)
+		x.FailedVotes = *clv.list
+	case "inference.inference.InferenceDispute.created_block_height":
+		x.CreatedBlockHeight = value.Int()
+	case "inference.inference.InferenceDispute.deadline_block_height":
+		x.DeadlineBlockHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceDispute"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InferenceDispute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.InferenceDispute.validators":
+		if x.Validators == nil {
+			x.Validators = []string{}
+		}
+		value := &_InferenceDispute_5_list{list: &x.Validators}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.InferenceDispute.passed_votes":
+		if x.PassedVotes == nil {
+			x.PassedVotes = []string{}
+		}
+		value := &_InferenceDispute_6_list{list: &x.PassedVotes}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.InferenceDispute.failed_votes":
+		if x.FailedVotes == nil {
+			x.FailedVotes = []string{}
+		}
+		value := &_InferenceDispute_7_list{list: &x.FailedVotes}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.InferenceDispute.inference_id":
+		panic(fmt.Errorf("field inference_id of message inference.inference.InferenceDispute is not mutable"))
+	case "inference.inference.InferenceDispute.developer":
+		panic(fmt.Errorf("field developer of message inference.inference.InferenceDispute is not mutable"))
+	case "inference.inference.InferenceDispute.executor":
+		panic(fmt.Errorf("field executor of message inference.inference.InferenceDispute is not mutable"))
+	case "inference.inference.InferenceDispute.bond":
+		panic(fmt.Errorf("field bond of message inference.inference.InferenceDispute is not mutable"))
+	case "inference.inference.InferenceDispute.created_block_height":
+		panic(fmt.Errorf("field created_block_height of message inference.inference.InferenceDispute is not mutable"))
+	case "inference.inference.InferenceDispute.deadline_block_height":
+		panic(fmt.Errorf("field deadline_block_height of message inference.inference.InferenceDispute is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceDispute"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InferenceDispute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.InferenceDispute.inference_id":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.InferenceDispute.developer":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.InferenceDispute.executor":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.InferenceDispute.bond":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.InferenceDispute.validators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_InferenceDispute_5_list{list: &list})
+	case "inference.inference.InferenceDispute.passed_votes":
+		list := []string{}
+		return protoreflect.ValueOfList(&_InferenceDispute_6_list{list: &list})
+	case "inference.inference.InferenceDispute.failed_votes":
+		list := []string{}
+		return protoreflect.ValueOfList(&_InferenceDispute_7_list{list: &list})
+	case "inference.inference.InferenceDispute.created_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.InferenceDispute.deadline_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceDispute"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InferenceDispute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.InferenceDispute", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InferenceDispute) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InferenceDispute) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InferenceDispute) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InferenceDispute) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InferenceDispute)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.InferenceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Developer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Executor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Bond != 0 {
+			n += 1 + runtime.Sov(uint64(x.Bond))
+		}
+		if len(x.Validators) > 0 {
+			for _, s := range x.Validators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PassedVotes) > 0 {
+			for _, s := range x.PassedVotes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FailedVotes) > 0 {
+			for _, s := range x.FailedVotes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CreatedBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedBlockHeight))
+		}
+		if x.DeadlineBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeadlineBlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InferenceDispute)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DeadlineBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeadlineBlockHeight))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.CreatedBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedBlockHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.FailedVotes) > 0 {
+			for iNdEx := len(x.FailedVotes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.FailedVotes[iNdEx])
+				copy(dAtA[i:], x.FailedVotes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FailedVotes[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.PassedVotes) > 0 {
+			for iNdEx := len(x.PassedVotes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PassedVotes[iNdEx])
+				copy(dAtA[i:], x.PassedVotes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PassedVotes[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Validators) > 0 {
+			for iNdEx := len(x.Validators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Validators[iNdEx])
+				copy(dAtA[i:], x.Validators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validators[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Bond != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Bond))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Executor) > 0 {
+			i -= len(x.Executor)
+			copy(dAtA[i:], x.Executor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executor)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Developer) > 0 {
+			i -= len(x.Developer)
+			copy(dAtA[i:], x.Developer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Developer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.InferenceId) > 0 {
+			i -= len(x.InferenceId)
+			copy(dAtA[i:], x.InferenceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InferenceId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InferenceDispute)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InferenceDispute: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InferenceDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InferenceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Developer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Executor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+				}
+				x.Bond = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Bond |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validators = append(x.Validators, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PassedVotes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PassedVotes = append(x.PassedVotes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedVotes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FailedVotes = append(x.FailedVotes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedBlockHeight", wireType)
+				}
+				x.CreatedBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeadlineBlockHeight", wireType)
+				}
+				x.DeadlineBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeadlineBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: inference/inference/inference_dispute.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// InferenceDispute is an open challenge of a single inference by the developer who paid for it.
+type InferenceDispute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InferenceId string `protobuf:"bytes,1,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	Developer   string `protobuf:"bytes,2,opt,name=developer,proto3" json:"developer,omitempty"`
+	Executor    string `protobuf:"bytes,3,opt,name=executor,proto3" json:"executor,omitempty"`
+	Bond        uint64 `protobuf:"varint,4,opt,name=bond,proto3" json:"bond,omitempty"`
+	// validators chosen at random to re-run the inference
+	Validators         []string `protobuf:"bytes,5,rep,name=validators,proto3" json:"validators,omitempty"`
+	PassedVotes        []string `protobuf:"bytes,6,rep,name=passed_votes,json=passedVotes,proto3" json:"passed_votes,omitempty"`
+	FailedVotes        []string `protobuf:"bytes,7,rep,name=failed_votes,json=failedVotes,proto3" json:"failed_votes,omitempty"`
+	CreatedBlockHeight int64    `protobuf:"varint,8,opt,name=created_block_height,json=createdBlockHeight,proto3" json:"created_block_height,omitempty"`
+	// height after which a dispute no majority has decided expires and the bond is refunded
+	DeadlineBlockHeight int64 `protobuf:"varint,9,opt,name=deadline_block_height,json=deadlineBlockHeight,proto3" json:"deadline_block_height,omitempty"`
+}
+
+func (x *InferenceDispute) Reset() {
+	*x = InferenceDispute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_inference_dispute_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InferenceDispute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InferenceDispute) ProtoMessage() {}
+
+// Deprecated: Use InferenceDispute.ProtoReflect.Descriptor instead.
+func (*InferenceDispute) Descriptor() ([]byte, []int) {
+	return file_inference_inference_inference_dispute_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InferenceDispute) GetInferenceId() string {
+	if x != nil {
+		return x.InferenceId
+	}
+	return ""
+}
+
+func (x *InferenceDispute) GetDeveloper() string {
+	if x != nil {
+		return x.Developer
+	}
+	return ""
+}
+
+func (x *InferenceDispute) GetExecutor() string {
+	if x != nil {
+		return x.Executor
+	}
+	return ""
+}
+
+func (x *InferenceDispute) GetBond() uint64 {
+	if x != nil {
+		return x.Bond
+	}
+	return 0
+}
+
+func (x *InferenceDispute) GetValidators() []string {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *InferenceDispute) GetPassedVotes() []string {
+	if x != nil {
+		return x.PassedVotes
+	}
+	return nil
+}
+
+func (x *InferenceDispute) GetFailedVotes() []string {
+	if x != nil {
+		return x.FailedVotes
+	}
+	return nil
+}
+
+func (x *InferenceDispute) GetCreatedBlockHeight() int64 {
+	if x != nil {
+		return x.CreatedBlockHeight
+	}
+	return 0
+}
+
+func (x *InferenceDispute) GetDeadlineBlockHeight() int64 {
+	if x != nil {
+		return x.DeadlineBlockHeight
+	}
+	return 0
+}
+
+var File_inference_inference_inference_dispute_proto protoreflect.FileDescriptor
+
+var file_inference_inference_inference_dispute_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x22, 0xcf, 0x02, 0x0a, 0x10, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64,
+	0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x73, 0x73,
+	0x65, 0x64, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x30,
+	0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x13, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x42, 0xc3, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x42, 0x15, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x73, 0x70, 0x75,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2,
+	0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a,
+	0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_inference_inference_inference_dispute_proto_rawDescOnce sync.Once
+	file_inference_inference_inference_dispute_proto_rawDescData = file_inference_inference_inference_dispute_proto_rawDesc
+)
+
+func file_inference_inference_inference_dispute_proto_rawDescGZIP() []byte {
+	file_inference_inference_inference_dispute_proto_rawDescOnce.Do(func() {
+		file_inference_inference_inference_dispute_proto_rawDescData = protoimpl.X.CompressGZIP(file_inference_inference_inference_dispute_proto_rawDescData)
+	})
+	return file_inference_inference_inference_dispute_proto_rawDescData
+}
+
+var file_inference_inference_inference_dispute_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_inference_inference_inference_dispute_proto_goTypes = []interface{}{
+	(*InferenceDispute)(nil), // 0: inference.inference.InferenceDispute
+}
+var file_inference_inference_inference_dispute_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_inference_inference_inference_dispute_proto_init() }
+func file_inference_inference_inference_dispute_proto_init() {
+	if File_inference_inference_inference_dispute_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_inference_inference_inference_dispute_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InferenceDispute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_inference_inference_inference_dispute_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_inference_inference_inference_dispute_proto_goTypes,
+		DependencyIndexes: file_inference_inference_inference_dispute_proto_depIdxs,
+		MessageInfos:      file_inference_inference_inference_dispute_proto_msgTypes,
+	}.Build()
+	File_inference_inference_inference_dispute_proto = out.File
+	file_inference_inference_inference_dispute_proto_rawDesc = nil
+	file_inference_inference_inference_dispute_proto_goTypes = nil
+	file_inference_inference_inference_dispute_proto_depIdxs = nil
+}
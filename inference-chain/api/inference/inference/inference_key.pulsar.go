@@ -0,0 +1,1738 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package inference
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_InferenceKeyGrant_4_list)(nil)
+
+type _InferenceKeyGrant_4_list struct {
+	list *[]string
+}
+
+func (x *_InferenceKeyGrant_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_InferenceKeyGrant_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_InferenceKeyGrant_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_InferenceKeyGrant_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_InferenceKeyGrant_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message InferenceKeyGrant at list field AllowedModels as it is not of Message kind"))
+}
+
+func (x *_InferenceKeyGrant_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_InferenceKeyGrant_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_InferenceKeyGrant_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_InferenceKeyGrant                        protoreflect.MessageDescriptor
+	fd_InferenceKeyGrant_developer              protoreflect.FieldDescriptor
+	fd_InferenceKeyGrant_key_address            protoreflect.FieldDescriptor
+	fd_InferenceKeyGrant_epoch_spend_limit      protoreflect.FieldDescriptor
+	fd_InferenceKeyGrant_allowed_models         protoreflect.FieldDescriptor
+	fd_InferenceKeyGrant_expiration             protoreflect.FieldDescriptor
+	fd_InferenceKeyGrant_max_tokens_per_request protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_inference_key_proto_init()
+	md_InferenceKeyGrant = File_inference_inference_inference_key_proto.Messages().ByName("InferenceKeyGrant")
+	fd_InferenceKeyGrant_developer = md_InferenceKeyGrant.Fields().ByName("developer")
+	fd_InferenceKeyGrant_key_address = md_InferenceKeyGrant.Fields().ByName("key_address")
+	fd_InferenceKeyGrant_epoch_spend_limit = md_InferenceKeyGrant.Fields().ByName("epoch_spend_limit")
+	fd_InferenceKeyGrant_allowed_models = md_InferenceKeyGrant.Fields().ByName("allowed_models")
+	fd_InferenceKeyGrant_expiration = md_InferenceKeyGrant.Fields().ByName("expiration")
+	fd_InferenceKeyGrant_max_tokens_per_request = md_InferenceKeyGrant.Fields().ByName("max_tokens_per_request")
+}
+
+var _ protoreflect.Message = (*fastReflection_InferenceKeyGrant)(nil)
+
+type fastReflection_InferenceKeyGrant InferenceKeyGrant
+
+func (x *InferenceKeyGrant) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InferenceKeyGrant)(x)
+}
+
+func (x *InferenceKeyGrant) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_inference_key_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InferenceKeyGrant_messageType fastReflection_InferenceKeyGrant_messageType
+var _ protoreflect.MessageType = fastReflection_InferenceKeyGrant_messageType{}
+
+type fastReflection_InferenceKeyGrant_messageType struct{}
+
+func (x fastReflection_InferenceKeyGrant_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InferenceKeyGrant)(nil)
+}
+func (x fastReflection_InferenceKeyGrant_messageType) New() protoreflect.Message {
+	return new(fastReflection_InferenceKeyGrant)
+}
+func (x fastReflection_InferenceKeyGrant_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InferenceKeyGrant
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InferenceKeyGrant) Descriptor() protoreflect.MessageDescriptor {
+	return md_InferenceKeyGrant
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InferenceKeyGrant) Type() protoreflect.MessageType {
+	return _fastReflection_InferenceKeyGrant_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InferenceKeyGrant) New() protoreflect.Message {
+	return new(fastReflection_InferenceKeyGrant)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InferenceKeyGrant) Interface() protoreflect.ProtoMessage {
+	return (*InferenceKeyGrant)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InferenceKeyGrant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Developer != "" {
+		value := protoreflect.ValueOfString(x.Developer)
+		if !f(fd_InferenceKeyGrant_developer, value) {
+			return
+		}
+	}
+	if x.KeyAddress != "" {
+		value := protoreflect.ValueOfString(x.KeyAddress)
+		if !f(fd_InferenceKeyGrant_key_address, value) {
+			return
+		}
+	}
+	if x.EpochSpendLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochSpendLimit)
+		if !f(fd_InferenceKeyGrant_epoch_spend_limit, value) {
+			return
+		}
+	}
+	if len(x.AllowedModels) != 0 {
+		value := protoreflect.ValueOfList(&_InferenceKeyGrant_4_list{list: &x.AllowedModels})
+		if !f(fd_InferenceKeyGrant_allowed_models, value) {
+			return
+		}
+	}
+	if x.Expiration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Expiration)
+		if !f(fd_InferenceKeyGrant_expiration, value) {
+			return
+		}
+	}
+	if x.MaxTokensPerRequest != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTokensPerRequest)
+		if !f(fd_InferenceKeyGrant_max_tokens_per_request, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InferenceKeyGrant) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.InferenceKeyGrant.developer":
+		return x.Developer != ""
+	case "inference.inference.InferenceKeyGrant.key_address":
+		return x.KeyAddress != ""
+	case "inference.inference.InferenceKeyGrant.epoch_spend_limit":
+		return x.EpochSpendLimit != uint64(0)
+	case "inference.inference.InferenceKeyGrant.allowed_models":
+		return len(x.AllowedModels) != 0
+	case "inference.inference.InferenceKeyGrant.expiration":
+		return x.Expiration != int64(0)
+	case "inference.inference.InferenceKeyGrant.max_tokens_per_request":
+		return x.MaxTokensPerRequest != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceKeyGrant"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceKeyGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InferenceKeyGrant) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.InferenceKeyGrant.developer":
+		x.Developer = ""
+	case "inference.inference.InferenceKeyGrant.key_address":
+		x.KeyAddress = ""
+	case "inference.inference.InferenceKeyGrant.epoch_spend_limit":
+		x.EpochSpendLimit = uint64(0)
+	case "inference.inference.InferenceKeyGrant.allowed_models":
+		x.AllowedModels = nil
+	case "inference.inference.InferenceKeyGrant.expiration":
+		x.Expiration = int64(0)
+	case "inference.inference.InferenceKeyGrant.max_tokens_per_request":
+		x.MaxTokensPerRequest = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceKeyGrant"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceKeyGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InferenceKeyGrant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.InferenceKeyGrant.developer":
+		value := x.Developer
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.InferenceKeyGrant.key_address":
+		value := x.KeyAddress
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.InferenceKeyGrant.epoch_spend_limit":
+		value := x.EpochSpendLimit
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.InferenceKeyGrant.allowed_models":
+		if len(x.AllowedModels) == 0 {
+			return protoreflect.ValueOfList(&_InferenceKeyGrant_4_list{})
+		}
+		listValue := &_InferenceKeyGrant_4_list{list: &x.AllowedModels}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.InferenceKeyGrant.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.InferenceKeyGrant.max_tokens_per_request":
+		value := x.MaxTokensPerRequest
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceKeyGrant"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceKeyGrant does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InferenceKeyGrant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.InferenceKeyGrant.developer":
+		x.Developer = value.Interface().(string)
+	case "inference.inference.InferenceKeyGrant.key_address":
+		x.KeyAddress = value.Interface().(string)
+	case "inference.inference.InferenceKeyGrant.epoch_spend_limit":
+		x.EpochSpendLimit = value.Uint()
+	case "inference.inference.InferenceKeyGrant.allowed_models":
+		lv := value.List()
+		clv := lv.(*_InferenceKeyGrant_4_list)
+		x.AllowedModels = *clv.list
+	case "inference.inference.InferenceKeyGrant.expiration":
+		x.Expiration = value.Int()
+	case "inference.inference.InferenceKeyGrant.max_tokens_per_request":
+		x.MaxTokensPerRequest = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceKeyGrant"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceKeyGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InferenceKeyGrant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.InferenceKeyGrant.allowed_models":
+		if x.AllowedModels == nil {
+			x.AllowedModels = []string{}
+		}
+		value := &_InferenceKeyGrant_4_list{list: &x.AllowedModels}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.InferenceKeyGrant.developer":
+		panic(fmt.Errorf("field developer of message inference.inference.InferenceKeyGrant is not mutable"))
+	case "inference.inference.InferenceKeyGrant.key_address":
+		panic(fmt.Errorf("field key_address of message inference.inference.InferenceKeyGrant is not mutable"))
+	case "inference.inference.InferenceKeyGrant.epoch_spend_limit":
+		panic(fmt.Errorf("field epoch_spend_limit of message inference.inference.InferenceKeyGrant is not mutable"))
+	case "inference.inference.InferenceKeyGrant.expiration":
+		panic(fmt.Errorf("field expiration of message inference.inference.InferenceKeyGrant is not mutable"))
+	case "inference.inference.InferenceKeyGrant.max_tokens_per_request":
+		panic(fmt.Errorf("field max_tokens_per_request of message inference.inference.InferenceKeyGrant is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceKeyGrant"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceKeyGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InferenceKeyGrant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.InferenceKeyGrant.developer":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.InferenceKeyGrant.key_address":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.InferenceKeyGrant.epoch_spend_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.InferenceKeyGrant.allowed_models":
+		list := []string{}
+		return protoreflect.ValueOfList(&_InferenceKeyGrant_4_list{list: &list})
+	case "inference.inference.InferenceKeyGrant.expiration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.InferenceKeyGrant.max_tokens_per_request":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceKeyGrant"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceKeyGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InferenceKeyGrant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.InferenceKeyGrant", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InferenceKeyGrant) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InferenceKeyGrant) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InferenceKeyGrant) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InferenceKeyGrant) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InferenceKeyGrant)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Developer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.KeyAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EpochSpendLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochSpendLimit))
+		}
+		if len(x.AllowedModels) > 0 {
+			for _, s := range x.AllowedModels {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Expiration != 0 {
+			n += 1 + runtime.Sov(uint64(x.Expiration))
+		}
+		if x.MaxTokensPerRequest != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTokensPerRequest))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InferenceKeyGrant)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxTokensPerRequest != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTokensPerRequest))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Expiration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Expiration))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.AllowedModels) > 0 {
+			for iNdEx := len(x.AllowedModels) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedModels[iNdEx])
+				copy(dAtA[i:], x.AllowedModels[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedModels[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.EpochSpendLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochSpendLimit))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.KeyAddress) > 0 {
+			i -= len(x.KeyAddress)
+			copy(dAtA[i:], x.KeyAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KeyAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Developer) > 0 {
+			i -= len(x.Developer)
+			copy(dAtA[i:], x.Developer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Developer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InferenceKeyGrant)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InferenceKeyGrant: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InferenceKeyGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Developer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KeyAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochSpendLimit", wireType)
+				}
+				x.EpochSpendLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochSpendLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedModels", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedModels = append(x.AllowedModels, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				x.Expiration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Expiration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTokensPerRequest", wireType)
+				}
+				x.MaxTokensPerRequest = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTokensPerRequest |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_InferenceKeyUsage                 protoreflect.MessageDescriptor
+	fd_InferenceKeyUsage_developer       protoreflect.FieldDescriptor
+	fd_InferenceKeyUsage_key_address     protoreflect.FieldDescriptor
+	fd_InferenceKeyUsage_epoch_index     protoreflect.FieldDescriptor
+	fd_InferenceKeyUsage_epoch_spent     protoreflect.FieldDescriptor
+	fd_InferenceKeyUsage_total_spent     protoreflect.FieldDescriptor
+	fd_InferenceKeyUsage_inference_count protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_inference_key_proto_init()
+	md_InferenceKeyUsage = File_inference_inference_inference_key_proto.Messages().ByName("InferenceKeyUsage")
+	fd_InferenceKeyUsage_developer = md_InferenceKeyUsage.Fields().ByName("developer")
+	fd_InferenceKeyUsage_key_address = md_InferenceKeyUsage.Fields().ByName("key_address")
+	fd_InferenceKeyUsage_epoch_index = md_InferenceKeyUsage.Fields().ByName("epoch_index")
+	fd_InferenceKeyUsage_epoch_spent = md_InferenceKeyUsage.Fields().ByName("epoch_spent")
+	fd_InferenceKeyUsage_total_spent = md_InferenceKeyUsage.Fields().ByName("total_spent")
+	fd_InferenceKeyUsage_inference_count = md_InferenceKeyUsage.Fields().ByName("inference_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_InferenceKeyUsage)(nil)
+
+type fastReflection_InferenceKeyUsage InferenceKeyUsage
+
+func (x *InferenceKeyUsage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InferenceKeyUsage)(x)
+}
+
+func (x *InferenceKeyUsage) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_inference_key_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InferenceKeyUsage_messageType fastReflection_InferenceKeyUsage_messageType
+var _ protoreflect.MessageType = fastReflection_InferenceKeyUsage_messageType{}
+
+type fastReflection_InferenceKeyUsage_messageType struct{}
+
+func (x fastReflection_InferenceKeyUsage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InferenceKeyUsage)(nil)
+}
+func (x fastReflection_InferenceKeyUsage_messageType) New() protoreflect.Message {
+	return new(fastReflection_InferenceKeyUsage)
+}
+func (x fastReflection_InferenceKeyUsage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InferenceKeyUsage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InferenceKeyUsage) Descriptor() protoreflect.MessageDescriptor {
+	return md_InferenceKeyUsage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InferenceKeyUsage) Type() protoreflect.MessageType {
+	return _fastReflection_InferenceKeyUsage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InferenceKeyUsage) New() protoreflect.Message {
+	return new(fastReflection_InferenceKeyUsage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InferenceKeyUsage) Interface() protoreflect.ProtoMessage {
+	return (*InferenceKeyUsage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InferenceKeyUsage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Developer != "" {
+		value := protoreflect.ValueOfString(x.Developer)
+		if !f(fd_InferenceKeyUsage_developer, value) {
+			return
+		}
+	}
+	if x.KeyAddress != "" {
+		value := protoreflect.ValueOfString(x.KeyAddress)
+		if !f(fd_InferenceKeyUsage_key_address, value) {
+			return
+		}
+	}
+	if x.EpochIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochIndex)
+		if !f(fd_InferenceKeyUsage_epoch_index, value) {
+			return
+		}
+	}
+	if x.EpochSpent != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochSpent)
+		if !f(fd_InferenceKeyUsage_epoch_spent, value) {
+			return
+		}
+	}
+	if x.TotalSpent != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalSpent)
+		if !f(fd_InferenceKeyUsage_total_spent, value) {
+			return
+		}
+	}
+	if x.InferenceCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InferenceCount)
+		if !f(fd_InferenceKeyUsage_inference_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InferenceKeyUsage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.InferenceKeyUsage.developer":
+		return x.Developer != ""
+	case "inference.inference.InferenceKeyUsage.key_address":
+		return x.KeyAddress != ""
+	case "inference.inference.InferenceKeyUsage.epoch_index":
+		return x.EpochIndex != uint64(0)
+	case "inference.inference.InferenceKeyUsage.epoch_spent":
+		return x.EpochSpent != uint64(0)
+	case "inference.inference.InferenceKeyUsage.total_spent":
+		return x.TotalSpent != uint64(0)
+	case "inference.inference.InferenceKeyUsage.inference_count":
+		return x.InferenceCount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceKeyUsage"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceKeyUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InferenceKeyUsage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.InferenceKeyUsage.developer":
+		x.Developer = ""
+	case "inference.inference.InferenceKeyUsage.key_address":
+		x.KeyAddress = ""
+	case "inference.inference.InferenceKeyUsage.epoch_index":
+		x.EpochIndex = uint64(0)
+	case "inference.inference.InferenceKeyUsage.epoch_spent":
+		x.EpochSpent = uint64(0)
+	case "inference.inference.InferenceKeyUsage.total_spent":
+		x.TotalSpent = uint64(0)
+	case "inference.inference.InferenceKeyUsage.inference_count":
+		x.InferenceCount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceKeyUsage"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceKeyUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InferenceKeyUsage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.InferenceKeyUsage.developer":
+		value := x.Developer
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.InferenceKeyUsage.key_address":
+		value := x.KeyAddress
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.InferenceKeyUsage.epoch_index":
+		value := x.EpochIndex
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.InferenceKeyUsage.epoch_spent":
+		value := x.EpochSpent
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.InferenceKeyUsage.total_spent":
+		value := x.TotalSpent
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.InferenceKeyUsage.inference_count":
+		value := x.InferenceCount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceKeyUsage"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceKeyUsage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InferenceKeyUsage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.InferenceKeyUsage.developer":
+		x.Developer = value.Interface().(string)
+	case "inference.inference.InferenceKeyUsage.key_address":
+		x.KeyAddress = value.Interface().(string)
+	case "inference.inference.InferenceKeyUsage.epoch_index":
+		x.EpochIndex = value.Uint()
+	case "inference.inference.InferenceKeyUsage.epoch_spent":
+		x.EpochSpent = value.Uint()
+	case "inference.inference.InferenceKeyUsage.total_spent":
+		x.TotalSpent = value.Uint()
+	case "inference.inference.InferenceKeyUsage.inference_count":
+		x.InferenceCount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceKeyUsage"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceKeyUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InferenceKeyUsage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.InferenceKeyUsage.developer":
+		panic(fmt.Errorf("field developer of message inference.inference.InferenceKeyUsage is not mutable"))
+	case "inference.inference.InferenceKeyUsage.key_address":
+		panic(fmt.Errorf("field key_address of message inference.inference.InferenceKeyUsage is not mutable"))
+	case "inference.inference.InferenceKeyUsage.epoch_index":
+		panic(fmt.Errorf("field epoch_index of message inference.inference.InferenceKeyUsage is not mutable"))
+	case "inference.inference.InferenceKeyUsage.epoch_spent":
+		panic(fmt.Errorf("field epoch_spent of message inference.inference.InferenceKeyUsage is not mutable"))
+	case "inference.inference.InferenceKeyUsage.total_spent":
+		panic(fmt.Errorf("field total_spent of message inference.inference.InferenceKeyUsage is not mutable"))
+	case "inference.inference.InferenceKeyUsage.inference_count":
+		panic(fmt.Errorf("field inference_count of message inference.inference.InferenceKeyUsage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceKeyUsage"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceKeyUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InferenceKeyUsage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.InferenceKeyUsage.developer":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.InferenceKeyUsage.key_address":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.InferenceKeyUsage.epoch_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.InferenceKeyUsage.epoch_spent":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.InferenceKeyUsage.total_spent":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.InferenceKeyUsage.inference_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceKeyUsage"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceKeyUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InferenceKeyUsage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.InferenceKeyUsage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InferenceKeyUsage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InferenceKeyUsage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InferenceKeyUsage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InferenceKeyUsage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InferenceKeyUsage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Developer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.KeyAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EpochIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochIndex))
+		}
+		if x.EpochSpent != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochSpent))
+		}
+		if x.TotalSpent != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalSpent))
+		}
+		if x.InferenceCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.InferenceCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InferenceKeyUsage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.InferenceCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InferenceCount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.TotalSpent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalSpent))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.EpochSpent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochSpent))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.EpochIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochIndex))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.KeyAddress) > 0 {
+			i -= len(x.KeyAddress)
+			copy(dAtA[i:], x.KeyAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KeyAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Developer) > 0 {
+			i -= len(x.Developer)
+			copy(dAtA[i:], x.Developer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Developer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InferenceKeyUsage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InferenceKeyUsage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InferenceKeyUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Developer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KeyAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+				}
+				x.EpochIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochSpent", wireType)
+				}
+				x.EpochSpent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochSpent |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalSpent", wireType)
+				}
+				x.TotalSpent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalSpent |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InferenceCount", wireType)
+				}
+				x.InferenceCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InferenceCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: inference/inference/inference_key.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// InferenceKeyGrant lets a sub-key sign inference requests on behalf of a developer, within limits.
+type InferenceKeyGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Developer  string `protobuf:"bytes,1,opt,name=developer,proto3" json:"developer,omitempty"`
+	KeyAddress string `protobuf:"bytes,2,opt,name=key_address,json=keyAddress,proto3" json:"key_address,omitempty"`
+	// epoch_spend_limit caps what the inferences of the key cost per epoch, 0 is unlimited. A running inference
+	// counts with its escrow, a finished one with its actual cost, expired and refunded ones not at all.
+	EpochSpendLimit uint64 `protobuf:"varint,3,opt,name=epoch_spend_limit,json=epochSpendLimit,proto3" json:"epoch_spend_limit,omitempty"`
+	// allowed_models the key can request, empty allows all models
+	AllowedModels []string `protobuf:"bytes,4,rep,name=allowed_models,json=allowedModels,proto3" json:"allowed_models,omitempty"`
+	// expiration is the unix time in seconds the key stops being valid at, 0 never expires
+	Expiration int64 `protobuf:"varint,5,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// max_tokens_per_request caps the max tokens of a single request, 0 is unlimited
+	MaxTokensPerRequest uint64 `protobuf:"varint,6,opt,name=max_tokens_per_request,json=maxTokensPerRequest,proto3" json:"max_tokens_per_request,omitempty"`
+}
+
+func (x *InferenceKeyGrant) Reset() {
+	*x = InferenceKeyGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_inference_key_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InferenceKeyGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InferenceKeyGrant) ProtoMessage() {}
+
+// Deprecated: Use InferenceKeyGrant.ProtoReflect.Descriptor instead.
+func (*InferenceKeyGrant) Descriptor() ([]byte, []int) {
+	return file_inference_inference_inference_key_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InferenceKeyGrant) GetDeveloper() string {
+	if x != nil {
+		return x.Developer
+	}
+	return ""
+}
+
+func (x *InferenceKeyGrant) GetKeyAddress() string {
+	if x != nil {
+		return x.KeyAddress
+	}
+	return ""
+}
+
+func (x *InferenceKeyGrant) GetEpochSpendLimit() uint64 {
+	if x != nil {
+		return x.EpochSpendLimit
+	}
+	return 0
+}
+
+func (x *InferenceKeyGrant) GetAllowedModels() []string {
+	if x != nil {
+		return x.AllowedModels
+	}
+	return nil
+}
+
+func (x *InferenceKeyGrant) GetExpiration() int64 {
+	if x != nil {
+		return x.Expiration
+	}
+	return 0
+}
+
+func (x *InferenceKeyGrant) GetMaxTokensPerRequest() uint64 {
+	if x != nil {
+		return x.MaxTokensPerRequest
+	}
+	return 0
+}
+
+type InferenceKeyUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Developer      string `protobuf:"bytes,1,opt,name=developer,proto3" json:"developer,omitempty"`
+	KeyAddress     string `protobuf:"bytes,2,opt,name=key_address,json=keyAddress,proto3" json:"key_address,omitempty"`
+	EpochIndex     uint64 `protobuf:"varint,3,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	EpochSpent     uint64 `protobuf:"varint,4,opt,name=epoch_spent,json=epochSpent,proto3" json:"epoch_spent,omitempty"`
+	TotalSpent     uint64 `protobuf:"varint,5,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent,omitempty"`
+	InferenceCount uint64 `protobuf:"varint,6,opt,name=inference_count,json=inferenceCount,proto3" json:"inference_count,omitempty"`
+}
+
+func (x *InferenceKeyUsage) Reset() {
+	*x = InferenceKeyUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_inference_key_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InferenceKeyUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InferenceKeyUsage) ProtoMessage() {}
+
+// Deprecated: Use InferenceKeyUsage.ProtoReflect.Descriptor instead.
+func (*InferenceKeyUsage) Descriptor() ([]byte, []int) {
+	return file_inference_inference_inference_key_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InferenceKeyUsage) GetDeveloper() string {
+	if x != nil {
+		return x.Developer
+	}
+	return ""
+}
+
+func (x *InferenceKeyUsage) GetKeyAddress() string {
+	if x != nil {
+		return x.KeyAddress
+	}
+	return ""
+}
+
+func (x *InferenceKeyUsage) GetEpochIndex() uint64 {
+	if x != nil {
+		return x.EpochIndex
+	}
+	return 0
+}
+
+func (x *InferenceKeyUsage) GetEpochSpent() uint64 {
+	if x != nil {
+		return x.EpochSpent
+	}
+	return 0
+}
+
+func (x *InferenceKeyUsage) GetTotalSpent() uint64 {
+	if x != nil {
+		return x.TotalSpent
+	}
+	return 0
+}
+
+func (x *InferenceKeyUsage) GetInferenceCount() uint64 {
+	if x != nil {
+		return x.InferenceCount
+	}
+	return 0
+}
+
+var File_inference_inference_inference_key_proto protoreflect.FileDescriptor
+
+var file_inference_inference_inference_key_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xfa,
+	0x01, 0x0a, 0x11, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x65, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x50, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xde, 0x01, 0x0a, 0x11,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6b, 0x65, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x70, 0x65,
+	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x70, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x70,
+	0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0xbf, 0x01, 0x0a,
+	0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x11, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca,
+	0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_inference_inference_inference_key_proto_rawDescOnce sync.Once
+	file_inference_inference_inference_key_proto_rawDescData = file_inference_inference_inference_key_proto_rawDesc
+)
+
+func file_inference_inference_inference_key_proto_rawDescGZIP() []byte {
+	file_inference_inference_inference_key_proto_rawDescOnce.Do(func() {
+		file_inference_inference_inference_key_proto_rawDescData = protoimpl.X.CompressGZIP(file_inference_inference_inference_key_proto_rawDescData)
+	})
+	return file_inference_inference_inference_key_proto_rawDescData
+}
+
+var file_inference_inference_inference_key_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_inference_inference_inference_key_proto_goTypes = []interface{}{
+	(*InferenceKeyGrant)(nil), // 0: inference.inference.InferenceKeyGrant
+	(*InferenceKeyUsage)(nil), // 1: inference.inference.InferenceKeyUsage
+}
+var file_inference_inference_inference_key_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_inference_inference_inference_key_proto_init() }
+func file_inference_inference_inference_key_proto_init() {
+	if File_inference_inference_inference_key_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_inference_inference_inference_key_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InferenceKeyGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_inference_key_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InferenceKeyUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_inference_inference_inference_key_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_inference_inference_inference_key_proto_goTypes,
+		DependencyIndexes: file_inference_inference_inference_key_proto_depIdxs,
+		MessageInfos:      file_inference_inference_inference_key_proto_msgTypes,
+	}.Build()
+	File_inference_inference_inference_key_proto = out.File
+	file_inference_inference_inference_key_proto_rawDesc = nil
+	file_inference_inference_inference_key_proto_goTypes = nil
+	file_inference_inference_inference_key_proto_depIdxs = nil
+}
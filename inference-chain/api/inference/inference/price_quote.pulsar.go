@@ -0,0 +1,997 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package inference
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_PriceQuote                   protoreflect.MessageDescriptor
+	fd_PriceQuote_id                protoreflect.FieldDescriptor
+	fd_PriceQuote_developer         protoreflect.FieldDescriptor
+	fd_PriceQuote_model_id          protoreflect.FieldDescriptor
+	fd_PriceQuote_per_token_price   protoreflect.FieldDescriptor
+	fd_PriceQuote_token_budget      protoreflect.FieldDescriptor
+	fd_PriceQuote_tokens_reserved   protoreflect.FieldDescriptor
+	fd_PriceQuote_created_height    protoreflect.FieldDescriptor
+	fd_PriceQuote_expiration_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_price_quote_proto_init()
+	md_PriceQuote = File_inference_inference_price_quote_proto.Messages().ByName("PriceQuote")
+	fd_PriceQuote_id = md_PriceQuote.Fields().ByName("id")
+	fd_PriceQuote_developer = md_PriceQuote.Fields().ByName("developer")
+	fd_PriceQuote_model_id = md_PriceQuote.Fields().ByName("model_id")
+	fd_PriceQuote_per_token_price = md_PriceQuote.Fields().ByName("per_token_price")
+	fd_PriceQuote_token_budget = md_PriceQuote.Fields().ByName("token_budget")
+	fd_PriceQuote_tokens_reserved = md_PriceQuote.Fields().ByName("tokens_reserved")
+	fd_PriceQuote_created_height = md_PriceQuote.Fields().ByName("created_height")
+	fd_PriceQuote_expiration_height = md_PriceQuote.Fields().ByName("expiration_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceQuote)(nil)
+
+type fastReflection_PriceQuote PriceQuote
+
+func (x *PriceQuote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceQuote)(x)
+}
+
+func (x *PriceQuote) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_price_quote_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceQuote_messageType fastReflection_PriceQuote_messageType
+var _ protoreflect.MessageType = fastReflection_PriceQuote_messageType{}
+
+type fastReflection_PriceQuote_messageType struct{}
+
+func (x fastReflection_PriceQuote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceQuote)(nil)
+}
+func (x fastReflection_PriceQuote_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceQuote)
+}
+func (x fastReflection_PriceQuote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceQuote
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceQuote) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceQuote
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceQuote) Type() protoreflect.MessageType {
+	return _fastReflection_PriceQuote_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceQuote) New() protoreflect.Message {
+	return new(fastReflection_PriceQuote)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceQuote) Interface() protoreflect.ProtoMessage {
+	return (*PriceQuote)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceQuote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_PriceQuote_id, value) {
+			return
+		}
+	}
+	if x.Developer != "" {
+		value := protoreflect.ValueOfString(x.Developer)
+		if !f(fd_PriceQuote_developer, value) {
+			return
+		}
+	}
+	if x.ModelId != "" {
+		value := protoreflect.ValueOfString(x.ModelId)
+		if !f(fd_PriceQuote_model_id, value) {
+			return
+		}
+	}
+	if x.PerTokenPrice != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PerTokenPrice)
+		if !f(fd_PriceQuote_per_token_price, value) {
+			return
+		}
+	}
+	if x.TokenBudget != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TokenBudget)
+		if !f(fd_PriceQuote_token_budget, value) {
+			return
+		}
+	}
+	if x.TokensReserved != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TokensReserved)
+		if !f(fd_PriceQuote_tokens_reserved, value) {
+			return
+		}
+	}
+	if x.CreatedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreatedHeight)
+		if !f(fd_PriceQuote_created_height, value) {
+			return
+		}
+	}
+	if x.ExpirationHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpirationHeight)
+		if !f(fd_PriceQuote_expiration_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceQuote) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.PriceQuote.id":
+		return x.Id != uint64(0)
+	case "inference.inference.PriceQuote.developer":
+		return x.Developer != ""
+	case "inference.inference.PriceQuote.model_id":
+		return x.ModelId != ""
+	case "inference.inference.PriceQuote.per_token_price":
+		return x.PerTokenPrice != uint64(0)
+	case "inference.inference.PriceQuote.token_budget":
+		return x.TokenBudget != uint64(0)
+	case "inference.inference.PriceQuote.tokens_reserved":
+		return x.TokensReserved != uint64(0)
+	case "inference.inference.PriceQuote.created_height":
+		return x.CreatedHeight != int64(0)
+	case "inference.inference.PriceQuote.expiration_height":
+		return x.ExpirationHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PriceQuote"))
+		}
+		panic(fmt.Errorf("message inference.inference.PriceQuote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceQuote) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.PriceQuote.id":
+		x.Id = uint64(0)
+	case "inference.inference.PriceQuote.developer":
+		x.Developer = ""
+	case "inference.inference.PriceQuote.model_id":
+		x.ModelId = ""
+	case "inference.inference.PriceQuote.per_token_price":
+		x.PerTokenPrice = uint64(0)
+	case "inference.inference.PriceQuote.token_budget":
+		x.TokenBudget = uint64(0)
+	case "inference.inference.PriceQuote.tokens_reserved":
+		x.TokensReserved = uint64(0)
+	case "inference.inference.PriceQuote.created_height":
+		x.CreatedHeight = int64(0)
+	case "inference.inference.PriceQuote.expiration_height":
+		x.ExpirationHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PriceQuote"))
+		}
+		panic(fmt.Errorf("message inference.inference.PriceQuote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceQuote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.PriceQuote.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.PriceQuote.developer":
+		value := x.Developer
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.PriceQuote.model_id":
+		value := x.ModelId
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.PriceQuote.per_token_price":
+		value := x.PerTokenPrice
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.PriceQuote.token_budget":
+		value := x.TokenBudget
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.PriceQuote.tokens_reserved":
+		value := x.TokensReserved
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.PriceQuote.created_height":
+		value := x.CreatedHeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.PriceQuote.expiration_height":
+		value := x.ExpirationHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PriceQuote"))
+		}
+		panic(fmt.Errorf("message inference.inference.PriceQuote does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceQuote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.PriceQuote.id":
+		x.Id = value.Uint()
+	case "inference.inference.PriceQuote.developer":
+		x.Developer = value.Interface().(string)
+	case "inference.inference.PriceQuote.model_id":
+		x.ModelId = value.Interface().(string)
+	case "inference.inference.PriceQuote.per_token_price":
+		x.PerTokenPrice = value.Uint()
+	case "inference.inference.PriceQuote.token_budget":
+		x.TokenBudget = value.Uint()
+	case "inference.inference.PriceQuote.tokens_reserved":
+		x.TokensReserved = value.Uint()
+	case "inference.inference.PriceQuote.created_height":
+		x.CreatedHeight = value.Int()
+	case "inference.inference.PriceQuote.expiration_height":
+		x.ExpirationHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PriceQuote"))
+		}
+		panic(fmt.Errorf("message inference.inference.PriceQuote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceQuote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.PriceQuote.id":
+		panic(fmt.Errorf("field id of message inference.inference.PriceQuote is not mutable"))
+	case "inference.inference.PriceQuote.developer":
+		panic(fmt.Errorf("field developer of message inference.inference.PriceQuote is not mutable"))
+	case "inference.inference.PriceQuote.model_id":
+		panic(fmt.Errorf("field model_id of message inference.inference.PriceQuote is not mutable"))
+	case "inference.inference.PriceQuote.per_token_price":
+		panic(fmt.Errorf("field per_token_price of message inference.inference.PriceQuote is not mutable"))
+	case "inference.inference.PriceQuote.token_budget":
+		panic(fmt.Errorf("field token_budget of message inference.inference.PriceQuote is not mutable"))
+	case "inference.inference.PriceQuote.tokens_reserved":
+		panic(fmt.Errorf("field tokens_reserved of message inference.inference.PriceQuote is not mutable"))
+	case "inference.inference.PriceQuote.created_height":
+		panic(fmt.Errorf("field created_height of message inference.inference.PriceQuote is not mutable"))
+	case "inference.inference.PriceQuote.expiration_height":
+		panic(fmt.Errorf("field expiration_height of message inference.inference.PriceQuote is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PriceQuote"))
+		}
+		panic(fmt.Errorf("message inference.inference.PriceQuote does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceQuote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.PriceQuote.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.PriceQuote.developer":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.PriceQuote.model_id":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.PriceQuote.per_token_price":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.PriceQuote.token_budget":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.PriceQuote.tokens_reserved":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.PriceQuote.created_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.PriceQuote.expiration_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PriceQuote"))
+		}
+		panic(fmt.Errorf("message inference.inference.PriceQuote does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceQuote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.PriceQuote", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceQuote) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceQuote) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceQuote) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceQuote) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceQuote)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Developer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PerTokenPrice != 0 {
+			n += 1 + runtime.Sov(uint64(x.PerTokenPrice))
+		}
+		if x.TokenBudget != 0 {
+			n += 1 + runtime.Sov(uint64(x.TokenBudget))
+		}
+		if x.TokensReserved != 0 {
+			n += 1 + runtime.Sov(uint64(x.TokensReserved))
+		}
+		if x.CreatedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatedHeight))
+		}
+		if x.ExpirationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpirationHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceQuote)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExpirationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpirationHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.CreatedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatedHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.TokensReserved != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TokensReserved))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.TokenBudget != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TokenBudget))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.PerTokenPrice != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PerTokenPrice))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ModelId) > 0 {
+			i -= len(x.ModelId)
+			copy(dAtA[i:], x.ModelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModelId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Developer) > 0 {
+			i -= len(x.Developer)
+			copy(dAtA[i:], x.Developer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Developer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceQuote)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceQuote: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceQuote: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Developer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerTokenPrice", wireType)
+				}
+				x.PerTokenPrice = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PerTokenPrice |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenBudget", wireType)
+				}
+				x.TokenBudget = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TokenBudget |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokensReserved", wireType)
+				}
+				x.TokensReserved = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TokensReserved |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+				}
+				x.CreatedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+				}
+				x.ExpirationHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpirationHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: inference/inference/price_quote.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PriceQuote locks the per-token price of a model for a developer, for a limited number of blocks and tokens.
+type PriceQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Developer     string `protobuf:"bytes,2,opt,name=developer,proto3" json:"developer,omitempty"`
+	ModelId       string `protobuf:"bytes,3,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	PerTokenPrice uint64 `protobuf:"varint,4,opt,name=per_token_price,json=perTokenPrice,proto3" json:"per_token_price,omitempty"`
+	// token_budget is the total of prompt and completion tokens inferences can use at the quoted price
+	TokenBudget uint64 `protobuf:"varint,5,opt,name=token_budget,json=tokenBudget,proto3" json:"token_budget,omitempty"`
+	// tokens_reserved are held by inferences using the quote: prompt and max tokens while running,
+	// the tokens actually used once finished
+	TokensReserved uint64 `protobuf:"varint,6,opt,name=tokens_reserved,json=tokensReserved,proto3" json:"tokens_reserved,omitempty"`
+	CreatedHeight  int64  `protobuf:"varint,7,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// expiration_height is the last height inferences can start with the quote
+	ExpirationHeight int64 `protobuf:"varint,8,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (x *PriceQuote) Reset() {
+	*x = PriceQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_price_quote_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceQuote) ProtoMessage() {}
+
+// Deprecated: Use PriceQuote.ProtoReflect.Descriptor instead.
+func (*PriceQuote) Descriptor() ([]byte, []int) {
+	return file_inference_inference_price_quote_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PriceQuote) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PriceQuote) GetDeveloper() string {
+	if x != nil {
+		return x.Developer
+	}
+	return ""
+}
+
+func (x *PriceQuote) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *PriceQuote) GetPerTokenPrice() uint64 {
+	if x != nil {
+		return x.PerTokenPrice
+	}
+	return 0
+}
+
+func (x *PriceQuote) GetTokenBudget() uint64 {
+	if x != nil {
+		return x.TokenBudget
+	}
+	return 0
+}
+
+func (x *PriceQuote) GetTokensReserved() uint64 {
+	if x != nil {
+		return x.TokensReserved
+	}
+	return 0
+}
+
+func (x *PriceQuote) GetCreatedHeight() int64 {
+	if x != nil {
+		return x.CreatedHeight
+	}
+	return 0
+}
+
+func (x *PriceQuote) GetExpirationHeight() int64 {
+	if x != nil {
+		return x.ExpirationHeight
+	}
+	return 0
+}
+
+var File_inference_inference_price_quote_proto protoreflect.FileDescriptor
+
+var file_inference_inference_price_quote_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x9d, 0x02, 0x0a,
+	0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x2b, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0xbd, 0x01, 0x0a,
+	0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_inference_inference_price_quote_proto_rawDescOnce sync.Once
+	file_inference_inference_price_quote_proto_rawDescData = file_inference_inference_price_quote_proto_rawDesc
+)
+
+func file_inference_inference_price_quote_proto_rawDescGZIP() []byte {
+	file_inference_inference_price_quote_proto_rawDescOnce.Do(func() {
+		file_inference_inference_price_quote_proto_rawDescData = protoimpl.X.CompressGZIP(file_inference_inference_price_quote_proto_rawDescData)
+	})
+	return file_inference_inference_price_quote_proto_rawDescData
+}
+
+var file_inference_inference_price_quote_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_inference_inference_price_quote_proto_goTypes = []interface{}{
+	(*PriceQuote)(nil), // 0: inference.inference.PriceQuote
+}
+var file_inference_inference_price_quote_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_inference_inference_price_quote_proto_init() }
+func file_inference_inference_price_quote_proto_init() {
+	if File_inference_inference_price_quote_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_inference_inference_price_quote_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_inference_inference_price_quote_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_inference_inference_price_quote_proto_goTypes,
+		DependencyIndexes: file_inference_inference_price_quote_proto_depIdxs,
+		MessageInfos:      file_inference_inference_price_quote_proto_msgTypes,
+	}.Build()
+	File_inference_inference_price_quote_proto = out.File
+	file_inference_inference_price_quote_proto_rawDesc = nil
+	file_inference_inference_price_quote_proto_goTypes = nil
+	file_inference_inference_price_quote_proto_depIdxs = nil
+}
@@ -13,10 +13,12 @@ import (
 )
 
 var (
-	md_PruningState                              protoreflect.MessageDescriptor
-	fd_PruningState_poc_batches_pruned_epoch     protoreflect.FieldDescriptor
-	fd_PruningState_poc_validations_pruned_epoch protoreflect.FieldDescriptor
-	fd_PruningState_inference_pruned_epoch       protoreflect.FieldDescriptor
+	md_PruningState                                     protoreflect.MessageDescriptor
+	fd_PruningState_poc_batches_pruned_epoch            protoreflect.FieldDescriptor
+	fd_PruningState_poc_validations_pruned_epoch        protoreflect.FieldDescriptor
+	fd_PruningState_inference_pruned_epoch              protoreflect.FieldDescriptor
+	fd_PruningState_validator_epoch_stats_pruned_epoch  protoreflect.FieldDescriptor
+	fd_PruningState_validator_target_stats_pruned_epoch protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -25,6 +27,8 @@ func init() {
 	fd_PruningState_poc_batches_pruned_epoch = md_PruningState.Fields().ByName("poc_batches_pruned_epoch")
 	fd_PruningState_poc_validations_pruned_epoch = md_PruningState.Fields().ByName("poc_validations_pruned_epoch")
 	fd_PruningState_inference_pruned_epoch = md_PruningState.Fields().ByName("inference_pruned_epoch")
+	fd_PruningState_validator_epoch_stats_pruned_epoch = md_PruningState.Fields().ByName("validator_epoch_stats_pruned_epoch")
+	fd_PruningState_validator_target_stats_pruned_epoch = md_PruningState.Fields().ByName("validator_target_stats_pruned_epoch")
 }
 
 var _ protoreflect.Message = (*fastReflection_PruningState)(nil)
@@ -110,6 +114,18 @@ func (x *fastReflection_PruningState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.ValidatorEpochStatsPrunedEpoch != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ValidatorEpochStatsPrunedEpoch)
+		if !f(fd_PruningState_validator_epoch_stats_pruned_epoch, value) {
+			return
+		}
+	}
+	if x.ValidatorTargetStatsPrunedEpoch != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ValidatorTargetStatsPrunedEpoch)
+		if !f(fd_PruningState_validator_target_stats_pruned_epoch, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -131,6 +147,10 @@ func (x *fastReflection_PruningState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.PocValidationsPrunedEpoch != int64(0)
 	case "inference.inference.PruningState.inference_pruned_epoch":
 		return x.InferencePrunedEpoch != int64(0)
+	case "inference.inference.PruningState.validator_epoch_stats_pruned_epoch":
+		return x.ValidatorEpochStatsPrunedEpoch != int64(0)
+	case "inference.inference.PruningState.validator_target_stats_pruned_epoch":
+		return x.ValidatorTargetStatsPrunedEpoch != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PruningState"))
@@ -153,6 +173,10 @@ func (x *fastReflection_PruningState) Clear(fd protoreflect.FieldDescriptor) {
 		x.PocValidationsPrunedEpoch = int64(0)
 	case "inference.inference.PruningState.inference_pruned_epoch":
 		x.InferencePrunedEpoch = int64(0)
+	case "inference.inference.PruningState.validator_epoch_stats_pruned_epoch":
+		x.ValidatorEpochStatsPrunedEpoch = int64(0)
+	case "inference.inference.PruningState.validator_target_stats_pruned_epoch":
+		x.ValidatorTargetStatsPrunedEpoch = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PruningState"))
@@ -178,6 +202,12 @@ func (x *fastReflection_PruningState) Get(descriptor protoreflect.FieldDescripto
 	case "inference.inference.PruningState.inference_pruned_epoch":
 		value := x.InferencePrunedEpoch
 		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.PruningState.validator_epoch_stats_pruned_epoch":
+		value := x.ValidatorEpochStatsPrunedEpoch
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.PruningState.validator_target_stats_pruned_epoch":
+		value := x.ValidatorTargetStatsPrunedEpoch
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PruningState"))
@@ -204,6 +234,10 @@ func (x *fastReflection_PruningState) Set(fd protoreflect.FieldDescriptor, value
 		x.PocValidationsPrunedEpoch = value.Int()
 	case "inference.inference.PruningState.inference_pruned_epoch":
 		x.InferencePrunedEpoch = value.Int()
+	case "inference.inference.PruningState.validator_epoch_stats_pruned_epoch":
+		x.ValidatorEpochStatsPrunedEpoch = value.Int()
+	case "inference.inference.PruningState.validator_target_stats_pruned_epoch":
+		x.ValidatorTargetStatsPrunedEpoch = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PruningState"))
@@ -230,6 +264,10 @@ func (x *fastReflection_PruningState) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field poc_validations_pruned_epoch of message inference.inference.PruningState is not mutable"))
 	case "inference.inference.PruningState.inference_pruned_epoch":
 		panic(fmt.Errorf("field inference_pruned_epoch of message inference.inference.PruningState is not mutable"))
+	case "inference.inference.PruningState.validator_epoch_stats_pruned_epoch":
+		panic(fmt.Errorf("field validator_epoch_stats_pruned_epoch of message inference.inference.PruningState is not mutable"))
+	case "inference.inference.PruningState.validator_target_stats_pruned_epoch":
+		panic(fmt.Errorf("field validator_target_stats_pruned_epoch of message inference.inference.PruningState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PruningState"))
@@ -249,6 +287,10 @@ func (x *fastReflection_PruningState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfInt64(int64(0))
 	case "inference.inference.PruningState.inference_pruned_epoch":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.PruningState.validator_epoch_stats_pruned_epoch":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.PruningState.validator_target_stats_pruned_epoch":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.PruningState"))
@@ -327,6 +369,12 @@ func (x *fastReflection_PruningState) ProtoMethods() *protoiface.Methods {
 		if x.InferencePrunedEpoch != 0 {
 			n += 1 + runtime.Sov(uint64(x.InferencePrunedEpoch))
 		}
+		if x.ValidatorEpochStatsPrunedEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorEpochStatsPrunedEpoch))
+		}
+		if x.ValidatorTargetStatsPrunedEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorTargetStatsPrunedEpoch))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -356,6 +404,16 @@ func (x *fastReflection_PruningState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ValidatorTargetStatsPrunedEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorTargetStatsPrunedEpoch))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ValidatorEpochStatsPrunedEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorEpochStatsPrunedEpoch))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.InferencePrunedEpoch != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.InferencePrunedEpoch))
 			i--
@@ -477,6 +535,44 @@ func (x *fastReflection_PruningState) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorEpochStatsPrunedEpoch", wireType)
+				}
+				x.ValidatorEpochStatsPrunedEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorEpochStatsPrunedEpoch |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorTargetStatsPrunedEpoch", wireType)
+				}
+				x.ValidatorTargetStatsPrunedEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorTargetStatsPrunedEpoch |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -530,9 +626,11 @@ type PruningState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PocBatchesPrunedEpoch     int64 `protobuf:"varint,1,opt,name=poc_batches_pruned_epoch,json=pocBatchesPrunedEpoch,proto3" json:"poc_batches_pruned_epoch,omitempty"`
-	PocValidationsPrunedEpoch int64 `protobuf:"varint,2,opt,name=poc_validations_pruned_epoch,json=pocValidationsPrunedEpoch,proto3" json:"poc_validations_pruned_epoch,omitempty"`
-	InferencePrunedEpoch      int64 `protobuf:"varint,3,opt,name=inference_pruned_epoch,json=inferencePrunedEpoch,proto3" json:"inference_pruned_epoch,omitempty"`
+	PocBatchesPrunedEpoch           int64 `protobuf:"varint,1,opt,name=poc_batches_pruned_epoch,json=pocBatchesPrunedEpoch,proto3" json:"poc_batches_pruned_epoch,omitempty"`
+	PocValidationsPrunedEpoch       int64 `protobuf:"varint,2,opt,name=poc_validations_pruned_epoch,json=pocValidationsPrunedEpoch,proto3" json:"poc_validations_pruned_epoch,omitempty"`
+	InferencePrunedEpoch            int64 `protobuf:"varint,3,opt,name=inference_pruned_epoch,json=inferencePrunedEpoch,proto3" json:"inference_pruned_epoch,omitempty"`
+	ValidatorEpochStatsPrunedEpoch  int64 `protobuf:"varint,4,opt,name=validator_epoch_stats_pruned_epoch,json=validatorEpochStatsPrunedEpoch,proto3" json:"validator_epoch_stats_pruned_epoch,omitempty"`
+	ValidatorTargetStatsPrunedEpoch int64 `protobuf:"varint,5,opt,name=validator_target_stats_pruned_epoch,json=validatorTargetStatsPrunedEpoch,proto3" json:"validator_target_stats_pruned_epoch,omitempty"`
 }
 
 func (x *PruningState) Reset() {
@@ -576,14 +674,28 @@ func (x *PruningState) GetInferencePrunedEpoch() int64 {
 	return 0
 }
 
+func (x *PruningState) GetValidatorEpochStatsPrunedEpoch() int64 {
+	if x != nil {
+		return x.ValidatorEpochStatsPrunedEpoch
+	}
+	return 0
+}
+
+func (x *PruningState) GetValidatorTargetStatsPrunedEpoch() int64 {
+	if x != nil {
+		return x.ValidatorTargetStatsPrunedEpoch
+	}
+	return 0
+}
+
 var File_inference_inference_pruning_state_proto protoreflect.FileDescriptor
 
 var file_inference_inference_pruning_state_proto_rawDesc = []byte{
 	0x0a, 0x27, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
 	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74,
 	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x69, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xbe,
-	0x01, 0x0a, 0x0c, 0x50, 0x72, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xd8,
+	0x02, 0x0a, 0x0c, 0x50, 0x72, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
 	0x37, 0x0a, 0x18, 0x70, 0x6f, 0x63, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x5f, 0x70,
 	0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x15, 0x70, 0x6f, 0x63, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x50, 0x72, 0x75,
@@ -594,20 +706,30 @@ var file_inference_inference_pruning_state_proto_rawDesc = []byte{
 	0x75, 0x6e, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x66,
 	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f, 0x65, 0x70,
 	0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x69, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42,
-	0xbf, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x11, 0x50, 0x72, 0x75,
-	0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x4a, 0x0a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x4c, 0x0a, 0x23, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72,
+	0x75, 0x6e, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0xbf, 0x01, 0x0a, 0x17, 0x63, 0x6f,
+	0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x11, 0x50, 0x72, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0xa2, 0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
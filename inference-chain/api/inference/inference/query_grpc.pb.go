@@ -82,6 +82,19 @@ const (
 	Query_GranteesByMessageType_FullMethodName                     = "/inference.inference.Query/GranteesByMessageType"
 	Query_MLNodeVersion_FullMethodName                             = "/inference.inference.Query/MLNodeVersion"
 	Query_TrainingAllowList_FullMethodName                         = "/inference.inference.Query/TrainingAllowList"
+	Query_InferenceDispute_FullMethodName                          = "/inference.inference.Query/InferenceDispute"
+	Query_DeveloperCredit_FullMethodName                           = "/inference.inference.Query/DeveloperCredit"
+	Query_InferenceKey_FullMethodName                              = "/inference.inference.Query/InferenceKey"
+	Query_InferenceKeys_FullMethodName                             = "/inference.inference.Query/InferenceKeys"
+	Query_PriceQuote_FullMethodName                                = "/inference.inference.Query/PriceQuote"
+	Query_ParticipantExit_FullMethodName                           = "/inference.inference.Query/ParticipantExit"
+	Query_InferencesByRequester_FullMethodName                     = "/inference.inference.Query/InferencesByRequester"
+	Query_InferencesByExecutor_FullMethodName                      = "/inference.inference.Query/InferencesByExecutor"
+	Query_InferencesByStatus_FullMethodName                        = "/inference.inference.Query/InferencesByStatus"
+	Query_ValidatorStats_FullMethodName                            = "/inference.inference.Query/ValidatorStats"
+	Query_ValidatorStatsHistory_FullMethodName                     = "/inference.inference.Query/ValidatorStatsHistory"
+	Query_ValidatorStatsRanked_FullMethodName                      = "/inference.inference.Query/ValidatorStatsRanked"
+	Query_ValidatorTargetStats_FullMethodName                      = "/inference.inference.Query/ValidatorTargetStats"
 )
 
 // QueryClient is the client API for Query service.
@@ -194,6 +207,32 @@ type QueryClient interface {
 	MLNodeVersion(ctx context.Context, in *QueryGetMLNodeVersionRequest, opts ...grpc.CallOption) (*QueryGetMLNodeVersionResponse, error)
 	// Queries a list of TrainingAllowList items.
 	TrainingAllowList(ctx context.Context, in *QueryTrainingAllowListRequest, opts ...grpc.CallOption) (*QueryTrainingAllowListResponse, error)
+	// Queries an open dispute of an inference.
+	InferenceDispute(ctx context.Context, in *QueryInferenceDisputeRequest, opts ...grpc.CallOption) (*QueryInferenceDisputeResponse, error)
+	// Queries the prepaid credit of a developer and its pending withdrawals.
+	DeveloperCredit(ctx context.Context, in *QueryDeveloperCreditRequest, opts ...grpc.CallOption) (*QueryDeveloperCreditResponse, error)
+	// Queries an inference key of a developer with its limits and usage.
+	InferenceKey(ctx context.Context, in *QueryInferenceKeyRequest, opts ...grpc.CallOption) (*QueryInferenceKeyResponse, error)
+	// Queries all inference keys of a developer.
+	InferenceKeys(ctx context.Context, in *QueryInferenceKeysRequest, opts ...grpc.CallOption) (*QueryInferenceKeysResponse, error)
+	// Queries a price quote by id.
+	PriceQuote(ctx context.Context, in *QueryPriceQuoteRequest, opts ...grpc.CallOption) (*QueryPriceQuoteResponse, error)
+	// Queries the exit of a participant leaving the network.
+	ParticipantExit(ctx context.Context, in *QueryParticipantExitRequest, opts ...grpc.CallOption) (*QueryParticipantExitResponse, error)
+	// Queries the stored inferences requested by an address, optionally of one epoch.
+	InferencesByRequester(ctx context.Context, in *QueryInferencesByRequesterRequest, opts ...grpc.CallOption) (*QueryInferencesByRequesterResponse, error)
+	// Queries the stored inferences assigned to or executed by an address, optionally of one epoch.
+	InferencesByExecutor(ctx context.Context, in *QueryInferencesByExecutorRequest, opts ...grpc.CallOption) (*QueryInferencesByExecutorResponse, error)
+	// Queries the stored inferences with a status, optionally of one epoch.
+	InferencesByStatus(ctx context.Context, in *QueryInferencesByStatusRequest, opts ...grpc.CallOption) (*QueryInferencesByStatusResponse, error)
+	// Queries the validation stats of a validator in an epoch.
+	ValidatorStats(ctx context.Context, in *QueryValidatorStatsRequest, opts ...grpc.CallOption) (*QueryValidatorStatsResponse, error)
+	// Queries the validation stats of a validator in every epoch it has them for.
+	ValidatorStatsHistory(ctx context.Context, in *QueryValidatorStatsHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorStatsHistoryResponse, error)
+	// Queries the validation stats of all validators in an epoch, ranked by one of the counters.
+	ValidatorStatsRanked(ctx context.Context, in *QueryValidatorStatsRankedRequest, opts ...grpc.CallOption) (*QueryValidatorStatsRankedResponse, error)
+	// Queries the validation stats of a validator in an epoch, per executor validated.
+	ValidatorTargetStats(ctx context.Context, in *QueryValidatorTargetStatsRequest, opts ...grpc.CallOption) (*QueryValidatorTargetStatsResponse, error)
 }
 
 type queryClient struct {
@@ -771,6 +810,123 @@ func (c *queryClient) TrainingAllowList(ctx context.Context, in *QueryTrainingAl
 	return out, nil
 }
 
+func (c *queryClient) InferenceDispute(ctx context.Context, in *QueryInferenceDisputeRequest, opts ...grpc.CallOption) (*QueryInferenceDisputeResponse, error) {
+	out := new(QueryInferenceDisputeResponse)
+	err := c.cc.Invoke(ctx, Query_InferenceDispute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeveloperCredit(ctx context.Context, in *QueryDeveloperCreditRequest, opts ...grpc.CallOption) (*QueryDeveloperCreditResponse, error) {
+	out := new(QueryDeveloperCreditResponse)
+	err := c.cc.Invoke(ctx, Query_DeveloperCredit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InferenceKey(ctx context.Context, in *QueryInferenceKeyRequest, opts ...grpc.CallOption) (*QueryInferenceKeyResponse, error) {
+	out := new(QueryInferenceKeyResponse)
+	err := c.cc.Invoke(ctx, Query_InferenceKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InferenceKeys(ctx context.Context, in *QueryInferenceKeysRequest, opts ...grpc.CallOption) (*QueryInferenceKeysResponse, error) {
+	out := new(QueryInferenceKeysResponse)
+	err := c.cc.Invoke(ctx, Query_InferenceKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceQuote(ctx context.Context, in *QueryPriceQuoteRequest, opts ...grpc.CallOption) (*QueryPriceQuoteResponse, error) {
+	out := new(QueryPriceQuoteResponse)
+	err := c.cc.Invoke(ctx, Query_PriceQuote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParticipantExit(ctx context.Context, in *QueryParticipantExitRequest, opts ...grpc.CallOption) (*QueryParticipantExitResponse, error) {
+	out := new(QueryParticipantExitResponse)
+	err := c.cc.Invoke(ctx, Query_ParticipantExit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InferencesByRequester(ctx context.Context, in *QueryInferencesByRequesterRequest, opts ...grpc.CallOption) (*QueryInferencesByRequesterResponse, error) {
+	out := new(QueryInferencesByRequesterResponse)
+	err := c.cc.Invoke(ctx, Query_InferencesByRequester_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InferencesByExecutor(ctx context.Context, in *QueryInferencesByExecutorRequest, opts ...grpc.CallOption) (*QueryInferencesByExecutorResponse, error) {
+	out := new(QueryInferencesByExecutorResponse)
+	err := c.cc.Invoke(ctx, Query_InferencesByExecutor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InferencesByStatus(ctx context.Context, in *QueryInferencesByStatusRequest, opts ...grpc.CallOption) (*QueryInferencesByStatusResponse, error) {
+	out := new(QueryInferencesByStatusResponse)
+	err := c.cc.Invoke(ctx, Query_InferencesByStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorStats(ctx context.Context, in *QueryValidatorStatsRequest, opts ...grpc.CallOption) (*QueryValidatorStatsResponse, error) {
+	out := new(QueryValidatorStatsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorStatsHistory(ctx context.Context, in *QueryValidatorStatsHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorStatsHistoryResponse, error) {
+	out := new(QueryValidatorStatsHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorStatsHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorStatsRanked(ctx context.Context, in *QueryValidatorStatsRankedRequest, opts ...grpc.CallOption) (*QueryValidatorStatsRankedResponse, error) {
+	out := new(QueryValidatorStatsRankedResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorStatsRanked_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorTargetStats(ctx context.Context, in *QueryValidatorTargetStatsRequest, opts ...grpc.CallOption) (*QueryValidatorTargetStatsResponse, error) {
+	out := new(QueryValidatorTargetStatsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorTargetStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -881,6 +1037,32 @@ type QueryServer interface {
 	MLNodeVersion(context.Context, *QueryGetMLNodeVersionRequest) (*QueryGetMLNodeVersionResponse, error)
 	// Queries a list of TrainingAllowList items.
 	TrainingAllowList(context.Context, *QueryTrainingAllowListRequest) (*QueryTrainingAllowListResponse, error)
+	// Queries an open dispute of an inference.
+	InferenceDispute(context.Context, *QueryInferenceDisputeRequest) (*QueryInferenceDisputeResponse, error)
+	// Queries the prepaid credit of a developer and its pending withdrawals.
+	DeveloperCredit(context.Context, *QueryDeveloperCreditRequest) (*QueryDeveloperCreditResponse, error)
+	// Queries an inference key of a developer with its limits and usage.
+	InferenceKey(context.Context, *QueryInferenceKeyRequest) (*QueryInferenceKeyResponse, error)
+	// Queries all inference keys of a developer.
+	InferenceKeys(context.Context, *QueryInferenceKeysRequest) (*QueryInferenceKeysResponse, error)
+	// Queries a price quote by id.
+	PriceQuote(context.Context, *QueryPriceQuoteRequest) (*QueryPriceQuoteResponse, error)
+	// Queries the exit of a participant leaving the network.
+	ParticipantExit(context.Context, *QueryParticipantExitRequest) (*QueryParticipantExitResponse, error)
+	// Queries the stored inferences requested by an address, optionally of one epoch.
+	InferencesByRequester(context.Context, *QueryInferencesByRequesterRequest) (*QueryInferencesByRequesterResponse, error)
+	// Queries the stored inferences assigned to or executed by an address, optionally of one epoch.
+	InferencesByExecutor(context.Context, *QueryInferencesByExecutorRequest) (*QueryInferencesByExecutorResponse, error)
+	// Queries the stored inferences with a status, optionally of one epoch.
+	InferencesByStatus(context.Context, *QueryInferencesByStatusRequest) (*QueryInferencesByStatusResponse, error)
+	// Queries the validation stats of a validator in an epoch.
+	ValidatorStats(context.Context, *QueryValidatorStatsRequest) (*QueryValidatorStatsResponse, error)
+	// Queries the validation stats of a validator in every epoch it has them for.
+	ValidatorStatsHistory(context.Context, *QueryValidatorStatsHistoryRequest) (*QueryValidatorStatsHistoryResponse, error)
+	// Queries the validation stats of all validators in an epoch, ranked by one of the counters.
+	ValidatorStatsRanked(context.Context, *QueryValidatorStatsRankedRequest) (*QueryValidatorStatsRankedResponse, error)
+	// Queries the validation stats of a validator in an epoch, per executor validated.
+	ValidatorTargetStats(context.Context, *QueryValidatorTargetStatsRequest) (*QueryValidatorTargetStatsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -1077,6 +1259,45 @@ func (UnimplementedQueryServer) MLNodeVersion(context.Context, *QueryGetMLNodeVe
 func (UnimplementedQueryServer) TrainingAllowList(context.Context, *QueryTrainingAllowListRequest) (*QueryTrainingAllowListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TrainingAllowList not implemented")
 }
+func (UnimplementedQueryServer) InferenceDispute(context.Context, *QueryInferenceDisputeRequest) (*QueryInferenceDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InferenceDispute not implemented")
+}
+func (UnimplementedQueryServer) DeveloperCredit(context.Context, *QueryDeveloperCreditRequest) (*QueryDeveloperCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeveloperCredit not implemented")
+}
+func (UnimplementedQueryServer) InferenceKey(context.Context, *QueryInferenceKeyRequest) (*QueryInferenceKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InferenceKey not implemented")
+}
+func (UnimplementedQueryServer) InferenceKeys(context.Context, *QueryInferenceKeysRequest) (*QueryInferenceKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InferenceKeys not implemented")
+}
+func (UnimplementedQueryServer) PriceQuote(context.Context, *QueryPriceQuoteRequest) (*QueryPriceQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceQuote not implemented")
+}
+func (UnimplementedQueryServer) ParticipantExit(context.Context, *QueryParticipantExitRequest) (*QueryParticipantExitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantExit not implemented")
+}
+func (UnimplementedQueryServer) InferencesByRequester(context.Context, *QueryInferencesByRequesterRequest) (*QueryInferencesByRequesterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InferencesByRequester not implemented")
+}
+func (UnimplementedQueryServer) InferencesByExecutor(context.Context, *QueryInferencesByExecutorRequest) (*QueryInferencesByExecutorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InferencesByExecutor not implemented")
+}
+func (UnimplementedQueryServer) InferencesByStatus(context.Context, *QueryInferencesByStatusRequest) (*QueryInferencesByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InferencesByStatus not implemented")
+}
+func (UnimplementedQueryServer) ValidatorStats(context.Context, *QueryValidatorStatsRequest) (*QueryValidatorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorStats not implemented")
+}
+func (UnimplementedQueryServer) ValidatorStatsHistory(context.Context, *QueryValidatorStatsHistoryRequest) (*QueryValidatorStatsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorStatsHistory not implemented")
+}
+func (UnimplementedQueryServer) ValidatorStatsRanked(context.Context, *QueryValidatorStatsRankedRequest) (*QueryValidatorStatsRankedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorStatsRanked not implemented")
+}
+func (UnimplementedQueryServer) ValidatorTargetStats(context.Context, *QueryValidatorTargetStatsRequest) (*QueryValidatorTargetStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorTargetStats not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -2224,6 +2445,240 @@ func _Query_TrainingAllowList_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InferenceDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInferenceDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InferenceDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InferenceDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InferenceDispute(ctx, req.(*QueryInferenceDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeveloperCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeveloperCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeveloperCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DeveloperCredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeveloperCredit(ctx, req.(*QueryDeveloperCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InferenceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInferenceKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InferenceKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InferenceKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InferenceKey(ctx, req.(*QueryInferenceKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InferenceKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInferenceKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InferenceKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InferenceKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InferenceKeys(ctx, req.(*QueryInferenceKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceQuote(ctx, req.(*QueryPriceQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipantExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantExitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipantExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParticipantExit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipantExit(ctx, req.(*QueryParticipantExitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InferencesByRequester_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInferencesByRequesterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InferencesByRequester(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InferencesByRequester_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InferencesByRequester(ctx, req.(*QueryInferencesByRequesterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InferencesByExecutor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInferencesByExecutorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InferencesByExecutor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InferencesByExecutor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InferencesByExecutor(ctx, req.(*QueryInferencesByExecutorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InferencesByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInferencesByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InferencesByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InferencesByStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InferencesByStatus(ctx, req.(*QueryInferencesByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorStats(ctx, req.(*QueryValidatorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorStatsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorStatsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorStatsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorStatsHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorStatsHistory(ctx, req.(*QueryValidatorStatsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorStatsRanked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorStatsRankedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorStatsRanked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorStatsRanked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorStatsRanked(ctx, req.(*QueryValidatorStatsRankedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorTargetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorTargetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorTargetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorTargetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorTargetStats(ctx, req.(*QueryValidatorTargetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2483,6 +2938,58 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TrainingAllowList",
 			Handler:    _Query_TrainingAllowList_Handler,
 		},
+		{
+			MethodName: "InferenceDispute",
+			Handler:    _Query_InferenceDispute_Handler,
+		},
+		{
+			MethodName: "DeveloperCredit",
+			Handler:    _Query_DeveloperCredit_Handler,
+		},
+		{
+			MethodName: "InferenceKey",
+			Handler:    _Query_InferenceKey_Handler,
+		},
+		{
+			MethodName: "InferenceKeys",
+			Handler:    _Query_InferenceKeys_Handler,
+		},
+		{
+			MethodName: "PriceQuote",
+			Handler:    _Query_PriceQuote_Handler,
+		},
+		{
+			MethodName: "ParticipantExit",
+			Handler:    _Query_ParticipantExit_Handler,
+		},
+		{
+			MethodName: "InferencesByRequester",
+			Handler:    _Query_InferencesByRequester_Handler,
+		},
+		{
+			MethodName: "InferencesByExecutor",
+			Handler:    _Query_InferencesByExecutor_Handler,
+		},
+		{
+			MethodName: "InferencesByStatus",
+			Handler:    _Query_InferencesByStatus_Handler,
+		},
+		{
+			MethodName: "ValidatorStats",
+			Handler:    _Query_ValidatorStats_Handler,
+		},
+		{
+			MethodName: "ValidatorStatsHistory",
+			Handler:    _Query_ValidatorStatsHistory_Handler,
+		},
+		{
+			MethodName: "ValidatorStatsRanked",
+			Handler:    _Query_ValidatorStatsRanked_Handler,
+		},
+		{
+			MethodName: "ValidatorTargetStats",
+			Handler:    _Query_ValidatorTargetStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/inference/query.proto",
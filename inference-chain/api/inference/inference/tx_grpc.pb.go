@@ -48,6 +48,15 @@ const (
 	Msg_AddUserToTrainingAllowList_FullMethodName       = "/inference.inference.Msg/AddUserToTrainingAllowList"
 	Msg_RemoveUserFromTrainingAllowList_FullMethodName  = "/inference.inference.Msg/RemoveUserFromTrainingAllowList"
 	Msg_SetTrainingAllowList_FullMethodName             = "/inference.inference.Msg/SetTrainingAllowList"
+	Msg_SubmitInferenceEvidence_FullMethodName          = "/inference.inference.Msg/SubmitInferenceEvidence"
+	Msg_DisputeInference_FullMethodName                 = "/inference.inference.Msg/DisputeInference"
+	Msg_DepositCredit_FullMethodName                    = "/inference.inference.Msg/DepositCredit"
+	Msg_WithdrawCredit_FullMethodName                   = "/inference.inference.Msg/WithdrawCredit"
+	Msg_GrantInferenceKey_FullMethodName                = "/inference.inference.Msg/GrantInferenceKey"
+	Msg_RevokeInferenceKey_FullMethodName               = "/inference.inference.Msg/RevokeInferenceKey"
+	Msg_RequestPriceQuote_FullMethodName                = "/inference.inference.Msg/RequestPriceQuote"
+	Msg_LeaveNetwork_FullMethodName                     = "/inference.inference.Msg/LeaveNetwork"
+	Msg_UpdateParticipantProfile_FullMethodName         = "/inference.inference.Msg/UpdateParticipantProfile"
 )
 
 // MsgClient is the client API for Msg service.
@@ -85,6 +94,15 @@ type MsgClient interface {
 	AddUserToTrainingAllowList(ctx context.Context, in *MsgAddUserToTrainingAllowList, opts ...grpc.CallOption) (*MsgAddUserToTrainingAllowListResponse, error)
 	RemoveUserFromTrainingAllowList(ctx context.Context, in *MsgRemoveUserFromTrainingAllowList, opts ...grpc.CallOption) (*MsgRemoveUserFromTrainingAllowListResponse, error)
 	SetTrainingAllowList(ctx context.Context, in *MsgSetTrainingAllowList, opts ...grpc.CallOption) (*MsgSetTrainingAllowListResponse, error)
+	SubmitInferenceEvidence(ctx context.Context, in *MsgSubmitInferenceEvidence, opts ...grpc.CallOption) (*MsgSubmitInferenceEvidenceResponse, error)
+	DisputeInference(ctx context.Context, in *MsgDisputeInference, opts ...grpc.CallOption) (*MsgDisputeInferenceResponse, error)
+	DepositCredit(ctx context.Context, in *MsgDepositCredit, opts ...grpc.CallOption) (*MsgDepositCreditResponse, error)
+	WithdrawCredit(ctx context.Context, in *MsgWithdrawCredit, opts ...grpc.CallOption) (*MsgWithdrawCreditResponse, error)
+	GrantInferenceKey(ctx context.Context, in *MsgGrantInferenceKey, opts ...grpc.CallOption) (*MsgGrantInferenceKeyResponse, error)
+	RevokeInferenceKey(ctx context.Context, in *MsgRevokeInferenceKey, opts ...grpc.CallOption) (*MsgRevokeInferenceKeyResponse, error)
+	RequestPriceQuote(ctx context.Context, in *MsgRequestPriceQuote, opts ...grpc.CallOption) (*MsgRequestPriceQuoteResponse, error)
+	LeaveNetwork(ctx context.Context, in *MsgLeaveNetwork, opts ...grpc.CallOption) (*MsgLeaveNetworkResponse, error)
+	UpdateParticipantProfile(ctx context.Context, in *MsgUpdateParticipantProfile, opts ...grpc.CallOption) (*MsgUpdateParticipantProfileResponse, error)
 }
 
 type msgClient struct {
@@ -356,6 +374,87 @@ func (c *msgClient) SetTrainingAllowList(ctx context.Context, in *MsgSetTraining
 	return out, nil
 }
 
+func (c *msgClient) SubmitInferenceEvidence(ctx context.Context, in *MsgSubmitInferenceEvidence, opts ...grpc.CallOption) (*MsgSubmitInferenceEvidenceResponse, error) {
+	out := new(MsgSubmitInferenceEvidenceResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitInferenceEvidence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisputeInference(ctx context.Context, in *MsgDisputeInference, opts ...grpc.CallOption) (*MsgDisputeInferenceResponse, error) {
+	out := new(MsgDisputeInferenceResponse)
+	err := c.cc.Invoke(ctx, Msg_DisputeInference_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DepositCredit(ctx context.Context, in *MsgDepositCredit, opts ...grpc.CallOption) (*MsgDepositCreditResponse, error) {
+	out := new(MsgDepositCreditResponse)
+	err := c.cc.Invoke(ctx, Msg_DepositCredit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawCredit(ctx context.Context, in *MsgWithdrawCredit, opts ...grpc.CallOption) (*MsgWithdrawCreditResponse, error) {
+	out := new(MsgWithdrawCreditResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawCredit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantInferenceKey(ctx context.Context, in *MsgGrantInferenceKey, opts ...grpc.CallOption) (*MsgGrantInferenceKeyResponse, error) {
+	out := new(MsgGrantInferenceKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantInferenceKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeInferenceKey(ctx context.Context, in *MsgRevokeInferenceKey, opts ...grpc.CallOption) (*MsgRevokeInferenceKeyResponse, error) {
+	out := new(MsgRevokeInferenceKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeInferenceKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestPriceQuote(ctx context.Context, in *MsgRequestPriceQuote, opts ...grpc.CallOption) (*MsgRequestPriceQuoteResponse, error) {
+	out := new(MsgRequestPriceQuoteResponse)
+	err := c.cc.Invoke(ctx, Msg_RequestPriceQuote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LeaveNetwork(ctx context.Context, in *MsgLeaveNetwork, opts ...grpc.CallOption) (*MsgLeaveNetworkResponse, error) {
+	out := new(MsgLeaveNetworkResponse)
+	err := c.cc.Invoke(ctx, Msg_LeaveNetwork_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParticipantProfile(ctx context.Context, in *MsgUpdateParticipantProfile, opts ...grpc.CallOption) (*MsgUpdateParticipantProfileResponse, error) {
+	out := new(MsgUpdateParticipantProfileResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParticipantProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -391,6 +490,15 @@ type MsgServer interface {
 	AddUserToTrainingAllowList(context.Context, *MsgAddUserToTrainingAllowList) (*MsgAddUserToTrainingAllowListResponse, error)
 	RemoveUserFromTrainingAllowList(context.Context, *MsgRemoveUserFromTrainingAllowList) (*MsgRemoveUserFromTrainingAllowListResponse, error)
 	SetTrainingAllowList(context.Context, *MsgSetTrainingAllowList) (*MsgSetTrainingAllowListResponse, error)
+	SubmitInferenceEvidence(context.Context, *MsgSubmitInferenceEvidence) (*MsgSubmitInferenceEvidenceResponse, error)
+	DisputeInference(context.Context, *MsgDisputeInference) (*MsgDisputeInferenceResponse, error)
+	DepositCredit(context.Context, *MsgDepositCredit) (*MsgDepositCreditResponse, error)
+	WithdrawCredit(context.Context, *MsgWithdrawCredit) (*MsgWithdrawCreditResponse, error)
+	GrantInferenceKey(context.Context, *MsgGrantInferenceKey) (*MsgGrantInferenceKeyResponse, error)
+	RevokeInferenceKey(context.Context, *MsgRevokeInferenceKey) (*MsgRevokeInferenceKeyResponse, error)
+	RequestPriceQuote(context.Context, *MsgRequestPriceQuote) (*MsgRequestPriceQuoteResponse, error)
+	LeaveNetwork(context.Context, *MsgLeaveNetwork) (*MsgLeaveNetworkResponse, error)
+	UpdateParticipantProfile(context.Context, *MsgUpdateParticipantProfile) (*MsgUpdateParticipantProfileResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -485,6 +593,33 @@ func (UnimplementedMsgServer) RemoveUserFromTrainingAllowList(context.Context, *
 func (UnimplementedMsgServer) SetTrainingAllowList(context.Context, *MsgSetTrainingAllowList) (*MsgSetTrainingAllowListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetTrainingAllowList not implemented")
 }
+func (UnimplementedMsgServer) SubmitInferenceEvidence(context.Context, *MsgSubmitInferenceEvidence) (*MsgSubmitInferenceEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitInferenceEvidence not implemented")
+}
+func (UnimplementedMsgServer) DisputeInference(context.Context, *MsgDisputeInference) (*MsgDisputeInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisputeInference not implemented")
+}
+func (UnimplementedMsgServer) DepositCredit(context.Context, *MsgDepositCredit) (*MsgDepositCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositCredit not implemented")
+}
+func (UnimplementedMsgServer) WithdrawCredit(context.Context, *MsgWithdrawCredit) (*MsgWithdrawCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCredit not implemented")
+}
+func (UnimplementedMsgServer) GrantInferenceKey(context.Context, *MsgGrantInferenceKey) (*MsgGrantInferenceKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantInferenceKey not implemented")
+}
+func (UnimplementedMsgServer) RevokeInferenceKey(context.Context, *MsgRevokeInferenceKey) (*MsgRevokeInferenceKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInferenceKey not implemented")
+}
+func (UnimplementedMsgServer) RequestPriceQuote(context.Context, *MsgRequestPriceQuote) (*MsgRequestPriceQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPriceQuote not implemented")
+}
+func (UnimplementedMsgServer) LeaveNetwork(context.Context, *MsgLeaveNetwork) (*MsgLeaveNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveNetwork not implemented")
+}
+func (UnimplementedMsgServer) UpdateParticipantProfile(context.Context, *MsgUpdateParticipantProfile) (*MsgUpdateParticipantProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParticipantProfile not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -1020,6 +1155,168 @@ func _Msg_SetTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitInferenceEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitInferenceEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitInferenceEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitInferenceEvidence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitInferenceEvidence(ctx, req.(*MsgSubmitInferenceEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisputeInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisputeInference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisputeInference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DisputeInference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisputeInference(ctx, req.(*MsgDisputeInference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositCredit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DepositCredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositCredit(ctx, req.(*MsgDepositCredit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawCredit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawCredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawCredit(ctx, req.(*MsgWithdrawCredit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantInferenceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantInferenceKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantInferenceKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantInferenceKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantInferenceKey(ctx, req.(*MsgGrantInferenceKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeInferenceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeInferenceKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeInferenceKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeInferenceKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeInferenceKey(ctx, req.(*MsgRevokeInferenceKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestPriceQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestPriceQuote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestPriceQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RequestPriceQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestPriceQuote(ctx, req.(*MsgRequestPriceQuote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LeaveNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeaveNetwork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LeaveNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LeaveNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeaveNetwork(ctx, req.(*MsgLeaveNetwork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParticipantProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParticipantProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParticipantProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParticipantProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParticipantProfile(ctx, req.(*MsgUpdateParticipantProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1143,6 +1440,42 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetTrainingAllowList",
 			Handler:    _Msg_SetTrainingAllowList_Handler,
 		},
+		{
+			MethodName: "SubmitInferenceEvidence",
+			Handler:    _Msg_SubmitInferenceEvidence_Handler,
+		},
+		{
+			MethodName: "DisputeInference",
+			Handler:    _Msg_DisputeInference_Handler,
+		},
+		{
+			MethodName: "DepositCredit",
+			Handler:    _Msg_DepositCredit_Handler,
+		},
+		{
+			MethodName: "WithdrawCredit",
+			Handler:    _Msg_WithdrawCredit_Handler,
+		},
+		{
+			MethodName: "GrantInferenceKey",
+			Handler:    _Msg_GrantInferenceKey_Handler,
+		},
+		{
+			MethodName: "RevokeInferenceKey",
+			Handler:    _Msg_RevokeInferenceKey_Handler,
+		},
+		{
+			MethodName: "RequestPriceQuote",
+			Handler:    _Msg_RequestPriceQuote_Handler,
+		},
+		{
+			MethodName: "LeaveNetwork",
+			Handler:    _Msg_LeaveNetwork_Handler,
+		},
+		{
+			MethodName: "UpdateParticipantProfile",
+			Handler:    _Msg_UpdateParticipantProfile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/inference/tx.proto",
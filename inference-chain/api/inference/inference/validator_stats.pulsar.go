@@ -0,0 +1,2067 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package inference
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_ValidatorEpochStats                          protoreflect.MessageDescriptor
+	fd_ValidatorEpochStats_epoch_index              protoreflect.FieldDescriptor
+	fd_ValidatorEpochStats_validator                protoreflect.FieldDescriptor
+	fd_ValidatorEpochStats_validations              protoreflect.FieldDescriptor
+	fd_ValidatorEpochStats_passed                   protoreflect.FieldDescriptor
+	fd_ValidatorEpochStats_invalidations            protoreflect.FieldDescriptor
+	fd_ValidatorEpochStats_upheld_invalidations     protoreflect.FieldDescriptor
+	fd_ValidatorEpochStats_overturned_invalidations protoreflect.FieldDescriptor
+	fd_ValidatorEpochStats_revalidations            protoreflect.FieldDescriptor
+	fd_ValidatorEpochStats_must_validate            protoreflect.FieldDescriptor
+	fd_ValidatorEpochStats_missed                   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_validator_stats_proto_init()
+	md_ValidatorEpochStats = File_inference_inference_validator_stats_proto.Messages().ByName("ValidatorEpochStats")
+	fd_ValidatorEpochStats_epoch_index = md_ValidatorEpochStats.Fields().ByName("epoch_index")
+	fd_ValidatorEpochStats_validator = md_ValidatorEpochStats.Fields().ByName("validator")
+	fd_ValidatorEpochStats_validations = md_ValidatorEpochStats.Fields().ByName("validations")
+	fd_ValidatorEpochStats_passed = md_ValidatorEpochStats.Fields().ByName("passed")
+	fd_ValidatorEpochStats_invalidations = md_ValidatorEpochStats.Fields().ByName("invalidations")
+	fd_ValidatorEpochStats_upheld_invalidations = md_ValidatorEpochStats.Fields().ByName("upheld_invalidations")
+	fd_ValidatorEpochStats_overturned_invalidations = md_ValidatorEpochStats.Fields().ByName("overturned_invalidations")
+	fd_ValidatorEpochStats_revalidations = md_ValidatorEpochStats.Fields().ByName("revalidations")
+	fd_ValidatorEpochStats_must_validate = md_ValidatorEpochStats.Fields().ByName("must_validate")
+	fd_ValidatorEpochStats_missed = md_ValidatorEpochStats.Fields().ByName("missed")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorEpochStats)(nil)
+
+type fastReflection_ValidatorEpochStats ValidatorEpochStats
+
+func (x *ValidatorEpochStats) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorEpochStats)(x)
+}
+
+func (x *ValidatorEpochStats) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_validator_stats_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorEpochStats_messageType fastReflection_ValidatorEpochStats_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorEpochStats_messageType{}
+
+type fastReflection_ValidatorEpochStats_messageType struct{}
+
+func (x fastReflection_ValidatorEpochStats_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorEpochStats)(nil)
+}
+func (x fastReflection_ValidatorEpochStats_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorEpochStats)
+}
+func (x fastReflection_ValidatorEpochStats_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorEpochStats
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorEpochStats) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorEpochStats
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorEpochStats) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorEpochStats_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorEpochStats) New() protoreflect.Message {
+	return new(fastReflection_ValidatorEpochStats)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorEpochStats) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorEpochStats)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorEpochStats) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EpochIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochIndex)
+		if !f(fd_ValidatorEpochStats_epoch_index, value) {
+			return
+		}
+	}
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_ValidatorEpochStats_validator, value) {
+			return
+		}
+	}
+	if x.Validations != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Validations)
+		if !f(fd_ValidatorEpochStats_validations, value) {
+			return
+		}
+	}
+	if x.Passed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Passed)
+		if !f(fd_ValidatorEpochStats_passed, value) {
+			return
+		}
+	}
+	if x.Invalidations != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Invalidations)
+		if !f(fd_ValidatorEpochStats_invalidations, value) {
+			return
+		}
+	}
+	if x.UpheldInvalidations != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UpheldInvalidations)
+		if !f(fd_ValidatorEpochStats_upheld_invalidations, value) {
+			return
+		}
+	}
+	if x.OverturnedInvalidations != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OverturnedInvalidations)
+		if !f(fd_ValidatorEpochStats_overturned_invalidations, value) {
+			return
+		}
+	}
+	if x.Revalidations != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Revalidations)
+		if !f(fd_ValidatorEpochStats_revalidations, value) {
+			return
+		}
+	}
+	if x.MustValidate != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MustValidate)
+		if !f(fd_ValidatorEpochStats_must_validate, value) {
+			return
+		}
+	}
+	if x.Missed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Missed)
+		if !f(fd_ValidatorEpochStats_missed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorEpochStats) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.ValidatorEpochStats.epoch_index":
+		return x.EpochIndex != uint64(0)
+	case "inference.inference.ValidatorEpochStats.validator":
+		return x.Validator != ""
+	case "inference.inference.ValidatorEpochStats.validations":
+		return x.Validations != uint64(0)
+	case "inference.inference.ValidatorEpochStats.passed":
+		return x.Passed != uint64(0)
+	case "inference.inference.ValidatorEpochStats.invalidations":
+		return x.Invalidations != uint64(0)
+	case "inference.inference.ValidatorEpochStats.upheld_invalidations":
+		return x.UpheldInvalidations != uint64(0)
+	case "inference.inference.ValidatorEpochStats.overturned_invalidations":
+		return x.OverturnedInvalidations != uint64(0)
+	case "inference.inference.ValidatorEpochStats.revalidations":
+		return x.Revalidations != uint64(0)
+	case "inference.inference.ValidatorEpochStats.must_validate":
+		return x.MustValidate != uint64(0)
+	case "inference.inference.ValidatorEpochStats.missed":
+		return x.Missed != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidatorEpochStats"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidatorEpochStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorEpochStats) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.ValidatorEpochStats.epoch_index":
+		x.EpochIndex = uint64(0)
+	case "inference.inference.ValidatorEpochStats.validator":
+		x.Validator = ""
+	case "inference.inference.ValidatorEpochStats.validations":
+		x.Validations = uint64(0)
+	case "inference.inference.ValidatorEpochStats.passed":
+		x.Passed = uint64(0)
+	case "inference.inference.ValidatorEpochStats.invalidations":
+		x.Invalidations = uint64(0)
+	case "inference.inference.ValidatorEpochStats.upheld_invalidations":
+		x.UpheldInvalidations = uint64(0)
+	case "inference.inference.ValidatorEpochStats.overturned_invalidations":
+		x.OverturnedInvalidations = uint64(0)
+	case "inference.inference.ValidatorEpochStats.revalidations":
+		x.Revalidations = uint64(0)
+	case "inference.inference.ValidatorEpochStats.must_validate":
+		x.MustValidate = uint64(0)
+	case "inference.inference.ValidatorEpochStats.missed":
+		x.Missed = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidatorEpochStats"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidatorEpochStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorEpochStats) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.ValidatorEpochStats.epoch_index":
+		value := x.EpochIndex
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ValidatorEpochStats.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.ValidatorEpochStats.validations":
+		value := x.Validations
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ValidatorEpochStats.passed":
+		value := x.Passed
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ValidatorEpochStats.invalidations":
+		value := x.Invalidations
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ValidatorEpochStats.upheld_invalidations":
+		value := x.UpheldInvalidations
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ValidatorEpochStats.overturned_invalidations":
+		value := x.OverturnedInvalidations
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ValidatorEpochStats.revalidations":
+		value := x.Revalidations
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ValidatorEpochStats.must_validate":
+		value := x.MustValidate
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ValidatorEpochStats.missed":
+		value := x.Missed
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidatorEpochStats"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidatorEpochStats does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorEpochStats) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.ValidatorEpochStats.epoch_index":
+		x.EpochIndex = value.Uint()
+	case "inference.inference.ValidatorEpochStats.validator":
+		x.Validator = value.Interface().(string)
+	case "inference.inference.ValidatorEpochStats.validations":
+		x.Validations = value.Uint()
+	case "inference.inference.ValidatorEpochStats.passed":
+		x.Passed = value.Uint()
+	case "inference.inference.ValidatorEpochStats.invalidations":
+		x.Invalidations = value.Uint()
+	case "inference.inference.ValidatorEpochStats.upheld_invalidations":
+		x.UpheldInvalidations = value.Uint()
+	case "inference.inference.ValidatorEpochStats.overturned_invalidations":
+		x.OverturnedInvalidations = value.Uint()
+	case "inference.inference.ValidatorEpochStats.revalidations":
+		x.Revalidations = value.Uint()
+	case "inference.inference.ValidatorEpochStats.must_validate":
+		x.MustValidate = value.Uint()
+	case "inference.inference.ValidatorEpochStats.missed":
+		x.Missed = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidatorEpochStats"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidatorEpochStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorEpochStats) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ValidatorEpochStats.epoch_index":
+		panic(fmt.Errorf("field epoch_index of message inference.inference.ValidatorEpochStats is not mutable"))
+	case "inference.inference.ValidatorEpochStats.validator":
+		panic(fmt.Errorf("field validator of message inference.inference.ValidatorEpochStats is not mutable"))
+	case "inference.inference.ValidatorEpochStats.validations":
+		panic(fmt.Errorf("field validations of message inference.inference.ValidatorEpochStats is not mutable"))
+	case "inference.inference.ValidatorEpochStats.passed":
+		panic(fmt.Errorf("field passed of message inference.inference.ValidatorEpochStats is not mutable"))
+	case "inference.inference.ValidatorEpochStats.invalidations":
+		panic(fmt.Errorf("field invalidations of message inference.inference.ValidatorEpochStats is not mutable"))
+	case "inference.inference.ValidatorEpochStats.upheld_invalidations":
+		panic(fmt.Errorf("field upheld_invalidations of message inference.inference.ValidatorEpochStats is not mutable"))
+	case "inference.inference.ValidatorEpochStats.overturned_invalidations":
+		panic(fmt.Errorf("field overturned_invalidations of message inference.inference.ValidatorEpochStats is not mutable"))
+	case "inference.inference.ValidatorEpochStats.revalidations":
+		panic(fmt.Errorf("field revalidations of message inference.inference.ValidatorEpochStats is not mutable"))
+	case "inference.inference.ValidatorEpochStats.must_validate":
+		panic(fmt.Errorf("field must_validate of message inference.inference.ValidatorEpochStats is not mutable"))
+	case "inference.inference.ValidatorEpochStats.missed":
+		panic(fmt.Errorf("field missed of message inference.inference.ValidatorEpochStats is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidatorEpochStats"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidatorEpochStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorEpochStats) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ValidatorEpochStats.epoch_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ValidatorEpochStats.validator":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.ValidatorEpochStats.validations":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ValidatorEpochStats.passed":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ValidatorEpochStats.invalidations":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ValidatorEpochStats.upheld_invalidations":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ValidatorEpochStats.overturned_invalidations":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ValidatorEpochStats.revalidations":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ValidatorEpochStats.must_validate":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ValidatorEpochStats.missed":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidatorEpochStats"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidatorEpochStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorEpochStats) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.ValidatorEpochStats", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorEpochStats) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorEpochStats) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorEpochStats) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorEpochStats) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorEpochStats)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EpochIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochIndex))
+		}
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Validations != 0 {
+			n += 1 + runtime.Sov(uint64(x.Validations))
+		}
+		if x.Passed != 0 {
+			n += 1 + runtime.Sov(uint64(x.Passed))
+		}
+		if x.Invalidations != 0 {
+			n += 1 + runtime.Sov(uint64(x.Invalidations))
+		}
+		if x.UpheldInvalidations != 0 {
+			n += 1 + runtime.Sov(uint64(x.UpheldInvalidations))
+		}
+		if x.OverturnedInvalidations != 0 {
+			n += 1 + runtime.Sov(uint64(x.OverturnedInvalidations))
+		}
+		if x.Revalidations != 0 {
+			n += 1 + runtime.Sov(uint64(x.Revalidations))
+		}
+		if x.MustValidate != 0 {
+			n += 1 + runtime.Sov(uint64(x.MustValidate))
+		}
+		if x.Missed != 0 {
+			n += 1 + runtime.Sov(uint64(x.Missed))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorEpochStats)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Missed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Missed))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.MustValidate != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MustValidate))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Revalidations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Revalidations))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.OverturnedInvalidations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OverturnedInvalidations))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.UpheldInvalidations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpheldInvalidations))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Invalidations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Invalidations))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Passed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Passed))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Validations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Validations))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.EpochIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorEpochStats)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorEpochStats: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorEpochStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+				}
+				x.EpochIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+				}
+				x.Validations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Validations |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+				}
+				x.Passed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Passed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Invalidations", wireType)
+				}
+				x.Invalidations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Invalidations |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpheldInvalidations", wireType)
+				}
+				x.UpheldInvalidations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UpheldInvalidations |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OverturnedInvalidations", wireType)
+				}
+				x.OverturnedInvalidations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OverturnedInvalidations |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revalidations", wireType)
+				}
+				x.Revalidations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Revalidations |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MustValidate", wireType)
+				}
+				x.MustValidate = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MustValidate |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
+				}
+				x.Missed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Missed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ValidatorTargetStats                          protoreflect.MessageDescriptor
+	fd_ValidatorTargetStats_epoch_index              protoreflect.FieldDescriptor
+	fd_ValidatorTargetStats_validator                protoreflect.FieldDescriptor
+	fd_ValidatorTargetStats_executor                 protoreflect.FieldDescriptor
+	fd_ValidatorTargetStats_validations              protoreflect.FieldDescriptor
+	fd_ValidatorTargetStats_passed                   protoreflect.FieldDescriptor
+	fd_ValidatorTargetStats_invalidations            protoreflect.FieldDescriptor
+	fd_ValidatorTargetStats_upheld_invalidations     protoreflect.FieldDescriptor
+	fd_ValidatorTargetStats_overturned_invalidations protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_validator_stats_proto_init()
+	md_ValidatorTargetStats = File_inference_inference_validator_stats_proto.Messages().ByName("ValidatorTargetStats")
+	fd_ValidatorTargetStats_epoch_index = md_ValidatorTargetStats.Fields().ByName("epoch_index")
+	fd_ValidatorTargetStats_validator = md_ValidatorTargetStats.Fields().ByName("validator")
+	fd_ValidatorTargetStats_executor = md_ValidatorTargetStats.Fields().ByName("executor")
+	fd_ValidatorTargetStats_validations = md_ValidatorTargetStats.Fields().ByName("validations")
+	fd_ValidatorTargetStats_passed = md_ValidatorTargetStats.Fields().ByName("passed")
+	fd_ValidatorTargetStats_invalidations = md_ValidatorTargetStats.Fields().ByName("invalidations")
+	fd_ValidatorTargetStats_upheld_invalidations = md_ValidatorTargetStats.Fields().ByName("upheld_invalidations")
+	fd_ValidatorTargetStats_overturned_invalidations = md_ValidatorTargetStats.Fields().ByName("overturned_invalidations")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorTargetStats)(nil)
+
+type fastReflection_ValidatorTargetStats ValidatorTargetStats
+
+func (x *ValidatorTargetStats) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorTargetStats)(x)
+}
+
+func (x *ValidatorTargetStats) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_validator_stats_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorTargetStats_messageType fastReflection_ValidatorTargetStats_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorTargetStats_messageType{}
+
+type fastReflection_ValidatorTargetStats_messageType struct{}
+
+func (x fastReflection_ValidatorTargetStats_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorTargetStats)(nil)
+}
+func (x fastReflection_ValidatorTargetStats_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorTargetStats)
+}
+func (x fastReflection_ValidatorTargetStats_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorTargetStats
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorTargetStats) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorTargetStats
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorTargetStats) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorTargetStats_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorTargetStats) New() protoreflect.Message {
+	return new(fastReflection_ValidatorTargetStats)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorTargetStats) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorTargetStats)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorTargetStats) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EpochIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochIndex)
+		if !f(fd_ValidatorTargetStats_epoch_index, value) {
+			return
+		}
+	}
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_ValidatorTargetStats_validator, value) {
+			return
+		}
+	}
+	if x.Executor != "" {
+		value := protoreflect.ValueOfString(x.Executor)
+		if !f(fd_ValidatorTargetStats_executor, value) {
+			return
+		}
+	}
+	if x.Validations != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Validations)
+		if !f(fd_ValidatorTargetStats_validations, value) {
+			return
+		}
+	}
+	if x.Passed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Passed)
+		if !f(fd_ValidatorTargetStats_passed, value) {
+			return
+		}
+	}
+	if x.Invalidations != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Invalidations)
+		if !f(fd_ValidatorTargetStats_invalidations, value) {
+			return
+		}
+	}
+	if x.UpheldInvalidations != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UpheldInvalidations)
+		if !f(fd_ValidatorTargetStats_upheld_invalidations, value) {
+			return
+		}
+	}
+	if x.OverturnedInvalidations != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OverturnedInvalidations)
+		if !f(fd_ValidatorTargetStats_overturned_invalidations, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorTargetStats) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.ValidatorTargetStats.epoch_index":
+		return x.EpochIndex != uint64(0)
+	case "inference.inference.ValidatorTargetStats.validator":
+		return x.Validator != ""
+	case "inference.inference.ValidatorTargetStats.executor":
+		return x.Executor != ""
+	case "inference.inference.ValidatorTargetStats.validations":
+		return x.Validations != uint64(0)
+	case "inference.inference.ValidatorTargetStats.passed":
+		return x.Passed != uint64(0)
+	case "inference.inference.ValidatorTargetStats.invalidations":
+		return x.Invalidations != uint64(0)
+	case "inference.inference.ValidatorTargetStats.upheld_invalidations":
+		return x.UpheldInvalidations != uint64(0)
+	case "inference.inference.ValidatorTargetStats.overturned_invalidations":
+		return x.OverturnedInvalidations != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidatorTargetStats"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidatorTargetStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorTargetStats) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.ValidatorTargetStats.epoch_index":
+		x.EpochIndex = uint64(0)
+	case "inference.inference.ValidatorTargetStats.validator":
+		x.Validator = ""
+	case "inference.inference.ValidatorTargetStats.executor":
+		x.Executor = ""
+	case "inference.inference.ValidatorTargetStats.validations":
+		x.Validations = uint64(0)
+	case "inference.inference.ValidatorTargetStats.passed":
+		x.Passed = uint64(0)
+	case "inference.inference.ValidatorTargetStats.invalidations":
+		x.Invalidations = uint64(0)
+	case "inference.inference.ValidatorTargetStats.upheld_invalidations":
+		x.UpheldInvalidations = uint64(0)
+	case "inference.inference.ValidatorTargetStats.overturned_invalidations":
+		x.OverturnedInvalidations = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidatorTargetStats"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidatorTargetStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorTargetStats) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.ValidatorTargetStats.epoch_index":
+		value := x.EpochIndex
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ValidatorTargetStats.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.ValidatorTargetStats.executor":
+		value := x.Executor
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.ValidatorTargetStats.validations":
+		value := x.Validations
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ValidatorTargetStats.passed":
+		value := x.Passed
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ValidatorTargetStats.invalidations":
+		value := x.Invalidations
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ValidatorTargetStats.upheld_invalidations":
+		value := x.UpheldInvalidations
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ValidatorTargetStats.overturned_invalidations":
+		value := x.OverturnedInvalidations
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidatorTargetStats"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidatorTargetStats does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorTargetStats) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.ValidatorTargetStats.epoch_index":
+		x.EpochIndex = value.Uint()
+	case "inference.inference.ValidatorTargetStats.validator":
+		x.Validator = value.Interface().(string)
+	case "inference.inference.ValidatorTargetStats.executor":
+		x.Executor = value.Interface().(string)
+	case "inference.inference.ValidatorTargetStats.validations":
+		x.Validations = value.Uint()
+	case "inference.inference.ValidatorTargetStats.passed":
+		x.Passed = value.Uint()
+	case "inference.inference.ValidatorTargetStats.invalidations":
+		x.Invalidations = value.Uint()
+	case "inference.inference.ValidatorTargetStats.upheld_invalidations":
+		x.UpheldInvalidations = value.Uint()
+	case "inference.inference.ValidatorTargetStats.overturned_invalidations":
+		x.OverturnedInvalidations = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidatorTargetStats"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidatorTargetStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorTargetStats) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ValidatorTargetStats.epoch_index":
+		panic(fmt.Errorf("field epoch_index of message inference.inference.ValidatorTargetStats is not mutable"))
+	case "inference.inference.ValidatorTargetStats.validator":
+		panic(fmt.Errorf("field validator of message inference.inference.ValidatorTargetStats is not mutable"))
+	case "inference.inference.ValidatorTargetStats.executor":
+		panic(fmt.Errorf("field executor of message inference.inference.ValidatorTargetStats is not mutable"))
+	case "inference.inference.ValidatorTargetStats.validations":
+		panic(fmt.Errorf("field validations of message inference.inference.ValidatorTargetStats is not mutable"))
+	case "inference.inference.ValidatorTargetStats.passed":
+		panic(fmt.Errorf("field passed of message inference.inference.ValidatorTargetStats is not mutable"))
+	case "inference.inference.ValidatorTargetStats.invalidations":
+		panic(fmt.Errorf("field invalidations of message inference.inference.ValidatorTargetStats is not mutable"))
+	case "inference.inference.ValidatorTargetStats.upheld_invalidations":
+		panic(fmt.Errorf("field upheld_invalidations of message inference.inference.ValidatorTargetStats is not mutable"))
+	case "inference.inference.ValidatorTargetStats.overturned_invalidations":
+		panic(fmt.Errorf("field overturned_invalidations of message inference.inference.ValidatorTargetStats is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidatorTargetStats"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidatorTargetStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorTargetStats) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ValidatorTargetStats.epoch_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ValidatorTargetStats.validator":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.ValidatorTargetStats.executor":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.ValidatorTargetStats.validations":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ValidatorTargetStats.passed":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ValidatorTargetStats.invalidations":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ValidatorTargetStats.upheld_invalidations":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ValidatorTargetStats.overturned_invalidations":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ValidatorTargetStats"))
+		}
+		panic(fmt.Errorf("message inference.inference.ValidatorTargetStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorTargetStats) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.ValidatorTargetStats", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorTargetStats) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorTargetStats) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorTargetStats) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorTargetStats) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorTargetStats)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EpochIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochIndex))
+		}
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Executor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Validations != 0 {
+			n += 1 + runtime.Sov(uint64(x.Validations))
+		}
+		if x.Passed != 0 {
+			n += 1 + runtime.Sov(uint64(x.Passed))
+		}
+		if x.Invalidations != 0 {
+			n += 1 + runtime.Sov(uint64(x.Invalidations))
+		}
+		if x.UpheldInvalidations != 0 {
+			n += 1 + runtime.Sov(uint64(x.UpheldInvalidations))
+		}
+		if x.OverturnedInvalidations != 0 {
+			n += 1 + runtime.Sov(uint64(x.OverturnedInvalidations))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorTargetStats)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.OverturnedInvalidations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OverturnedInvalidations))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.UpheldInvalidations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpheldInvalidations))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Invalidations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Invalidations))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Passed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Passed))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Validations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Validations))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Executor) > 0 {
+			i -= len(x.Executor)
+			copy(dAtA[i:], x.Executor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executor)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.EpochIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorTargetStats)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorTargetStats: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorTargetStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+				}
+				x.EpochIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Executor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+				}
+				x.Validations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Validations |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+				}
+				x.Passed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Passed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Invalidations", wireType)
+				}
+				x.Invalidations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Invalidations |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpheldInvalidations", wireType)
+				}
+				x.UpheldInvalidations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UpheldInvalidations |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OverturnedInvalidations", wireType)
+				}
+				x.OverturnedInvalidations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OverturnedInvalidations |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: inference/inference/validator_stats.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ValidatorStatsRanking is the counter validators of an epoch are ranked by, highest first
+type ValidatorStatsRanking int32
+
+const (
+	ValidatorStatsRanking_RANK_BY_VALIDATIONS              ValidatorStatsRanking = 0
+	ValidatorStatsRanking_RANK_BY_INVALIDATIONS            ValidatorStatsRanking = 1
+	ValidatorStatsRanking_RANK_BY_UPHELD_INVALIDATIONS     ValidatorStatsRanking = 2
+	ValidatorStatsRanking_RANK_BY_OVERTURNED_INVALIDATIONS ValidatorStatsRanking = 3
+	ValidatorStatsRanking_RANK_BY_MISSED                   ValidatorStatsRanking = 4
+)
+
+// Enum value maps for ValidatorStatsRanking.
+var (
+	ValidatorStatsRanking_name = map[int32]string{
+		0: "RANK_BY_VALIDATIONS",
+		1: "RANK_BY_INVALIDATIONS",
+		2: "RANK_BY_UPHELD_INVALIDATIONS",
+		3: "RANK_BY_OVERTURNED_INVALIDATIONS",
+		4: "RANK_BY_MISSED",
+	}
+	ValidatorStatsRanking_value = map[string]int32{
+		"RANK_BY_VALIDATIONS":              0,
+		"RANK_BY_INVALIDATIONS":            1,
+		"RANK_BY_UPHELD_INVALIDATIONS":     2,
+		"RANK_BY_OVERTURNED_INVALIDATIONS": 3,
+		"RANK_BY_MISSED":                   4,
+	}
+)
+
+func (x ValidatorStatsRanking) Enum() *ValidatorStatsRanking {
+	p := new(ValidatorStatsRanking)
+	*p = x
+	return p
+}
+
+func (x ValidatorStatsRanking) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValidatorStatsRanking) Descriptor() protoreflect.EnumDescriptor {
+	return file_inference_inference_validator_stats_proto_enumTypes[0].Descriptor()
+}
+
+func (ValidatorStatsRanking) Type() protoreflect.EnumType {
+	return &file_inference_inference_validator_stats_proto_enumTypes[0]
+}
+
+func (x ValidatorStatsRanking) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValidatorStatsRanking.Descriptor instead.
+func (ValidatorStatsRanking) EnumDescriptor() ([]byte, []int) {
+	return file_inference_inference_validator_stats_proto_rawDescGZIP(), []int{0}
+}
+
+// ValidatorEpochStats aggregates how one validator validated the inferences of one epoch
+type ValidatorEpochStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochIndex uint64 `protobuf:"varint,1,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	Validator  string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// validations counts every inference validated, whatever the result
+	Validations uint64 `protobuf:"varint,3,opt,name=validations,proto3" json:"validations,omitempty"`
+	Passed      uint64 `protobuf:"varint,4,opt,name=passed,proto3" json:"passed,omitempty"`
+	// invalidations counts the inferences flagged as invalid, each of which starts an invalidation vote
+	Invalidations uint64 `protobuf:"varint,5,opt,name=invalidations,proto3" json:"invalidations,omitempty"`
+	// upheld_invalidations and overturned_invalidations count how those votes ended
+	UpheldInvalidations     uint64 `protobuf:"varint,6,opt,name=upheld_invalidations,json=upheldInvalidations,proto3" json:"upheld_invalidations,omitempty"`
+	OverturnedInvalidations uint64 `protobuf:"varint,7,opt,name=overturned_invalidations,json=overturnedInvalidations,proto3" json:"overturned_invalidations,omitempty"`
+	// revalidations counts the votes cast on inferences flagged by others
+	Revalidations uint64 `protobuf:"varint,8,opt,name=revalidations,proto3" json:"revalidations,omitempty"`
+	// must_validate and missed are recorded when the validator claims rewards for the epoch
+	MustValidate uint64 `protobuf:"varint,9,opt,name=must_validate,json=mustValidate,proto3" json:"must_validate,omitempty"`
+	Missed       uint64 `protobuf:"varint,10,opt,name=missed,proto3" json:"missed,omitempty"`
+}
+
+func (x *ValidatorEpochStats) Reset() {
+	*x = ValidatorEpochStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_validator_stats_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorEpochStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorEpochStats) ProtoMessage() {}
+
+// Deprecated: Use ValidatorEpochStats.ProtoReflect.Descriptor instead.
+func (*ValidatorEpochStats) Descriptor() ([]byte, []int) {
+	return file_inference_inference_validator_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidatorEpochStats) GetEpochIndex() uint64 {
+	if x != nil {
+		return x.EpochIndex
+	}
+	return 0
+}
+
+func (x *ValidatorEpochStats) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ValidatorEpochStats) GetValidations() uint64 {
+	if x != nil {
+		return x.Validations
+	}
+	return 0
+}
+
+func (x *ValidatorEpochStats) GetPassed() uint64 {
+	if x != nil {
+		return x.Passed
+	}
+	return 0
+}
+
+func (x *ValidatorEpochStats) GetInvalidations() uint64 {
+	if x != nil {
+		return x.Invalidations
+	}
+	return 0
+}
+
+func (x *ValidatorEpochStats) GetUpheldInvalidations() uint64 {
+	if x != nil {
+		return x.UpheldInvalidations
+	}
+	return 0
+}
+
+func (x *ValidatorEpochStats) GetOverturnedInvalidations() uint64 {
+	if x != nil {
+		return x.OverturnedInvalidations
+	}
+	return 0
+}
+
+func (x *ValidatorEpochStats) GetRevalidations() uint64 {
+	if x != nil {
+		return x.Revalidations
+	}
+	return 0
+}
+
+func (x *ValidatorEpochStats) GetMustValidate() uint64 {
+	if x != nil {
+		return x.MustValidate
+	}
+	return 0
+}
+
+func (x *ValidatorEpochStats) GetMissed() uint64 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+// ValidatorTargetStats aggregates how one validator validated the inferences of one executor in an epoch
+type ValidatorTargetStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochIndex              uint64 `protobuf:"varint,1,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	Validator               string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Executor                string `protobuf:"bytes,3,opt,name=executor,proto3" json:"executor,omitempty"`
+	Validations             uint64 `protobuf:"varint,4,opt,name=validations,proto3" json:"validations,omitempty"`
+	Passed                  uint64 `protobuf:"varint,5,opt,name=passed,proto3" json:"passed,omitempty"`
+	Invalidations           uint64 `protobuf:"varint,6,opt,name=invalidations,proto3" json:"invalidations,omitempty"`
+	UpheldInvalidations     uint64 `protobuf:"varint,7,opt,name=upheld_invalidations,json=upheldInvalidations,proto3" json:"upheld_invalidations,omitempty"`
+	OverturnedInvalidations uint64 `protobuf:"varint,8,opt,name=overturned_invalidations,json=overturnedInvalidations,proto3" json:"overturned_invalidations,omitempty"`
+}
+
+func (x *ValidatorTargetStats) Reset() {
+	*x = ValidatorTargetStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_validator_stats_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorTargetStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorTargetStats) ProtoMessage() {}
+
+// Deprecated: Use ValidatorTargetStats.ProtoReflect.Descriptor instead.
+func (*ValidatorTargetStats) Descriptor() ([]byte, []int) {
+	return file_inference_inference_validator_stats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorTargetStats) GetEpochIndex() uint64 {
+	if x != nil {
+		return x.EpochIndex
+	}
+	return 0
+}
+
+func (x *ValidatorTargetStats) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ValidatorTargetStats) GetExecutor() string {
+	if x != nil {
+		return x.Executor
+	}
+	return ""
+}
+
+func (x *ValidatorTargetStats) GetValidations() uint64 {
+	if x != nil {
+		return x.Validations
+	}
+	return 0
+}
+
+func (x *ValidatorTargetStats) GetPassed() uint64 {
+	if x != nil {
+		return x.Passed
+	}
+	return 0
+}
+
+func (x *ValidatorTargetStats) GetInvalidations() uint64 {
+	if x != nil {
+		return x.Invalidations
+	}
+	return 0
+}
+
+func (x *ValidatorTargetStats) GetUpheldInvalidations() uint64 {
+	if x != nil {
+		return x.UpheldInvalidations
+	}
+	return 0
+}
+
+func (x *ValidatorTargetStats) GetOverturnedInvalidations() uint64 {
+	if x != nil {
+		return x.OverturnedInvalidations
+	}
+	return 0
+}
+
+var File_inference_inference_validator_stats_proto protoreflect.FileDescriptor
+
+var file_inference_inference_validator_stats_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x22, 0x85, 0x03, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73,
+	0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x64, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x75, 0x70, 0x68, 0x65, 0x6c,
+	0x64, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x75, 0x70, 0x68, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x18, 0x6f, 0x76,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6f, 0x76,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d,
+	0x75, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x6d, 0x75, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x22, 0xbf, 0x02, 0x0a, 0x14, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x14,
+	0x75, 0x70, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x75, 0x70, 0x68, 0x65,
+	0x6c, 0x64, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x39, 0x0a, 0x18, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x69, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x17, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0xa7, 0x01, 0x0a, 0x15, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x42, 0x59, 0x5f,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x00, 0x12, 0x19, 0x0a,
+	0x15, 0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x42, 0x59, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x41, 0x4e, 0x4b,
+	0x5f, 0x42, 0x59, 0x5f, 0x55, 0x50, 0x48, 0x45, 0x4c, 0x44, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x41,
+	0x4e, 0x4b, 0x5f, 0x42, 0x59, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x54, 0x55, 0x52, 0x4e, 0x45, 0x44,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x03,
+	0x12, 0x12, 0x0a, 0x0e, 0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x42, 0x59, 0x5f, 0x4d, 0x49, 0x53, 0x53,
+	0x45, 0x44, 0x10, 0x04, 0x42, 0xc1, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x42, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03,
+	0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2,
+	0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_inference_inference_validator_stats_proto_rawDescOnce sync.Once
+	file_inference_inference_validator_stats_proto_rawDescData = file_inference_inference_validator_stats_proto_rawDesc
+)
+
+func file_inference_inference_validator_stats_proto_rawDescGZIP() []byte {
+	file_inference_inference_validator_stats_proto_rawDescOnce.Do(func() {
+		file_inference_inference_validator_stats_proto_rawDescData = protoimpl.X.CompressGZIP(file_inference_inference_validator_stats_proto_rawDescData)
+	})
+	return file_inference_inference_validator_stats_proto_rawDescData
+}
+
+var file_inference_inference_validator_stats_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_inference_inference_validator_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_inference_inference_validator_stats_proto_goTypes = []interface{}{
+	(ValidatorStatsRanking)(0),   // 0: inference.inference.ValidatorStatsRanking
+	(*ValidatorEpochStats)(nil),  // 1: inference.inference.ValidatorEpochStats
+	(*ValidatorTargetStats)(nil), // 2: inference.inference.ValidatorTargetStats
+}
+var file_inference_inference_validator_stats_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_inference_inference_validator_stats_proto_init() }
+func file_inference_inference_validator_stats_proto_init() {
+	if File_inference_inference_validator_stats_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_inference_inference_validator_stats_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorEpochStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_validator_stats_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorTargetStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_inference_inference_validator_stats_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_inference_inference_validator_stats_proto_goTypes,
+		DependencyIndexes: file_inference_inference_validator_stats_proto_depIdxs,
+		EnumInfos:         file_inference_inference_validator_stats_proto_enumTypes,
+		MessageInfos:      file_inference_inference_validator_stats_proto_msgTypes,
+	}.Build()
+	File_inference_inference_validator_stats_proto = out.File
+	file_inference_inference_validator_stats_proto_rawDesc = nil
+	file_inference_inference_validator_stats_proto_goTypes = nil
+	file_inference_inference_validator_stats_proto_depIdxs = nil
+}
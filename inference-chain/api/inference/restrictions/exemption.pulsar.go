@@ -0,0 +1,2883 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package restrictions
+
+import (
+	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_TransferExemption_2_list)(nil)
+
+type _TransferExemption_2_list struct {
+	list *[]string
+}
+
+func (x *_TransferExemption_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TransferExemption_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TransferExemption_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TransferExemption_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TransferExemption_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TransferExemption at list field FromAddresses as it is not of Message kind"))
+}
+
+func (x *_TransferExemption_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TransferExemption_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TransferExemption_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TransferExemption_3_list)(nil)
+
+type _TransferExemption_3_list struct {
+	list *[]string
+}
+
+func (x *_TransferExemption_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TransferExemption_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TransferExemption_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TransferExemption_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TransferExemption_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TransferExemption at list field ToAddresses as it is not of Message kind"))
+}
+
+func (x *_TransferExemption_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TransferExemption_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TransferExemption_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TransferExemption_4_list)(nil)
+
+type _TransferExemption_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_TransferExemption_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TransferExemption_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TransferExemption_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TransferExemption_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TransferExemption_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TransferExemption_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TransferExemption_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TransferExemption_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TransferExemption                     protoreflect.MessageDescriptor
+	fd_TransferExemption_exemption_id        protoreflect.FieldDescriptor
+	fd_TransferExemption_from_addresses      protoreflect.FieldDescriptor
+	fd_TransferExemption_to_addresses        protoreflect.FieldDescriptor
+	fd_TransferExemption_amount_per_epoch    protoreflect.FieldDescriptor
+	fd_TransferExemption_epoch_length_blocks protoreflect.FieldDescriptor
+	fd_TransferExemption_expiry_height       protoreflect.FieldDescriptor
+	fd_TransferExemption_revoked             protoreflect.FieldDescriptor
+	fd_TransferExemption_revoked_height      protoreflect.FieldDescriptor
+	fd_TransferExemption_justification       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_restrictions_exemption_proto_init()
+	md_TransferExemption = File_inference_restrictions_exemption_proto.Messages().ByName("TransferExemption")
+	fd_TransferExemption_exemption_id = md_TransferExemption.Fields().ByName("exemption_id")
+	fd_TransferExemption_from_addresses = md_TransferExemption.Fields().ByName("from_addresses")
+	fd_TransferExemption_to_addresses = md_TransferExemption.Fields().ByName("to_addresses")
+	fd_TransferExemption_amount_per_epoch = md_TransferExemption.Fields().ByName("amount_per_epoch")
+	fd_TransferExemption_epoch_length_blocks = md_TransferExemption.Fields().ByName("epoch_length_blocks")
+	fd_TransferExemption_expiry_height = md_TransferExemption.Fields().ByName("expiry_height")
+	fd_TransferExemption_revoked = md_TransferExemption.Fields().ByName("revoked")
+	fd_TransferExemption_revoked_height = md_TransferExemption.Fields().ByName("revoked_height")
+	fd_TransferExemption_justification = md_TransferExemption.Fields().ByName("justification")
+}
+
+var _ protoreflect.Message = (*fastReflection_TransferExemption)(nil)
+
+type fastReflection_TransferExemption TransferExemption
+
+func (x *TransferExemption) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TransferExemption)(x)
+}
+
+func (x *TransferExemption) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_restrictions_exemption_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TransferExemption_messageType fastReflection_TransferExemption_messageType
+var _ protoreflect.MessageType = fastReflection_TransferExemption_messageType{}
+
+type fastReflection_TransferExemption_messageType struct{}
+
+func (x fastReflection_TransferExemption_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TransferExemption)(nil)
+}
+func (x fastReflection_TransferExemption_messageType) New() protoreflect.Message {
+	return new(fastReflection_TransferExemption)
+}
+func (x fastReflection_TransferExemption_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransferExemption
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TransferExemption) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransferExemption
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TransferExemption) Type() protoreflect.MessageType {
+	return _fastReflection_TransferExemption_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TransferExemption) New() protoreflect.Message {
+	return new(fastReflection_TransferExemption)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TransferExemption) Interface() protoreflect.ProtoMessage {
+	return (*TransferExemption)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TransferExemption) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExemptionId != "" {
+		value := protoreflect.ValueOfString(x.ExemptionId)
+		if !f(fd_TransferExemption_exemption_id, value) {
+			return
+		}
+	}
+	if len(x.FromAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_TransferExemption_2_list{list: &x.FromAddresses})
+		if !f(fd_TransferExemption_from_addresses, value) {
+			return
+		}
+	}
+	if len(x.ToAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_TransferExemption_3_list{list: &x.ToAddresses})
+		if !f(fd_TransferExemption_to_addresses, value) {
+			return
+		}
+	}
+	if len(x.AmountPerEpoch) != 0 {
+		value := protoreflect.ValueOfList(&_TransferExemption_4_list{list: &x.AmountPerEpoch})
+		if !f(fd_TransferExemption_amount_per_epoch, value) {
+			return
+		}
+	}
+	if x.EpochLengthBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochLengthBlocks)
+		if !f(fd_TransferExemption_epoch_length_blocks, value) {
+			return
+		}
+	}
+	if x.ExpiryHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExpiryHeight)
+		if !f(fd_TransferExemption_expiry_height, value) {
+			return
+		}
+	}
+	if x.Revoked != false {
+		value := protoreflect.ValueOfBool(x.Revoked)
+		if !f(fd_TransferExemption_revoked, value) {
+			return
+		}
+	}
+	if x.RevokedHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RevokedHeight)
+		if !f(fd_TransferExemption_revoked_height, value) {
+			return
+		}
+	}
+	if x.Justification != "" {
+		value := protoreflect.ValueOfString(x.Justification)
+		if !f(fd_TransferExemption_justification, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TransferExemption) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.restrictions.TransferExemption.exemption_id":
+		return x.ExemptionId != ""
+	case "inference.restrictions.TransferExemption.from_addresses":
+		return len(x.FromAddresses) != 0
+	case "inference.restrictions.TransferExemption.to_addresses":
+		return len(x.ToAddresses) != 0
+	case "inference.restrictions.TransferExemption.amount_per_epoch":
+		return len(x.AmountPerEpoch) != 0
+	case "inference.restrictions.TransferExemption.epoch_length_blocks":
+		return x.EpochLengthBlocks != uint64(0)
+	case "inference.restrictions.TransferExemption.expiry_height":
+		return x.ExpiryHeight != uint64(0)
+	case "inference.restrictions.TransferExemption.revoked":
+		return x.Revoked != false
+	case "inference.restrictions.TransferExemption.revoked_height":
+		return x.RevokedHeight != uint64(0)
+	case "inference.restrictions.TransferExemption.justification":
+		return x.Justification != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.TransferExemption"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.TransferExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferExemption) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.restrictions.TransferExemption.exemption_id":
+		x.ExemptionId = ""
+	case "inference.restrictions.TransferExemption.from_addresses":
+		x.FromAddresses = nil
+	case "inference.restrictions.TransferExemption.to_addresses":
+		x.ToAddresses = nil
+	case "inference.restrictions.TransferExemption.amount_per_epoch":
+		x.AmountPerEpoch = nil
+	case "inference.restrictions.TransferExemption.epoch_length_blocks":
+		x.EpochLengthBlocks = uint64(0)
+	case "inference.restrictions.TransferExemption.expiry_height":
+		x.ExpiryHeight = uint64(0)
+	case "inference.restrictions.TransferExemption.revoked":
+		x.Revoked = false
+	case "inference.restrictions.TransferExemption.revoked_height":
+		x.RevokedHeight = uint64(0)
+	case "inference.restrictions.TransferExemption.justification":
+		x.Justification = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.TransferExemption"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.TransferExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TransferExemption) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.restrictions.TransferExemption.exemption_id":
+		value := x.ExemptionId
+		return protoreflect.ValueOfString(value)
+	case "inference.restrictions.TransferExemption.from_addresses":
+		if len(x.FromAddresses) == 0 {
+			return protoreflect.ValueOfList(&_TransferExemption_2_list{})
+		}
+		listValue := &_TransferExemption_2_list{list: &x.FromAddresses}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.restrictions.TransferExemption.to_addresses":
+		if len(x.ToAddresses) == 0 {
+			return protoreflect.ValueOfList(&_TransferExemption_3_list{})
+		}
+		listValue := &_TransferExemption_3_list{list: &x.ToAddresses}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.restrictions.TransferExemption.amount_per_epoch":
+		if len(x.AmountPerEpoch) == 0 {
+			return protoreflect.ValueOfList(&_TransferExemption_4_list{})
+		}
+		listValue := &_TransferExemption_4_list{list: &x.AmountPerEpoch}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.restrictions.TransferExemption.epoch_length_blocks":
+		value := x.EpochLengthBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "inference.restrictions.TransferExemption.expiry_height":
+		value := x.ExpiryHeight
+		return protoreflect.ValueOfUint64(value)
+	case "inference.restrictions.TransferExemption.revoked":
+		value := x.Revoked
+		return protoreflect.ValueOfBool(value)
+	case "inference.restrictions.TransferExemption.revoked_height":
+		value := x.RevokedHeight
+		return protoreflect.ValueOfUint64(value)
+	case "inference.restrictions.TransferExemption.justification":
+		value := x.Justification
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.TransferExemption"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.TransferExemption does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferExemption) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.restrictions.TransferExemption.exemption_id":
+		x.ExemptionId = value.Interface().(string)
+	case "inference.restrictions.TransferExemption.from_addresses":
+		lv := value.List()
+		clv := lv.(*_TransferExemption_2_list)
+		x.FromAddresses = *clv.list
+	case "inference.restrictions.TransferExemption.to_addresses":
+		lv := value.List()
+		clv := lv.(*_TransferExemption_3_list)
+		x.ToAddresses = *clv.list
+	case "inference.restrictions.TransferExemption.amount_per_epoch":
+		lv := value.List()
+		clv := lv.(*_TransferExemption_4_list)
+		x.AmountPerEpoch = *clv.list
+	case "inference.restrictions.TransferExemption.epoch_length_blocks":
+		x.EpochLengthBlocks = value.Uint()
+	case "inference.restrictions.TransferExemption.expiry_height":
+		x.ExpiryHeight = value.Uint()
+	case "inference.restrictions.TransferExemption.revoked":
+		x.Revoked = value.Bool()
+	case "inference.restrictions.TransferExemption.revoked_height":
+		x.RevokedHeight = value.Uint()
+	case "inference.restrictions.TransferExemption.justification":
+		x.Justification = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.TransferExemption"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.TransferExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferExemption) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.restrictions.TransferExemption.from_addresses":
+		if x.FromAddresses == nil {
+			x.FromAddresses = []string{}
+		}
+		value := &_TransferExemption_2_list{list: &x.FromAddresses}
+		return protoreflect.ValueOfList(value)
+	case "inference.restrictions.TransferExemption.to_addresses":
+		if x.ToAddresses == nil {
+			x.ToAddresses = []string{}
+		}
+		value := &_TransferExemption_3_list{list: &x.ToAddresses}
+		return protoreflect.ValueOfList(value)
+	case "inference.restrictions.TransferExemption.amount_per_epoch":
+		if x.AmountPerEpoch == nil {
+			x.AmountPerEpoch = []*v1beta1.Coin{}
+		}
+		value := &_TransferExemption_4_list{list: &x.AmountPerEpoch}
+		return protoreflect.ValueOfList(value)
+	case "inference.restrictions.TransferExemption.exemption_id":
+		panic(fmt.Errorf("field exemption_id of message inference.restrictions.TransferExemption is not mutable"))
+	case "inference.restrictions.TransferExemption.epoch_length_blocks":
+		panic(fmt.Errorf("field epoch_length_blocks of message inference.restrictions.TransferExemption is not mutable"))
+	case "inference.restrictions.TransferExemption.expiry_height":
+		panic(fmt.Errorf("field expiry_height of message inference.restrictions.TransferExemption is not mutable"))
+	case "inference.restrictions.TransferExemption.revoked":
+		panic(fmt.Errorf("field revoked of message inference.restrictions.TransferExemption is not mutable"))
+	case "inference.restrictions.TransferExemption.revoked_height":
+		panic(fmt.Errorf("field revoked_height of message inference.restrictions.TransferExemption is not mutable"))
+	case "inference.restrictions.TransferExemption.justification":
+		panic(fmt.Errorf("field justification of message inference.restrictions.TransferExemption is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.TransferExemption"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.TransferExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TransferExemption) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.restrictions.TransferExemption.exemption_id":
+		return protoreflect.ValueOfString("")
+	case "inference.restrictions.TransferExemption.from_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TransferExemption_2_list{list: &list})
+	case "inference.restrictions.TransferExemption.to_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TransferExemption_3_list{list: &list})
+	case "inference.restrictions.TransferExemption.amount_per_epoch":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_TransferExemption_4_list{list: &list})
+	case "inference.restrictions.TransferExemption.epoch_length_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.restrictions.TransferExemption.expiry_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.restrictions.TransferExemption.revoked":
+		return protoreflect.ValueOfBool(false)
+	case "inference.restrictions.TransferExemption.revoked_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.restrictions.TransferExemption.justification":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.TransferExemption"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.TransferExemption does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TransferExemption) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.restrictions.TransferExemption", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TransferExemption) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferExemption) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TransferExemption) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TransferExemption) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TransferExemption)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ExemptionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FromAddresses) > 0 {
+			for _, s := range x.FromAddresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ToAddresses) > 0 {
+			for _, s := range x.ToAddresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AmountPerEpoch) > 0 {
+			for _, e := range x.AmountPerEpoch {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.EpochLengthBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochLengthBlocks))
+		}
+		if x.ExpiryHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryHeight))
+		}
+		if x.Revoked {
+			n += 2
+		}
+		if x.RevokedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.RevokedHeight))
+		}
+		l = len(x.Justification)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TransferExemption)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Justification) > 0 {
+			i -= len(x.Justification)
+			copy(dAtA[i:], x.Justification)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Justification)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.RevokedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevokedHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Revoked {
+			i--
+			if x.Revoked {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.ExpiryHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.EpochLengthBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochLengthBlocks))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.AmountPerEpoch) > 0 {
+			for iNdEx := len(x.AmountPerEpoch) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AmountPerEpoch[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.ToAddresses) > 0 {
+			for iNdEx := len(x.ToAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ToAddresses[iNdEx])
+				copy(dAtA[i:], x.ToAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.FromAddresses) > 0 {
+			for iNdEx := len(x.FromAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.FromAddresses[iNdEx])
+				copy(dAtA[i:], x.FromAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FromAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.ExemptionId) > 0 {
+			i -= len(x.ExemptionId)
+			copy(dAtA[i:], x.ExemptionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExemptionId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TransferExemption)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransferExemption: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransferExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExemptionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExemptionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromAddresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FromAddresses = append(x.FromAddresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAddresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ToAddresses = append(x.ToAddresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountPerEpoch", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AmountPerEpoch = append(x.AmountPerEpoch, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AmountPerEpoch[len(x.AmountPerEpoch)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochLengthBlocks", wireType)
+				}
+				x.EpochLengthBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochLengthBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+				}
+				x.ExpiryHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Revoked = bool(v != 0)
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevokedHeight", wireType)
+				}
+				x.RevokedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RevokedHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Justification", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Justification = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TransferExemptionUsage_4_list)(nil)
+
+type _TransferExemptionUsage_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_TransferExemptionUsage_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TransferExemptionUsage_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TransferExemptionUsage_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TransferExemptionUsage_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TransferExemptionUsage_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TransferExemptionUsage_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TransferExemptionUsage_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TransferExemptionUsage_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TransferExemptionUsage                 protoreflect.MessageDescriptor
+	fd_TransferExemptionUsage_exemption_id    protoreflect.FieldDescriptor
+	fd_TransferExemptionUsage_account_address protoreflect.FieldDescriptor
+	fd_TransferExemptionUsage_epoch           protoreflect.FieldDescriptor
+	fd_TransferExemptionUsage_used            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_restrictions_exemption_proto_init()
+	md_TransferExemptionUsage = File_inference_restrictions_exemption_proto.Messages().ByName("TransferExemptionUsage")
+	fd_TransferExemptionUsage_exemption_id = md_TransferExemptionUsage.Fields().ByName("exemption_id")
+	fd_TransferExemptionUsage_account_address = md_TransferExemptionUsage.Fields().ByName("account_address")
+	fd_TransferExemptionUsage_epoch = md_TransferExemptionUsage.Fields().ByName("epoch")
+	fd_TransferExemptionUsage_used = md_TransferExemptionUsage.Fields().ByName("used")
+}
+
+var _ protoreflect.Message = (*fastReflection_TransferExemptionUsage)(nil)
+
+type fastReflection_TransferExemptionUsage TransferExemptionUsage
+
+func (x *TransferExemptionUsage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TransferExemptionUsage)(x)
+}
+
+func (x *TransferExemptionUsage) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_restrictions_exemption_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TransferExemptionUsage_messageType fastReflection_TransferExemptionUsage_messageType
+var _ protoreflect.MessageType = fastReflection_TransferExemptionUsage_messageType{}
+
+type fastReflection_TransferExemptionUsage_messageType struct{}
+
+func (x fastReflection_TransferExemptionUsage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TransferExemptionUsage)(nil)
+}
+func (x fastReflection_TransferExemptionUsage_messageType) New() protoreflect.Message {
+	return new(fastReflection_TransferExemptionUsage)
+}
+func (x fastReflection_TransferExemptionUsage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransferExemptionUsage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TransferExemptionUsage) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransferExemptionUsage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TransferExemptionUsage) Type() protoreflect.MessageType {
+	return _fastReflection_TransferExemptionUsage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TransferExemptionUsage) New() protoreflect.Message {
+	return new(fastReflection_TransferExemptionUsage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TransferExemptionUsage) Interface() protoreflect.ProtoMessage {
+	return (*TransferExemptionUsage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TransferExemptionUsage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExemptionId != "" {
+		value := protoreflect.ValueOfString(x.ExemptionId)
+		if !f(fd_TransferExemptionUsage_exemption_id, value) {
+			return
+		}
+	}
+	if x.AccountAddress != "" {
+		value := protoreflect.ValueOfString(x.AccountAddress)
+		if !f(fd_TransferExemptionUsage_account_address, value) {
+			return
+		}
+	}
+	if x.Epoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Epoch)
+		if !f(fd_TransferExemptionUsage_epoch, value) {
+			return
+		}
+	}
+	if len(x.Used) != 0 {
+		value := protoreflect.ValueOfList(&_TransferExemptionUsage_4_list{list: &x.Used})
+		if !f(fd_TransferExemptionUsage_used, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TransferExemptionUsage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.restrictions.TransferExemptionUsage.exemption_id":
+		return x.ExemptionId != ""
+	case "inference.restrictions.TransferExemptionUsage.account_address":
+		return x.AccountAddress != ""
+	case "inference.restrictions.TransferExemptionUsage.epoch":
+		return x.Epoch != uint64(0)
+	case "inference.restrictions.TransferExemptionUsage.used":
+		return len(x.Used) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.TransferExemptionUsage"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.TransferExemptionUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferExemptionUsage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.restrictions.TransferExemptionUsage.exemption_id":
+		x.ExemptionId = ""
+	case "inference.restrictions.TransferExemptionUsage.account_address":
+		x.AccountAddress = ""
+	case "inference.restrictions.TransferExemptionUsage.epoch":
+		x.Epoch = uint64(0)
+	case "inference.restrictions.TransferExemptionUsage.used":
+		x.Used = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.TransferExemptionUsage"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.TransferExemptionUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TransferExemptionUsage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.restrictions.TransferExemptionUsage.exemption_id":
+		value := x.ExemptionId
+		return protoreflect.ValueOfString(value)
+	case "inference.restrictions.TransferExemptionUsage.account_address":
+		value := x.AccountAddress
+		return protoreflect.ValueOfString(value)
+	case "inference.restrictions.TransferExemptionUsage.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfUint64(value)
+	case "inference.restrictions.TransferExemptionUsage.used":
+		if len(x.Used) == 0 {
+			return protoreflect.ValueOfList(&_TransferExemptionUsage_4_list{})
+		}
+		listValue := &_TransferExemptionUsage_4_list{list: &x.Used}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.TransferExemptionUsage"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.TransferExemptionUsage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferExemptionUsage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.restrictions.TransferExemptionUsage.exemption_id":
+		x.ExemptionId = value.Interface().(string)
+	case "inference.restrictions.TransferExemptionUsage.account_address":
+		x.AccountAddress = value.Interface().(string)
+	case "inference.restrictions.TransferExemptionUsage.epoch":
+		x.Epoch = value.Uint()
+	case "inference.restrictions.TransferExemptionUsage.used":
+		lv := value.List()
+		clv := lv.(*_TransferExemptionUsage_4_list)
+		x.Used = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.TransferExemptionUsage"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.TransferExemptionUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferExemptionUsage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.restrictions.TransferExemptionUsage.used":
+		if x.Used == nil {
+			x.Used = []*v1beta1.Coin{}
+		}
+		value := &_TransferExemptionUsage_4_list{list: &x.Used}
+		return protoreflect.ValueOfList(value)
+	case "inference.restrictions.TransferExemptionUsage.exemption_id":
+		panic(fmt.Errorf("field exemption_id of message inference.restrictions.TransferExemptionUsage is not mutable"))
+	case "inference.restrictions.TransferExemptionUsage.account_address":
+		panic(fmt.Errorf("field account_address of message inference.restrictions.TransferExemptionUsage is not mutable"))
+	case "inference.restrictions.TransferExemptionUsage.epoch":
+		panic(fmt.Errorf("field epoch of message inference.restrictions.TransferExemptionUsage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.TransferExemptionUsage"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.TransferExemptionUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TransferExemptionUsage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.restrictions.TransferExemptionUsage.exemption_id":
+		return protoreflect.ValueOfString("")
+	case "inference.restrictions.TransferExemptionUsage.account_address":
+		return protoreflect.ValueOfString("")
+	case "inference.restrictions.TransferExemptionUsage.epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.restrictions.TransferExemptionUsage.used":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_TransferExemptionUsage_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.TransferExemptionUsage"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.TransferExemptionUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TransferExemptionUsage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.restrictions.TransferExemptionUsage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TransferExemptionUsage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferExemptionUsage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TransferExemptionUsage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TransferExemptionUsage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TransferExemptionUsage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ExemptionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AccountAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
+		}
+		if len(x.Used) > 0 {
+			for _, e := range x.Used {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TransferExemptionUsage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Used) > 0 {
+			for iNdEx := len(x.Used) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Used[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.AccountAddress) > 0 {
+			i -= len(x.AccountAddress)
+			copy(dAtA[i:], x.AccountAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccountAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ExemptionId) > 0 {
+			i -= len(x.ExemptionId)
+			copy(dAtA[i:], x.ExemptionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExemptionId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TransferExemptionUsage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransferExemptionUsage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransferExemptionUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExemptionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExemptionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccountAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				x.Epoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Epoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Used = append(x.Used, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Used[len(x.Used)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ExemptionAllowance_3_list)(nil)
+
+type _ExemptionAllowance_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ExemptionAllowance_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ExemptionAllowance_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ExemptionAllowance_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ExemptionAllowance_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ExemptionAllowance_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ExemptionAllowance_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ExemptionAllowance_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ExemptionAllowance_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ExemptionAllowance                  protoreflect.MessageDescriptor
+	fd_ExemptionAllowance_exemption_id     protoreflect.FieldDescriptor
+	fd_ExemptionAllowance_epoch            protoreflect.FieldDescriptor
+	fd_ExemptionAllowance_remaining        protoreflect.FieldDescriptor
+	fd_ExemptionAllowance_epoch_end_height protoreflect.FieldDescriptor
+	fd_ExemptionAllowance_expiry_height    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_restrictions_exemption_proto_init()
+	md_ExemptionAllowance = File_inference_restrictions_exemption_proto.Messages().ByName("ExemptionAllowance")
+	fd_ExemptionAllowance_exemption_id = md_ExemptionAllowance.Fields().ByName("exemption_id")
+	fd_ExemptionAllowance_epoch = md_ExemptionAllowance.Fields().ByName("epoch")
+	fd_ExemptionAllowance_remaining = md_ExemptionAllowance.Fields().ByName("remaining")
+	fd_ExemptionAllowance_epoch_end_height = md_ExemptionAllowance.Fields().ByName("epoch_end_height")
+	fd_ExemptionAllowance_expiry_height = md_ExemptionAllowance.Fields().ByName("expiry_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_ExemptionAllowance)(nil)
+
+type fastReflection_ExemptionAllowance ExemptionAllowance
+
+func (x *ExemptionAllowance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExemptionAllowance)(x)
+}
+
+func (x *ExemptionAllowance) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_restrictions_exemption_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ExemptionAllowance_messageType fastReflection_ExemptionAllowance_messageType
+var _ protoreflect.MessageType = fastReflection_ExemptionAllowance_messageType{}
+
+type fastReflection_ExemptionAllowance_messageType struct{}
+
+func (x fastReflection_ExemptionAllowance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExemptionAllowance)(nil)
+}
+func (x fastReflection_ExemptionAllowance_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExemptionAllowance)
+}
+func (x fastReflection_ExemptionAllowance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExemptionAllowance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ExemptionAllowance) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExemptionAllowance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ExemptionAllowance) Type() protoreflect.MessageType {
+	return _fastReflection_ExemptionAllowance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ExemptionAllowance) New() protoreflect.Message {
+	return new(fastReflection_ExemptionAllowance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ExemptionAllowance) Interface() protoreflect.ProtoMessage {
+	return (*ExemptionAllowance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ExemptionAllowance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExemptionId != "" {
+		value := protoreflect.ValueOfString(x.ExemptionId)
+		if !f(fd_ExemptionAllowance_exemption_id, value) {
+			return
+		}
+	}
+	if x.Epoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Epoch)
+		if !f(fd_ExemptionAllowance_epoch, value) {
+			return
+		}
+	}
+	if len(x.Remaining) != 0 {
+		value := protoreflect.ValueOfList(&_ExemptionAllowance_3_list{list: &x.Remaining})
+		if !f(fd_ExemptionAllowance_remaining, value) {
+			return
+		}
+	}
+	if x.EpochEndHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochEndHeight)
+		if !f(fd_ExemptionAllowance_epoch_end_height, value) {
+			return
+		}
+	}
+	if x.ExpiryHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExpiryHeight)
+		if !f(fd_ExemptionAllowance_expiry_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ExemptionAllowance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.restrictions.ExemptionAllowance.exemption_id":
+		return x.ExemptionId != ""
+	case "inference.restrictions.ExemptionAllowance.epoch":
+		return x.Epoch != uint64(0)
+	case "inference.restrictions.ExemptionAllowance.remaining":
+		return len(x.Remaining) != 0
+	case "inference.restrictions.ExemptionAllowance.epoch_end_height":
+		return x.EpochEndHeight != uint64(0)
+	case "inference.restrictions.ExemptionAllowance.expiry_height":
+		return x.ExpiryHeight != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.ExemptionAllowance"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.ExemptionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExemptionAllowance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.restrictions.ExemptionAllowance.exemption_id":
+		x.ExemptionId = ""
+	case "inference.restrictions.ExemptionAllowance.epoch":
+		x.Epoch = uint64(0)
+	case "inference.restrictions.ExemptionAllowance.remaining":
+		x.Remaining = nil
+	case "inference.restrictions.ExemptionAllowance.epoch_end_height":
+		x.EpochEndHeight = uint64(0)
+	case "inference.restrictions.ExemptionAllowance.expiry_height":
+		x.ExpiryHeight = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.ExemptionAllowance"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.ExemptionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExemptionAllowance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.restrictions.ExemptionAllowance.exemption_id":
+		value := x.ExemptionId
+		return protoreflect.ValueOfString(value)
+	case "inference.restrictions.ExemptionAllowance.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfUint64(value)
+	case "inference.restrictions.ExemptionAllowance.remaining":
+		if len(x.Remaining) == 0 {
+			return protoreflect.ValueOfList(&_ExemptionAllowance_3_list{})
+		}
+		listValue := &_ExemptionAllowance_3_list{list: &x.Remaining}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.restrictions.ExemptionAllowance.epoch_end_height":
+		value := x.EpochEndHeight
+		return protoreflect.ValueOfUint64(value)
+	case "inference.restrictions.ExemptionAllowance.expiry_height":
+		value := x.ExpiryHeight
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.ExemptionAllowance"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.ExemptionAllowance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExemptionAllowance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.restrictions.ExemptionAllowance.exemption_id":
+		x.ExemptionId = value.Interface().(string)
+	case "inference.restrictions.ExemptionAllowance.epoch":
+		x.Epoch = value.Uint()
+	case "inference.restrictions.ExemptionAllowance.remaining":
+		lv := value.List()
+		clv := lv.(*_ExemptionAllowance_3_list)
+		x.Remaining = *clv.list
+	case "inference.restrictions.ExemptionAllowance.epoch_end_height":
+		x.EpochEndHeight = value.Uint()
+	case "inference.restrictions.ExemptionAllowance.expiry_height":
+		x.ExpiryHeight = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.ExemptionAllowance"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.ExemptionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExemptionAllowance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.restrictions.ExemptionAllowance.remaining":
+		if x.Remaining == nil {
+			x.Remaining = []*v1beta1.Coin{}
+		}
+		value := &_ExemptionAllowance_3_list{list: &x.Remaining}
+		return protoreflect.ValueOfList(value)
+	case "inference.restrictions.ExemptionAllowance.exemption_id":
+		panic(fmt.Errorf("field exemption_id of message inference.restrictions.ExemptionAllowance is not mutable"))
+	case "inference.restrictions.ExemptionAllowance.epoch":
+		panic(fmt.Errorf("field epoch of message inference.restrictions.ExemptionAllowance is not mutable"))
+	case "inference.restrictions.ExemptionAllowance.epoch_end_height":
+		panic(fmt.Errorf("field epoch_end_height of message inference.restrictions.ExemptionAllowance is not mutable"))
+	case "inference.restrictions.ExemptionAllowance.expiry_height":
+		panic(fmt.Errorf("field expiry_height of message inference.restrictions.ExemptionAllowance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.ExemptionAllowance"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.ExemptionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExemptionAllowance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.restrictions.ExemptionAllowance.exemption_id":
+		return protoreflect.ValueOfString("")
+	case "inference.restrictions.ExemptionAllowance.epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.restrictions.ExemptionAllowance.remaining":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ExemptionAllowance_3_list{list: &list})
+	case "inference.restrictions.ExemptionAllowance.epoch_end_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.restrictions.ExemptionAllowance.expiry_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.restrictions.ExemptionAllowance"))
+		}
+		panic(fmt.Errorf("message inference.restrictions.ExemptionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExemptionAllowance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.restrictions.ExemptionAllowance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExemptionAllowance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExemptionAllowance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExemptionAllowance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExemptionAllowance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExemptionAllowance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ExemptionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
+		}
+		if len(x.Remaining) > 0 {
+			for _, e := range x.Remaining {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.EpochEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochEndHeight))
+		}
+		if x.ExpiryHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExemptionAllowance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExpiryHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.EpochEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochEndHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Remaining) > 0 {
+			for iNdEx := len(x.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Remaining[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ExemptionId) > 0 {
+			i -= len(x.ExemptionId)
+			copy(dAtA[i:], x.ExemptionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExemptionId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ExemptionAllowance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExemptionAllowance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExemptionAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExemptionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExemptionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				x.Epoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Epoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Remaining = append(x.Remaining, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Remaining[len(x.Remaining)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochEndHeight", wireType)
+				}
+				x.EpochEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochEndHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+				}
+				x.ExpiryHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: inference/restrictions/exemption.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TransferExemption lets the listed senders transfer to the listed destinations during the restriction period,
+// up to an amount per epoch of epoch_length_blocks blocks
+type TransferExemption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExemptionId string `protobuf:"bytes,1,opt,name=exemption_id,json=exemptionId,proto3" json:"exemption_id,omitempty"`
+	// from_addresses are the senders the exemption applies to, "*" for any
+	FromAddresses []string `protobuf:"bytes,2,rep,name=from_addresses,json=fromAddresses,proto3" json:"from_addresses,omitempty"`
+	// to_addresses are the destinations the exemption applies to, "*" for any
+	ToAddresses []string `protobuf:"bytes,3,rep,name=to_addresses,json=toAddresses,proto3" json:"to_addresses,omitempty"`
+	// amount_per_epoch is what each sender may transfer under the exemption in one epoch
+	AmountPerEpoch    []*v1beta1.Coin `protobuf:"bytes,4,rep,name=amount_per_epoch,json=amountPerEpoch,proto3" json:"amount_per_epoch,omitempty"`
+	EpochLengthBlocks uint64          `protobuf:"varint,5,opt,name=epoch_length_blocks,json=epochLengthBlocks,proto3" json:"epoch_length_blocks,omitempty"`
+	// expiry_height is the first block height the exemption no longer applies at
+	ExpiryHeight  uint64 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	Revoked       bool   `protobuf:"varint,7,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	RevokedHeight uint64 `protobuf:"varint,8,opt,name=revoked_height,json=revokedHeight,proto3" json:"revoked_height,omitempty"`
+	Justification string `protobuf:"bytes,9,opt,name=justification,proto3" json:"justification,omitempty"`
+}
+
+func (x *TransferExemption) Reset() {
+	*x = TransferExemption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_restrictions_exemption_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferExemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferExemption) ProtoMessage() {}
+
+// Deprecated: Use TransferExemption.ProtoReflect.Descriptor instead.
+func (*TransferExemption) Descriptor() ([]byte, []int) {
+	return file_inference_restrictions_exemption_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransferExemption) GetExemptionId() string {
+	if x != nil {
+		return x.ExemptionId
+	}
+	return ""
+}
+
+func (x *TransferExemption) GetFromAddresses() []string {
+	if x != nil {
+		return x.FromAddresses
+	}
+	return nil
+}
+
+func (x *TransferExemption) GetToAddresses() []string {
+	if x != nil {
+		return x.ToAddresses
+	}
+	return nil
+}
+
+func (x *TransferExemption) GetAmountPerEpoch() []*v1beta1.Coin {
+	if x != nil {
+		return x.AmountPerEpoch
+	}
+	return nil
+}
+
+func (x *TransferExemption) GetEpochLengthBlocks() uint64 {
+	if x != nil {
+		return x.EpochLengthBlocks
+	}
+	return 0
+}
+
+func (x *TransferExemption) GetExpiryHeight() uint64 {
+	if x != nil {
+		return x.ExpiryHeight
+	}
+	return 0
+}
+
+func (x *TransferExemption) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *TransferExemption) GetRevokedHeight() uint64 {
+	if x != nil {
+		return x.RevokedHeight
+	}
+	return 0
+}
+
+func (x *TransferExemption) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+// TransferExemptionUsage is what an account transferred under an exemption in its current epoch
+type TransferExemptionUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExemptionId    string          `protobuf:"bytes,1,opt,name=exemption_id,json=exemptionId,proto3" json:"exemption_id,omitempty"`
+	AccountAddress string          `protobuf:"bytes,2,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+	Epoch          uint64          `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Used           []*v1beta1.Coin `protobuf:"bytes,4,rep,name=used,proto3" json:"used,omitempty"`
+}
+
+func (x *TransferExemptionUsage) Reset() {
+	*x = TransferExemptionUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_restrictions_exemption_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferExemptionUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferExemptionUsage) ProtoMessage() {}
+
+// Deprecated: Use TransferExemptionUsage.ProtoReflect.Descriptor instead.
+func (*TransferExemptionUsage) Descriptor() ([]byte, []int) {
+	return file_inference_restrictions_exemption_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransferExemptionUsage) GetExemptionId() string {
+	if x != nil {
+		return x.ExemptionId
+	}
+	return ""
+}
+
+func (x *TransferExemptionUsage) GetAccountAddress() string {
+	if x != nil {
+		return x.AccountAddress
+	}
+	return ""
+}
+
+func (x *TransferExemptionUsage) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *TransferExemptionUsage) GetUsed() []*v1beta1.Coin {
+	if x != nil {
+		return x.Used
+	}
+	return nil
+}
+
+// ExemptionAllowance is what an account may still transfer under an exemption in the current epoch
+type ExemptionAllowance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExemptionId string          `protobuf:"bytes,1,opt,name=exemption_id,json=exemptionId,proto3" json:"exemption_id,omitempty"`
+	Epoch       uint64          `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Remaining   []*v1beta1.Coin `protobuf:"bytes,3,rep,name=remaining,proto3" json:"remaining,omitempty"`
+	// epoch_end_height is the height the allowance is restored at
+	EpochEndHeight uint64 `protobuf:"varint,4,opt,name=epoch_end_height,json=epochEndHeight,proto3" json:"epoch_end_height,omitempty"`
+	ExpiryHeight   uint64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (x *ExemptionAllowance) Reset() {
+	*x = ExemptionAllowance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_restrictions_exemption_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExemptionAllowance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExemptionAllowance) ProtoMessage() {}
+
+// Deprecated: Use ExemptionAllowance.ProtoReflect.Descriptor instead.
+func (*ExemptionAllowance) Descriptor() ([]byte, []int) {
+	return file_inference_restrictions_exemption_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExemptionAllowance) GetExemptionId() string {
+	if x != nil {
+		return x.ExemptionId
+	}
+	return ""
+}
+
+func (x *ExemptionAllowance) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ExemptionAllowance) GetRemaining() []*v1beta1.Coin {
+	if x != nil {
+		return x.Remaining
+	}
+	return nil
+}
+
+func (x *ExemptionAllowance) GetEpochEndHeight() uint64 {
+	if x != nil {
+		return x.EpochEndHeight
+	}
+	return 0
+}
+
+func (x *ExemptionAllowance) GetExpiryHeight() uint64 {
+	if x != nil {
+		return x.ExpiryHeight
+	}
+	return 0
+}
+
+var File_inference_restrictions_exemption_proto protoreflect.FileDescriptor
+
+var file_inference_restrictions_exemption_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x72, 0x65, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x03, 0x0a, 0x11, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x7a, 0x0a, 0x10,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x50, 0x65, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xe0, 0x01, 0x0a, 0x16, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x64, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x22, 0x8c, 0x02,
+	0x0a, 0x12, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x6e, 0x0a,
+	0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a,
+	0x10, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x45, 0x6e,
+	0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0xce, 0x01, 0x0a,
+	0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x72,
+	0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0e, 0x45, 0x78, 0x65,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0xa2, 0x02, 0x03, 0x49, 0x52, 0x58, 0xaa, 0x02, 0x16, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0xca, 0x02, 0x16, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x5c, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0xe2, 0x02,
+	0x22, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x52, 0x65, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a,
+	0x3a, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_inference_restrictions_exemption_proto_rawDescOnce sync.Once
+	file_inference_restrictions_exemption_proto_rawDescData = file_inference_restrictions_exemption_proto_rawDesc
+)
+
+func file_inference_restrictions_exemption_proto_rawDescGZIP() []byte {
+	file_inference_restrictions_exemption_proto_rawDescOnce.Do(func() {
+		file_inference_restrictions_exemption_proto_rawDescData = protoimpl.X.CompressGZIP(file_inference_restrictions_exemption_proto_rawDescData)
+	})
+	return file_inference_restrictions_exemption_proto_rawDescData
+}
+
+var file_inference_restrictions_exemption_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_inference_restrictions_exemption_proto_goTypes = []interface{}{
+	(*TransferExemption)(nil),      // 0: inference.restrictions.TransferExemption
+	(*TransferExemptionUsage)(nil), // 1: inference.restrictions.TransferExemptionUsage
+	(*ExemptionAllowance)(nil),     // 2: inference.restrictions.ExemptionAllowance
+	(*v1beta1.Coin)(nil),           // 3: cosmos.base.v1beta1.Coin
+}
+var file_inference_restrictions_exemption_proto_depIdxs = []int32{
+	3, // 0: inference.restrictions.TransferExemption.amount_per_epoch:type_name -> cosmos.base.v1beta1.Coin
+	3, // 1: inference.restrictions.TransferExemptionUsage.used:type_name -> cosmos.base.v1beta1.Coin
+	3, // 2: inference.restrictions.ExemptionAllowance.remaining:type_name -> cosmos.base.v1beta1.Coin
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_inference_restrictions_exemption_proto_init() }
+func file_inference_restrictions_exemption_proto_init() {
+	if File_inference_restrictions_exemption_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_inference_restrictions_exemption_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferExemption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_restrictions_exemption_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferExemptionUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_restrictions_exemption_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExemptionAllowance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_inference_restrictions_exemption_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_inference_restrictions_exemption_proto_goTypes,
+		DependencyIndexes: file_inference_restrictions_exemption_proto_depIdxs,
+		MessageInfos:      file_inference_restrictions_exemption_proto_msgTypes,
+	}.Build()
+	File_inference_restrictions_exemption_proto = out.File
+	file_inference_restrictions_exemption_proto_rawDesc = nil
+	file_inference_restrictions_exemption_proto_goTypes = nil
+	file_inference_restrictions_exemption_proto_depIdxs = nil
+}
@@ -21,6 +21,8 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Msg_UpdateParams_FullMethodName             = "/inference.restrictions.Msg/UpdateParams"
 	Msg_ExecuteEmergencyTransfer_FullMethodName = "/inference.restrictions.Msg/ExecuteEmergencyTransfer"
+	Msg_AddTransferExemption_FullMethodName     = "/inference.restrictions.Msg/AddTransferExemption"
+	Msg_RevokeTransferExemption_FullMethodName  = "/inference.restrictions.Msg/RevokeTransferExemption"
 )
 
 // MsgClient is the client API for Msg service.
@@ -32,6 +34,10 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// ExecuteEmergencyTransfer allows users to execute governance-approved emergency transfers
 	ExecuteEmergencyTransfer(ctx context.Context, in *MsgExecuteEmergencyTransfer, opts ...grpc.CallOption) (*MsgExecuteEmergencyTransferResponse, error)
+	// AddTransferExemption defines a (governance) operation for adding an address-scoped transfer exemption
+	AddTransferExemption(ctx context.Context, in *MsgAddTransferExemption, opts ...grpc.CallOption) (*MsgAddTransferExemptionResponse, error)
+	// RevokeTransferExemption defines a (governance) operation for revoking a transfer exemption
+	RevokeTransferExemption(ctx context.Context, in *MsgRevokeTransferExemption, opts ...grpc.CallOption) (*MsgRevokeTransferExemptionResponse, error)
 }
 
 type msgClient struct {
@@ -60,6 +66,24 @@ func (c *msgClient) ExecuteEmergencyTransfer(ctx context.Context, in *MsgExecute
 	return out, nil
 }
 
+func (c *msgClient) AddTransferExemption(ctx context.Context, in *MsgAddTransferExemption, opts ...grpc.CallOption) (*MsgAddTransferExemptionResponse, error) {
+	out := new(MsgAddTransferExemptionResponse)
+	err := c.cc.Invoke(ctx, Msg_AddTransferExemption_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeTransferExemption(ctx context.Context, in *MsgRevokeTransferExemption, opts ...grpc.CallOption) (*MsgRevokeTransferExemptionResponse, error) {
+	out := new(MsgRevokeTransferExemptionResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeTransferExemption_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -69,6 +93,10 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// ExecuteEmergencyTransfer allows users to execute governance-approved emergency transfers
 	ExecuteEmergencyTransfer(context.Context, *MsgExecuteEmergencyTransfer) (*MsgExecuteEmergencyTransferResponse, error)
+	// AddTransferExemption defines a (governance) operation for adding an address-scoped transfer exemption
+	AddTransferExemption(context.Context, *MsgAddTransferExemption) (*MsgAddTransferExemptionResponse, error)
+	// RevokeTransferExemption defines a (governance) operation for revoking a transfer exemption
+	RevokeTransferExemption(context.Context, *MsgRevokeTransferExemption) (*MsgRevokeTransferExemptionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -82,6 +110,12 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) ExecuteEmergencyTransfer(context.Context, *MsgExecuteEmergencyTransfer) (*MsgExecuteEmergencyTransferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteEmergencyTransfer not implemented")
 }
+func (UnimplementedMsgServer) AddTransferExemption(context.Context, *MsgAddTransferExemption) (*MsgAddTransferExemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTransferExemption not implemented")
+}
+func (UnimplementedMsgServer) RevokeTransferExemption(context.Context, *MsgRevokeTransferExemption) (*MsgRevokeTransferExemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeTransferExemption not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -131,6 +165,42 @@ func _Msg_ExecuteEmergencyTransfer_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddTransferExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddTransferExemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddTransferExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddTransferExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddTransferExemption(ctx, req.(*MsgAddTransferExemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeTransferExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeTransferExemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeTransferExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeTransferExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeTransferExemption(ctx, req.(*MsgRevokeTransferExemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -146,6 +216,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExecuteEmergencyTransfer",
 			Handler:    _Msg_ExecuteEmergencyTransfer_Handler,
 		},
+		{
+			MethodName: "AddTransferExemption",
+			Handler:    _Msg_AddTransferExemption_Handler,
+		},
+		{
+			MethodName: "RevokeTransferExemption",
+			Handler:    _Msg_RevokeTransferExemption_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/restrictions/tx.proto",
@@ -4,6 +4,7 @@ package streamvesting
 import (
 	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -15,14 +16,20 @@ import (
 )
 
 var (
-	md_Params                       protoreflect.MessageDescriptor
-	fd_Params_reward_vesting_period protoreflect.FieldDescriptor
+	md_Params                           protoreflect.MessageDescriptor
+	fd_Params_reward_vesting_period     protoreflect.FieldDescriptor
+	fd_Params_allow_schedule_transfers  protoreflect.FieldDescriptor
+	fd_Params_acceleration_penalty      protoreflect.FieldDescriptor
+	fd_Params_penalty_to_community_pool protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_inference_streamvesting_params_proto_init()
 	md_Params = File_inference_streamvesting_params_proto.Messages().ByName("Params")
 	fd_Params_reward_vesting_period = md_Params.Fields().ByName("reward_vesting_period")
+	fd_Params_allow_schedule_transfers = md_Params.Fields().ByName("allow_schedule_transfers")
+	fd_Params_acceleration_penalty = md_Params.Fields().ByName("acceleration_penalty")
+	fd_Params_penalty_to_community_pool = md_Params.Fields().ByName("penalty_to_community_pool")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -96,6 +103,24 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.AllowScheduleTransfers != false {
+		value := protoreflect.ValueOfBool(x.AllowScheduleTransfers)
+		if !f(fd_Params_allow_schedule_transfers, value) {
+			return
+		}
+	}
+	if x.AccelerationPenalty != "" {
+		value := protoreflect.ValueOfString(x.AccelerationPenalty)
+		if !f(fd_Params_acceleration_penalty, value) {
+			return
+		}
+	}
+	if x.PenaltyToCommunityPool != false {
+		value := protoreflect.ValueOfBool(x.PenaltyToCommunityPool)
+		if !f(fd_Params_penalty_to_community_pool, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -113,6 +138,12 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "inference.streamvesting.Params.reward_vesting_period":
 		return x.RewardVestingPeriod != uint64(0)
+	case "inference.streamvesting.Params.allow_schedule_transfers":
+		return x.AllowScheduleTransfers != false
+	case "inference.streamvesting.Params.acceleration_penalty":
+		return x.AccelerationPenalty != ""
+	case "inference.streamvesting.Params.penalty_to_community_pool":
+		return x.PenaltyToCommunityPool != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.Params"))
@@ -131,6 +162,12 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "inference.streamvesting.Params.reward_vesting_period":
 		x.RewardVestingPeriod = uint64(0)
+	case "inference.streamvesting.Params.allow_schedule_transfers":
+		x.AllowScheduleTransfers = false
+	case "inference.streamvesting.Params.acceleration_penalty":
+		x.AccelerationPenalty = ""
+	case "inference.streamvesting.Params.penalty_to_community_pool":
+		x.PenaltyToCommunityPool = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.Params"))
@@ -150,6 +187,15 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "inference.streamvesting.Params.reward_vesting_period":
 		value := x.RewardVestingPeriod
 		return protoreflect.ValueOfUint64(value)
+	case "inference.streamvesting.Params.allow_schedule_transfers":
+		value := x.AllowScheduleTransfers
+		return protoreflect.ValueOfBool(value)
+	case "inference.streamvesting.Params.acceleration_penalty":
+		value := x.AccelerationPenalty
+		return protoreflect.ValueOfString(value)
+	case "inference.streamvesting.Params.penalty_to_community_pool":
+		value := x.PenaltyToCommunityPool
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.Params"))
@@ -172,6 +218,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 	switch fd.FullName() {
 	case "inference.streamvesting.Params.reward_vesting_period":
 		x.RewardVestingPeriod = value.Uint()
+	case "inference.streamvesting.Params.allow_schedule_transfers":
+		x.AllowScheduleTransfers = value.Bool()
+	case "inference.streamvesting.Params.acceleration_penalty":
+		x.AccelerationPenalty = value.Interface().(string)
+	case "inference.streamvesting.Params.penalty_to_community_pool":
+		x.PenaltyToCommunityPool = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.Params"))
@@ -194,6 +246,12 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 	switch fd.FullName() {
 	case "inference.streamvesting.Params.reward_vesting_period":
 		panic(fmt.Errorf("field reward_vesting_period of message inference.streamvesting.Params is not mutable"))
+	case "inference.streamvesting.Params.allow_schedule_transfers":
+		panic(fmt.Errorf("field allow_schedule_transfers of message inference.streamvesting.Params is not mutable"))
+	case "inference.streamvesting.Params.acceleration_penalty":
+		panic(fmt.Errorf("field acceleration_penalty of message inference.streamvesting.Params is not mutable"))
+	case "inference.streamvesting.Params.penalty_to_community_pool":
+		panic(fmt.Errorf("field penalty_to_community_pool of message inference.streamvesting.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.Params"))
@@ -209,6 +267,12 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	switch fd.FullName() {
 	case "inference.streamvesting.Params.reward_vesting_period":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.streamvesting.Params.allow_schedule_transfers":
+		return protoreflect.ValueOfBool(false)
+	case "inference.streamvesting.Params.acceleration_penalty":
+		return protoreflect.ValueOfString("")
+	case "inference.streamvesting.Params.penalty_to_community_pool":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.streamvesting.Params"))
@@ -281,6 +345,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.RewardVestingPeriod != 0 {
 			n += 1 + runtime.Sov(uint64(x.RewardVestingPeriod))
 		}
+		if x.AllowScheduleTransfers {
+			n += 2
+		}
+		l = len(x.AccelerationPenalty)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PenaltyToCommunityPool {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -310,6 +384,33 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.PenaltyToCommunityPool {
+			i--
+			if x.PenaltyToCommunityPool {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.AccelerationPenalty) > 0 {
+			i -= len(x.AccelerationPenalty)
+			copy(dAtA[i:], x.AccelerationPenalty)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccelerationPenalty)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.AllowScheduleTransfers {
+			i--
+			if x.AllowScheduleTransfers {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
 		if x.RewardVestingPeriod != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.RewardVestingPeriod))
 			i--
@@ -383,6 +484,78 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowScheduleTransfers", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AllowScheduleTransfers = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccelerationPenalty", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccelerationPenalty = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PenaltyToCommunityPool", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.PenaltyToCommunityPool = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -440,6 +613,12 @@ type Params struct {
 	// reward_vesting_period defines how many epochs rewards vest for
 	// Default is 180 epochs, but can be overridden to 2 in tests
 	RewardVestingPeriod uint64 `protobuf:"varint,1,opt,name=reward_vesting_period,json=rewardVestingPeriod,proto3" json:"reward_vesting_period,omitempty"`
+	// allow_schedule_transfers lets participants move their vesting schedule to another address
+	AllowScheduleTransfers bool `protobuf:"varint,2,opt,name=allow_schedule_transfers,json=allowScheduleTransfers,proto3" json:"allow_schedule_transfers,omitempty"`
+	// acceleration_penalty is the fraction of an accelerated unlock that the participant gives up
+	AccelerationPenalty string `protobuf:"bytes,3,opt,name=acceleration_penalty,json=accelerationPenalty,proto3" json:"acceleration_penalty,omitempty"`
+	// penalty_to_community_pool sends acceleration penalties to the community pool instead of burning them
+	PenaltyToCommunityPool bool `protobuf:"varint,4,opt,name=penalty_to_community_pool,json=penaltyToCommunityPool,proto3" json:"penalty_to_community_pool,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -469,6 +648,27 @@ func (x *Params) GetRewardVestingPeriod() uint64 {
 	return 0
 }
 
+func (x *Params) GetAllowScheduleTransfers() bool {
+	if x != nil {
+		return x.AllowScheduleTransfers
+	}
+	return false
+}
+
+func (x *Params) GetAccelerationPenalty() string {
+	if x != nil {
+		return x.AccelerationPenalty
+	}
+	return ""
+}
+
+func (x *Params) GetPenaltyToCommunityPool() bool {
+	if x != nil {
+		return x.PenaltyToCommunityPool
+	}
+	return false
+}
+
 var File_inference_streamvesting_params_proto protoreflect.FileDescriptor
 
 var file_inference_streamvesting_params_proto_rawDesc = []byte{
@@ -477,28 +677,44 @@ var file_inference_streamvesting_params_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
 	0x65, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x1a,
 	0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x67, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x76, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x13, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
-	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x3a, 0x29, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a,
-	0x20, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x78, 0x2f, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x42, 0xd1, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0xa2, 0x02, 0x03, 0x49, 0x53, 0x58,
-	0xaa, 0x02, 0x17, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0xca, 0x02, 0x17, 0x49, 0x6e, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0xe2, 0x02, 0x23, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x49, 0x6e, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67,
+	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xc7, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x69, 0x0a, 0x14,
+	0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x13, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f,
+	0x6f, 0x6c, 0x3a, 0x29, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x78, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xd1, 0x01,
+	0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x0b, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0xa2, 0x02, 0x03, 0x49, 0x53, 0x58, 0xaa, 0x02, 0x17, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0xca, 0x02, 0x17, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0xe2, 0x02, 0x23, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x3a, 0x3a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
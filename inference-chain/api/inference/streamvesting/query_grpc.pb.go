@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName             = "/inference.streamvesting.Query/Params"
-	Query_VestingSchedule_FullMethodName    = "/inference.streamvesting.Query/VestingSchedule"
-	Query_TotalVestingAmount_FullMethodName = "/inference.streamvesting.Query/TotalVestingAmount"
+	Query_Params_FullMethodName               = "/inference.streamvesting.Query/Params"
+	Query_VestingSchedule_FullMethodName      = "/inference.streamvesting.Query/VestingSchedule"
+	Query_TotalVestingAmount_FullMethodName   = "/inference.streamvesting.Query/TotalVestingAmount"
+	Query_ProjectedUnlocks_FullMethodName     = "/inference.streamvesting.Query/ProjectedUnlocks"
+	Query_UnlockHistory_FullMethodName        = "/inference.streamvesting.Query/UnlockHistory"
+	Query_SimulateAcceleration_FullMethodName = "/inference.streamvesting.Query/SimulateAcceleration"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +37,12 @@ type QueryClient interface {
 	VestingSchedule(ctx context.Context, in *QueryVestingScheduleRequest, opts ...grpc.CallOption) (*QueryVestingScheduleResponse, error)
 	// TotalVestingAmount queries the total vesting amount for a participant.
 	TotalVestingAmount(ctx context.Context, in *QueryTotalVestingAmountRequest, opts ...grpc.CallOption) (*QueryTotalVestingAmountResponse, error)
+	// ProjectedUnlocks returns the upcoming unlocks of a participant, or of all participants if none is given.
+	ProjectedUnlocks(ctx context.Context, in *QueryProjectedUnlocksRequest, opts ...grpc.CallOption) (*QueryProjectedUnlocksResponse, error)
+	// UnlockHistory returns the unlocks of past epochs, oldest first.
+	UnlockHistory(ctx context.Context, in *QueryUnlockHistoryRequest, opts ...grpc.CallOption) (*QueryUnlockHistoryResponse, error)
+	// SimulateAcceleration shows what accelerating the next epochs of a participant's schedule would pay and cost.
+	SimulateAcceleration(ctx context.Context, in *QuerySimulateAccelerationRequest, opts ...grpc.CallOption) (*QuerySimulateAccelerationResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +80,33 @@ func (c *queryClient) TotalVestingAmount(ctx context.Context, in *QueryTotalVest
 	return out, nil
 }
 
+func (c *queryClient) ProjectedUnlocks(ctx context.Context, in *QueryProjectedUnlocksRequest, opts ...grpc.CallOption) (*QueryProjectedUnlocksResponse, error) {
+	out := new(QueryProjectedUnlocksResponse)
+	err := c.cc.Invoke(ctx, Query_ProjectedUnlocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnlockHistory(ctx context.Context, in *QueryUnlockHistoryRequest, opts ...grpc.CallOption) (*QueryUnlockHistoryResponse, error) {
+	out := new(QueryUnlockHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_UnlockHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateAcceleration(ctx context.Context, in *QuerySimulateAccelerationRequest, opts ...grpc.CallOption) (*QuerySimulateAccelerationResponse, error) {
+	out := new(QuerySimulateAccelerationResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateAcceleration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +117,12 @@ type QueryServer interface {
 	VestingSchedule(context.Context, *QueryVestingScheduleRequest) (*QueryVestingScheduleResponse, error)
 	// TotalVestingAmount queries the total vesting amount for a participant.
 	TotalVestingAmount(context.Context, *QueryTotalVestingAmountRequest) (*QueryTotalVestingAmountResponse, error)
+	// ProjectedUnlocks returns the upcoming unlocks of a participant, or of all participants if none is given.
+	ProjectedUnlocks(context.Context, *QueryProjectedUnlocksRequest) (*QueryProjectedUnlocksResponse, error)
+	// UnlockHistory returns the unlocks of past epochs, oldest first.
+	UnlockHistory(context.Context, *QueryUnlockHistoryRequest) (*QueryUnlockHistoryResponse, error)
+	// SimulateAcceleration shows what accelerating the next epochs of a participant's schedule would pay and cost.
+	SimulateAcceleration(context.Context, *QuerySimulateAccelerationRequest) (*QuerySimulateAccelerationResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +139,15 @@ func (UnimplementedQueryServer) VestingSchedule(context.Context, *QueryVestingSc
 func (UnimplementedQueryServer) TotalVestingAmount(context.Context, *QueryTotalVestingAmountRequest) (*QueryTotalVestingAmountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalVestingAmount not implemented")
 }
+func (UnimplementedQueryServer) ProjectedUnlocks(context.Context, *QueryProjectedUnlocksRequest) (*QueryProjectedUnlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedUnlocks not implemented")
+}
+func (UnimplementedQueryServer) UnlockHistory(context.Context, *QueryUnlockHistoryRequest) (*QueryUnlockHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockHistory not implemented")
+}
+func (UnimplementedQueryServer) SimulateAcceleration(context.Context, *QuerySimulateAccelerationRequest) (*QuerySimulateAccelerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateAcceleration not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +215,60 @@ func _Query_TotalVestingAmount_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectedUnlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedUnlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedUnlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProjectedUnlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedUnlocks(ctx, req.(*QueryProjectedUnlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnlockHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnlockHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnlockHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnlockHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnlockHistory(ctx, req.(*QueryUnlockHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateAcceleration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateAccelerationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateAcceleration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateAcceleration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateAcceleration(ctx, req.(*QuerySimulateAccelerationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +288,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TotalVestingAmount",
 			Handler:    _Query_TotalVestingAmount_Handler,
 		},
+		{
+			MethodName: "ProjectedUnlocks",
+			Handler:    _Query_ProjectedUnlocks_Handler,
+		},
+		{
+			MethodName: "UnlockHistory",
+			Handler:    _Query_UnlockHistory_Handler,
+		},
+		{
+			MethodName: "SimulateAcceleration",
+			Handler:    _Query_SimulateAcceleration_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/streamvesting/query.proto",
@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/inference.streamvesting.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName            = "/inference.streamvesting.Msg/UpdateParams"
+	Msg_TransferVestingSchedule_FullMethodName = "/inference.streamvesting.Msg/TransferVestingSchedule"
+	Msg_AccelerateVesting_FullMethodName       = "/inference.streamvesting.Msg/AccelerateVesting"
 )
 
 // MsgClient is the client API for Msg service.
@@ -29,6 +31,8 @@ type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	TransferVestingSchedule(ctx context.Context, in *MsgTransferVestingSchedule, opts ...grpc.CallOption) (*MsgTransferVestingScheduleResponse, error)
+	AccelerateVesting(ctx context.Context, in *MsgAccelerateVesting, opts ...grpc.CallOption) (*MsgAccelerateVestingResponse, error)
 }
 
 type msgClient struct {
@@ -48,6 +52,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) TransferVestingSchedule(ctx context.Context, in *MsgTransferVestingSchedule, opts ...grpc.CallOption) (*MsgTransferVestingScheduleResponse, error) {
+	out := new(MsgTransferVestingScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferVestingSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AccelerateVesting(ctx context.Context, in *MsgAccelerateVesting, opts ...grpc.CallOption) (*MsgAccelerateVestingResponse, error) {
+	out := new(MsgAccelerateVestingResponse)
+	err := c.cc.Invoke(ctx, Msg_AccelerateVesting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -55,6 +77,8 @@ type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	TransferVestingSchedule(context.Context, *MsgTransferVestingSchedule) (*MsgTransferVestingScheduleResponse, error)
+	AccelerateVesting(context.Context, *MsgAccelerateVesting) (*MsgAccelerateVestingResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -65,6 +89,12 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) TransferVestingSchedule(context.Context, *MsgTransferVestingSchedule) (*MsgTransferVestingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferVestingSchedule not implemented")
+}
+func (UnimplementedMsgServer) AccelerateVesting(context.Context, *MsgAccelerateVesting) (*MsgAccelerateVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccelerateVesting not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -96,6 +126,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferVestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferVestingSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferVestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferVestingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferVestingSchedule(ctx, req.(*MsgTransferVestingSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AccelerateVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAccelerateVesting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AccelerateVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AccelerateVesting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AccelerateVesting(ctx, req.(*MsgAccelerateVesting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,6 +173,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "TransferVestingSchedule",
+			Handler:    _Msg_TransferVestingSchedule_Handler,
+		},
+		{
+			MethodName: "AccelerateVesting",
+			Handler:    _Msg_AccelerateVesting_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/streamvesting/tx.proto",
@@ -308,6 +308,11 @@ func New(
 	}
 
 	app.ModuleManager.RegisterInvariants(app.CrisisKeeper)
+	// The module manager no longer registers module invariants, so the custom modules register theirs here
+	inferencemodulekeeper.RegisterInvariants(app.CrisisKeeper, app.InferenceKeeper)
+	collateralmodulekeeper.RegisterInvariants(app.CrisisKeeper, app.CollateralKeeper)
+	streamvestingmodulekeeper.RegisterInvariants(app.CrisisKeeper, app.StreamvestingKeeper)
+	bookkeepermodulekeeper.RegisterInvariants(app.CrisisKeeper, app.BookkeeperKeeper)
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
@@ -208,7 +208,7 @@ var (
 		{Account: inferencemoduletypes.PreProgrammedSaleAccName, Permissions: []string{authtypes.Minter}},
 		{Account: wasmtypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: collateralmoduletypes.ModuleName, Permissions: []string{authtypes.Burner}},
-		{Account: streamvestingmoduletypes.ModuleName, Permissions: []string{authtypes.Minter}},
+		{Account: streamvestingmoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: genesistransfermoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
@@ -221,6 +221,11 @@ var (
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
 		nft.ModuleName,
+		// The module-escrow, module-collateral and module-vesting invariants count on these accounts
+		// receiving coins only from their own modules
+		inferencemoduletypes.ModuleName,
+		collateralmoduletypes.ModuleName,
+		streamvestingmoduletypes.ModuleName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 	}
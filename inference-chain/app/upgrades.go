@@ -15,7 +15,9 @@ import (
 	v0_2_2 "github.com/productscience/inference/app/upgrades/v0_2_2"
 	v0_2_3 "github.com/productscience/inference/app/upgrades/v0_2_3"
 	"github.com/productscience/inference/app/upgrades/v0_2_4"
+	bookkeepertypes "github.com/productscience/inference/x/bookkeeper/types"
 	inferencetypes "github.com/productscience/inference/x/inference/types"
+	streamvestingtypes "github.com/productscience/inference/x/streamvesting/types"
 )
 
 func CreateEmptyUpgradeHandler(
@@ -64,6 +66,21 @@ func (app *App) registerMigrations() {
 		return nil
 	})
 
+	// Version 8 adds the secondary indexes of inferences, which have to be built for the stored ones
+	app.Configurator().RegisterMigration(inferencetypes.ModuleName, 7, func(ctx sdk.Context) error {
+		return app.InferenceKeeper.ReindexInferences(ctx)
+	})
+
+	// Version 2 adds the acceleration penalty, which params stored before it do not have
+	app.Configurator().RegisterMigration(streamvestingtypes.ModuleName, 1, func(ctx sdk.Context) error {
+		return app.StreamvestingKeeper.SetDefaultAccelerationPenalty(ctx)
+	})
+
+	// Version 2 adds the ledger retention, without which params stored before it leave the ledger disabled
+	app.Configurator().RegisterMigration(bookkeepertypes.ModuleName, 1, func(ctx sdk.Context) error {
+		return app.BookkeeperKeeper.SetDefaultLedgerRetention(ctx)
+	})
+
 	app.Configurator().RegisterMigration(districutiontypes.ModuleName, 3, func(ctx sdk.Context) error {
 		return nil
 	})
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/rootmulti"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/spf13/cobra"
+
+	"github.com/productscience/inference/x/inference/archive"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	flagIncludeLatest = "include-latest"
+	flagArchiveNode   = "node"
+)
+
+// ArchiveCommand returns the commands that turn the records a node archived while pruning into verifiable bundles
+func ArchiveCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive",
+		Short: "Bundle and verify inference and PoC records removed by state pruning",
+		Long: fmt.Sprintf(`Nodes with %s set in app.toml write every inference, PoC batch and PoC validation to that
+directory before pruning deletes it. These commands collect those records into compressed, hash-chained bundles,
+one per pruning epoch, and verify them.`, archive.FlagArchiveDir),
+	}
+	cmd.AddCommand(archiveBundleCommand(), archiveVerifyCommand())
+	return cmd
+}
+
+func archiveBundleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle [archive-dir] [bundle-dir]",
+		Short: "Bundle the archived records of every pruning epoch not bundled yet",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			includeLatest, err := cmd.Flags().GetBool(flagIncludeLatest)
+			if err != nil {
+				return err
+			}
+			bundles, err := archive.BundleSink(args[0], args[1], includeLatest)
+			if err != nil {
+				return err
+			}
+			for _, bundle := range bundles {
+				cmd.Printf("Bundled pruning epoch %d: %d records, hash %X\n", bundle.Header.PruningEpoch, bundle.Header.RecordCount, bundle.Hash)
+			}
+			if len(bundles) == 0 {
+				cmd.Println("Nothing to bundle")
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Bool(flagIncludeLatest, false, "Also bundle the latest pruning epoch, which the node may still be adding records to")
+	return cmd
+}
+
+func archiveVerifyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [bundle-dir]",
+		Short: "Verify the bundle chain and, with --node, prove every record against the app hash it was pruned at",
+		Long: `Checks the hash chain and the records root of every bundle. With --node each record is also queried with a
+proof at the height before it was pruned and checked against the app hash in the header of the pruning height.
+The node must still have the state of those heights, so it has to be an archive node.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bundles, err := archive.ReadBundles(args[0])
+			if err != nil {
+				return err
+			}
+			if err := archive.VerifyChain(bundles); err != nil {
+				return err
+			}
+
+			node, err := cmd.Flags().GetString(flagArchiveNode)
+			if err != nil {
+				return err
+			}
+			if node != "" {
+				client, err := rpchttp.New(node, "/websocket")
+				if err != nil {
+					return err
+				}
+				for _, bundle := range bundles {
+					if err := proveBundle(cmd.Context(), client, bundle); err != nil {
+						return err
+					}
+				}
+			}
+
+			cmd.Printf("Verified %d bundles\n", len(bundles))
+			return nil
+		},
+	}
+	cmd.Flags().String(flagArchiveNode, "", "CometBFT RPC endpoint of an archive node to prove records against")
+	return cmd
+}
+
+func proveBundle(ctx context.Context, client *rpchttp.HTTP, bundle archive.Bundle) error {
+	prt := rootmulti.DefaultProofRuntime()
+	checkedHeights := make(map[int64]bool)
+	for _, record := range bundle.Records {
+		if !checkedHeights[record.PruningHeight] {
+			height := record.PruningHeight
+			header, err := client.Header(ctx, &height)
+			if err != nil {
+				return fmt.Errorf("bundle %d: header at height %d: %w", bundle.Header.PruningEpoch, height, err)
+			}
+			if !bytes.Equal(header.Header.AppHash, record.AppHash) {
+				return fmt.Errorf("bundle %d: app hash at height %d is %X, records have %X", bundle.Header.PruningEpoch, height, header.Header.AppHash, record.AppHash)
+			}
+			checkedHeights[height] = true
+		}
+
+		res, err := client.ABCIQueryWithOptions(ctx, "/store/"+types.StoreKey+"/key", record.Key, rpcclient.ABCIQueryOptions{
+			Height: record.PruningHeight - 1,
+			Prove:  true,
+		})
+		if err != nil {
+			return fmt.Errorf("bundle %d: query %X: %w", bundle.Header.PruningEpoch, record.Key, err)
+		}
+		if !res.Response.IsOK() {
+			return fmt.Errorf("bundle %d: query %X: %s", bundle.Header.PruningEpoch, record.Key, res.Response.Log)
+		}
+		keyPath := merkle.KeyPath{}.
+			AppendKey([]byte(types.StoreKey), merkle.KeyEncodingURL).
+			AppendKey(record.Key, merkle.KeyEncodingURL)
+		if err := prt.VerifyValue(res.Response.ProofOps, record.AppHash, keyPath.String(), record.Value); err != nil {
+			return fmt.Errorf("bundle %d: %s record %X does not match the state at height %d: %w", bundle.Header.PruningEpoch, record.Kind, record.Key, record.PruningHeight-1, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/productscience/inference/app"
+)
+
+// CheckInvariantsCommand returns the Cobra command that runs all registered invariants against an exported state
+func CheckInvariantsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-invariants [exported-genesis-file]",
+		Short: "Load an exported genesis into an in-memory app and run all registered invariants against it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appGenesis, err := genutiltypes.AppGenesisFromFile(args[0])
+			if err != nil {
+				return err
+			}
+			broken, err := checkInvariants(appGenesis, cmd)
+			if err != nil {
+				return err
+			}
+			if broken > 0 {
+				return fmt.Errorf("%d invariants broken", broken)
+			}
+			cmd.Println("All invariants hold")
+			return nil
+		},
+	}
+	return cmd
+}
+
+func checkInvariants(appGenesis *genutiltypes.AppGenesis, cmd *cobra.Command) (int, error) {
+	home, err := os.MkdirTemp("", "check-invariants")
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(home)
+
+	appOpts := viper.New()
+	appOpts.Set(flags.FlagHome, home)
+	// Invariants are run one by one below instead of halting on the first broken one
+	appOpts.Set(crisis.FlagSkipGenesisInvariants, true)
+
+	inferenceApp, err := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOpts, nil, baseapp.SetChainID(appGenesis.ChainID))
+	if err != nil {
+		return 0, err
+	}
+	var consensusParams *cmtproto.ConsensusParams
+	if appGenesis.Consensus != nil && appGenesis.Consensus.Params != nil {
+		params := appGenesis.Consensus.Params.ToProto()
+		consensusParams = &params
+	}
+	_, err = inferenceApp.InitChain(&abci.RequestInitChain{
+		Time:            appGenesis.GenesisTime,
+		ChainId:         appGenesis.ChainID,
+		InitialHeight:   appGenesis.InitialHeight,
+		ConsensusParams: consensusParams,
+		AppStateBytes:   appGenesis.AppState,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to load exported state: %w", err)
+	}
+
+	ctx := inferenceApp.NewContextLegacy(false, cmtproto.Header{
+		ChainID: appGenesis.ChainID,
+		Height:  appGenesis.InitialHeight,
+		Time:    appGenesis.GenesisTime,
+	})
+	broken := 0
+	for _, route := range inferenceApp.CrisisKeeper.Routes() {
+		msg, isBroken := route.Invar(ctx)
+		if isBroken {
+			broken++
+			cmd.Printf("BROKEN %s\n%s\n", route.FullRoute(), msg)
+		} else {
+			cmd.Printf("ok     %s\n", route.FullRoute())
+		}
+	}
+	return broken, nil
+}
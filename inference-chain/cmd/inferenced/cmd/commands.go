@@ -38,9 +38,12 @@ func initRootCmd(
 	txConfig client.TxConfig,
 	basicManager module.BasicManager,
 ) {
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(CheckInvariantsCommand())
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(basicManager, app.DefaultNodeHome),
-		debug.Cmd(),
+		debugCmd,
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp, app.DefaultNodeHome),
 		snapshot.Cmd(newApp),
@@ -64,6 +67,7 @@ func initRootCmd(
 		SetRpcServers(),
 		SetTrustedBlock(),
 		PatchToml(),
+		ArchiveCommand(),
 	)
 }
 
@@ -45,8 +45,14 @@ func initCometBFTConfig() *cmtcfg.Config {
 // return "", nil if no custom configuration is required for the application.
 func initAppConfig() (string, interface{}) {
 	// The following code snippet is just for reference.
+	type InferenceConfig struct {
+		ArchiveDir string `mapstructure:"archive-dir"`
+	}
+
 	type CustomAppConfig struct {
 		serverconfig.Config `mapstructure:",squash"`
+
+		Inference InferenceConfig `mapstructure:"inference"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -71,7 +77,17 @@ func initAppConfig() (string, interface{}) {
 		Config: *srvCfg,
 	}
 
-	customAppTemplate := serverconfig.DefaultConfigTemplate
+	customAppTemplate := serverconfig.DefaultConfigTemplate + `
+###############################################################################
+###                          Inference Configuration                        ###
+###############################################################################
+
+[inference]
+
+# Directory to write inferences and PoC data to before state pruning deletes them.
+# Bundle them with "inferenced archive bundle". Empty disables archiving.
+archive-dir = "{{ .Inference.ArchiveDir }}"
+`
 	// Edit the default template file
 	//
 	// customAppTemplate := serverconfig.DefaultConfigTemplate + `
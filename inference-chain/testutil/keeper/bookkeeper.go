@@ -22,6 +22,10 @@ import (
 )
 
 func BookkeeperKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return BookkeeperKeeperWithBank(t, nil)
+}
+
+func BookkeeperKeeperWithBank(t testing.TB, bankKeeper types.BankKeeper) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -38,7 +42,7 @@ func BookkeeperKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
-		nil,
+		bankKeeper,
 		keeper.LogConfig{
 			DoubleEntry: true,
 			SimpleEntry: true,
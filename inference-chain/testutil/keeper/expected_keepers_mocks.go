@@ -21,7 +21,8 @@ import (
 	keeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	types2 "github.com/productscience/inference/x/bls/types"
-	types3 "github.com/productscience/inference/x/inference/types"
+	types3 "github.com/productscience/inference/x/collateral/types"
+	types4 "github.com/productscience/inference/x/inference/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -690,6 +691,76 @@ func (mr *MockCollateralKeeperMockRecorder) GetCollateral(ctx, participant any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollateral", reflect.TypeOf((*MockCollateralKeeper)(nil).GetCollateral), ctx, participant)
 }
 
+// GetCommissionRate mocks base method.
+func (m *MockCollateralKeeper) GetCommissionRate(ctx context.Context, host types.AccAddress) math.LegacyDec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommissionRate", ctx, host)
+	ret0, _ := ret[0].(math.LegacyDec)
+	return ret0
+}
+
+// GetCommissionRate indicates an expected call of GetCommissionRate.
+func (mr *MockCollateralKeeperMockRecorder) GetCommissionRate(ctx, host any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommissionRate", reflect.TypeOf((*MockCollateralKeeper)(nil).GetCommissionRate), ctx, host)
+}
+
+// GetDelegatedCollateral mocks base method.
+func (m *MockCollateralKeeper) GetDelegatedCollateral(ctx context.Context, host types.AccAddress) types.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegatedCollateral", ctx, host)
+	ret0, _ := ret[0].(types.Coin)
+	return ret0
+}
+
+// GetDelegatedCollateral indicates an expected call of GetDelegatedCollateral.
+func (mr *MockCollateralKeeperMockRecorder) GetDelegatedCollateral(ctx, host any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatedCollateral", reflect.TypeOf((*MockCollateralKeeper)(nil).GetDelegatedCollateral), ctx, host)
+}
+
+// GetDelegationsToHost mocks base method.
+func (m *MockCollateralKeeper) GetDelegationsToHost(ctx context.Context, host types.AccAddress) []types3.CollateralDelegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegationsToHost", ctx, host)
+	ret0, _ := ret[0].([]types3.CollateralDelegation)
+	return ret0
+}
+
+// GetDelegationsToHost indicates an expected call of GetDelegationsToHost.
+func (mr *MockCollateralKeeperMockRecorder) GetDelegationsToHost(ctx, host any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegationsToHost", reflect.TypeOf((*MockCollateralKeeper)(nil).GetDelegationsToHost), ctx, host)
+}
+
+// IsJailedForInference mocks base method.
+func (m *MockCollateralKeeper) IsJailedForInference(ctx context.Context, participant types.AccAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsJailedForInference", ctx, participant)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsJailedForInference indicates an expected call of IsJailedForInference.
+func (mr *MockCollateralKeeperMockRecorder) IsJailedForInference(ctx, participant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsJailedForInference", reflect.TypeOf((*MockCollateralKeeper)(nil).IsJailedForInference), ctx, participant)
+}
+
+// Jail mocks base method.
+func (m *MockCollateralKeeper) Jail(ctx context.Context, participant types.AccAddress, reason string, slashed, requiredCollateral types.Coin) types3.JailRecord {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Jail", ctx, participant, reason, slashed, requiredCollateral)
+	ret0, _ := ret[0].(types3.JailRecord)
+	return ret0
+}
+
+// Jail indicates an expected call of Jail.
+func (mr *MockCollateralKeeperMockRecorder) Jail(ctx, participant, reason, slashed, requiredCollateral any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Jail", reflect.TypeOf((*MockCollateralKeeper)(nil).Jail), ctx, participant, reason, slashed, requiredCollateral)
+}
+
 // Slash mocks base method.
 func (m *MockCollateralKeeper) Slash(ctx context.Context, participant types.AccAddress, slashFraction math.LegacyDec) (types.Coin, error) {
 	m.ctrl.T.Helper()
@@ -705,6 +776,36 @@ func (mr *MockCollateralKeeperMockRecorder) Slash(ctx, participant, slashFractio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slash", reflect.TypeOf((*MockCollateralKeeper)(nil).Slash), ctx, participant, slashFraction)
 }
 
+// SlashWithReward mocks base method.
+func (m *MockCollateralKeeper) SlashWithReward(ctx context.Context, participant types.AccAddress, slashFraction math.LegacyDec, rewardModule string, rewardFraction math.LegacyDec) (types.Coin, types.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashWithReward", ctx, participant, slashFraction, rewardModule, rewardFraction)
+	ret0, _ := ret[0].(types.Coin)
+	ret1, _ := ret[1].(types.Coin)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SlashWithReward indicates an expected call of SlashWithReward.
+func (mr *MockCollateralKeeperMockRecorder) SlashWithReward(ctx, participant, slashFraction, rewardModule, rewardFraction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashWithReward", reflect.TypeOf((*MockCollateralKeeper)(nil).SlashWithReward), ctx, participant, slashFraction, rewardModule, rewardFraction)
+}
+
+// UnbondAllCollateral mocks base method.
+func (m *MockCollateralKeeper) UnbondAllCollateral(ctx context.Context, participant types.AccAddress) types.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbondAllCollateral", ctx, participant)
+	ret0, _ := ret[0].(types.Coin)
+	return ret0
+}
+
+// UnbondAllCollateral indicates an expected call of UnbondAllCollateral.
+func (mr *MockCollateralKeeperMockRecorder) UnbondAllCollateral(ctx, participant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbondAllCollateral", reflect.TypeOf((*MockCollateralKeeper)(nil).UnbondAllCollateral), ctx, participant)
+}
+
 // MockStreamVestingKeeper is a mock of StreamVestingKeeper interface.
 type MockStreamVestingKeeper struct {
 	ctrl     *gomock.Controller
@@ -780,10 +881,10 @@ func (m *MockParticipantKeeper) EXPECT() *MockParticipantKeeperMockRecorder {
 }
 
 // GetAllParticipant mocks base method.
-func (m *MockParticipantKeeper) GetAllParticipant(ctx context.Context) []types3.Participant {
+func (m *MockParticipantKeeper) GetAllParticipant(ctx context.Context) []types4.Participant {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllParticipant", ctx)
-	ret0, _ := ret[0].([]types3.Participant)
+	ret0, _ := ret[0].([]types4.Participant)
 	return ret0
 }
 
@@ -794,10 +895,10 @@ func (mr *MockParticipantKeeperMockRecorder) GetAllParticipant(ctx any) *gomock.
 }
 
 // GetParticipant mocks base method.
-func (m *MockParticipantKeeper) GetParticipant(ctx context.Context, index string) (types3.Participant, bool) {
+func (m *MockParticipantKeeper) GetParticipant(ctx context.Context, index string) (types4.Participant, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetParticipant", ctx, index)
-	ret0, _ := ret[0].(types3.Participant)
+	ret0, _ := ret[0].(types4.Participant)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -809,10 +910,10 @@ func (mr *MockParticipantKeeperMockRecorder) GetParticipant(ctx, index any) *gom
 }
 
 // ParticipantAll mocks base method.
-func (m *MockParticipantKeeper) ParticipantAll(ctx context.Context, req *types3.QueryAllParticipantRequest) (*types3.QueryAllParticipantResponse, error) {
+func (m *MockParticipantKeeper) ParticipantAll(ctx context.Context, req *types4.QueryAllParticipantRequest) (*types4.QueryAllParticipantResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ParticipantAll", ctx, req)
-	ret0, _ := ret[0].(*types3.QueryAllParticipantResponse)
+	ret0, _ := ret[0].(*types4.QueryAllParticipantResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -836,7 +937,7 @@ func (mr *MockParticipantKeeperMockRecorder) RemoveParticipant(ctx, index any) *
 }
 
 // SetParticipant mocks base method.
-func (m *MockParticipantKeeper) SetParticipant(ctx context.Context, participant types3.Participant) error {
+func (m *MockParticipantKeeper) SetParticipant(ctx context.Context, participant types4.Participant) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetParticipant", ctx, participant)
 	ret0, _ := ret[0].(error)
@@ -873,10 +974,10 @@ func (m *MockHardwareNodeKeeper) EXPECT() *MockHardwareNodeKeeperMockRecorder {
 }
 
 // GetHardwareNodes mocks base method.
-func (m *MockHardwareNodeKeeper) GetHardwareNodes(ctx context.Context, address string) (*types3.HardwareNodes, bool) {
+func (m *MockHardwareNodeKeeper) GetHardwareNodes(ctx context.Context, address string) (*types4.HardwareNodes, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetHardwareNodes", ctx, address)
-	ret0, _ := ret[0].(*types3.HardwareNodes)
+	ret0, _ := ret[0].(*types4.HardwareNodes)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -911,10 +1012,10 @@ func (m *MockEpochGroupDataKeeper) EXPECT() *MockEpochGroupDataKeeperMockRecorde
 }
 
 // GetAllEpochGroupData mocks base method.
-func (m *MockEpochGroupDataKeeper) GetAllEpochGroupData(ctx context.Context) []types3.EpochGroupData {
+func (m *MockEpochGroupDataKeeper) GetAllEpochGroupData(ctx context.Context) []types4.EpochGroupData {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllEpochGroupData", ctx)
-	ret0, _ := ret[0].([]types3.EpochGroupData)
+	ret0, _ := ret[0].([]types4.EpochGroupData)
 	return ret0
 }
 
@@ -925,10 +1026,10 @@ func (mr *MockEpochGroupDataKeeperMockRecorder) GetAllEpochGroupData(ctx any) *g
 }
 
 // GetEpochGroupData mocks base method.
-func (m *MockEpochGroupDataKeeper) GetEpochGroupData(ctx context.Context, epochIndex uint64, modelId string) (types3.EpochGroupData, bool) {
+func (m *MockEpochGroupDataKeeper) GetEpochGroupData(ctx context.Context, epochIndex uint64, modelId string) (types4.EpochGroupData, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetEpochGroupData", ctx, epochIndex, modelId)
-	ret0, _ := ret[0].(types3.EpochGroupData)
+	ret0, _ := ret[0].(types4.EpochGroupData)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -952,7 +1053,7 @@ func (mr *MockEpochGroupDataKeeperMockRecorder) RemoveEpochGroupData(ctx, epochI
 }
 
 // SetEpochGroupData mocks base method.
-func (m *MockEpochGroupDataKeeper) SetEpochGroupData(ctx context.Context, epochGroupData types3.EpochGroupData) {
+func (m *MockEpochGroupDataKeeper) SetEpochGroupData(ctx context.Context, epochGroupData types4.EpochGroupData) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SetEpochGroupData", ctx, epochGroupData)
 }
@@ -1092,10 +1193,10 @@ func (m *MockModelKeeper) EXPECT() *MockModelKeeperMockRecorder {
 }
 
 // GetGovernanceModel mocks base method.
-func (m *MockModelKeeper) GetGovernanceModel(ctx context.Context, id string) (*types3.Model, bool) {
+func (m *MockModelKeeper) GetGovernanceModel(ctx context.Context, id string) (*types4.Model, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetGovernanceModel", ctx, id)
-	ret0, _ := ret[0].(*types3.Model)
+	ret0, _ := ret[0].(*types4.Model)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -1107,10 +1208,10 @@ func (mr *MockModelKeeperMockRecorder) GetGovernanceModel(ctx, id any) *gomock.C
 }
 
 // GetGovernanceModels mocks base method.
-func (m *MockModelKeeper) GetGovernanceModels(ctx context.Context) ([]*types3.Model, error) {
+func (m *MockModelKeeper) GetGovernanceModels(ctx context.Context) ([]*types4.Model, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetGovernanceModels", ctx)
-	ret0, _ := ret[0].([]*types3.Model)
+	ret0, _ := ret[0].([]*types4.Model)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -49,11 +50,26 @@ func StreamVestingKeeperWithMocks(t testing.TB) (keeper.Keeper, sdk.Context, Str
 	return k, ctx, mocks
 }
 
+// StreamvestingKeeperWithStoreKey also returns the store key, for tests that write raw state such as params
+// stored by an earlier version of the module
+func StreamvestingKeeperWithStoreKey(t testing.TB) (keeper.Keeper, sdk.Context, *storetypes.KVStoreKey) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	k, ctx := streamVestingKeeperWithStoreKey(t, NewMockBookkeepingBankKeeper(gomock.NewController(t)), storeKey)
+	return k, ctx, storeKey
+}
+
 func StreamVestingKeeperWithMock(
 	t testing.TB,
 	bankEscrowKeeper *MockBookkeepingBankKeeper,
 ) (keeper.Keeper, sdk.Context) {
-	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	return streamVestingKeeperWithStoreKey(t, bankEscrowKeeper, storetypes.NewKVStoreKey(types.StoreKey))
+}
+
+func streamVestingKeeperWithStoreKey(
+	t testing.TB,
+	bankEscrowKeeper *MockBookkeepingBankKeeper,
+	storeKey *storetypes.KVStoreKey,
+) (keeper.Keeper, sdk.Context) {
 
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
@@ -69,8 +85,9 @@ func StreamVestingKeeperWithMock(
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
-		nil, // BankKeeper can be nil for tests
+		moduleAccountsBankKeeper{},
 		bankEscrowKeeper,
+		nil, // DistributionKeeper is only needed when penalties go to the community pool
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -82,3 +99,20 @@ func StreamVestingKeeperWithMock(
 
 	return k, ctx
 }
+
+// moduleAccountsBankKeeper stands in for the bank keeper, blocking the module accounts from receiving funds as the
+// app does
+type moduleAccountsBankKeeper struct{}
+
+func (moduleAccountsBankKeeper) SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins()
+}
+
+func (moduleAccountsBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	for _, moduleName := range []string{types.ModuleName, authtypes.FeeCollectorName} {
+		if addr.Equals(authtypes.NewModuleAddress(moduleName)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/bookkeeper/types"
+)
+
+// RegisterInvariants registers the bookkeeper module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "supply-ledger", SupplyLedgerInvariant(k))
+}
+
+// SupplyLedgerInvariant checks that the mint and burn totals of every denom equal the mint and burn entries kept
+// in the ledger plus the pruned part of the totals. It deliberately leaves the bank supply out: x/mint, staking
+// slashes and wasm contracts change it without going through the keeper, so it can't be held to the totals.
+func SupplyLedgerInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		listedMinted, listedBurned, err := k.listedSupplyMovements(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "supply-ledger", fmt.Sprintf("failed to read ledger: %s", err)), true
+		}
+
+		denoms := make(map[string]bool)
+		for _, total := range k.GetAllSupplyTotals(ctx) {
+			denoms[total.Denom] = true
+		}
+		for denom := range listedMinted {
+			denoms[denom] = true
+		}
+		for denom := range listedBurned {
+			denoms[denom] = true
+		}
+		sorted := make([]string, 0, len(denoms))
+		for denom := range denoms {
+			sorted = append(sorted, denom)
+		}
+		sort.Strings(sorted)
+
+		var msg string
+		broken := false
+		for _, denom := range sorted {
+			total := k.GetSupplyTotal(ctx, denom)
+			minted := total.PrunedMinted.Add(intOrZero(listedMinted[denom]))
+			burned := total.PrunedBurned.Add(intOrZero(listedBurned[denom]))
+			if !minted.Equal(total.Minted) || !burned.Equal(total.Burned) {
+				broken = true
+				msg += fmt.Sprintf("\t%s: minted %s, in ledger or pruned %s; burned %s, in ledger or pruned %s\n",
+					denom, total.Minted, minted, total.Burned, burned)
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "supply-ledger", msg), broken
+	}
+}
+
+// listedSupplyMovements sums the mint and burn entries in the ledger by denom.
+func (k Keeper) listedSupplyMovements(ctx sdk.Context) (minted, burned map[string]math.Int, err error) {
+	minted = make(map[string]math.Int)
+	burned = make(map[string]math.Int)
+	err = k.LedgerByAccount.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](types.SupplyAccount), func(key collections.Pair[string, uint64]) (bool, error) {
+		entry, err := k.Ledger.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		if entry.SubAccount != "" {
+			return false, nil
+		}
+		if entry.CreditAccount == types.SupplyAccount {
+			minted[entry.Denom] = intOrZero(minted[entry.Denom]).Add(entry.Amount)
+		} else if entry.DebitAccount == types.SupplyAccount {
+			burned[entry.Denom] = intOrZero(burned[entry.Denom]).Add(entry.Amount)
+		}
+		return false, nil
+	})
+	return minted, burned, err
+}
+
+func intOrZero(i math.Int) math.Int {
+	if i.IsNil() {
+		return math.ZeroInt()
+	}
+	return i
+}
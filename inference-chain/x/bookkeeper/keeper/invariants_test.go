@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/bookkeeper/keeper"
+	"github.com/productscience/inference/x/bookkeeper/types"
+)
+
+// supplyBank accepts every mint and burn without tracking balances.
+type supplyBank struct {
+	types.BankKeeper
+}
+
+func (supplyBank) MintCoins(context.Context, string, sdk.Coins) error { return nil }
+func (supplyBank) BurnCoins(context.Context, string, sdk.Coins) error { return nil }
+
+func TestSupplyLedgerInvariant(t *testing.T) {
+	k, ctx := keepertest.BookkeeperKeeperWithBank(t, supplyBank{})
+	require.NoError(t, k.SetParams(ctx, types.Params{LedgerRetentionBlocks: 10}))
+	invariant := keeper.SupplyLedgerInvariant(k)
+
+	require.NoError(t, k.MintCoins(ctx.WithBlockHeight(10), "inference", sdk.NewCoins(sdk.NewInt64Coin("ngonka", 100)), "reward"))
+	require.NoError(t, k.BurnCoins(ctx.WithBlockHeight(15), "inference", sdk.NewCoins(sdk.NewInt64Coin("ngonka", 30)), "burn"))
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// Pruning moves the mint into the pruned part of the totals
+	k.PruneLedger(ctx, 20)
+	total := k.GetSupplyTotal(ctx, "ngonka")
+	require.Equal(t, math.NewInt(100), total.PrunedMinted)
+	require.True(t, total.PrunedBurned.IsZero())
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// Mints while the ledger is disabled are counted as pruned right away
+	require.NoError(t, k.SetParams(ctx, types.Params{}))
+	require.NoError(t, k.MintCoins(ctx.WithBlockHeight(21), "inference", sdk.NewCoins(sdk.NewInt64Coin("ngonka", 5)), "reward"))
+	k.PruneLedger(ctx, 21)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	total = k.GetSupplyTotal(ctx, "ngonka")
+	total.Minted = total.Minted.AddRaw(1)
+	k.SetSupplyTotal(ctx, total)
+	msg, broken := invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "ngonka")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -24,6 +25,13 @@ type (
 
 		bankKeeper types.BankKeeper
 		logConfig  LogConfig
+
+		Schema          collections.Schema
+		Ledger          collections.Map[uint64, types.LedgerEntry]
+		LedgerSequence  collections.Sequence
+		LedgerByAccount collections.KeySet[collections.Pair[string, uint64]]
+		LedgerByHeight  collections.KeySet[collections.Pair[int64, uint64]]
+		SupplyTotals    collections.Map[string, types.SupplyTotal]
 	}
 )
 
@@ -46,7 +54,8 @@ func NewKeeper(
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
 	}
 
-	return Keeper{
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
 		cdc:          cdc,
 		storeService: storeService,
 		authority:    authority,
@@ -54,7 +63,41 @@ func NewKeeper(
 
 		bankKeeper: bankKeeper,
 		logConfig:  logConfig,
+
+		Ledger: collections.NewMap(
+			sb,
+			types.LedgerEntriesPrefix,
+			"ledger_entries",
+			collections.Uint64Key,
+			codec.CollValue[types.LedgerEntry](cdc),
+		),
+		LedgerSequence: collections.NewSequence(sb, types.LedgerSequencePrefix, "ledger_sequence"),
+		LedgerByAccount: collections.NewKeySet(
+			sb,
+			types.LedgerByAccountPrefix,
+			"ledger_by_account",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		LedgerByHeight: collections.NewKeySet(
+			sb,
+			types.LedgerByHeightPrefix,
+			"ledger_by_height",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
+		),
+		SupplyTotals: collections.NewMap(
+			sb,
+			types.SupplyTotalsPrefix,
+			"supply_totals",
+			collections.StringKey,
+			codec.CollValue[types.SupplyTotal](cdc),
+		),
+	}
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	k.Schema = schema
+	return k
 }
 
 // GetAuthority returns the module's authority.
@@ -119,7 +162,8 @@ func (k Keeper) MintCoins(ctx context.Context, moduleName string, amt sdk.Coins,
 		return err
 	}
 	for _, coin := range amt {
-		k.logTransaction(ctx, moduleName, "supply", coin, memo, "")
+		k.logTransaction(ctx, moduleName, types.SupplyAccount, coin, memo, "")
+		k.addSupplyMovement(ctx, coin, true)
 	}
 	return nil
 }
@@ -134,19 +178,24 @@ func (k Keeper) BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins,
 		return err
 	}
 	for _, coin := range amt {
-		k.logTransaction(ctx, "supply", moduleName, coin, memo, "")
+		k.logTransaction(ctx, types.SupplyAccount, moduleName, coin, memo, "")
+		k.addSupplyMovement(ctx, coin, false)
 	}
 	return nil
 }
 
 func (k Keeper) LogSubAccountTransaction(ctx context.Context, recipient string, sender string, subAccount string, amt sdk.Coin, memo string) {
-	k.logTransaction(ctx, recipient+"_"+subAccount, sender+"_"+subAccount, amt, memo, subAccount)
+	k.logTransaction(ctx, recipient, sender, amt, memo, subAccount)
 }
 
 func (k Keeper) logTransaction(ctx context.Context, to string, from string, coin sdk.Coin, memo string, subAccount string) {
 	if coin.Amount.IsZero() {
 		return
 	}
+	k.appendLedgerEntry(ctx, to, from, coin, memo, subAccount)
+	if subAccount != "" {
+		to, from = to+"_"+subAccount, from+"_"+subAccount
+	}
 	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
 	logFunc := k.getLogFunction(k.logConfig.LogLevel)
 	amount := coin.Amount.Int64()
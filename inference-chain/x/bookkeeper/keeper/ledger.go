@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/bookkeeper/types"
+)
+
+// The ledger keeps every transfer made through the keeper in state for ledger_retention_blocks, indexed by both
+// accounts of the transfer and by height.
+
+func (k Keeper) appendLedgerEntry(ctx context.Context, to string, from string, coin sdk.Coin, memo string, subAccount string) {
+	if k.GetParams(ctx).LedgerRetentionBlocks <= 0 {
+		return
+	}
+	id, err := k.LedgerSequence.Next(ctx)
+	if err != nil {
+		panic(err)
+	}
+	k.SetLedgerEntry(ctx, types.LedgerEntry{
+		Id:            id + 1,
+		Height:        sdk.UnwrapSDKContext(ctx).BlockHeight(),
+		DebitAccount:  to,
+		CreditAccount: from,
+		SubAccount:    subAccount,
+		Denom:         coin.Denom,
+		Amount:        coin.Amount,
+		Memo:          memo,
+	})
+}
+
+func (k Keeper) SetLedgerEntry(ctx context.Context, entry types.LedgerEntry) {
+	if err := k.Ledger.Set(ctx, entry.Id, entry); err != nil {
+		panic(err)
+	}
+	for _, account := range []string{entry.DebitAccount, entry.CreditAccount} {
+		if err := k.LedgerByAccount.Set(ctx, collections.Join(account, entry.Id)); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.LedgerByHeight.Set(ctx, collections.Join(entry.Height, entry.Id)); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) removeLedgerEntry(ctx context.Context, entry types.LedgerEntry) error {
+	if err := k.Ledger.Remove(ctx, entry.Id); err != nil {
+		return err
+	}
+	for _, account := range []string{entry.DebitAccount, entry.CreditAccount} {
+		if err := k.LedgerByAccount.Remove(ctx, collections.Join(account, entry.Id)); err != nil {
+			return err
+		}
+	}
+	return k.LedgerByHeight.Remove(ctx, collections.Join(entry.Height, entry.Id))
+}
+
+func (k Keeper) GetAllLedgerEntries(ctx context.Context) []types.LedgerEntry {
+	iter, err := k.Ledger.Iterate(ctx, nil)
+	if err != nil {
+		return nil
+	}
+	entries, err := iter.Values()
+	if err != nil {
+		return nil
+	}
+	return entries
+}
+
+// PruneLedger removes the entries that fell out of the retention window at height, or all of them once the
+// ledger is disabled.
+func (k Keeper) PruneLedger(ctx context.Context, height int64) {
+	retention := k.GetParams(ctx).LedgerRetentionBlocks
+	cutoff := height - retention
+	if retention <= 0 {
+		cutoff = height
+	}
+	iter, err := k.LedgerByHeight.Iterate(ctx, collections.NewPrefixUntilPairRange[int64, uint64](cutoff))
+	if err != nil {
+		k.Logger().Error("failed to iterate ledger entries", "error", err)
+		return
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		k.Logger().Error("failed to read ledger entries", "error", err)
+		return
+	}
+	for _, key := range keys {
+		entry, err := k.Ledger.Get(ctx, key.K2())
+		if err != nil {
+			k.Logger().Error("ledger entry not found", "id", key.K2(), "error", err)
+			continue
+		}
+		if err := k.removeLedgerEntry(ctx, entry); err != nil {
+			k.Logger().Error("failed to remove ledger entry", "id", entry.Id, "error", err)
+			continue
+		}
+		k.notePrunedSupplyEntry(ctx, entry)
+	}
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/bookkeeper/types"
+)
+
+func TestLedger_QueryFilters(t *testing.T) {
+	k, ctx := keepertest.BookkeeperKeeper(t)
+	coin := sdk.NewInt64Coin("ngonka", 100)
+	k.LogSubAccountTransaction(ctx.WithBlockHeight(10), "alice", "inference", "settled", coin, "work_coins:epoch1")
+	k.LogSubAccountTransaction(ctx.WithBlockHeight(11), "bob", "inference", "settled", coin, "reward_coins:epoch1")
+	k.LogSubAccountTransaction(ctx.WithBlockHeight(12), "collateral", "alice", "unbonding", coin, "collateral to unbonding")
+
+	resp, err := k.LedgerEntries(ctx, &types.QueryLedgerEntriesRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 3)
+	require.Equal(t, types.LedgerEntry{
+		Id: 1, Height: 10, DebitAccount: "alice", CreditAccount: "inference", SubAccount: "settled",
+		Denom: "ngonka", Amount: coin.Amount, Memo: "work_coins:epoch1",
+	}, resp.Entries[0])
+
+	// Both sides of an entry match the account
+	resp, err = k.LedgerEntries(ctx, &types.QueryLedgerEntriesRequest{Account: "alice"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3}, entryIds(resp.Entries))
+
+	resp, err = k.LedgerEntries(ctx, &types.QueryLedgerEntriesRequest{Account: "inference", MemoPrefix: "reward_coins"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, entryIds(resp.Entries))
+
+	resp, err = k.LedgerEntries(ctx, &types.QueryLedgerEntriesRequest{SubAccount: "settled", MinHeight: 11, MaxHeight: 12})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, entryIds(resp.Entries))
+
+	resp, err = k.LedgerEntries(ctx, &types.QueryLedgerEntriesRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, entryIds(resp.Entries))
+	resp, err = k.LedgerEntries(ctx, &types.QueryLedgerEntriesRequest{Pagination: &query.PageRequest{Key: resp.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, entryIds(resp.Entries))
+}
+
+func TestLedger_Retention(t *testing.T) {
+	k, ctx := keepertest.BookkeeperKeeper(t)
+	require.NoError(t, k.SetParams(ctx, types.Params{LedgerRetentionBlocks: 10}))
+	coin := sdk.NewInt64Coin("ngonka", 100)
+	k.LogSubAccountTransaction(ctx.WithBlockHeight(10), "alice", "inference", "settled", coin, "work_coins")
+	k.LogSubAccountTransaction(ctx.WithBlockHeight(15), "bob", "inference", "settled", coin, "work_coins")
+
+	k.PruneLedger(ctx, 20)
+	require.Equal(t, []uint64{2}, entryIds(k.GetAllLedgerEntries(ctx)))
+	resp, err := k.LedgerEntries(ctx, &types.QueryLedgerEntriesRequest{Account: "alice"})
+	require.NoError(t, err)
+	require.Empty(t, resp.Entries)
+
+	// Disabling the ledger stops recording and drops what is left
+	require.NoError(t, k.SetParams(ctx, types.Params{}))
+	k.LogSubAccountTransaction(ctx.WithBlockHeight(21), "bob", "inference", "settled", coin, "work_coins")
+	k.PruneLedger(ctx, 21)
+	require.Empty(t, k.GetAllLedgerEntries(ctx))
+}
+
+func entryIds(entries []types.LedgerEntry) []uint64 {
+	ids := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.Id)
+	}
+	return ids
+}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/productscience/inference/x/bookkeeper/types"
+)
+
+// SetDefaultLedgerRetention writes the default ledger retention into params stored before the retention
+// existed, which read as 0 and would leave the ledger disabled. Params with a retention are left alone.
+func (k Keeper) SetDefaultLedgerRetention(ctx context.Context) error {
+	params := k.GetParams(ctx)
+	if params.LedgerRetentionBlocks != 0 {
+		return nil
+	}
+	params.LedgerRetentionBlocks = types.DefaultParams().LedgerRetentionBlocks
+	return k.SetParams(ctx, params)
+}
@@ -0,0 +1,24 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/bookkeeper/types"
+)
+
+func TestSetDefaultLedgerRetention(t *testing.T) {
+	k, ctx := keepertest.BookkeeperKeeper(t)
+
+	// Params as stored before the retention existed
+	require.NoError(t, k.SetParams(ctx, types.Params{}))
+	require.NoError(t, k.SetDefaultLedgerRetention(ctx))
+	require.Equal(t, types.DefaultParams().LedgerRetentionBlocks, k.GetParams(ctx).LedgerRetentionBlocks)
+
+	// A retention chosen by governance is kept
+	require.NoError(t, k.SetParams(ctx, types.Params{LedgerRetentionBlocks: 10}))
+	require.NoError(t, k.SetDefaultLedgerRetention(ctx))
+	require.Equal(t, int64(10), k.GetParams(ctx).LedgerRetentionBlocks)
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/productscience/inference/x/bookkeeper/types"
+)
+
+func (k Keeper) LedgerEntries(ctx context.Context, req *types.QueryLedgerEntriesRequest) (*types.QueryLedgerEntriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.MaxHeight != 0 && req.MaxHeight < req.MinHeight {
+		return nil, status.Error(codes.InvalidArgument, "max_height is below min_height")
+	}
+
+	matches := func(entry types.LedgerEntry) bool {
+		return (req.SubAccount == "" || entry.SubAccount == req.SubAccount) &&
+			strings.HasPrefix(entry.Memo, req.MemoPrefix) &&
+			entry.Height >= req.MinHeight &&
+			(req.MaxHeight == 0 || entry.Height <= req.MaxHeight)
+	}
+
+	if req.Account == "" {
+		entries, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Ledger, req.Pagination,
+			func(_ uint64, entry types.LedgerEntry) (bool, error) {
+				return matches(entry), nil
+			},
+			func(_ uint64, entry types.LedgerEntry) (types.LedgerEntry, error) {
+				return entry, nil
+			},
+		)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &types.QueryLedgerEntriesResponse{Entries: entries, Pagination: pageRes}, nil
+	}
+
+	entries, pageRes, err := query.CollectionFilteredPaginate(ctx, k.LedgerByAccount, req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (bool, error) {
+			entry, err := k.Ledger.Get(ctx, key.K2())
+			if err != nil {
+				return false, err
+			}
+			return matches(entry), nil
+		},
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.LedgerEntry, error) {
+			return k.Ledger.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Account),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryLedgerEntriesResponse{Entries: entries, Pagination: pageRes}, nil
+}
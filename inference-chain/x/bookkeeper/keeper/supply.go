@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/bookkeeper/types"
+)
+
+// Supply totals count every coin minted or burned through the keeper. The part that is no longer backed by ledger
+// entries is counted separately, so the totals can be reconciled with the retained ledger.
+
+func (k Keeper) GetSupplyTotal(ctx context.Context, denom string) types.SupplyTotal {
+	total, err := k.SupplyTotals.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.SupplyTotal{
+			Denom:        denom,
+			Minted:       math.ZeroInt(),
+			Burned:       math.ZeroInt(),
+			PrunedMinted: math.ZeroInt(),
+			PrunedBurned: math.ZeroInt(),
+		}
+	}
+	if err != nil {
+		panic(err)
+	}
+	return total
+}
+
+func (k Keeper) SetSupplyTotal(ctx context.Context, total types.SupplyTotal) {
+	if err := k.SupplyTotals.Set(ctx, total.Denom, total); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) GetAllSupplyTotals(ctx context.Context) []types.SupplyTotal {
+	iter, err := k.SupplyTotals.Iterate(ctx, nil)
+	if err != nil {
+		return nil
+	}
+	totals, err := iter.Values()
+	if err != nil {
+		return nil
+	}
+	return totals
+}
+
+func (k Keeper) addSupplyMovement(ctx context.Context, coin sdk.Coin, minted bool) {
+	if coin.Amount.IsZero() {
+		return
+	}
+	total := k.GetSupplyTotal(ctx, coin.Denom)
+	unlisted := k.GetParams(ctx).LedgerRetentionBlocks <= 0
+	if minted {
+		total.Minted = total.Minted.Add(coin.Amount)
+		if unlisted {
+			total.PrunedMinted = total.PrunedMinted.Add(coin.Amount)
+		}
+	} else {
+		total.Burned = total.Burned.Add(coin.Amount)
+		if unlisted {
+			total.PrunedBurned = total.PrunedBurned.Add(coin.Amount)
+		}
+	}
+	k.SetSupplyTotal(ctx, total)
+}
+
+// notePrunedSupplyEntry moves a pruned mint or burn entry into the pruned part of its supply total.
+func (k Keeper) notePrunedSupplyEntry(ctx context.Context, entry types.LedgerEntry) {
+	if entry.SubAccount != "" {
+		return
+	}
+	switch {
+	case entry.CreditAccount == types.SupplyAccount:
+		total := k.GetSupplyTotal(ctx, entry.Denom)
+		total.PrunedMinted = total.PrunedMinted.Add(entry.Amount)
+		k.SetSupplyTotal(ctx, total)
+	case entry.DebitAccount == types.SupplyAccount:
+		total := k.GetSupplyTotal(ctx, entry.Denom)
+		total.PrunedBurned = total.PrunedBurned.Add(entry.Amount)
+		k.SetSupplyTotal(ctx, total)
+	}
+}
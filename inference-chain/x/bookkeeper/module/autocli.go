@@ -17,6 +17,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod: "LedgerEntries",
+					Use:       "ledger-entries",
+					Short:     "List persisted ledger entries, filtered by account, sub-account, memo prefix and height range",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -9,6 +9,17 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	var lastEntryId uint64
+	for _, entry := range genState.LedgerEntries {
+		k.SetLedgerEntry(ctx, entry)
+		lastEntryId = max(lastEntryId, entry.Id)
+	}
+	if err := k.LedgerSequence.Set(ctx, lastEntryId); err != nil {
+		panic(err)
+	}
+	for _, total := range genState.SupplyTotals {
+		k.SetSupplyTotal(ctx, total)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -19,6 +30,8 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.LedgerEntries = k.GetAllLedgerEntries(ctx)
+	genesis.SupplyTotals = k.GetAllSupplyTotals(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -16,6 +18,20 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	ids := make(map[uint64]bool)
+	for _, entry := range gs.LedgerEntries {
+		if entry.Id == 0 || ids[entry.Id] {
+			return fmt.Errorf("invalid or duplicate ledger entry id %d", entry.Id)
+		}
+		ids[entry.Id] = true
+	}
+	denoms := make(map[string]bool)
+	for _, total := range gs.SupplyTotals {
+		if total.Denom == "" || denoms[total.Denom] {
+			return fmt.Errorf("invalid or duplicate supply total denom %q", total.Denom)
+		}
+		denoms[total.Denom] = true
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -27,7 +27,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the bookkeeper module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params        Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LedgerEntries []LedgerEntry `protobuf:"bytes,2,rep,name=ledger_entries,json=ledgerEntries,proto3" json:"ledger_entries"`
+	SupplyTotals  []SupplyTotal `protobuf:"bytes,3,rep,name=supply_totals,json=supplyTotals,proto3" json:"supply_totals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +72,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetLedgerEntries() []LedgerEntry {
+	if m != nil {
+		return m.LedgerEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSupplyTotals() []SupplyTotal {
+	if m != nil {
+		return m.SupplyTotals
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "inference.bookkeeper.GenesisState")
 }
@@ -79,21 +95,27 @@ func init() {
 }
 
 var fileDescriptor_6086753e00976ec5 = []byte{
-	// 218 bytes of a gzipped FileDescriptorProto
+	// 308 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0xcc, 0x4b, 0x4b,
 	0x2d, 0x4a, 0xcd, 0x4b, 0x4e, 0xd5, 0x4f, 0xca, 0xcf, 0xcf, 0xce, 0x4e, 0x4d, 0x2d, 0x48, 0x2d,
 	0xd2, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
 	0x81, 0xab, 0xd1, 0x43, 0xa8, 0x91, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10,
 	0x85, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x55, 0xc4, 0x6a,
-	0x45, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x06, 0x25, 0x7f, 0x2e, 0x1e, 0x77, 0x88, 0x95, 0xc1,
-	0x25, 0x89, 0x25, 0xa9, 0x42, 0xf6, 0x5c, 0x6c, 0x10, 0x79, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e,
-	0x23, 0x19, 0x3d, 0x6c, 0x4e, 0xd0, 0x0b, 0x00, 0xab, 0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61,
-	0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x6d, 0x4e, 0x01, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
-	0x78, 0x2c, 0xc7, 0x10, 0x65, 0x96, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab,
-	0x5f, 0x50, 0x94, 0x9f, 0x52, 0x9a, 0x5c, 0x52, 0x9c, 0x9c, 0x09, 0x76, 0x1d, 0xc2, 0x9d, 0x15,
-	0xc8, 0x2e, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xbb, 0xd4, 0x18, 0x10, 0x00, 0x00,
-	0xff, 0xff, 0x1c, 0x9d, 0x3d, 0x4b, 0x31, 0x01, 0x00, 0x00,
+	0x45, 0x41, 0x62, 0x51, 0x62, 0x6e, 0x31, 0x5e, 0x25, 0x39, 0xa9, 0x29, 0xe9, 0xa9, 0x45, 0x10,
+	0x25, 0x4a, 0x6f, 0x19, 0xb9, 0x78, 0xdc, 0x21, 0xce, 0x0a, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xb2,
+	0xe7, 0x62, 0x83, 0x98, 0x21, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa3, 0x87, 0xcd, 0x99,
+	0x7a, 0x01, 0x60, 0x35, 0x4e, 0x9c, 0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31,
+	0x08, 0xaa, 0x4d, 0xc8, 0x8f, 0x8b, 0x0f, 0x62, 0x43, 0x7c, 0x6a, 0x5e, 0x49, 0x51, 0x66, 0x6a,
+	0xb1, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x22, 0x76, 0x83, 0x7c, 0xc0, 0x6a, 0x5d, 0xf3,
+	0x4a, 0x8a, 0x2a, 0x9d, 0x58, 0x40, 0xa6, 0x05, 0xf1, 0xe6, 0xc0, 0x85, 0x32, 0x53, 0x8b, 0x85,
+	0x7c, 0xb8, 0x78, 0x8b, 0x4b, 0x0b, 0x0a, 0x72, 0x2a, 0xe3, 0x4b, 0xf2, 0x4b, 0x12, 0x73, 0x8a,
+	0x25, 0x98, 0xf1, 0x19, 0x17, 0x0c, 0x56, 0x1a, 0x02, 0x52, 0x09, 0x35, 0x8e, 0xa7, 0x18, 0x21,
+	0x54, 0xec, 0x14, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
+	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x66, 0xe9,
+	0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x05, 0x45, 0xf9, 0x29, 0xa5, 0xc9,
+	0x25, 0xc5, 0xc9, 0x99, 0xe0, 0xc0, 0x43, 0x04, 0x63, 0x05, 0x72, 0x40, 0x96, 0x54, 0x16, 0xa4,
+	0x16, 0x27, 0xb1, 0x81, 0x03, 0xd2, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xc3, 0x0a, 0xdf, 0x7b,
+	0xf3, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -116,6 +138,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SupplyTotals) > 0 {
+		for iNdEx := len(m.SupplyTotals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LedgerEntries) > 0 {
+		for iNdEx := len(m.LedgerEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LedgerEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -148,6 +198,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LedgerEntries) > 0 {
+		for _, e := range m.LedgerEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SupplyTotals) > 0 {
+		for _, e := range m.SupplyTotals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -219,6 +281,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LedgerEntries = append(m.LedgerEntries, LedgerEntry{})
+			if err := m.LedgerEntries[len(m.LedgerEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyTotals = append(m.SupplyTotals, SupplyTotal{})
+			if err := m.SupplyTotals[len(m.SupplyTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
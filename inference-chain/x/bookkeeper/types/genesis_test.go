@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/productscience/inference/x/bookkeeper/types"
 	"github.com/stretchr/testify/require"
 )
@@ -26,6 +27,13 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated ledger entry",
+			genState: &types.GenesisState{
+				LedgerEntries: []types.LedgerEntry{{Id: 1, Amount: math.NewInt(1)}, {Id: 1, Amount: math.NewInt(2)}},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
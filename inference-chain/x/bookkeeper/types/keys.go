@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "bookkeeper"
@@ -9,10 +11,19 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_bookkeeper"
+
+	// SupplyAccount is the ledger account coins are minted from and burned to
+	SupplyAccount = "supply"
 )
 
 var (
 	ParamsKey = []byte("p_bookkeeper")
+
+	LedgerEntriesPrefix   = collections.NewPrefix(1)
+	LedgerSequencePrefix  = collections.NewPrefix(2)
+	LedgerByAccountPrefix = collections.NewPrefix(3)
+	LedgerByHeightPrefix  = collections.NewPrefix(4)
+	SupplyTotalsPrefix    = collections.NewPrefix(5)
 )
 
 func KeyPrefix(p string) []byte {
@@ -0,0 +1,1020 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/bookkeeper/ledger.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LedgerEntry records one transfer: amount is debited to debit_account and credited from credit_account.
+type LedgerEntry struct {
+	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Height        int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	DebitAccount  string `protobuf:"bytes,3,opt,name=debit_account,json=debitAccount,proto3" json:"debit_account,omitempty"`
+	CreditAccount string `protobuf:"bytes,4,opt,name=credit_account,json=creditAccount,proto3" json:"credit_account,omitempty"`
+	// sub_account is set for transfers between sub-accounts of the same accounts, such as collateral or unbonding
+	SubAccount string                `protobuf:"bytes,5,opt,name=sub_account,json=subAccount,proto3" json:"sub_account,omitempty"`
+	Denom      string                `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount     cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Memo       string                `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *LedgerEntry) Reset()         { *m = LedgerEntry{} }
+func (m *LedgerEntry) String() string { return proto.CompactTextString(m) }
+func (*LedgerEntry) ProtoMessage()    {}
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c30bde96933bcf7e, []int{0}
+}
+func (m *LedgerEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LedgerEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LedgerEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LedgerEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LedgerEntry.Merge(m, src)
+}
+func (m *LedgerEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *LedgerEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LedgerEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LedgerEntry proto.InternalMessageInfo
+
+func (m *LedgerEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *LedgerEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LedgerEntry) GetDebitAccount() string {
+	if m != nil {
+		return m.DebitAccount
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetCreditAccount() string {
+	if m != nil {
+		return m.CreditAccount
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetSubAccount() string {
+	if m != nil {
+		return m.SubAccount
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+// SupplyTotal accumulates the coins of a denom minted and burned through the keeper.
+type SupplyTotal struct {
+	Denom  string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+	Burned cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=burned,proto3,customtype=cosmossdk.io/math.Int" json:"burned"`
+	// pruned_minted is the part of minted that has no ledger entry, because it was pruned or the ledger was disabled
+	PrunedMinted cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=pruned_minted,json=prunedMinted,proto3,customtype=cosmossdk.io/math.Int" json:"pruned_minted"`
+	// pruned_burned is the part of burned that has no ledger entry
+	PrunedBurned cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=pruned_burned,json=prunedBurned,proto3,customtype=cosmossdk.io/math.Int" json:"pruned_burned"`
+}
+
+func (m *SupplyTotal) Reset()         { *m = SupplyTotal{} }
+func (m *SupplyTotal) String() string { return proto.CompactTextString(m) }
+func (*SupplyTotal) ProtoMessage()    {}
+func (*SupplyTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c30bde96933bcf7e, []int{1}
+}
+func (m *SupplyTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyTotal.Merge(m, src)
+}
+func (m *SupplyTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyTotal proto.InternalMessageInfo
+
+func (m *SupplyTotal) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*LedgerEntry)(nil), "inference.bookkeeper.LedgerEntry")
+	proto.RegisterType((*SupplyTotal)(nil), "inference.bookkeeper.SupplyTotal")
+}
+
+func init() { proto.RegisterFile("inference/bookkeeper/ledger.proto", fileDescriptor_c30bde96933bcf7e) }
+
+var fileDescriptor_c30bde96933bcf7e = []byte{
+	// 439 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0xd9, 0xb6, 0xea, 0x74, 0xbb, 0xe0, 0x50, 0x25, 0xee, 0x21, 0xad, 0x2b, 0x42,
+	0x11, 0x4c, 0x04, 0xc1, 0xbb, 0x05, 0xc1, 0x05, 0x05, 0xa9, 0x7a, 0xf1, 0x52, 0x92, 0xcc, 0x33,
+	0x1d, 0xda, 0x99, 0x17, 0x26, 0x33, 0x60, 0xbf, 0x85, 0x27, 0x3f, 0x83, 0x47, 0x0f, 0x7e, 0x88,
+	0x3d, 0x2e, 0x9e, 0xc4, 0xc3, 0x22, 0x2d, 0xe2, 0xd7, 0x90, 0xce, 0x64, 0x37, 0xc5, 0x63, 0xf7,
+	0x12, 0xe6, 0xfd, 0xe7, 0xf7, 0xfe, 0xf9, 0xe7, 0x91, 0x47, 0xef, 0x0b, 0xf5, 0x11, 0x34, 0xa8,
+	0x1c, 0x92, 0x0c, 0x71, 0xb1, 0x00, 0x28, 0x41, 0x27, 0x4b, 0xe0, 0x05, 0xe8, 0xb8, 0xd4, 0x68,
+	0x90, 0x0d, 0xae, 0x90, 0xb8, 0x41, 0x8e, 0x6f, 0xa7, 0x52, 0x28, 0x4c, 0xdc, 0xd3, 0x83, 0xc7,
+	0xf7, 0x72, 0xac, 0x24, 0x56, 0x33, 0x57, 0x25, 0xbe, 0xa8, 0xaf, 0x06, 0x05, 0x16, 0xe8, 0xf5,
+	0xed, 0xc9, 0xab, 0x27, 0x5f, 0x02, 0xda, 0x7b, 0xe5, 0x5e, 0xf5, 0x42, 0x19, 0xbd, 0x62, 0x47,
+	0x34, 0x10, 0x3c, 0x24, 0x23, 0x32, 0x6e, 0x4f, 0x03, 0xc1, 0xd9, 0x5d, 0xda, 0x9d, 0x83, 0x28,
+	0xe6, 0x26, 0x0c, 0x46, 0x64, 0x7c, 0x30, 0xad, 0x2b, 0xf6, 0x80, 0xf6, 0x39, 0x64, 0xc2, 0xcc,
+	0xd2, 0x3c, 0x47, 0xab, 0x4c, 0x78, 0x30, 0x22, 0xe3, 0x5b, 0xd3, 0x43, 0x27, 0x3e, 0xf7, 0x1a,
+	0x7b, 0x48, 0x8f, 0x72, 0x0d, 0x7c, 0x87, 0x6a, 0x3b, 0xaa, 0xef, 0xd5, 0x4b, 0x6c, 0x48, 0x7b,
+	0x95, 0xcd, 0xae, 0x98, 0x8e, 0x63, 0x68, 0x65, 0xb3, 0x4b, 0x60, 0x40, 0x3b, 0x1c, 0x14, 0xca,
+	0xb0, 0xeb, 0xae, 0x7c, 0xc1, 0x5e, 0xd2, 0x6e, 0x2a, 0x5d, 0xc7, 0x8d, 0xad, 0x3c, 0x79, 0x72,
+	0x76, 0x31, 0x6c, 0xfd, 0xba, 0x18, 0xde, 0xf1, 0x9f, 0x5d, 0xf1, 0x45, 0x2c, 0x30, 0x91, 0xa9,
+	0x99, 0xc7, 0xa7, 0xca, 0xfc, 0xf8, 0xfe, 0x98, 0xd6, 0xf3, 0x38, 0x55, 0xe6, 0xeb, 0xdf, 0x6f,
+	0x8f, 0xc8, 0xb4, 0xee, 0x67, 0x8c, 0xb6, 0x25, 0x48, 0x0c, 0x6f, 0x3a, 0x7b, 0x77, 0x3e, 0xf9,
+	0x13, 0xd0, 0xde, 0x5b, 0x5b, 0x96, 0xcb, 0xd5, 0x3b, 0x34, 0xe9, 0xb2, 0xc9, 0x40, 0xfe, 0xcb,
+	0x20, 0x85, 0x32, 0xc0, 0xdd, 0x78, 0xf6, 0xca, 0xe0, 0xfb, 0xb7, 0x4e, 0x99, 0xd5, 0x0a, 0xb8,
+	0x9f, 0xe4, 0x3e, 0x4e, 0xbe, 0x9f, 0xbd, 0xa7, 0xfd, 0x52, 0x5b, 0x05, 0x7c, 0x56, 0x47, 0x6b,
+	0xef, 0x69, 0x78, 0xe8, 0x6d, 0x5e, 0xfb, 0x80, 0x8d, 0x6d, 0x9d, 0xb3, 0x73, 0x3d, 0xdb, 0x89,
+	0x73, 0x99, 0xbc, 0x39, 0x5b, 0x47, 0xe4, 0x7c, 0x1d, 0x91, 0xdf, 0xeb, 0x88, 0x7c, 0xde, 0x44,
+	0xad, 0xf3, 0x4d, 0xd4, 0xfa, 0xb9, 0x89, 0x5a, 0x1f, 0x9e, 0x15, 0xc2, 0xcc, 0x6d, 0x16, 0xe7,
+	0x28, 0x93, 0x52, 0x23, 0xb7, 0xb9, 0xa9, 0x72, 0xe1, 0xf6, 0xa4, 0xd9, 0x98, 0x4f, 0xbb, 0x3b,
+	0x63, 0x56, 0x25, 0x54, 0x59, 0xd7, 0xfd, 0xd9, 0x4f, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x55,
+	0x9e, 0x7d, 0x39, 0x58, 0x03, 0x00, 0x00,
+}
+
+func (m *LedgerEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LedgerEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LedgerEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintLedger(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLedger(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLedger(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SubAccount) > 0 {
+		i -= len(m.SubAccount)
+		copy(dAtA[i:], m.SubAccount)
+		i = encodeVarintLedger(dAtA, i, uint64(len(m.SubAccount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CreditAccount) > 0 {
+		i -= len(m.CreditAccount)
+		copy(dAtA[i:], m.CreditAccount)
+		i = encodeVarintLedger(dAtA, i, uint64(len(m.CreditAccount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DebitAccount) > 0 {
+		i -= len(m.DebitAccount)
+		copy(dAtA[i:], m.DebitAccount)
+		i = encodeVarintLedger(dAtA, i, uint64(len(m.DebitAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintLedger(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintLedger(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PrunedBurned.Size()
+		i -= size
+		if _, err := m.PrunedBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLedger(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PrunedMinted.Size()
+		i -= size
+		if _, err := m.PrunedMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLedger(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Burned.Size()
+		i -= size
+		if _, err := m.Burned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLedger(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLedger(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLedger(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLedger(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLedger(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LedgerEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLedger(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLedger(uint64(m.Height))
+	}
+	l = len(m.DebitAccount)
+	if l > 0 {
+		n += 1 + l + sovLedger(uint64(l))
+	}
+	l = len(m.CreditAccount)
+	if l > 0 {
+		n += 1 + l + sovLedger(uint64(l))
+	}
+	l = len(m.SubAccount)
+	if l > 0 {
+		n += 1 + l + sovLedger(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLedger(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLedger(uint64(l))
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovLedger(uint64(l))
+	}
+	return n
+}
+
+func (m *SupplyTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLedger(uint64(l))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovLedger(uint64(l))
+	l = m.Burned.Size()
+	n += 1 + l + sovLedger(uint64(l))
+	l = m.PrunedMinted.Size()
+	n += 1 + l + sovLedger(uint64(l))
+	l = m.PrunedBurned.Size()
+	n += 1 + l + sovLedger(uint64(l))
+	return n
+}
+
+func sovLedger(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLedger(x uint64) (n int) {
+	return sovLedger(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LedgerEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLedger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LedgerEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LedgerEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebitAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DebitAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLedger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLedger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLedger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrunedMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrunedBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLedger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLedger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLedger(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLedger
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLedger
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLedger
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLedger
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLedger
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLedger
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLedger        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLedger          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLedger = fmt.Errorf("proto: unexpected end of group")
+)
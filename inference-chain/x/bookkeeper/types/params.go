@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -18,7 +20,9 @@ func NewParams() Params {
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return Params{}
+	return Params{
+		LedgerRetentionBlocks: 100_000,
+	}
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -28,5 +32,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if p.LedgerRetentionBlocks < 0 {
+		return fmt.Errorf("ledger retention blocks cannot be negative")
+	}
 	return nil
 }
@@ -0,0 +1,267 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/collateral/types"
+	inferencetypes "github.com/productscience/inference/x/inference/types"
+)
+
+// AddDelegation adds amount to the collateral a delegator has delegated to a host
+func (k Keeper) AddDelegation(ctx context.Context, delegatorAddress sdk.AccAddress, hostAddress sdk.AccAddress, amount sdk.Coin) types.CollateralDelegation {
+	delegation, found := k.GetDelegation(ctx, delegatorAddress, hostAddress)
+	if found {
+		delegation.Amount = delegation.Amount.Add(amount)
+	} else {
+		delegation = types.CollateralDelegation{
+			Delegator: delegatorAddress.String(),
+			Host:      hostAddress.String(),
+			Amount:    amount,
+		}
+	}
+	k.setDelegation(ctx, delegation)
+	return delegation
+}
+
+// setDelegation writes a delegation to the store, removing it once nothing is left delegated.
+func (k Keeper) setDelegation(ctx context.Context, delegation types.CollateralDelegation) {
+	delegatorAddr, err := sdk.AccAddressFromBech32(delegation.Delegator)
+	if err != nil {
+		panic(err)
+	}
+	hostAddr, err := sdk.AccAddressFromBech32(delegation.Host)
+	if err != nil {
+		panic(err)
+	}
+	pk := collections.Join(hostAddr, delegatorAddr)
+	if delegation.Amount.IsZero() {
+		if err := k.Delegations.Remove(ctx, pk); err != nil {
+			panic(err)
+		}
+		return
+	}
+	if err := k.Delegations.Set(ctx, pk, delegation); err != nil {
+		panic(err)
+	}
+}
+
+// GetDelegation retrieves the collateral a delegator has delegated to a host
+func (k Keeper) GetDelegation(ctx context.Context, delegatorAddress sdk.AccAddress, hostAddress sdk.AccAddress) (types.CollateralDelegation, bool) {
+	delegation, err := k.Delegations.Get(ctx, collections.Join(hostAddress, delegatorAddress))
+	if err != nil {
+		return types.CollateralDelegation{}, false
+	}
+	return delegation, true
+}
+
+// GetDelegationsToHost returns all delegations backing a host
+func (k Keeper) GetDelegationsToHost(ctx context.Context, hostAddress sdk.AccAddress) []types.CollateralDelegation {
+	iter, err := k.Delegations.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](hostAddress))
+	if err != nil {
+		panic(err)
+	}
+	defer iter.Close()
+	var list []types.CollateralDelegation
+	for ; iter.Valid(); iter.Next() {
+		v, err := iter.Value()
+		if err != nil {
+			panic(err)
+		}
+		list = append(list, v)
+	}
+	return list
+}
+
+// GetDelegationsByDelegator returns all delegations made by a delegator
+func (k Keeper) GetDelegationsByDelegator(ctx context.Context, delegatorAddress sdk.AccAddress) []types.CollateralDelegation {
+	idxIter, err := k.Delegations.Indexes.ByDelegator.MatchExact(ctx, delegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	defer idxIter.Close()
+	var list []types.CollateralDelegation
+	for ; idxIter.Valid(); idxIter.Next() {
+		pk, err := idxIter.PrimaryKey()
+		if err != nil {
+			panic(err)
+		}
+		v, err := k.Delegations.Get(ctx, pk)
+		if err != nil {
+			panic(err)
+		}
+		list = append(list, v)
+	}
+	return list
+}
+
+// GetAllDelegations returns all delegations (for genesis export)
+func (k Keeper) GetAllDelegations(ctx context.Context) []types.CollateralDelegation {
+	iter, err := k.Delegations.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	defer iter.Close()
+	var list []types.CollateralDelegation
+	for ; iter.Valid(); iter.Next() {
+		v, err := iter.Value()
+		if err != nil {
+			panic(err)
+		}
+		list = append(list, v)
+	}
+	return list
+}
+
+// GetDelegatedCollateral returns the total collateral delegated to a host
+func (k Keeper) GetDelegatedCollateral(ctx context.Context, hostAddress sdk.AccAddress) sdk.Coin {
+	total := sdk.NewCoin(inferencetypes.BaseCoin, math.ZeroInt())
+	for _, delegation := range k.GetDelegationsToHost(ctx, hostAddress) {
+		total = total.Add(delegation.Amount)
+	}
+	return total
+}
+
+// SetHostCommission stores a host's commission on delegator rewards
+func (k Keeper) SetHostCommission(ctx context.Context, commission types.HostCommission) {
+	hostAddr, err := sdk.AccAddressFromBech32(commission.Host)
+	if err != nil {
+		panic(err)
+	}
+	if err := k.HostCommissions.Set(ctx, hostAddr, commission); err != nil {
+		panic(err)
+	}
+}
+
+// GetHostCommission retrieves a host's commission
+func (k Keeper) GetHostCommission(ctx context.Context, hostAddress sdk.AccAddress) (types.HostCommission, bool) {
+	commission, err := k.HostCommissions.Get(ctx, hostAddress)
+	if err != nil {
+		return types.HostCommission{}, false
+	}
+	return commission, true
+}
+
+// GetCommissionRate returns the share of delegator rewards a host keeps, zero if it never set one
+func (k Keeper) GetCommissionRate(ctx context.Context, hostAddress sdk.AccAddress) math.LegacyDec {
+	commission, found := k.GetHostCommission(ctx, hostAddress)
+	if !found || commission.Rate.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return commission.Rate
+}
+
+// GetAllHostCommissions returns all host commissions (for genesis export)
+func (k Keeper) GetAllHostCommissions(ctx context.Context) []types.HostCommission {
+	iter, err := k.HostCommissions.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	list, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return list
+}
+
+// AddUnbondingDelegation queues amount undelegated from a host for release at the end of completionEpoch,
+// adding to the entry if the delegator already undelegated from the host for the same epoch.
+func (k Keeper) AddUnbondingDelegation(ctx context.Context, delegatorAddress sdk.AccAddress, hostAddress sdk.AccAddress, completionEpoch uint64, amount sdk.Coin) {
+	existing, err := k.UnbondingDelegations.Get(ctx, collections.Join3(completionEpoch, hostAddress, delegatorAddress))
+	if err == nil {
+		amount = amount.Add(existing.Amount)
+	}
+	k.setUnbondingDelegation(ctx, types.UnbondingDelegation{
+		Delegator:       delegatorAddress.String(),
+		Host:            hostAddress.String(),
+		CompletionEpoch: completionEpoch,
+		Amount:          amount,
+	})
+}
+
+// setUnbondingDelegation writes an unbonding delegation to the store, removing it once nothing is left.
+func (k Keeper) setUnbondingDelegation(ctx context.Context, unbonding types.UnbondingDelegation) {
+	delegatorAddr, err := sdk.AccAddressFromBech32(unbonding.Delegator)
+	if err != nil {
+		panic(err)
+	}
+	hostAddr, err := sdk.AccAddressFromBech32(unbonding.Host)
+	if err != nil {
+		panic(err)
+	}
+	pk := collections.Join3(unbonding.CompletionEpoch, hostAddr, delegatorAddr)
+	if unbonding.Amount.IsZero() {
+		if err := k.UnbondingDelegations.Remove(ctx, pk); err != nil {
+			panic(err)
+		}
+		return
+	}
+	if err := k.UnbondingDelegations.Set(ctx, pk, unbonding); err != nil {
+		panic(err)
+	}
+}
+
+// GetUnbondingDelegationsToHost returns the collateral being undelegated from a host
+func (k Keeper) GetUnbondingDelegationsToHost(ctx context.Context, hostAddress sdk.AccAddress) []types.UnbondingDelegation {
+	return k.unbondingDelegationsByIndex(ctx, k.UnbondingDelegations.Indexes.ByHost, hostAddress)
+}
+
+// GetUnbondingDelegationsByDelegator returns the collateral a delegator is undelegating from any host
+func (k Keeper) GetUnbondingDelegationsByDelegator(ctx context.Context, delegatorAddress sdk.AccAddress) []types.UnbondingDelegation {
+	return k.unbondingDelegationsByIndex(ctx, k.UnbondingDelegations.Indexes.ByDelegator, delegatorAddress)
+}
+
+func (k Keeper) unbondingDelegationsByIndex(
+	ctx context.Context,
+	index *indexes.Multi[sdk.AccAddress, collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], types.UnbondingDelegation],
+	address sdk.AccAddress,
+) []types.UnbondingDelegation {
+	idxIter, err := index.MatchExact(ctx, address)
+	if err != nil {
+		panic(err)
+	}
+	defer idxIter.Close()
+	var list []types.UnbondingDelegation
+	for ; idxIter.Valid(); idxIter.Next() {
+		pk, err := idxIter.PrimaryKey()
+		if err != nil {
+			panic(err)
+		}
+		v, err := k.UnbondingDelegations.Get(ctx, pk)
+		if err != nil {
+			panic(err)
+		}
+		list = append(list, v)
+	}
+	return list
+}
+
+// GetUnbondingDelegationsByEpoch returns the undelegated collateral released at the end of completionEpoch
+func (k Keeper) GetUnbondingDelegationsByEpoch(ctx context.Context, completionEpoch uint64) []types.UnbondingDelegation {
+	iter, err := k.UnbondingDelegations.Iterate(ctx, collections.NewPrefixedTripleRange[uint64, sdk.AccAddress, sdk.AccAddress](completionEpoch))
+	if err != nil {
+		panic(err)
+	}
+	list, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return list
+}
+
+// GetAllUnbondingDelegations returns all unbonding delegations (for genesis export)
+func (k Keeper) GetAllUnbondingDelegations(ctx context.Context) []types.UnbondingDelegation {
+	iter, err := k.UnbondingDelegations.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	list, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return list
+}
@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/productscience/inference/x/collateral/types"
+	inftypes "github.com/productscience/inference/x/inference/types"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *KeeperTestSuite) TestMsgDelegateCollateral_Success() {
+	delegatorStr, hostStr := sample.AccAddress(), sample.AccAddress()
+	delegator := sdk.MustAccAddressFromBech32(delegatorStr)
+	host := sdk.MustAccAddressFromBech32(hostStr)
+	amount := sdk.NewInt64Coin(inftypes.BaseCoin, 300)
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(s.ctx, delegator, types.ModuleName, sdk.NewCoins(amount), "collateral delegation").
+		Return(nil).
+		Times(2)
+	s.bankKeeper.EXPECT().
+		LogSubAccountTransaction(s.ctx, types.ModuleName, delegatorStr, types.SubAccountDelegated, amount, "collateral delegation").
+		Times(2)
+
+	msg := types.NewMsgDelegateCollateral(delegatorStr, hostStr, amount)
+	_, err := s.msgServer.DelegateCollateral(s.ctx, msg)
+	s.Require().NoError(err)
+	_, err = s.msgServer.DelegateCollateral(s.ctx, msg)
+	s.Require().NoError(err)
+
+	delegation, found := s.k.GetDelegation(s.ctx, delegator, host)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt64Coin(inftypes.BaseCoin, 600), delegation.Amount)
+	s.Require().Equal(sdk.NewInt64Coin(inftypes.BaseCoin, 600), s.k.GetDelegatedCollateral(s.ctx, host))
+
+	// The host's own collateral is untouched
+	_, found = s.k.GetCollateral(s.ctx, host)
+	s.Require().False(found)
+
+	byDelegator := s.k.GetDelegationsByDelegator(s.ctx, delegator)
+	s.Require().Len(byDelegator, 1)
+	s.Require().Equal(hostStr, byDelegator[0].Host)
+}
+
+func (s *KeeperTestSuite) TestMsgDelegateCollateral_Rejected() {
+	addr := sample.AccAddress()
+	_, err := s.msgServer.DelegateCollateral(s.ctx, types.NewMsgDelegateCollateral(addr, addr, sdk.NewInt64Coin(inftypes.BaseCoin, 1)))
+	s.Require().ErrorIs(err, types.ErrSelfDelegation)
+
+	_, err = s.msgServer.DelegateCollateral(s.ctx, types.NewMsgDelegateCollateral(addr, sample.AccAddress(), sdk.NewInt64Coin("uatom", 1)))
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+}
+
+func (s *KeeperTestSuite) TestMsgUndelegateCollateral() {
+	delegatorStr, hostStr := sample.AccAddress(), sample.AccAddress()
+	delegator := sdk.MustAccAddressFromBech32(delegatorStr)
+	host := sdk.MustAccAddressFromBech32(hostStr)
+	s.k.AddDelegation(s.ctx, delegator, host, sdk.NewInt64Coin(inftypes.BaseCoin, 500))
+	s.k.SetCurrentEpoch(s.ctx, 10)
+
+	tooMuch := types.NewMsgUndelegateCollateral(delegatorStr, hostStr, sdk.NewInt64Coin(inftypes.BaseCoin, 501))
+	_, err := s.msgServer.UndelegateCollateral(s.ctx, tooMuch)
+	s.Require().ErrorIs(err, types.ErrInsufficientCollateral)
+
+	_, err = s.msgServer.UndelegateCollateral(s.ctx, types.NewMsgUndelegateCollateral(delegatorStr, sample.AccAddress(), sdk.NewInt64Coin(inftypes.BaseCoin, 1)))
+	s.Require().ErrorIs(err, types.ErrNoDelegationFound)
+
+	s.bankKeeper.EXPECT().LogSubAccountTransaction(s.ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), "delegation to unbonding").Times(4)
+
+	res, err := s.msgServer.UndelegateCollateral(s.ctx, types.NewMsgUndelegateCollateral(delegatorStr, hostStr, sdk.NewInt64Coin(inftypes.BaseCoin, 200)))
+	s.Require().NoError(err)
+	expectedCompletionEpoch := uint64(10) + s.k.GetParams(s.ctx).UnbondingPeriodEpochs
+	s.Require().Equal(expectedCompletionEpoch, res.CompletionEpoch)
+	s.Require().Equal(math.NewInt(300), s.k.GetDelegatedCollateral(s.ctx, host).Amount)
+
+	// The funds unbond for the delegator but stay tied to the host, not to the delegator's own collateral
+	s.Require().Empty(s.k.GetUnbondingByParticipant(s.ctx, delegator))
+	unbonding := s.k.GetUnbondingDelegationsToHost(s.ctx, host)
+	s.Require().Len(unbonding, 1)
+	s.Require().Equal(delegatorStr, unbonding[0].Delegator)
+	s.Require().Equal(expectedCompletionEpoch, unbonding[0].CompletionEpoch)
+	s.Require().Equal(math.NewInt(200), unbonding[0].Amount.Amount)
+	s.Require().Equal(unbonding, s.k.GetUnbondingDelegationsByDelegator(s.ctx, delegator))
+
+	// Undelegating the rest removes the delegation
+	_, err = s.msgServer.UndelegateCollateral(s.ctx, types.NewMsgUndelegateCollateral(delegatorStr, hostStr, sdk.NewInt64Coin(inftypes.BaseCoin, 300)))
+	s.Require().NoError(err)
+	_, found := s.k.GetDelegation(s.ctx, delegator, host)
+	s.Require().False(found)
+	s.Require().Empty(s.k.GetDelegationsByDelegator(s.ctx, delegator))
+
+	// Both undelegations complete together and are released to the delegator
+	released := sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 500))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, types.ModuleName, delegator, released, "collateral undelegated").Return(nil)
+	s.bankKeeper.EXPECT().LogSubAccountTransaction(s.ctx, delegatorStr, types.ModuleName, types.SubAccountUnbonding, released[0], "collateral undelegated")
+	s.k.ProcessUnbondingQueue(s.ctx, expectedCompletionEpoch)
+	s.Require().Empty(s.k.GetUnbondingDelegationsToHost(s.ctx, host))
+	s.Require().Empty(s.k.GetUnbondingDelegationsByDelegator(s.ctx, delegator))
+}
+
+func (s *KeeperTestSuite) TestMsgSetCollateralCommission() {
+	hostStr := sample.AccAddress()
+	host := sdk.MustAccAddressFromBech32(hostStr)
+	s.k.SetCurrentEpoch(s.ctx, 3)
+	s.Require().True(s.k.GetCommissionRate(s.ctx, host).IsZero())
+
+	rate := math.LegacyNewDecWithPrec(15, 2)
+	_, err := s.msgServer.SetCollateralCommission(s.ctx, types.NewMsgSetCollateralCommission(hostStr, rate))
+	s.Require().NoError(err)
+	s.Require().Equal(rate, s.k.GetCommissionRate(s.ctx, host))
+
+	// Only one change per epoch
+	_, err = s.msgServer.SetCollateralCommission(s.ctx, types.NewMsgSetCollateralCommission(hostStr, math.LegacyOneDec()))
+	s.Require().ErrorIs(err, types.ErrCommissionUpdateTooSoon)
+
+	s.k.SetCurrentEpoch(s.ctx, 4)
+	_, err = s.msgServer.SetCollateralCommission(s.ctx, types.NewMsgSetCollateralCommission(hostStr, math.LegacyNewDecWithPrec(11, 1)))
+	s.Require().ErrorIs(err, types.ErrInvalidCommissionRate)
+	_, err = s.msgServer.SetCollateralCommission(s.ctx, types.NewMsgSetCollateralCommission(hostStr, math.LegacyNewDecWithPrec(2, 1)))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestSlashing_DelegatedCollateral() {
+	hostStr := sample.AccAddress()
+	host := sdk.MustAccAddressFromBech32(hostStr)
+	delegator1 := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	delegator2 := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	s.k.SetCollateral(s.ctx, host, sdk.NewInt64Coin(inftypes.BaseCoin, 1000))
+	s.k.AddDelegation(s.ctx, delegator1, host, sdk.NewInt64Coin(inftypes.BaseCoin, 400))
+	s.k.AddDelegation(s.ctx, delegator2, host, sdk.NewInt64Coin(inftypes.BaseCoin, 100))
+
+	// 10% of 1000 + 400 + 100
+	s.bankKeeper.EXPECT().
+		BurnCoins(s.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 150)), "collateral slashed").
+		Return(nil).
+		Times(1)
+
+	slashed, err := s.k.Slash(s.ctx, host, math.LegacyNewDecWithPrec(10, 2))
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(150), slashed.Amount)
+
+	d1, found := s.k.GetDelegation(s.ctx, delegator1, host)
+	s.Require().True(found)
+	s.Require().Equal(math.NewInt(360), d1.Amount.Amount)
+	d2, found := s.k.GetDelegation(s.ctx, delegator2, host)
+	s.Require().True(found)
+	s.Require().Equal(math.NewInt(90), d2.Amount.Amount)
+
+	// Collateral being undelegated from the host is still slashed with it
+	s.k.AddUnbondingDelegation(s.ctx, delegator2, host, 20, sdk.NewInt64Coin(inftypes.BaseCoin, 90))
+	s.bankKeeper.EXPECT().
+		BurnCoins(s.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 45+18+4+4)), "collateral slashed").
+		Return(nil).
+		Times(1)
+	slashed, err = s.k.Slash(s.ctx, host, math.LegacyNewDecWithPrec(5, 2))
+	s.Require().NoError(err)
+	unbonding := s.k.GetUnbondingDelegationsToHost(s.ctx, host)
+	s.Require().Len(unbonding, 1)
+	s.Require().Equal(math.NewInt(86), unbonding[0].Amount.Amount)
+
+	// Slashing the delegator itself does not touch what it delegated
+	s.k.SetCollateral(s.ctx, delegator1, sdk.NewInt64Coin(inftypes.BaseCoin, 100))
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	_, err = s.k.Slash(s.ctx, delegator1, math.LegacyNewDecWithPrec(50, 2))
+	s.Require().NoError(err)
+	d1, _ = s.k.GetDelegation(s.ctx, delegator1, host)
+	s.Require().Equal(math.NewInt(342), d1.Amount.Amount)
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/productscience/inference/x/collateral/types"
+)
+
+// RegisterInvariants registers the collateral module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-collateral", ModuleCollateralInvariant(k))
+}
+
+// ModuleCollateralInvariant checks that the collateral module account holds at least the active, delegated and
+// unbonding collateral. The account is blocked from plain sends, so coins only enter it as a deposit or delegation
+// and only leave it as a withdrawal or a slash. Coins that reached it otherwise are reported as surplus.
+func ModuleCollateralInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		tracked := k.TrackedCollateral(ctx)
+		balance := k.bankViewKeeper.SpendableCoins(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		surplus, broken := balance.SafeSub(tracked...)
+		return sdk.FormatInvariant(types.ModuleName, "module-collateral", fmt.Sprintf(
+			"\tmodule account balance: %s\n\tactive, delegated and unbonding collateral: %s\n\tsurplus: %s\n",
+			balance, tracked, surplus)), broken
+	}
+}
+
+// TrackedCollateral returns the sum of all active, delegated and unbonding collateral.
+func (k Keeper) TrackedCollateral(ctx sdk.Context) sdk.Coins {
+	tracked := sdk.NewCoins()
+	k.IterateCollaterals(ctx, func(_ sdk.AccAddress, amount sdk.Coin) bool {
+		tracked = tracked.Add(amount)
+		return false
+	})
+	for _, delegation := range k.GetAllDelegations(ctx) {
+		tracked = tracked.Add(delegation.Amount)
+	}
+	for _, unbonding := range k.GetAllUnbondings(ctx) {
+		tracked = tracked.Add(unbonding.Amount)
+	}
+	for _, unbonding := range k.GetAllUnbondingDelegations(ctx) {
+		tracked = tracked.Add(unbonding.Amount)
+	}
+	return tracked
+}
@@ -0,0 +1,22 @@
+package keeper_test
+
+import (
+	"github.com/productscience/inference/testutil/sample"
+	inftypes "github.com/productscience/inference/x/inference/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestTrackedCollateral() {
+	participant := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	delegator := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	s.Require().True(s.k.TrackedCollateral(s.ctx).IsZero())
+
+	s.k.SetCollateral(s.ctx, participant, sdk.NewInt64Coin(inftypes.BaseCoin, 1_000))
+	s.k.AddDelegation(s.ctx, delegator, participant, sdk.NewInt64Coin(inftypes.BaseCoin, 400))
+	s.k.AddUnbondingCollateral(s.ctx, participant, 5, sdk.NewInt64Coin(inftypes.BaseCoin, 250))
+	s.k.AddUnbondingCollateral(s.ctx, delegator, 6, sdk.NewInt64Coin(inftypes.BaseCoin, 50))
+
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 1_700)), s.k.TrackedCollateral(s.ctx))
+}
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/collateral/types"
+	inferencetypes "github.com/productscience/inference/x/inference/types"
+)
+
+// Jail marks a participant as jailed and appends a record to its jail history.
+// requiredCollateral is the active collateral the participant must keep before it may unjail itself.
+func (k Keeper) Jail(ctx context.Context, participantAddress sdk.AccAddress, reason string, slashed sdk.Coin, requiredCollateral sdk.Coin) types.JailRecord {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	id, err := k.JailRecordSeq.Next(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if slashed.Denom == "" {
+		slashed = sdk.NewCoin(inferencetypes.BaseCoin, math.ZeroInt())
+	}
+	if requiredCollateral.Denom == "" {
+		requiredCollateral = sdk.NewCoin(inferencetypes.BaseCoin, math.ZeroInt())
+	}
+	record := types.JailRecord{
+		Participant:        participantAddress.String(),
+		Id:                 id,
+		Reason:             reason,
+		JailedEpoch:        k.currentEpochOrZero(ctx),
+		SlashedAmount:      slashed,
+		RequiredCollateral: requiredCollateral,
+	}
+	k.SetJailRecord(ctx, record)
+	k.SetJailed(sdkCtx, participantAddress)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJail,
+			sdk.NewAttribute(types.AttributeKeyParticipant, record.Participant),
+			sdk.NewAttribute(types.AttributeKeyJailReason, reason),
+			sdk.NewAttribute(types.AttributeKeySlashAmount, slashed.String()),
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(record.JailedEpoch, 10)),
+		),
+	)
+	k.Logger().Info("participant jailed",
+		"participant", record.Participant,
+		"reason", reason,
+		"slashed_amount", slashed.String(),
+		"required_collateral", requiredCollateral.String(),
+		"epoch", record.JailedEpoch,
+	)
+	return record
+}
+
+// Release closes the participant's open jail records matching the filter. The participant stays
+// jailed as long as any other record is still open. It returns the records that were released.
+func (k Keeper) Release(ctx context.Context, participantAddress sdk.AccAddress, filter func(record types.JailRecord) bool) []types.JailRecord {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	epoch := k.currentEpochOrZero(ctx)
+	var released []types.JailRecord
+	stillJailed := false
+	for _, record := range k.GetOpenJailRecords(ctx, participantAddress) {
+		if !filter(record) {
+			stillJailed = true
+			continue
+		}
+		record.Released = true
+		record.ReleasedEpoch = epoch
+		k.SetJailRecord(ctx, record)
+		released = append(released, record)
+	}
+	if !stillJailed {
+		k.RemoveJailed(sdkCtx, participantAddress)
+	}
+	return released
+}
+
+// SetJailRecord writes a jail record to the store
+func (k Keeper) SetJailRecord(ctx context.Context, record types.JailRecord) {
+	participantAddr, err := sdk.AccAddressFromBech32(record.Participant)
+	if err != nil {
+		panic(err)
+	}
+	if err := k.JailRecords.Set(ctx, collections.Join(participantAddr, record.Id), record); err != nil {
+		panic(err)
+	}
+}
+
+// GetJailHistory returns all jail records of a participant, oldest first
+func (k Keeper) GetJailHistory(ctx context.Context, participantAddress sdk.AccAddress) []types.JailRecord {
+	iter, err := k.JailRecords.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](participantAddress))
+	if err != nil {
+		panic(err)
+	}
+	defer iter.Close()
+	var list []types.JailRecord
+	for ; iter.Valid(); iter.Next() {
+		v, err := iter.Value()
+		if err != nil {
+			panic(err)
+		}
+		list = append(list, v)
+	}
+	return list
+}
+
+// GetOpenJailRecords returns the jail records of a participant that have not been released yet
+func (k Keeper) GetOpenJailRecords(ctx context.Context, participantAddress sdk.AccAddress) []types.JailRecord {
+	var open []types.JailRecord
+	for _, record := range k.GetJailHistory(ctx, participantAddress) {
+		if !record.Released {
+			open = append(open, record)
+		}
+	}
+	return open
+}
+
+// IsJailedForInference reports whether the participant has an open jail record other than a
+// consensus one. Consensus jails are left to x/staking so that the validator can still bond again.
+func (k Keeper) IsJailedForInference(ctx context.Context, participantAddress sdk.AccAddress) bool {
+	for _, record := range k.GetOpenJailRecords(ctx, participantAddress) {
+		if record.Reason != types.JailReasonConsensus {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAllJailRecords returns the jail history of all participants (for genesis export)
+func (k Keeper) GetAllJailRecords(ctx context.Context) []types.JailRecord {
+	iter, err := k.JailRecords.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	list, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return list
+}
+
+// currentEpochOrZero is GetCurrentEpoch for callers such as staking hooks that may run before the
+// inference module advanced the first epoch.
+func (k Keeper) currentEpochOrZero(ctx context.Context) uint64 {
+	epoch, err := k.CurrentEpoch.Get(ctx)
+	if err != nil {
+		return 0
+	}
+	return epoch
+}
@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"github.com/productscience/inference/testutil/sample"
+	collateralmodule "github.com/productscience/inference/x/collateral/module"
+	"github.com/productscience/inference/x/collateral/types"
+	inftypes "github.com/productscience/inference/x/inference/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestMsgUnjail_AfterEquivocation() {
+	participantStr := sample.AccAddress()
+	participant := sdk.MustAccAddressFromBech32(participantStr)
+	params := types.DefaultParams()
+	params.MinJailEpochs = 2
+	s.Require().NoError(s.k.SetParams(s.ctx, params))
+
+	s.k.SetCurrentEpoch(s.ctx, 10)
+	s.k.SetCollateral(s.ctx, participant, sdk.NewInt64Coin(inftypes.BaseCoin, 800))
+	s.k.Jail(s.ctx, participant, types.JailReasonEquivocation, sdk.NewInt64Coin(inftypes.BaseCoin, 100), sdk.NewInt64Coin(inftypes.BaseCoin, 900))
+	s.Require().True(s.k.IsJailed(s.ctx, participant))
+	s.Require().True(s.k.IsJailedForInference(s.ctx, participant))
+
+	msg := types.NewMsgUnjail(participantStr)
+
+	// Minimum jail duration
+	s.k.SetCurrentEpoch(s.ctx, 11)
+	_, err := s.msgServer.Unjail(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrJailPeriodNotOver)
+
+	// Collateral withdrawn below what the slash left
+	s.k.SetCurrentEpoch(s.ctx, 12)
+	_, err = s.msgServer.Unjail(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInsufficientCollateral)
+
+	s.k.SetCollateral(s.ctx, participant, sdk.NewInt64Coin(inftypes.BaseCoin, 900))
+	resp, err := s.msgServer.Unjail(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(13), resp.WeightEpoch)
+	s.Require().False(s.k.IsJailed(s.ctx, participant))
+	s.Require().False(s.k.IsJailedForInference(s.ctx, participant))
+
+	_, err = s.msgServer.Unjail(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrNotJailed)
+
+	history, err := s.k.JailHistory(s.ctx, &types.QueryJailHistoryRequest{Participant: participantStr})
+	s.Require().NoError(err)
+	s.Require().False(history.Jailed)
+	s.Require().Len(history.Records, 1)
+	record := history.Records[0]
+	s.Require().Equal(types.JailReasonEquivocation, record.Reason)
+	s.Require().Equal(uint64(10), record.JailedEpoch)
+	s.Require().Equal(sdk.NewInt64Coin(inftypes.BaseCoin, 100), record.SlashedAmount)
+	s.Require().True(record.Released)
+	s.Require().Equal(uint64(12), record.ReleasedEpoch)
+}
+
+func (s *KeeperTestSuite) TestMsgUnjail_LeavesConsensusJail() {
+	valAddr, participant := sample.AccAddressAndValAddress()
+	participantStr := participant.String()
+	hooks := collateralmodule.NewStakingHooks(s.k)
+	s.k.SetCurrentEpoch(s.ctx, 5)
+
+	// Only x/slashing can lift a consensus jail
+	s.Require().NoError(hooks.AfterValidatorBeginUnbonding(s.ctx, nil, valAddr))
+	s.Require().False(s.k.IsJailedForInference(s.ctx, participant))
+	_, err := s.msgServer.Unjail(s.ctx, types.NewMsgUnjail(participantStr))
+	s.Require().ErrorIs(err, types.ErrJailedByConsensus)
+
+	// An equivocation jail on top is lifted by MsgUnjail, the consensus one stays until the validator bonds
+	s.k.Jail(s.ctx, participant, types.JailReasonEquivocation, sdk.NewInt64Coin(inftypes.BaseCoin, 0), sdk.NewInt64Coin(inftypes.BaseCoin, 0))
+	s.k.SetCurrentEpoch(s.ctx, 5+types.DefaultMinJailEpochs)
+	_, err = s.msgServer.Unjail(s.ctx, types.NewMsgUnjail(participantStr))
+	s.Require().NoError(err)
+	s.Require().False(s.k.IsJailedForInference(s.ctx, participant))
+	s.Require().True(s.k.IsJailed(s.ctx, participant))
+
+	s.Require().NoError(hooks.AfterValidatorBonded(s.ctx, nil, valAddr))
+	s.Require().False(s.k.IsJailed(s.ctx, participant))
+	s.Require().Empty(s.k.GetOpenJailRecords(s.ctx, participant))
+	s.Require().Len(s.k.GetJailHistory(s.ctx, participant), 2)
+}
+
+func (s *KeeperTestSuite) TestGenesis_JailRecords() {
+	participant := sample.AccAddress()
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		JailedParticipantList: []*types.JailedParticipant{
+			{Address: participant},
+		},
+		JailRecordList: []types.JailRecord{
+			{Participant: participant, Id: 0, Reason: types.JailReasonEquivocation, JailedEpoch: 3, SlashedAmount: sdk.NewInt64Coin(inftypes.BaseCoin, 10), RequiredCollateral: sdk.NewInt64Coin(inftypes.BaseCoin, 100), Released: true, ReleasedEpoch: 5},
+			{Participant: participant, Id: 4, Reason: types.JailReasonEquivocation, JailedEpoch: 8, SlashedAmount: sdk.NewInt64Coin(inftypes.BaseCoin, 9), RequiredCollateral: sdk.NewInt64Coin(inftypes.BaseCoin, 90)},
+		},
+	}
+	s.Require().NoError(genesisState.Validate())
+	collateralmodule.InitGenesis(s.ctx, s.k, genesisState)
+
+	exported := collateralmodule.ExportGenesis(s.ctx, s.k)
+	s.Require().Equal(genesisState.JailRecordList, exported.JailRecordList)
+	s.Require().True(s.k.IsJailedForInference(s.ctx, sdk.MustAccAddressFromBech32(participant)))
+
+	// New records continue after the imported ids
+	record := s.k.Jail(s.ctx, sdk.MustAccAddressFromBech32(participant), types.JailReasonEquivocation, sdk.Coin{}, sdk.Coin{})
+	s.Require().Equal(uint64(5), record.Id)
+}
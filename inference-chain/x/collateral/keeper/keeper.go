@@ -24,6 +24,20 @@ type (
 		ByParticipant *indexes.ReversePair[uint64, sdk.AccAddress, types.UnbondingCollateral]
 	}
 
+	// DelegationIndexes groups the secondary indexes for the Delegations map
+	DelegationIndexes struct {
+		// ByDelegator indexes primary keys by delegator address, to allow queries by delegator
+		ByDelegator *indexes.ReversePair[sdk.AccAddress, sdk.AccAddress, types.CollateralDelegation]
+	}
+
+	// UnbondingDelegationIndexes groups the secondary indexes for the UnbondingDelegations map
+	UnbondingDelegationIndexes struct {
+		// ByHost indexes primary keys by host address, so that slashing a host finds them
+		ByHost *indexes.Multi[sdk.AccAddress, collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], types.UnbondingDelegation]
+		// ByDelegator indexes primary keys by delegator address, to allow queries by delegator
+		ByDelegator *indexes.Multi[sdk.AccAddress, collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], types.UnbondingDelegation]
+	}
+
 	Keeper struct {
 		cdc          codec.BinaryCodec
 		storeService store.KVStoreService
@@ -43,6 +57,17 @@ type (
 
 		// UnbondingIM is an IndexedMap with primary key Pair[completionEpoch, participant]
 		UnbondingIM collections.IndexedMap[collections.Pair[uint64, sdk.AccAddress], types.UnbondingCollateral, UnbondingIndexes]
+
+		// Delegations is an IndexedMap with primary key Pair[host, delegator]
+		Delegations     collections.IndexedMap[collections.Pair[sdk.AccAddress, sdk.AccAddress], types.CollateralDelegation, DelegationIndexes]
+		HostCommissions collections.Map[sdk.AccAddress, types.HostCommission]
+
+		// UnbondingDelegations is an IndexedMap with primary key Triple[completionEpoch, host, delegator]
+		UnbondingDelegations collections.IndexedMap[collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], types.UnbondingDelegation, UnbondingDelegationIndexes]
+
+		// JailRecords holds the jail history with primary key Pair[participant, id]
+		JailRecords   collections.Map[collections.Pair[sdk.AccAddress, uint64], types.JailRecord]
+		JailRecordSeq collections.Sequence
 	}
 )
 
@@ -69,6 +94,38 @@ func NewKeeper(
 		),
 	}
 
+	delegationIdx := DelegationIndexes{
+		ByDelegator: indexes.NewReversePair[types.CollateralDelegation](
+			sb,
+			types.DelegationByDelegatorIndexPrefix,
+			"delegation_by_delegator",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+		),
+	}
+
+	unbondingDelegationIdx := UnbondingDelegationIndexes{
+		ByHost: indexes.NewMulti(
+			sb,
+			types.UnbondingDelegationByHostIndexPrefix,
+			"unbonding_delegation_by_host",
+			sdk.AccAddressKey,
+			collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, sdk.AccAddressKey),
+			func(pk collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], _ types.UnbondingDelegation) (sdk.AccAddress, error) {
+				return pk.K2(), nil
+			},
+		),
+		ByDelegator: indexes.NewMulti(
+			sb,
+			types.UnbondingDelegationByDelegatorIndexPrefix,
+			"unbonding_delegation_by_delegator",
+			sdk.AccAddressKey,
+			collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, sdk.AccAddressKey),
+			func(pk collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], _ types.UnbondingDelegation) (sdk.AccAddress, error) {
+				return pk.K3(), nil
+			},
+		),
+	}
+
 	ak := Keeper{
 		cdc:          cdc,
 		storeService: storeService,
@@ -89,6 +146,31 @@ func NewKeeper(
 			codec.CollValue[types.UnbondingCollateral](cdc),
 			unbondingIdx,
 		),
+		Delegations: *collections.NewIndexedMap(
+			sb,
+			types.DelegationPrefix,
+			"collateral_delegation",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+			codec.CollValue[types.CollateralDelegation](cdc),
+			delegationIdx,
+		),
+		HostCommissions: collections.NewMap(sb, types.HostCommissionKey, "host_commission", sdk.AccAddressKey, codec.CollValue[types.HostCommission](cdc)),
+		UnbondingDelegations: *collections.NewIndexedMap(
+			sb,
+			types.UnbondingDelegationPrefix,
+			"unbonding_delegation",
+			collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, sdk.AccAddressKey),
+			codec.CollValue[types.UnbondingDelegation](cdc),
+			unbondingDelegationIdx,
+		),
+		JailRecords: collections.NewMap(
+			sb,
+			types.JailRecordPrefix,
+			"jail_record",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+			codec.CollValue[types.JailRecord](cdc),
+		),
+		JailRecordSeq: collections.NewSequence(sb, types.JailRecordSeqKey, "jail_record_seq"),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -186,6 +268,85 @@ func (k Keeper) RemoveUnbondingCollateral(ctx sdk.Context, participantAddress sd
 	}
 }
 
+// ReduceUnbondingCollateral takes amount out of a pending unbonding entry and returns what is left of it.
+// The entry is removed, together with its participant index, once nothing is left.
+func (k Keeper) ReduceUnbondingCollateral(ctx sdk.Context, participantAddress sdk.AccAddress, completionEpoch uint64, amount sdk.Coin) (sdk.Coin, error) {
+	entry, found := k.GetUnbondingCollateral(ctx, participantAddress, completionEpoch)
+	if !found {
+		return sdk.Coin{}, types.ErrNoUnbondingFound.Wrapf("participant %s has nothing unbonding in epoch %d", participantAddress, completionEpoch)
+	}
+	if entry.Amount.Denom != amount.Denom {
+		return sdk.Coin{}, types.ErrInvalidDenom.Wrapf("unbonding entry is in %s, got %s", entry.Amount.Denom, amount.Denom)
+	}
+	if entry.Amount.IsLT(amount) {
+		return sdk.Coin{}, types.ErrInsufficientCollateral.Wrapf("unbonding entry %s is less than %s", entry.Amount, amount)
+	}
+
+	entry.Amount = entry.Amount.Sub(amount)
+	if entry.Amount.IsZero() {
+		k.RemoveUnbondingCollateral(ctx, participantAddress, completionEpoch)
+	} else {
+		k.setUnbondingCollateralEntry(ctx, entry)
+	}
+	return entry.Amount, nil
+}
+
+// UnbondCollateral moves amount of a participant's active collateral into the unbonding queue and returns the
+// epoch it completes in. The caller must have checked that the participant has at least amount.
+func (k Keeper) UnbondCollateral(ctx context.Context, participantAddress sdk.AccAddress, amount sdk.Coin) uint64 {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentCollateral, _ := k.GetCollateral(ctx, participantAddress)
+
+	// Get the current epoch from the collateral module's own state
+	currentEpoch := k.GetCurrentEpoch(sdkCtx)
+
+	// Get the unbonding period from params
+	params := k.GetParams(ctx)
+
+	// Calculate the completion epoch
+	completionEpoch := currentEpoch + params.UnbondingPeriodEpochs
+
+	k.Logger().Info("adding unbonding entry for collateral withdrawal",
+		"participant", participantAddress.String(),
+		"amount", amount.String(),
+		"completion_epoch", completionEpoch,
+		"current_epoch", currentEpoch,
+		"unbonding_period_epochs", params.UnbondingPeriodEpochs,
+	)
+	// Create the unbonding entry
+	k.AddUnbondingCollateral(sdkCtx, participantAddress, completionEpoch, amount)
+
+	// Reduce the active collateral
+	newCollateral := currentCollateral.Sub(amount)
+	if newCollateral.IsZero() {
+		k.RemoveCollateral(ctx, participantAddress)
+	} else {
+		k.SetCollateral(ctx, participantAddress, newCollateral)
+	}
+
+	k.bookkeepingBankKeeper.LogSubAccountTransaction(ctx, participantAddress.String(), types.ModuleName, types.SubAccountCollateral, amount, "collateral to unbonding")
+	k.bookkeepingBankKeeper.LogSubAccountTransaction(ctx, types.ModuleName, participantAddress.String(), types.SubAccountUnbonding, amount, "collateral to unbonding")
+	return completionEpoch
+}
+
+// UnbondAllCollateral starts unbonding all of a participant's own collateral, as for a withdrawal of the full amount.
+// Collateral delegated to the participant stays with its delegators. Returns the amount unbonded, zero if there was none.
+func (k Keeper) UnbondAllCollateral(ctx context.Context, participantAddress sdk.AccAddress) sdk.Coin {
+	collateral, found := k.GetCollateral(ctx, participantAddress)
+	if !found || collateral.IsZero() {
+		return sdk.NewCoin(inferencetypes.BaseCoin, math.ZeroInt())
+	}
+	completionEpoch := k.UnbondCollateral(ctx, participantAddress, collateral)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeWithdrawCollateral,
+		sdk.NewAttribute(types.AttributeKeyParticipant, participantAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, collateral.String()),
+		sdk.NewAttribute(types.AttributeKeyCompletionEpoch, strconv.FormatUint(completionEpoch, 10)),
+	))
+	return collateral
+}
+
 // RemoveUnbondingByEpoch removes all unbonding entries for a specific epoch
 // This is useful for batch processing at the end of an epoch
 func (k Keeper) RemoveUnbondingByEpoch(ctx sdk.Context, completionEpoch uint64) {
@@ -321,6 +482,38 @@ func (k Keeper) ProcessUnbondingQueue(ctx sdk.Context, completionEpoch uint64) {
 	if len(unbondingEntries) > 0 {
 		k.RemoveUnbondingByEpoch(ctx, completionEpoch)
 	}
+
+	k.processUnbondingDelegations(ctx, completionEpoch)
+}
+
+// processUnbondingDelegations releases collateral undelegated from hosts back to the delegators and removes the
+// processed entries.
+func (k Keeper) processUnbondingDelegations(ctx sdk.Context, completionEpoch uint64) {
+	for _, entry := range k.GetUnbondingDelegationsByEpoch(ctx, completionEpoch) {
+		delegatorAddr, err := sdk.AccAddressFromBech32(entry.Delegator)
+		if err != nil {
+			k.Logger().Error("failed to parse delegator address during unbonding processing",
+				"delegator", entry.Delegator, "error", err)
+			continue
+		}
+
+		err = k.bookkeepingBankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAddr, sdk.NewCoins(entry.Amount), "collateral undelegated")
+		if err != nil {
+			panic(fmt.Sprintf("failed to release undelegated collateral for %s: %v", entry.Delegator, err))
+		}
+		k.bookkeepingBankKeeper.LogSubAccountTransaction(ctx, entry.Delegator, types.ModuleName, types.SubAccountUnbonding, entry.Amount, "collateral undelegated")
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeProcessWithdrawal,
+			sdk.NewAttribute(types.AttributeKeyParticipant, entry.Delegator),
+			sdk.NewAttribute(types.AttributeKeyHost, entry.Host),
+			sdk.NewAttribute(types.AttributeKeyAmount, entry.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionEpoch, strconv.FormatUint(completionEpoch, 10)),
+		))
+
+		entry.Amount = sdk.NewCoin(entry.Amount.Denom, math.ZeroInt())
+		k.setUnbondingDelegation(ctx, entry)
+	}
 }
 
 // GetAllUnbondings returns all unbonding entries (for genesis export)
@@ -381,12 +574,23 @@ func (k Keeper) GetAllJailed(ctx sdk.Context) []sdk.AccAddress {
 }
 
 // Slash penalizes a participant by burning a fraction of their total collateral.
-// This includes both their active collateral and any collateral in the unbonding queue.
+// This includes their active collateral, any collateral in the unbonding queue and
+// collateral delegated to them by third parties, including delegations still unbonding.
 // The slash is applied proportionally to all holdings.
 func (k Keeper) Slash(ctx context.Context, participantAddress sdk.AccAddress, slashFraction math.LegacyDec) (sdk.Coin, error) {
+	slashed, _, err := k.SlashWithReward(ctx, participantAddress, slashFraction, "", math.LegacyZeroDec())
+	return slashed, err
+}
+
+// SlashWithReward slashes a participant like Slash, but sends rewardFraction of the slashed amount to
+// rewardModule instead of burning it. Only the rest of the slashed amount is burned.
+func (k Keeper) SlashWithReward(ctx context.Context, participantAddress sdk.AccAddress, slashFraction math.LegacyDec, rewardModule string, rewardFraction math.LegacyDec) (sdk.Coin, sdk.Coin, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	if slashFraction.IsNegative() || slashFraction.GT(math.LegacyOneDec()) {
-		return sdk.Coin{}, fmt.Errorf("slash fraction must be between 0 and 1, got %s", slashFraction)
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("slash fraction must be between 0 and 1, got %s", slashFraction)
+	}
+	if rewardFraction.IsNegative() || rewardFraction.GT(math.LegacyOneDec()) {
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("reward fraction must be between 0 and 1, got %s", rewardFraction)
 	}
 
 	totalSlashedAmount := sdk.NewCoin(inferencetypes.BaseCoin, math.ZeroInt())
@@ -429,15 +633,47 @@ func (k Keeper) Slash(ctx context.Context, participantAddress sdk.AccAddress, sl
 		}
 	}
 
-	// 3. Burn the total slashed amount from the module account
-	if !totalSlashedAmount.IsZero() {
-		err := k.bookkeepingBankKeeper.BurnCoins(sdkCtx, types.ModuleName, sdk.NewCoins(totalSlashedAmount), "collateral slashed")
+	// 3. Slash collateral delegated to the participant
+	for _, delegation := range k.GetDelegationsToHost(ctx, participantAddress) {
+		slashAmountDec := math.LegacyNewDecFromInt(delegation.Amount.Amount).Mul(slashFraction)
+		slashAmount := sdk.NewCoin(delegation.Amount.Denom, slashAmountDec.TruncateInt())
+
+		if !slashAmount.IsZero() {
+			delegation.Amount = delegation.Amount.Sub(slashAmount)
+			k.setDelegation(ctx, delegation)
+			totalSlashedAmount = totalSlashedAmount.Add(slashAmount)
+		}
+	}
+
+	// 4. Slash collateral being undelegated from the participant, which was at stake when the fault happened
+	for _, unbonding := range k.GetUnbondingDelegationsToHost(ctx, participantAddress) {
+		slashAmountDec := math.LegacyNewDecFromInt(unbonding.Amount.Amount).Mul(slashFraction)
+		slashAmount := sdk.NewCoin(unbonding.Amount.Denom, slashAmountDec.TruncateInt())
+
+		if !slashAmount.IsZero() {
+			unbonding.Amount = unbonding.Amount.Sub(slashAmount)
+			k.setUnbondingDelegation(ctx, unbonding)
+			totalSlashedAmount = totalSlashedAmount.Add(slashAmount)
+		}
+	}
+
+	// 5. Pay the reward out of the slashed amount and burn the rest from the module account
+	reward := sdk.NewCoin(totalSlashedAmount.Denom, math.LegacyNewDecFromInt(totalSlashedAmount.Amount).Mul(rewardFraction).TruncateInt())
+	if !reward.IsZero() {
+		err := k.bookkeepingBankKeeper.SendCoinsFromModuleToModule(sdkCtx, types.ModuleName, rewardModule, sdk.NewCoins(reward), "collateral slash reward")
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("failed to send slash reward: %w", err)
+		}
+	}
+	if burned := totalSlashedAmount.Sub(reward); !burned.IsZero() {
+		err := k.bookkeepingBankKeeper.BurnCoins(sdkCtx, types.ModuleName, sdk.NewCoins(burned), "collateral slashed")
 		if err != nil {
 			// This is a critical error, indicating an issue with the module account or supply
-			return sdk.Coin{}, fmt.Errorf("failed to burn slashed coins: %w", err)
+			return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("failed to burn slashed coins: %w", err)
 		}
-
-		// 4. Emit a slash event
+	}
+	if !totalSlashedAmount.IsZero() {
+		// 6. Emit a slash event
 		sdkCtx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeSlashCollateral,
@@ -451,8 +687,9 @@ func (k Keeper) Slash(ctx context.Context, participantAddress sdk.AccAddress, sl
 			"participant", participantAddress.String(),
 			"slash_fraction", slashFraction.String(),
 			"slashed_amount", totalSlashedAmount.String(),
+			"reward", reward.String(),
 		)
 	}
 
-	return totalSlashedAmount, nil
+	return totalSlashedAmount, reward, nil
 }
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/collateral/types"
+	inferencetypes "github.com/productscience/inference/x/inference/types"
+)
+
+// CancelUnbondingCollateral moves collateral from a pending unbonding entry straight back to the
+// participant's active collateral. Undelegations are kept apart and can't be cancelled here.
+func (k msgServer) CancelUnbondingCollateral(goCtx context.Context, msg *types.MsgCancelUnbondingCollateral) (*types.MsgCancelUnbondingCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	participantAddr, err := sdk.AccAddressFromBech32(msg.Participant)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure only base denomination is accepted
+	if msg.Amount.Denom != inferencetypes.BaseCoin {
+		return nil, types.ErrInvalidDenom.Wrapf("only %s denomination is accepted for collateral, got %s",
+			inferencetypes.BaseCoin, msg.Amount.Denom)
+	}
+
+	remaining, err := k.ReduceUnbondingCollateral(ctx, participantAddr, msg.CompletionEpoch, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	currentCollateral, found := k.GetCollateral(ctx, participantAddr)
+	if found {
+		currentCollateral = currentCollateral.Add(msg.Amount)
+	} else {
+		currentCollateral = msg.Amount
+	}
+	k.SetCollateral(ctx, participantAddr, currentCollateral)
+
+	k.bookkeepingBankKeeper.LogSubAccountTransaction(goCtx, msg.Participant, types.ModuleName, types.SubAccountUnbonding, msg.Amount, "unbonding cancelled")
+	k.bookkeepingBankKeeper.LogSubAccountTransaction(goCtx, types.ModuleName, msg.Participant, types.SubAccountCollateral, msg.Amount, "unbonding cancelled")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUnbonding,
+			sdk.NewAttribute(types.AttributeKeyParticipant, msg.Participant),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionEpoch, strconv.FormatUint(msg.CompletionEpoch, 10)),
+		),
+	})
+
+	k.Logger().Info("collateral unbonding cancelled",
+		"participant", msg.Participant,
+		"amount", msg.Amount.String(),
+		"completion_epoch", msg.CompletionEpoch,
+		"remaining_unbonding", remaining.String(),
+		"total_collateral", currentCollateral.String(),
+	)
+
+	return &types.MsgCancelUnbondingCollateralResponse{
+		Collateral:         currentCollateral,
+		RemainingUnbonding: remaining,
+	}, nil
+}
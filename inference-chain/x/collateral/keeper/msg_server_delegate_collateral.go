@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/collateral/types"
+	inferencetypes "github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) DelegateCollateral(goCtx context.Context, msg *types.MsgDelegateCollateral) (*types.MsgDelegateCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddr, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	hostAddr, err := sdk.AccAddressFromBech32(msg.Host)
+	if err != nil {
+		return nil, err
+	}
+	if delegatorAddr.Equals(hostAddr) {
+		return nil, types.ErrSelfDelegation.Wrap("use MsgDepositCollateral to back your own weight")
+	}
+
+	// Ensure only base denomination is accepted
+	if msg.Amount.Denom != inferencetypes.BaseCoin {
+		return nil, types.ErrInvalidDenom.Wrapf("only %s denomination is accepted for collateral, got %s",
+			inferencetypes.BaseCoin, msg.Amount.Denom)
+	}
+
+	// Transfer tokens from the delegator to the module account
+	err = k.bookkeepingBankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddr, types.ModuleName, sdk.NewCoins(msg.Amount), "collateral delegation")
+	if err != nil {
+		return nil, err
+	}
+
+	delegation := k.AddDelegation(ctx, delegatorAddr, hostAddr, msg.Amount)
+
+	k.bookkeepingBankKeeper.LogSubAccountTransaction(goCtx, types.ModuleName, msg.Delegator, types.SubAccountDelegated, msg.Amount, "collateral delegation")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegateCollateral,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator),
+			sdk.NewAttribute(types.AttributeKeyHost, msg.Host),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+
+	k.Logger().Info("collateral delegated",
+		"delegator", msg.Delegator,
+		"host", msg.Host,
+		"amount", msg.Amount.String(),
+		"total_delegation", delegation.Amount.String(),
+	)
+
+	return &types.MsgDelegateCollateralResponse{}, nil
+}
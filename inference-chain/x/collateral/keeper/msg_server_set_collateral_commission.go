@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/collateral/types"
+)
+
+func (k msgServer) SetCollateralCommission(goCtx context.Context, msg *types.MsgSetCollateralCommission) (*types.MsgSetCollateralCommissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hostAddr, err := sdk.AccAddressFromBech32(msg.Host)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Limiting changes to one per epoch keeps a host from raising its cut right before
+	// rewards settle without giving delegators a chance to react
+	currentEpoch := k.GetCurrentEpoch(ctx)
+	existing, found := k.GetHostCommission(ctx, hostAddr)
+	if found && existing.UpdateEpoch == currentEpoch {
+		return nil, types.ErrCommissionUpdateTooSoon.Wrapf("commission was already changed in epoch %d", currentEpoch)
+	}
+
+	k.SetHostCommission(ctx, types.HostCommission{
+		Host:        msg.Host,
+		Rate:        msg.Rate,
+		UpdateEpoch: currentEpoch,
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetCommission,
+			sdk.NewAttribute(types.AttributeKeyHost, msg.Host),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, msg.Rate.String()),
+		),
+	})
+
+	k.Logger().Info("collateral commission set",
+		"host", msg.Host,
+		"rate", msg.Rate.String(),
+		"epoch", currentEpoch,
+	)
+
+	return &types.MsgSetCollateralCommissionResponse{}, nil
+}
@@ -306,3 +306,80 @@ func (s *KeeperTestSuite) TestMsgWithdrawCollateral_UnbondingAggregation() {
 	expectedRemaining := initialAmount - totalWithdrawn
 	s.Require().Equal(math.NewInt(expectedRemaining), remainingCollateral.Amount)
 }
+
+func (s *KeeperTestSuite) TestMsgCancelUnbondingCollateral() {
+	participantStr := sample.AccAddress()
+	participant, err := sdk.AccAddressFromBech32(participantStr)
+	s.Require().NoError(err)
+
+	s.k.SetCollateral(s.ctx, participant, sdk.NewInt64Coin(inftypes.BaseCoin, 100))
+	s.k.AddUnbondingCollateral(s.ctx, participant, 12, sdk.NewInt64Coin(inftypes.BaseCoin, 400))
+	s.k.AddUnbondingCollateral(s.ctx, participant, 13, sdk.NewInt64Coin(inftypes.BaseCoin, 50))
+
+	s.bankKeeper.EXPECT().
+		LogSubAccountTransaction(s.ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), "unbonding cancelled").
+		Times(4)
+
+	// Partial cancellation keeps the rest of the entry unbonding
+	res, err := s.msgServer.CancelUnbondingCollateral(s.ctx, types.NewMsgCancelUnbondingCollateral(participantStr, 12, sdk.NewInt64Coin(inftypes.BaseCoin, 150)))
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(250), res.Collateral.Amount)
+	s.Require().Equal(math.NewInt(250), res.RemainingUnbonding.Amount)
+	entry, found := s.k.GetUnbondingCollateral(s.ctx, participant, 12)
+	s.Require().True(found)
+	s.Require().Equal(math.NewInt(250), entry.Amount.Amount)
+
+	// Cancelling the rest removes the entry from the epoch queue and the participant index
+	res, err = s.msgServer.CancelUnbondingCollateral(s.ctx, types.NewMsgCancelUnbondingCollateral(participantStr, 12, sdk.NewInt64Coin(inftypes.BaseCoin, 250)))
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(500), res.Collateral.Amount)
+	s.Require().True(res.RemainingUnbonding.IsZero())
+	_, found = s.k.GetUnbondingCollateral(s.ctx, participant, 12)
+	s.Require().False(found)
+	s.Require().Empty(s.k.GetUnbondingByEpoch(s.ctx, 12))
+	byParticipant := s.k.GetUnbondingByParticipant(s.ctx, participant)
+	s.Require().Len(byParticipant, 1)
+	s.Require().Equal(uint64(13), byParticipant[0].CompletionEpoch)
+
+	collateral, found := s.k.GetCollateral(s.ctx, participant)
+	s.Require().True(found)
+	s.Require().Equal(math.NewInt(500), collateral.Amount)
+
+	// Nothing is released for the cancelled epoch
+	s.k.ProcessUnbondingQueue(s.ctx, 12)
+}
+
+func (s *KeeperTestSuite) TestMsgCancelUnbondingCollateral_Invalid() {
+	participantStr := sample.AccAddress()
+	participant, err := sdk.AccAddressFromBech32(participantStr)
+	s.Require().NoError(err)
+	s.k.AddUnbondingCollateral(s.ctx, participant, 12, sdk.NewInt64Coin(inftypes.BaseCoin, 100))
+
+	_, err = s.msgServer.CancelUnbondingCollateral(s.ctx, types.NewMsgCancelUnbondingCollateral(participantStr, 11, sdk.NewInt64Coin(inftypes.BaseCoin, 10)))
+	s.Require().ErrorIs(err, types.ErrNoUnbondingFound)
+
+	_, err = s.msgServer.CancelUnbondingCollateral(s.ctx, types.NewMsgCancelUnbondingCollateral(participantStr, 12, sdk.NewInt64Coin(inftypes.BaseCoin, 101)))
+	s.Require().ErrorIs(err, types.ErrInsufficientCollateral)
+
+	_, err = s.msgServer.CancelUnbondingCollateral(s.ctx, types.NewMsgCancelUnbondingCollateral(participantStr, 12, sdk.NewInt64Coin("uatom", 10)))
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	entry, found := s.k.GetUnbondingCollateral(s.ctx, participant, 12)
+	s.Require().True(found)
+	s.Require().Equal(math.NewInt(100), entry.Amount.Amount)
+	_, found = s.k.GetCollateral(s.ctx, participant)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestQueryAllUnbondingCollaterals() {
+	for i := uint64(0); i < 3; i++ {
+		participant, err := sdk.AccAddressFromBech32(sample.AccAddress())
+		s.Require().NoError(err)
+		s.k.AddUnbondingCollateral(s.ctx, participant, 10+i, sdk.NewInt64Coin(inftypes.BaseCoin, 10))
+	}
+
+	res, err := s.k.AllUnbondingCollaterals(s.ctx, &types.QueryAllUnbondingCollateralsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Unbondings, 3)
+	s.Require().Equal(uint64(10), res.Unbondings[0].CompletionEpoch)
+}
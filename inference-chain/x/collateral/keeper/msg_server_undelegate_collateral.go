@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/collateral/types"
+	inferencetypes "github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) UndelegateCollateral(goCtx context.Context, msg *types.MsgUndelegateCollateral) (*types.MsgUndelegateCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddr, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	hostAddr, err := sdk.AccAddressFromBech32(msg.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure only base denomination is accepted
+	if msg.Amount.Denom != inferencetypes.BaseCoin {
+		return nil, types.ErrInvalidDenom.Wrapf("only %s denomination is accepted for collateral, got %s",
+			inferencetypes.BaseCoin, msg.Amount.Denom)
+	}
+
+	delegation, found := k.GetDelegation(ctx, delegatorAddr, hostAddr)
+	if !found {
+		return nil, types.ErrNoDelegationFound.Wrapf("%s has no collateral delegated to %s", msg.Delegator, msg.Host)
+	}
+	if delegation.Amount.IsLT(msg.Amount) {
+		return nil, types.ErrInsufficientCollateral.Wrapf("delegation %s is less than undelegation amount %s",
+			delegation.Amount.String(), msg.Amount.String())
+	}
+
+	// Undelegated funds unbond for as long as withdrawals do and stay at stake for the host until released
+	completionEpoch := k.GetCurrentEpoch(ctx) + k.GetParams(ctx).UnbondingPeriodEpochs
+	k.AddUnbondingDelegation(ctx, delegatorAddr, hostAddr, completionEpoch, msg.Amount)
+
+	delegation.Amount = delegation.Amount.Sub(msg.Amount)
+	k.setDelegation(ctx, delegation)
+
+	k.bookkeepingBankKeeper.LogSubAccountTransaction(goCtx, msg.Delegator, types.ModuleName, types.SubAccountDelegated, msg.Amount, "delegation to unbonding")
+	k.bookkeepingBankKeeper.LogSubAccountTransaction(goCtx, types.ModuleName, msg.Delegator, types.SubAccountUnbonding, msg.Amount, "delegation to unbonding")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUndelegateCollateral,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator),
+			sdk.NewAttribute(types.AttributeKeyHost, msg.Host),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionEpoch, strconv.FormatUint(completionEpoch, 10)),
+		),
+	})
+
+	k.Logger().Info("collateral undelegation initiated",
+		"delegator", msg.Delegator,
+		"host", msg.Host,
+		"amount", msg.Amount.String(),
+		"completion_epoch", completionEpoch,
+	)
+
+	return &types.MsgUndelegateCollateralResponse{
+		CompletionEpoch: completionEpoch,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/collateral/types"
+)
+
+// Unjail releases a participant from every open inference jail once the minimum jail period has passed
+// for each of them and its active collateral is back to what it held before the slash.
+// The participant's weight is counted again from the next epoch the inference module forms.
+// Consensus jails are left open: they are closed by the staking hooks once the validator bonds again.
+func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	participantAddr, err := sdk.AccAddressFromBech32(msg.Participant)
+	if err != nil {
+		return nil, err
+	}
+
+	openRecords := k.GetOpenJailRecords(ctx, participantAddr)
+	if len(openRecords) == 0 {
+		return nil, types.ErrNotJailed.Wrapf("participant %s has no open jail record", msg.Participant)
+	}
+
+	currentEpoch := k.GetCurrentEpoch(ctx)
+	minJailEpochs := k.GetParams(ctx).MinJailEpochs
+	collateral, found := k.GetCollateral(ctx, participantAddr)
+	inferenceJails := 0
+	for _, record := range openRecords {
+		if record.Reason == types.JailReasonConsensus {
+			continue
+		}
+		inferenceJails++
+		if currentEpoch < record.JailedEpoch+minJailEpochs {
+			return nil, types.ErrJailPeriodNotOver.Wrapf("jailed in epoch %d, can unjail from epoch %d, current epoch is %d",
+				record.JailedEpoch, record.JailedEpoch+minJailEpochs, currentEpoch)
+		}
+		if record.RequiredCollateral.IsPositive() && (!found || collateral.IsLT(record.RequiredCollateral)) {
+			return nil, types.ErrInsufficientCollateral.Wrapf("collateral must be topped up to %s, currently %s",
+				record.RequiredCollateral, collateral)
+		}
+	}
+	if inferenceJails == 0 {
+		return nil, types.ErrJailedByConsensus.Wrap("unjail the validator through x/slashing")
+	}
+
+	released := k.Release(ctx, participantAddr, func(record types.JailRecord) bool {
+		return record.Reason != types.JailReasonConsensus
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(types.AttributeKeyParticipant, msg.Participant),
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(currentEpoch, 10)),
+		),
+	})
+
+	k.Logger().Info("participant unjailed",
+		"participant", msg.Participant,
+		"released_records", len(released),
+		"epoch", currentEpoch,
+	)
+
+	return &types.MsgUnjailResponse{WeightEpoch: currentEpoch + 1}, nil
+}
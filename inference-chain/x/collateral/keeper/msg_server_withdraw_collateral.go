@@ -36,35 +36,7 @@ func (k msgServer) WithdrawCollateral(goCtx context.Context, msg *types.MsgWithd
 			currentCollateral.String(), msg.Amount.String())
 	}
 
-	// Get the current epoch from the collateral module's own state
-	currentEpoch := k.GetCurrentEpoch(ctx)
-
-	// Get the unbonding period from params
-	params := k.GetParams(ctx)
-
-	// Calculate the completion epoch
-	completionEpoch := currentEpoch + params.UnbondingPeriodEpochs
-
-	k.Logger().Info("adding unbonding entry for collateral withdrawal",
-		"participant", msg.Participant,
-		"amount", msg.Amount.String(),
-		"completion_epoch", completionEpoch,
-		"current_epoch", currentEpoch,
-		"unbonding_period_epochs", params.UnbondingPeriodEpochs,
-	)
-	// Create the unbonding entry
-	k.AddUnbondingCollateral(ctx, participantAddr, completionEpoch, msg.Amount)
-
-	// Reduce the active collateral
-	newCollateral := currentCollateral.Sub(msg.Amount)
-	if newCollateral.IsZero() {
-		k.RemoveCollateral(ctx, participantAddr)
-	} else {
-		k.SetCollateral(ctx, participantAddr, newCollateral)
-	}
-
-	k.bookkeepingBankKeeper.LogSubAccountTransaction(goCtx, msg.Participant, types.ModuleName, types.SubAccountCollateral, msg.Amount, "collateral to unbonding")
-	k.bookkeepingBankKeeper.LogSubAccountTransaction(goCtx, types.ModuleName, msg.Participant, types.SubAccountUnbonding, msg.Amount, "collateral to unbonding")
+	completionEpoch := k.UnbondCollateral(ctx, participantAddr, msg.Amount)
 
 	// Emit withdrawal event
 	ctx.EventManager().EmitEvents(sdk.Events{
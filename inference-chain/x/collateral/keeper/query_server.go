@@ -3,8 +3,8 @@ package keeper
 import (
 	"context"
 
-	"cosmossdk.io/store/prefix"
-	"github.com/cosmos/cosmos-sdk/runtime"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -78,24 +78,74 @@ func (k Keeper) AllUnbondingCollaterals(c context.Context, req *types.QueryAllUn
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var allUnbondings []types.UnbondingCollateral
+	// Unbonding entries live in the UnbondingIM collection; the legacy UnbondingKey prefix is no longer written
+	allUnbondings, pageRes, err := query.CollectionPaginate(
+		c,
+		&k.UnbondingIM,
+		req.Pagination,
+		func(_ collections.Pair[uint64, sdk.AccAddress], value types.UnbondingCollateral) (types.UnbondingCollateral, error) {
+			return value, nil
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllUnbondingCollateralsResponse{Unbondings: allUnbondings, Pagination: pageRes}, nil
+}
+
+func (k Keeper) HostDelegations(c context.Context, req *types.QueryHostDelegationsRequest) (*types.QueryHostDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	unbondingStore := prefix.NewStore(store, types.UnbondingKey)
+	hostAddr, err := sdk.AccAddressFromBech32(req.Host)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid host address: %v", err)
+	}
 
-	pageRes, err := query.Paginate(unbondingStore, req.Pagination, func(key []byte, value []byte) error {
-		var unbonding types.UnbondingCollateral
-		if err := k.cdc.Unmarshal(value, &unbonding); err != nil {
-			return err
-		}
-		allUnbondings = append(allUnbondings, unbonding)
-		return nil
-	})
+	commission, found := k.GetHostCommission(ctx, hostAddr)
+	if !found {
+		commission = types.HostCommission{Host: req.Host, Rate: math.LegacyZeroDec()}
+	}
+
+	return &types.QueryHostDelegationsResponse{
+		Delegations:    k.GetDelegationsToHost(ctx, hostAddr),
+		TotalDelegated: k.GetDelegatedCollateral(ctx, hostAddr),
+		Commission:     commission,
+	}, nil
+}
 
+func (k Keeper) DelegatorDelegations(c context.Context, req *types.QueryDelegatorDelegationsRequest) (*types.QueryDelegatorDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delegatorAddr, err := sdk.AccAddressFromBech32(req.Delegator)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address: %v", err)
 	}
 
-	return &types.QueryAllUnbondingCollateralsResponse{Unbondings: allUnbondings, Pagination: pageRes}, nil
+	return &types.QueryDelegatorDelegationsResponse{
+		Delegations:          k.GetDelegationsByDelegator(ctx, delegatorAddr),
+		UnbondingDelegations: k.GetUnbondingDelegationsByDelegator(ctx, delegatorAddr),
+	}, nil
+}
+
+func (k Keeper) JailHistory(c context.Context, req *types.QueryJailHistoryRequest) (*types.QueryJailHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	participantAddr, err := sdk.AccAddressFromBech32(req.Participant)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid participant address: %v", err)
+	}
+
+	return &types.QueryJailHistoryResponse{
+		Jailed:  k.IsJailed(ctx, participantAddr),
+		Records: k.GetJailHistory(ctx, participantAddr),
+	}, nil
 }
@@ -158,3 +158,27 @@ func (s *KeeperTestSuite) TestSlashing_InvalidFraction() {
 	s.Require().True(found)
 	s.Require().Equal(initialCollateral, finalCollateral)
 }
+
+func (s *KeeperTestSuite) TestSlashing_WithReward() {
+	participant := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	s.k.SetCollateral(s.ctx, participant, sdk.NewInt64Coin(inftypes.BaseCoin, 1000))
+
+	// Half of the collateral is slashed; a tenth of that goes to the reward module and the rest is burned
+	send := s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToModule(s.ctx, types.ModuleName, inftypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 50)), "collateral slash reward").
+		Return(nil).
+		Times(1)
+	s.bankKeeper.EXPECT().
+		BurnCoins(s.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(inftypes.BaseCoin, 450)), "collateral slashed").
+		Return(nil).
+		After(send).
+		Times(1)
+
+	slashed, reward, err := s.k.SlashWithReward(s.ctx, participant, math.LegacyNewDecWithPrec(5, 1), inftypes.ModuleName, math.LegacyNewDecWithPrec(1, 1))
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(500), slashed.Amount)
+	s.Require().Equal(math.NewInt(50), reward.Amount)
+
+	_, _, err = s.k.SlashWithReward(s.ctx, participant, math.LegacyNewDecWithPrec(5, 1), inftypes.ModuleName, math.LegacyNewDec(2))
+	s.Require().Error(err, "should error on reward fraction greater than 1")
+}
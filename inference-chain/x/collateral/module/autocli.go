@@ -39,6 +39,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "list-unbonding-collaterals",
 					Short:     "List all unbondingCollateral",
 				},
+				{
+					RpcMethod:      "HostDelegations",
+					Use:            "host-delegations [host]",
+					Short:          "Shows the collateral delegated to a host and its commission",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "host"}},
+				},
+				{
+					RpcMethod:      "DelegatorDelegations",
+					Use:            "delegator-delegations [delegator]",
+					Short:          "Lists the collateral delegations made by a delegator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator"}},
+				},
+				{
+					RpcMethod:      "JailHistory",
+					Use:            "jail-history [participant]",
+					Short:          "Shows whether a participant is jailed and its jail history",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "participant"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -62,6 +80,35 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a withdraw-collateral tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
 				},
+				{
+					RpcMethod:      "CancelUnbondingCollateral",
+					Use:            "cancel-unbonding-collateral [completion-epoch] [amount]",
+					Short:          "Return pending unbonding collateral to active collateral",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "completion_epoch"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "DelegateCollateral",
+					Use:            "delegate-collateral [host] [amount]",
+					Short:          "Delegate collateral to a host",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "host"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "UndelegateCollateral",
+					Use:            "undelegate-collateral [host] [amount]",
+					Short:          "Undelegate collateral from a host (subject to unbonding period)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "host"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "SetCollateralCommission",
+					Use:            "set-collateral-commission [rate]",
+					Short:          "Set the share of delegator rewards kept by the host",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "rate"}},
+				},
+				{
+					RpcMethod: "Unjail",
+					Use:       "unjail",
+					Short:     "Release yourself from jail after the minimum jail period, once collateral is topped up",
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
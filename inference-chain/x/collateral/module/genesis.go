@@ -36,6 +36,49 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetJailed(ctx, jailedAddr)
 	}
 
+	// Set all the collateral delegations
+	for _, elem := range genState.DelegationList {
+		delegator, err := sdk.AccAddressFromBech32(elem.Delegator)
+		if err != nil {
+			panic(err)
+		}
+		host, err := sdk.AccAddressFromBech32(elem.Host)
+		if err != nil {
+			panic(err)
+		}
+		k.AddDelegation(ctx, delegator, host, elem.Amount)
+	}
+
+	// Set all the collateral being undelegated from hosts
+	for _, elem := range genState.UnbondingDelegationList {
+		delegator, err := sdk.AccAddressFromBech32(elem.Delegator)
+		if err != nil {
+			panic(err)
+		}
+		host, err := sdk.AccAddressFromBech32(elem.Host)
+		if err != nil {
+			panic(err)
+		}
+		k.AddUnbondingDelegation(ctx, delegator, host, elem.CompletionEpoch, elem.Amount)
+	}
+
+	// Set all the host commissions
+	for _, elem := range genState.HostCommissionList {
+		k.SetHostCommission(ctx, elem)
+	}
+
+	// Set the jail history, keeping ids and continuing the sequence after the highest one
+	var nextJailRecordId uint64
+	for _, elem := range genState.JailRecordList {
+		k.SetJailRecord(ctx, elem)
+		if elem.Id >= nextJailRecordId {
+			nextJailRecordId = elem.Id + 1
+		}
+	}
+	if err := k.JailRecordSeq.Set(ctx, nextJailRecordId); err != nil {
+		panic(err)
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -69,6 +112,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.JailedParticipantList[i] = &types.JailedParticipant{Address: addr.String()}
 	}
 
+	genesis.DelegationList = k.GetAllDelegations(ctx)
+	genesis.UnbondingDelegationList = k.GetAllUnbondingDelegations(ctx)
+	genesis.HostCommissionList = k.GetAllHostCommissions(ctx)
+	genesis.JailRecordList = k.GetAllJailRecords(ctx)
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
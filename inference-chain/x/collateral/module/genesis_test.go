@@ -3,16 +3,31 @@ package collateral_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	keepertest "github.com/productscience/inference/testutil/keeper"
 	"github.com/productscience/inference/testutil/nullify"
+	"github.com/productscience/inference/testutil/sample"
 	collateral "github.com/productscience/inference/x/collateral/module"
 	"github.com/productscience/inference/x/collateral/types"
+	inftypes "github.com/productscience/inference/x/inference/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	host := sample.AccAddress()
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		DelegationList: []types.CollateralDelegation{
+			{Delegator: sample.AccAddress(), Host: host, Amount: sdk.NewInt64Coin(inftypes.BaseCoin, 100)},
+		},
+		UnbondingDelegationList: []types.UnbondingDelegation{
+			{Delegator: sample.AccAddress(), Host: host, CompletionEpoch: 7, Amount: sdk.NewInt64Coin(inftypes.BaseCoin, 40)},
+		},
+		HostCommissionList: []types.HostCommission{
+			{Host: host, Rate: math.LegacyNewDecWithPrec(5, 2), UpdateEpoch: 3},
+		},
 
 		// this line is used by starport scaffolding # genesis/test/state
 	}
@@ -21,6 +36,10 @@ func TestGenesis(t *testing.T) {
 	collateral.InitGenesis(ctx, k, genesisState)
 	got := collateral.ExportGenesis(ctx, k)
 	require.NotNil(t, got)
+	require.ElementsMatch(t, genesisState.DelegationList, got.DelegationList)
+	require.ElementsMatch(t, genesisState.UnbondingDelegationList, got.UnbondingDelegationList)
+	require.Len(t, got.HostCommissionList, 1)
+	require.True(t, genesisState.HostCommissionList[0].Rate.Equal(got.HostCommissionList[0].Rate))
 
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
@@ -8,6 +8,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/productscience/inference/x/collateral/keeper"
+	"github.com/productscience/inference/x/collateral/types"
 )
 
 // StakingHooks wrapper struct
@@ -36,8 +37,11 @@ func (h StakingHooks) AfterValidatorRemoved(ctx context.Context, consAddr sdk.Co
 
 func (h StakingHooks) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	// When a validator is bonded (e.g., un-jailed), we remove them from our jailed list.
-	h.k.RemoveJailed(sdkCtx, sdk.AccAddress(valAddr))
+	// When a validator is bonded (e.g., un-jailed), we close its consensus jail. It stays on our
+	// jailed list if the inference module jailed it as well.
+	h.k.Release(sdkCtx, sdk.AccAddress(valAddr), func(record types.JailRecord) bool {
+		return record.Reason == types.JailReasonConsensus
+	})
 	h.k.Logger().Debug("Staking hook: AfterValidatorBonded, removed jailed status", "validator_address", valAddr.String(), "height", sdkCtx.BlockHeight())
 	return nil
 }
@@ -45,7 +49,8 @@ func (h StakingHooks) AfterValidatorBonded(ctx context.Context, consAddr sdk.Con
 func (h StakingHooks) AfterValidatorBeginUnbonding(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	// When a validator is jailed, we mark their corresponding participant as jailed in our module.
-	h.k.SetJailed(sdkCtx, sdk.AccAddress(valAddr))
+	// x/slashing decides when it may bond again, so no collateral is required to leave this jail.
+	h.k.Jail(sdkCtx, sdk.AccAddress(valAddr), types.JailReasonConsensus, sdk.Coin{}, sdk.Coin{})
 	h.k.Logger().Debug("Staking hook: AfterValidatorBeginUnbonding, set jailed status", "validator_address", valAddr.String(), "height", sdkCtx.BlockHeight())
 	return nil
 }
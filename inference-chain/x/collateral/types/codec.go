@@ -14,6 +14,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgDepositCollateral{},
 		&MsgWithdrawCollateral{},
+		&MsgCancelUnbondingCollateral{},
+		&MsgDelegateCollateral{},
+		&MsgUndelegateCollateral{},
+		&MsgSetCollateralCommission{},
+		&MsgUnjail{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -6,9 +6,17 @@ import (
 
 // x/collateral module sentinel errors
 var (
-	ErrNoCollateralFound      = sdkerrors.Register(ModuleName, 1100, "no collateral found")
-	ErrInsufficientCollateral = sdkerrors.Register(ModuleName, 1101, "insufficient collateral")
-	ErrInvalidDenom           = sdkerrors.Register(ModuleName, 1102, "invalid denomination")
-	ErrLatestEpochNotFound    = sdkerrors.Register(ModuleName, 1103, "latest epoch not found")
-	ErrInvalidSigner          = sdkerrors.Register(ModuleName, 1104, "expected gov account as only signer for proposal message")
+	ErrNoCollateralFound       = sdkerrors.Register(ModuleName, 1100, "no collateral found")
+	ErrInsufficientCollateral  = sdkerrors.Register(ModuleName, 1101, "insufficient collateral")
+	ErrInvalidDenom            = sdkerrors.Register(ModuleName, 1102, "invalid denomination")
+	ErrLatestEpochNotFound     = sdkerrors.Register(ModuleName, 1103, "latest epoch not found")
+	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 1104, "expected gov account as only signer for proposal message")
+	ErrNoDelegationFound       = sdkerrors.Register(ModuleName, 1105, "no delegation found")
+	ErrSelfDelegation          = sdkerrors.Register(ModuleName, 1106, "cannot delegate collateral to yourself")
+	ErrInvalidCommissionRate   = sdkerrors.Register(ModuleName, 1107, "invalid commission rate")
+	ErrCommissionUpdateTooSoon = sdkerrors.Register(ModuleName, 1108, "commission can only be changed once per epoch")
+	ErrNoUnbondingFound        = sdkerrors.Register(ModuleName, 1109, "no unbonding entry found")
+	ErrNotJailed               = sdkerrors.Register(ModuleName, 1110, "participant is not jailed")
+	ErrJailedByConsensus       = sdkerrors.Register(ModuleName, 1111, "participant is jailed at the consensus level")
+	ErrJailPeriodNotOver       = sdkerrors.Register(ModuleName, 1112, "minimum jail period has not passed")
 )
@@ -2,10 +2,16 @@ package types
 
 // Event types
 const (
-	EventTypeDepositCollateral  = "deposit_collateral"
-	EventTypeWithdrawCollateral = "withdraw_collateral"
-	EventTypeSlashCollateral    = "slash_collateral"
-	EventTypeProcessWithdrawal  = "process_withdrawal"
+	EventTypeDepositCollateral    = "deposit_collateral"
+	EventTypeWithdrawCollateral   = "withdraw_collateral"
+	EventTypeSlashCollateral      = "slash_collateral"
+	EventTypeProcessWithdrawal    = "process_withdrawal"
+	EventTypeDelegateCollateral   = "delegate_collateral"
+	EventTypeUndelegateCollateral = "undelegate_collateral"
+	EventTypeSetCommission        = "set_collateral_commission"
+	EventTypeCancelUnbonding      = "cancel_unbonding_collateral"
+	EventTypeJail                 = "jail_participant"
+	EventTypeUnjail               = "unjail_participant"
 )
 
 // Event attribute keys
@@ -15,4 +21,9 @@ const (
 	AttributeKeyCompletionEpoch = "completion_epoch"
 	AttributeKeySlashAmount     = "slash_amount"
 	AttributeKeySlashFraction   = "slash_fraction"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyHost            = "host"
+	AttributeKeyCommissionRate  = "commission_rate"
+	AttributeKeyJailReason      = "jail_reason"
+	AttributeKeyEpoch           = "epoch"
 )
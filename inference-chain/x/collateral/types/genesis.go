@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -16,6 +23,46 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	delegationIndex := make(map[string]struct{})
+	for _, elem := range gs.DelegationList {
+		if err := validateDelegation(elem.Delegator, elem.Host, elem.Amount); err != nil {
+			return err
+		}
+		key := elem.Host + "/" + elem.Delegator
+		if _, ok := delegationIndex[key]; ok {
+			return fmt.Errorf("duplicated delegation from %s to %s", elem.Delegator, elem.Host)
+		}
+		delegationIndex[key] = struct{}{}
+	}
+
+	for _, elem := range gs.UnbondingDelegationList {
+		if err := validateDelegation(elem.Delegator, elem.Host, elem.Amount); err != nil {
+			return err
+		}
+	}
+
+	commissionIndex := make(map[string]struct{})
+	for _, elem := range gs.HostCommissionList {
+		if elem.Rate.IsNil() || elem.Rate.IsNegative() || elem.Rate.GT(math.LegacyOneDec()) {
+			return ErrInvalidCommissionRate.Wrapf("commission rate for %s must be between 0 and 1", elem.Host)
+		}
+		if _, ok := commissionIndex[elem.Host]; ok {
+			return fmt.Errorf("duplicated commission for host %s", elem.Host)
+		}
+		commissionIndex[elem.Host] = struct{}{}
+	}
+
+	jailRecordIndex := make(map[uint64]struct{})
+	for _, elem := range gs.JailRecordList {
+		if _, err := sdk.AccAddressFromBech32(elem.Participant); err != nil {
+			return fmt.Errorf("invalid participant address in jail record %d: %w", elem.Id, err)
+		}
+		if _, ok := jailRecordIndex[elem.Id]; ok {
+			return fmt.Errorf("duplicated jail record id %d", elem.Id)
+		}
+		jailRecordIndex[elem.Id] = struct{}{}
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -33,6 +33,14 @@ type GenesisState struct {
 	// unbonding_collateral_list defines all the unbonding collateral entries at genesis
 	UnbondingCollateralList []UnbondingCollateral `protobuf:"bytes,3,rep,name=unbonding_collateral_list,json=unbondingCollateralList,proto3" json:"unbonding_collateral_list"`
 	JailedParticipantList   []*JailedParticipant  `protobuf:"bytes,4,rep,name=jailed_participant_list,json=jailedParticipantList,proto3" json:"jailed_participant_list,omitempty"`
+	// delegation_list defines all the collateral delegations at genesis
+	DelegationList []CollateralDelegation `protobuf:"bytes,5,rep,name=delegation_list,json=delegationList,proto3" json:"delegation_list"`
+	// host_commission_list defines all the host commissions at genesis
+	HostCommissionList []HostCommission `protobuf:"bytes,6,rep,name=host_commission_list,json=hostCommissionList,proto3" json:"host_commission_list"`
+	// jail_record_list defines the jail history of all participants at genesis
+	JailRecordList []JailRecord `protobuf:"bytes,7,rep,name=jail_record_list,json=jailRecordList,proto3" json:"jail_record_list"`
+	// unbonding_delegation_list defines all the collateral being undelegated from hosts at genesis
+	UnbondingDelegationList []UnbondingDelegation `protobuf:"bytes,8,rep,name=unbonding_delegation_list,json=unbondingDelegationList,proto3" json:"unbonding_delegation_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,6 +104,34 @@ func (m *GenesisState) GetJailedParticipantList() []*JailedParticipant {
 	return nil
 }
 
+func (m *GenesisState) GetDelegationList() []CollateralDelegation {
+	if m != nil {
+		return m.DelegationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHostCommissionList() []HostCommission {
+	if m != nil {
+		return m.HostCommissionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetJailRecordList() []JailRecord {
+	if m != nil {
+		return m.JailRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondingDelegationList() []UnbondingDelegation {
+	if m != nil {
+		return m.UnbondingDelegationList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "inference.collateral.GenesisState")
 }
@@ -105,30 +141,37 @@ func init() {
 }
 
 var fileDescriptor_f1879ca2d8ff63f9 = []byte{
-	// 364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
-	0x18, 0xc7, 0x5b, 0x37, 0x06, 0x76, 0x5e, 0x2c, 0x1b, 0x9b, 0x43, 0xea, 0x1c, 0x82, 0x53, 0xb0,
-	0x85, 0x09, 0x5e, 0x85, 0x7a, 0x10, 0xc4, 0xc3, 0x98, 0x78, 0xf1, 0x52, 0xd2, 0x2c, 0xd6, 0xcc,
-	0x2e, 0x29, 0x49, 0x0a, 0x7a, 0xf7, 0x01, 0x7c, 0x0c, 0x8f, 0x3e, 0xc6, 0x8e, 0x3b, 0x7a, 0x12,
-	0xd9, 0x0e, 0xbe, 0x86, 0x34, 0x99, 0x6b, 0xd9, 0xc2, 0x2e, 0xe5, 0x23, 0xf9, 0xfd, 0xf3, 0xfb,
-	0xbe, 0x7e, 0x56, 0x07, 0x93, 0x47, 0xc4, 0x10, 0x81, 0xc8, 0x83, 0x34, 0x8e, 0x81, 0x40, 0x0c,
-	0xc4, 0x5e, 0x84, 0x08, 0xe2, 0x98, 0xbb, 0x09, 0xa3, 0x82, 0xda, 0xb5, 0x25, 0xe3, 0xe6, 0x4c,
-	0x6b, 0x17, 0x8c, 0x31, 0xa1, 0x9e, 0xfc, 0x2a, 0xb0, 0x55, 0x8b, 0x68, 0x44, 0x65, 0xe9, 0x65,
-	0xd5, 0xe2, 0xf4, 0x50, 0xab, 0x48, 0x00, 0x03, 0xe3, 0x85, 0xa1, 0x75, 0xa6, 0x45, 0xf2, 0x32,
-	0x08, 0x41, 0x0c, 0x32, 0xbf, 0xc2, 0x8f, 0xb4, 0x78, 0x4a, 0x42, 0x4a, 0x86, 0x98, 0x44, 0x1b,
-	0xbd, 0x23, 0x80, 0x63, 0x34, 0x54, 0x48, 0xe7, 0xad, 0x64, 0xed, 0x5c, 0xab, 0x59, 0xef, 0x04,
-	0x10, 0xc8, 0xbe, 0xb4, 0x2a, 0xaa, 0xb1, 0xa6, 0xd9, 0x36, 0xbb, 0xd5, 0xde, 0xbe, 0xab, 0x9b,
-	0xdd, 0xed, 0x4b, 0xc6, 0xdf, 0x9e, 0x7c, 0x1f, 0x18, 0x1f, 0xbf, 0x9f, 0xa7, 0xe6, 0x60, 0x11,
-	0xb3, 0x91, 0xd5, 0x58, 0x6f, 0x3b, 0x88, 0x31, 0x17, 0xcd, 0xad, 0x76, 0xa9, 0x5b, 0xed, 0x1d,
-	0xeb, 0x5f, 0xbc, 0x5a, 0x96, 0xbe, 0xca, 0xf8, 0xe5, 0xec, 0xf1, 0x41, 0x1d, 0xae, 0x5e, 0xdc,
-	0x62, 0x2e, 0xec, 0x67, 0x6b, 0x6f, 0x39, 0x6e, 0x50, 0x10, 0x4a, 0x51, 0x49, 0x8a, 0x4e, 0xf4,
-	0xa2, 0xfb, 0xff, 0x58, 0xc1, 0xa8, 0x54, 0x8d, 0x74, 0xfd, 0x4a, 0xca, 0x02, 0xab, 0xa1, 0xfe,
-	0x5a, 0x90, 0x00, 0x26, 0x30, 0xc4, 0x09, 0x20, 0x42, 0xa9, 0xca, 0x9b, 0x66, 0xba, 0x91, 0xa1,
-	0x7e, 0x9e, 0x19, 0xd4, 0x47, 0xab, 0x47, 0x99, 0xc0, 0xef, 0x4f, 0x66, 0x8e, 0x39, 0x9d, 0x39,
-	0xe6, 0xcf, 0xcc, 0x31, 0xdf, 0xe7, 0x8e, 0x31, 0x9d, 0x3b, 0xc6, 0xd7, 0xdc, 0x31, 0x1e, 0x2e,
-	0x22, 0x2c, 0x9e, 0xd2, 0xd0, 0x85, 0x74, 0xec, 0x25, 0x8c, 0x0e, 0x53, 0x28, 0x38, 0xc4, 0x72,
-	0xa7, 0xf9, 0x76, 0x5f, 0x8a, 0xfb, 0x15, 0xaf, 0x09, 0xe2, 0x61, 0x45, 0xee, 0xf7, 0xfc, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0x76, 0x8d, 0xf4, 0x00, 0xdf, 0x02, 0x00, 0x00,
+	// 480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6b, 0xd4, 0x40,
+	0x14, 0xdf, 0xd8, 0x76, 0xd5, 0xa9, 0xf8, 0x27, 0x6c, 0xd9, 0xba, 0x48, 0x5c, 0x4b, 0xc5, 0x5a,
+	0x30, 0x81, 0x0a, 0x5e, 0x85, 0x6d, 0x41, 0x11, 0x0f, 0xcb, 0x8a, 0x07, 0x45, 0x08, 0x93, 0xc9,
+	0x98, 0x9d, 0x35, 0x99, 0x09, 0x33, 0x13, 0xd0, 0x6f, 0xe1, 0xc7, 0xf0, 0xe8, 0xc7, 0xe8, 0xb1,
+	0x47, 0x4f, 0x22, 0xbb, 0x07, 0xbf, 0x84, 0x07, 0xc9, 0x9b, 0x34, 0xd9, 0x6e, 0xc6, 0xb5, 0x97,
+	0xf0, 0x78, 0xf9, 0xfd, 0x99, 0xf7, 0x7b, 0x3c, 0xb4, 0xc7, 0xf8, 0x47, 0x2a, 0x29, 0x27, 0x34,
+	0x20, 0x22, 0x4d, 0xb1, 0xa6, 0x12, 0xa7, 0x41, 0x42, 0x39, 0x55, 0x4c, 0xf9, 0xb9, 0x14, 0x5a,
+	0xb8, 0xbd, 0x1a, 0xe3, 0x37, 0x98, 0xc1, 0x1d, 0x9c, 0x31, 0x2e, 0x02, 0xf8, 0x1a, 0xe0, 0xa0,
+	0x97, 0x88, 0x44, 0x40, 0x19, 0x94, 0x55, 0xd5, 0x7d, 0x60, 0xb5, 0xc8, 0xb1, 0xc4, 0x59, 0xe5,
+	0x30, 0x78, 0x62, 0x85, 0x34, 0x65, 0x18, 0xe1, 0x14, 0x97, 0xfe, 0x06, 0xbe, 0x6f, 0x85, 0x17,
+	0x3c, 0x12, 0x3c, 0x66, 0x3c, 0x59, 0xeb, 0x3b, 0xc3, 0x2c, 0xa5, 0x71, 0x05, 0x79, 0x68, 0x85,
+	0xc4, 0x34, 0xa5, 0x09, 0xd6, 0x4c, 0x70, 0x03, 0xdb, 0xfb, 0xb3, 0x85, 0x6e, 0xbc, 0x30, 0x91,
+	0xbc, 0xd1, 0x58, 0x53, 0xf7, 0x39, 0xea, 0x9a, 0xf7, 0xef, 0x3a, 0x43, 0xe7, 0x60, 0xfb, 0xe8,
+	0x9e, 0x6f, 0x8b, 0xc8, 0x1f, 0x03, 0x66, 0x74, 0xfd, 0xf4, 0xe7, 0xfd, 0xce, 0xb7, 0xdf, 0xdf,
+	0x0f, 0x9d, 0x49, 0x45, 0x73, 0x29, 0xea, 0xb7, 0xa7, 0x0b, 0x53, 0xa6, 0xf4, 0xee, 0x95, 0xe1,
+	0xc6, 0xc1, 0xf6, 0xd1, 0x23, 0xbb, 0xe2, 0x71, 0x5d, 0x8e, 0x0c, 0x67, 0xb4, 0x59, 0x8a, 0x4f,
+	0x76, 0xc8, 0xea, 0x8f, 0xd7, 0x4c, 0x69, 0xf7, 0x13, 0xba, 0x5b, 0xa7, 0x12, 0x2e, 0x19, 0x82,
+	0xd1, 0x06, 0x18, 0x3d, 0xb6, 0x1b, 0xbd, 0x3d, 0xa7, 0x2d, 0x39, 0x1a, 0xab, 0x7e, 0xd1, 0xfe,
+	0x05, 0x66, 0x21, 0xea, 0x9b, 0x70, 0xc3, 0x1c, 0x4b, 0xcd, 0x08, 0xcb, 0x31, 0xd7, 0xc6, 0x6a,
+	0x73, 0xdd, 0x4c, 0xaf, 0x80, 0x34, 0x6e, 0x38, 0x93, 0x9d, 0xd9, 0x6a, 0x0b, 0x0c, 0xde, 0xa1,
+	0x5b, 0xcd, 0x6a, 0x8c, 0xf0, 0x16, 0x08, 0x1f, 0xfe, 0x2f, 0xac, 0x93, 0x9a, 0x56, 0x0d, 0x71,
+	0xb3, 0x11, 0x02, 0xe9, 0x0f, 0xa8, 0x37, 0x15, 0x4a, 0x87, 0x44, 0x64, 0x19, 0x53, 0xaa, 0xd6,
+	0xef, 0x82, 0xfe, 0xbe, 0x5d, 0xff, 0xa5, 0x50, 0xfa, 0xb8, 0x26, 0x54, 0xca, 0xee, 0xf4, 0x42,
+	0x17, 0xd4, 0xc7, 0xe8, 0x76, 0x39, 0x51, 0x28, 0x29, 0x11, 0x32, 0x36, 0xca, 0x57, 0x41, 0x79,
+	0xf8, 0xef, 0x48, 0x26, 0x00, 0x3e, 0x7f, 0xef, 0xac, 0xee, 0xb4, 0x17, 0xbb, 0x1a, 0xca, 0xb5,
+	0x4b, 0x2d, 0xb6, 0x95, 0x49, 0xb3, 0xd8, 0x93, 0x0b, 0xe1, 0x8c, 0xc6, 0xa7, 0x73, 0xcf, 0x39,
+	0x9b, 0x7b, 0xce, 0xaf, 0xb9, 0xe7, 0x7c, 0x5d, 0x78, 0x9d, 0xb3, 0x85, 0xd7, 0xf9, 0xb1, 0xf0,
+	0x3a, 0xef, 0x9f, 0x25, 0x4c, 0x4f, 0x8b, 0xc8, 0x27, 0x22, 0x0b, 0x72, 0x29, 0xe2, 0x82, 0x68,
+	0x45, 0x18, 0xdc, 0x53, 0x73, 0x59, 0x9f, 0x97, 0x6f, 0x4b, 0x7f, 0xc9, 0xa9, 0x8a, 0xba, 0x70,
+	0x57, 0x4f, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x86, 0xe9, 0xe9, 0x37, 0x7e, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -151,6 +194,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnbondingDelegationList) > 0 {
+		for iNdEx := len(m.UnbondingDelegationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingDelegationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.JailRecordList) > 0 {
+		for iNdEx := len(m.JailRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JailRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.HostCommissionList) > 0 {
+		for iNdEx := len(m.HostCommissionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostCommissionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DelegationList) > 0 {
+		for iNdEx := len(m.DelegationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.JailedParticipantList) > 0 {
 		for iNdEx := len(m.JailedParticipantList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -243,6 +342,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DelegationList) > 0 {
+		for _, e := range m.DelegationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HostCommissionList) > 0 {
+		for _, e := range m.HostCommissionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.JailRecordList) > 0 {
+		for _, e := range m.JailRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingDelegationList) > 0 {
+		for _, e := range m.UnbondingDelegationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -416,6 +539,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationList = append(m.DelegationList, CollateralDelegation{})
+			if err := m.DelegationList[len(m.DelegationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostCommissionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostCommissionList = append(m.HostCommissionList, HostCommission{})
+			if err := m.HostCommissionList[len(m.HostCommissionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailRecordList = append(m.JailRecordList, JailRecord{})
+			if err := m.JailRecordList[len(m.JailRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingDelegationList = append(m.UnbondingDelegationList, UnbondingDelegation{})
+			if err := m.UnbondingDelegationList[len(m.UnbondingDelegationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
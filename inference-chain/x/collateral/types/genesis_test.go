@@ -3,11 +3,16 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/testutil/sample"
 	"github.com/productscience/inference/x/collateral/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	delegator := sample.AccAddress()
+	host := sample.AccAddress()
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -27,6 +32,25 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated delegation",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DelegationList: []types.CollateralDelegation{
+					{Delegator: delegator, Host: host, Amount: sdk.NewInt64Coin("ngonka", 10)},
+					{Delegator: delegator, Host: host, Amount: sdk.NewInt64Coin("ngonka", 20)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "commission above one",
+			genState: &types.GenesisState{
+				Params:             types.DefaultParams(),
+				HostCommissionList: []types.HostCommission{{Host: host, Rate: math.LegacyNewDecWithPrec(15, 1)}},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -68,25 +71,147 @@ func (m *JailedParticipant) GetAddress() string {
 	return ""
 }
 
+// JailRecord is one entry in a participant's jail history.
+type JailRecord struct {
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// id is unique across all participants and increases with every jailing
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// reason is why the participant was jailed, see the JailReason constants
+	Reason      string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	JailedEpoch uint64 `protobuf:"varint,4,opt,name=jailed_epoch,json=jailedEpoch,proto3" json:"jailed_epoch,omitempty"`
+	// slashed_amount is the collateral burned together with the jailing
+	SlashedAmount types.Coin `protobuf:"bytes,5,opt,name=slashed_amount,json=slashedAmount,proto3" json:"slashed_amount"`
+	// required_collateral is the active collateral the participant must hold before it can
+	// unjail itself, i.e. its collateral right after the slash
+	RequiredCollateral types.Coin `protobuf:"bytes,6,opt,name=required_collateral,json=requiredCollateral,proto3" json:"required_collateral"`
+	Released           bool       `protobuf:"varint,7,opt,name=released,proto3" json:"released,omitempty"`
+	ReleasedEpoch      uint64     `protobuf:"varint,8,opt,name=released_epoch,json=releasedEpoch,proto3" json:"released_epoch,omitempty"`
+}
+
+func (m *JailRecord) Reset()         { *m = JailRecord{} }
+func (m *JailRecord) String() string { return proto.CompactTextString(m) }
+func (*JailRecord) ProtoMessage()    {}
+func (*JailRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_902100804fab95ee, []int{1}
+}
+func (m *JailRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JailRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JailRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JailRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailRecord.Merge(m, src)
+}
+func (m *JailRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *JailRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JailRecord proto.InternalMessageInfo
+
+func (m *JailRecord) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *JailRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *JailRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *JailRecord) GetJailedEpoch() uint64 {
+	if m != nil {
+		return m.JailedEpoch
+	}
+	return 0
+}
+
+func (m *JailRecord) GetSlashedAmount() types.Coin {
+	if m != nil {
+		return m.SlashedAmount
+	}
+	return types.Coin{}
+}
+
+func (m *JailRecord) GetRequiredCollateral() types.Coin {
+	if m != nil {
+		return m.RequiredCollateral
+	}
+	return types.Coin{}
+}
+
+func (m *JailRecord) GetReleased() bool {
+	if m != nil {
+		return m.Released
+	}
+	return false
+}
+
+func (m *JailRecord) GetReleasedEpoch() uint64 {
+	if m != nil {
+		return m.ReleasedEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*JailedParticipant)(nil), "inference.collateral.JailedParticipant")
+	proto.RegisterType((*JailRecord)(nil), "inference.collateral.JailRecord")
 }
 
 func init() { proto.RegisterFile("inference/collateral/jailed.proto", fileDescriptor_902100804fab95ee) }
 
 var fileDescriptor_902100804fab95ee = []byte{
-	// 172 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0xcc, 0x4b, 0x4b,
-	0x2d, 0x4a, 0xcd, 0x4b, 0x4e, 0xd5, 0x4f, 0xce, 0xcf, 0xc9, 0x49, 0x2c, 0x49, 0x2d, 0x4a, 0xcc,
-	0xd1, 0xcf, 0x4a, 0xcc, 0xcc, 0x49, 0x4d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81,
-	0x2b, 0xd1, 0x43, 0x28, 0x51, 0xd2, 0xe5, 0x12, 0xf4, 0x02, 0xab, 0x0a, 0x48, 0x2c, 0x2a, 0xc9,
-	0x4c, 0xce, 0x2c, 0x48, 0xcc, 0x2b, 0x11, 0x92, 0xe0, 0x62, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d,
-	0x2e, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x82, 0x71, 0x9d, 0x02, 0x4e, 0x3c, 0x92, 0x63,
-	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
-	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x2c, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39,
-	0x3f, 0x57, 0xbf, 0xa0, 0x28, 0x3f, 0xa5, 0x34, 0xb9, 0xa4, 0x38, 0x39, 0x13, 0xec, 0x22, 0x84,
-	0xdb, 0x2a, 0x90, 0x5d, 0x57, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0x9d, 0x31, 0x20,
-	0x00, 0x00, 0xff, 0xff, 0x67, 0xd5, 0xe5, 0x7c, 0xc2, 0x00, 0x00, 0x00,
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0x86, 0xe3, 0x50, 0xd2, 0xd4, 0xa1, 0x91, 0x6a, 0x2a, 0x64, 0x72, 0x58, 0xb6, 0x95, 0x90,
+	0x22, 0x24, 0xd6, 0x2a, 0x48, 0xdc, 0x69, 0xc5, 0x05, 0x2e, 0xd5, 0x4a, 0x5c, 0xb8, 0x44, 0x8e,
+	0x3d, 0x24, 0x46, 0x1b, 0xcf, 0x62, 0x3b, 0x08, 0xde, 0x82, 0xc7, 0xe0, 0xc8, 0x63, 0xf4, 0xd8,
+	0x23, 0x27, 0x54, 0x25, 0x07, 0x5e, 0x03, 0xad, 0x77, 0x37, 0x9b, 0x6b, 0x2f, 0xab, 0x99, 0x7f,
+	0xff, 0x7f, 0xac, 0xcf, 0x1e, 0x7a, 0x66, 0xec, 0x67, 0x70, 0x60, 0x15, 0x08, 0x85, 0x45, 0x21,
+	0x03, 0x38, 0x59, 0x88, 0x2f, 0xd2, 0x14, 0xa0, 0xb3, 0xd2, 0x61, 0x40, 0x76, 0xba, 0xb3, 0x64,
+	0x9d, 0x65, 0x72, 0x22, 0x57, 0xc6, 0xa2, 0x88, 0xdf, 0xda, 0x38, 0x39, 0x5d, 0xe0, 0x02, 0x63,
+	0x29, 0xaa, 0xaa, 0x51, 0x13, 0x85, 0x7e, 0x85, 0x5e, 0xcc, 0xa5, 0x07, 0xf1, 0xed, 0x62, 0x0e,
+	0x41, 0x5e, 0x08, 0x85, 0xc6, 0xd6, 0xff, 0xcf, 0x5f, 0xd2, 0x93, 0xf7, 0xf1, 0xb8, 0x6b, 0xe9,
+	0x82, 0x51, 0xa6, 0x94, 0x36, 0x30, 0x4e, 0x0f, 0xa5, 0xd6, 0x0e, 0xbc, 0xe7, 0x24, 0x25, 0xd3,
+	0xa3, 0xbc, 0x6d, 0xcf, 0xef, 0xfa, 0x94, 0x56, 0xfe, 0x1c, 0x14, 0x3a, 0xcd, 0x52, 0x3a, 0x2a,
+	0xbb, 0x5c, 0x63, 0xde, 0x97, 0xd8, 0x98, 0xf6, 0x8d, 0xe6, 0xfd, 0x94, 0x4c, 0x0f, 0xf2, 0xbe,
+	0xd1, 0xec, 0x09, 0x1d, 0x38, 0x90, 0x1e, 0x2d, 0x7f, 0x10, 0xcd, 0x4d, 0xc7, 0xce, 0xe8, 0xa3,
+	0x1a, 0x7b, 0x06, 0x25, 0xaa, 0x25, 0x3f, 0x88, 0x89, 0x51, 0xad, 0xbd, 0xab, 0x24, 0xf6, 0x81,
+	0x8e, 0x7d, 0x21, 0xfd, 0x12, 0xf4, 0x4c, 0xae, 0x70, 0x6d, 0x03, 0x7f, 0x98, 0x92, 0xe9, 0xe8,
+	0xd5, 0xd3, 0xac, 0x66, 0xcc, 0x2a, 0xc6, 0xac, 0x61, 0xcc, 0xae, 0xd0, 0xd8, 0xcb, 0xa3, 0x9b,
+	0xbf, 0xcf, 0x7a, 0xbf, 0xfe, 0xfd, 0x7e, 0x41, 0xf2, 0xe3, 0x26, 0xfb, 0x36, 0x46, 0xd9, 0x47,
+	0xfa, 0xd8, 0xc1, 0xd7, 0xb5, 0x71, 0xa0, 0x67, 0xdd, 0xbd, 0xf2, 0xc1, 0x3d, 0x26, 0xb2, 0x76,
+	0xc0, 0xd5, 0x2e, 0xcf, 0x26, 0x74, 0xe8, 0xa0, 0x00, 0xe9, 0x41, 0xf3, 0xc3, 0x94, 0x4c, 0x87,
+	0xf9, 0xae, 0x67, 0xcf, 0xe9, 0xb8, 0xad, 0x1b, 0xc8, 0x61, 0x84, 0x3c, 0x6e, 0xd5, 0x88, 0x79,
+	0x79, 0x7d, 0xb3, 0x49, 0xc8, 0xed, 0x26, 0x21, 0x77, 0x9b, 0x84, 0xfc, 0xdc, 0x26, 0xbd, 0xdb,
+	0x6d, 0xd2, 0xfb, 0xb3, 0x4d, 0x7a, 0x9f, 0xde, 0x2c, 0x4c, 0x58, 0xae, 0xe7, 0x99, 0xc2, 0x95,
+	0x28, 0x1d, 0xea, 0xb5, 0x0a, 0x5e, 0x99, 0xb8, 0x3d, 0xdd, 0x1e, 0x7d, 0xdf, 0xdf, 0xa4, 0xf0,
+	0xa3, 0x04, 0x3f, 0x1f, 0xc4, 0xa7, 0x7e, 0xfd, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x15, 0x73,
+	0xe7, 0x6e, 0x02, 0x00, 0x00,
 }
 
 func (m *JailedParticipant) Marshal() (dAtA []byte, err error) {
@@ -119,6 +244,88 @@ func (m *JailedParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JailRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JailRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JailRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleasedEpoch != 0 {
+		i = encodeVarintJailed(dAtA, i, uint64(m.ReleasedEpoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Released {
+		i--
+		if m.Released {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.RequiredCollateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintJailed(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.SlashedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintJailed(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.JailedEpoch != 0 {
+		i = encodeVarintJailed(dAtA, i, uint64(m.JailedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintJailed(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintJailed(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintJailed(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintJailed(dAtA []byte, offset int, v uint64) int {
 	offset -= sovJailed(v)
 	base := offset
@@ -143,6 +350,39 @@ func (m *JailedParticipant) Size() (n int) {
 	return n
 }
 
+func (m *JailRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovJailed(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovJailed(uint64(m.Id))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovJailed(uint64(l))
+	}
+	if m.JailedEpoch != 0 {
+		n += 1 + sovJailed(uint64(m.JailedEpoch))
+	}
+	l = m.SlashedAmount.Size()
+	n += 1 + l + sovJailed(uint64(l))
+	l = m.RequiredCollateral.Size()
+	n += 1 + l + sovJailed(uint64(l))
+	if m.Released {
+		n += 2
+	}
+	if m.ReleasedEpoch != 0 {
+		n += 1 + sovJailed(uint64(m.ReleasedEpoch))
+	}
+	return n
+}
+
 func sovJailed(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -231,6 +471,263 @@ func (m *JailedParticipant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *JailRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJailed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JailRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JailRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJailed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJailed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJailed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJailed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJailed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJailed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJailed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedEpoch", wireType)
+			}
+			m.JailedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJailed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJailed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJailed
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJailed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJailed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJailed
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJailed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequiredCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJailed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Released = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedEpoch", wireType)
+			}
+			m.ReleasedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJailed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleasedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJailed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJailed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipJailed(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
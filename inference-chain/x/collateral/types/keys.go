@@ -12,6 +12,8 @@ const (
 
 	SubAccountUnbonding = "collateral-unbonding"
 
+	SubAccountDelegated = "collateral-delegated"
+
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
 
@@ -38,4 +40,33 @@ var (
 
 	// JailedKey is the prefix for jailed participant entries
 	JailedKey = collections.NewPrefix(6)
+
+	// DelegationPrefix is the prefix for collateral delegations keyed by (host, delegator),
+	// DelegationByDelegatorIndexPrefix is its secondary index by delegator
+	DelegationPrefix                 = collections.NewPrefix(7)
+	DelegationByDelegatorIndexPrefix = collections.NewPrefix(8)
+
+	// HostCommissionKey is the prefix for host commission rates
+	HostCommissionKey = collections.NewPrefix(9)
+
+	// JailRecordPrefix is the prefix for jail history keyed by (participant, id),
+	// JailRecordSeqKey holds the next jail record id
+	JailRecordPrefix = collections.NewPrefix(10)
+	JailRecordSeqKey = collections.NewPrefix(11)
+
+	// UnbondingDelegationPrefix is the prefix for undelegated collateral keyed by (completionEpoch, host, delegator),
+	// UnbondingDelegationByHostIndexPrefix and UnbondingDelegationByDelegatorIndexPrefix are its secondary indexes
+	UnbondingDelegationPrefix                 = collections.NewPrefix(12)
+	UnbondingDelegationByHostIndexPrefix      = collections.NewPrefix(13)
+	UnbondingDelegationByDelegatorIndexPrefix = collections.NewPrefix(14)
+)
+
+// Reasons recorded in a JailRecord
+const (
+	// JailReasonConsensus is set when x/staking jails the participant's validator; it is lifted through x/slashing
+	JailReasonConsensus = "consensus"
+	// JailReasonDowntime is set when the inference module slashes the participant for missing requests
+	JailReasonDowntime = "downtime"
+	// JailReasonEquivocation is set when an executor is proven to have signed two different responses for one inference
+	JailReasonEquivocation = "equivocation"
 )
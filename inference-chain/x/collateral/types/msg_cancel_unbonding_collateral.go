@@ -0,0 +1,36 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCancelUnbondingCollateral{}
+
+// NewMsgCancelUnbondingCollateral creates a new MsgCancelUnbondingCollateral instance
+func NewMsgCancelUnbondingCollateral(participant string, completionEpoch uint64, amount sdk.Coin) *MsgCancelUnbondingCollateral {
+	return &MsgCancelUnbondingCollateral{
+		Participant:     participant,
+		CompletionEpoch: completionEpoch,
+		Amount:          amount,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (msg *MsgCancelUnbondingCollateral) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Participant)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid participant address (%s)", err)
+	}
+
+	if !msg.Amount.IsValid() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, "invalid cancellation amount")
+	}
+
+	if msg.Amount.IsZero() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, "cancellation amount cannot be zero")
+	}
+
+	return nil
+}
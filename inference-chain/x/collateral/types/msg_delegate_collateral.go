@@ -0,0 +1,47 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgDelegateCollateral{}
+
+// NewMsgDelegateCollateral creates a new MsgDelegateCollateral instance
+func NewMsgDelegateCollateral(delegator string, host string, amount sdk.Coin) *MsgDelegateCollateral {
+	return &MsgDelegateCollateral{
+		Delegator: delegator,
+		Host:      host,
+		Amount:    amount,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (msg *MsgDelegateCollateral) ValidateBasic() error {
+	return validateDelegation(msg.Delegator, msg.Host, msg.Amount)
+}
+
+func validateDelegation(delegator string, host string, amount sdk.Coin) error {
+	if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(host); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid host address (%s)", err)
+	}
+
+	if delegator == host {
+		return ErrSelfDelegation
+	}
+
+	if !amount.IsValid() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, "invalid delegation amount")
+	}
+
+	if amount.IsZero() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, "delegation amount cannot be zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetCollateralCommission{}
+
+// NewMsgSetCollateralCommission creates a new MsgSetCollateralCommission instance
+func NewMsgSetCollateralCommission(host string, rate math.LegacyDec) *MsgSetCollateralCommission {
+	return &MsgSetCollateralCommission{
+		Host: host,
+		Rate: rate,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (msg *MsgSetCollateralCommission) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Host); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid host address (%s)", err)
+	}
+
+	if msg.Rate.IsNil() || msg.Rate.IsNegative() || msg.Rate.GT(math.LegacyOneDec()) {
+		return ErrInvalidCommissionRate.Wrapf("commission rate must be between 0 and 1, got %s", msg.Rate)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgUndelegateCollateral{}
+
+// NewMsgUndelegateCollateral creates a new MsgUndelegateCollateral instance
+func NewMsgUndelegateCollateral(delegator string, host string, amount sdk.Coin) *MsgUndelegateCollateral {
+	return &MsgUndelegateCollateral{
+		Delegator: delegator,
+		Host:      host,
+		Amount:    amount,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (msg *MsgUndelegateCollateral) ValidateBasic() error {
+	return validateDelegation(msg.Delegator, msg.Host, msg.Amount)
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUnjail{}
+
+// NewMsgUnjail creates a new MsgUnjail instance
+func NewMsgUnjail(participant string) *MsgUnjail {
+	return &MsgUnjail{
+		Participant: participant,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (msg *MsgUnjail) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Participant)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid participant address (%s)", err)
+	}
+
+	return nil
+}
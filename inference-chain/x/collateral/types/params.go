@@ -11,11 +11,13 @@ var _ paramtypes.ParamSet = (*Params)(nil)
 // Default parameter values
 var (
 	DefaultUnbondingPeriodEpochs = uint64(1) // 1 epoch
+	DefaultMinJailEpochs         = uint64(2) // 2 epochs
 )
 
 // Parameter store keys
 var (
 	KeyUnbondingPeriodEpochs = []byte("UnbondingPeriodEpochs")
+	KeyMinJailEpochs         = []byte("MinJailEpochs")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -26,9 +28,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params instance
 func NewParams(
 	unbondingPeriodEpochs uint64,
+	minJailEpochs uint64,
 ) Params {
 	return Params{
 		UnbondingPeriodEpochs: unbondingPeriodEpochs,
+		MinJailEpochs:         minJailEpochs,
 	}
 }
 
@@ -36,6 +40,7 @@ func NewParams(
 func DefaultParams() Params {
 	return NewParams(
 		DefaultUnbondingPeriodEpochs,
+		DefaultMinJailEpochs,
 	)
 }
 
@@ -43,6 +48,7 @@ func DefaultParams() Params {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyUnbondingPeriodEpochs, &p.UnbondingPeriodEpochs, validateUnbondingPeriodEpochs),
+		paramtypes.NewParamSetPair(KeyMinJailEpochs, &p.MinJailEpochs, validateMinJailEpochs),
 	}
 }
 
@@ -51,6 +57,9 @@ func (p Params) Validate() error {
 	if err := validateUnbondingPeriodEpochs(p.UnbondingPeriodEpochs); err != nil {
 		return err
 	}
+	if err := validateMinJailEpochs(p.MinJailEpochs); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -67,3 +76,12 @@ func validateUnbondingPeriodEpochs(v interface{}) error {
 
 	return nil
 }
+
+// validateMinJailEpochs validates the MinJailEpochs param. Zero lets participants unjail in the epoch they were jailed.
+func validateMinJailEpochs(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
@@ -28,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// UnbondingPeriodEpochs is the number of epochs a withdrawal must wait before being released
 	UnbondingPeriodEpochs uint64 `protobuf:"varint,1,opt,name=unbonding_period_epochs,json=unbondingPeriodEpochs,proto3" json:"unbonding_period_epochs,omitempty"`
+	// MinJailEpochs is the number of epochs a jailed participant must wait before it can unjail itself
+	MinJailEpochs uint64 `protobuf:"varint,2,opt,name=min_jail_epochs,json=minJailEpochs,proto3" json:"min_jail_epochs,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +72,13 @@ func (m *Params) GetUnbondingPeriodEpochs() uint64 {
 	return 0
 }
 
+func (m *Params) GetMinJailEpochs() uint64 {
+	if m != nil {
+		return m.MinJailEpochs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "inference.collateral.Params")
 }
@@ -77,21 +86,23 @@ func init() {
 func init() { proto.RegisterFile("inference/collateral/params.proto", fileDescriptor_3c54d7995682b333) }
 
 var fileDescriptor_3c54d7995682b333 = []byte{
-	// 224 bytes of a gzipped FileDescriptorProto
+	// 253 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0xcc, 0x4b, 0x4b,
 	0x2d, 0x4a, 0xcd, 0x4b, 0x4e, 0xd5, 0x4f, 0xce, 0xcf, 0xc9, 0x49, 0x2c, 0x49, 0x2d, 0x4a, 0xcc,
 	0xd1, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81,
 	0x2b, 0xd1, 0x43, 0x28, 0x91, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x85,
-	0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0xca, 0xe0, 0x62,
-	0x0b, 0x00, 0x1b, 0x27, 0x64, 0xc6, 0x25, 0x5e, 0x9a, 0x97, 0x94, 0x9f, 0x97, 0x92, 0x99, 0x97,
-	0x1e, 0x5f, 0x90, 0x5a, 0x94, 0x99, 0x9f, 0x12, 0x9f, 0x5a, 0x90, 0x9f, 0x9c, 0x51, 0x2c, 0xc1,
-	0xa8, 0xc0, 0xa8, 0xc1, 0x12, 0x24, 0x0a, 0x97, 0x0e, 0x00, 0xcb, 0xba, 0x82, 0x25, 0xad, 0xd4,
-	0x5e, 0x2c, 0x90, 0x67, 0xec, 0x7a, 0xbe, 0x41, 0x4b, 0x16, 0xe1, 0xd8, 0x0a, 0x64, 0xe7, 0x42,
-	0xcc, 0x77, 0x0a, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
-	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xb3, 0xf4,
-	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x82, 0xa2, 0xfc, 0x94, 0xd2, 0xe4,
-	0x92, 0xe2, 0xe4, 0x4c, 0xb0, 0x41, 0x38, 0x8c, 0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03,
-	0x7b, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x22, 0x46, 0x9f, 0x1c, 0x26, 0x01, 0x00, 0x00,
+	0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0x9a, 0xc0, 0xc8,
+	0xc5, 0x16, 0x00, 0x36, 0x4f, 0xc8, 0x8c, 0x4b, 0xbc, 0x34, 0x2f, 0x29, 0x3f, 0x2f, 0x25, 0x33,
+	0x2f, 0x3d, 0xbe, 0x20, 0xb5, 0x28, 0x33, 0x3f, 0x25, 0x3e, 0xb5, 0x20, 0x3f, 0x39, 0xa3, 0x58,
+	0x82, 0x51, 0x81, 0x51, 0x83, 0x25, 0x48, 0x14, 0x2e, 0x1d, 0x00, 0x96, 0x75, 0x05, 0x4b, 0x0a,
+	0xa9, 0x71, 0xf1, 0xe7, 0x66, 0xe6, 0xc5, 0x67, 0x25, 0x66, 0xe6, 0xc0, 0xd4, 0x33, 0x81, 0xd5,
+	0xf3, 0xe6, 0x66, 0xe6, 0x79, 0x25, 0x66, 0xe6, 0x40, 0xd4, 0x59, 0xa9, 0xbd, 0x58, 0x20, 0xcf,
+	0xd8, 0xf5, 0x7c, 0x83, 0x96, 0x2c, 0xc2, 0x57, 0x15, 0xc8, 0xfe, 0x82, 0xb8, 0xc3, 0x29, 0xe0,
+	0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e,
+	0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xcc, 0xd2, 0x33, 0x4b, 0x32, 0x4a,
+	0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x0b, 0x8a, 0xf2, 0x53, 0x4a, 0x93, 0x4b, 0x8a, 0x93, 0x33,
+	0xc1, 0x06, 0xe1, 0x30, 0xb2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x57, 0x63, 0x40,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x65, 0xd3, 0x32, 0x0d, 0x4f, 0x01, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -116,6 +127,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.UnbondingPeriodEpochs != that1.UnbondingPeriodEpochs {
 		return false
 	}
+	if this.MinJailEpochs != that1.MinJailEpochs {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -138,6 +152,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinJailEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinJailEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.UnbondingPeriodEpochs != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.UnbondingPeriodEpochs))
 		i--
@@ -166,6 +185,9 @@ func (m *Params) Size() (n int) {
 	if m.UnbondingPeriodEpochs != 0 {
 		n += 1 + sovParams(uint64(m.UnbondingPeriodEpochs))
 	}
+	if m.MinJailEpochs != 0 {
+		n += 1 + sovParams(uint64(m.MinJailEpochs))
+	}
 	return n
 }
 
@@ -223,6 +245,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinJailEpochs", wireType)
+			}
+			m.MinJailEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinJailEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
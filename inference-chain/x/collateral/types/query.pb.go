@@ -490,6 +490,312 @@ func (m *QueryAllUnbondingCollateralsResponse) GetPagination() *query.PageRespon
 	return nil
 }
 
+// QueryHostDelegationsRequest is the request type for the Query/HostDelegations RPC method.
+type QueryHostDelegationsRequest struct {
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (m *QueryHostDelegationsRequest) Reset()         { *m = QueryHostDelegationsRequest{} }
+func (m *QueryHostDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHostDelegationsRequest) ProtoMessage()    {}
+func (*QueryHostDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{10}
+}
+func (m *QueryHostDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHostDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostDelegationsRequest.Merge(m, src)
+}
+func (m *QueryHostDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHostDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryHostDelegationsRequest) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+// QueryHostDelegationsResponse is the response type for the Query/HostDelegations RPC method.
+type QueryHostDelegationsResponse struct {
+	Delegations []CollateralDelegation `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	// total_delegated is the sum of all delegations to the host
+	TotalDelegated types.Coin `protobuf:"bytes,2,opt,name=total_delegated,json=totalDelegated,proto3" json:"total_delegated"`
+	// commission is the host's commission, zero if it never set one
+	Commission HostCommission `protobuf:"bytes,3,opt,name=commission,proto3" json:"commission"`
+}
+
+func (m *QueryHostDelegationsResponse) Reset()         { *m = QueryHostDelegationsResponse{} }
+func (m *QueryHostDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHostDelegationsResponse) ProtoMessage()    {}
+func (*QueryHostDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{11}
+}
+func (m *QueryHostDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHostDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostDelegationsResponse.Merge(m, src)
+}
+func (m *QueryHostDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHostDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryHostDelegationsResponse) GetDelegations() []CollateralDelegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *QueryHostDelegationsResponse) GetTotalDelegated() types.Coin {
+	if m != nil {
+		return m.TotalDelegated
+	}
+	return types.Coin{}
+}
+
+func (m *QueryHostDelegationsResponse) GetCommission() HostCommission {
+	if m != nil {
+		return m.Commission
+	}
+	return HostCommission{}
+}
+
+// QueryDelegatorDelegationsRequest is the request type for the Query/DelegatorDelegations RPC method.
+type QueryDelegatorDelegationsRequest struct {
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *QueryDelegatorDelegationsRequest) Reset()         { *m = QueryDelegatorDelegationsRequest{} }
+func (m *QueryDelegatorDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorDelegationsRequest) ProtoMessage()    {}
+func (*QueryDelegatorDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{12}
+}
+func (m *QueryDelegatorDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorDelegationsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorDelegationsRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+// QueryDelegatorDelegationsResponse is the response type for the Query/DelegatorDelegations RPC method.
+type QueryDelegatorDelegationsResponse struct {
+	Delegations []CollateralDelegation `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	// unbonding_delegations is the collateral the delegator undelegated that has not been released yet
+	UnbondingDelegations []UnbondingDelegation `protobuf:"bytes,2,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations"`
+}
+
+func (m *QueryDelegatorDelegationsResponse) Reset()         { *m = QueryDelegatorDelegationsResponse{} }
+func (m *QueryDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorDelegationsResponse) ProtoMessage()    {}
+func (*QueryDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{13}
+}
+func (m *QueryDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorDelegationsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorDelegationsResponse) GetDelegations() []CollateralDelegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *QueryDelegatorDelegationsResponse) GetUnbondingDelegations() []UnbondingDelegation {
+	if m != nil {
+		return m.UnbondingDelegations
+	}
+	return nil
+}
+
+// QueryJailHistoryRequest is the request type for the Query/JailHistory RPC method.
+type QueryJailHistoryRequest struct {
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+}
+
+func (m *QueryJailHistoryRequest) Reset()         { *m = QueryJailHistoryRequest{} }
+func (m *QueryJailHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryJailHistoryRequest) ProtoMessage()    {}
+func (*QueryJailHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{14}
+}
+func (m *QueryJailHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryJailHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryJailHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryJailHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJailHistoryRequest.Merge(m, src)
+}
+func (m *QueryJailHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryJailHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJailHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryJailHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryJailHistoryRequest) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+// QueryJailHistoryResponse is the response type for the Query/JailHistory RPC method.
+type QueryJailHistoryResponse struct {
+	// jailed reports whether the participant is currently jailed for any reason
+	Jailed  bool         `protobuf:"varint,1,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	Records []JailRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryJailHistoryResponse) Reset()         { *m = QueryJailHistoryResponse{} }
+func (m *QueryJailHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryJailHistoryResponse) ProtoMessage()    {}
+func (*QueryJailHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eba28a79880c27ca, []int{15}
+}
+func (m *QueryJailHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryJailHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryJailHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryJailHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJailHistoryResponse.Merge(m, src)
+}
+func (m *QueryJailHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryJailHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJailHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryJailHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryJailHistoryResponse) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *QueryJailHistoryResponse) GetRecords() []JailRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "inference.collateral.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "inference.collateral.QueryParamsResponse")
@@ -501,55 +807,81 @@ func init() {
 	proto.RegisterType((*QueryUnbondingCollateralResponse)(nil), "inference.collateral.QueryUnbondingCollateralResponse")
 	proto.RegisterType((*QueryAllUnbondingCollateralsRequest)(nil), "inference.collateral.QueryAllUnbondingCollateralsRequest")
 	proto.RegisterType((*QueryAllUnbondingCollateralsResponse)(nil), "inference.collateral.QueryAllUnbondingCollateralsResponse")
+	proto.RegisterType((*QueryHostDelegationsRequest)(nil), "inference.collateral.QueryHostDelegationsRequest")
+	proto.RegisterType((*QueryHostDelegationsResponse)(nil), "inference.collateral.QueryHostDelegationsResponse")
+	proto.RegisterType((*QueryDelegatorDelegationsRequest)(nil), "inference.collateral.QueryDelegatorDelegationsRequest")
+	proto.RegisterType((*QueryDelegatorDelegationsResponse)(nil), "inference.collateral.QueryDelegatorDelegationsResponse")
+	proto.RegisterType((*QueryJailHistoryRequest)(nil), "inference.collateral.QueryJailHistoryRequest")
+	proto.RegisterType((*QueryJailHistoryResponse)(nil), "inference.collateral.QueryJailHistoryResponse")
 }
 
 func init() { proto.RegisterFile("inference/collateral/query.proto", fileDescriptor_eba28a79880c27ca) }
 
 var fileDescriptor_eba28a79880c27ca = []byte{
-	// 681 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0x33, 0x05, 0x82, 0x38, 0x91, 0x58, 0x4c, 0x2b, 0x5a, 0x4c, 0xe5, 0x06, 0x53, 0xd1,
-	0x16, 0xa8, 0xdd, 0xa4, 0xa2, 0x15, 0x15, 0x17, 0xd1, 0x72, 0x59, 0x21, 0xda, 0x48, 0x6c, 0xd8,
-	0xa0, 0xb1, 0x3b, 0x18, 0x4b, 0xce, 0x8c, 0xeb, 0x0b, 0xa2, 0x42, 0x6c, 0x78, 0x02, 0x04, 0x1b,
-	0x9e, 0x02, 0xb1, 0x61, 0xcf, 0xb2, 0x62, 0x55, 0x89, 0x0d, 0x2b, 0x84, 0x5a, 0x1e, 0x04, 0x65,
-	0x3c, 0x8e, 0x1d, 0x32, 0x31, 0x49, 0x55, 0x76, 0x23, 0xe7, 0x3f, 0xff, 0xf9, 0xce, 0x3f, 0x97,
-	0x40, 0xdd, 0x63, 0xcf, 0x69, 0x48, 0x99, 0x43, 0x2d, 0x87, 0xfb, 0x3e, 0x89, 0x69, 0x48, 0x7c,
-	0x6b, 0x27, 0xa1, 0xe1, 0xae, 0x19, 0x84, 0x3c, 0xe6, 0x78, 0xa2, 0xab, 0x30, 0x73, 0x85, 0x36,
-	0xe1, 0x72, 0x97, 0x0b, 0x81, 0xd5, 0x59, 0xa5, 0x5a, 0x6d, 0xda, 0xe5, 0xdc, 0xf5, 0xa9, 0x45,
-	0x02, 0xcf, 0x22, 0x8c, 0xf1, 0x98, 0xc4, 0x1e, 0x67, 0x91, 0xfc, 0xf5, 0x8a, 0xc3, 0xa3, 0x36,
-	0x8f, 0x2c, 0x9b, 0x44, 0x34, 0x6d, 0x61, 0xbd, 0x6c, 0xd8, 0x34, 0x26, 0x0d, 0x2b, 0x20, 0xae,
-	0xc7, 0x84, 0x58, 0x6a, 0x2f, 0x2a, 0xb9, 0x02, 0x12, 0x92, 0x76, 0x66, 0xa7, 0x17, 0xed, 0x32,
-	0x23, 0x87, 0x7b, 0x99, 0xc5, 0xac, 0xd2, 0x22, 0x61, 0x36, 0x67, 0xdb, 0x1e, 0x73, 0xa5, 0x6a,
-	0x51, 0xa9, 0xca, 0x97, 0xcf, 0x6c, 0xe2, 0x93, 0xce, 0xf0, 0x42, 0x6e, 0x4c, 0x00, 0xde, 0xea,
-	0x90, 0x6f, 0x0a, 0x92, 0x16, 0xdd, 0x49, 0x68, 0x14, 0x1b, 0x5b, 0x30, 0xde, 0xf3, 0x35, 0x0a,
-	0x38, 0x8b, 0x28, 0x5e, 0x83, 0x6a, 0x4a, 0x3c, 0x85, 0xea, 0x68, 0xbe, 0xd6, 0x9c, 0x36, 0x55,
-	0x59, 0x9a, 0x69, 0xd5, 0xfa, 0xc9, 0xbd, 0x9f, 0x33, 0x95, 0x96, 0xac, 0x30, 0xd6, 0xe0, 0x9c,
-	0xb0, 0xdc, 0xe8, 0xea, 0x64, 0x33, 0x5c, 0x87, 0x5a, 0x40, 0xc2, 0xd8, 0x73, 0xbc, 0x80, 0xb0,
-	0x58, 0x58, 0x9f, 0x69, 0x15, 0x3f, 0x19, 0x2d, 0x98, 0xec, 0xab, 0x95, 0x48, 0xab, 0x50, 0x25,
-	0x6d, 0x9e, 0xc8, 0xba, 0x5a, 0xf3, 0xbc, 0x99, 0xa6, 0x68, 0x76, 0x52, 0x34, 0x65, 0x8a, 0xe6,
-	0x06, 0xf7, 0x58, 0xc6, 0x93, 0xca, 0x8d, 0x6d, 0xd0, 0x84, 0xe7, 0x5d, 0xdf, 0xcf, 0x6d, 0xb3,
-	0x00, 0xf0, 0x03, 0x80, 0x7c, 0x0b, 0xa5, 0xf5, 0xe5, 0x1e, 0xeb, 0xf4, 0x48, 0x65, 0x0d, 0x36,
-	0x89, 0x4b, 0x65, 0x6d, 0xab, 0x50, 0x69, 0x7c, 0x41, 0x70, 0x41, 0xd9, 0x46, 0xe2, 0x3f, 0x02,
-	0xc8, 0x83, 0x9b, 0x42, 0xf5, 0x13, 0xf3, 0xb5, 0xe6, 0x9c, 0x3a, 0xd5, 0xbc, 0x7c, 0x3d, 0xdd,
-	0x41, 0x39, 0x50, 0xc1, 0x00, 0x3f, 0xec, 0xc1, 0x1e, 0x13, 0xd8, 0x73, 0xff, 0xc4, 0x4e, 0x59,
-	0x7a, 0xb8, 0x37, 0x60, 0x46, 0x60, 0x3f, 0xc9, 0x4e, 0xd7, 0x51, 0xb6, 0x2d, 0x82, 0xfa, 0x60,
-	0x13, 0x19, 0xc0, 0x63, 0x80, 0xee, 0x09, 0x8e, 0x64, 0x00, 0x0b, 0xea, 0x00, 0x14, 0x36, 0x59,
-	0x04, 0xb9, 0x85, 0xd1, 0x86, 0x4b, 0x59, 0xe0, 0x8a, 0x82, 0x63, 0xdf, 0xe0, 0xaf, 0x08, 0x66,
-	0xcb, 0xfb, 0xfd, 0xa7, 0x41, 0x8f, 0x6d, 0xaf, 0x9b, 0x1f, 0x4f, 0xc3, 0x29, 0x31, 0x02, 0x7e,
-	0x8f, 0xa0, 0x9a, 0x5e, 0x5e, 0x3c, 0xaf, 0x46, 0xeb, 0x7f, 0x2b, 0xb4, 0x85, 0x21, 0x94, 0x69,
-	0x57, 0x63, 0xf9, 0xed, 0xf7, 0xdf, 0x1f, 0xc6, 0x16, 0xf1, 0x55, 0x2b, 0x08, 0xf9, 0x76, 0xe2,
-	0xc4, 0x91, 0xe3, 0x89, 0x97, 0xaa, 0xe4, 0x71, 0xc4, 0x9f, 0x11, 0x40, 0x1e, 0x04, 0xbe, 0x56,
-	0xd2, 0xae, 0xef, 0x90, 0x6a, 0x8b, 0x43, 0xaa, 0x25, 0xe0, 0x7d, 0x01, 0x78, 0x07, 0xdf, 0x1a,
-	0x0a, 0xb0, 0xb0, 0x7c, 0x5d, 0x38, 0xf7, 0x6f, 0xf0, 0x27, 0x04, 0x67, 0x7b, 0x2f, 0x3c, 0x5e,
-	0x2a, 0x01, 0x51, 0x3e, 0x41, 0x5a, 0x63, 0x84, 0x0a, 0x89, 0xbf, 0x2a, 0xf0, 0x1b, 0xd8, 0x1a,
-	0x11, 0x1f, 0x7f, 0x43, 0x30, 0xae, 0x38, 0x75, 0xf8, 0x7a, 0x09, 0xc3, 0xe0, 0xa7, 0x41, 0x5b,
-	0x19, 0xb5, 0x4c, 0xf2, 0xdf, 0x13, 0xfc, 0xb7, 0xf1, 0xcd, 0xa1, 0xf8, 0xbb, 0x77, 0xe1, 0xaf,
-	0xf4, 0xf7, 0x10, 0x4c, 0x0e, 0xb8, 0x8d, 0xf8, 0x46, 0x79, 0xa8, 0x25, 0x2f, 0x86, 0xb6, 0x76,
-	0x94, 0x52, 0x39, 0xd8, 0x8a, 0x18, 0x6c, 0x09, 0x9b, 0xa3, 0x0d, 0xb6, 0xbe, 0xb9, 0x77, 0xa0,
-	0xa3, 0xfd, 0x03, 0x1d, 0xfd, 0x3a, 0xd0, 0xd1, 0xbb, 0x43, 0xbd, 0xb2, 0x7f, 0xa8, 0x57, 0x7e,
-	0x1c, 0xea, 0x95, 0xa7, 0x2b, 0xae, 0x17, 0xbf, 0x48, 0x6c, 0xd3, 0xe1, 0xed, 0xc1, 0x9e, 0xaf,
-	0x8a, 0xae, 0xf1, 0x6e, 0x40, 0x23, 0xbb, 0x2a, 0xfe, 0xf6, 0x97, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xb0, 0x22, 0x4b, 0x7b, 0x28, 0x09, 0x00, 0x00,
+	// 999 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0xa4, 0x65, 0xa1, 0x6f, 0xa5, 0x56, 0x9a, 0x2e, 0x6d, 0x30, 0xd1, 0x76, 0x6b, 0x02,
+	0x4d, 0x0b, 0xb1, 0xbb, 0x5b, 0x91, 0x88, 0xf0, 0xa3, 0x6d, 0x12, 0xda, 0xa8, 0x12, 0x90, 0xae,
+	0xc4, 0x85, 0x4b, 0x34, 0x6b, 0x0f, 0x8e, 0x91, 0xd7, 0xe3, 0x7a, 0xbc, 0x88, 0x28, 0xca, 0x85,
+	0x13, 0x47, 0x04, 0xff, 0x07, 0xe2, 0xd2, 0x2b, 0xe2, 0x18, 0x71, 0xaa, 0xc4, 0x01, 0x2e, 0x20,
+	0x94, 0x70, 0xe3, 0x9f, 0x40, 0x1e, 0xcf, 0xd8, 0xde, 0xee, 0xc4, 0xf1, 0x46, 0xcd, 0x25, 0x72,
+	0xec, 0xf7, 0x7d, 0xf3, 0x7d, 0xef, 0xbd, 0x79, 0x2f, 0x81, 0x8e, 0x1f, 0x7e, 0x49, 0x63, 0x1a,
+	0x3a, 0xd4, 0x76, 0x58, 0x10, 0x90, 0x84, 0xc6, 0x24, 0xb0, 0x9f, 0x8c, 0x68, 0xbc, 0x6b, 0x45,
+	0x31, 0x4b, 0x18, 0x6e, 0xe5, 0x11, 0x56, 0x11, 0x61, 0xb4, 0x3c, 0xe6, 0x31, 0x11, 0x60, 0xa7,
+	0x4f, 0x59, 0xac, 0x31, 0xef, 0x31, 0xe6, 0x05, 0xd4, 0x26, 0x91, 0x6f, 0x93, 0x30, 0x64, 0x09,
+	0x49, 0x7c, 0x16, 0x72, 0xf9, 0xf5, 0x96, 0xc3, 0xf8, 0x90, 0x71, 0x7b, 0x40, 0x38, 0xcd, 0x8e,
+	0xb0, 0xbf, 0xee, 0x0e, 0x68, 0x42, 0xba, 0x76, 0x44, 0x3c, 0x3f, 0x14, 0xc1, 0x32, 0xf6, 0xba,
+	0x56, 0x57, 0x44, 0x62, 0x32, 0x54, 0x74, 0xed, 0x32, 0x9d, 0x22, 0x72, 0x98, 0xaf, 0x28, 0x16,
+	0xb4, 0x14, 0xa3, 0x70, 0xc0, 0x42, 0xd7, 0x0f, 0x3d, 0x19, 0xb5, 0xa4, 0x8d, 0x2a, 0x1e, 0xb7,
+	0x07, 0x24, 0x20, 0xa9, 0xf9, 0x2c, 0xfc, 0x4d, 0x6d, 0xb8, 0x4b, 0x03, 0xea, 0x9d, 0x2c, 0xff,
+	0x2b, 0xe2, 0x07, 0xd4, 0xcd, 0x42, 0xcc, 0x16, 0xe0, 0xc7, 0x69, 0x0e, 0xb6, 0x84, 0xa7, 0x3e,
+	0x7d, 0x32, 0xa2, 0x3c, 0x31, 0x1f, 0xc3, 0xe5, 0xb1, 0xb7, 0x3c, 0x62, 0x21, 0xa7, 0x78, 0x15,
+	0x1a, 0x99, 0xf7, 0x39, 0xd4, 0x41, 0x8b, 0xcd, 0xde, 0xbc, 0xa5, 0xab, 0x8a, 0x95, 0xa1, 0xd6,
+	0xce, 0x1f, 0xfc, 0x7d, 0x6d, 0xa6, 0x2f, 0x11, 0xe6, 0x2a, 0x5c, 0x11, 0x94, 0xeb, 0x79, 0x9c,
+	0x3c, 0x0c, 0x77, 0xa0, 0x19, 0x91, 0x38, 0xf1, 0x1d, 0x3f, 0x22, 0x61, 0x22, 0xa8, 0x2f, 0xf4,
+	0xcb, 0xaf, 0xcc, 0x3e, 0x5c, 0x9d, 0xc0, 0x4a, 0x49, 0x2b, 0xd0, 0x20, 0x43, 0x36, 0x92, 0xb8,
+	0x66, 0xef, 0x35, 0x2b, 0xab, 0x87, 0x95, 0xd6, 0xc3, 0x92, 0xf5, 0xb0, 0xd6, 0x99, 0x1f, 0x2a,
+	0x3d, 0x59, 0xb8, 0xe9, 0x82, 0x21, 0x38, 0xef, 0x07, 0x41, 0x41, 0xab, 0x12, 0x80, 0x1f, 0x00,
+	0x14, 0xcd, 0x20, 0xa9, 0xdf, 0x1a, 0xa3, 0xce, 0x9a, 0x53, 0x1d, 0xb0, 0x45, 0x3c, 0x2a, 0xb1,
+	0xfd, 0x12, 0xd2, 0x7c, 0x8a, 0xe0, 0x75, 0xed, 0x31, 0x52, 0xfe, 0x27, 0x00, 0x45, 0xe2, 0xe6,
+	0x50, 0xe7, 0xdc, 0x62, 0xb3, 0x77, 0x43, 0x9f, 0xd5, 0x02, 0xbe, 0x96, 0xf5, 0x82, 0x34, 0x54,
+	0x22, 0xc0, 0x0f, 0xc7, 0x64, 0xcf, 0x0a, 0xd9, 0x37, 0x4e, 0x94, 0x9d, 0x69, 0x19, 0xd3, 0xbd,
+	0x0e, 0xd7, 0x84, 0xec, 0xcf, 0x55, 0x9f, 0x9e, 0xa6, 0x6c, 0x1c, 0x3a, 0xc7, 0x93, 0xc8, 0x04,
+	0x7c, 0x06, 0x90, 0xdf, 0x05, 0x2e, 0x13, 0x70, 0x53, 0x9f, 0x00, 0x0d, 0x8d, 0x4a, 0x41, 0x41,
+	0x61, 0x0e, 0xe1, 0x0d, 0x95, 0x70, 0x0d, 0xe0, 0x85, 0x17, 0xf8, 0x57, 0x04, 0x0b, 0xd5, 0xe7,
+	0x9d, 0x91, 0xd1, 0x17, 0x57, 0xeb, 0xae, 0x6c, 0xd1, 0x4d, 0xc6, 0x93, 0x8d, 0x7c, 0x84, 0xe4,
+	0x99, 0xc2, 0x70, 0x7e, 0x87, 0x71, 0x55, 0x60, 0xf1, 0x6c, 0x7e, 0x37, 0x0b, 0xf3, 0x7a, 0x8c,
+	0x74, 0xdb, 0x87, 0x66, 0x31, 0x8d, 0x94, 0xdd, 0x5b, 0x27, 0x35, 0x76, 0xc1, 0x24, 0xfd, 0x96,
+	0x49, 0xf0, 0x26, 0x5c, 0x4a, 0x58, 0x42, 0x82, 0x6d, 0xf9, 0x92, 0xba, 0xd2, 0xf5, 0x89, 0x77,
+	0xfe, 0xa2, 0xc0, 0x6d, 0x28, 0x18, 0x7e, 0x94, 0xde, 0xba, 0xe1, 0xd0, 0xe7, 0x3c, 0x4d, 0xdd,
+	0x39, 0x41, 0xb2, 0xa0, 0x17, 0x97, 0x1a, 0x5c, 0xcf, 0x63, 0x8b, 0x2b, 0xa7, 0xde, 0x98, 0xf7,
+	0x64, 0x93, 0x4b, 0x76, 0x16, 0x6b, 0x52, 0x38, 0x0f, 0x17, 0x5c, 0xf5, 0x59, 0xe6, 0xb1, 0x78,
+	0x61, 0xfe, 0x85, 0xe0, 0x7a, 0x05, 0xc5, 0x19, 0x66, 0xd4, 0x85, 0x57, 0xf3, 0x86, 0xda, 0x2e,
+	0xb3, 0xcf, 0xd6, 0x6a, 0xcf, 0x09, 0xf2, 0xd6, 0x68, 0xf2, 0x13, 0x37, 0xdf, 0x97, 0xd3, 0xfb,
+	0x11, 0xf1, 0x83, 0x4d, 0x9f, 0x27, 0x2c, 0xde, 0xad, 0x3f, 0x43, 0x12, 0x98, 0x9b, 0x04, 0xcb,
+	0x94, 0x5c, 0x81, 0x46, 0xb6, 0xcb, 0x04, 0xf0, 0x95, 0xbe, 0xfc, 0x0d, 0xdf, 0x83, 0x97, 0x63,
+	0xea, 0xb0, 0xd8, 0x55, 0x46, 0x3a, 0x7a, 0x23, 0x29, 0x67, 0x5f, 0x04, 0x4a, 0xfd, 0x0a, 0xd6,
+	0xfb, 0xaf, 0x09, 0x2f, 0x89, 0x63, 0xf1, 0x0f, 0x08, 0x1a, 0xd9, 0x3e, 0xc3, 0x8b, 0x7a, 0x96,
+	0xc9, 0xf5, 0x69, 0xdc, 0xac, 0x11, 0x99, 0x79, 0x30, 0xef, 0x7c, 0xfb, 0xfb, 0xbf, 0x3f, 0xce,
+	0x2e, 0xe1, 0xb7, 0xed, 0x28, 0x66, 0xee, 0xc8, 0x49, 0xb8, 0xe3, 0x8b, 0x85, 0x5d, 0xf1, 0x97,
+	0x07, 0xfe, 0x19, 0x01, 0x14, 0x35, 0xc6, 0xef, 0x54, 0x1c, 0x37, 0x31, 0xb7, 0x8d, 0xa5, 0x9a,
+	0xd1, 0x52, 0xe0, 0xc7, 0x42, 0xe0, 0x5d, 0xfc, 0x61, 0x2d, 0x81, 0xa5, 0xc7, 0xbd, 0x52, 0x19,
+	0xf7, 0xf1, 0x4f, 0x08, 0x2e, 0x8e, 0xef, 0x40, 0x7c, 0xbb, 0x42, 0x88, 0x76, 0x2b, 0x1b, 0xdd,
+	0x29, 0x10, 0x52, 0xfe, 0x8a, 0x90, 0xdf, 0xc5, 0xf6, 0x94, 0xf2, 0xf1, 0x6f, 0x08, 0x2e, 0x6b,
+	0x06, 0x31, 0x7e, 0xb7, 0x42, 0xc3, 0xf1, 0xdb, 0xd2, 0x58, 0x9e, 0x16, 0x26, 0xf5, 0x6f, 0x08,
+	0xfd, 0x1f, 0xe1, 0x0f, 0x6a, 0xe9, 0xcf, 0xef, 0xdf, 0x73, 0xd9, 0x3f, 0x40, 0x70, 0xf5, 0x98,
+	0x05, 0x85, 0xdf, 0xab, 0x4e, 0x6a, 0xc5, 0x12, 0x35, 0x56, 0x4f, 0x03, 0x95, 0xc6, 0x96, 0x85,
+	0xb1, 0xdb, 0xd8, 0x9a, 0xce, 0x18, 0xfe, 0x05, 0xc1, 0xa5, 0xe7, 0xb6, 0x0e, 0xae, 0xea, 0x0b,
+	0xfd, 0x56, 0x33, 0x7a, 0xd3, 0x40, 0x4e, 0x55, 0x8b, 0xd2, 0x3c, 0xb5, 0xd3, 0xa5, 0x69, 0xef,
+	0xa5, 0x3f, 0xf7, 0xf1, 0x1f, 0x08, 0x5a, 0xba, 0x49, 0x8f, 0xab, 0x5a, 0xa4, 0x62, 0xbb, 0x18,
+	0x2b, 0x53, 0xe3, 0xa4, 0x9f, 0x4f, 0x85, 0x9f, 0x4d, 0xfc, 0x60, 0x6a, 0x3f, 0xf9, 0xf2, 0xb2,
+	0xf7, 0xf2, 0xc7, 0x7d, 0xfc, 0x14, 0x41, 0xb3, 0x34, 0xa7, 0x71, 0xd5, 0xa4, 0x99, 0x5c, 0x06,
+	0x86, 0x55, 0x37, 0x5c, 0xca, 0x7f, 0x28, 0xe4, 0xdf, 0xc7, 0x77, 0x6b, 0xc9, 0x4f, 0x77, 0xc3,
+	0xf6, 0x4e, 0x46, 0x31, 0x7e, 0x3b, 0xd6, 0xb6, 0x0e, 0x0e, 0xdb, 0xe8, 0xd9, 0x61, 0x1b, 0xfd,
+	0x73, 0xd8, 0x46, 0xdf, 0x1f, 0xb5, 0x67, 0x9e, 0x1d, 0xb5, 0x67, 0xfe, 0x3c, 0x6a, 0xcf, 0x7c,
+	0xb1, 0xec, 0xf9, 0xc9, 0xce, 0x68, 0x60, 0x39, 0x6c, 0x78, 0xfc, 0x21, 0xdf, 0x94, 0x8f, 0x49,
+	0x76, 0x23, 0xca, 0x07, 0x0d, 0xf1, 0xcf, 0xd5, 0x9d, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x44,
+	0x9a, 0x81, 0x0f, 0xd8, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -574,6 +906,12 @@ type QueryClient interface {
 	UnbondingCollateral(ctx context.Context, in *QueryUnbondingCollateralRequest, opts ...grpc.CallOption) (*QueryUnbondingCollateralResponse, error)
 	// Queries all unbonding collateral.
 	AllUnbondingCollaterals(ctx context.Context, in *QueryAllUnbondingCollateralsRequest, opts ...grpc.CallOption) (*QueryAllUnbondingCollateralsResponse, error)
+	// Queries the delegations backing a host, together with its commission.
+	HostDelegations(ctx context.Context, in *QueryHostDelegationsRequest, opts ...grpc.CallOption) (*QueryHostDelegationsResponse, error)
+	// Queries all delegations made by a delegator.
+	DelegatorDelegations(ctx context.Context, in *QueryDelegatorDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorDelegationsResponse, error)
+	// Queries a participant's jail history, oldest first.
+	JailHistory(ctx context.Context, in *QueryJailHistoryRequest, opts ...grpc.CallOption) (*QueryJailHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -629,6 +967,33 @@ func (c *queryClient) AllUnbondingCollaterals(ctx context.Context, in *QueryAllU
 	return out, nil
 }
 
+func (c *queryClient) HostDelegations(ctx context.Context, in *QueryHostDelegationsRequest, opts ...grpc.CallOption) (*QueryHostDelegationsResponse, error) {
+	out := new(QueryHostDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Query/HostDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorDelegations(ctx context.Context, in *QueryDelegatorDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorDelegationsResponse, error) {
+	out := new(QueryDelegatorDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Query/DelegatorDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) JailHistory(ctx context.Context, in *QueryJailHistoryRequest, opts ...grpc.CallOption) (*QueryJailHistoryResponse, error) {
+	out := new(QueryJailHistoryResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Query/JailHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -641,6 +1006,12 @@ type QueryServer interface {
 	UnbondingCollateral(context.Context, *QueryUnbondingCollateralRequest) (*QueryUnbondingCollateralResponse, error)
 	// Queries all unbonding collateral.
 	AllUnbondingCollaterals(context.Context, *QueryAllUnbondingCollateralsRequest) (*QueryAllUnbondingCollateralsResponse, error)
+	// Queries the delegations backing a host, together with its commission.
+	HostDelegations(context.Context, *QueryHostDelegationsRequest) (*QueryHostDelegationsResponse, error)
+	// Queries all delegations made by a delegator.
+	DelegatorDelegations(context.Context, *QueryDelegatorDelegationsRequest) (*QueryDelegatorDelegationsResponse, error)
+	// Queries a participant's jail history, oldest first.
+	JailHistory(context.Context, *QueryJailHistoryRequest) (*QueryJailHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -662,6 +1033,15 @@ func (*UnimplementedQueryServer) UnbondingCollateral(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) AllUnbondingCollaterals(ctx context.Context, req *QueryAllUnbondingCollateralsRequest) (*QueryAllUnbondingCollateralsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllUnbondingCollaterals not implemented")
 }
+func (*UnimplementedQueryServer) HostDelegations(ctx context.Context, req *QueryHostDelegationsRequest) (*QueryHostDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostDelegations not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorDelegations(ctx context.Context, req *QueryDelegatorDelegationsRequest) (*QueryDelegatorDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorDelegations not implemented")
+}
+func (*UnimplementedQueryServer) JailHistory(ctx context.Context, req *QueryJailHistoryRequest) (*QueryJailHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JailHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -757,6 +1137,60 @@ func _Query_AllUnbondingCollaterals_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HostDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Query/HostDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostDelegations(ctx, req.(*QueryHostDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Query/DelegatorDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorDelegations(ctx, req.(*QueryDelegatorDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_JailHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryJailHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).JailHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Query/JailHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).JailHistory(ctx, req.(*QueryJailHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.collateral.Query",
@@ -782,6 +1216,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllUnbondingCollaterals",
 			Handler:    _Query_AllUnbondingCollaterals_Handler,
 		},
+		{
+			MethodName: "HostDelegations",
+			Handler:    _Query_HostDelegations_Handler,
+		},
+		{
+			MethodName: "DelegatorDelegations",
+			Handler:    _Query_DelegatorDelegations_Handler,
+		},
+		{
+			MethodName: "JailHistory",
+			Handler:    _Query_JailHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/collateral/query.proto",
@@ -1141,16 +1587,261 @@ func (m *QueryAllUnbondingCollateralsResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryHostDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Host) > 0 {
+		i -= len(m.Host)
+		copy(dAtA[i:], m.Host)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Host)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TotalDelegated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnbondingDelegations) > 0 {
+		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJailHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJailHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJailHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJailHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJailHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJailHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
@@ -1288,41 +1979,674 @@ func (m *QueryAllUnbondingCollateralsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryHostDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryHostDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalDelegated.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QueryDelegatorDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for _, e := range m.UnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryJailHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryJailHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Jailed {
+		n += 2
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCollateralsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCollateralsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCollateralsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCollateralsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCollateralsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCollateralsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = append(m.Collateral, CollateralBalance{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingCollateralRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingCollateralRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1344,7 +2668,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1367,15 +2691,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1402,7 +2726,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unbondings = append(m.Unbondings, UnbondingCollateral{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1427,7 +2752,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllUnbondingCollateralsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1450,17 +2775,17 @@ func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCollateralRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllUnbondingCollateralsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllUnbondingCollateralsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1470,23 +2795,27 @@ func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1509,7 +2838,7 @@ func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllUnbondingCollateralsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1532,15 +2861,15 @@ func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllUnbondingCollateralsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllUnbondingCollateralsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1567,7 +2896,44 @@ func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unbondings = append(m.Unbondings, UnbondingCollateral{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1592,7 +2958,7 @@ func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCollateralsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHostDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1615,17 +2981,17 @@ func (m *QueryAllCollateralsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCollateralsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCollateralsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1635,27 +3001,23 @@ func (m *QueryAllCollateralsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1678,7 +3040,7 @@ func (m *QueryAllCollateralsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCollateralsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHostDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1701,15 +3063,15 @@ func (m *QueryAllCollateralsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCollateralsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCollateralsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1736,14 +3098,14 @@ func (m *QueryAllCollateralsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Collateral = append(m.Collateral, CollateralBalance{})
-			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, CollateralDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1770,10 +3132,40 @@ func (m *QueryAllCollateralsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if err := m.TotalDelegated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1798,7 +3190,7 @@ func (m *QueryAllCollateralsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingCollateralRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1821,15 +3213,15 @@ func (m *QueryUnbondingCollateralRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingCollateralRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1857,7 +3249,7 @@ func (m *QueryUnbondingCollateralRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1880,7 +3272,7 @@ func (m *QueryUnbondingCollateralRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1903,15 +3295,15 @@ func (m *QueryUnbondingCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1938,8 +3330,42 @@ func (m *QueryUnbondingCollateralResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unbondings = append(m.Unbondings, UnbondingCollateral{})
-			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, CollateralDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingDelegations = append(m.UnbondingDelegations, UnbondingDelegation{})
+			if err := m.UnbondingDelegations[len(m.UnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1964,7 +3390,7 @@ func (m *QueryUnbondingCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUnbondingCollateralsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryJailHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1987,17 +3413,17 @@ func (m *QueryAllUnbondingCollateralsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUnbondingCollateralsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryJailHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUnbondingCollateralsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryJailHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2007,27 +3433,23 @@ func (m *QueryAllUnbondingCollateralsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2050,7 +3472,7 @@ func (m *QueryAllUnbondingCollateralsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUnbondingCollateralsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryJailHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2073,17 +3495,17 @@ func (m *QueryAllUnbondingCollateralsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUnbondingCollateralsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryJailHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUnbondingCollateralsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryJailHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2093,29 +3515,15 @@ func (m *QueryAllUnbondingCollateralsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Unbondings = append(m.Unbondings, UnbondingCollateral{})
-			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Jailed = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2142,10 +3550,8 @@ func (m *QueryAllUnbondingCollateralsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, JailRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
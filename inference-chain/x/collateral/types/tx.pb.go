@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -322,504 +323,2500 @@ func (m *MsgWithdrawCollateralResponse) GetCompletionEpoch() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "inference.collateral.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "inference.collateral.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgDepositCollateral)(nil), "inference.collateral.MsgDepositCollateral")
-	proto.RegisterType((*MsgDepositCollateralResponse)(nil), "inference.collateral.MsgDepositCollateralResponse")
-	proto.RegisterType((*MsgWithdrawCollateral)(nil), "inference.collateral.MsgWithdrawCollateral")
-	proto.RegisterType((*MsgWithdrawCollateralResponse)(nil), "inference.collateral.MsgWithdrawCollateralResponse")
+// MsgCancelUnbondingCollateral defines a message to move unbonding collateral back to active collateral
+type MsgCancelUnbondingCollateral struct {
+	// participant is the address that owns the unbonding entry
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// completion_epoch identifies the unbonding entry to cancel
+	CompletionEpoch uint64 `protobuf:"varint,2,opt,name=completion_epoch,json=completionEpoch,proto3" json:"completion_epoch,omitempty"`
+	// amount is the part of the entry to return to active collateral
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func init() { proto.RegisterFile("inference/collateral/tx.proto", fileDescriptor_e738e4a8b0fcc692) }
-
-var fileDescriptor_e738e4a8b0fcc692 = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x4d, 0x6b, 0x13, 0x4f,
-	0x1c, 0xc7, 0x33, 0xfd, 0xff, 0x0d, 0x64, 0x2a, 0xd4, 0x2e, 0x91, 0x26, 0x4b, 0xbb, 0xd6, 0x80,
-	0x52, 0x53, 0xbb, 0x4b, 0x52, 0x28, 0x18, 0x04, 0x6d, 0xaa, 0x17, 0x21, 0x50, 0x22, 0x22, 0x78,
-	0x29, 0x93, 0xd9, 0x71, 0x33, 0x90, 0x9d, 0x19, 0x66, 0x26, 0xb1, 0xbd, 0x89, 0x47, 0x4f, 0xbe,
-	0x0c, 0x8f, 0x39, 0xf8, 0x16, 0x84, 0x1e, 0x4b, 0xf1, 0xe0, 0x49, 0x25, 0x39, 0xe4, 0x6d, 0xc8,
-	0x3e, 0xe4, 0xa1, 0xd9, 0x5d, 0xac, 0x37, 0x2f, 0xc9, 0xce, 0xef, 0xf7, 0xfd, 0x3d, 0x7c, 0x66,
-	0xbf, 0x2c, 0xdc, 0xa2, 0xec, 0x2d, 0x91, 0x84, 0x61, 0xe2, 0x60, 0xde, 0xeb, 0x21, 0x4d, 0x24,
-	0xea, 0x39, 0xfa, 0xd4, 0x16, 0x92, 0x6b, 0x6e, 0x14, 0x67, 0x69, 0x7b, 0x9e, 0x36, 0xd7, 0x91,
-	0x4f, 0x19, 0x77, 0xc2, 0xdf, 0x48, 0x68, 0x6e, 0x60, 0xae, 0x7c, 0xae, 0x1c, 0x5f, 0x79, 0xce,
-	0xa0, 0x16, 0xfc, 0xc5, 0x89, 0x72, 0x94, 0x38, 0x09, 0x4f, 0x4e, 0x74, 0x88, 0x53, 0x45, 0x8f,
-	0x7b, 0x3c, 0x8a, 0x07, 0x4f, 0x71, 0xf4, 0x6e, 0xea, 0x46, 0x02, 0x49, 0xe4, 0x4f, 0x0b, 0xad,
-	0x78, 0x58, 0x07, 0x29, 0xe2, 0x0c, 0x6a, 0x1d, 0xa2, 0x51, 0xcd, 0xc1, 0x9c, 0xb2, 0x28, 0x5f,
-	0xf9, 0x0a, 0xe0, 0x5a, 0x4b, 0x79, 0xaf, 0x84, 0x8b, 0x34, 0x39, 0x0e, 0x2b, 0x8d, 0x03, 0x58,
-	0x40, 0x7d, 0xdd, 0xe5, 0x92, 0xea, 0xb3, 0x12, 0xd8, 0x06, 0x3b, 0x85, 0x66, 0xe9, 0xf2, 0xcb,
-	0x5e, 0x31, 0xde, 0xe8, 0xd0, 0x75, 0x25, 0x51, 0xea, 0xa5, 0x96, 0x94, 0x79, 0xed, 0xb9, 0xd4,
-	0x78, 0x02, 0xf3, 0xd1, 0xec, 0xd2, 0xca, 0x36, 0xd8, 0x59, 0xad, 0x6f, 0xda, 0x69, 0x57, 0x62,
-	0x47, 0x53, 0x9a, 0x85, 0xf3, 0x1f, 0x77, 0x72, 0x9f, 0x27, 0xc3, 0x2a, 0x68, 0xc7, 0x65, 0x8d,
-	0x47, 0x1f, 0x26, 0xc3, 0xea, 0xbc, 0xe1, 0xc7, 0xc9, 0xb0, 0x7a, 0x7f, 0x8e, 0x78, 0xba, 0x08,
-	0xb9, 0xb4, 0x73, 0xa5, 0x0c, 0x37, 0x96, 0x42, 0x6d, 0xa2, 0x04, 0x67, 0x8a, 0x54, 0x2e, 0x01,
-	0x2c, 0xb6, 0x94, 0xf7, 0x8c, 0x08, 0xae, 0xa8, 0x3e, 0x9a, 0xf5, 0x30, 0x1a, 0x70, 0x55, 0x20,
-	0xa9, 0x29, 0xa6, 0x02, 0x31, 0xfd, 0x47, 0xd2, 0x45, 0xb1, 0xf1, 0x18, 0xe6, 0x91, 0xcf, 0xfb,
-	0x4c, 0xc7, 0xac, 0x65, 0x3b, 0xae, 0x09, 0x2e, 0xda, 0x8e, 0x2f, 0xda, 0x3e, 0xe2, 0x94, 0x5d,
-	0x01, 0x8d, 0x6a, 0x1a, 0x4f, 0x03, 0xd0, 0xc5, 0x7e, 0x01, 0xea, 0x6e, 0x36, 0x6a, 0x62, 0xf7,
-	0x8a, 0x05, 0x37, 0xd3, 0xe2, 0x33, 0xe8, 0x6f, 0x00, 0xde, 0x6e, 0x29, 0xef, 0x35, 0xd5, 0x5d,
-	0x57, 0xa2, 0x77, 0xff, 0x04, 0xf5, 0x61, 0x1a, 0xf5, 0xc3, 0x6c, 0xea, 0xe4, 0xf2, 0x95, 0x17,
-	0x70, 0x2b, 0x35, 0x31, 0xe5, 0x36, 0x1e, 0xc0, 0x5b, 0x98, 0xfb, 0xa2, 0x47, 0x34, 0xe5, 0xec,
-	0x84, 0x08, 0x8e, 0xbb, 0x21, 0xe2, 0xff, 0xed, 0xb5, 0x79, 0xfc, 0x79, 0x10, 0xae, 0xff, 0x5c,
-	0x81, 0xff, 0xb5, 0x94, 0x67, 0xb8, 0xf0, 0xe6, 0x15, 0xfb, 0xdf, 0x4b, 0xb7, 0xed, 0x92, 0xbd,
-	0xcc, 0xbd, 0x6b, 0xc9, 0x66, 0x8b, 0x29, 0xb8, 0x9e, 0x74, 0x60, 0x35, 0xb3, 0x47, 0x42, 0x6b,
-	0xd6, 0xaf, 0xaf, 0x9d, 0x0d, 0x1d, 0x40, 0x23, 0xc5, 0x01, 0xbb, 0x99, 0x9d, 0x92, 0x62, 0x73,
-	0xff, 0x2f, 0xc4, 0xd3, 0xb9, 0xe6, 0x8d, 0xf7, 0xc1, 0x8b, 0x6f, 0x1e, 0x9f, 0x8f, 0x2c, 0x70,
-	0x31, 0xb2, 0xc0, 0xaf, 0x91, 0x05, 0x3e, 0x8d, 0xad, 0xdc, 0xc5, 0xd8, 0xca, 0x7d, 0x1f, 0x5b,
-	0xb9, 0x37, 0x07, 0x1e, 0xd5, 0xdd, 0x7e, 0xc7, 0xc6, 0xdc, 0x77, 0x84, 0xe4, 0x6e, 0x1f, 0x6b,
-	0x85, 0x69, 0xe8, 0x82, 0x0c, 0x3f, 0xe8, 0x33, 0x41, 0x54, 0x27, 0x1f, 0x7e, 0xb5, 0xf6, 0x7f,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x12, 0x3f, 0x26, 0x8c, 0x05, 0x00, 0x00,
+func (m *MsgCancelUnbondingCollateral) Reset()         { *m = MsgCancelUnbondingCollateral{} }
+func (m *MsgCancelUnbondingCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbondingCollateral) ProtoMessage()    {}
+func (*MsgCancelUnbondingCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{6}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// DepositCollateral deposits collateral for the participant
-	DepositCollateral(ctx context.Context, in *MsgDepositCollateral, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error)
-	// WithdrawCollateral initiates withdrawal of collateral (subject to unbonding period)
-	WithdrawCollateral(ctx context.Context, in *MsgWithdrawCollateral, opts ...grpc.CallOption) (*MsgWithdrawCollateralResponse, error)
+func (m *MsgCancelUnbondingCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCancelUnbondingCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnbondingCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCancelUnbondingCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbondingCollateral.Merge(m, src)
+}
+func (m *MsgCancelUnbondingCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnbondingCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbondingCollateral.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/inference.collateral.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelUnbondingCollateral proto.InternalMessageInfo
+
+func (m *MsgCancelUnbondingCollateral) GetParticipant() string {
+	if m != nil {
+		return m.Participant
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DepositCollateral(ctx context.Context, in *MsgDepositCollateral, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error) {
-	out := new(MsgDepositCollateralResponse)
-	err := c.cc.Invoke(ctx, "/inference.collateral.Msg/DepositCollateral", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelUnbondingCollateral) GetCompletionEpoch() uint64 {
+	if m != nil {
+		return m.CompletionEpoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) WithdrawCollateral(ctx context.Context, in *MsgWithdrawCollateral, opts ...grpc.CallOption) (*MsgWithdrawCollateralResponse, error) {
-	out := new(MsgWithdrawCollateralResponse)
-	err := c.cc.Invoke(ctx, "/inference.collateral.Msg/WithdrawCollateral", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelUnbondingCollateral) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// DepositCollateral deposits collateral for the participant
-	DepositCollateral(context.Context, *MsgDepositCollateral) (*MsgDepositCollateralResponse, error)
-	// WithdrawCollateral initiates withdrawal of collateral (subject to unbonding period)
-	WithdrawCollateral(context.Context, *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error)
+// MsgCancelUnbondingCollateralResponse defines the response structure for executing a
+// MsgCancelUnbondingCollateral message.
+type MsgCancelUnbondingCollateralResponse struct {
+	// collateral is the participant's active collateral after the cancellation
+	Collateral types.Coin `protobuf:"bytes,1,opt,name=collateral,proto3" json:"collateral"`
+	// remaining_unbonding is what is left of the unbonding entry
+	RemainingUnbonding types.Coin `protobuf:"bytes,2,opt,name=remaining_unbonding,json=remainingUnbonding,proto3" json:"remaining_unbonding"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgCancelUnbondingCollateralResponse) Reset()         { *m = MsgCancelUnbondingCollateralResponse{} }
+func (m *MsgCancelUnbondingCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbondingCollateralResponse) ProtoMessage()    {}
+func (*MsgCancelUnbondingCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{7}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgCancelUnbondingCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DepositCollateral(ctx context.Context, req *MsgDepositCollateral) (*MsgDepositCollateralResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositCollateral not implemented")
+func (m *MsgCancelUnbondingCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnbondingCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) WithdrawCollateral(ctx context.Context, req *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCollateral not implemented")
+func (m *MsgCancelUnbondingCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbondingCollateralResponse.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgCancelUnbondingCollateralResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.collateral.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgCancelUnbondingCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbondingCollateralResponse.DiscardUnknown(m)
 }
 
-func _Msg_DepositCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositCollateral)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DepositCollateral(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.collateral.Msg/DepositCollateral",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DepositCollateral(ctx, req.(*MsgDepositCollateral))
+var xxx_messageInfo_MsgCancelUnbondingCollateralResponse proto.InternalMessageInfo
+
+func (m *MsgCancelUnbondingCollateralResponse) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_WithdrawCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawCollateral)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawCollateral(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.collateral.Msg/WithdrawCollateral",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawCollateral(ctx, req.(*MsgWithdrawCollateral))
+func (m *MsgCancelUnbondingCollateralResponse) GetRemainingUnbonding() types.Coin {
+	if m != nil {
+		return m.RemainingUnbonding
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "inference.collateral.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "DepositCollateral",
-			Handler:    _Msg_DepositCollateral_Handler,
-		},
-		{
-			MethodName: "WithdrawCollateral",
-			Handler:    _Msg_WithdrawCollateral_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "inference/collateral/tx.proto",
+// MsgDelegateCollateral defines a message to delegate collateral to a host
+type MsgDelegateCollateral struct {
+	// delegator is the address providing the collateral
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// host is the participant whose collateral is backed
+	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	// amount is the collateral to delegate
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgDelegateCollateral) Reset()         { *m = MsgDelegateCollateral{} }
+func (m *MsgDelegateCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateCollateral) ProtoMessage()    {}
+func (*MsgDelegateCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{8}
 }
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDelegateCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgDelegateCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgDelegateCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateCollateral.Merge(m, src)
+}
+func (m *MsgDelegateCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateCollateral proto.InternalMessageInfo
+
+func (m *MsgDelegateCollateral) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateCollateral) GetHost() string {
+	if m != nil {
+		return m.Host
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDelegateCollateral) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgDelegateCollateralResponse defines the response structure for executing a
+// MsgDelegateCollateral message.
+type MsgDelegateCollateralResponse struct {
 }
 
-func (m *MsgDepositCollateral) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateCollateralResponse) Reset()         { *m = MsgDelegateCollateralResponse{} }
+func (m *MsgDelegateCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateCollateralResponse) ProtoMessage()    {}
+func (*MsgDelegateCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{9}
+}
+func (m *MsgDelegateCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgDelegateCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateCollateralResponse.Merge(m, src)
+}
+func (m *MsgDelegateCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateCollateralResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDepositCollateral) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDelegateCollateralResponse proto.InternalMessageInfo
+
+// MsgUndelegateCollateral defines a message to withdraw delegated collateral from a host
+type MsgUndelegateCollateral struct {
+	// delegator is the address that delegated the collateral
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// host is the participant the collateral was delegated to
+	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	// amount is the collateral to undelegate
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgDepositCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUndelegateCollateral) Reset()         { *m = MsgUndelegateCollateral{} }
+func (m *MsgUndelegateCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateCollateral) ProtoMessage()    {}
+func (*MsgUndelegateCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{10}
+}
+func (m *MsgUndelegateCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Participant) > 0 {
-		i -= len(m.Participant)
-		copy(dAtA[i:], m.Participant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDepositCollateralResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUndelegateCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateCollateral.Merge(m, src)
+}
+func (m *MsgUndelegateCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateCollateral.DiscardUnknown(m)
 }
 
-func (m *MsgDepositCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUndelegateCollateral proto.InternalMessageInfo
+
+func (m *MsgUndelegateCollateral) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
 }
 
-func (m *MsgDepositCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUndelegateCollateral) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
 }
 
-func (m *MsgWithdrawCollateral) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUndelegateCollateral) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgWithdrawCollateral) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUndelegateCollateralResponse defines the response structure for executing a
+// MsgUndelegateCollateral message.
+type MsgUndelegateCollateralResponse struct {
+	// completion_epoch is the epoch when the funds will be returned to the delegator
+	CompletionEpoch uint64 `protobuf:"varint,1,opt,name=completion_epoch,json=completionEpoch,proto3" json:"completion_epoch,omitempty"`
 }
 
-func (m *MsgWithdrawCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUndelegateCollateralResponse) Reset()         { *m = MsgUndelegateCollateralResponse{} }
+func (m *MsgUndelegateCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateCollateralResponse) ProtoMessage()    {}
+func (*MsgUndelegateCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{11}
+}
+func (m *MsgUndelegateCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Participant) > 0 {
-		i -= len(m.Participant)
-		copy(dAtA[i:], m.Participant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUndelegateCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateCollateralResponse.Merge(m, src)
+}
+func (m *MsgUndelegateCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateCollateralResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawCollateralResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUndelegateCollateralResponse proto.InternalMessageInfo
+
+func (m *MsgUndelegateCollateralResponse) GetCompletionEpoch() uint64 {
+	if m != nil {
+		return m.CompletionEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgWithdrawCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetCollateralCommission defines a message to set a host's commission on delegator rewards
+type MsgSetCollateralCommission struct {
+	// host is the participant setting its commission
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// rate is the commission as a fraction between 0 and 1
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
 }
 
-func (m *MsgWithdrawCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CompletionEpoch != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CompletionEpoch))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetCollateralCommission) Reset()         { *m = MsgSetCollateralCommission{} }
+func (m *MsgSetCollateralCommission) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCollateralCommission) ProtoMessage()    {}
+func (*MsgSetCollateralCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{12}
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgSetCollateralCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetCollateralCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCollateralCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgSetCollateralCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCollateralCommission.Merge(m, src)
+}
+func (m *MsgSetCollateralCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCollateralCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCollateralCommission.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetCollateralCommission proto.InternalMessageInfo
+
+func (m *MsgSetCollateralCommission) GetHost() string {
+	if m != nil {
+		return m.Host
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgDepositCollateral) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Participant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+// MsgSetCollateralCommissionResponse defines the response structure for executing a
+// MsgSetCollateralCommission message.
+type MsgSetCollateralCommissionResponse struct {
 }
 
-func (m *MsgDepositCollateralResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetCollateralCommissionResponse) Reset()         { *m = MsgSetCollateralCommissionResponse{} }
+func (m *MsgSetCollateralCommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCollateralCommissionResponse) ProtoMessage()    {}
+func (*MsgSetCollateralCommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{13}
+}
+func (m *MsgSetCollateralCommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCollateralCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCollateralCommissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgSetCollateralCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCollateralCommissionResponse.Merge(m, src)
+}
+func (m *MsgSetCollateralCommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCollateralCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCollateralCommissionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawCollateral) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Participant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+var xxx_messageInfo_MsgSetCollateralCommissionResponse proto.InternalMessageInfo
+
+// MsgUnjail defines a message for a jailed participant to release itself
+type MsgUnjail struct {
+	// participant is the jailed address
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
 }
 
-func (m *MsgWithdrawCollateralResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CompletionEpoch != 0 {
-		n += 1 + sovTx(uint64(m.CompletionEpoch))
-	}
-	return n
+func (m *MsgUnjail) Reset()         { *m = MsgUnjail{} }
+func (m *MsgUnjail) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjail) ProtoMessage()    {}
+func (*MsgUnjail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{14}
 }
+func (m *MsgUnjail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjail.Merge(m, src)
+}
+func (m *MsgUnjail) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjail) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjail proto.InternalMessageInfo
+
+func (m *MsgUnjail) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+// MsgUnjailResponse defines the response structure for executing a
+// MsgUnjail message.
+type MsgUnjailResponse struct {
+	// weight_epoch is the first epoch the participant's weight is counted again
+	WeightEpoch uint64 `protobuf:"varint,1,opt,name=weight_epoch,json=weightEpoch,proto3" json:"weight_epoch,omitempty"`
+}
+
+func (m *MsgUnjailResponse) Reset()         { *m = MsgUnjailResponse{} }
+func (m *MsgUnjailResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailResponse) ProtoMessage()    {}
+func (*MsgUnjailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e738e4a8b0fcc692, []int{15}
+}
+func (m *MsgUnjailResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailResponse.Merge(m, src)
+}
+func (m *MsgUnjailResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailResponse proto.InternalMessageInfo
+
+func (m *MsgUnjailResponse) GetWeightEpoch() uint64 {
+	if m != nil {
+		return m.WeightEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "inference.collateral.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "inference.collateral.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgDepositCollateral)(nil), "inference.collateral.MsgDepositCollateral")
+	proto.RegisterType((*MsgDepositCollateralResponse)(nil), "inference.collateral.MsgDepositCollateralResponse")
+	proto.RegisterType((*MsgWithdrawCollateral)(nil), "inference.collateral.MsgWithdrawCollateral")
+	proto.RegisterType((*MsgWithdrawCollateralResponse)(nil), "inference.collateral.MsgWithdrawCollateralResponse")
+	proto.RegisterType((*MsgCancelUnbondingCollateral)(nil), "inference.collateral.MsgCancelUnbondingCollateral")
+	proto.RegisterType((*MsgCancelUnbondingCollateralResponse)(nil), "inference.collateral.MsgCancelUnbondingCollateralResponse")
+	proto.RegisterType((*MsgDelegateCollateral)(nil), "inference.collateral.MsgDelegateCollateral")
+	proto.RegisterType((*MsgDelegateCollateralResponse)(nil), "inference.collateral.MsgDelegateCollateralResponse")
+	proto.RegisterType((*MsgUndelegateCollateral)(nil), "inference.collateral.MsgUndelegateCollateral")
+	proto.RegisterType((*MsgUndelegateCollateralResponse)(nil), "inference.collateral.MsgUndelegateCollateralResponse")
+	proto.RegisterType((*MsgSetCollateralCommission)(nil), "inference.collateral.MsgSetCollateralCommission")
+	proto.RegisterType((*MsgSetCollateralCommissionResponse)(nil), "inference.collateral.MsgSetCollateralCommissionResponse")
+	proto.RegisterType((*MsgUnjail)(nil), "inference.collateral.MsgUnjail")
+	proto.RegisterType((*MsgUnjailResponse)(nil), "inference.collateral.MsgUnjailResponse")
+}
+
+func init() { proto.RegisterFile("inference/collateral/tx.proto", fileDescriptor_e738e4a8b0fcc692) }
+
+var fileDescriptor_e738e4a8b0fcc692 = []byte{
+	// 953 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0xd3, 0x10, 0xb4, 0x2f, 0x95, 0x4a, 0xcc, 0xa2, 0x24, 0xa6, 0xdd, 0x4d, 0xad, 0x02,
+	0x25, 0x69, 0x6c, 0x36, 0x51, 0x23, 0x58, 0x21, 0x85, 0xfc, 0xa8, 0x84, 0x50, 0x57, 0x8a, 0xb6,
+	0xaa, 0x90, 0xb8, 0x44, 0xb3, 0xf6, 0xe0, 0x1d, 0x58, 0xcf, 0x58, 0x9e, 0xd9, 0xb4, 0x91, 0x10,
+	0xaa, 0x90, 0xb8, 0x80, 0x90, 0xf8, 0x33, 0x38, 0xe6, 0xd0, 0x0b, 0x27, 0x4e, 0x48, 0x3d, 0x56,
+	0x11, 0x07, 0xc4, 0xa1, 0x42, 0xc9, 0x21, 0xff, 0x04, 0x07, 0x64, 0x8f, 0x33, 0xbb, 0x89, 0x3d,
+	0xde, 0xa4, 0xe1, 0x40, 0x2f, 0xbb, 0xeb, 0xf7, 0xbe, 0xf7, 0xe3, 0xfb, 0x66, 0xfc, 0x66, 0x16,
+	0x6e, 0x10, 0xfa, 0x25, 0x8e, 0x31, 0xf5, 0xb0, 0xeb, 0xb1, 0x5e, 0x0f, 0x09, 0x1c, 0xa3, 0x9e,
+	0x2b, 0x1e, 0x3b, 0x51, 0xcc, 0x04, 0x33, 0xab, 0xca, 0xed, 0x0c, 0xdc, 0xd6, 0x34, 0x0a, 0x09,
+	0x65, 0x6e, 0xfa, 0x29, 0x81, 0xd6, 0x8c, 0xc7, 0x78, 0xc8, 0xb8, 0x1b, 0xf2, 0xc0, 0xdd, 0x6d,
+	0x24, 0x5f, 0x99, 0x63, 0x4e, 0x3a, 0x76, 0xd2, 0x27, 0x57, 0x3e, 0x64, 0xae, 0x6a, 0xc0, 0x02,
+	0x26, 0xed, 0xc9, 0xaf, 0xcc, 0x7a, 0xb3, 0xb0, 0xa3, 0x08, 0xc5, 0x28, 0x3c, 0x09, 0xac, 0x65,
+	0xc5, 0x3a, 0x88, 0x63, 0x77, 0xb7, 0xd1, 0xc1, 0x02, 0x35, 0x5c, 0x8f, 0x11, 0x2a, 0xfd, 0xf6,
+	0xef, 0x06, 0x5c, 0x6b, 0xf1, 0xe0, 0x61, 0xe4, 0x23, 0x81, 0xb7, 0xd3, 0x48, 0x73, 0x15, 0x2a,
+	0xa8, 0x2f, 0xba, 0x2c, 0x26, 0x62, 0x6f, 0xd6, 0x98, 0x37, 0x6e, 0x57, 0x36, 0x66, 0x0f, 0x9e,
+	0x2e, 0x55, 0xb3, 0x8e, 0xd6, 0x7d, 0x3f, 0xc6, 0x9c, 0x3f, 0x10, 0x31, 0xa1, 0x41, 0x7b, 0x00,
+	0x35, 0xd7, 0x60, 0x52, 0xd6, 0x9e, 0x1d, 0x9f, 0x37, 0x6e, 0x4f, 0x2d, 0x5f, 0x77, 0x8a, 0x24,
+	0x71, 0x64, 0x95, 0x8d, 0xca, 0xb3, 0x17, 0xf5, 0xb1, 0x5f, 0x8e, 0xf7, 0x17, 0x8c, 0x76, 0x16,
+	0xd6, 0xfc, 0xe8, 0xbb, 0xe3, 0xfd, 0x85, 0x41, 0xc2, 0x1f, 0x8e, 0xf7, 0x17, 0xde, 0x1d, 0x50,
+	0x7c, 0x3c, 0x4c, 0xf2, 0x4c, 0xcf, 0xf6, 0x1c, 0xcc, 0x9c, 0x31, 0xb5, 0x31, 0x8f, 0x18, 0xe5,
+	0xd8, 0x3e, 0x30, 0xa0, 0xda, 0xe2, 0xc1, 0x16, 0x8e, 0x18, 0x27, 0x62, 0x53, 0xe5, 0x30, 0x9b,
+	0x30, 0x15, 0xa1, 0x58, 0x10, 0x8f, 0x44, 0x88, 0x8a, 0x91, 0x4c, 0x87, 0xc1, 0xe6, 0xc7, 0x30,
+	0x89, 0x42, 0xd6, 0xa7, 0x22, 0xe3, 0x3a, 0xe7, 0x64, 0x31, 0x89, 0xd0, 0x4e, 0x26, 0xb4, 0xb3,
+	0xc9, 0x08, 0x3d, 0x45, 0x54, 0xc6, 0x34, 0x3f, 0x49, 0x88, 0x0e, 0xe7, 0x4b, 0xa8, 0x2e, 0xea,
+	0xa9, 0xe6, 0x7a, 0xb7, 0x6b, 0x70, 0xbd, 0xc8, 0xae, 0x48, 0xff, 0x61, 0xc0, 0x5b, 0x2d, 0x1e,
+	0x7c, 0x4e, 0x44, 0xd7, 0x8f, 0xd1, 0xa3, 0xff, 0x05, 0xeb, 0xf5, 0x22, 0xd6, 0x77, 0xf4, 0xac,
+	0xf3, 0xcd, 0xdb, 0x9f, 0xc1, 0x8d, 0x42, 0xc7, 0x09, 0x6f, 0xf3, 0x7d, 0x78, 0xc3, 0x63, 0x61,
+	0xd4, 0xc3, 0x82, 0x30, 0xba, 0x83, 0x23, 0xe6, 0x75, 0x53, 0x8a, 0x13, 0xed, 0x6b, 0x03, 0xfb,
+	0xbd, 0xc4, 0x6c, 0xff, 0x34, 0x9e, 0x6a, 0xb8, 0x89, 0xa8, 0x87, 0x7b, 0x0f, 0x69, 0x87, 0x51,
+	0x9f, 0xd0, 0xe0, 0x3f, 0x52, 0xaa, 0xa8, 0x8f, 0xf1, 0xc2, 0x3e, 0x86, 0x44, 0xbd, 0xf2, 0x12,
+	0xa2, 0x7e, 0x5a, 0x24, 0xea, 0x8a, 0x5e, 0x54, 0x2d, 0x5d, 0xfb, 0x57, 0x03, 0x6e, 0x95, 0x01,
+	0x94, 0xc6, 0x6b, 0x00, 0x83, 0x9c, 0xa9, 0x2c, 0xa5, 0x4d, 0x4f, 0x24, 0x4d, 0xb7, 0x87, 0x42,
+	0xcc, 0x6d, 0x78, 0x33, 0xc6, 0x21, 0x22, 0x94, 0xd0, 0x60, 0xa7, 0x7f, 0x52, 0x69, 0xf4, 0x9e,
+	0x92, 0x99, 0x4c, 0x15, 0xab, 0x9a, 0xb4, 0xff, 0x91, 0xdb, 0x7d, 0x0b, 0xf7, 0x70, 0x80, 0x04,
+	0x1e, 0x5a, 0xc4, 0x55, 0xa8, 0xf8, 0xd2, 0xca, 0xe2, 0xd1, 0xc3, 0x4c, 0x41, 0xcd, 0x3b, 0x30,
+	0xd1, 0x65, 0x5c, 0x6e, 0xf4, 0xb2, 0x90, 0x14, 0x75, 0xc9, 0x35, 0x5c, 0x4b, 0xe7, 0x9e, 0xaa,
+	0x3d, 0xe2, 0xb5, 0xc8, 0x93, 0xb4, 0xeb, 0xe9, 0x6b, 0x91, 0x77, 0xa8, 0x71, 0xf0, 0x64, 0x5c,
+	0xce, 0x47, 0xea, 0xbf, 0x9a, 0x0a, 0xad, 0xe7, 0x15, 0x72, 0x4a, 0x4e, 0x86, 0x02, 0x9a, 0xf6,
+	0x7d, 0xa8, 0x6b, 0x5c, 0x2f, 0x33, 0x3c, 0x0e, 0x0c, 0xb0, 0x5a, 0x3c, 0x78, 0x80, 0x87, 0x86,
+	0xef, 0x26, 0x0b, 0x43, 0xc2, 0x39, 0x61, 0x54, 0x69, 0x63, 0x9c, 0x4b, 0x9b, 0x7b, 0x30, 0x11,
+	0x23, 0x81, 0x33, 0x25, 0x1b, 0x09, 0xfd, 0xbf, 0x5e, 0xd4, 0xdf, 0x96, 0x11, 0xdc, 0xff, 0xda,
+	0x21, 0xcc, 0x0d, 0x91, 0xe8, 0x3a, 0xf7, 0x71, 0x80, 0xbc, 0xbd, 0x2d, 0xec, 0x1d, 0x3c, 0x5d,
+	0x82, 0x2c, 0xe1, 0x16, 0xf6, 0xda, 0x69, 0xb8, 0xdc, 0x46, 0x69, 0xc6, 0x44, 0x9f, 0x86, 0x5e,
+	0x1f, 0x4d, 0xd7, 0xf6, 0x2d, 0xb0, 0xf5, 0x5e, 0xb5, 0x97, 0xbe, 0x85, 0x4a, 0x2a, 0xe4, 0x57,
+	0x88, 0x5c, 0x6a, 0x46, 0x36, 0xef, 0x16, 0x8d, 0xae, 0xf9, 0xb2, 0x65, 0x4d, 0x4a, 0xda, 0xab,
+	0x30, 0xad, 0x1e, 0xd4, 0xd2, 0xdd, 0x84, 0xab, 0x8f, 0x30, 0x09, 0xba, 0xe2, 0xd4, 0xb2, 0x4d,
+	0x49, 0x5b, 0xba, 0x64, 0xcb, 0xbf, 0xbd, 0x0e, 0x57, 0x5a, 0x3c, 0x30, 0x7d, 0xb8, 0x7a, 0xea,
+	0xba, 0xf3, 0x4e, 0xf1, 0x35, 0xe5, 0xcc, 0x75, 0xc2, 0x5a, 0x3a, 0x17, 0x4c, 0x35, 0xc4, 0x61,
+	0x3a, 0x7f, 0xe3, 0x58, 0xd0, 0xe6, 0xc8, 0x61, 0xad, 0xe5, 0xf3, 0x63, 0x55, 0xd1, 0x5d, 0x30,
+	0x0b, 0x4e, 0xfc, 0x45, 0x6d, 0xa6, 0x3c, 0xd8, 0x5a, 0xb9, 0x00, 0x58, 0xd5, 0xfd, 0xd1, 0x80,
+	0x39, 0xfd, 0x39, 0xaa, 0x67, 0xa2, 0x8d, 0xb1, 0x9a, 0x17, 0x8f, 0x19, 0x56, 0xa1, 0xe0, 0x20,
+	0x58, 0x2c, 0xd1, 0xf3, 0x2c, 0xb8, 0x44, 0x05, 0xfd, 0x90, 0x35, 0xbf, 0x81, 0x6a, 0xe1, 0x80,
+	0x2d, 0xd9, 0x39, 0x05, 0x70, 0xeb, 0xee, 0x85, 0xe0, 0xaa, 0xfa, 0xf7, 0x06, 0xcc, 0xe8, 0xc6,
+	0xd1, 0x07, 0xda, 0x94, 0x9a, 0x08, 0xeb, 0xc3, 0x8b, 0x46, 0xa8, 0x3e, 0xda, 0x30, 0x99, 0xcd,
+	0x86, 0x7a, 0x09, 0x91, 0x04, 0x60, 0xbd, 0x37, 0x02, 0x70, 0x92, 0xd3, 0x7a, 0xed, 0x49, 0x72,
+	0x1a, 0x6c, 0x6c, 0x3f, 0x3b, 0xac, 0x19, 0xcf, 0x0f, 0x6b, 0xc6, 0xdf, 0x87, 0x35, 0xe3, 0xe7,
+	0xa3, 0xda, 0xd8, 0xf3, 0xa3, 0xda, 0xd8, 0x9f, 0x47, 0xb5, 0xb1, 0x2f, 0x56, 0x03, 0x22, 0xba,
+	0xfd, 0x8e, 0xe3, 0xb1, 0xd0, 0x8d, 0x62, 0xe6, 0xf7, 0x3d, 0xc1, 0x3d, 0x92, 0x4e, 0x11, 0xcd,
+	0x3c, 0x11, 0x7b, 0x11, 0xe6, 0x9d, 0xc9, 0xf4, 0x5f, 0xd0, 0xca, 0xbf, 0x01, 0x00, 0x00, 0xff,
+	0xff, 0xdc, 0xca, 0x82, 0x62, 0xdc, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// DepositCollateral deposits collateral for the participant
+	DepositCollateral(ctx context.Context, in *MsgDepositCollateral, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error)
+	// WithdrawCollateral initiates withdrawal of collateral (subject to unbonding period)
+	WithdrawCollateral(ctx context.Context, in *MsgWithdrawCollateral, opts ...grpc.CallOption) (*MsgWithdrawCollateralResponse, error)
+	// CancelUnbondingCollateral returns some or all of a pending unbonding entry to active collateral
+	CancelUnbondingCollateral(ctx context.Context, in *MsgCancelUnbondingCollateral, opts ...grpc.CallOption) (*MsgCancelUnbondingCollateralResponse, error)
+	// DelegateCollateral backs a host's collateral with the delegator's funds
+	DelegateCollateral(ctx context.Context, in *MsgDelegateCollateral, opts ...grpc.CallOption) (*MsgDelegateCollateralResponse, error)
+	// UndelegateCollateral moves delegated collateral back to the delegator (subject to unbonding period)
+	UndelegateCollateral(ctx context.Context, in *MsgUndelegateCollateral, opts ...grpc.CallOption) (*MsgUndelegateCollateralResponse, error)
+	// SetCollateralCommission sets the share of delegators' rewards the host keeps
+	SetCollateralCommission(ctx context.Context, in *MsgSetCollateralCommission, opts ...grpc.CallOption) (*MsgSetCollateralCommissionResponse, error)
+	// Unjail releases a participant jailed by the inference module once it has served its minimum
+	// jail time and restored its collateral
+	Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DepositCollateral(ctx context.Context, in *MsgDepositCollateral, opts ...grpc.CallOption) (*MsgDepositCollateralResponse, error) {
+	out := new(MsgDepositCollateralResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Msg/DepositCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawCollateral(ctx context.Context, in *MsgWithdrawCollateral, opts ...grpc.CallOption) (*MsgWithdrawCollateralResponse, error) {
+	out := new(MsgWithdrawCollateralResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Msg/WithdrawCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelUnbondingCollateral(ctx context.Context, in *MsgCancelUnbondingCollateral, opts ...grpc.CallOption) (*MsgCancelUnbondingCollateralResponse, error) {
+	out := new(MsgCancelUnbondingCollateralResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Msg/CancelUnbondingCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateCollateral(ctx context.Context, in *MsgDelegateCollateral, opts ...grpc.CallOption) (*MsgDelegateCollateralResponse, error) {
+	out := new(MsgDelegateCollateralResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Msg/DelegateCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateCollateral(ctx context.Context, in *MsgUndelegateCollateral, opts ...grpc.CallOption) (*MsgUndelegateCollateralResponse, error) {
+	out := new(MsgUndelegateCollateralResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Msg/UndelegateCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCollateralCommission(ctx context.Context, in *MsgSetCollateralCommission, opts ...grpc.CallOption) (*MsgSetCollateralCommissionResponse, error) {
+	out := new(MsgSetCollateralCommissionResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Msg/SetCollateralCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error) {
+	out := new(MsgUnjailResponse)
+	err := c.cc.Invoke(ctx, "/inference.collateral.Msg/Unjail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// DepositCollateral deposits collateral for the participant
+	DepositCollateral(context.Context, *MsgDepositCollateral) (*MsgDepositCollateralResponse, error)
+	// WithdrawCollateral initiates withdrawal of collateral (subject to unbonding period)
+	WithdrawCollateral(context.Context, *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error)
+	// CancelUnbondingCollateral returns some or all of a pending unbonding entry to active collateral
+	CancelUnbondingCollateral(context.Context, *MsgCancelUnbondingCollateral) (*MsgCancelUnbondingCollateralResponse, error)
+	// DelegateCollateral backs a host's collateral with the delegator's funds
+	DelegateCollateral(context.Context, *MsgDelegateCollateral) (*MsgDelegateCollateralResponse, error)
+	// UndelegateCollateral moves delegated collateral back to the delegator (subject to unbonding period)
+	UndelegateCollateral(context.Context, *MsgUndelegateCollateral) (*MsgUndelegateCollateralResponse, error)
+	// SetCollateralCommission sets the share of delegators' rewards the host keeps
+	SetCollateralCommission(context.Context, *MsgSetCollateralCommission) (*MsgSetCollateralCommissionResponse, error)
+	// Unjail releases a participant jailed by the inference module once it has served its minimum
+	// jail time and restored its collateral
+	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) DepositCollateral(ctx context.Context, req *MsgDepositCollateral) (*MsgDepositCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositCollateral not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawCollateral(ctx context.Context, req *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCollateral not implemented")
+}
+func (*UnimplementedMsgServer) CancelUnbondingCollateral(ctx context.Context, req *MsgCancelUnbondingCollateral) (*MsgCancelUnbondingCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUnbondingCollateral not implemented")
+}
+func (*UnimplementedMsgServer) DelegateCollateral(ctx context.Context, req *MsgDelegateCollateral) (*MsgDelegateCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateCollateral not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateCollateral(ctx context.Context, req *MsgUndelegateCollateral) (*MsgUndelegateCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateCollateral not implemented")
+}
+func (*UnimplementedMsgServer) SetCollateralCommission(ctx context.Context, req *MsgSetCollateralCommission) (*MsgSetCollateralCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollateralCommission not implemented")
+}
+func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*MsgUnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Msg/DepositCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositCollateral(ctx, req.(*MsgDepositCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Msg/WithdrawCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawCollateral(ctx, req.(*MsgWithdrawCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelUnbondingCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUnbondingCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUnbondingCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Msg/CancelUnbondingCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUnbondingCollateral(ctx, req.(*MsgCancelUnbondingCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Msg/DelegateCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateCollateral(ctx, req.(*MsgDelegateCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Msg/UndelegateCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateCollateral(ctx, req.(*MsgUndelegateCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCollateralCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCollateralCommission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCollateralCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Msg/SetCollateralCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCollateralCommission(ctx, req.(*MsgSetCollateralCommission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.collateral.Msg/Unjail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unjail(ctx, req.(*MsgUnjail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inference.collateral.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "DepositCollateral",
+			Handler:    _Msg_DepositCollateral_Handler,
+		},
+		{
+			MethodName: "WithdrawCollateral",
+			Handler:    _Msg_WithdrawCollateral_Handler,
+		},
+		{
+			MethodName: "CancelUnbondingCollateral",
+			Handler:    _Msg_CancelUnbondingCollateral_Handler,
+		},
+		{
+			MethodName: "DelegateCollateral",
+			Handler:    _Msg_DelegateCollateral_Handler,
+		},
+		{
+			MethodName: "UndelegateCollateral",
+			Handler:    _Msg_UndelegateCollateral_Handler,
+		},
+		{
+			MethodName: "SetCollateralCommission",
+			Handler:    _Msg_SetCollateralCommission_Handler,
+		},
+		{
+			MethodName: "Unjail",
+			Handler:    _Msg_Unjail_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inference/collateral/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompletionEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUnbondingCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnbondingCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnbondingCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CompletionEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompletionEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUnbondingCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnbondingCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnbondingCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RemainingUnbonding.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Host) > 0 {
+		i -= len(m.Host)
+		copy(dAtA[i:], m.Host)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Host)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Host) > 0 {
+		i -= len(m.Host)
+		copy(dAtA[i:], m.Host)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Host)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompletionEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCollateralCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCollateralCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCollateralCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Host) > 0 {
+		i -= len(m.Host)
+		copy(dAtA[i:], m.Host)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Host)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCollateralCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCollateralCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCollateralCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WeightEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WeightEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompletionEpoch != 0 {
+		n += 1 + sovTx(uint64(m.CompletionEpoch))
+	}
+	return n
+}
+
+func (m *MsgCancelUnbondingCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CompletionEpoch != 0 {
+		n += 1 + sovTx(uint64(m.CompletionEpoch))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelUnbondingCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RemainingUnbonding.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDelegateCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDelegateCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegateCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUndelegateCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompletionEpoch != 0 {
+		n += 1 + sovTx(uint64(m.CompletionEpoch))
+	}
+	return n
+}
+
+func (m *MsgSetCollateralCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetCollateralCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnjail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WeightEpoch != 0 {
+		n += 1 + sovTx(uint64(m.WeightEpoch))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEpoch", wireType)
+			}
+			m.CompletionEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelUnbondingCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUnbondingCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUnbondingCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEpoch", wireType)
+			}
+			m.CompletionEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelUnbondingCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUnbondingCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUnbondingCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingUnbonding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingUnbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -842,15 +2839,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -878,11 +2875,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -909,7 +2938,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -934,7 +2963,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -957,10 +2986,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -984,7 +3013,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1007,15 +3036,15 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1043,9 +3072,41 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1099,7 +3160,7 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1122,12 +3183,31 @@ func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEpoch", wireType)
+			}
+			m.CompletionEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1149,7 +3229,7 @@ func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCollateralCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1172,15 +3252,15 @@ func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCollateralCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCollateralCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1208,13 +3288,13 @@ func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1224,22 +3304,23 @@ func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1264,7 +3345,7 @@ func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCollateralCommissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1287,17 +3368,149 @@ func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCollateralCommissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCollateralCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightEpoch", wireType)
 			}
-			m.CompletionEpoch = 0
+			m.WeightEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1307,7 +3520,7 @@ func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompletionEpoch |= uint64(b&0x7F) << shift
+				m.WeightEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6,6 +6,9 @@ import (
 	"cosmossdk.io/store/prefix"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -116,3 +119,74 @@ func (k Keeper) TransferEligibility(goCtx context.Context, req *types.QueryTrans
 		AlreadyTransferred: alreadyTransferred,
 	}, nil
 }
+
+// SimulateTransfer reports what transferring a genesis account to a recipient would move, without executing it
+func (k Keeper) SimulateTransfer(goCtx context.Context, req *types.QuerySimulateTransferRequest) (*types.QuerySimulateTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	genesisAddr := sdk.MustAccAddressFromBech32(req.GenesisAddress)
+	recipientAddr := sdk.MustAccAddressFromBech32(req.RecipientAddress)
+
+	genesisAccount := k.accountKeeper.GetAccount(goCtx, genesisAddr)
+	if genesisAccount == nil {
+		return nil, status.Errorf(codes.NotFound, "genesis account %s does not exist", req.GenesisAddress)
+	}
+
+	resp := &types.QuerySimulateTransferResponse{
+		IsEligible: true,
+		Reason:     "account is eligible for transfer",
+		Balances:   k.bankView.GetAllBalances(goCtx, genesisAddr),
+		Spendable:  k.bankView.SpendableCoins(goCtx, genesisAddr),
+	}
+	if err := k.ValidateTransfer(goCtx, genesisAddr, recipientAddr); err != nil {
+		resp.IsEligible = false
+		resp.Reason = err.Error()
+	}
+
+	recipientAccount, isVesting, err := k.recipientVestingAccount(goCtx, genesisAccount, recipientAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !isVesting {
+		return resp, nil
+	}
+	resp.VestingType = proto.MessageName(genesisAccount)
+	if recipientAccount == nil {
+		// The genesis account has finished vesting
+		return resp, nil
+	}
+	resp.RecipientVestingType = proto.MessageName(recipientAccount)
+	if vestingAccount, ok := recipientAccount.(vestingexported.VestingAccount); ok {
+		resp.RecipientOriginalVesting = vestingAccount.GetOriginalVesting()
+		resp.RecipientStartTime = vestingAccount.GetStartTime()
+		resp.RecipientEndTime = vestingAccount.GetEndTime()
+	}
+	if periodicAccount, ok := recipientAccount.(*vestingtypes.PeriodicVestingAccount); ok {
+		for _, period := range periodicAccount.VestingPeriods {
+			resp.RecipientPeriods = append(resp.RecipientPeriods, types.VestingPeriod{Length: period.Length, Amount: period.Amount})
+		}
+	}
+
+	return resp, nil
+}
+
+// PendingTransfers queries the transfers waiting for their recipients to accept them
+func (k Keeper) PendingTransfers(goCtx context.Context, req *types.QueryPendingTransfersRequest) (*types.QueryPendingTransfersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pendingTransfers, pageRes, err := k.GetPendingTransfersPage(goCtx, req.RecipientAddress, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingTransfersResponse{
+		PendingTransfers: pendingTransfers,
+		Pagination:       pageRes,
+	}, nil
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/genesistransfer/types"
+)
+
+// BatchTransferOwnership handles the MsgBatchTransferOwnership message. Every transfer is executed, or with an
+// acceptance window stored as pending, in a cached context that is only written if all of them succeed.
+func (k msgServer) BatchTransferOwnership(goCtx context.Context, msg *types.MsgBatchTransferOwnership) (*types.MsgBatchTransferOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid message")
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	resp := &types.MsgBatchTransferOwnershipResponse{
+		TransferRecords:  []types.TransferRecord{},
+		PendingTransfers: []types.PendingTransfer{},
+	}
+	for i, transfer := range msg.Transfers {
+		genesisAddr := sdk.MustAccAddressFromBech32(transfer.GenesisAddress)
+		recipientAddr := sdk.MustAccAddressFromBech32(transfer.RecipientAddress)
+
+		if msg.AcceptanceWindowBlocks == 0 {
+			record, err := k.executeTransfer(cacheCtx, genesisAddr, recipientAddr)
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "transfer %d of %s failed", i, transfer.GenesisAddress)
+			}
+			resp.TransferRecords = append(resp.TransferRecords, record)
+			continue
+		}
+
+		// Fail now rather than at acceptance if the transfer can't go through as it stands
+		if err := k.ValidateTransfer(cacheCtx, genesisAddr, recipientAddr); err != nil {
+			return nil, errorsmod.Wrapf(err, "transfer %d of %s failed", i, transfer.GenesisAddress)
+		}
+		height := uint64(ctx.BlockHeight())
+		pending := types.PendingTransfer{
+			GenesisAddress:   transfer.GenesisAddress,
+			RecipientAddress: transfer.RecipientAddress,
+			CreatedHeight:    height,
+			DeadlineHeight:   height + msg.AcceptanceWindowBlocks,
+		}
+		if err := k.SetPendingTransfer(cacheCtx, pending); err != nil {
+			return nil, err
+		}
+		resp.PendingTransfers = append(resp.PendingTransfers, pending)
+
+		cacheCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"ownership_transfer_pending",
+				sdk.NewAttribute("genesis_address", pending.GenesisAddress),
+				sdk.NewAttribute("recipient_address", pending.RecipientAddress),
+				sdk.NewAttribute("deadline_height", fmt.Sprintf("%d", pending.DeadlineHeight)),
+			),
+		)
+	}
+	writeCache()
+
+	k.Logger().Info(
+		"batch ownership transfer message processed successfully",
+		"transfers", len(msg.Transfers),
+		"executed", len(resp.TransferRecords),
+		"pending", len(resp.PendingTransfers),
+	)
+
+	return resp, nil
+}
+
+// AcceptOwnershipTransfer handles the MsgAcceptOwnershipTransfer message, executing the listed pending transfers
+// to the signer all or none
+func (k msgServer) AcceptOwnershipTransfer(goCtx context.Context, msg *types.MsgAcceptOwnershipTransfer) (*types.MsgAcceptOwnershipTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid message")
+	}
+	recipientAddr := sdk.MustAccAddressFromBech32(msg.RecipientAddress)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	records := make([]types.TransferRecord, 0, len(msg.GenesisAddresses))
+	for _, genesisAddress := range msg.GenesisAddresses {
+		genesisAddr := sdk.MustAccAddressFromBech32(genesisAddress)
+		pending, found := k.GetPendingTransfer(cacheCtx, genesisAddr)
+		if !found || pending.RecipientAddress != msg.RecipientAddress {
+			return nil, types.ErrPendingTransferNotFound.Wrapf("no pending transfer of %s to %s", genesisAddress, msg.RecipientAddress)
+		}
+		if uint64(ctx.BlockHeight()) > pending.DeadlineHeight {
+			return nil, types.ErrPendingTransferExpired.Wrapf("transfer of %s expired at height %d", genesisAddress, pending.DeadlineHeight)
+		}
+
+		record, err := k.executeTransfer(cacheCtx, genesisAddr, recipientAddr)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "transfer of %s failed", genesisAddress)
+		}
+		records = append(records, record)
+	}
+	writeCache()
+
+	k.Logger().Info(
+		"pending ownership transfers accepted",
+		"recipient_address", msg.RecipientAddress,
+		"transfers", len(records),
+	)
+
+	return &types.MsgAcceptOwnershipTransferResponse{TransferRecords: records}, nil
+}
+
+func (k Keeper) executeTransfer(ctx sdk.Context, genesisAddr, recipientAddr sdk.AccAddress) (types.TransferRecord, error) {
+	if err := k.ExecuteOwnershipTransfer(ctx, genesisAddr, recipientAddr); err != nil {
+		return types.TransferRecord{}, err
+	}
+	record, found, err := k.GetTransferRecord(ctx, genesisAddr)
+	if err != nil {
+		return types.TransferRecord{}, err
+	}
+	if !found {
+		return types.TransferRecord{}, types.ErrInvalidTransfer.Wrap("transfer record not found after completion")
+	}
+	return *record, nil
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/genesistransfer/types"
+)
+
+func fundGenesisAccount(t *testing.T, ctx sdk.Context, accountKeeper *IntegrationMockAccountKeeper, bankKeeper *IntegrationMockBankKeeper, address string, amount int64) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	require.NoError(t, err)
+	accountKeeper.SetAccount(ctx, authtypes.NewBaseAccountWithAddress(addr))
+	bankKeeper.SetBalance(addr, sdk.NewCoins(sdk.NewCoin("ngonka", math.NewInt(amount))))
+	return addr
+}
+
+func TestBatchTransferOwnership_Immediate(t *testing.T) {
+	ctx, k, accountKeeper, bankKeeper, _, msgServer := setupVestingIntegrationKeepers(t)
+	ctx = ctx.WithBlockHeight(50)
+
+	fundGenesisAccount(t, ctx, accountKeeper, bankKeeper, testutil.Creator, 100)
+	fundGenesisAccount(t, ctx, accountKeeper, bankKeeper, testutil.Executor, 200)
+
+	resp, err := msgServer.BatchTransferOwnership(ctx, &types.MsgBatchTransferOwnership{
+		Transfers: []types.OwnershipTransfer{
+			{GenesisAddress: testutil.Creator, RecipientAddress: testutil.Requester},
+			{GenesisAddress: testutil.Executor, RecipientAddress: testutil.Validator},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.TransferRecords, 2)
+	require.Empty(t, resp.PendingTransfers)
+
+	requester := sdk.MustAccAddressFromBech32(testutil.Requester)
+	validator := sdk.MustAccAddressFromBech32(testutil.Validator)
+	require.Equal(t, int64(100), bankKeeper.GetAllBalances(ctx, requester).AmountOf("ngonka").Int64())
+	require.Equal(t, int64(200), bankKeeper.GetAllBalances(ctx, validator).AmountOf("ngonka").Int64())
+
+	for _, address := range []string{testutil.Creator, testutil.Executor} {
+		_, found, err := k.GetTransferRecord(ctx, sdk.MustAccAddressFromBech32(address))
+		require.NoError(t, err)
+		require.True(t, found)
+	}
+}
+
+func TestBatchTransferOwnership_AllOrNothing(t *testing.T) {
+	ctx, k, accountKeeper, bankKeeper, _, msgServer := setupVestingIntegrationKeepers(t)
+
+	creator := fundGenesisAccount(t, ctx, accountKeeper, bankKeeper, testutil.Creator, 100)
+
+	// The second genesis account does not exist, so the whole batch fails
+	_, err := msgServer.BatchTransferOwnership(ctx, &types.MsgBatchTransferOwnership{
+		Transfers: []types.OwnershipTransfer{
+			{GenesisAddress: testutil.Creator, RecipientAddress: testutil.Requester},
+			{GenesisAddress: testutil.Executor, RecipientAddress: testutil.Validator},
+		},
+	})
+	require.Error(t, err)
+
+	_, found, err := k.GetTransferRecord(ctx, creator)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	_, err = msgServer.BatchTransferOwnership(ctx, &types.MsgBatchTransferOwnership{
+		Transfers: []types.OwnershipTransfer{
+			{GenesisAddress: testutil.Creator, RecipientAddress: testutil.Requester},
+			{GenesisAddress: testutil.Executor, RecipientAddress: testutil.Validator},
+		},
+		AcceptanceWindowBlocks: 10,
+	})
+	require.Error(t, err)
+	_, found = k.GetPendingTransfer(ctx, creator)
+	require.False(t, found)
+}
+
+func TestBatchTransferOwnership_PendingAccepted(t *testing.T) {
+	ctx, k, accountKeeper, bankKeeper, _, msgServer := setupVestingIntegrationKeepers(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	creator := fundGenesisAccount(t, ctx, accountKeeper, bankKeeper, testutil.Creator, 100)
+	executor := fundGenesisAccount(t, ctx, accountKeeper, bankKeeper, testutil.Executor, 200)
+
+	resp, err := msgServer.BatchTransferOwnership(ctx, &types.MsgBatchTransferOwnership{
+		Transfers: []types.OwnershipTransfer{
+			{GenesisAddress: testutil.Creator, RecipientAddress: testutil.Requester},
+			{GenesisAddress: testutil.Executor, RecipientAddress: testutil.Requester},
+		},
+		AcceptanceWindowBlocks: 20,
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.TransferRecords)
+	require.Len(t, resp.PendingTransfers, 2)
+	require.Equal(t, uint64(30), resp.PendingTransfers[0].DeadlineHeight)
+
+	// Nothing moves until the recipient accepts
+	requester := sdk.MustAccAddressFromBech32(testutil.Requester)
+	require.True(t, bankKeeper.GetAllBalances(ctx, requester).IsZero())
+
+	pendingResp, err := k.PendingTransfers(ctx, &types.QueryPendingTransfersRequest{RecipientAddress: testutil.Requester})
+	require.NoError(t, err)
+	require.Len(t, pendingResp.PendingTransfers, 2)
+
+	// Only the named recipient can accept
+	_, err = msgServer.AcceptOwnershipTransfer(ctx, &types.MsgAcceptOwnershipTransfer{
+		RecipientAddress: testutil.Validator,
+		GenesisAddresses: []string{testutil.Creator},
+	})
+	require.ErrorIs(t, err, types.ErrPendingTransferNotFound)
+
+	acceptResp, err := msgServer.AcceptOwnershipTransfer(ctx.WithBlockHeight(30), &types.MsgAcceptOwnershipTransfer{
+		RecipientAddress: testutil.Requester,
+		GenesisAddresses: []string{testutil.Creator, testutil.Executor},
+	})
+	require.NoError(t, err)
+	require.Len(t, acceptResp.TransferRecords, 2)
+	require.Equal(t, int64(300), bankKeeper.GetAllBalances(ctx, requester).AmountOf("ngonka").Int64())
+
+	_, found := k.GetPendingTransfer(ctx, creator)
+	require.False(t, found)
+	_, found = k.GetPendingTransfer(ctx, executor)
+	require.False(t, found)
+}
+
+func TestBatchTransferOwnership_PendingExpired(t *testing.T) {
+	ctx, k, accountKeeper, bankKeeper, _, msgServer := setupVestingIntegrationKeepers(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	creator := fundGenesisAccount(t, ctx, accountKeeper, bankKeeper, testutil.Creator, 100)
+
+	_, err := msgServer.BatchTransferOwnership(ctx, &types.MsgBatchTransferOwnership{
+		Transfers: []types.OwnershipTransfer{
+			{GenesisAddress: testutil.Creator, RecipientAddress: testutil.Requester},
+		},
+		AcceptanceWindowBlocks: 5,
+	})
+	require.NoError(t, err)
+
+	lateCtx := ctx.WithBlockHeight(16)
+	_, err = msgServer.AcceptOwnershipTransfer(lateCtx, &types.MsgAcceptOwnershipTransfer{
+		RecipientAddress: testutil.Requester,
+		GenesisAddresses: []string{testutil.Creator},
+	})
+	require.ErrorIs(t, err, types.ErrPendingTransferExpired)
+
+	// Pruning at the deadline keeps the transfer, past it removes it
+	k.PruneExpiredPendingTransfers(ctx.WithBlockHeight(15))
+	_, found := k.GetPendingTransfer(ctx, creator)
+	require.True(t, found)
+
+	k.PruneExpiredPendingTransfers(lateCtx)
+	_, found = k.GetPendingTransfer(ctx, creator)
+	require.False(t, found)
+
+	// The genesis account can be transferred again once the pending transfer is gone
+	_, found, err = k.GetTransferRecord(ctx, creator)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestSimulateTransfer_PeriodicVesting(t *testing.T) {
+	ctx, k, accountKeeper, bankKeeper, _, _ := setupVestingIntegrationKeepers(t)
+
+	currentTime := time.Now().Unix()
+	ctx = ctx.WithBlockTime(time.Unix(currentTime, 0))
+
+	genesisAddr := sdk.MustAccAddressFromBech32(testutil.Creator)
+	recipientAddr := sdk.MustAccAddressFromBech32(testutil.Requester)
+	vestingCoins := sdk.NewCoins(sdk.NewCoin("ngonka", math.NewInt(1000)))
+	periods := vestingtypes.Periods{
+		{Length: 3600, Amount: sdk.NewCoins(sdk.NewCoin("ngonka", math.NewInt(400)))},
+		{Length: 3600, Amount: sdk.NewCoins(sdk.NewCoin("ngonka", math.NewInt(600)))},
+	}
+	periodicAcc, err := vestingtypes.NewPeriodicVestingAccount(authtypes.NewBaseAccountWithAddress(genesisAddr), vestingCoins, currentTime, periods)
+	require.NoError(t, err)
+	accountKeeper.SetAccount(ctx, periodicAcc)
+	bankKeeper.SetBalance(genesisAddr, vestingCoins)
+
+	resp, err := k.SimulateTransfer(ctx, &types.QuerySimulateTransferRequest{
+		GenesisAddress:   testutil.Creator,
+		RecipientAddress: testutil.Requester,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.IsEligible)
+	require.Equal(t, vestingCoins, resp.Balances)
+	require.Equal(t, "cosmos.vesting.v1beta1.PeriodicVestingAccount", resp.VestingType)
+	require.Equal(t, "cosmos.vesting.v1beta1.PeriodicVestingAccount", resp.RecipientVestingType)
+	require.Equal(t, vestingCoins, resp.RecipientOriginalVesting)
+	require.Len(t, resp.RecipientPeriods, 2)
+	require.Equal(t, int64(3600), resp.RecipientPeriods[0].Length)
+
+	// The dry run leaves both accounts untouched
+	require.Equal(t, vestingCoins, bankKeeper.GetAllBalances(ctx, genesisAddr))
+	require.Nil(t, accountKeeper.GetAccount(ctx, recipientAddr))
+	_, found, err := k.GetTransferRecord(ctx, genesisAddr)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// A genesis account that does not exist has nothing to simulate
+	resp, err = k.SimulateTransfer(ctx, &types.QuerySimulateTransferRequest{
+		GenesisAddress:   testutil.Executor,
+		RecipientAddress: testutil.Requester,
+	})
+	require.Error(t, err)
+	require.Nil(t, resp)
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/productscience/inference/x/genesistransfer/types"
+)
+
+// SetPendingTransfer stores a transfer waiting for its recipient, replacing any earlier one of the genesis account
+func (k Keeper) SetPendingTransfer(ctx context.Context, pending types.PendingTransfer) error {
+	if err := pending.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "invalid pending transfer")
+	}
+	genesisAddr, err := sdk.AccAddressFromBech32(pending.GenesisAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid genesis address in pending transfer: %s", pending.GenesisAddress)
+	}
+	bz, err := k.cdc.Marshal(&pending)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to marshal pending transfer")
+	}
+	k.pendingTransferStore(ctx).Set(genesisAddr.Bytes(), bz)
+	return nil
+}
+
+// GetPendingTransfer retrieves the pending transfer of a genesis account
+func (k Keeper) GetPendingTransfer(ctx context.Context, genesisAddr sdk.AccAddress) (types.PendingTransfer, bool) {
+	var pending types.PendingTransfer
+	bz := k.pendingTransferStore(ctx).Get(genesisAddr.Bytes())
+	if bz == nil {
+		return pending, false
+	}
+	k.cdc.MustUnmarshal(bz, &pending)
+	return pending, true
+}
+
+// RemovePendingTransfer deletes the pending transfer of a genesis account, if any
+func (k Keeper) RemovePendingTransfer(ctx context.Context, genesisAddr sdk.AccAddress) {
+	k.pendingTransferStore(ctx).Delete(genesisAddr.Bytes())
+}
+
+// GetAllPendingTransfers returns all pending transfers
+func (k Keeper) GetAllPendingTransfers(ctx context.Context) []types.PendingTransfer {
+	iterator := k.pendingTransferStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	pendingTransfers := []types.PendingTransfer{}
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &pending)
+		pendingTransfers = append(pendingTransfers, pending)
+	}
+	return pendingTransfers
+}
+
+// GetPendingTransfersPage returns pending transfers with pagination, optionally only those to recipient
+func (k Keeper) GetPendingTransfersPage(ctx context.Context, recipient string, pagination *query.PageRequest) ([]types.PendingTransfer, *query.PageResponse, error) {
+	var pendingTransfers []types.PendingTransfer
+	pageRes, err := query.FilteredPaginate(k.pendingTransferStore(ctx), pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var pending types.PendingTransfer
+		if err := k.cdc.Unmarshal(value, &pending); err != nil {
+			return false, err
+		}
+		if recipient != "" && pending.RecipientAddress != recipient {
+			return false, nil
+		}
+		if accumulate {
+			pendingTransfers = append(pendingTransfers, pending)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return pendingTransfers, pageRes, nil
+}
+
+// PruneExpiredPendingTransfers drops the pending transfers whose acceptance deadline has passed
+func (k Keeper) PruneExpiredPendingTransfers(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+	for _, pending := range k.GetAllPendingTransfers(ctx) {
+		if pending.DeadlineHeight >= height {
+			continue
+		}
+		k.RemovePendingTransfer(ctx, sdk.MustAccAddressFromBech32(pending.GenesisAddress))
+		k.Logger().Info(
+			"pending ownership transfer expired",
+			"genesis_address", pending.GenesisAddress,
+			"recipient_address", pending.RecipientAddress,
+			"deadline_height", pending.DeadlineHeight,
+		)
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"ownership_transfer_expired",
+				sdk.NewAttribute("genesis_address", pending.GenesisAddress),
+				sdk.NewAttribute("recipient_address", pending.RecipientAddress),
+				sdk.NewAttribute("deadline_height", fmt.Sprintf("%d", pending.DeadlineHeight)),
+			),
+		)
+	}
+}
+
+func (k Keeper) pendingTransferStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, []byte(types.PendingTransferKeyPrefix))
+}
@@ -109,26 +109,14 @@ func (k Keeper) transferVestingSchedule(ctx context.Context, genesisAccount sdk.
 	}
 
 	// Create vesting account for recipient based on genesis account type
-	currentTime := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
-	var newVestingAccount sdk.AccountI
-
-	switch v := genesisAccount.(type) {
-	case *vestingtypes.PeriodicVestingAccount:
-		newVestingAccount, err = k.createPeriodicVestingAccount(ctx, v, recipientAddr, currentTime)
-	case *vestingtypes.ContinuousVestingAccount:
-		newVestingAccount, err = k.createContinuousVestingAccount(ctx, v, recipientAddr, currentTime)
-	case *vestingtypes.DelayedVestingAccount:
-		newVestingAccount, err = k.createDelayedVestingAccount(ctx, v, recipientAddr, currentTime)
-	case *vestingtypes.BaseVestingAccount:
-		newVestingAccount, err = k.createBaseVestingAccount(ctx, v, recipientAddr, currentTime)
-	default:
-		// No vesting account, nothing to transfer
-		return nil
-	}
-
+	newVestingAccount, isVesting, err := k.recipientVestingAccount(ctx, genesisAccount, recipientAddr)
 	if err != nil {
 		return errorsmod.Wrapf(err, "failed to create vesting account")
 	}
+	if !isVesting {
+		// No vesting account, nothing to transfer
+		return nil
+	}
 
 	// Set the new vesting account for the recipient
 	k.accountKeeper.SetAccount(ctx, newVestingAccount)
@@ -154,6 +142,26 @@ func (k Keeper) transferVestingSchedule(ctx context.Context, genesisAccount sdk.
 	return nil
 }
 
+// recipientVestingAccount builds, without storing it, the vesting account the recipient receives for the
+// remaining schedule of the genesis account. isVesting is false if the genesis account is not a vesting account.
+func (k Keeper) recipientVestingAccount(ctx context.Context, genesisAccount sdk.AccountI, recipientAddr sdk.AccAddress) (account sdk.AccountI, isVesting bool, err error) {
+	currentTime := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	switch v := genesisAccount.(type) {
+	case *vestingtypes.PeriodicVestingAccount:
+		account, err = k.createPeriodicVestingAccount(ctx, v, recipientAddr, currentTime)
+	case *vestingtypes.ContinuousVestingAccount:
+		account, err = k.createContinuousVestingAccount(ctx, v, recipientAddr, currentTime)
+	case *vestingtypes.DelayedVestingAccount:
+		account, err = k.createDelayedVestingAccount(ctx, v, recipientAddr, currentTime)
+	case *vestingtypes.BaseVestingAccount:
+		account, err = k.createBaseVestingAccount(ctx, v, recipientAddr, currentTime)
+	default:
+		return nil, false, nil
+	}
+	return account, true, err
+}
+
 // createPeriodicVestingAccount creates a periodic vesting account for the recipient
 func (k Keeper) createPeriodicVestingAccount(ctx context.Context, vestingAcc *vestingtypes.PeriodicVestingAccount, recipientAddr sdk.AccAddress, currentTime int64) (sdk.AccountI, error) {
 	// Calculate remaining periods and amounts
@@ -358,6 +366,9 @@ func (k Keeper) ExecuteOwnershipTransfer(ctx context.Context, genesisAddr, recip
 		return errorsmod.Wrapf(err, "failed to store transfer record")
 	}
 
+	// A direct transfer supersedes any transfer still waiting for acceptance
+	k.RemovePendingTransfer(ctx, genesisAddr)
+
 	// Phase 4: Emit events for monitoring and audit trail
 	k.emitOwnershipTransferEvents(ctx, transferRecord)
 
@@ -140,6 +140,9 @@ func (m *IntegrationMockBookkeepingBankKeeper) SendCoinsFromModuleToAccount(ctx
 
 // setupVestingIntegrationKeepers creates real genesistransfer keeper with enhanced mock dependencies
 func setupVestingIntegrationKeepers(t testing.TB) (sdk.Context, keeper.Keeper, *IntegrationMockAccountKeeper, *IntegrationMockBankKeeper, *IntegrationMockBookkeepingBankKeeper, types.MsgServer) {
+	// Configure SDK with proper Bech32 prefix for gonka
+	sdk.GetConfig().SetBech32PrefixForAccount("gonka", "gonkapub")
+
 	// Store and Codec Setup
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
@@ -39,6 +39,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "allowed-accounts",
 					Short:     "List all accounts allowed for transfer",
 				},
+				{
+					RpcMethod:      "SimulateTransfer",
+					Use:            "simulate-transfer [genesis-address] [recipient-address]",
+					Short:          "Show the balances and vesting schedule a transfer would move, without executing it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "genesis_address"}, {ProtoField: "recipient_address"}},
+				},
+				{
+					RpcMethod: "PendingTransfers",
+					Use:       "pending-transfers",
+					Short:     "List transfers waiting for their recipients to accept them",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -59,6 +70,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "recipient_address"},
 					},
 				},
+				{
+					RpcMethod: "BatchTransferOwnership",
+					Use:       "batch-transfer-ownership",
+					Short:     "Transfer ownership of many genesis accounts at once, all or none",
+					Long:      "Transfer ownership of many genesis accounts at once, all or none. Pass each pair as --transfers '{\"genesis_address\":\"...\",\"recipient_address\":\"...\"}'. Every genesis account must sign. With --acceptance-window-blocks each recipient has to accept its transfer within that many blocks.",
+				},
+				{
+					RpcMethod:      "AcceptOwnershipTransfer",
+					Use:            "accept-ownership-transfer [genesis-address]...",
+					Short:          "Accept pending transfers of genesis accounts to you",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "genesis_addresses", Varargs: true}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -13,12 +13,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
 	}
+	for _, pending := range genState.PendingTransfers {
+		if err := k.SetPendingTransfer(ctx, pending); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.PendingTransfers = k.GetAllPendingTransfers(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -20,4 +20,6 @@ var (
 	ErrInvalidTransfer          = sdkerrors.Register(ModuleName, 1109, "invalid transfer")
 	ErrAlreadyTransferred       = sdkerrors.Register(ModuleName, 1110, "account already transferred")
 	ErrNotInAllowedList         = sdkerrors.Register(ModuleName, 1111, "account not in allowed transfer list")
+	ErrPendingTransferNotFound  = sdkerrors.Register(ModuleName, 1112, "pending transfer not found")
+	ErrPendingTransferExpired   = sdkerrors.Register(ModuleName, 1113, "pending transfer expired")
 )
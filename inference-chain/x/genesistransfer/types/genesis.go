@@ -9,8 +9,9 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params:          DefaultParams(),
-		TransferRecords: []TransferRecord{},
+		Params:           DefaultParams(),
+		TransferRecords:  []TransferRecord{},
+		PendingTransfers: []PendingTransfer{},
 	}
 }
 
@@ -33,5 +34,17 @@ func (gs GenesisState) Validate() error {
 		seenAddresses[record.GenesisAddress] = true
 	}
 
+	// Validate pending transfers
+	seenPending := make(map[string]bool)
+	for _, pending := range gs.PendingTransfers {
+		if err := pending.Validate(); err != nil {
+			return err
+		}
+		if seenPending[pending.GenesisAddress] {
+			return ErrInvalidTransferRequest.Wrapf("duplicate pending transfer for %s", pending.GenesisAddress)
+		}
+		seenPending[pending.GenesisAddress] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -109,19 +109,91 @@ func (m *TransferRecord) GetTransferAmount() string {
 	return ""
 }
 
+// PendingTransfer is an ownership transfer waiting for the recipient to accept it
+type PendingTransfer struct {
+	GenesisAddress   string `protobuf:"bytes,1,opt,name=genesis_address,json=genesisAddress,proto3" json:"genesis_address,omitempty"`
+	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	CreatedHeight    uint64 `protobuf:"varint,3,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// deadline_height is the last height the recipient can accept the transfer at
+	DeadlineHeight uint64 `protobuf:"varint,4,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+}
+
+func (m *PendingTransfer) Reset()         { *m = PendingTransfer{} }
+func (m *PendingTransfer) String() string { return proto.CompactTextString(m) }
+func (*PendingTransfer) ProtoMessage()    {}
+func (*PendingTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9584dc1c39f69dd, []int{1}
+}
+func (m *PendingTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTransfer.Merge(m, src)
+}
+func (m *PendingTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTransfer proto.InternalMessageInfo
+
+func (m *PendingTransfer) GetGenesisAddress() string {
+	if m != nil {
+		return m.GenesisAddress
+	}
+	return ""
+}
+
+func (m *PendingTransfer) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+func (m *PendingTransfer) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *PendingTransfer) GetDeadlineHeight() uint64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
 // GenesisState defines the genesistransfer module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// transfer_records list of completed transfers for state persistence
 	TransferRecords []TransferRecord `protobuf:"bytes,2,rep,name=transfer_records,json=transferRecords,proto3" json:"transfer_records"`
+	// pending_transfers waiting for their recipients to accept them
+	PendingTransfers []PendingTransfer `protobuf:"bytes,3,rep,name=pending_transfers,json=pendingTransfers,proto3" json:"pending_transfers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9584dc1c39f69dd, []int{1}
+	return fileDescriptor_e9584dc1c39f69dd, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,8 +236,16 @@ func (m *GenesisState) GetTransferRecords() []TransferRecord {
 	return nil
 }
 
+func (m *GenesisState) GetPendingTransfers() []PendingTransfer {
+	if m != nil {
+		return m.PendingTransfers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TransferRecord)(nil), "inference.genesistransfer.TransferRecord")
+	proto.RegisterType((*PendingTransfer)(nil), "inference.genesistransfer.PendingTransfer")
 	proto.RegisterType((*GenesisState)(nil), "inference.genesistransfer.GenesisState")
 }
 
@@ -174,33 +254,37 @@ func init() {
 }
 
 var fileDescriptor_e9584dc1c39f69dd = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xbd, 0x0e, 0xd3, 0x30,
-	0x14, 0x85, 0xe3, 0xb6, 0x54, 0xc4, 0x45, 0xfd, 0xb1, 0x18, 0x42, 0x85, 0xd2, 0xd0, 0x81, 0x06,
-	0x10, 0x89, 0x54, 0x36, 0x98, 0x5a, 0x55, 0x82, 0x11, 0x05, 0x58, 0x58, 0xa2, 0x34, 0xb9, 0x4d,
-	0x2d, 0x11, 0x3b, 0xb2, 0x5d, 0x09, 0x9e, 0x81, 0x85, 0x47, 0x60, 0x64, 0x64, 0xe1, 0x1d, 0x3a,
-	0x76, 0x64, 0x42, 0xa8, 0x1d, 0xe0, 0x31, 0x50, 0x9d, 0x9f, 0xb6, 0xa0, 0xb2, 0x44, 0xce, 0x39,
-	0x9f, 0x7d, 0x7d, 0xaf, 0x0f, 0x9e, 0x50, 0xb6, 0x02, 0x01, 0x2c, 0x06, 0x3f, 0x05, 0x06, 0x92,
-	0x4a, 0x25, 0x22, 0x26, 0x57, 0x20, 0xaa, 0x7f, 0x2f, 0x17, 0x5c, 0x71, 0x72, 0xa7, 0x06, 0xbd,
-	0xbf, 0xc0, 0xe1, 0x20, 0xca, 0x28, 0xe3, 0xbe, 0xfe, 0x16, 0xf4, 0xf0, 0x76, 0xca, 0x53, 0xae,
-	0x97, 0xfe, 0x71, 0x55, 0xaa, 0xf7, 0xaf, 0x17, 0xcb, 0x23, 0x11, 0x65, 0x65, 0xad, 0xf1, 0xc7,
-	0x06, 0xee, 0xbe, 0x2e, 0x9d, 0x00, 0x62, 0x2e, 0x12, 0x32, 0xc1, 0xbd, 0x72, 0x4b, 0x18, 0x25,
-	0x89, 0x00, 0x29, 0x2d, 0xe4, 0x20, 0xd7, 0x0c, 0xba, 0xa5, 0x3c, 0x2b, 0x54, 0xf2, 0x08, 0x0f,
-	0x04, 0xc4, 0x34, 0xa7, 0xc0, 0x54, 0x8d, 0x36, 0x34, 0xda, 0xaf, 0x8d, 0x0a, 0x9e, 0xe0, 0x5e,
-	0x75, 0x83, 0x70, 0x0d, 0x34, 0x5d, 0x2b, 0xab, 0xe9, 0x20, 0xb7, 0x15, 0x74, 0x2b, 0xf9, 0x85,
-	0x56, 0xc9, 0x5d, 0x6c, 0xc6, 0x3c, 0xcb, 0xdf, 0x81, 0x82, 0xc4, 0x6a, 0x39, 0xc8, 0xbd, 0x19,
-	0x9c, 0x04, 0xf2, 0x18, 0x93, 0x8a, 0x17, 0x90, 0x84, 0x09, 0x30, 0x9e, 0x49, 0xeb, 0x86, 0xd3,
-	0x74, 0xcd, 0x60, 0x70, 0xe6, 0x2c, 0xb4, 0x71, 0x51, 0x35, 0xca, 0xf8, 0x86, 0x29, 0xab, 0x5d,
-	0xf4, 0x52, 0xc9, 0x33, 0xad, 0x3e, 0x6d, 0xfd, 0xfe, 0x3c, 0x42, 0xe3, 0x6f, 0x08, 0xdf, 0x7a,
-	0x5e, 0x34, 0xf9, 0x4a, 0x45, 0x0a, 0xc8, 0x02, 0xb7, 0x8b, 0x71, 0xe9, 0x11, 0x74, 0xa6, 0xf7,
-	0xbc, 0xab, 0x6f, 0xe3, 0xbd, 0xd4, 0xe0, 0xdc, 0xdc, 0xfe, 0x18, 0x19, 0x5f, 0x7e, 0x7d, 0x7d,
-	0x88, 0x82, 0x72, 0x2f, 0x09, 0x71, 0xbf, 0xbe, 0x85, 0xd0, 0x43, 0x3e, 0xce, 0xa9, 0xe9, 0x76,
-	0xa6, 0x0f, 0xfe, 0x73, 0xde, 0xe5, 0xb3, 0x9c, 0x9f, 0x5b, 0xf7, 0x54, 0x58, 0x72, 0xfe, 0x66,
-	0xbb, 0xb7, 0xd1, 0x6e, 0x6f, 0xa3, 0x9f, 0x7b, 0x1b, 0x7d, 0x3a, 0xd8, 0xc6, 0xee, 0x60, 0x1b,
-	0xdf, 0x0f, 0xb6, 0xf1, 0xf6, 0x59, 0x4a, 0xd5, 0x7a, 0xb3, 0xf4, 0x62, 0x9e, 0xf9, 0xb9, 0xe0,
-	0xc9, 0x26, 0x56, 0x32, 0xa6, 0x3a, 0x17, 0xa7, 0x84, 0xbc, 0xff, 0x27, 0x23, 0xea, 0x43, 0x0e,
-	0x72, 0xd9, 0xd6, 0x19, 0x79, 0xf2, 0x27, 0x00, 0x00, 0xff, 0xff, 0x42, 0x2f, 0x70, 0x36, 0xba,
-	0x02, 0x00, 0x00,
+	// 478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xbf, 0x6f, 0x13, 0x31,
+	0x18, 0x8d, 0x93, 0x10, 0x11, 0x17, 0xf2, 0xe3, 0xc4, 0x70, 0x54, 0xe8, 0x1a, 0x2a, 0x41, 0x8e,
+	0x22, 0x2e, 0x52, 0xd9, 0x60, 0x6a, 0x55, 0x09, 0xc6, 0xea, 0x80, 0x85, 0xe5, 0xe4, 0x9c, 0xbf,
+	0x5e, 0x2c, 0xf5, 0xec, 0x93, 0xed, 0x48, 0xf0, 0x37, 0xb0, 0xb0, 0xb2, 0x31, 0x32, 0xf2, 0x0f,
+	0xb0, 0x77, 0xec, 0xc8, 0x84, 0x50, 0x32, 0xc0, 0x9f, 0x81, 0xea, 0x1f, 0xd7, 0x1c, 0xa8, 0xdd,
+	0x58, 0x4e, 0xbe, 0xf7, 0x9e, 0xfd, 0x3d, 0x3f, 0x7f, 0x1f, 0x9e, 0x32, 0x7e, 0x02, 0x12, 0x78,
+	0x0e, 0xb3, 0x02, 0x38, 0x28, 0xa6, 0xb4, 0x24, 0x5c, 0x9d, 0x80, 0xf4, 0xff, 0x49, 0x25, 0x85,
+	0x16, 0xc1, 0xdd, 0x5a, 0x98, 0xfc, 0x25, 0xdc, 0x1e, 0x93, 0x92, 0x71, 0x31, 0x33, 0x5f, 0xab,
+	0xde, 0xbe, 0x53, 0x88, 0x42, 0x98, 0xe5, 0xec, 0x62, 0xe5, 0xd0, 0x87, 0x57, 0x17, 0xab, 0x88,
+	0x24, 0xa5, 0xab, 0xb5, 0xfb, 0xa1, 0x8d, 0x07, 0xaf, 0x1d, 0x93, 0x42, 0x2e, 0x24, 0x0d, 0xa6,
+	0x78, 0xe8, 0xb6, 0x64, 0x84, 0x52, 0x09, 0x4a, 0x85, 0x68, 0x82, 0xe2, 0x7e, 0x3a, 0x70, 0xf0,
+	0x81, 0x45, 0x83, 0xc7, 0x78, 0x2c, 0x21, 0x67, 0x15, 0x03, 0xae, 0x6b, 0x69, 0xdb, 0x48, 0x47,
+	0x35, 0xe1, 0xc5, 0x53, 0x3c, 0xf4, 0x0e, 0xb2, 0x05, 0xb0, 0x62, 0xa1, 0xc3, 0xce, 0x04, 0xc5,
+	0xdd, 0x74, 0xe0, 0xe1, 0x97, 0x06, 0x0d, 0xee, 0xe1, 0x7e, 0x2e, 0xca, 0xea, 0x14, 0x34, 0xd0,
+	0xb0, 0x3b, 0x41, 0xf1, 0xcd, 0xf4, 0x12, 0x08, 0x9e, 0xe0, 0xc0, 0xeb, 0x25, 0xd0, 0x8c, 0x02,
+	0x17, 0xa5, 0x0a, 0x6f, 0x4c, 0x3a, 0x71, 0x3f, 0x1d, 0x6f, 0x30, 0x47, 0x86, 0x68, 0x54, 0x25,
+	0xa5, 0x58, 0x72, 0x1d, 0xf6, 0xec, 0x5d, 0x3c, 0x7c, 0x60, 0xd0, 0x67, 0xdd, 0xdf, 0x9f, 0x77,
+	0xd0, 0xee, 0x37, 0x84, 0x87, 0xc7, 0xc0, 0x29, 0xe3, 0x85, 0x0f, 0xe5, 0x3f, 0xc5, 0xf1, 0x00,
+	0x0f, 0x72, 0x09, 0x44, 0x03, 0x6d, 0xa6, 0x71, 0xdb, 0xa1, 0x2e, 0x8c, 0x29, 0x1e, 0x52, 0x20,
+	0xf4, 0x94, 0x71, 0xf0, 0xba, 0xae, 0x4d, 0xcd, 0xc3, 0x56, 0xe8, 0xfc, 0x7f, 0x6a, 0xe3, 0x5b,
+	0x2f, 0xac, 0xab, 0x57, 0x9a, 0x68, 0x08, 0x8e, 0x70, 0xcf, 0x3e, 0xb7, 0xf1, 0xbc, 0xb5, 0x7f,
+	0x3f, 0xb9, 0xb2, 0xb7, 0x92, 0x63, 0x23, 0x3c, 0xec, 0x9f, 0xfd, 0xd8, 0x69, 0x7d, 0xf9, 0xf5,
+	0x75, 0x0f, 0xa5, 0x6e, 0x6f, 0x90, 0xe1, 0x51, 0x9d, 0xa2, 0x34, 0x4d, 0x72, 0x71, 0xb1, 0x4e,
+	0xbc, 0xb5, 0xff, 0xe8, 0x9a, 0xf3, 0x9a, 0x6d, 0xb5, 0x79, 0x6e, 0xfd, 0x26, 0x96, 0x52, 0xc1,
+	0x1c, 0x8f, 0x2b, 0x1b, 0x7b, 0xe6, 0x29, 0x15, 0x76, 0x4c, 0x85, 0xbd, 0xeb, 0x1c, 0x37, 0x9f,
+	0x6a, 0xb3, 0xc4, 0xa8, 0x6a, 0x72, 0xea, 0xf0, 0xcd, 0xd9, 0x2a, 0x42, 0xe7, 0xab, 0x08, 0xfd,
+	0x5c, 0x45, 0xe8, 0xe3, 0x3a, 0x6a, 0x9d, 0xaf, 0xa3, 0xd6, 0xf7, 0x75, 0xd4, 0x7a, 0xfb, 0xbc,
+	0x60, 0x7a, 0xb1, 0x9c, 0x27, 0xb9, 0x28, 0x67, 0x95, 0x14, 0x74, 0x99, 0x6b, 0x95, 0x33, 0x33,
+	0x3b, 0x97, 0x53, 0xf4, 0xee, 0x9f, 0x39, 0xd2, 0xef, 0x2b, 0x50, 0xf3, 0x9e, 0x99, 0xa3, 0xa7,
+	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x02, 0x04, 0xb1, 0xde, 0x03, 0x00, 0x00,
 }
 
 func (this *TransferRecord) Equal(that interface{}) bool {
@@ -247,6 +331,39 @@ func (this *TransferRecord) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *PendingTransfer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingTransfer)
+	if !ok {
+		that2, ok := that.(PendingTransfer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.GenesisAddress != that1.GenesisAddress {
+		return false
+	}
+	if this.RecipientAddress != that1.RecipientAddress {
+		return false
+	}
+	if this.CreatedHeight != that1.CreatedHeight {
+		return false
+	}
+	if this.DeadlineHeight != that1.DeadlineHeight {
+		return false
+	}
+	return true
+}
 func (m *TransferRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -315,6 +432,53 @@ func (m *TransferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GenesisAddress) > 0 {
+		i -= len(m.GenesisAddress)
+		copy(dAtA[i:], m.GenesisAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GenesisAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -335,6 +499,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingTransfers) > 0 {
+		for iNdEx := len(m.PendingTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TransferRecords) > 0 {
 		for iNdEx := len(m.TransferRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -406,6 +584,29 @@ func (m *TransferRecord) Size() (n int) {
 	return n
 }
 
+func (m *PendingTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GenesisAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.CreatedHeight))
+	}
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.DeadlineHeight))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -420,6 +621,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingTransfers) > 0 {
+		for _, e := range m.PendingTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -646,6 +853,158 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -742,6 +1101,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTransfers = append(m.PendingTransfers, PendingTransfer{})
+			if err := m.PendingTransfers[len(m.PendingTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"github.com/productscience/inference/testutil"
 	"github.com/productscience/inference/x/genesistransfer/types"
 	"github.com/stretchr/testify/require"
 )
@@ -26,6 +27,27 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated pending transfer",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingTransfers: []types.PendingTransfer{
+					{GenesisAddress: testutil.Creator, RecipientAddress: testutil.Requester, CreatedHeight: 1, DeadlineHeight: 10},
+					{GenesisAddress: testutil.Creator, RecipientAddress: testutil.Executor, CreatedHeight: 1, DeadlineHeight: 10},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pending transfer deadline before creation",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PendingTransfers: []types.PendingTransfer{
+					{GenesisAddress: testutil.Creator, RecipientAddress: testutil.Requester, CreatedHeight: 10, DeadlineHeight: 5},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
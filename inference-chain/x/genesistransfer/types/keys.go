@@ -16,7 +16,11 @@ var (
 )
 
 const (
-	TransferRecordKeyPrefix = "transfer_record/"
+	TransferRecordKeyPrefix  = "transfer_record/"
+	PendingTransferKeyPrefix = "pending_transfer/"
+
+	// MaxBatchTransfers caps the number of transfers in one MsgBatchTransferOwnership
+	MaxBatchTransfers = 100
 )
 
 func KeyPrefix(p string) []byte {
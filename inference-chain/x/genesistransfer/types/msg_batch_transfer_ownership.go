@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs basic validation of MsgBatchTransferOwnership
+func (msg *MsgBatchTransferOwnership) ValidateBasic() error {
+	if len(msg.Transfers) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTransferRequest, "no transfers in batch")
+	}
+	if len(msg.Transfers) > MaxBatchTransfers {
+		return sdkerrors.Wrapf(ErrInvalidTransferRequest, "%d transfers in batch, at most %d allowed", len(msg.Transfers), MaxBatchTransfers)
+	}
+
+	genesisAddresses := make(map[string]bool, len(msg.Transfers))
+	for _, transfer := range msg.Transfers {
+		single := MsgTransferOwnership{GenesisAddress: transfer.GenesisAddress, RecipientAddress: transfer.RecipientAddress}
+		if err := single.ValidateBasic(); err != nil {
+			return err
+		}
+		if genesisAddresses[transfer.GenesisAddress] {
+			return sdkerrors.Wrapf(ErrInvalidTransferRequest, "genesis address %s appears more than once", transfer.GenesisAddress)
+		}
+		genesisAddresses[transfer.GenesisAddress] = true
+	}
+	// Chained transfers would depend on the order of execution
+	for _, transfer := range msg.Transfers {
+		if genesisAddresses[transfer.RecipientAddress] {
+			return sdkerrors.Wrapf(ErrInvalidTransferRequest, "recipient %s is also a genesis address of the batch", transfer.RecipientAddress)
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation of MsgAcceptOwnershipTransfer
+func (msg *MsgAcceptOwnershipTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.RecipientAddress); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidTransferRequest, "invalid recipient address %s: %v", msg.RecipientAddress, err)
+	}
+	if len(msg.GenesisAddresses) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTransferRequest, "no genesis addresses to accept")
+	}
+	if len(msg.GenesisAddresses) > MaxBatchTransfers {
+		return sdkerrors.Wrapf(ErrInvalidTransferRequest, "%d genesis addresses, at most %d allowed", len(msg.GenesisAddresses), MaxBatchTransfers)
+	}
+
+	seen := make(map[string]bool, len(msg.GenesisAddresses))
+	for _, genesisAddress := range msg.GenesisAddresses {
+		if _, err := sdk.AccAddressFromBech32(genesisAddress); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidTransferRequest, "invalid genesis address %s: %v", genesisAddress, err)
+		}
+		if seen[genesisAddress] {
+			return sdkerrors.Wrapf(ErrInvalidTransferRequest, "genesis address %s appears more than once", genesisAddress)
+		}
+		seen[genesisAddress] = true
+	}
+
+	return nil
+}
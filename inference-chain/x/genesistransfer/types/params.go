@@ -121,3 +121,17 @@ func (tr TransferRecord) Validate() error {
 
 	return nil
 }
+
+// Validate validates a pending transfer
+func (pt PendingTransfer) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(pt.GenesisAddress); err != nil {
+		return fmt.Errorf("invalid genesis address %s: %w", pt.GenesisAddress, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(pt.RecipientAddress); err != nil {
+		return fmt.Errorf("invalid recipient address %s: %w", pt.RecipientAddress, err)
+	}
+	if pt.DeadlineHeight < pt.CreatedHeight {
+		return fmt.Errorf("deadline height %d is before created height %d", pt.DeadlineHeight, pt.CreatedHeight)
+	}
+	return nil
+}
@@ -42,3 +42,14 @@ func (req *QueryAllowedAccountsRequest) ValidateBasic() error {
 	// No parameters to validate
 	return nil
 }
+
+// ValidateBasic validates the QuerySimulateTransferRequest
+func (req *QuerySimulateTransferRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(req.GenesisAddress); err != nil {
+		return errors.ErrInvalidAddress.Wrapf("invalid genesis address: %s", err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(req.RecipientAddress); err != nil {
+		return errors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err.Error())
+	}
+	return nil
+}
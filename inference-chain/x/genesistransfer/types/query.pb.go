@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -506,6 +508,336 @@ func (m *QueryTransferEligibilityResponse) GetAlreadyTransferred() bool {
 	return false
 }
 
+// QuerySimulateTransferRequest is request type for the Query/SimulateTransfer RPC method.
+type QuerySimulateTransferRequest struct {
+	GenesisAddress   string `protobuf:"bytes,1,opt,name=genesis_address,json=genesisAddress,proto3" json:"genesis_address,omitempty"`
+	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+}
+
+func (m *QuerySimulateTransferRequest) Reset()         { *m = QuerySimulateTransferRequest{} }
+func (m *QuerySimulateTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateTransferRequest) ProtoMessage()    {}
+func (*QuerySimulateTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1df3317d4ed4316, []int{10}
+}
+func (m *QuerySimulateTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateTransferRequest.Merge(m, src)
+}
+func (m *QuerySimulateTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateTransferRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateTransferRequest) GetGenesisAddress() string {
+	if m != nil {
+		return m.GenesisAddress
+	}
+	return ""
+}
+
+func (m *QuerySimulateTransferRequest) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+// VestingPeriod is a period of the vesting schedule the recipient would receive
+type VestingPeriod struct {
+	Length int64                                    `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *VestingPeriod) Reset()         { *m = VestingPeriod{} }
+func (m *VestingPeriod) String() string { return proto.CompactTextString(m) }
+func (*VestingPeriod) ProtoMessage()    {}
+func (*VestingPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1df3317d4ed4316, []int{11}
+}
+func (m *VestingPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingPeriod.Merge(m, src)
+}
+func (m *VestingPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingPeriod proto.InternalMessageInfo
+
+func (m *VestingPeriod) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *VestingPeriod) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// QuerySimulateTransferResponse is response type for the Query/SimulateTransfer RPC method.
+type QuerySimulateTransferResponse struct {
+	IsEligible  bool                                     `protobuf:"varint,1,opt,name=is_eligible,json=isEligible,proto3" json:"is_eligible,omitempty"`
+	Reason      string                                   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Balances    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	Spendable   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spendable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spendable"`
+	VestingType string                                   `protobuf:"bytes,5,opt,name=vesting_type,json=vestingType,proto3" json:"vesting_type,omitempty"`
+	// recipient_vesting_type is the vesting account type the recipient would receive, empty if none
+	RecipientVestingType     string                                   `protobuf:"bytes,6,opt,name=recipient_vesting_type,json=recipientVestingType,proto3" json:"recipient_vesting_type,omitempty"`
+	RecipientOriginalVesting github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=recipient_original_vesting,json=recipientOriginalVesting,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recipient_original_vesting"`
+	RecipientStartTime       int64                                    `protobuf:"varint,8,opt,name=recipient_start_time,json=recipientStartTime,proto3" json:"recipient_start_time,omitempty"`
+	RecipientEndTime         int64                                    `protobuf:"varint,9,opt,name=recipient_end_time,json=recipientEndTime,proto3" json:"recipient_end_time,omitempty"`
+	RecipientPeriods         []VestingPeriod                          `protobuf:"bytes,10,rep,name=recipient_periods,json=recipientPeriods,proto3" json:"recipient_periods"`
+}
+
+func (m *QuerySimulateTransferResponse) Reset()         { *m = QuerySimulateTransferResponse{} }
+func (m *QuerySimulateTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateTransferResponse) ProtoMessage()    {}
+func (*QuerySimulateTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1df3317d4ed4316, []int{12}
+}
+func (m *QuerySimulateTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateTransferResponse.Merge(m, src)
+}
+func (m *QuerySimulateTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateTransferResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateTransferResponse) GetIsEligible() bool {
+	if m != nil {
+		return m.IsEligible
+	}
+	return false
+}
+
+func (m *QuerySimulateTransferResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *QuerySimulateTransferResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *QuerySimulateTransferResponse) GetSpendable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spendable
+	}
+	return nil
+}
+
+func (m *QuerySimulateTransferResponse) GetVestingType() string {
+	if m != nil {
+		return m.VestingType
+	}
+	return ""
+}
+
+func (m *QuerySimulateTransferResponse) GetRecipientVestingType() string {
+	if m != nil {
+		return m.RecipientVestingType
+	}
+	return ""
+}
+
+func (m *QuerySimulateTransferResponse) GetRecipientOriginalVesting() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RecipientOriginalVesting
+	}
+	return nil
+}
+
+func (m *QuerySimulateTransferResponse) GetRecipientStartTime() int64 {
+	if m != nil {
+		return m.RecipientStartTime
+	}
+	return 0
+}
+
+func (m *QuerySimulateTransferResponse) GetRecipientEndTime() int64 {
+	if m != nil {
+		return m.RecipientEndTime
+	}
+	return 0
+}
+
+func (m *QuerySimulateTransferResponse) GetRecipientPeriods() []VestingPeriod {
+	if m != nil {
+		return m.RecipientPeriods
+	}
+	return nil
+}
+
+// QueryPendingTransfersRequest is request type for the Query/PendingTransfers RPC method.
+type QueryPendingTransfersRequest struct {
+	RecipientAddress string             `protobuf:"bytes,1,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingTransfersRequest) Reset()         { *m = QueryPendingTransfersRequest{} }
+func (m *QueryPendingTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingTransfersRequest) ProtoMessage()    {}
+func (*QueryPendingTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1df3317d4ed4316, []int{13}
+}
+func (m *QueryPendingTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingTransfersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingTransfersRequest.Merge(m, src)
+}
+func (m *QueryPendingTransfersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingTransfersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingTransfersRequest proto.InternalMessageInfo
+
+func (m *QueryPendingTransfersRequest) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+func (m *QueryPendingTransfersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingTransfersResponse is response type for the Query/PendingTransfers RPC method.
+type QueryPendingTransfersResponse struct {
+	PendingTransfers []PendingTransfer   `protobuf:"bytes,1,rep,name=pending_transfers,json=pendingTransfers,proto3" json:"pending_transfers"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingTransfersResponse) Reset()         { *m = QueryPendingTransfersResponse{} }
+func (m *QueryPendingTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingTransfersResponse) ProtoMessage()    {}
+func (*QueryPendingTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1df3317d4ed4316, []int{14}
+}
+func (m *QueryPendingTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingTransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingTransfersResponse.Merge(m, src)
+}
+func (m *QueryPendingTransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingTransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingTransfersResponse proto.InternalMessageInfo
+
+func (m *QueryPendingTransfersResponse) GetPendingTransfers() []PendingTransfer {
+	if m != nil {
+		return m.PendingTransfers
+	}
+	return nil
+}
+
+func (m *QueryPendingTransfersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "inference.genesistransfer.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "inference.genesistransfer.QueryParamsResponse")
@@ -517,6 +849,11 @@ func init() {
 	proto.RegisterType((*QueryAllowedAccountsResponse)(nil), "inference.genesistransfer.QueryAllowedAccountsResponse")
 	proto.RegisterType((*QueryTransferEligibilityRequest)(nil), "inference.genesistransfer.QueryTransferEligibilityRequest")
 	proto.RegisterType((*QueryTransferEligibilityResponse)(nil), "inference.genesistransfer.QueryTransferEligibilityResponse")
+	proto.RegisterType((*QuerySimulateTransferRequest)(nil), "inference.genesistransfer.QuerySimulateTransferRequest")
+	proto.RegisterType((*VestingPeriod)(nil), "inference.genesistransfer.VestingPeriod")
+	proto.RegisterType((*QuerySimulateTransferResponse)(nil), "inference.genesistransfer.QuerySimulateTransferResponse")
+	proto.RegisterType((*QueryPendingTransfersRequest)(nil), "inference.genesistransfer.QueryPendingTransfersRequest")
+	proto.RegisterType((*QueryPendingTransfersResponse)(nil), "inference.genesistransfer.QueryPendingTransfersResponse")
 }
 
 func init() {
@@ -524,56 +861,83 @@ func init() {
 }
 
 var fileDescriptor_e1df3317d4ed4316 = []byte{
-	// 779 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4b, 0x6f, 0xd3, 0x4a,
-	0x14, 0x8e, 0x5b, 0xdd, 0xdc, 0x66, 0xaa, 0x9b, 0xf4, 0x4e, 0x2b, 0x54, 0x42, 0x49, 0x8b, 0xa5,
-	0x3e, 0x25, 0x6c, 0x5a, 0xc4, 0x43, 0x2d, 0x9b, 0x56, 0x14, 0x10, 0x0f, 0xa9, 0xb8, 0x45, 0x42,
-	0xb0, 0xb0, 0x26, 0xce, 0xd4, 0x1d, 0xc9, 0xf1, 0xb8, 0x33, 0x13, 0x20, 0x42, 0x6c, 0x58, 0xb3,
-	0x40, 0x62, 0xc3, 0x96, 0x1d, 0x4b, 0x7e, 0x05, 0xea, 0xb2, 0x12, 0x2c, 0x58, 0x21, 0xd4, 0x22,
-	0xc1, 0xbf, 0x00, 0x65, 0x66, 0xdc, 0xd6, 0x4e, 0xd2, 0x3c, 0x36, 0x91, 0x7d, 0x9e, 0xdf, 0x77,
-	0xfc, 0x9d, 0xa3, 0x80, 0x69, 0x12, 0x6e, 0x63, 0x86, 0x43, 0x0f, 0xdb, 0x3e, 0x0e, 0x31, 0x27,
-	0x5c, 0x30, 0x14, 0xf2, 0x6d, 0xcc, 0xec, 0xdd, 0x1a, 0x66, 0x75, 0x2b, 0x62, 0x54, 0x50, 0x78,
-	0xf6, 0x28, 0xcc, 0x4a, 0x85, 0x15, 0xff, 0x47, 0x55, 0x12, 0x52, 0x5b, 0xfe, 0xaa, 0xe8, 0xe2,
-	0x98, 0x4f, 0x7d, 0x2a, 0x1f, 0xed, 0xc6, 0x93, 0xb6, 0x4e, 0xf8, 0x94, 0xfa, 0x01, 0xb6, 0x51,
-	0x44, 0x6c, 0x14, 0x86, 0x54, 0x20, 0x41, 0x68, 0xc8, 0xb5, 0x77, 0xc1, 0xa3, 0xbc, 0x4a, 0xb9,
-	0x5d, 0x46, 0x1c, 0xab, 0xd6, 0xf6, 0xb3, 0xc5, 0x32, 0x16, 0x68, 0xd1, 0x8e, 0x90, 0x4f, 0x42,
-	0x19, 0xac, 0x63, 0x67, 0xda, 0x83, 0x8e, 0x10, 0x43, 0xd5, 0xb8, 0xe6, 0x6c, 0xfb, 0x38, 0xfd,
-	0xae, 0x02, 0xcd, 0x31, 0x00, 0x1f, 0x36, 0x5a, 0x6e, 0xc8, 0x6c, 0x07, 0xef, 0xd6, 0x30, 0x17,
-	0xe6, 0x53, 0x30, 0x9a, 0xb0, 0xf2, 0x88, 0x86, 0x1c, 0xc3, 0x9b, 0x20, 0xab, 0xba, 0x8c, 0x1b,
-	0x53, 0xc6, 0xdc, 0xf0, 0xd2, 0x05, 0xab, 0xed, 0x70, 0x2c, 0x95, 0xba, 0x96, 0xdb, 0xfb, 0x3e,
-	0x99, 0xf9, 0xf8, 0xeb, 0xd3, 0x82, 0xe1, 0xe8, 0x5c, 0x73, 0x1d, 0x14, 0x65, 0xf1, 0x2d, 0x1d,
-	0xba, 0x29, 0x90, 0xa8, 0xc5, 0xad, 0xe1, 0x2c, 0x28, 0xe8, 0x52, 0x2e, 0xaa, 0x54, 0x18, 0xe6,
-	0xaa, 0x59, 0xce, 0xc9, 0x6b, 0xf3, 0xaa, 0xb2, 0x9a, 0xef, 0x0d, 0x70, 0xae, 0x65, 0x1d, 0x0d,
-	0x76, 0x1a, 0xe4, 0x09, 0x77, 0x63, 0x3c, 0x0c, 0x57, 0x64, 0x9d, 0x21, 0xe7, 0x3f, 0xc2, 0xb7,
-	0x8e, 0x8d, 0xd0, 0x01, 0x85, 0x38, 0xc6, 0x65, 0xd8, 0xa3, 0xac, 0x32, 0x3e, 0x20, 0xc9, 0xcd,
-	0x9f, 0x42, 0x2e, 0x2e, 0xe0, 0xc8, 0x04, 0x27, 0x2f, 0x12, 0xef, 0x26, 0x4e, 0x21, 0xbb, 0x43,
-	0xb8, 0xa0, 0xac, 0x1e, 0x53, 0xbc, 0x05, 0xc0, 0xf1, 0x87, 0xd5, 0xa3, 0x9c, 0xb1, 0x94, 0x0a,
-	0xac, 0x86, 0x0a, 0x2c, 0x25, 0x40, 0xad, 0x02, 0x6b, 0x03, 0xf9, 0x58, 0xe7, 0x3a, 0x27, 0x32,
-	0xcd, 0x3d, 0x03, 0x4c, 0xb4, 0xee, 0xa3, 0x47, 0xe0, 0x82, 0x91, 0x14, 0xb7, 0xc6, 0x30, 0x07,
-	0x7b, 0x22, 0x77, 0xf2, 0x0b, 0x16, 0x92, 0x3c, 0x39, 0xbc, 0x9d, 0x60, 0xa2, 0xe6, 0x36, 0xdb,
-	0x91, 0x89, 0x42, 0x97, 0xa0, 0x72, 0x5e, 0x4f, 0x6c, 0x35, 0x08, 0xe8, 0x73, 0x5c, 0x59, 0xf5,
-	0x3c, 0x5a, 0x0b, 0xc5, 0x91, 0x1e, 0xb9, 0x26, 0xda, 0xe4, 0xd6, 0x44, 0xe7, 0xc1, 0x08, 0x52,
-	0x2e, 0x17, 0x69, 0x9f, 0x24, 0x9a, 0x73, 0x0a, 0x28, 0x99, 0x02, 0xe7, 0xc0, 0x08, 0xc3, 0x5c,
-	0x30, 0xe2, 0x09, 0x57, 0x50, 0x37, 0x20, 0x5c, 0x48, 0xe0, 0x43, 0x4e, 0x3e, 0xb6, 0x6f, 0xd1,
-	0xfb, 0x84, 0x0b, 0xf3, 0x2e, 0x98, 0x4c, 0x4c, 0x77, 0x3d, 0x20, 0x3e, 0x29, 0x93, 0x80, 0x88,
-	0x7a, 0xcf, 0x62, 0x7d, 0x63, 0x80, 0xa9, 0xf6, 0xc5, 0x34, 0x8b, 0x49, 0x30, 0x4c, 0xb8, 0x8b,
-	0xa5, 0x27, 0xc0, 0x5a, 0xae, 0x80, 0xf0, 0x75, 0x6d, 0x81, 0x67, 0x40, 0x96, 0x61, 0xc4, 0xf5,
-	0xa8, 0x73, 0x8e, 0x7e, 0x83, 0x36, 0x18, 0x45, 0x01, 0xc3, 0xa8, 0x52, 0x4f, 0xe8, 0x7d, 0x50,
-	0x16, 0x80, 0xda, 0x75, 0x42, 0xf4, 0x4b, 0x7f, 0xfe, 0x05, 0xff, 0x48, 0x38, 0xf0, 0x83, 0x01,
-	0xb2, 0x6a, 0x55, 0xe1, 0xc5, 0x53, 0x34, 0xd1, 0x7c, 0x23, 0x8a, 0x56, 0xb7, 0xe1, 0x8a, 0x9d,
-	0x79, 0xfd, 0xf5, 0x97, 0x9f, 0xef, 0x06, 0x96, 0xe0, 0x25, 0x3b, 0x62, 0xb4, 0x52, 0xf3, 0x04,
-	0xf7, 0x88, 0x3c, 0x50, 0x9d, 0x4e, 0x1a, 0xfc, 0x6a, 0x80, 0x7c, 0x72, 0xc9, 0xe1, 0x95, 0x4e,
-	0xcd, 0x5b, 0x1e, 0x97, 0xe2, 0xd5, 0x5e, 0xd3, 0x34, 0xf6, 0x4d, 0x89, 0xfd, 0x01, 0xbc, 0xd7,
-	0x3d, 0xf6, 0xa3, 0xc5, 0xe3, 0xb2, 0x94, 0xfd, 0x32, 0x25, 0x94, 0x57, 0xf0, 0xb3, 0x01, 0x0a,
-	0xa9, 0xcd, 0x85, 0x5d, 0x03, 0x4c, 0x9e, 0x94, 0xe2, 0xb5, 0x9e, 0xf3, 0x34, 0xb3, 0x35, 0xc9,
-	0xec, 0x06, 0x5c, 0xee, 0x83, 0xd9, 0x8e, 0x06, 0xdd, 0x20, 0x92, 0xda, 0xcc, 0xce, 0x44, 0x5a,
-	0x6f, 0x7a, 0x67, 0x22, 0x6d, 0x4e, 0x40, 0x3f, 0x44, 0xd2, 0x27, 0x03, 0xfe, 0x36, 0xc0, 0x68,
-	0x8b, 0x05, 0x85, 0xcb, 0xdd, 0x4e, 0xb7, 0xf9, 0x44, 0x14, 0x57, 0xfa, 0xca, 0xd5, 0xa4, 0x1e,
-	0x4b, 0x52, 0x0e, 0xdc, 0xe8, 0xe3, 0xeb, 0xe0, 0xe3, 0x7a, 0xcd, 0xe2, 0x5b, 0x7b, 0xb4, 0x77,
-	0x50, 0x32, 0xf6, 0x0f, 0x4a, 0xc6, 0x8f, 0x83, 0x92, 0xf1, 0xf6, 0xb0, 0x94, 0xd9, 0x3f, 0x2c,
-	0x65, 0xbe, 0x1d, 0x96, 0x32, 0x4f, 0x56, 0x7c, 0x22, 0x76, 0x6a, 0x65, 0xcb, 0xa3, 0xd5, 0xf6,
-	0x5d, 0x5f, 0x34, 0xf7, 0xad, 0x47, 0x98, 0x97, 0xb3, 0xf2, 0x5f, 0xc5, 0xe5, 0xbf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xcb, 0xe7, 0xcb, 0x7e, 0x5d, 0x09, 0x00, 0x00,
+	// 1207 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x26, 0x8d, 0xbf, 0xf1, 0xe4, 0xdb, 0xc4, 0x99, 0x44, 0x95, 0x6b, 0x5a, 0xa7, 0x5d,
+	0xa9, 0x4d, 0x1a, 0xa8, 0xb7, 0x0d, 0x14, 0xaa, 0x06, 0x0e, 0x49, 0x09, 0x20, 0x7e, 0x88, 0xb0,
+	0x09, 0x15, 0xa2, 0x87, 0xd5, 0x78, 0x77, 0xba, 0x19, 0xb1, 0x9e, 0xd9, 0xee, 0x8c, 0x0b, 0x56,
+	0xd5, 0x0b, 0x67, 0x84, 0x40, 0xbd, 0x70, 0xe5, 0x86, 0x38, 0xf1, 0x1f, 0x70, 0x43, 0x11, 0xe2,
+	0x50, 0x09, 0x0e, 0x5c, 0xf8, 0xa1, 0x04, 0x09, 0x8e, 0xfc, 0x09, 0x68, 0x67, 0x66, 0xd7, 0xde,
+	0xb5, 0x37, 0x8e, 0x03, 0xb9, 0xb4, 0xde, 0x79, 0x3f, 0x3f, 0xef, 0xbd, 0x99, 0xf7, 0x09, 0xb8,
+	0x44, 0xe8, 0x3d, 0x1c, 0x61, 0xea, 0x62, 0xcb, 0xc7, 0x14, 0x73, 0xc2, 0x45, 0x84, 0x28, 0xbf,
+	0x87, 0x23, 0xeb, 0x7e, 0x1b, 0x47, 0x9d, 0x46, 0x18, 0x31, 0xc1, 0xe0, 0xd9, 0x54, 0xad, 0x91,
+	0x53, 0xab, 0xcd, 0xa1, 0x16, 0xa1, 0xcc, 0x92, 0xff, 0x2a, 0xed, 0xda, 0x82, 0xcf, 0x7c, 0x26,
+	0x7f, 0x5a, 0xf1, 0x2f, 0x7d, 0x7a, 0xce, 0x67, 0xcc, 0x0f, 0xb0, 0x85, 0x42, 0x62, 0x21, 0x4a,
+	0x99, 0x40, 0x82, 0x30, 0xca, 0xb5, 0x74, 0xc5, 0x65, 0xbc, 0xc5, 0xb8, 0xd5, 0x44, 0x1c, 0xab,
+	0xd0, 0xd6, 0x83, 0xeb, 0x4d, 0x2c, 0xd0, 0x75, 0x2b, 0x44, 0x3e, 0xa1, 0x52, 0x59, 0xeb, 0xd6,
+	0x7b, 0x75, 0x13, 0x2d, 0x97, 0x91, 0x44, 0x7e, 0xb9, 0x18, 0x54, 0x88, 0x22, 0xd4, 0x4a, 0x62,
+	0x2e, 0x15, 0xeb, 0xe9, 0x6f, 0xa5, 0x68, 0x2e, 0x00, 0xf8, 0x4e, 0x9c, 0xd2, 0x96, 0xb4, 0xb6,
+	0xf1, 0xfd, 0x36, 0xe6, 0xc2, 0xbc, 0x0b, 0xe6, 0x33, 0xa7, 0x3c, 0x64, 0x94, 0x63, 0xf8, 0x32,
+	0x28, 0xa9, 0x28, 0x55, 0xe3, 0x82, 0xb1, 0x3c, 0xbd, 0x7a, 0xb1, 0x51, 0x58, 0xbc, 0x86, 0x32,
+	0xdd, 0x28, 0xef, 0xfd, 0xba, 0x38, 0xf6, 0xd5, 0x9f, 0xdf, 0xac, 0x18, 0xb6, 0xb6, 0x35, 0x37,
+	0x41, 0x4d, 0x3a, 0xdf, 0xd1, 0xaa, 0xdb, 0x02, 0x89, 0x76, 0x12, 0x1a, 0x2e, 0x81, 0x59, 0xed,
+	0xca, 0x41, 0x9e, 0x17, 0x61, 0xae, 0x82, 0x95, 0xed, 0x19, 0x7d, 0xbc, 0xae, 0x4e, 0xcd, 0x2f,
+	0x0c, 0xf0, 0xd4, 0x40, 0x3f, 0x3a, 0xd9, 0x4b, 0x60, 0x86, 0x70, 0x27, 0xc9, 0x27, 0xc2, 0x9e,
+	0xf4, 0x33, 0x65, 0x9f, 0x26, 0x7c, 0xa7, 0x7b, 0x08, 0x6d, 0x30, 0x9b, 0xe8, 0x38, 0x11, 0x76,
+	0x59, 0xe4, 0x55, 0xc7, 0x25, 0xb8, 0x2b, 0x87, 0x80, 0x4b, 0x1c, 0xd8, 0xd2, 0xc0, 0x9e, 0x11,
+	0x99, 0x6f, 0x13, 0xe7, 0x32, 0x7b, 0x8d, 0x70, 0xc1, 0xa2, 0x4e, 0x02, 0xf1, 0x15, 0x00, 0xba,
+	0x8d, 0xd7, 0xa5, 0xbc, 0xdc, 0x50, 0x9d, 0x6f, 0xc4, 0x9d, 0x6f, 0xa8, 0x01, 0xd5, 0xfd, 0x6f,
+	0x6c, 0x21, 0x1f, 0x6b, 0x5b, 0xbb, 0xc7, 0xd2, 0xdc, 0x33, 0xc0, 0xb9, 0xc1, 0x71, 0x74, 0x09,
+	0x1c, 0x50, 0xc9, 0x61, 0x8b, 0x8b, 0x39, 0x31, 0x12, 0xb8, 0xde, 0x0e, 0xce, 0x66, 0x71, 0x72,
+	0xf8, 0x6a, 0x06, 0x89, 0xaa, 0xdb, 0xd2, 0x50, 0x24, 0x2a, 0xbb, 0x0c, 0x94, 0xf3, 0xba, 0x62,
+	0xeb, 0x41, 0xc0, 0x3e, 0xc4, 0xde, 0xba, 0xeb, 0xb2, 0x36, 0x15, 0xe9, 0x3c, 0x72, 0x0d, 0xb4,
+	0x4f, 0xac, 0x81, 0x5e, 0x01, 0x15, 0xa4, 0x44, 0x0e, 0xd2, 0x32, 0x09, 0xb4, 0x6c, 0xcf, 0xa2,
+	0xac, 0x09, 0x5c, 0x06, 0x95, 0x08, 0x73, 0x11, 0x11, 0x57, 0x38, 0x82, 0x39, 0x01, 0xe1, 0x42,
+	0x26, 0x3e, 0x65, 0xcf, 0x24, 0xe7, 0x3b, 0xec, 0x4d, 0xc2, 0x85, 0xf9, 0x3a, 0x58, 0xcc, 0x54,
+	0x77, 0x33, 0x20, 0x3e, 0x69, 0x92, 0x80, 0x88, 0xce, 0xc8, 0xc3, 0xfa, 0x89, 0x01, 0x2e, 0x14,
+	0x3b, 0xd3, 0x28, 0x16, 0xc1, 0x34, 0xe1, 0x0e, 0x96, 0x92, 0x00, 0xeb, 0x71, 0x05, 0x84, 0x6f,
+	0xea, 0x13, 0x78, 0x06, 0x94, 0x22, 0x8c, 0xb8, 0x2e, 0x75, 0xd9, 0xd6, 0x5f, 0xd0, 0x02, 0xf3,
+	0x28, 0x88, 0x30, 0xf2, 0x3a, 0x99, 0x79, 0x9f, 0x90, 0x0e, 0xa0, 0x16, 0xf5, 0x0c, 0xbd, 0x29,
+	0x74, 0x3d, 0xb7, 0x49, 0xab, 0x1d, 0x20, 0x81, 0xbb, 0x2d, 0x1f, 0x0d, 0x17, 0x7c, 0x1a, 0xcc,
+	0x45, 0xd8, 0x25, 0x21, 0xc1, 0x54, 0xa4, 0xaa, 0x2a, 0xb9, 0x4a, 0x2a, 0x48, 0x8a, 0xf0, 0xb9,
+	0x01, 0x4e, 0xdf, 0xc1, 0x5c, 0x10, 0xea, 0x6f, 0xe1, 0x88, 0x30, 0x2f, 0x06, 0x14, 0x60, 0xea,
+	0x8b, 0x5d, 0xe9, 0x7e, 0xc2, 0xd6, 0x5f, 0x70, 0x17, 0x94, 0x50, 0x2b, 0xee, 0x57, 0x75, 0x5c,
+	0x8e, 0xeb, 0xd9, 0xcc, 0x4c, 0x25, 0xd3, 0x74, 0x9b, 0x11, 0xba, 0x71, 0x23, 0x1e, 0xcf, 0xaf,
+	0x7f, 0x5b, 0x5c, 0xf6, 0x89, 0xd8, 0x6d, 0x37, 0x1b, 0x2e, 0x6b, 0x59, 0xfa, 0x11, 0x55, 0xff,
+	0x5d, 0xe5, 0xde, 0x07, 0x96, 0xe8, 0x84, 0x98, 0x4b, 0x03, 0xae, 0x1f, 0x23, 0xe5, 0xdf, 0xfc,
+	0x65, 0x12, 0x9c, 0x2f, 0x28, 0xc5, 0xbf, 0xed, 0x4a, 0x00, 0xa6, 0x9a, 0x28, 0x40, 0xd4, 0xc5,
+	0xbc, 0x3a, 0x71, 0x42, 0x30, 0xd2, 0x08, 0x90, 0x82, 0x32, 0x0f, 0x31, 0xf5, 0x50, 0x9c, 0xe4,
+	0xa9, 0x13, 0x0a, 0xd7, 0x0d, 0x01, 0x2f, 0x82, 0xff, 0x3f, 0x50, 0xbd, 0x74, 0x62, 0xb5, 0xea,
+	0xa4, 0xc4, 0x3e, 0xad, 0xcf, 0x76, 0x3a, 0x21, 0x86, 0xcf, 0x81, 0x33, 0xdd, 0xe1, 0xc8, 0x28,
+	0x97, 0xa4, 0xf2, 0x42, 0x2a, 0xbd, 0xd3, 0x63, 0xf5, 0xa9, 0x01, 0x6a, 0x5d, 0x33, 0x16, 0x91,
+	0xf8, 0x91, 0x08, 0x12, 0xfb, 0xea, 0xff, 0x4e, 0x08, 0x5a, 0x35, 0x8d, 0xf9, 0xb6, 0x0e, 0xa9,
+	0x93, 0x82, 0xd7, 0x40, 0x37, 0x51, 0x87, 0x0b, 0x14, 0x09, 0x47, 0x90, 0x16, 0xae, 0x4e, 0xc9,
+	0x91, 0x85, 0xa9, 0x6c, 0x3b, 0x16, 0xed, 0x90, 0x16, 0x86, 0xcf, 0x80, 0xee, 0xa9, 0x83, 0xa9,
+	0xa7, 0xf4, 0xcb, 0x52, 0xbf, 0x7b, 0x2d, 0x36, 0xa9, 0x27, 0xb5, 0xef, 0xf6, 0xde, 0xa1, 0x50,
+	0x5e, 0x0c, 0x5e, 0x05, 0x12, 0xe6, 0xf2, 0x21, 0xcf, 0x74, 0xe6, 0x26, 0x6d, 0x9c, 0x8a, 0x51,
+	0xf7, 0x38, 0x57, 0xc7, 0xdc, 0x7c, 0x9c, 0xec, 0x88, 0x2d, 0x4c, 0xbd, 0xb8, 0xc4, 0xda, 0x3c,
+	0xdd, 0xb7, 0x03, 0x6f, 0xb0, 0x31, 0xf8, 0x06, 0xe7, 0x36, 0xd7, 0xf8, 0xb1, 0x37, 0xd7, 0x0f,
+	0x86, 0xbe, 0x75, 0xfd, 0x59, 0xe9, 0x5b, 0xd7, 0x04, 0x73, 0xa1, 0x92, 0xa5, 0x4f, 0x5a, 0xb2,
+	0xbb, 0x56, 0x0e, 0x63, 0x1d, 0x59, 0x7f, 0xbd, 0xcb, 0xab, 0x12, 0xe6, 0x62, 0xfd, 0x67, 0xdb,
+	0x6b, 0xf5, 0xdb, 0x69, 0x30, 0x29, 0xe1, 0xc0, 0x2f, 0x0d, 0x50, 0x52, 0xcc, 0x07, 0x5e, 0x3d,
+	0x24, 0xcd, 0x7e, 0xca, 0x55, 0x6b, 0x1c, 0x55, 0x5d, 0xc5, 0x37, 0x6f, 0x7e, 0xfc, 0xe3, 0x1f,
+	0x8f, 0xc7, 0x57, 0xe1, 0x35, 0x2b, 0x8c, 0x98, 0xd7, 0x76, 0x05, 0x77, 0x89, 0xe4, 0x7b, 0xc3,
+	0x18, 0x22, 0xfc, 0xc9, 0x00, 0x33, 0x59, 0xce, 0x04, 0x6f, 0x0c, 0x0b, 0x3e, 0x90, 0xab, 0xd5,
+	0x9e, 0x1f, 0xd5, 0x4c, 0xe7, 0xbe, 0x2d, 0x73, 0x7f, 0x0b, 0xbe, 0x71, 0xf4, 0xdc, 0x53, 0x1e,
+	0xc3, 0xa5, 0x2b, 0xeb, 0x61, 0x6e, 0x3f, 0x3d, 0x82, 0xdf, 0x19, 0x60, 0x36, 0x47, 0x84, 0xe0,
+	0x91, 0x13, 0xcc, 0x32, 0xb4, 0xda, 0x0b, 0x23, 0xdb, 0x69, 0x64, 0x1b, 0x12, 0xd9, 0x8b, 0xf0,
+	0xd6, 0x31, 0x90, 0xed, 0xea, 0xa4, 0x63, 0x20, 0x39, 0xa2, 0x33, 0x1c, 0xc8, 0x60, 0xe2, 0x34,
+	0x1c, 0x48, 0x01, 0xa3, 0x3a, 0x0e, 0x90, 0x3c, 0x03, 0x83, 0x7f, 0x19, 0x60, 0x7e, 0x00, 0xdf,
+	0x81, 0xb7, 0x8e, 0x5a, 0xdd, 0x7e, 0xc6, 0x55, 0x5b, 0x3b, 0x96, 0xad, 0x06, 0xf5, 0x9e, 0x04,
+	0x65, 0xc3, 0xad, 0x63, 0x74, 0x07, 0x77, 0xfd, 0x0d, 0x18, 0xbe, 0xbf, 0x0d, 0x50, 0xc9, 0x33,
+	0x08, 0x38, 0xb4, 0xf8, 0x05, 0xf4, 0xab, 0x76, 0x73, 0x74, 0x43, 0x8d, 0x90, 0x48, 0x84, 0x2e,
+	0x44, 0x47, 0x47, 0xc8, 0xb5, 0xaf, 0xf4, 0x9d, 0xed, 0x87, 0x67, 0x3d, 0xec, 0x5b, 0x11, 0x8f,
+	0xe0, 0xf7, 0x06, 0xa8, 0xe4, 0x9f, 0xef, 0xe1, 0x90, 0x0b, 0xd6, 0xd0, 0x70, 0xc8, 0x45, 0x9b,
+	0xc2, 0xbc, 0x2d, 0x21, 0xbf, 0x04, 0xd7, 0x46, 0x78, 0x08, 0xf3, 0x9b, 0x65, 0xe3, 0xdd, 0xbd,
+	0xfd, 0xba, 0xf1, 0x64, 0xbf, 0x6e, 0xfc, 0xbe, 0x5f, 0x37, 0x3e, 0x3b, 0xa8, 0x8f, 0x3d, 0x39,
+	0xa8, 0x8f, 0xfd, 0x7c, 0x50, 0x1f, 0x7b, 0x7f, 0xad, 0x87, 0x46, 0x14, 0x06, 0xf8, 0xa8, 0x7f,
+	0x6e, 0x62, 0x7e, 0xd1, 0x2c, 0xc9, 0x3f, 0xb2, 0x9f, 0xfd, 0x27, 0x00, 0x00, 0xff, 0xff, 0xa4,
+	0xa6, 0x35, 0x46, 0x8c, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -598,6 +962,11 @@ type QueryClient interface {
 	AllowedAccounts(ctx context.Context, in *QueryAllowedAccountsRequest, opts ...grpc.CallOption) (*QueryAllowedAccountsResponse, error)
 	// TransferEligibility validates whether a specific account can be transferred.
 	TransferEligibility(ctx context.Context, in *QueryTransferEligibilityRequest, opts ...grpc.CallOption) (*QueryTransferEligibilityResponse, error)
+	// SimulateTransfer reports what a transfer would move, without executing it: the balances, the vesting
+	// type and the vesting schedule the recipient would receive.
+	SimulateTransfer(ctx context.Context, in *QuerySimulateTransferRequest, opts ...grpc.CallOption) (*QuerySimulateTransferResponse, error)
+	// PendingTransfers queries the transfers waiting for their recipients to accept them
+	PendingTransfers(ctx context.Context, in *QueryPendingTransfersRequest, opts ...grpc.CallOption) (*QueryPendingTransfersResponse, error)
 }
 
 type queryClient struct {
@@ -653,6 +1022,24 @@ func (c *queryClient) TransferEligibility(ctx context.Context, in *QueryTransfer
 	return out, nil
 }
 
+func (c *queryClient) SimulateTransfer(ctx context.Context, in *QuerySimulateTransferRequest, opts ...grpc.CallOption) (*QuerySimulateTransferResponse, error) {
+	out := new(QuerySimulateTransferResponse)
+	err := c.cc.Invoke(ctx, "/inference.genesistransfer.Query/SimulateTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingTransfers(ctx context.Context, in *QueryPendingTransfersRequest, opts ...grpc.CallOption) (*QueryPendingTransfersResponse, error) {
+	out := new(QueryPendingTransfersResponse)
+	err := c.cc.Invoke(ctx, "/inference.genesistransfer.Query/PendingTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -665,6 +1052,11 @@ type QueryServer interface {
 	AllowedAccounts(context.Context, *QueryAllowedAccountsRequest) (*QueryAllowedAccountsResponse, error)
 	// TransferEligibility validates whether a specific account can be transferred.
 	TransferEligibility(context.Context, *QueryTransferEligibilityRequest) (*QueryTransferEligibilityResponse, error)
+	// SimulateTransfer reports what a transfer would move, without executing it: the balances, the vesting
+	// type and the vesting schedule the recipient would receive.
+	SimulateTransfer(context.Context, *QuerySimulateTransferRequest) (*QuerySimulateTransferResponse, error)
+	// PendingTransfers queries the transfers waiting for their recipients to accept them
+	PendingTransfers(context.Context, *QueryPendingTransfersRequest) (*QueryPendingTransfersResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -686,6 +1078,12 @@ func (*UnimplementedQueryServer) AllowedAccounts(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) TransferEligibility(ctx context.Context, req *QueryTransferEligibilityRequest) (*QueryTransferEligibilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferEligibility not implemented")
 }
+func (*UnimplementedQueryServer) SimulateTransfer(ctx context.Context, req *QuerySimulateTransferRequest) (*QuerySimulateTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTransfer not implemented")
+}
+func (*UnimplementedQueryServer) PendingTransfers(ctx context.Context, req *QueryPendingTransfersRequest) (*QueryPendingTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTransfers not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -781,6 +1179,42 @@ func _Query_TransferEligibility_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.genesistransfer.Query/SimulateTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateTransfer(ctx, req.(*QuerySimulateTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.genesistransfer.Query/PendingTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingTransfers(ctx, req.(*QueryPendingTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.genesistransfer.Query",
@@ -806,6 +1240,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TransferEligibility",
 			Handler:    _Query_TransferEligibility_Handler,
 		},
+		{
+			MethodName: "SimulateTransfer",
+			Handler:    _Query_SimulateTransfer_Handler,
+		},
+		{
+			MethodName: "PendingTransfers",
+			Handler:    _Query_PendingTransfers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/genesistransfer/query.proto",
@@ -1171,20 +1613,310 @@ func (m *QueryTransferEligibilityResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySimulateTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QuerySimulateTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GenesisAddress) > 0 {
+		i -= len(m.GenesisAddress)
+		copy(dAtA[i:], m.GenesisAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GenesisAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Length != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecipientPeriods) > 0 {
+		for iNdEx := len(m.RecipientPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecipientPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.RecipientEndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecipientEndTime))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RecipientStartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecipientStartTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RecipientOriginalVesting) > 0 {
+		for iNdEx := len(m.RecipientOriginalVesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecipientOriginalVesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RecipientVestingType) > 0 {
+		i -= len(m.RecipientVestingType)
+		copy(dAtA[i:], m.RecipientVestingType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipientVestingType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.VestingType) > 0 {
+		i -= len(m.VestingType)
+		copy(dAtA[i:], m.VestingType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VestingType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Spendable) > 0 {
+		for iNdEx := len(m.Spendable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spendable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IsEligible {
+		i--
+		if m.IsEligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingTransfersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingTransfersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingTransfersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingTransfers) > 0 {
+		for iNdEx := len(m.PendingTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1322,18 +2054,143 @@ func (m *QueryTransferEligibilityResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QuerySimulateTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GenesisAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *VestingPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Length != 0 {
+		n += 1 + sovQuery(uint64(m.Length))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsEligible {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Spendable) > 0 {
+		for _, e := range m.Spendable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.VestingType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecipientVestingType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RecipientOriginalVesting) > 0 {
+		for _, e := range m.RecipientOriginalVesting {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RecipientStartTime != 0 {
+		n += 1 + sovQuery(uint64(m.RecipientStartTime))
+	}
+	if m.RecipientEndTime != 0 {
+		n += 1 + sovQuery(uint64(m.RecipientEndTime))
+	}
+	if len(m.RecipientPeriods) > 0 {
+		for _, e := range m.RecipientPeriods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingTransfersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingTransfers) > 0 {
+		for _, e := range m.PendingTransfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
@@ -1409,9 +2266,740 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsTransferred", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsTransferred = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransferRecord == nil {
+				m.TransferRecord = &TransferRecord{}
+			}
+			if err := m.TransferRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferRecords = append(m.TransferRecords, TransferRecord{})
+			if err := m.TransferRecords[len(m.TransferRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAccounts = append(m.AllowedAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictToList", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestrictToList = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferEligibilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferEligibilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferEligibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferEligibilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferEligibilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferEligibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEligible = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1421,25 +3009,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlreadyTransferred", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AlreadyTransferred = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1461,7 +3068,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTransferStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1484,10 +3091,10 @@ func (m *QueryTransferStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTransferStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTransferStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1522,6 +3129,38 @@ func (m *QueryTransferStatusRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.GenesisAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1543,7 +3182,7 @@ func (m *QueryTransferStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTransferStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *VestingPeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1566,17 +3205,17 @@ func (m *QueryTransferStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTransferStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingPeriod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTransferStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsTransferred", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			var v int
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1586,15 +3225,14 @@ func (m *QueryTransferStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Length |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsTransferred = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1621,10 +3259,8 @@ func (m *QueryTransferStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TransferRecord == nil {
-				m.TransferRecord = &TransferRecord{}
-			}
-			if err := m.TransferRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1649,7 +3285,7 @@ func (m *QueryTransferStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTransferHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1672,15 +3308,67 @@ func (m *QueryTransferHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTransferHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTransferHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEligible = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1707,66 +3395,112 @@ func (m *QueryTransferHistoryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spendable", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTransferHistoryResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Spendable = append(m.Spendable, types.Coin{})
+			if err := m.Spendable[len(m.Spendable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.VestingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientVestingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTransferHistoryResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTransferHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientVestingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientOriginalVesting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1793,14 +3527,52 @@ func (m *QueryTransferHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferRecords = append(m.TransferRecords, TransferRecord{})
-			if err := m.TransferRecords[len(m.TransferRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecipientOriginalVesting = append(m.RecipientOriginalVesting, types.Coin{})
+			if err := m.RecipientOriginalVesting[len(m.RecipientOriginalVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientStartTime", wireType)
+			}
+			m.RecipientStartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecipientStartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientEndTime", wireType)
+			}
+			m.RecipientEndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecipientEndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientPeriods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1827,10 +3599,8 @@ func (m *QueryTransferHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecipientPeriods = append(m.RecipientPeriods, VestingPeriod{})
+			if err := m.RecipientPeriods[len(m.RecipientPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1855,57 +3625,7 @@ func (m *QueryTransferHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowedAccountsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowedAccountsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowedAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllowedAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingTransfersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1928,15 +3648,15 @@ func (m *QueryAllowedAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowedAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingTransfersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowedAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAccounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1964,13 +3684,13 @@ func (m *QueryAllowedAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedAccounts = append(m.AllowedAccounts, string(dAtA[iNdEx:postIndex]))
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestrictToList", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1980,12 +3700,28 @@ func (m *QueryAllowedAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RestrictToList = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2007,7 +3743,7 @@ func (m *QueryAllowedAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTransferEligibilityRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingTransfersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2030,17 +3766,17 @@ func (m *QueryTransferEligibilityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTransferEligibilityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingTransfersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTransferEligibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTransfers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2050,99 +3786,31 @@ func (m *QueryTransferEligibilityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.PendingTransfers = append(m.PendingTransfers, PendingTransfer{})
+			if err := m.PendingTransfers[len(m.PendingTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTransferEligibilityResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTransferEligibilityResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTransferEligibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsEligible", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsEligible = bool(v != 0)
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2152,44 +3820,28 @@ func (m *QueryTransferEligibilityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlreadyTransferred", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.AlreadyTransferred = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
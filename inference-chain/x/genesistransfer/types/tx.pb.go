@@ -228,11 +228,282 @@ func (m *MsgTransferOwnershipResponse) GetTransferRecord() TransferRecord {
 	return TransferRecord{}
 }
 
+// OwnershipTransfer is one (genesis account, recipient) pair of a batch
+type OwnershipTransfer struct {
+	// genesis_address is the source genesis account to transfer from (must be a signer)
+	GenesisAddress string `protobuf:"bytes,1,opt,name=genesis_address,json=genesisAddress,proto3" json:"genesis_address,omitempty"`
+	// recipient_address is the destination account to transfer ownership to
+	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+}
+
+func (m *OwnershipTransfer) Reset()         { *m = OwnershipTransfer{} }
+func (m *OwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*OwnershipTransfer) ProtoMessage()    {}
+func (*OwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bfff6e5ac1eeaf, []int{4}
+}
+func (m *OwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnershipTransfer.Merge(m, src)
+}
+func (m *OwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnershipTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnershipTransfer proto.InternalMessageInfo
+
+func (m *OwnershipTransfer) GetGenesisAddress() string {
+	if m != nil {
+		return m.GenesisAddress
+	}
+	return ""
+}
+
+func (m *OwnershipTransfer) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+// MsgBatchTransferOwnership is the Msg/BatchTransferOwnership request type.
+type MsgBatchTransferOwnership struct {
+	// transfers are executed all or none, every genesis account must sign
+	Transfers []OwnershipTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
+	// acceptance_window_blocks, if set, makes each recipient accept its transfer within this many blocks
+	AcceptanceWindowBlocks uint64 `protobuf:"varint,2,opt,name=acceptance_window_blocks,json=acceptanceWindowBlocks,proto3" json:"acceptance_window_blocks,omitempty"`
+}
+
+func (m *MsgBatchTransferOwnership) Reset()         { *m = MsgBatchTransferOwnership{} }
+func (m *MsgBatchTransferOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchTransferOwnership) ProtoMessage()    {}
+func (*MsgBatchTransferOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bfff6e5ac1eeaf, []int{5}
+}
+func (m *MsgBatchTransferOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchTransferOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchTransferOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchTransferOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchTransferOwnership.Merge(m, src)
+}
+func (m *MsgBatchTransferOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchTransferOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchTransferOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchTransferOwnership proto.InternalMessageInfo
+
+func (m *MsgBatchTransferOwnership) GetTransfers() []OwnershipTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+func (m *MsgBatchTransferOwnership) GetAcceptanceWindowBlocks() uint64 {
+	if m != nil {
+		return m.AcceptanceWindowBlocks
+	}
+	return 0
+}
+
+// MsgBatchTransferOwnershipResponse defines the response structure for executing a
+// MsgBatchTransferOwnership message.
+type MsgBatchTransferOwnershipResponse struct {
+	// transfer_records of the executed transfers, empty if they wait for acceptance
+	TransferRecords []TransferRecord `protobuf:"bytes,1,rep,name=transfer_records,json=transferRecords,proto3" json:"transfer_records"`
+	// pending_transfers waiting for their recipients to accept them
+	PendingTransfers []PendingTransfer `protobuf:"bytes,2,rep,name=pending_transfers,json=pendingTransfers,proto3" json:"pending_transfers"`
+}
+
+func (m *MsgBatchTransferOwnershipResponse) Reset()         { *m = MsgBatchTransferOwnershipResponse{} }
+func (m *MsgBatchTransferOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchTransferOwnershipResponse) ProtoMessage()    {}
+func (*MsgBatchTransferOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bfff6e5ac1eeaf, []int{6}
+}
+func (m *MsgBatchTransferOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchTransferOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchTransferOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchTransferOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchTransferOwnershipResponse.Merge(m, src)
+}
+func (m *MsgBatchTransferOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchTransferOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchTransferOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchTransferOwnershipResponse proto.InternalMessageInfo
+
+func (m *MsgBatchTransferOwnershipResponse) GetTransferRecords() []TransferRecord {
+	if m != nil {
+		return m.TransferRecords
+	}
+	return nil
+}
+
+func (m *MsgBatchTransferOwnershipResponse) GetPendingTransfers() []PendingTransfer {
+	if m != nil {
+		return m.PendingTransfers
+	}
+	return nil
+}
+
+// MsgAcceptOwnershipTransfer is the Msg/AcceptOwnershipTransfer request type.
+type MsgAcceptOwnershipTransfer struct {
+	RecipientAddress string `protobuf:"bytes,1,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	// genesis_addresses of the pending transfers to accept, all or none
+	GenesisAddresses []string `protobuf:"bytes,2,rep,name=genesis_addresses,json=genesisAddresses,proto3" json:"genesis_addresses,omitempty"`
+}
+
+func (m *MsgAcceptOwnershipTransfer) Reset()         { *m = MsgAcceptOwnershipTransfer{} }
+func (m *MsgAcceptOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptOwnershipTransfer) ProtoMessage()    {}
+func (*MsgAcceptOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bfff6e5ac1eeaf, []int{7}
+}
+func (m *MsgAcceptOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptOwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptOwnershipTransfer.Merge(m, src)
+}
+func (m *MsgAcceptOwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptOwnershipTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptOwnershipTransfer proto.InternalMessageInfo
+
+func (m *MsgAcceptOwnershipTransfer) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+func (m *MsgAcceptOwnershipTransfer) GetGenesisAddresses() []string {
+	if m != nil {
+		return m.GenesisAddresses
+	}
+	return nil
+}
+
+// MsgAcceptOwnershipTransferResponse defines the response structure for executing a
+// MsgAcceptOwnershipTransfer message.
+type MsgAcceptOwnershipTransferResponse struct {
+	TransferRecords []TransferRecord `protobuf:"bytes,1,rep,name=transfer_records,json=transferRecords,proto3" json:"transfer_records"`
+}
+
+func (m *MsgAcceptOwnershipTransferResponse) Reset()         { *m = MsgAcceptOwnershipTransferResponse{} }
+func (m *MsgAcceptOwnershipTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptOwnershipTransferResponse) ProtoMessage()    {}
+func (*MsgAcceptOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bfff6e5ac1eeaf, []int{8}
+}
+func (m *MsgAcceptOwnershipTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptOwnershipTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptOwnershipTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptOwnershipTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptOwnershipTransferResponse.Merge(m, src)
+}
+func (m *MsgAcceptOwnershipTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptOwnershipTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptOwnershipTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptOwnershipTransferResponse proto.InternalMessageInfo
+
+func (m *MsgAcceptOwnershipTransferResponse) GetTransferRecords() []TransferRecord {
+	if m != nil {
+		return m.TransferRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "inference.genesistransfer.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "inference.genesistransfer.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgTransferOwnership)(nil), "inference.genesistransfer.MsgTransferOwnership")
 	proto.RegisterType((*MsgTransferOwnershipResponse)(nil), "inference.genesistransfer.MsgTransferOwnershipResponse")
+	proto.RegisterType((*OwnershipTransfer)(nil), "inference.genesistransfer.OwnershipTransfer")
+	proto.RegisterType((*MsgBatchTransferOwnership)(nil), "inference.genesistransfer.MsgBatchTransferOwnership")
+	proto.RegisterType((*MsgBatchTransferOwnershipResponse)(nil), "inference.genesistransfer.MsgBatchTransferOwnershipResponse")
+	proto.RegisterType((*MsgAcceptOwnershipTransfer)(nil), "inference.genesistransfer.MsgAcceptOwnershipTransfer")
+	proto.RegisterType((*MsgAcceptOwnershipTransferResponse)(nil), "inference.genesistransfer.MsgAcceptOwnershipTransferResponse")
 }
 
 func init() {
@@ -240,39 +511,54 @@ func init() {
 }
 
 var fileDescriptor_a3bfff6e5ac1eeaf = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x15, 0x51, 0x29, 0x57, 0xd4, 0x10, 0x2b, 0x52, 0x13, 0x0b, 0x99, 0xe2, 0x01, 0x4a,
-	0x10, 0x36, 0x04, 0x09, 0xa4, 0x22, 0x21, 0x35, 0x82, 0x81, 0x21, 0x02, 0x05, 0xba, 0x20, 0xa1,
-	0xc8, 0xb5, 0xaf, 0x97, 0x1b, 0x7c, 0x67, 0xdd, 0xbb, 0x40, 0x3b, 0x54, 0x42, 0x8c, 0x4c, 0xfc,
-	0x0c, 0xc6, 0x0c, 0xfc, 0x88, 0x6e, 0x54, 0x4c, 0x4c, 0x08, 0x25, 0x43, 0x06, 0x7e, 0x02, 0x0b,
-	0xb2, 0xef, 0x9c, 0x50, 0x87, 0x24, 0xb0, 0x24, 0xbe, 0xf7, 0xbe, 0xef, 0xbb, 0xf7, 0xbd, 0xcf,
-	0xc6, 0x2e, 0xe3, 0x87, 0x44, 0x12, 0x1e, 0x12, 0x9f, 0x12, 0x4e, 0x80, 0x81, 0x92, 0x01, 0x87,
-	0x43, 0x22, 0x7d, 0x75, 0xe4, 0x25, 0x52, 0x28, 0x61, 0x35, 0xa6, 0x18, 0xaf, 0x80, 0xb1, 0xab,
-	0x41, 0xcc, 0xb8, 0xf0, 0xb3, 0x5f, 0x8d, 0xb6, 0xb7, 0x42, 0x01, 0xb1, 0x00, 0x3f, 0x06, 0xea,
-	0xbf, 0xb9, 0x9b, 0xfe, 0x99, 0x46, 0x43, 0x37, 0x7a, 0xd9, 0xc9, 0xd7, 0x07, 0xd3, 0xaa, 0x51,
-	0x41, 0x85, 0xae, 0xa7, 0x4f, 0xa6, 0x7a, 0x7d, 0xf1, 0x6c, 0x49, 0x20, 0x83, 0x38, 0x67, 0xdf,
-	0x58, 0x8c, 0x33, 0x67, 0x0d, 0x74, 0xbf, 0x20, 0x5c, 0xe9, 0x00, 0xdd, 0x4f, 0xa2, 0x40, 0x91,
-	0xe7, 0x99, 0x84, 0x75, 0x1f, 0x97, 0x83, 0x81, 0xea, 0x0b, 0xc9, 0xd4, 0x71, 0x1d, 0x6d, 0xa3,
-	0x9d, 0x72, 0xbb, 0xfe, 0xf5, 0xf3, 0xed, 0x9a, 0x99, 0x6f, 0x2f, 0x8a, 0x24, 0x01, 0x78, 0xa1,
-	0x24, 0xe3, 0xb4, 0x3b, 0x83, 0x5a, 0x8f, 0xf1, 0xba, 0x1e, 0xa2, 0xbe, 0xb6, 0x8d, 0x76, 0x36,
-	0x5a, 0xd7, 0xbc, 0x85, 0x5b, 0xf2, 0xf4, 0x55, 0xed, 0xf2, 0xe9, 0xf7, 0xab, 0xa5, 0x4f, 0x93,
-	0x61, 0x13, 0x75, 0x0d, 0x77, 0xf7, 0xd1, 0xfb, 0xc9, 0xb0, 0x39, 0x53, 0xfd, 0x30, 0x19, 0x36,
-	0x6f, 0xcd, 0xdc, 0x1c, 0xcd, 0xf9, 0x29, 0x4c, 0xef, 0x36, 0xf0, 0x56, 0xa1, 0xd4, 0x25, 0x90,
-	0x08, 0x0e, 0xc4, 0xfd, 0x89, 0x70, 0xad, 0x03, 0xf4, 0xa5, 0xa1, 0x3e, 0x7b, 0xcb, 0x89, 0x84,
-	0x3e, 0x4b, 0xac, 0x3d, 0x5c, 0x31, 0xb2, 0xbd, 0x40, 0xbb, 0x5b, 0xe9, 0x7b, 0xd3, 0x10, 0x4c,
-	0xd5, 0x7a, 0x82, 0xab, 0x92, 0x84, 0x2c, 0x61, 0x84, 0xab, 0xa9, 0xc8, 0xda, 0x0a, 0x91, 0xcb,
-	0x53, 0x8a, 0xa9, 0xef, 0x3e, 0x4d, 0xdd, 0x17, 0x87, 0x49, 0x77, 0x70, 0x67, 0xc5, 0x0e, 0xe6,
-	0x4c, 0xb9, 0x27, 0xf8, 0xca, 0xdf, 0xea, 0xf9, 0x36, 0xac, 0xd7, 0xb8, 0x92, 0x0b, 0xf4, 0x24,
-	0x09, 0x85, 0x8c, 0x32, 0xd3, 0x1b, 0xad, 0x9b, 0x4b, 0x72, 0xcb, 0xe5, 0xba, 0x19, 0xe1, 0xcf,
-	0xfc, 0x36, 0xd5, 0xb9, 0x56, 0xeb, 0x17, 0xc2, 0x17, 0x3a, 0x40, 0x2d, 0x8e, 0x2f, 0x9d, 0x7b,
-	0xbb, 0x9a, 0x4b, 0xd4, 0x0b, 0xc1, 0xd9, 0xad, 0x7f, 0xc7, 0x4e, 0x6d, 0x9d, 0xe0, 0xea, 0x7c,
-	0xc0, 0xfe, 0x72, 0xa1, 0x39, 0x82, 0xfd, 0xe0, 0x3f, 0x09, 0xf9, 0xf5, 0xf6, 0xc5, 0x77, 0xe9,
-	0x36, 0xda, 0xfb, 0xa7, 0x23, 0x07, 0x9d, 0x8d, 0x1c, 0xf4, 0x63, 0xe4, 0xa0, 0x8f, 0x63, 0xa7,
-	0x74, 0x36, 0x76, 0x4a, 0xdf, 0xc6, 0x4e, 0xe9, 0xd5, 0x43, 0xca, 0x54, 0x7f, 0x70, 0xe0, 0x85,
-	0x22, 0xf6, 0x13, 0x29, 0xa2, 0x41, 0xa8, 0x20, 0x64, 0x59, 0xb0, 0xcb, 0x22, 0x56, 0xc7, 0x09,
-	0x81, 0x83, 0xf5, 0xec, 0xab, 0xbd, 0xf7, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x50, 0x51, 0xf7,
-	0xa4, 0x04, 0x00, 0x00,
+	// 746 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x6b, 0x13, 0x5d,
+	0x14, 0xce, 0x6d, 0xfb, 0x16, 0x72, 0xfb, 0xd2, 0x24, 0x43, 0x68, 0x93, 0x41, 0x62, 0x3b, 0x0b,
+	0xad, 0xa9, 0x66, 0x34, 0xda, 0x2a, 0xf1, 0x03, 0x1a, 0x14, 0x74, 0x11, 0xd4, 0x68, 0x11, 0x04,
+	0x19, 0x26, 0x33, 0xb7, 0x93, 0x41, 0x73, 0xef, 0x70, 0xef, 0xad, 0x6d, 0x17, 0x05, 0x11, 0xdc,
+	0x88, 0xa0, 0x3f, 0xc0, 0x1f, 0xe0, 0xb2, 0xa0, 0x3f, 0xa2, 0x3b, 0x8b, 0x2b, 0x57, 0x22, 0xcd,
+	0xa2, 0x82, 0x7b, 0xd7, 0x32, 0x9f, 0x69, 0xe6, 0x2b, 0xb6, 0x0b, 0x71, 0xd3, 0x66, 0xce, 0x39,
+	0xcf, 0xb9, 0xe7, 0x79, 0xce, 0x73, 0x27, 0x81, 0x92, 0x89, 0xd7, 0x10, 0x45, 0x58, 0x43, 0xb2,
+	0x81, 0x30, 0x62, 0x26, 0xe3, 0x54, 0xc5, 0x6c, 0x0d, 0x51, 0x99, 0x6f, 0xd6, 0x2c, 0x4a, 0x38,
+	0x11, 0xca, 0x41, 0x4d, 0x2d, 0x54, 0x23, 0x16, 0xd4, 0x9e, 0x89, 0x89, 0xec, 0xfc, 0x75, 0xab,
+	0xc5, 0x59, 0x8d, 0xb0, 0x1e, 0x61, 0x72, 0x8f, 0x19, 0xf2, 0xf3, 0x0b, 0xf6, 0x3f, 0x2f, 0x51,
+	0x76, 0x13, 0x8a, 0xf3, 0x24, 0xbb, 0x0f, 0x5e, 0xaa, 0x68, 0x10, 0x83, 0xb8, 0x71, 0xfb, 0x93,
+	0x17, 0x3d, 0x95, 0x3c, 0x9b, 0xa5, 0x52, 0xb5, 0xe7, 0xa3, 0x4f, 0x27, 0xd7, 0x79, 0xcf, 0x6e,
+	0xa1, 0xf4, 0x19, 0xc0, 0x5c, 0x8b, 0x19, 0xab, 0x96, 0xae, 0x72, 0x74, 0xcf, 0x69, 0x21, 0x2c,
+	0xc3, 0xac, 0xba, 0xce, 0xbb, 0x84, 0x9a, 0x7c, 0xab, 0x04, 0xe6, 0xc0, 0x42, 0xb6, 0x59, 0xfa,
+	0xf2, 0xe9, 0x5c, 0xd1, 0x9b, 0x6f, 0x45, 0xd7, 0x29, 0x62, 0xec, 0x01, 0xa7, 0x26, 0x36, 0xda,
+	0x83, 0x52, 0xe1, 0x26, 0x9c, 0x74, 0x87, 0x28, 0x8d, 0xcd, 0x81, 0x85, 0xa9, 0xfa, 0x7c, 0x2d,
+	0x51, 0xa5, 0x9a, 0x7b, 0x54, 0x33, 0xbb, 0xfb, 0xed, 0x64, 0xe6, 0xc3, 0xc1, 0x4e, 0x15, 0xb4,
+	0x3d, 0x6c, 0xe3, 0xc6, 0xcb, 0x83, 0x9d, 0xea, 0xa0, 0xeb, 0xeb, 0x83, 0x9d, 0xea, 0xe2, 0x80,
+	0xcd, 0x66, 0x84, 0x4f, 0x68, 0x7a, 0xa9, 0x0c, 0x67, 0x43, 0xa1, 0x36, 0x62, 0x16, 0xc1, 0x0c,
+	0x49, 0x3f, 0x01, 0x2c, 0xb6, 0x98, 0xf1, 0xd0, 0x83, 0xde, 0xdd, 0xc0, 0x88, 0xb2, 0xae, 0x69,
+	0x09, 0x2b, 0x30, 0xe7, 0xb5, 0x55, 0x54, 0x97, 0xdd, 0x48, 0xde, 0xd3, 0x1e, 0xc0, 0x8b, 0x0a,
+	0xb7, 0x60, 0x81, 0x22, 0xcd, 0xb4, 0x4c, 0x84, 0x79, 0xd0, 0x64, 0x6c, 0x44, 0x93, 0x7c, 0x00,
+	0xf1, 0xe2, 0x8d, 0x3b, 0x36, 0xfb, 0xf0, 0x30, 0xb6, 0x06, 0xe7, 0x47, 0x68, 0x10, 0x21, 0x25,
+	0x6d, 0xc3, 0x13, 0x71, 0x71, 0x5f, 0x0d, 0xe1, 0x09, 0xcc, 0xf9, 0x0d, 0x14, 0x8a, 0x34, 0x42,
+	0x75, 0x87, 0xf4, 0x54, 0xfd, 0x4c, 0xca, 0xde, 0xfc, 0x76, 0x6d, 0x07, 0x70, 0x78, 0x7f, 0xd3,
+	0x7c, 0x28, 0x25, 0x7d, 0x04, 0xb0, 0x10, 0x1c, 0xea, 0xc3, 0xfe, 0x21, 0xa5, 0x8b, 0x71, 0x4a,
+	0x4b, 0xbf, 0x00, 0x2c, 0xb7, 0x98, 0xd1, 0x54, 0xb9, 0xd6, 0x8d, 0xfa, 0x64, 0x15, 0x66, 0x7d,
+	0x96, 0xf6, 0xdc, 0xe3, 0x0b, 0x53, 0xf5, 0xb3, 0x29, 0x62, 0x45, 0xe8, 0x1f, 0xd6, 0x6b, 0xd0,
+	0x49, 0xb8, 0x02, 0x4b, 0xaa, 0xa6, 0x21, 0x8b, 0xab, 0x58, 0x43, 0xca, 0x86, 0x89, 0x75, 0xb2,
+	0xa1, 0x74, 0x9e, 0x11, 0xed, 0xa9, 0x4b, 0x6c, 0xa2, 0x3d, 0x33, 0xc8, 0x3f, 0x72, 0xd2, 0x4d,
+	0x27, 0xdb, 0xb8, 0xed, 0x5c, 0x96, 0xa0, 0x93, 0x6d, 0x94, 0xa5, 0x11, 0x46, 0x89, 0xa7, 0x26,
+	0xfd, 0x00, 0x70, 0x3e, 0x31, 0x1b, 0x78, 0x46, 0x81, 0xf9, 0x90, 0x67, 0x7c, 0x1d, 0x8e, 0x67,
+	0x9a, 0xdc, 0xb0, 0x69, 0x98, 0xd0, 0x81, 0x05, 0x0b, 0x61, 0xdd, 0xc4, 0x86, 0x32, 0x50, 0x7a,
+	0xcc, 0x39, 0xa1, 0x9a, 0xf6, 0x3a, 0x71, 0x31, 0x71, 0x3a, 0xe7, 0xad, 0xe1, 0x1c, 0x93, 0xfa,
+	0x00, 0x8a, 0x2d, 0x66, 0xac, 0x38, 0x92, 0x46, 0x2d, 0x1a, 0xeb, 0x2f, 0x70, 0x54, 0x7f, 0x09,
+	0x8b, 0xb0, 0x10, 0x32, 0x17, 0x72, 0x99, 0x64, 0xdb, 0xf9, 0x61, 0x47, 0x23, 0xd6, 0xb8, 0x6f,
+	0xef, 0x31, 0x7a, 0xac, 0xbd, 0xcf, 0xe5, 0x11, 0xfb, 0x4c, 0xa0, 0x21, 0xbd, 0x02, 0x50, 0x4a,
+	0x4e, 0xff, 0xb5, 0x8d, 0xd6, 0xdf, 0x4f, 0xc0, 0xf1, 0x16, 0x33, 0x04, 0x0c, 0xff, 0x1f, 0xfa,
+	0x96, 0x49, 0x5b, 0x67, 0xe8, 0x05, 0x2e, 0xd6, 0xff, 0xbc, 0x36, 0x20, 0xb6, 0x0d, 0x0b, 0xd1,
+	0x0b, 0x2c, 0xa7, 0x37, 0x8a, 0x00, 0xc4, 0xcb, 0x47, 0x04, 0x04, 0xc7, 0xbf, 0x01, 0x70, 0x26,
+	0xe1, 0x2d, 0x72, 0x29, 0xbd, 0x67, 0x3c, 0x4a, 0xbc, 0x76, 0x1c, 0x54, 0x30, 0xce, 0x5b, 0x00,
+	0x67, 0x93, 0x0c, 0xbf, 0x94, 0xde, 0x39, 0x01, 0x26, 0x5e, 0x3f, 0x16, 0xcc, 0x9f, 0x48, 0xfc,
+	0xef, 0x85, 0xed, 0x97, 0xe6, 0xea, 0xee, 0x7e, 0x05, 0xec, 0xed, 0x57, 0xc0, 0xf7, 0xfd, 0x0a,
+	0x78, 0xd7, 0xaf, 0x64, 0xf6, 0xfa, 0x95, 0xcc, 0xd7, 0x7e, 0x25, 0xf3, 0xf8, 0xaa, 0x61, 0xf2,
+	0xee, 0x7a, 0xa7, 0xa6, 0x91, 0x9e, 0x6c, 0x51, 0xa2, 0xaf, 0x6b, 0x9c, 0x69, 0xa6, 0x73, 0x11,
+	0xd2, 0xae, 0x04, 0xdf, 0xb2, 0x10, 0xeb, 0x4c, 0x3a, 0x3f, 0x6f, 0x2e, 0xfe, 0x0e, 0x00, 0x00,
+	0xff, 0xff, 0x98, 0x3b, 0xa0, 0xb8, 0xcd, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -293,6 +579,11 @@ type MsgClient interface {
 	// TransferOwnership transfers complete ownership of a genesis account including
 	// all liquid balances and vesting schedules to the recipient account
 	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
+	// BatchTransferOwnership transfers many genesis accounts at once, all or none of them. With an
+	// acceptance window the transfers wait for their recipients to accept them instead.
+	BatchTransferOwnership(ctx context.Context, in *MsgBatchTransferOwnership, opts ...grpc.CallOption) (*MsgBatchTransferOwnershipResponse, error)
+	// AcceptOwnershipTransfer executes pending transfers to the signing recipient
+	AcceptOwnershipTransfer(ctx context.Context, in *MsgAcceptOwnershipTransfer, opts ...grpc.CallOption) (*MsgAcceptOwnershipTransferResponse, error)
 }
 
 type msgClient struct {
@@ -321,6 +612,24 @@ func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwners
 	return out, nil
 }
 
+func (c *msgClient) BatchTransferOwnership(ctx context.Context, in *MsgBatchTransferOwnership, opts ...grpc.CallOption) (*MsgBatchTransferOwnershipResponse, error) {
+	out := new(MsgBatchTransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/inference.genesistransfer.Msg/BatchTransferOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptOwnershipTransfer(ctx context.Context, in *MsgAcceptOwnershipTransfer, opts ...grpc.CallOption) (*MsgAcceptOwnershipTransferResponse, error) {
+	out := new(MsgAcceptOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/inference.genesistransfer.Msg/AcceptOwnershipTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -329,6 +638,11 @@ type MsgServer interface {
 	// TransferOwnership transfers complete ownership of a genesis account including
 	// all liquid balances and vesting schedules to the recipient account
 	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
+	// BatchTransferOwnership transfers many genesis accounts at once, all or none of them. With an
+	// acceptance window the transfers wait for their recipients to accept them instead.
+	BatchTransferOwnership(context.Context, *MsgBatchTransferOwnership) (*MsgBatchTransferOwnershipResponse, error)
+	// AcceptOwnershipTransfer executes pending transfers to the signing recipient
+	AcceptOwnershipTransfer(context.Context, *MsgAcceptOwnershipTransfer) (*MsgAcceptOwnershipTransferResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -341,6 +655,12 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) TransferOwnership(ctx context.Context, req *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
 }
+func (*UnimplementedMsgServer) BatchTransferOwnership(ctx context.Context, req *MsgBatchTransferOwnership) (*MsgBatchTransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTransferOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AcceptOwnershipTransfer(ctx context.Context, req *MsgAcceptOwnershipTransfer) (*MsgAcceptOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwnershipTransfer not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -382,6 +702,42 @@ func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BatchTransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchTransferOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchTransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.genesistransfer.Msg/BatchTransferOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchTransferOwnership(ctx, req.(*MsgBatchTransferOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptOwnershipTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.genesistransfer.Msg/AcceptOwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptOwnershipTransfer(ctx, req.(*MsgAcceptOwnershipTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.genesistransfer.Msg",
@@ -395,6 +751,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TransferOwnership",
 			Handler:    _Msg_TransferOwnership_Handler,
 		},
+		{
+			MethodName: "BatchTransferOwnership",
+			Handler:    _Msg_BatchTransferOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptOwnershipTransfer",
+			Handler:    _Msg_AcceptOwnershipTransfer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/genesistransfer/tx.proto",
@@ -533,76 +897,734 @@ func (m *MsgTransferOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *OwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *OwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTransferOwnership) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.GenesisAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.RecipientAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.GenesisAddress) > 0 {
+		i -= len(m.GenesisAddress)
+		copy(dAtA[i:], m.GenesisAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchTransferOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchTransferOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchTransferOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AcceptanceWindowBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AcceptanceWindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchTransferOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchTransferOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchTransferOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingTransfers) > 0 {
+		for iNdEx := len(m.PendingTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TransferRecords) > 0 {
+		for iNdEx := len(m.TransferRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GenesisAddresses) > 0 {
+		for iNdEx := len(m.GenesisAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GenesisAddresses[iNdEx])
+			copy(dAtA[i:], m.GenesisAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransferRecords) > 0 {
+		for iNdEx := len(m.TransferRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GenesisAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TransferRecord.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *OwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GenesisAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchTransferOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.AcceptanceWindowBlocks != 0 {
+		n += 1 + sovTx(uint64(m.AcceptanceWindowBlocks))
+	}
+	return n
+}
+
+func (m *MsgBatchTransferOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TransferRecords) > 0 {
+		for _, e := range m.TransferRecords {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PendingTransfers) > 0 {
+		for _, e := range m.PendingTransfers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAcceptOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.GenesisAddresses) > 0 {
+		for _, s := range m.GenesisAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAcceptOwnershipTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TransferRecords) > 0 {
+		for _, e := range m.TransferRecords {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgTransferOwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TransferRecord.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *OwnershipTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -625,15 +1647,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: OwnershipTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -661,11 +1683,93 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.GenesisAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchTransferOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchTransferOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -692,10 +1796,30 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Transfers = append(m.Transfers, OwnershipTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptanceWindowBlocks", wireType)
+			}
+			m.AcceptanceWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptanceWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -717,7 +1841,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -740,12 +1864,80 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchTransferOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferRecords = append(m.TransferRecords, TransferRecord{})
+			if err := m.TransferRecords[len(m.TransferRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTransfers = append(m.PendingTransfers, PendingTransfer{})
+			if err := m.PendingTransfers[len(m.PendingTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -767,7 +1959,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptOwnershipTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -790,15 +1982,15 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptOwnershipTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -826,11 +2018,11 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisAddress = string(dAtA[iNdEx:postIndex])
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -858,7 +2050,7 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			m.GenesisAddresses = append(m.GenesisAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -881,7 +2073,7 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -904,15 +2096,15 @@ func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptOwnershipTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -939,7 +2131,8 @@ func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TransferRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TransferRecords = append(m.TransferRecords, TransferRecord{})
+			if err := m.TransferRecords[len(m.TransferRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
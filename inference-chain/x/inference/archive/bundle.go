@@ -0,0 +1,288 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// BundleHeader describes the records pruned during one epoch. PrevHash links it to the bundle of the
+// previous pruning epoch, so a gap or a rewritten bundle breaks the chain.
+type BundleHeader struct {
+	PruningEpoch int64  `json:"pruning_epoch"`
+	FirstHeight  int64  `json:"first_height"`
+	LastHeight   int64  `json:"last_height"`
+	RecordCount  int    `json:"record_count"`
+	RecordsRoot  []byte `json:"records_root"`
+	PrevHash     []byte `json:"prev_hash"`
+}
+
+func (h BundleHeader) Hash() []byte {
+	bz, err := json.Marshal(h)
+	if err != nil {
+		panic(err)
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+type Bundle struct {
+	Header  BundleHeader           `json:"header"`
+	Hash    []byte                 `json:"hash"`
+	Records []types.ArchivedRecord `json:"records"`
+}
+
+// NewBundle builds the bundle of the records pruned during pruningEpoch, chained to prev if there is one
+func NewBundle(pruningEpoch int64, records []types.ArchivedRecord, prev *Bundle) Bundle {
+	header := BundleHeader{
+		PruningEpoch: pruningEpoch,
+		RecordCount:  len(records),
+		RecordsRoot:  RecordsRoot(records),
+	}
+	if len(records) > 0 {
+		header.FirstHeight = records[0].PruningHeight
+		header.LastHeight = records[len(records)-1].PruningHeight
+	}
+	if prev != nil {
+		header.PrevHash = prev.Hash
+	}
+	return Bundle{Header: header, Hash: header.Hash(), Records: records}
+}
+
+// RecordsRoot is the merkle root over the JSON encoding of the records, in order
+func RecordsRoot(records []types.ArchivedRecord) []byte {
+	leaves := make([][]byte, len(records))
+	for i, record := range records {
+		bz, err := json.Marshal(record)
+		if err != nil {
+			panic(err)
+		}
+		leaves[i] = bz
+	}
+	return merkle.HashFromByteSlices(leaves)
+}
+
+// Verify checks the bundle against its own header and, if prev is set, that it follows prev in the chain
+func (b Bundle) Verify(prev *Bundle) error {
+	if !bytes.Equal(b.Hash, b.Header.Hash()) {
+		return fmt.Errorf("bundle %d: hash does not match header", b.Header.PruningEpoch)
+	}
+	if b.Header.RecordCount != len(b.Records) {
+		return fmt.Errorf("bundle %d: header has %d records, bundle has %d", b.Header.PruningEpoch, b.Header.RecordCount, len(b.Records))
+	}
+	if !bytes.Equal(b.Header.RecordsRoot, RecordsRoot(b.Records)) {
+		return fmt.Errorf("bundle %d: records do not match records root", b.Header.PruningEpoch)
+	}
+	for _, record := range b.Records {
+		if record.PruningEpoch != b.Header.PruningEpoch {
+			return fmt.Errorf("bundle %d: record %x was pruned in epoch %d", b.Header.PruningEpoch, record.Key, record.PruningEpoch)
+		}
+		if record.PruningHeight < b.Header.FirstHeight || record.PruningHeight > b.Header.LastHeight {
+			return fmt.Errorf("bundle %d: record %x pruned at height %d outside [%d, %d]", b.Header.PruningEpoch, record.Key, record.PruningHeight, b.Header.FirstHeight, b.Header.LastHeight)
+		}
+	}
+	if prev == nil {
+		return nil
+	}
+	if b.Header.PruningEpoch <= prev.Header.PruningEpoch {
+		return fmt.Errorf("bundle %d follows bundle %d", b.Header.PruningEpoch, prev.Header.PruningEpoch)
+	}
+	if !bytes.Equal(b.Header.PrevHash, prev.Hash) {
+		return fmt.Errorf("bundle %d: previous hash does not match bundle %d", b.Header.PruningEpoch, prev.Header.PruningEpoch)
+	}
+	return nil
+}
+
+// VerifyChain verifies every bundle and the links between them. bundles must be in pruning epoch order and
+// start at the first bundle.
+func VerifyChain(bundles []Bundle) error {
+	if len(bundles) > 0 && len(bundles[0].Header.PrevHash) > 0 {
+		return fmt.Errorf("bundle %d links to a previous bundle that is missing", bundles[0].Header.PruningEpoch)
+	}
+	var prev *Bundle
+	for i := range bundles {
+		if err := bundles[i].Verify(prev); err != nil {
+			return err
+		}
+		prev = &bundles[i]
+	}
+	return nil
+}
+
+// ReadSinkRecords reads the records a FileSink wrote, dropping the duplicates left by replayed blocks
+func ReadSinkRecords(path string) ([]types.ArchivedRecord, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	seen := make(map[string]bool)
+	var records []types.ArchivedRecord
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 1024*1024), 64*1024*1024)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var record types.ArchivedRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		id := fmt.Sprintf("%d/%x", record.PruningHeight, record.Key)
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		if records[i].PruningHeight != records[j].PruningHeight {
+			return records[i].PruningHeight < records[j].PruningHeight
+		}
+		return bytes.Compare(records[i].Key, records[j].Key) < 0
+	})
+	return records, nil
+}
+
+// BundleFilePath is the file a bundle for pruningEpoch is written to
+func BundleFilePath(dir string, pruningEpoch int64) string {
+	return filepath.Join(dir, fmt.Sprintf("bundle-%010d.json.gz", pruningEpoch))
+}
+
+func WriteBundle(dir string, bundle Bundle) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(bundle); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	// Write through a temporary file so an interrupted run never leaves a truncated bundle in the chain
+	path := BundleFilePath(dir, bundle.Header.PruningEpoch)
+	if err := os.WriteFile(path+".tmp", buf.Bytes(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+func ReadBundle(path string) (Bundle, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Bundle{}, err
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return Bundle{}, fmt.Errorf("%s: %w", path, err)
+	}
+	defer zr.Close()
+	var bundle Bundle
+	if err := json.NewDecoder(zr).Decode(&bundle); err != nil {
+		return Bundle{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return bundle, nil
+}
+
+// ReadBundles reads all bundles in dir in pruning epoch order
+func ReadBundles(dir string) ([]Bundle, error) {
+	epochs, err := listEpochs(dir, "bundle-", ".json.gz")
+	if err != nil {
+		return nil, err
+	}
+	bundles := make([]Bundle, 0, len(epochs))
+	for _, epoch := range epochs {
+		bundle, err := ReadBundle(BundleFilePath(dir, epoch))
+		if err != nil {
+			return nil, err
+		}
+		bundles = append(bundles, bundle)
+	}
+	return bundles, nil
+}
+
+// BundleSink bundles the pruning epochs in sinkDir that come after the last bundle in bundleDir. The latest
+// pruning epoch in the sink may still be receiving records and is left out unless includeLatest is set.
+func BundleSink(sinkDir, bundleDir string, includeLatest bool) ([]Bundle, error) {
+	sinkEpochs, err := listEpochs(sinkDir, "pruned-", ".jsonl")
+	if err != nil {
+		return nil, err
+	}
+	if len(sinkEpochs) > 0 && !includeLatest {
+		sinkEpochs = sinkEpochs[:len(sinkEpochs)-1]
+	}
+
+	existing, err := ReadBundles(bundleDir)
+	if err != nil {
+		return nil, err
+	}
+	var prev *Bundle
+	if len(existing) > 0 {
+		prev = &existing[len(existing)-1]
+	}
+
+	var created []Bundle
+	for _, epoch := range sinkEpochs {
+		if prev != nil && epoch <= prev.Header.PruningEpoch {
+			if _, err := os.Stat(BundleFilePath(bundleDir, epoch)); err == nil {
+				continue
+			}
+			return nil, fmt.Errorf("pruning epoch %d is older than the last bundle %d and cannot be chained", epoch, prev.Header.PruningEpoch)
+		}
+		records, err := ReadSinkRecords(SinkFilePath(sinkDir, epoch))
+		if err != nil {
+			return nil, err
+		}
+		bundle := NewBundle(epoch, records, prev)
+		if err := WriteBundle(bundleDir, bundle); err != nil {
+			return nil, err
+		}
+		created = append(created, bundle)
+		prev = &created[len(created)-1]
+	}
+	return created, nil
+}
+
+func listEpochs(dir string, prefix string, suffix string) ([]int64, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var epochs []int64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, suffix) {
+			continue
+		}
+		epoch, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, prefix), suffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		epochs = append(epochs, epoch)
+	}
+	sort.Slice(epochs, func(i, j int) bool { return epochs[i] < epochs[j] })
+	return epochs, nil
+}
@@ -0,0 +1,112 @@
+package archive_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/x/inference/archive"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func archiveRecords(t *testing.T, sink *archive.FileSink, pruningEpoch int64, height int64, keys ...string) {
+	for _, key := range keys {
+		require.NoError(t, sink.Archive(context.Background(), types.ArchivedRecord{
+			Kind:          types.ArchiveKindInference,
+			Epoch:         pruningEpoch - 2,
+			PruningEpoch:  pruningEpoch,
+			PruningHeight: height,
+			AppHash:       []byte{byte(height)},
+			Key:           []byte(key),
+			Value:         []byte("value-" + key),
+		}))
+	}
+}
+
+func TestBundleSink(t *testing.T) {
+	sinkDir := t.TempDir()
+	bundleDir := t.TempDir()
+	sink, err := archive.NewFileSink(sinkDir)
+	require.NoError(t, err)
+
+	archiveRecords(t, sink, 3, 30, "b", "a")
+	// A replayed block writes the same records again
+	archiveRecords(t, sink, 3, 30, "a")
+	archiveRecords(t, sink, 3, 31, "c")
+	archiveRecords(t, sink, 4, 40, "d")
+
+	// Epoch 4 is the latest and may still be pruning
+	bundles, err := archive.BundleSink(sinkDir, bundleDir, false)
+	require.NoError(t, err)
+	require.Len(t, bundles, 1)
+	require.Equal(t, int64(3), bundles[0].Header.PruningEpoch)
+	require.Equal(t, 3, bundles[0].Header.RecordCount)
+	require.Equal(t, int64(30), bundles[0].Header.FirstHeight)
+	require.Equal(t, int64(31), bundles[0].Header.LastHeight)
+	require.Equal(t, []byte("a"), bundles[0].Records[0].Key)
+	require.Empty(t, bundles[0].Header.PrevHash)
+
+	archiveRecords(t, sink, 5, 50, "e")
+	bundles, err = archive.BundleSink(sinkDir, bundleDir, false)
+	require.NoError(t, err)
+	require.Len(t, bundles, 1)
+	require.Equal(t, int64(4), bundles[0].Header.PruningEpoch)
+
+	bundles, err = archive.BundleSink(sinkDir, bundleDir, true)
+	require.NoError(t, err)
+	require.Len(t, bundles, 1)
+	require.Equal(t, int64(5), bundles[0].Header.PruningEpoch)
+
+	all, err := archive.ReadBundles(bundleDir)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.NoError(t, archive.VerifyChain(all))
+	require.Equal(t, all[0].Hash, all[1].Header.PrevHash)
+	require.Equal(t, all[1].Hash, all[2].Header.PrevHash)
+
+	// Nothing new to bundle
+	bundles, err = archive.BundleSink(sinkDir, bundleDir, true)
+	require.NoError(t, err)
+	require.Empty(t, bundles)
+}
+
+func TestVerifyChain_DetectsTampering(t *testing.T) {
+	sinkDir := t.TempDir()
+	bundleDir := t.TempDir()
+	sink, err := archive.NewFileSink(sinkDir)
+	require.NoError(t, err)
+	archiveRecords(t, sink, 3, 30, "a", "b")
+	archiveRecords(t, sink, 4, 40, "c")
+	_, err = archive.BundleSink(sinkDir, bundleDir, true)
+	require.NoError(t, err)
+
+	bundles, err := archive.ReadBundles(bundleDir)
+	require.NoError(t, err)
+	require.NoError(t, archive.VerifyChain(bundles))
+
+	t.Run("changed record", func(t *testing.T) {
+		tampered := append([]archive.Bundle{}, bundles...)
+		records := append([]types.ArchivedRecord{}, tampered[0].Records...)
+		records[1].Value = []byte("forged")
+		tampered[0].Records = records
+		require.ErrorContains(t, archive.VerifyChain(tampered), "records root")
+	})
+
+	t.Run("rebuilt bundle", func(t *testing.T) {
+		// Rebuilding a bundle with other records gives it a new hash, which breaks the link from the next one
+		tampered := append([]archive.Bundle{}, bundles...)
+		tampered[0] = archive.NewBundle(3, bundles[0].Records[:1], nil)
+		require.ErrorContains(t, archive.VerifyChain(tampered), "previous hash")
+	})
+
+	t.Run("missing bundle", func(t *testing.T) {
+		require.NoError(t, os.Remove(filepath.Join(bundleDir, "bundle-0000000003.json.gz")))
+		remaining, err := archive.ReadBundles(bundleDir)
+		require.NoError(t, err)
+		require.Len(t, remaining, 1)
+		require.ErrorContains(t, archive.VerifyChain(remaining), "missing")
+	})
+}
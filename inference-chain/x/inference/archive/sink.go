@@ -0,0 +1,54 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// FlagArchiveDir is the app.toml key of the directory pruned records are written to
+const FlagArchiveDir = "inference.archive-dir"
+
+// FileSink appends archived records as JSON lines to one file per pruning epoch
+type FileSink struct {
+	dir string
+	mu  sync.Mutex
+}
+
+var _ types.ArchiveSink = (*FileSink)(nil)
+
+func NewFileSink(dir string) (*FileSink, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileSink{dir: dir}, nil
+}
+
+func (s *FileSink) Archive(_ context.Context, record types.ArchivedRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, err := os.OpenFile(SinkFilePath(s.dir, record.PruningEpoch), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// SinkFilePath is the file FileSink writes the records pruned during pruningEpoch to
+func SinkFilePath(dir string, pruningEpoch int64) string {
+	return filepath.Join(dir, fmt.Sprintf("pruned-%010d.jsonl", pruningEpoch))
+}
@@ -20,8 +20,14 @@ const (
 	Developer SignatureType = iota
 	TransferAgent
 	ExecutorAgent
+	// ExecutionAttestation is the executor's statement of the response it produced. It is domain separated from
+	// ExecutorAgent, which signs arbitrary request payloads, so that no other signature can pass for one.
+	ExecutionAttestation
 )
 
+// ExecutionAttestationDomain prefixes the bytes of every ExecutionAttestation signature.
+const ExecutionAttestationDomain = "inference/execution-attestation/v1:"
+
 // PubKeyGetter defines an interface for retrieving public keys
 type PubKeyGetter interface {
 	GetAccountPubKey(ctx context.Context, address string) (string, error)
@@ -34,6 +40,7 @@ type SignatureData struct {
 	TransferSignature string             `json:"transfer_signature"`
 	ExecutorSignature string             `json:"executor_signature"`
 	Dev               *types.Participant `json:"dev"`
+	DevKey            string             `json:"dev_key"` // Inference key that signed for Dev, if Dev didn't sign itself
 	TransferAgent     *types.Participant `json:"transfer_agent"`
 	Executor          *types.Participant `json:"executor"`
 }
@@ -42,9 +49,13 @@ type SignatureData struct {
 func VerifyKeys(ctx context.Context, components SignatureComponents, sigData SignatureData, pubKeyGetter PubKeyGetter) error {
 	// Check developer signature if developer participant is provided
 	if sigData.Dev != nil && sigData.DevSignature != "" {
-		devKey, err := pubKeyGetter.GetAccountPubKey(ctx, sigData.Dev.Address)
+		devSigner := sigData.Dev.Address
+		if sigData.DevKey != "" {
+			devSigner = sigData.DevKey
+		}
+		devKey, err := pubKeyGetter.GetAccountPubKey(ctx, devSigner)
 		if err != nil {
-			return sdkerrors.Wrap(types.ErrParticipantNotFound, sigData.Dev.Address)
+			return sdkerrors.Wrap(types.ErrParticipantNotFound, devSigner)
 		}
 
 		err = ValidateSignature(components, Developer, devKey, sigData.DevSignature)
@@ -89,6 +100,26 @@ type SignatureComponents struct {
 	ExecutorAddress string
 }
 
+// GetExecutionSignatureComponents returns what an executor signs to commit to the response it produced
+// for an inference. Two valid signatures over different response hashes for the same inference id and
+// seed prove that the executor equivocated.
+func GetExecutionSignatureComponents(inferenceId string, seed int64, responseHash string, timestamp int64, transferAddress string, executorAddress string) SignatureComponents {
+	return SignatureComponents{
+		Payload:         inferenceId + "/" + strconv.FormatInt(seed, 10) + "/" + responseHash,
+		Timestamp:       timestamp,
+		TransferAddress: transferAddress,
+		ExecutorAddress: executorAddress,
+	}
+}
+
+// VerifyExecutionSignature checks a signed execution against the executor's keys, as the executor's dapi signs it
+// when it hands out a response.
+func VerifyExecutionSignature(execution types.SignedExecution, executorAddress string, executorKeys []string) error {
+	components := GetExecutionSignatureComponents(execution.InferenceId, execution.Seed, execution.ResponseHash,
+		execution.RequestTimestamp, execution.TransferredBy, executorAddress)
+	return ValidateSignatureWithGrantees(components, ExecutionAttestation, executorKeys, execution.ExecutionSignature)
+}
+
 type Signer interface {
 	SignBytes(data []byte) (string, error)
 }
@@ -130,6 +161,8 @@ func getSignatureBytes(components SignatureComponents, signatureType SignatureTy
 		bytes = getTransferBytes(components)
 	case ExecutorAgent:
 		bytes = getTransferBytes(components)
+	case ExecutionAttestation:
+		bytes = append([]byte(ExecutionAttestationDomain), getTransferBytes(components)...)
 	}
 
 	return bytes
@@ -231,3 +231,42 @@ func TestGetRandomMemberForModel(t *testing.T) {
 	require.NotNil(t, member)
 	require.Equal(t, "member1", member.Address)
 }
+
+func TestGetRandomMembersForModel(t *testing.T) {
+	testEG := createEpochGroupObject(t, &types.EpochGroupData{EpochGroupId: 7, ModelId: "model1"})
+	groupMembers := &group.QueryGroupMembersResponse{
+		Members: []*group.GroupMember{
+			{Member: &group.Member{Address: "member1", Weight: "10"}},
+			{Member: &group.Member{Address: "member2", Weight: "20"}},
+			{Member: &group.Member{Address: "member3", Weight: "30"}},
+			{Member: &group.Member{Address: "member4", Weight: "0"}},
+			{Member: &group.Member{Address: "executor", Weight: "40"}},
+		},
+	}
+	testEG.GroupMock.EXPECT().GroupMembers(gomock.Any(), gomock.Any()).Return(groupMembers, nil).AnyTimes()
+	withoutExecutor := func(members []*group.GroupMember) []*group.GroupMember {
+		var filtered []*group.GroupMember
+		for _, member := range members {
+			if member.Member.Address != "executor" {
+				filtered = append(filtered, member)
+			}
+		}
+		return filtered
+	}
+
+	selected, err := testEG.EpochGroup.GetRandomMembersForModel(context.Background(), "", 2, 42, withoutExecutor)
+	require.NoError(t, err)
+	require.Len(t, selected, 2)
+	require.NotEqual(t, selected[0], selected[1])
+	require.NotContains(t, selected, "executor")
+
+	// Same seed, same selection
+	again, err := testEG.EpochGroup.GetRandomMembersForModel(context.Background(), "", 2, 42, withoutExecutor)
+	require.NoError(t, err)
+	require.Equal(t, selected, again)
+
+	// Members without weight are never picked, even when more are requested than available
+	all, err := testEG.EpochGroup.GetRandomMembersForModel(context.Background(), "", 10, 42, withoutExecutor)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"member1", "member2", "member3"}, all)
+}
@@ -94,3 +94,49 @@ func getWeight(participant *group.GroupMember) int64 {
 	}
 	return int64(weight)
 }
+
+// GetRandomMembersForModel picks up to count distinct members serving modelId, weighted by their voting weight.
+// Unlike GetRandomMember the selection is derived from seed, so every node agrees on the result.
+func (eg *EpochGroup) GetRandomMembersForModel(
+	goCtx context.Context,
+	modelId string,
+	count int,
+	seed int64,
+	filterFn func([]*group.GroupMember) []*group.GroupMember,
+) ([]string, error) {
+	if modelId != "" && eg.GroupData.GetModelId() == "" {
+		subGroup, err := eg.GetSubGroup(goCtx, modelId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("Error getting sub-group for model %s: %v", modelId, err))
+		}
+		return subGroup.GetRandomMembersForModel(goCtx, "", count, seed, filterFn)
+	}
+
+	groupMemberResponse, err := eg.GroupKeeper.GroupMembers(goCtx, &group.QueryGroupMembersRequest{GroupId: uint64(eg.GroupData.EpochGroupId)})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	var candidates []*group.GroupMember
+	for _, member := range filterFn(groupMemberResponse.GetMembers()) {
+		if getWeight(member) > 0 {
+			candidates = append(candidates, member)
+		}
+	}
+
+	random := rand.New(rand.NewSource(seed))
+	var selected []string
+	for len(selected) < count && len(candidates) > 0 {
+		cumulativeArray := computeCumulativeArray(candidates)
+		randomNumber := random.Int63n(cumulativeArray[len(cumulativeArray)-1])
+		index := len(candidates) - 1
+		for i, cumulativeWeight := range cumulativeArray {
+			if randomNumber < cumulativeWeight {
+				index = i
+				break
+			}
+		}
+		selected = append(selected, candidates[index].Member.Address)
+		candidates = append(candidates[:index], candidates[index+1:]...)
+	}
+	return selected, nil
+}
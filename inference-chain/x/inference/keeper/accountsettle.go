@@ -210,6 +210,9 @@ func (k *Keeper) SettleAccounts(ctx context.Context, currentEpochIndex uint64, p
 	k.LogInfo("Checking downtime for participants", types.Settle, "participants", len(allParticipants))
 	for _, participant := range allParticipants {
 		k.LogDebug("Checking downtime for participant", types.Settle, "participant", participant.Address, "missed_requests", participant.CurrentEpochStats.MissedRequests, "inference_count", participant.CurrentEpochStats.InferenceCount)
+		if k.exemptFromDowntime(ctx, participant.Address, currentEpochIndex) {
+			continue
+		}
 		// TODO: Check if it is better to move this function outside the settleAccounts function.
 		k.CheckAndSlashForDowntime(ctx, &participant)
 	}
@@ -253,6 +256,9 @@ func (k *Keeper) SettleAccounts(ctx context.Context, currentEpochIndex uint64, p
 			k.LogError("Error calculating settle amounts", types.Settle, "error", amount.Error, "participant", amount.Settle.Participant)
 			continue
 		}
+		// Delegators are paid their share right away, the host claims what is left
+		delegatorRewards := k.PayDelegatorRewards(ctx, amount.Settle.Participant, amount.Settle.RewardCoins, &params.TokenomicsParams.RewardVestingPeriod)
+		amount.Settle.RewardCoins -= delegatorRewards
 		totalPayment := amount.Settle.WorkCoins + amount.Settle.RewardCoins
 		if totalPayment == 0 {
 			k.LogDebug("No payment needed for participant", types.Settle, "address", amount.Settle.Participant)
@@ -4,7 +4,10 @@ import (
 	"testing"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/productscience/inference/testutil"
+	collateraltypes "github.com/productscience/inference/x/collateral/types"
 	"go.uber.org/mock/gomock"
 
 	keeper2 "github.com/productscience/inference/testutil/keeper"
@@ -424,6 +427,7 @@ func TestActualSettle(t *testing.T) {
 
 	mocks.BankKeeper.EXPECT().MintCoins(ctx, types.ModuleName, coins, gomock.Any()).Return(nil)
 	mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mocks.CollateralKeeper.EXPECT().GetDelegationsToHost(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	err := keeper.SettleAccounts(ctx, 10, 0)
 	require.NoError(t, err, "SettleAccounts should complete successfully")
@@ -455,6 +459,53 @@ func TestActualSettle(t *testing.T) {
 	logger.Info("TestActualSettle completed successfully")
 }
 
+func TestActualSettleSplitsRewardsWithDelegators(t *testing.T) {
+	host := types.Participant{
+		Index:             testutil.Executor,
+		Address:           testutil.Executor,
+		CoinBalance:       1000,
+		Status:            types.ParticipantStatus_ACTIVE,
+		CurrentEpochStats: &types.CurrentEpochStats{InferenceCount: 100},
+	}
+	keeper, ctx, mocks := keeper2.InferenceKeeperReturningMocks(t)
+	params := keeper.GetParams(ctx)
+	params.BitcoinRewardParams.UseBitcoinRewards = false
+	params.TokenomicsParams.RewardVestingPeriod = 5
+	keeper.SetParams(ctx, params)
+	keeper.SetParticipant(ctx, host)
+	keeper.SetEpochGroupData(ctx, types.EpochGroupData{EpochIndex: 10})
+
+	reward := calcExpectedRewards([]types.Participant{host})
+	coins, err := types.GetCoins(reward)
+	require.NoError(t, err)
+	mocks.BankKeeper.EXPECT().MintCoins(ctx, types.ModuleName, coins, gomock.Any()).Return(nil)
+	mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	// The host backs 600 itself, two delegators add 300 and 100, and the host keeps 10% of their share
+	hostAddress := sdk.MustAccAddressFromBech32(host.Address)
+	delegator1, delegator2 := testutil.Requester, testutil.Validator
+	mocks.CollateralKeeper.EXPECT().GetDelegationsToHost(gomock.Any(), hostAddress).Return([]collateraltypes.CollateralDelegation{
+		{Delegator: delegator1, Host: host.Address, Amount: sdk.NewInt64Coin(types.BaseCoin, 300)},
+		{Delegator: delegator2, Host: host.Address, Amount: sdk.NewInt64Coin(types.BaseCoin, 100)},
+	})
+	mocks.CollateralKeeper.EXPECT().GetCollateral(gomock.Any(), hostAddress).Return(sdk.NewInt64Coin(types.BaseCoin, 600), true)
+	mocks.CollateralKeeper.EXPECT().GetCommissionRate(gomock.Any(), hostAddress).Return(math.LegacyNewDecWithPrec(1, 1))
+
+	delegatorsShare := reward * 400 / 1000
+	pool := delegatorsShare - delegatorsShare/10
+	expected1, expected2 := pool*300/400, pool*100/400
+	vestingEpochs := uint64(5)
+	mocks.StreamVestingKeeper.EXPECT().AddVestedRewards(gomock.Any(), delegator1, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, expected1)), &vestingEpochs, gomock.Any()).Return(nil)
+	mocks.StreamVestingKeeper.EXPECT().AddVestedRewards(gomock.Any(), delegator2, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, expected2)), &vestingEpochs, gomock.Any()).Return(nil)
+
+	require.NoError(t, keeper.SettleAccounts(ctx, 10, 0))
+
+	settleAmount, found := keeper.GetSettleAmount(ctx, host.Address)
+	require.True(t, found)
+	require.Equal(t, uint64(1000), settleAmount.WorkCoins, "work coins are not shared with delegators")
+	require.Equal(t, uint64(reward-expected1-expected2), settleAmount.RewardCoins)
+}
+
 func TestActualSettleWithManyParticipants(t *testing.T) {
 	logger := createTestLogger(t)
 	logger.Info("Starting TestActualSettleWithManyParticipants - testing settlement with 150 participants")
@@ -503,6 +554,7 @@ func TestActualSettleWithManyParticipants(t *testing.T) {
 	require.NoError(t, err2, "Should be able to create coins from reward amount")
 	mocks.BankKeeper.EXPECT().MintCoins(ctx, types.ModuleName, coins, gomock.Any()).Return(nil)
 	mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mocks.CollateralKeeper.EXPECT().GetDelegationsToHost(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	// This should work with pagination and process all 150 participants
 	logger.Info("Starting SettleAccounts for 150 participants")
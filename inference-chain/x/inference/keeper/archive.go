@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// archivePrunedRecord emits a record_pruned event for the store entry about to be pruned and hands the full
+// entry to the archive sink, if the node has one.
+func (k Keeper) archivePrunedRecord(ctx context.Context, kind string, epoch int64, pruningEpoch int64, storeKey []byte) error {
+	value, err := k.storeService.OpenKVStore(ctx).Get(storeKey)
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	valueHash := sha256.Sum256(value)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeRecordPruned,
+		sdk.NewAttribute("kind", kind),
+		sdk.NewAttribute("epoch", strconv.FormatInt(epoch, 10)),
+		sdk.NewAttribute("key", hex.EncodeToString(storeKey)),
+		sdk.NewAttribute("value_hash", hex.EncodeToString(valueHash[:])),
+	))
+
+	if k.archiveSink == nil {
+		return nil
+	}
+	record := types.ArchivedRecord{
+		Kind:          kind,
+		Epoch:         epoch,
+		PruningEpoch:  pruningEpoch,
+		PruningHeight: sdkCtx.BlockHeight(),
+		AppHash:       sdkCtx.BlockHeader().AppHash,
+		Key:           storeKey,
+		Value:         value,
+	}
+	if err := k.archiveSink.Archive(ctx, record); err != nil {
+		// The sink is local to this node, so it must not change what the block does
+		k.LogError("Failed to archive pruned record", types.Pruning, "kind", kind, "key", hex.EncodeToString(storeKey), "error", err)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	collateraltypes "github.com/productscience/inference/x/collateral/types"
 	"github.com/productscience/inference/x/inference/types"
 )
 
@@ -66,12 +67,18 @@ func (k Keeper) AdjustWeightsByCollateral(ctx context.Context, participants []*t
 		collateralEligibleWeight := potentialWeight.Sub(baseWeight)
 		var activatedWeight math.LegacyDec
 
-		// 3. Calculate Activated Weight: Determine how much of the eligible weight is activated by the participant's collateral.
+		// 3. Calculate Activated Weight: Determine how much of the eligible weight is activated by the participant's
+		// own collateral plus whatever third parties have delegated to it.
 		collateral, found := k.collateralKeeper.GetCollateral(sdkCtx, participantAddress)
-		if !found || collateral.IsZero() {
+		if !found {
+			collateral = sdk.NewCoin(types.BaseCoin, math.ZeroInt())
+		}
+		delegated := k.collateralKeeper.GetDelegatedCollateral(sdkCtx, participantAddress)
+		backingCollateral := collateral.Amount.Add(delegated.Amount)
+		if backingCollateral.IsZero() {
 			activatedWeight = math.LegacyZeroDec()
 		} else {
-			collateralAmount := math.LegacyNewDecFromInt(collateral.Amount)
+			collateralAmount := math.LegacyNewDecFromInt(backingCollateral)
 			if !collateralPerWeightUnit.IsZero() {
 				// Weight activated is limited by the collateral deposited.
 				weightFromCollateral := collateralAmount.Quo(collateralPerWeightUnit)
@@ -91,6 +98,7 @@ func (k Keeper) AdjustWeightsByCollateral(ctx context.Context, participants []*t
 			"potential_weight", potentialWeight.String(),
 			"base_weight", baseWeight.String(),
 			"eligible_weight", collateralEligibleWeight.String(),
+			"delegated_collateral", delegated.String(),
 			"activated_weight", activatedWeight.String(),
 			"effective_weight", participant.Weight,
 		)
@@ -99,6 +107,26 @@ func (k Keeper) AdjustWeightsByCollateral(ctx context.Context, participants []*t
 	return nil
 }
 
+// ExcludeJailedParticipants drops participants jailed by the inference module from the upcoming epoch,
+// so they get no weight until they unjail themselves. Consensus jails are left to x/staking.
+// If every participant is jailed nobody is excluded, as an epoch without participants would halt the chain.
+func (k Keeper) ExcludeJailedParticipants(ctx context.Context, participants []*types.ActiveParticipant) []*types.ActiveParticipant {
+	eligible := make([]*types.ActiveParticipant, 0, len(participants))
+	for _, participant := range participants {
+		participantAddress, err := sdk.AccAddressFromBech32(participant.Index)
+		if err == nil && k.collateralKeeper.IsJailedForInference(ctx, participantAddress) {
+			k.LogInfo("Excluding jailed participant from the upcoming epoch", types.Tokenomics, "participant", participant.Index)
+			continue
+		}
+		eligible = append(eligible, participant)
+	}
+	if len(eligible) == 0 && len(participants) > 0 {
+		k.LogWarn("All participants are jailed, keeping them in the upcoming epoch", types.Tokenomics, "participants", len(participants))
+		return participants
+	}
+	return eligible
+}
+
 // CheckAndSlashForInvalidStatus checks if a participant's status has transitioned to INVALID
 // and, if so, triggers a collateral slash.
 func (k Keeper) CheckAndSlashForInvalidStatus(ctx context.Context, originalStatus types.ParticipantStatus, participant *types.Participant) {
@@ -131,7 +159,7 @@ func (k Keeper) CheckAndSlashForInvalidStatus(ctx context.Context, originalStatu
 }
 
 // CheckAndSlashForDowntime checks a participant's performance for the completed epoch and
-// slashes their collateral if their missed request percentage exceeds the threshold.
+// slashes and jails them if their missed request percentage exceeds the threshold.
 func (k Keeper) CheckAndSlashForDowntime(ctx context.Context, participant *types.Participant) {
 	// Don't slash participants who had no work assigned.
 	totalRequests := participant.CurrentEpochStats.InferenceCount + participant.CurrentEpochStats.MissedRequests
@@ -162,18 +190,83 @@ func (k Keeper) CheckAndSlashForDowntime(ctx context.Context, participant *types
 			return
 		}
 
-		k.LogInfo("Slashing participant for downtime", types.Tokenomics,
+		k.LogInfo("Slashing and jailing participant for downtime", types.Tokenomics,
 			"participant", participant.Address,
 			"missed_percentage", missedPercentage.String(),
 			"downtime_threshold", downtimeThreshold.String(),
 			"slash_fraction", slashFraction.String(),
 		)
 
-		_, err = k.collateralKeeper.Slash(sdkCtx, participantAddress, slashFraction)
+		// The participant must restore the collateral it held before the slash to unjail itself
+		requiredCollateral, _ := k.collateralKeeper.GetCollateral(sdkCtx, participantAddress)
+		slashed, err := k.collateralKeeper.Slash(sdkCtx, participantAddress, slashFraction)
 		if err != nil {
 			k.LogError("Failed to slash participant for downtime", types.Tokenomics, "participant", participant.Address, "error", err)
+			return
 		}
+		k.collateralKeeper.Jail(sdkCtx, participantAddress, collateraltypes.JailReasonDowntime, slashed, requiredCollateral)
 	} else {
 		k.LogInfo("Participant did not exceed downtime threshold", types.Tokenomics, "participant", participant.Address, "missed_percentage", missedPercentage.String(), "downtime_threshold", downtimeThreshold.String())
 	}
 }
+
+// PayDelegatorRewards pays collateral delegators their share of the reward a host earned in the epoch being settled
+// and returns how much was paid. Delegators earn the fraction of the reward matching the fraction of the host's
+// backing collateral they provided, less the host's commission. Their share goes into their own vesting schedules
+// and the host claims the rest; rounding dust and shares that could not be paid stay with the host.
+func (k *Keeper) PayDelegatorRewards(ctx context.Context, host string, reward uint64, vestingPeriods *uint64) uint64 {
+	if reward == 0 {
+		return 0
+	}
+	hostAddress, err := sdk.AccAddressFromBech32(host)
+	if err != nil {
+		k.LogError("Could not parse host address, skipping delegator rewards", types.Claims, "address", host, "error", err)
+		return 0
+	}
+	delegations := k.collateralKeeper.GetDelegationsToHost(ctx, hostAddress)
+	if len(delegations) == 0 {
+		return 0
+	}
+
+	delegated := math.ZeroInt()
+	for _, delegation := range delegations {
+		delegated = delegated.Add(delegation.Amount.Amount)
+	}
+	if delegated.IsZero() {
+		return 0
+	}
+	backing := delegated
+	if own, found := k.collateralKeeper.GetCollateral(ctx, hostAddress); found {
+		backing = backing.Add(own.Amount)
+	}
+
+	delegatorsShare := math.NewIntFromUint64(reward).Mul(delegated).Quo(backing)
+	commissionRate := k.collateralKeeper.GetCommissionRate(ctx, hostAddress)
+	commission := math.LegacyNewDecFromInt(delegatorsShare).Mul(commissionRate).TruncateInt()
+	pool := delegatorsShare.Sub(commission)
+
+	paid := math.ZeroInt()
+	for _, delegation := range delegations {
+		share := pool.Mul(delegation.Amount.Amount).Quo(delegated)
+		if share.IsZero() {
+			continue
+		}
+		err := k.PayParticipantFromModule(ctx, delegation.Delegator, share.Int64(), types.ModuleName, "delegator_reward:"+host, vestingPeriods)
+		if err != nil {
+			k.LogError("Error paying delegator reward", types.Claims, "host", host, "delegator", delegation.Delegator, "amount", share, "error", err)
+			continue
+		}
+		paid = paid.Add(share)
+	}
+
+	k.LogInfo("Paid delegator rewards", types.Claims,
+		"host", host,
+		"delegators", len(delegations),
+		"delegated_collateral", delegated,
+		"backing_collateral", backing,
+		"commission_rate", commissionRate,
+		"claimed_reward", reward,
+		"paid", paid,
+	)
+	return paid.Uint64()
+}
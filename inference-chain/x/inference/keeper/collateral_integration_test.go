@@ -183,12 +183,18 @@ func TestSlashingForDowntime_Integration(t *testing.T) {
 		},
 	}
 
-	// Mock the slash call on the collateral keeper
+	// Mock the slash call on the collateral keeper, the participant must restore its pre-slash collateral to unjail
 	expectedSlashFraction, err := slashFraction.ToLegacyDec()
 	require.NoError(t, err)
+	slashed := sdk.NewCoin(types.BaseCoin, math.NewInt(100))
+	collateralBeforeSlash := sdk.NewCoin(types.BaseCoin, math.NewInt(1000))
+	getCollateral := mocks.CollateralKeeper.EXPECT().GetCollateral(gomock.Any(), participantAcc).Return(collateralBeforeSlash, true).Times(1)
 	mocks.CollateralKeeper.EXPECT().
 		Slash(gomock.Any(), participantAcc, expectedSlashFraction).
-		Return(sdk.NewCoin(types.BaseCoin, math.NewInt(0)), nil).Times(1)
+		Return(slashed, nil).After(getCollateral).Times(1)
+	mocks.CollateralKeeper.EXPECT().
+		Jail(gomock.Any(), participantAcc, collateralTypes.JailReasonDowntime, slashed, collateralBeforeSlash).
+		Times(1)
 
 	// Execute the function under test directly
 	k.CheckAndSlashForDowntime(ctx, participant)
@@ -393,3 +399,64 @@ func TestDoubleJeopardy_DowntimeThenInvalidSlash(t *testing.T) {
 	// For clarity, check the final value is 720
 	require.Equal(t, math.NewInt(720), finalCollateralCoin.Amount)
 }
+
+func TestDowntimeJailAndUnjail_Integration(t *testing.T) {
+	ctx, k, ck, _, collateralMsgSrv, mocks := setupRealKeepers(t)
+
+	params := types.DefaultParams()
+	params.CollateralParams.DowntimeMissedPercentageThreshold = types.DecimalFromFloat(0.5)
+	params.CollateralParams.SlashFractionDowntime = types.DecimalFromFloat(0.1)
+	k.SetParams(ctx, params)
+	participantAddrStr := sample.AccAddress()
+	participantAcc, err := sdk.AccAddressFromBech32(participantAddrStr)
+	require.NoError(t, err)
+	otherAddrStr := sample.AccAddress()
+
+	_, err = collateralMsgSrv.DepositCollateral(ctx, &collateralTypes.MsgDepositCollateral{
+		Participant: participantAddrStr,
+		Amount:      sdk.NewCoin(types.BaseCoin, math.NewInt(1000)),
+	})
+	require.NoError(t, err)
+	require.NoError(t, setEffectiveEpoch(ctx, k, 1, mocks))
+	ck.SetCurrentEpoch(ctx, 1)
+
+	participant := types.Participant{
+		Index:   participantAddrStr,
+		Address: participantAddrStr,
+		CurrentEpochStats: &types.CurrentEpochStats{
+			InferenceCount: 1,
+			MissedRequests: 9,
+		},
+	}
+	k.CheckAndSlashForDowntime(ctx, &participant)
+
+	history := ck.GetJailHistory(ctx, participantAcc)
+	require.Len(t, history, 1)
+	require.Equal(t, collateralTypes.JailReasonDowntime, history[0].Reason)
+	require.Equal(t, math.NewInt(100), history[0].SlashedAmount.Amount)
+	require.Equal(t, math.NewInt(1000), history[0].RequiredCollateral.Amount)
+
+	// The jailed participant is left out of the next epoch
+	activeParticipants := []*types.ActiveParticipant{{Index: participantAddrStr, Weight: 100}, {Index: otherAddrStr, Weight: 100}}
+	eligible := k.ExcludeJailedParticipants(ctx, activeParticipants)
+	require.Len(t, eligible, 1)
+	require.Equal(t, otherAddrStr, eligible[0].Index)
+
+	// Unjailing needs the slashed collateral restored, even once the jail period is over
+	ck.SetCurrentEpoch(ctx, 1+collateralTypes.DefaultMinJailEpochs)
+	_, err = collateralMsgSrv.Unjail(ctx, collateralTypes.NewMsgUnjail(participantAddrStr))
+	require.ErrorIs(t, err, collateralTypes.ErrInsufficientCollateral)
+	require.Len(t, k.ExcludeJailedParticipants(ctx, activeParticipants), 1)
+
+	_, err = collateralMsgSrv.DepositCollateral(ctx, &collateralTypes.MsgDepositCollateral{
+		Participant: participantAddrStr,
+		Amount:      sdk.NewCoin(types.BaseCoin, math.NewInt(100)),
+	})
+	require.NoError(t, err)
+	resp, err := collateralMsgSrv.Unjail(ctx, collateralTypes.NewMsgUnjail(participantAddrStr))
+	require.NoError(t, err)
+	require.Equal(t, 2+collateralTypes.DefaultMinJailEpochs, resp.WeightEpoch)
+
+	// The next epoch formed counts the participant's weight again
+	require.Len(t, k.ExcludeJailedParticipants(ctx, activeParticipants), 2)
+}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// Developer credit is a prepaid balance kept in the module account. Inferences of a developer with enough
+// available credit reserve their escrow from it instead of transferring coins, and refunds go back to it.
+// Deposits and payouts of withdrawals are the only bank transfers.
+
+// CreditWithdrawalIndexes are the secondary indexes of the CreditWithdrawals map
+type CreditWithdrawalIndexes struct {
+	// ByDeveloper indexes the (release height, developer) keys by developer, for the pending withdrawals of one developer
+	ByDeveloper *indexes.ReversePair[int64, sdk.AccAddress, uint64]
+}
+
+func (i CreditWithdrawalIndexes) IndexesList() []collections.Index[collections.Pair[int64, sdk.AccAddress], uint64] {
+	return []collections.Index[collections.Pair[int64, sdk.AccAddress], uint64]{i.ByDeveloper}
+}
+
+func newCreditWithdrawalIndexes(sb *collections.SchemaBuilder) CreditWithdrawalIndexes {
+	return CreditWithdrawalIndexes{
+		ByDeveloper: indexes.NewReversePair[uint64](
+			sb,
+			types.WithdrawalsByDeveloperPrefix,
+			"credit_withdrawals_by_developer",
+			collections.PairKeyCodec(collections.Int64Key, sdk.AccAddressKey),
+		),
+	}
+}
+
+func (k Keeper) GetDeveloperCredit(ctx context.Context, developer string) (types.DeveloperCredit, bool) {
+	addr, err := sdk.AccAddressFromBech32(developer)
+	if err != nil {
+		return types.DeveloperCredit{}, false
+	}
+	credit, err := k.DeveloperCredits.Get(ctx, addr)
+	if err != nil {
+		return types.DeveloperCredit{}, false
+	}
+	return credit, true
+}
+
+func (k Keeper) SetDeveloperCredit(ctx context.Context, credit types.DeveloperCredit) {
+	if err := k.DeveloperCredits.Set(ctx, sdk.MustAccAddressFromBech32(credit.Developer), credit); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) GetAllDeveloperCredits(ctx context.Context) []types.DeveloperCredit {
+	iter, err := k.DeveloperCredits.Iterate(ctx, nil)
+	if err != nil {
+		return nil
+	}
+	credits, err := iter.Values()
+	if err != nil {
+		return nil
+	}
+	return credits
+}
+
+// AddCreditWithdrawal schedules a payout of withdrawn credit, merging it with one due at the same height.
+func (k Keeper) AddCreditWithdrawal(ctx context.Context, withdrawal types.CreditWithdrawal) {
+	key := collections.Join(withdrawal.ReleaseHeight, sdk.MustAccAddressFromBech32(withdrawal.Developer))
+	existing, err := k.CreditWithdrawals.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+	if err := k.CreditWithdrawals.Set(ctx, key, existing+withdrawal.Amount); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) GetAllCreditWithdrawals(ctx context.Context) []types.CreditWithdrawal {
+	return k.getCreditWithdrawals(ctx, nil)
+}
+
+// GetCreditWithdrawals returns the pending withdrawals of developer.
+func (k Keeper) GetCreditWithdrawals(ctx context.Context, developer string) []types.CreditWithdrawal {
+	addr, err := sdk.AccAddressFromBech32(developer)
+	if err != nil {
+		return nil
+	}
+	iter, err := k.CreditWithdrawals.Indexes.ByDeveloper.MatchExact(ctx, addr)
+	if err != nil {
+		return nil
+	}
+	defer iter.Close()
+	var withdrawals []types.CreditWithdrawal
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.PrimaryKey()
+		if err != nil {
+			k.LogError("Failed to read credit withdrawal", types.Payments, "error", err)
+			continue
+		}
+		amount, err := k.CreditWithdrawals.Get(ctx, key)
+		if err != nil {
+			k.LogError("Failed to read credit withdrawal", types.Payments, "developer", developer, "error", err)
+			continue
+		}
+		withdrawals = append(withdrawals, types.CreditWithdrawal{
+			Developer:     developer,
+			Amount:        amount,
+			ReleaseHeight: key.K1(),
+		})
+	}
+	return withdrawals
+}
+
+func (k Keeper) getCreditWithdrawals(ctx context.Context, ranger collections.Ranger[collections.Pair[int64, sdk.AccAddress]]) []types.CreditWithdrawal {
+	iter, err := k.CreditWithdrawals.Iterate(ctx, ranger)
+	if err != nil {
+		return nil
+	}
+	defer iter.Close()
+	var withdrawals []types.CreditWithdrawal
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			k.LogError("Failed to read credit withdrawal", types.Payments, "error", err)
+			continue
+		}
+		withdrawals = append(withdrawals, types.CreditWithdrawal{
+			Developer:     kv.Key.K2().String(),
+			Amount:        kv.Value,
+			ReleaseHeight: kv.Key.K1(),
+		})
+	}
+	return withdrawals
+}
+
+// ReserveDeveloperCredit draws amount from the developer's available credit if it covers it. The amount is reserved
+// until the inference finishes, or settled right away if it already has.
+func (k *Keeper) ReserveDeveloperCredit(ctx context.Context, developer string, amount int64, settle bool) bool {
+	credit, found := k.GetDeveloperCredit(ctx, developer)
+	if !found || amount < 0 || credit.Available < uint64(amount) {
+		return false
+	}
+	credit.Available -= uint64(amount)
+	if settle {
+		credit.Settled += uint64(amount)
+	} else {
+		credit.Reserved += uint64(amount)
+	}
+	k.SetDeveloperCredit(ctx, credit)
+	k.LogDebug("Reserved developer credit", types.Payments, "developer", developer, "amount", amount, "settled", settle)
+	return true
+}
+
+// SettleDeveloperCredit turns a reservation into spent credit once its inference is done.
+func (k *Keeper) SettleDeveloperCredit(ctx context.Context, developer string, amount int64) {
+	credit, found := k.GetDeveloperCredit(ctx, developer)
+	if !found || amount <= 0 {
+		return
+	}
+	if credit.Reserved < uint64(amount) {
+		k.LogError("Settling more developer credit than reserved", types.Payments, "developer", developer, "amount", amount, "reserved", credit.Reserved)
+		amount = int64(credit.Reserved)
+	}
+	credit.Reserved -= uint64(amount)
+	credit.Settled += uint64(amount)
+	k.SetDeveloperCredit(ctx, credit)
+}
+
+// RefundInference returns amount to whoever paid for the inference: the developer credit it was drawn from,
+// or the requester's account.
+func (k *Keeper) RefundInference(ctx context.Context, inference *types.Inference, amount int64, memo string) error {
+	if !inference.PaidFromCredit {
+		return k.IssueRefund(ctx, amount, inference.RequestedBy, memo)
+	}
+	credit, found := k.GetDeveloperCredit(ctx, inference.RequestedBy)
+	if !found {
+		k.LogError("Developer credit not found for refund, refunding to account", types.Payments, "developer", inference.RequestedBy, "inferenceId", inference.InferenceId)
+		return k.IssueRefund(ctx, amount, inference.RequestedBy, memo)
+	}
+	if amount <= 0 {
+		return nil
+	}
+	k.LogInfo("Refunding to developer credit", types.Payments, "developer", inference.RequestedBy, "amount", amount, "memo", memo)
+	credit.Available += uint64(amount)
+	credit.Settled -= min(credit.Settled, uint64(amount))
+	k.SetDeveloperCredit(ctx, credit)
+	k.AddTokenomicsData(ctx, &types.TokenomicsData{TotalRefunded: uint64(amount)})
+	return nil
+}
+
+// ProcessCreditWithdrawals pays out all withdrawals released at or before height.
+func (k *Keeper) ProcessCreditWithdrawals(ctx context.Context, height int64) {
+	due := k.getCreditWithdrawals(ctx, collections.NewPrefixUntilPairRange[int64, sdk.AccAddress](height))
+	for _, withdrawal := range due {
+		if err := k.CreditWithdrawals.Remove(ctx, collections.Join(withdrawal.ReleaseHeight, sdk.MustAccAddressFromBech32(withdrawal.Developer))); err != nil {
+			k.LogError("Failed to remove credit withdrawal", types.Payments, "developer", withdrawal.Developer, "error", err)
+			continue
+		}
+		credit, found := k.GetDeveloperCredit(ctx, withdrawal.Developer)
+		if !found {
+			k.LogError("Developer credit not found for withdrawal", types.Payments, "developer", withdrawal.Developer)
+			continue
+		}
+		credit.PendingWithdrawal -= min(credit.PendingWithdrawal, withdrawal.Amount)
+		if err := k.PayParticipantFromEscrow(ctx, withdrawal.Developer, int64(withdrawal.Amount), "credit_withdrawal", nil); err != nil {
+			// Keep the funds spendable rather than losing track of them
+			k.LogError("Failed to pay out credit withdrawal", types.Payments, "developer", withdrawal.Developer, "amount", withdrawal.Amount, "error", err)
+			credit.Available += withdrawal.Amount
+		}
+		k.SetDeveloperCredit(ctx, credit)
+	}
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/testutil"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func depositCredit(t *testing.T, ms types.MsgServer, ctx sdk.Context, mocks *keepertest.InferenceMocks, amount uint64) {
+	mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.MustAccAddressFromBech32(testutil.Requester), types.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, int64(amount))), "credit_deposit").Return(nil)
+	_, err := ms.DepositCredit(ctx, &types.MsgDepositCredit{Creator: testutil.Requester, Amount: amount})
+	require.NoError(t, err)
+}
+
+func TestDeveloperCredit_PaysAndRefundsInference(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	depositCredit(t, ms, ctx, &mocks, 1_000)
+
+	// No bank transfer is expected for the escrow
+	inference := &types.Inference{InferenceId: "inference", RequestedBy: testutil.Requester}
+	escrow, err := k.PutPaymentInEscrow(ctx, inference, 800)
+	require.NoError(t, err)
+	require.Equal(t, int64(800), escrow)
+	require.True(t, inference.PaidFromCredit)
+
+	credit, found := k.GetDeveloperCredit(ctx, testutil.Requester)
+	require.True(t, found)
+	require.Equal(t, uint64(200), credit.Available)
+	require.Equal(t, uint64(800), credit.Reserved)
+
+	k.SettleDeveloperCredit(ctx, testutil.Requester, 800)
+	require.NoError(t, k.RefundInference(ctx, inference, 300, "inference_refund:inference"))
+
+	credit, _ = k.GetDeveloperCredit(ctx, testutil.Requester)
+	require.Equal(t, types.DeveloperCredit{Developer: testutil.Requester, Available: 500, Settled: 500}, credit)
+}
+
+func TestDeveloperCredit_FallsBackToAccount(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	depositCredit(t, ms, ctx, &mocks, 100)
+
+	mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.MustAccAddressFromBech32(testutil.Requester), types.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 500)), "escrow for inferenceId:inference").Return(nil)
+	inference := &types.Inference{InferenceId: "inference", RequestedBy: testutil.Requester}
+	_, err := k.PutPaymentInEscrow(ctx, inference, 500)
+	require.NoError(t, err)
+	require.False(t, inference.PaidFromCredit)
+
+	credit, _ := k.GetDeveloperCredit(ctx, testutil.Requester)
+	require.Equal(t, uint64(100), credit.Available)
+}
+
+func TestDeveloperCredit_DelayedWithdrawal(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	depositCredit(t, ms, ctx, &mocks, 1_000)
+
+	_, err := ms.WithdrawCredit(ctx, &types.MsgWithdrawCredit{Creator: testutil.Requester, Amount: 2_000})
+	require.ErrorIs(t, err, types.ErrInsufficientCredit)
+
+	resp, err := ms.WithdrawCredit(ctx, &types.MsgWithdrawCredit{Creator: testutil.Requester, Amount: 600})
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight()+types.DefaultDeveloperCreditParams().WithdrawalDelayBlocks, resp.ReleaseHeight)
+
+	query, err := k.DeveloperCredit(ctx, &types.QueryDeveloperCreditRequest{Developer: testutil.Requester})
+	require.NoError(t, err)
+	require.Equal(t, uint64(400), query.Credit.Available)
+	require.Equal(t, uint64(600), query.Credit.PendingWithdrawal)
+	require.Len(t, query.Withdrawals, 1)
+
+	// Withdrawals of other developers are not listed
+	k.AddCreditWithdrawal(ctx, types.CreditWithdrawal{Developer: testutil.Executor, Amount: 50, ReleaseHeight: resp.ReleaseHeight + 1})
+	require.Equal(t, []types.CreditWithdrawal{{Developer: testutil.Requester, Amount: 600, ReleaseHeight: resp.ReleaseHeight}},
+		k.GetCreditWithdrawals(ctx, testutil.Requester))
+
+	// Nothing is paid out before the release height
+	k.ProcessCreditWithdrawals(ctx, resp.ReleaseHeight-1)
+	require.Len(t, k.GetCreditWithdrawals(ctx, testutil.Requester), 1)
+
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(testutil.Requester),
+		sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 600)), "credit_withdrawal").Return(nil)
+	k.ProcessCreditWithdrawals(ctx, resp.ReleaseHeight)
+	require.Empty(t, k.GetCreditWithdrawals(ctx, testutil.Requester))
+
+	credit, _ := k.GetDeveloperCredit(ctx, testutil.Requester)
+	require.Equal(t, types.DeveloperCredit{Developer: testutil.Requester, Available: 400}, credit)
+}
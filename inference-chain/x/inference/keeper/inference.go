@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/productscience/inference/x/inference/types"
 )
 
@@ -39,6 +40,17 @@ func (k Keeper) addInferenceToPruningList(ctx context.Context, inference types.I
 	}
 }
 
+// EmitInferenceStatus records a status change that no message of its own carries (invalidation, revalidation
+// and expiry), so indexers can follow inferences from block results alone
+func (k Keeper) EmitInferenceStatus(ctx context.Context, inference *types.Inference) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"inference_status",
+			sdk.NewAttribute("inference_id", inference.InferenceId),
+			sdk.NewAttribute("status", inference.Status.String()),
+		))
+}
+
 // GetInference returns a inference from its index
 func (k Keeper) GetInference(
 	ctx context.Context,
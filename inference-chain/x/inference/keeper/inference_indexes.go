@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// InferenceIndexKey is (reference, epoch, inference index), where the reference is an address or a status
+type InferenceIndexKey = collections.Triple[string, uint64, string]
+
+// InferenceIndex maps a reference and an epoch to the inferences that have it. Unlike indexes.Multi an
+// inference can have several references, which the executor index needs for assigned_to and executed_by,
+// and the keys sit in a plain KeySet so queries can paginate them.
+type InferenceIndex struct {
+	Keys collections.KeySet[InferenceIndexKey]
+	refs func(inference types.Inference) []string
+}
+
+var _ collections.Index[string, types.Inference] = (*InferenceIndex)(nil)
+
+func NewInferenceIndex(sb *collections.SchemaBuilder, prefix collections.Prefix, name string, refs func(inference types.Inference) []string) *InferenceIndex {
+	return &InferenceIndex{
+		Keys: collections.NewKeySet(
+			sb,
+			prefix,
+			name,
+			collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.StringKey),
+			collections.WithKeySetSecondaryIndex(),
+		),
+		refs: refs,
+	}
+}
+
+func (i *InferenceIndex) keys(pk string, inference types.Inference) []InferenceIndexKey {
+	var keys []InferenceIndexKey
+	seen := make(map[string]bool)
+	for _, ref := range i.refs(inference) {
+		if ref == "" || seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		keys = append(keys, collections.Join3(ref, inference.EpochId, pk))
+	}
+	return keys
+}
+
+func (i *InferenceIndex) Reference(ctx context.Context, pk string, newValue types.Inference, lazyOldValue func() (types.Inference, error)) error {
+	newKeys := i.keys(pk, newValue)
+	oldValue, err := lazyOldValue()
+	switch {
+	case err == nil:
+		for _, oldKey := range i.keys(pk, oldValue) {
+			if containsIndexKey(newKeys, oldKey) {
+				continue
+			}
+			if err := i.Keys.Remove(ctx, oldKey); err != nil {
+				return err
+			}
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+	for _, key := range newKeys {
+		if err := i.Keys.Set(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *InferenceIndex) Unreference(ctx context.Context, pk string, lazyOldValue func() (types.Inference, error)) error {
+	oldValue, err := lazyOldValue()
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, key := range i.keys(pk, oldValue) {
+		if err := i.Keys.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func containsIndexKey(keys []InferenceIndexKey, key InferenceIndexKey) bool {
+	for _, k := range keys {
+		if k.K1() == key.K1() && k.K2() == key.K2() && k.K3() == key.K3() {
+			return true
+		}
+	}
+	return false
+}
+
+// InferenceIndexes are the secondary indexes of the Inferences map
+type InferenceIndexes struct {
+	Requester *InferenceIndex
+	Executor  *InferenceIndex
+	Status    *InferenceIndex
+}
+
+func (i InferenceIndexes) IndexesList() []collections.Index[string, types.Inference] {
+	return []collections.Index[string, types.Inference]{i.Requester, i.Executor, i.Status}
+}
+
+func newInferenceIndexes(sb *collections.SchemaBuilder) InferenceIndexes {
+	return InferenceIndexes{
+		Requester: NewInferenceIndex(sb, types.InferencesByRequesterPrefix, "inferences_by_requester",
+			func(inference types.Inference) []string { return []string{inference.RequestedBy} }),
+		Executor: NewInferenceIndex(sb, types.InferencesByExecutorPrefix, "inferences_by_executor",
+			func(inference types.Inference) []string { return []string{inference.AssignedTo, inference.ExecutedBy} }),
+		Status: NewInferenceIndex(sb, types.InferencesByStatusPrefix, "inferences_by_status",
+			func(inference types.Inference) []string { return []string{inference.Status.String()} }),
+	}
+}
+
+// ReindexInferences rebuilds the secondary indexes of every stored inference. Inferences stored before
+// the indexes existed are only found through them after this ran.
+func (k Keeper) ReindexInferences(ctx context.Context) error {
+	indexes := k.Inferences.Indexes.IndexesList()
+	return k.Inferences.Walk(ctx, nil, func(key string, inference types.Inference) (bool, error) {
+		noOldValue := func() (types.Inference, error) { return types.Inference{}, collections.ErrNotFound }
+		for _, index := range indexes {
+			if err := index.Reference(ctx, key, inference, noOldValue); err != nil {
+				return true, err
+			}
+		}
+		return false, nil
+	})
+}
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// Inference keys are sub-keys a developer lets sign inference requests in its name. Each key has its own
+// limits, checked when the inference starts, and its spending is tracked per epoch.
+
+func inferenceKey(developer string, keyAddress string) (collections.Pair[sdk.AccAddress, sdk.AccAddress], error) {
+	developerAddr, err := sdk.AccAddressFromBech32(developer)
+	if err != nil {
+		return collections.Pair[sdk.AccAddress, sdk.AccAddress]{}, err
+	}
+	keyAddr, err := sdk.AccAddressFromBech32(keyAddress)
+	if err != nil {
+		return collections.Pair[sdk.AccAddress, sdk.AccAddress]{}, err
+	}
+	return collections.Join(developerAddr, keyAddr), nil
+}
+
+func (k Keeper) GetInferenceKeyGrant(ctx context.Context, developer string, keyAddress string) (types.InferenceKeyGrant, bool) {
+	key, err := inferenceKey(developer, keyAddress)
+	if err != nil {
+		return types.InferenceKeyGrant{}, false
+	}
+	grant, err := k.InferenceKeyGrants.Get(ctx, key)
+	if err != nil {
+		return types.InferenceKeyGrant{}, false
+	}
+	return grant, true
+}
+
+func (k Keeper) SetInferenceKeyGrant(ctx context.Context, grant types.InferenceKeyGrant) {
+	key, err := inferenceKey(grant.Developer, grant.KeyAddress)
+	if err != nil {
+		panic(err)
+	}
+	if err := k.InferenceKeyGrants.Set(ctx, key, grant); err != nil {
+		panic(err)
+	}
+}
+
+// RemoveInferenceKeyGrant revokes a key. Its usage is kept so re-granting the key doesn't reset the epoch spend.
+func (k Keeper) RemoveInferenceKeyGrant(ctx context.Context, developer string, keyAddress string) error {
+	key, err := inferenceKey(developer, keyAddress)
+	if err != nil {
+		return err
+	}
+	return k.InferenceKeyGrants.Remove(ctx, key)
+}
+
+func (k Keeper) GetInferenceKeyUsage(ctx context.Context, developer string, keyAddress string) (types.InferenceKeyUsage, bool) {
+	key, err := inferenceKey(developer, keyAddress)
+	if err != nil {
+		return types.InferenceKeyUsage{}, false
+	}
+	usage, err := k.InferenceKeyUsages.Get(ctx, key)
+	if err != nil {
+		return types.InferenceKeyUsage{}, false
+	}
+	return usage, true
+}
+
+func (k Keeper) SetInferenceKeyUsage(ctx context.Context, usage types.InferenceKeyUsage) {
+	key, err := inferenceKey(usage.Developer, usage.KeyAddress)
+	if err != nil {
+		panic(err)
+	}
+	if err := k.InferenceKeyUsages.Set(ctx, key, usage); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) GetAllInferenceKeyGrants(ctx context.Context) []types.InferenceKeyGrant {
+	return k.getInferenceKeyGrants(ctx, nil)
+}
+
+func (k Keeper) GetAllInferenceKeyUsages(ctx context.Context) []types.InferenceKeyUsage {
+	return k.getInferenceKeyUsages(ctx, nil)
+}
+
+// GetInferenceKeys returns the grants and usages of all keys of developer.
+func (k Keeper) GetInferenceKeys(ctx context.Context, developer sdk.AccAddress) ([]types.InferenceKeyGrant, []types.InferenceKeyUsage) {
+	ranger := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](developer)
+	return k.getInferenceKeyGrants(ctx, ranger), k.getInferenceKeyUsages(ctx, ranger)
+}
+
+func (k Keeper) getInferenceKeyGrants(ctx context.Context, ranger collections.Ranger[collections.Pair[sdk.AccAddress, sdk.AccAddress]]) []types.InferenceKeyGrant {
+	iter, err := k.InferenceKeyGrants.Iterate(ctx, ranger)
+	if err != nil {
+		return nil
+	}
+	grants, err := iter.Values()
+	if err != nil {
+		return nil
+	}
+	return grants
+}
+
+func (k Keeper) getInferenceKeyUsages(ctx context.Context, ranger collections.Ranger[collections.Pair[sdk.AccAddress, sdk.AccAddress]]) []types.InferenceKeyUsage {
+	iter, err := k.InferenceKeyUsages.Iterate(ctx, ranger)
+	if err != nil {
+		return nil
+	}
+	usages, err := iter.Values()
+	if err != nil {
+		return nil
+	}
+	return usages
+}
+
+// ChargeInferenceKey checks that inference, signed for its requester by keyAddress, is within the limits of the key
+// and records the escrow it costs against the key's spending for the current epoch. The charge is lowered to the
+// actual cost when the inference finishes and dropped when it expires or is refunded, see ReleaseInferenceKeyCharge.
+func (k Keeper) ChargeInferenceKey(ctx context.Context, inference *types.Inference, keyAddress string, escrow int64) error {
+	developer := inference.RequestedBy
+	grant, found := k.GetInferenceKeyGrant(ctx, developer, keyAddress)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInferenceKeyNotFound, "key %s, developer %s", keyAddress, developer)
+	}
+	if err := grant.CheckRequest(inference.Model, inference.MaxTokens, sdk.UnwrapSDKContext(ctx).BlockTime().Unix()); err != nil {
+		return err
+	}
+
+	epochIndex, _ := k.GetEffectiveEpochIndex(ctx)
+	usage, found := k.GetInferenceKeyUsage(ctx, developer, keyAddress)
+	if !found {
+		usage = types.InferenceKeyUsage{Developer: developer, KeyAddress: keyAddress}
+	}
+	spent := usage.SpentInEpoch(epochIndex)
+	if escrow < 0 {
+		escrow = 0
+	}
+	if grant.EpochSpendLimit != 0 && spent+uint64(escrow) > grant.EpochSpendLimit {
+		return errorsmod.Wrapf(types.ErrInferenceKeySpendLimitExceeded, "spent %d, escrow %d, limit %d", spent, escrow, grant.EpochSpendLimit)
+	}
+
+	usage.EpochIndex = epochIndex
+	usage.EpochSpent = spent + uint64(escrow)
+	usage.TotalSpent += uint64(escrow)
+	usage.InferenceCount++
+	k.SetInferenceKeyUsage(ctx, usage)
+	inference.RequesterKey = keyAddress
+	inference.RequesterKeyEpoch = epochIndex
+	return nil
+}
+
+// ReleaseInferenceKeyCharge takes amount an inference no longer costs off the spending of its requester key.
+// Spending of the epoch the inference was charged in is only lowered while that epoch is still the key's latest.
+func (k Keeper) ReleaseInferenceKeyCharge(ctx context.Context, inference *types.Inference, amount uint64) {
+	if inference.RequesterKey == "" || amount == 0 {
+		return
+	}
+	usage, found := k.GetInferenceKeyUsage(ctx, inference.RequestedBy, inference.RequesterKey)
+	if !found {
+		return
+	}
+	if usage.EpochIndex == inference.RequesterKeyEpoch {
+		usage.EpochSpent -= min(usage.EpochSpent, amount)
+	}
+	usage.TotalSpent -= min(usage.TotalSpent, amount)
+	k.SetInferenceKeyUsage(ctx, usage)
+}
+
+// inferenceKeyCharge is what an inference counts against the spend limit of its requester key: its escrow while
+// running, what was actually paid once finished.
+func inferenceKeyCharge(inference *types.Inference) uint64 {
+	charge := inference.EscrowAmount
+	if inference.FinishedProcessed() {
+		charge = min(charge, inference.ActualCost)
+	}
+	return uint64(max(charge, 0))
+}
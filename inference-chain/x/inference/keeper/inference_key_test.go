@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// startInferenceWithKey starts an inference for the helper's requester, signed by key instead of the requester.
+func startInferenceWithKey(t *testing.T, h *MockInferenceHelper, key *MockAccount, payload string, model string, maxTokens uint64) (string, error) {
+	return signAndStartInference(t, h, key, payload, func(msg *types.MsgStartInference) {
+		msg.RequesterKey = key.address
+		msg.Model = model
+		msg.MaxTokens = maxTokens
+	})
+}
+
+// signAndStartInference starts an inference for the helper's requester signed by signer, after modify adjusted
+// the message.
+func signAndStartInference(t *testing.T, h *MockInferenceHelper, signer *MockAccount, payload string, modify func(msg *types.MsgStartInference)) (string, error) {
+	h.Mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	h.Mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), signer.GetBechAddress()).Return(signer).AnyTimes()
+	h.Mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), h.MockTransferAgent.GetBechAddress()).Return(h.MockTransferAgent).AnyTimes()
+	h.Mocks.AuthzKeeper.EXPECT().GranterGrants(gomock.Any(), gomock.Any()).Return(&authztypes.QueryGranterGrantsResponse{Grants: []*authztypes.GrantAuthorization{}}, nil).AnyTimes()
+
+	components := calculations.SignatureComponents{
+		Payload:         payload,
+		Timestamp:       time.Now().UnixNano(),
+		TransferAddress: h.MockTransferAgent.address,
+		ExecutorAddress: h.MockExecutor.address,
+	}
+	inferenceId, err := calculations.Sign(signer, components, calculations.Developer)
+	require.NoError(t, err)
+	taSignature, err := calculations.Sign(h.MockTransferAgent, components, calculations.TransferAgent)
+	require.NoError(t, err)
+	msg := &types.MsgStartInference{
+		InferenceId:       inferenceId,
+		PromptHash:        "promptHash",
+		PromptPayload:     payload,
+		RequestedBy:       h.MockRequester.address,
+		Creator:           h.MockTransferAgent.address,
+		Model:             MODEL_ID,
+		OriginalPrompt:    payload,
+		RequestTimestamp:  components.Timestamp,
+		TransferSignature: taSignature,
+		AssignedTo:        h.MockExecutor.address,
+	}
+	modify(msg)
+	_, err = h.MessageServer.StartInference(h.context, msg)
+	return inferenceId, err
+}
+
+func TestInferenceKey_EnforcesLimits(t *testing.T) {
+	h, k, ctx := NewMockInferenceHelper(t)
+	key := NewMockAccount(testutil.Validator2)
+
+	_, err := startInferenceWithKey(t, h, key, "not granted", MODEL_ID, 100)
+	require.ErrorIs(t, err, types.ErrInferenceKeyNotFound)
+
+	grant := &types.MsgGrantInferenceKey{
+		Creator:             testutil.Requester,
+		KeyAddress:          key.address,
+		AllowedModels:       []string{MODEL_ID},
+		MaxTokensPerRequest: 100,
+	}
+	_, err = h.MessageServer.GrantInferenceKey(ctx, grant)
+	require.NoError(t, err)
+
+	_, err = startInferenceWithKey(t, h, key, "other model", "other-model", 100)
+	require.ErrorIs(t, err, types.ErrInferenceKeyModelNotAllowed)
+	_, err = startInferenceWithKey(t, h, key, "too many tokens", MODEL_ID, 101)
+	require.ErrorIs(t, err, types.ErrInferenceKeyMaxTokensExceeded)
+
+	inferenceId, err := startInferenceWithKey(t, h, key, "within limits", MODEL_ID, 100)
+	require.NoError(t, err)
+	inference, found := k.GetInference(ctx, inferenceId)
+	require.True(t, found)
+	require.Equal(t, key.address, inference.RequesterKey)
+
+	resp, err := k.InferenceKey(ctx, &types.QueryInferenceKeyRequest{Developer: testutil.Requester, KeyAddress: key.address})
+	require.NoError(t, err)
+	require.Equal(t, uint64(inference.EscrowAmount), resp.Usage.EpochSpent)
+	require.Equal(t, uint64(1), resp.Usage.InferenceCount)
+	require.NotEmpty(t, resp.PubKey)
+
+	// A second inference of the same cost does not fit in the epoch limit
+	grant.EpochSpendLimit = resp.Usage.EpochSpent + resp.Usage.EpochSpent/2
+	_, err = h.MessageServer.GrantInferenceKey(ctx, grant)
+	require.NoError(t, err)
+	_, err = startInferenceWithKey(t, h, key, "over the limit", MODEL_ID, 100)
+	require.ErrorIs(t, err, types.ErrInferenceKeySpendLimitExceeded)
+
+	// Spending of earlier epochs does not count
+	require.NoError(t, setEffectiveEpoch(ctx, k, 1, h.Mocks))
+	_, err = startInferenceWithKey(t, h, key, "next epoch", MODEL_ID, 100)
+	require.NoError(t, err)
+
+	grant.Expiration = ctx.BlockTime().Unix()
+	_, err = h.MessageServer.GrantInferenceKey(ctx, grant)
+	require.NoError(t, err)
+	_, err = startInferenceWithKey(t, h, key, "expired", MODEL_ID, 100)
+	require.ErrorIs(t, err, types.ErrInferenceKeyExpired)
+
+	_, err = h.MessageServer.RevokeInferenceKey(ctx, &types.MsgRevokeInferenceKey{Creator: testutil.Requester, KeyAddress: key.address})
+	require.NoError(t, err)
+	_, err = startInferenceWithKey(t, h, key, "revoked", MODEL_ID, 100)
+	require.ErrorIs(t, err, types.ErrInferenceKeyNotFound)
+
+	keys, err := k.InferenceKeys(ctx, &types.QueryInferenceKeysRequest{Developer: testutil.Requester})
+	require.NoError(t, err)
+	require.Empty(t, keys.Grants)
+	require.Len(t, keys.Usages, 1)
+}
+
+func TestInferenceKey_ReleaseCharge(t *testing.T) {
+	h, k, ctx := NewMockInferenceHelper(t)
+	key := NewMockAccount(testutil.Validator2)
+	grant := &types.MsgGrantInferenceKey{Creator: testutil.Requester, KeyAddress: key.address}
+	_, err := h.MessageServer.GrantInferenceKey(ctx, grant)
+	require.NoError(t, err)
+
+	firstId, err := startInferenceWithKey(t, h, key, "first", MODEL_ID, 100)
+	require.NoError(t, err)
+	first, found := k.GetInference(ctx, firstId)
+	require.True(t, found)
+	escrow := uint64(first.EscrowAmount)
+
+	// The limit fits one running inference
+	grant.EpochSpendLimit = escrow
+	_, err = h.MessageServer.GrantInferenceKey(ctx, grant)
+	require.NoError(t, err)
+	_, err = startInferenceWithKey(t, h, key, "second", MODEL_ID, 100)
+	require.ErrorIs(t, err, types.ErrInferenceKeySpendLimitExceeded)
+
+	// Once the first one expired, its escrow is available to the key again
+	k.ReleaseInferenceKeyCharge(ctx, &first, escrow)
+	usage, found := k.GetInferenceKeyUsage(ctx, testutil.Requester, key.address)
+	require.True(t, found)
+	require.Zero(t, usage.EpochSpent)
+	secondId, err := startInferenceWithKey(t, h, key, "second", MODEL_ID, 100)
+	require.NoError(t, err)
+	second, found := k.GetInference(ctx, secondId)
+	require.True(t, found)
+
+	// A charge released after its epoch ended only lowers the total
+	require.NoError(t, setEffectiveEpoch(ctx, k, 1, h.Mocks))
+	_, err = startInferenceWithKey(t, h, key, "third", MODEL_ID, 100)
+	require.NoError(t, err)
+	k.ReleaseInferenceKeyCharge(ctx, &second, escrow)
+	usage, found = k.GetInferenceKeyUsage(ctx, testutil.Requester, key.address)
+	require.True(t, found)
+	require.Equal(t, uint64(1), usage.EpochIndex)
+	require.Equal(t, escrow, usage.EpochSpent)
+	require.Equal(t, escrow, usage.TotalSpent)
+	require.Equal(t, uint64(3), usage.InferenceCount)
+}
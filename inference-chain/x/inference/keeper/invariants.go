@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// RegisterInvariants registers the inference module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-escrow", ModuleEscrowInvariant(k))
+}
+
+// ModuleEscrowInvariant checks that the inference module account holds at least what it owes: the escrow of
+// started inferences, the unsettled and settled balances of participants, developer credits and dispute bonds.
+// The account is blocked from plain sends, but it keeps the part of each epoch's reward mint that no settle amount
+// takes: the integer division remainder of the WorkCoins-based split and the share of participants whose settle
+// amount failed to compute. That surplus is the only slack allowed, so the balance may run ahead of what is owed
+// but never fall behind it.
+func ModuleEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		owed, err := k.escrowLiabilities(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module-escrow", fmt.Sprintf("failed to sum liabilities: %s", err)), true
+		}
+		balance := k.BankView.SpendableCoins(ctx, authtypes.NewModuleAddress(types.ModuleName)).AmountOf(types.BaseCoin)
+		broken := balance.LT(owed)
+		return sdk.FormatInvariant(types.ModuleName, "module-escrow", fmt.Sprintf(
+			"\tmodule account balance: %s%s\n\tsum of liabilities: %s%s\n\tsurplus: %s%s\n",
+			balance, types.BaseCoin, owed, types.BaseCoin, balance.Sub(owed), types.BaseCoin)), broken
+	}
+}
+
+func (k Keeper) escrowLiabilities(ctx context.Context) (math.Int, error) {
+	owed := math.ZeroInt()
+	err := k.Inferences.Walk(ctx, nil, func(_ string, inference types.Inference) (bool, error) {
+		if inference.Status == types.InferenceStatus_STARTED && !inference.PaidFromCredit && inference.EscrowAmount > 0 {
+			owed = owed.AddRaw(inference.EscrowAmount)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return owed, err
+	}
+	err = k.Participants.Walk(ctx, nil, func(_ sdk.AccAddress, participant types.Participant) (bool, error) {
+		if participant.CoinBalance > 0 {
+			owed = owed.AddRaw(participant.CoinBalance)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return owed, err
+	}
+	for _, settleAmount := range k.GetAllSettleAmount(ctx) {
+		owed = owed.Add(math.NewIntFromUint64(settleAmount.WorkCoins)).Add(math.NewIntFromUint64(settleAmount.RewardCoins))
+	}
+	for _, credit := range k.GetAllDeveloperCredits(ctx) {
+		owed = owed.Add(math.NewIntFromUint64(credit.Available)).
+			Add(math.NewIntFromUint64(credit.Reserved)).
+			Add(math.NewIntFromUint64(credit.PendingWithdrawal))
+	}
+	err = k.InferenceDisputes.Walk(ctx, nil, func(_ string, dispute types.InferenceDispute) (bool, error) {
+		owed = owed.Add(math.NewIntFromUint64(dispute.Bond))
+		return false, nil
+	})
+	return owed, err
+}
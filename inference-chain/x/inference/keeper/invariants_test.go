@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/productscience/inference/testutil"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestModuleEscrowInvariant(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	invariant := keeper.ModuleEscrowInvariant(k)
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+
+	require.NoError(t, k.SetInference(ctx, types.Inference{Index: "started", InferenceId: "started", Status: types.InferenceStatus_STARTED, EscrowAmount: 1_000}))
+	// Escrow drawn from developer credit is counted through the credit
+	require.NoError(t, k.SetInference(ctx, types.Inference{Index: "credit", InferenceId: "credit", Status: types.InferenceStatus_STARTED, EscrowAmount: 500, PaidFromCredit: true}))
+	require.NoError(t, k.SetInference(ctx, types.Inference{Index: "finished", InferenceId: "finished", Status: types.InferenceStatus_FINISHED, EscrowAmount: 700}))
+	require.NoError(t, k.SetParticipant(ctx, types.Participant{Index: testutil.Executor, Address: testutil.Executor, CoinBalance: 300}))
+	k.SetSettleAmount(ctx, types.SettleAmount{Participant: testutil.Executor2, WorkCoins: 200, RewardCoins: 100})
+	k.SetDeveloperCredit(ctx, types.DeveloperCredit{Developer: testutil.Requester, Available: 50, Reserved: 500, PendingWithdrawal: 25})
+	// 1_000 + 300 + 200 + 100 + 50 + 500 + 25
+	owed := int64(2_175)
+
+	mocks.BankViewKeeper.EXPECT().SpendableCoins(gomock.Any(), moduleAddress).Return(sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, owed)))
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	mocks.BankViewKeeper.EXPECT().SpendableCoins(gomock.Any(), moduleAddress).Return(sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, owed+10)))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	mocks.BankViewKeeper.EXPECT().SpendableCoins(gomock.Any(), moduleAddress).Return(sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, owed-1)))
+	msg, broken := invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "2175ngonka")
+}
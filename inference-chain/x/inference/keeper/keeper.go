@@ -32,6 +32,7 @@ type (
 
 		collateralKeeper    types.CollateralKeeper
 		streamvestingKeeper types.StreamVestingKeeper
+		archiveSink         types.ArchiveSink
 		// Collections schema and stores
 		Schema         collections.Schema
 		Participants   collections.Map[sdk.AccAddress, types.Participant]
@@ -43,7 +44,7 @@ type (
 		ModelCapacityMap     collections.Map[string, uint64]
 		// Governance models
 		Models                        collections.Map[string, types.Model]
-		Inferences                    collections.Map[string, types.Inference]
+		Inferences                    *collections.IndexedMap[string, types.Inference, InferenceIndexes]
 		InferenceTimeouts             collections.Map[collections.Pair[uint64, string], types.InferenceTimeout]
 		InferenceValidationDetailsMap collections.Map[collections.Pair[uint64, string], types.InferenceValidationDetails]
 		UnitOfComputePriceProposals   collections.Map[string, types.UnitOfComputePriceProposal]
@@ -61,6 +62,20 @@ type (
 		PruningState              collections.Item[types.PruningState]
 		InferencesToPrune         collections.Map[collections.Pair[int64, string], collections.NoValue]
 		ActiveInvalidations       collections.KeySet[collections.Pair[sdk.AccAddress, string]]
+		EquivocationEvidence      collections.KeySet[collections.Pair[sdk.AccAddress, string]]
+		InferenceDisputes         collections.Map[string, types.InferenceDispute]
+		DisputeDeadlines          collections.KeySet[collections.Pair[int64, string]]
+		DeveloperCredits          collections.Map[sdk.AccAddress, types.DeveloperCredit]
+		CreditWithdrawals         *collections.IndexedMap[collections.Pair[int64, sdk.AccAddress], uint64, CreditWithdrawalIndexes]
+		InferenceKeyGrants        collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], types.InferenceKeyGrant]
+		InferenceKeyUsages        collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], types.InferenceKeyUsage]
+		PriceQuotes               collections.Map[uint64, types.PriceQuote]
+		PriceQuoteSequence        collections.Sequence
+		PriceQuoteExpirations     collections.KeySet[collections.Pair[int64, uint64]]
+		ParticipantExits          collections.Map[sdk.AccAddress, types.ParticipantExit]
+		InferenceUrlChanges       collections.KeySet[collections.Pair[int64, sdk.AccAddress]]
+		ValidatorEpochStatsMap    *collections.IndexedMap[collections.Pair[uint64, sdk.AccAddress], types.ValidatorEpochStats, ValidatorStatsIndexes]
+		ValidatorTargetStatsMap   collections.Map[collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], types.ValidatorTargetStats]
 	}
 )
 
@@ -156,12 +171,13 @@ func NewKeeper(
 			codec.CollValue[types.Model](cdc),
 		),
 		// inferences map
-		Inferences: collections.NewMap(
+		Inferences: collections.NewIndexedMap(
 			sb,
 			types.InferencesPrefix,
 			"inferences",
 			collections.StringKey,
 			codec.CollValue[types.Inference](cdc),
+			newInferenceIndexes(sb),
 		),
 		// unit of compute price proposals map
 		UnitOfComputePriceProposals: collections.NewMap(
@@ -272,6 +288,96 @@ func NewKeeper(
 			"active_invalidations",
 			collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey),
 		),
+		EquivocationEvidence: collections.NewKeySet(
+			sb,
+			types.EquivocationEvidencePrefix,
+			"equivocation_evidence",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey),
+		),
+		InferenceDisputes: collections.NewMap(
+			sb,
+			types.InferenceDisputesPrefix,
+			"inference_disputes",
+			collections.StringKey,
+			codec.CollValue[types.InferenceDispute](cdc),
+		),
+		DisputeDeadlines: collections.NewKeySet(
+			sb,
+			types.DisputeDeadlinesPrefix,
+			"dispute_deadlines",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey),
+		),
+		DeveloperCredits: collections.NewMap(
+			sb,
+			types.DeveloperCreditsPrefix,
+			"developer_credits",
+			sdk.AccAddressKey,
+			codec.CollValue[types.DeveloperCredit](cdc),
+		),
+		CreditWithdrawals: collections.NewIndexedMap(
+			sb,
+			types.CreditWithdrawalsPrefix,
+			"credit_withdrawals",
+			collections.PairKeyCodec(collections.Int64Key, sdk.AccAddressKey),
+			collections.Uint64Value,
+			newCreditWithdrawalIndexes(sb),
+		),
+		InferenceKeyGrants: collections.NewMap(
+			sb,
+			types.InferenceKeyGrantsPrefix,
+			"inference_key_grants",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+			codec.CollValue[types.InferenceKeyGrant](cdc),
+		),
+		InferenceKeyUsages: collections.NewMap(
+			sb,
+			types.InferenceKeyUsagesPrefix,
+			"inference_key_usages",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+			codec.CollValue[types.InferenceKeyUsage](cdc),
+		),
+		PriceQuotes: collections.NewMap(
+			sb,
+			types.PriceQuotesPrefix,
+			"price_quotes",
+			collections.Uint64Key,
+			codec.CollValue[types.PriceQuote](cdc),
+		),
+		PriceQuoteSequence: collections.NewSequence(sb, types.PriceQuoteSequencePrefix, "price_quote_sequence"),
+		PriceQuoteExpirations: collections.NewKeySet(
+			sb,
+			types.PriceQuoteExpirationsPrefix,
+			"price_quote_expirations",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
+		),
+		ParticipantExits: collections.NewMap(
+			sb,
+			types.ParticipantExitsPrefix,
+			"participant_exits",
+			sdk.AccAddressKey,
+			codec.CollValue[types.ParticipantExit](cdc),
+		),
+		InferenceUrlChanges: collections.NewKeySet(
+			sb,
+			types.InferenceUrlChangesPrefix,
+			"inference_url_changes",
+			collections.PairKeyCodec(collections.Int64Key, sdk.AccAddressKey),
+		),
+		ValidatorEpochStatsMap: collections.NewIndexedMap(
+			sb,
+			types.ValidatorStatsPrefix,
+			"validator_stats",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey),
+			codec.CollValue[types.ValidatorEpochStats](cdc),
+			newValidatorStatsIndexes(sb),
+		),
+		ValidatorTargetStatsMap: collections.NewMap(
+			sb,
+			types.ValidatorTargetStatsPrefix,
+			"validator_target_stats",
+			collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, sdk.AccAddressKey),
+			codec.CollValue[types.ValidatorTargetStats](cdc),
+		),
 	}
 	// Build the collections schema
 	schema, err := sb.Build()
@@ -287,6 +393,11 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// SetArchiveSink sets the node-local sink that receives records before pruning deletes them
+func (k *Keeper) SetArchiveSink(sink types.ArchiveSink) {
+	k.archiveSink = sink
+}
+
 // GetWasmKeeper returns the WASM keeper
 func (k Keeper) GetWasmKeeper() wasmkeeper.Keeper {
 	return k.getWasmKeeper()
@@ -28,6 +28,9 @@ func (k msgServer) AssignTrainingTask(goCtx context.Context, msg *types.MsgAssig
 			sdk.NewAttribute("task_id", strconv.FormatUint(msg.TaskId, 10)),
 		),
 	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTrainingTaskAssigned{TaskId: msg.TaskId}); err != nil {
+		k.LogError("MsgAssignTrainingTask: failed to emit EventTrainingTaskAssigned", types.Training, "taskId", msg.TaskId, "error", err)
+	}
 
 	return &types.MsgAssignTrainingTaskResponse{}, nil
 }
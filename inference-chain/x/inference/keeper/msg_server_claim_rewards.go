@@ -208,6 +208,7 @@ func (k msgServer) hasSignificantMissedValidations(ctx sdk.Context, msg *types.M
 			missed++
 		}
 	}
+	k.RecordMissedValidations(ctx, msg.EpochIndex, msg.Creator, total, missed)
 	passed, err := calculations.MissedStatTest(missed, total)
 	k.LogInfo("Missed validations", types.Claims, "missed", missed, "totalToBeValidated", total, "passed", passed)
 
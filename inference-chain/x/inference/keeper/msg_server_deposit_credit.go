@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) DepositCredit(goCtx context.Context, msg *types.MsgDepositCredit) (*types.MsgDepositCreditResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	coins, err := types.GetCoins(int64(msg.Amount))
+	if err != nil {
+		return nil, err
+	}
+	err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, sdk.MustAccAddressFromBech32(msg.Creator), types.ModuleName, coins, "credit_deposit")
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, types.ErrRequesterCannotPay.Error())
+	}
+
+	credit, found := k.GetDeveloperCredit(ctx, msg.Creator)
+	if !found {
+		credit = types.DeveloperCredit{Developer: msg.Creator}
+	}
+	credit.Available += msg.Amount
+	k.SetDeveloperCredit(ctx, credit)
+	k.LogInfo("Developer credit deposited", types.Payments, "developer", msg.Creator, "amount", msg.Amount, "available", credit.Available)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent("credit_deposit",
+		sdk.NewAttribute("developer", msg.Creator),
+		sdk.NewAttribute("amount", strconv.FormatUint(msg.Amount, 10)),
+	))
+	return &types.MsgDepositCreditResponse{Available: credit.Available}, nil
+}
@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"slices"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// DisputeInference lets the developer who paid for an inference challenge it. The developer escrows a bond and
+// K randomly chosen validators re-run the inference, whatever the regular validation sampling decided.
+// The invalidation/revalidation proposals of the epoch group are opened as for a failed validation, so the
+// dispute ends when the group decides, when a majority of the chosen validators agree, or when it times out.
+func (k msgServer) DisputeInference(goCtx context.Context, msg *types.MsgDisputeInference) (*types.MsgDisputeInferenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx).ValidationParams
+	if params.DisputeValidators == 0 || params.DisputeTimeoutBlocks == 0 {
+		return nil, types.ErrDisputesDisabled
+	}
+
+	inference, found := k.GetInference(ctx, msg.InferenceId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInferenceNotFound, "inference with id %s not found", msg.InferenceId)
+	}
+	if inference.RequestedBy != msg.Creator {
+		return nil, types.ErrNotInferenceRequester
+	}
+	if inference.Status != types.InferenceStatus_FINISHED && inference.Status != types.InferenceStatus_VALIDATED {
+		return nil, errorsmod.Wrapf(types.ErrInferenceNotDisputable, "status %s", inference.Status)
+	}
+	if ctx.BlockHeight()-inference.EndBlockHeight > params.DisputeWindowBlocks {
+		return nil, errorsmod.Wrapf(types.ErrDisputeWindowClosed, "inference finished at height %d", inference.EndBlockHeight)
+	}
+	if has, err := k.InferenceDisputes.Has(ctx, msg.InferenceId); err != nil {
+		return nil, err
+	} else if has {
+		return nil, types.ErrDisputeAlreadyOpen
+	}
+
+	epochGroup, err := k.GetCurrentEpochGroup(ctx)
+	if err != nil {
+		k.LogError("Failed to get current epoch group", types.Validation, "error", err)
+		return nil, err
+	}
+	// Refunds are only possible while the executor's earnings have not been claimed
+	if beforeClaims, reason := k.inferenceIsBeforeClaimsSet(ctx, inference, epochGroup); !beforeClaims {
+		return nil, errorsmod.Wrap(types.ErrDisputeWindowClosed, reason)
+	}
+
+	excluded := []string{inference.ExecutedBy, inference.RequestedBy}
+	validators, err := epochGroup.GetRandomMembersForModel(ctx, inference.Model, int(params.DisputeValidators), disputeSeed(ctx, inference.InferenceId),
+		func(members []*group.GroupMember) []*group.GroupMember {
+			return slices.DeleteFunc(members, func(member *group.GroupMember) bool {
+				return slices.Contains(excluded, member.Member.Address)
+			})
+		})
+	if err != nil {
+		k.LogError("Failed to select dispute validators", types.Validation, "inferenceId", inference.InferenceId, "error", err)
+		return nil, err
+	}
+	if len(validators) == 0 {
+		return nil, types.ErrNotEnoughDisputeValidators
+	}
+
+	if err := k.escrowDisputeBond(ctx, msg.Creator, params.DisputeBond, inference.InferenceId); err != nil {
+		return nil, err
+	}
+
+	proposalDetails, err := epochGroup.StartValidationVote(ctx, &inference, validators[0])
+	if err != nil {
+		return nil, err
+	}
+	inference.Status = types.InferenceStatus_VOTING
+	inference.ProposalDetails = proposalDetails
+	if err := k.SetInference(ctx, inference); err != nil {
+		return nil, err
+	}
+
+	dispute := types.InferenceDispute{
+		InferenceId:         inference.InferenceId,
+		Developer:           msg.Creator,
+		Executor:            inference.ExecutedBy,
+		Bond:                params.DisputeBond,
+		Validators:          validators,
+		CreatedBlockHeight:  ctx.BlockHeight(),
+		DeadlineBlockHeight: ctx.BlockHeight() + params.DisputeTimeoutBlocks,
+	}
+	if err := k.InferenceDisputes.Set(ctx, dispute.InferenceId, dispute); err != nil {
+		return nil, err
+	}
+	if err := k.DisputeDeadlines.Set(ctx, collections.Join(dispute.DeadlineBlockHeight, dispute.InferenceId)); err != nil {
+		return nil, err
+	}
+	k.LogInfo("Inference disputed", types.Validation, "inferenceId", inference.InferenceId, "developer", msg.Creator, "validators", validators)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventInferenceDisputed{
+		InferenceId: inference.InferenceId,
+		Developer:   msg.Creator,
+		Executor:    inference.ExecutedBy,
+		Bond:        params.DisputeBond,
+		Validators:  validators,
+	})
+	if err != nil {
+		k.LogError("Failed to emit EventInferenceDisputed", types.Validation, "inferenceId", inference.InferenceId, "error", err)
+	}
+
+	return &types.MsgDisputeInferenceResponse{Validators: validators}, nil
+}
+
+func (k msgServer) escrowDisputeBond(ctx context.Context, developer string, bond uint64, inferenceId string) error {
+	if bond == 0 {
+		return nil
+	}
+	coins, err := types.GetCoins(int64(bond))
+	if err != nil {
+		return err
+	}
+	err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, sdk.MustAccAddressFromBech32(developer), types.ModuleName, coins, "dispute_bond:"+inferenceId)
+	if err != nil {
+		return errorsmod.Wrapf(err, types.ErrRequesterCannotPay.Error())
+	}
+	return nil
+}
+
+// disputeSeed derives the validator selection seed from the block and the inference, so every node picks the same set.
+func disputeSeed(ctx sdk.Context, inferenceId string) int64 {
+	hash := sha256.Sum256(append(ctx.HeaderHash(), []byte(inferenceId)...))
+	return int64(binary.BigEndian.Uint64(hash[:8]))
+}
+
+// recordDisputeVote counts a revalidation by one of the validators chosen for a dispute and decides the inference
+// once a majority of them agree. The decision goes through the same handlers the group proposals execute, which
+// ignore whichever proposal comes after it.
+func (k msgServer) recordDisputeVote(ctx context.Context, inference types.Inference, voter string, passed bool) error {
+	dispute, err := k.InferenceDisputes.Get(ctx, inference.InferenceId)
+	if errors.Is(err, collections.ErrNotFound) {
+		// Not disputed, or already resolved
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !slices.Contains(dispute.Validators, voter) ||
+		slices.Contains(dispute.PassedVotes, voter) || slices.Contains(dispute.FailedVotes, voter) {
+		return nil
+	}
+	if passed {
+		dispute.PassedVotes = append(dispute.PassedVotes, voter)
+	} else {
+		dispute.FailedVotes = append(dispute.FailedVotes, voter)
+	}
+	if err := k.InferenceDisputes.Set(ctx, dispute.InferenceId, dispute); err != nil {
+		return err
+	}
+
+	majority := len(dispute.Validators)/2 + 1
+	switch {
+	case len(dispute.FailedVotes) >= majority:
+		_, err = k.InvalidateInference(ctx, &types.MsgInvalidateInference{
+			InferenceId: inference.InferenceId,
+			Creator:     inference.ProposalDetails.PolicyAddress,
+			Invalidator: dispute.Validators[0],
+		})
+	case len(dispute.PassedVotes) >= majority:
+		_, err = k.RevalidateInference(ctx, &types.MsgRevalidateInference{
+			InferenceId: inference.InferenceId,
+			Creator:     inference.ProposalDetails.PolicyAddress,
+			Invalidator: dispute.Validators[0],
+		})
+	}
+	return err
+}
+
+// settleDispute pays out the bond of an open dispute once the inference has been decided: back to the developer
+// if the inference was invalidated, to the executor otherwise. It reports whether the inference was disputed.
+func (k msgServer) settleDispute(ctx context.Context, inferenceId string, invalidated bool) bool {
+	dispute, err := k.InferenceDisputes.Get(ctx, inferenceId)
+	if err != nil {
+		return false
+	}
+	k.removeDispute(ctx, dispute)
+
+	recipient, memo := dispute.Executor, "dispute_bond_forfeited:"+inferenceId
+	if invalidated {
+		recipient, memo = dispute.Developer, "dispute_bond_returned:"+inferenceId
+	}
+	if err := k.PayParticipantFromEscrow(ctx, recipient, int64(dispute.Bond), memo, nil); err != nil {
+		k.LogError("Failed to pay out dispute bond", types.Validation, "inferenceId", inferenceId, "recipient", recipient, "error", err)
+	}
+	k.LogInfo("Dispute resolved", types.Validation, "inferenceId", inferenceId, "upheld", invalidated, "bondRecipient", recipient)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("inference_dispute_resolved",
+		sdk.NewAttribute("inference_id", inferenceId),
+		sdk.NewAttribute("upheld", strconv.FormatBool(invalidated)),
+		sdk.NewAttribute("bond_recipient", recipient),
+	))
+	return true
+}
+
+// ExpireDisputes closes the disputes nobody decided before their deadline and returns the bond to the developer.
+// The inference itself stays with the epoch group vote.
+func (k Keeper) ExpireDisputes(ctx context.Context, height int64) {
+	iter, err := k.DisputeDeadlines.Iterate(ctx, collections.NewPrefixUntilPairRange[int64, string](height))
+	if err != nil {
+		k.LogError("Failed to iterate dispute deadlines", types.Validation, "error", err)
+		return
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		k.LogError("Failed to read dispute deadlines", types.Validation, "error", err)
+		return
+	}
+	for _, key := range keys {
+		inferenceId := key.K2()
+		dispute, err := k.InferenceDisputes.Get(ctx, inferenceId)
+		if err != nil {
+			k.LogError("Expired dispute not found", types.Validation, "inferenceId", inferenceId, "error", err)
+			if err := k.DisputeDeadlines.Remove(ctx, key); err != nil {
+				k.LogError("Failed to remove dispute deadline", types.Validation, "inferenceId", inferenceId, "error", err)
+			}
+			continue
+		}
+		k.removeDispute(ctx, dispute)
+
+		if err := k.PayParticipantFromEscrow(ctx, dispute.Developer, int64(dispute.Bond), "dispute_bond_refunded:"+inferenceId, nil); err != nil {
+			k.LogError("Failed to refund dispute bond", types.Validation, "inferenceId", inferenceId, "developer", dispute.Developer, "error", err)
+		}
+		k.LogInfo("Dispute expired", types.Validation, "inferenceId", inferenceId, "passedVotes", len(dispute.PassedVotes), "failedVotes", len(dispute.FailedVotes))
+
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("inference_dispute_expired",
+			sdk.NewAttribute("inference_id", inferenceId),
+			sdk.NewAttribute("bond_recipient", dispute.Developer),
+		))
+	}
+}
+
+func (k Keeper) removeDispute(ctx context.Context, dispute types.InferenceDispute) {
+	if err := k.InferenceDisputes.Remove(ctx, dispute.InferenceId); err != nil {
+		k.LogError("Failed to remove dispute", types.Validation, "inferenceId", dispute.InferenceId, "error", err)
+	}
+	if err := k.DisputeDeadlines.Remove(ctx, collections.Join(dispute.DeadlineBlockHeight, dispute.InferenceId)); err != nil {
+		k.LogError("Failed to remove dispute deadline", types.Validation, "inferenceId", dispute.InferenceId, "error", err)
+	}
+}
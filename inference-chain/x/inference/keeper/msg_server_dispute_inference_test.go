@@ -0,0 +1,240 @@
+package keeper_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const disputeBond = 1_000
+
+// setupDisputedInference finishes an inference by Executor for Requester and prepares Validator and Validator2
+// as the only members eligible to re-run it.
+func setupDisputedInference(t *testing.T) (*MockInferenceHelper, keeper.Keeper, sdk.Context, *types.Inference) {
+	inferenceHelper, k, ctx := NewMockInferenceHelper(t)
+	ms := inferenceHelper.MessageServer
+	MustAddParticipant(t, ms, ctx, *NewMockAccount(testutil.Validator))
+	MustAddParticipant(t, ms, ctx, *NewMockAccount(testutil.Validator2))
+
+	params := k.GetParams(ctx)
+	params.ValidationParams.DisputeBond = disputeBond
+	params.ValidationParams.DisputeValidators = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	model := &types.Model{Id: MODEL_ID, ValidationThreshold: &types.Decimal{Value: 85, Exponent: -2}}
+	k.SetModel(ctx, model)
+	StubModelSubgroup(t, ctx, k, inferenceHelper.Mocks, model)
+	groupData, _ := k.GetEpochGroupData(ctx, 0, MODEL_ID)
+	groupData.ValidationWeights = []*types.ValidationWeight{
+		{MemberAddress: testutil.Validator, Weight: 100, Reputation: 100},
+		{MemberAddress: testutil.Validator2, Weight: 100, Reputation: 100},
+		{MemberAddress: testutil.Executor, Weight: 100, Reputation: 100},
+	}
+	groupData.TotalWeight = 300
+	k.SetEpochGroupData(ctx, groupData)
+
+	_, err := inferenceHelper.StartInference("promptPayload", model.Id, time.Now().UnixNano(), calculations.DefaultMaxTokens)
+	require.NoError(t, err)
+	finished, err := inferenceHelper.FinishInference()
+	require.NoError(t, err)
+
+	mocks := inferenceHelper.Mocks
+	mocks.GroupKeeper.EXPECT().GroupMembers(gomock.Any(), gomock.Any()).Return(&group.QueryGroupMembersResponse{
+		Members: []*group.GroupMember{
+			{Member: &group.Member{Address: testutil.Executor, Weight: "100"}},
+			{Member: &group.Member{Address: testutil.Validator, Weight: "100"}},
+			{Member: &group.Member{Address: testutil.Validator2, Weight: "100"}},
+		},
+	}, nil).AnyTimes()
+	mocks.GroupKeeper.EXPECT().SubmitProposal(gomock.Any(), gomock.Any()).Return(&group.MsgSubmitProposalResponse{ProposalId: 1}, nil)
+	mocks.GroupKeeper.EXPECT().SubmitProposal(gomock.Any(), gomock.Any()).Return(&group.MsgSubmitProposalResponse{ProposalId: 2}, nil)
+	mocks.GroupKeeper.EXPECT().Vote(gomock.Any(), gomock.Any()).Return(&group.MsgVoteResponse{}, nil).AnyTimes()
+	mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.MustAccAddressFromBech32(testutil.Requester), types.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, disputeBond)), "dispute_bond:"+finished.InferenceId).Return(nil)
+
+	resp, err := ms.DisputeInference(ctx, &types.MsgDisputeInference{Creator: testutil.Requester, InferenceId: finished.InferenceId})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{testutil.Validator, testutil.Validator2}, resp.Validators)
+	require.True(t, slices.ContainsFunc(ctx.EventManager().Events(), func(event sdk.Event) bool {
+		return event.Type == "inference.inference.EventInferenceDisputed"
+	}))
+
+	inference, found := k.GetInference(ctx, finished.InferenceId)
+	require.True(t, found)
+	require.Equal(t, types.InferenceStatus_VOTING, inference.Status)
+	return inferenceHelper, k, ctx, &inference
+}
+
+func revalidate(t *testing.T, ms types.MsgServer, ctx sdk.Context, inferenceId string, validator string, value float64) {
+	_, err := ms.Validation(ctx, &types.MsgValidation{
+		InferenceId:  inferenceId,
+		Creator:      validator,
+		Value:        value,
+		Revalidation: true,
+	})
+	require.NoError(t, err)
+}
+
+func TestMsgServer_DisputeInference_Upheld(t *testing.T) {
+	inferenceHelper, k, ctx, inference := setupDisputedInference(t)
+	ms := inferenceHelper.MessageServer
+
+	revalidate(t, ms, ctx, inference.InferenceId, testutil.Validator, 0.5)
+	dispute, err := k.InferenceDisputes.Get(ctx, inference.InferenceId)
+	require.NoError(t, err)
+	require.Equal(t, []string{testutil.Validator}, dispute.FailedVotes)
+
+	// The refund and the bond both go back to the developer
+	requester := sdk.MustAccAddressFromBech32(testutil.Requester)
+	inferenceHelper.Mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, requester,
+		sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, inference.ActualCost)), "invalidated_inference:"+inference.InferenceId).Return(nil)
+	inferenceHelper.Mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, requester,
+		sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, disputeBond)), "dispute_bond_returned:"+inference.InferenceId).Return(nil)
+	revalidate(t, ms, ctx, inference.InferenceId, testutil.Validator2, 0.5)
+
+	updated, found := k.GetInference(ctx, inference.InferenceId)
+	require.True(t, found)
+	require.Equal(t, types.InferenceStatus_INVALIDATED, updated.Status)
+	has, err := k.InferenceDisputes.Has(ctx, inference.InferenceId)
+	require.NoError(t, err)
+	require.False(t, has)
+	// The decision belongs to the dispute, not to the validator listed first on it
+	stats, err := k.GetValidatorEpochStats(ctx, updated.EpochId, sdk.MustAccAddressFromBech32(testutil.Validator))
+	require.NoError(t, err)
+	require.Zero(t, stats.UpheldInvalidations)
+
+	// The revalidation proposal of the group executing later doesn't overturn the decision
+	_, err = ms.RevalidateInference(ctx, &types.MsgRevalidateInference{
+		InferenceId: inference.InferenceId,
+		Creator:     updated.ProposalDetails.PolicyAddress,
+		Invalidator: testutil.Validator,
+	})
+	require.NoError(t, err)
+	updated, found = k.GetInference(ctx, inference.InferenceId)
+	require.True(t, found)
+	require.Equal(t, types.InferenceStatus_INVALIDATED, updated.Status)
+}
+
+func TestMsgServer_DisputeInference_UpheldAfterClaims(t *testing.T) {
+	inferenceHelper, k, ctx, inference := setupDisputedInference(t)
+	ms := inferenceHelper.MessageServer
+	executorBefore, found := k.GetParticipant(ctx, testutil.Executor)
+	require.True(t, found)
+
+	// Claims for the epoch are set while the dispute is still open
+	effectiveEpochIndex, found := k.GetEffectiveEpochIndex(ctx)
+	require.True(t, found)
+	k.SetEpoch(ctx, &types.Epoch{Index: effectiveEpochIndex + 1})
+	require.NoError(t, k.SetActiveParticipants(ctx, types.ActiveParticipants{EpochId: effectiveEpochIndex + 1}))
+
+	// The executor has already been paid, so only the bond goes back to the developer
+	revalidate(t, ms, ctx, inference.InferenceId, testutil.Validator, 0.5)
+	inferenceHelper.Mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(testutil.Requester),
+		sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, disputeBond)), "dispute_bond_returned:"+inference.InferenceId).Return(nil)
+	revalidate(t, ms, ctx, inference.InferenceId, testutil.Validator2, 0.5)
+
+	updated, found := k.GetInference(ctx, inference.InferenceId)
+	require.True(t, found)
+	require.Equal(t, types.InferenceStatus_INVALIDATED, updated.Status)
+	executor, found := k.GetParticipant(ctx, testutil.Executor)
+	require.True(t, found)
+	require.Equal(t, executorBefore.CoinBalance, executor.CoinBalance)
+}
+
+func TestMsgServer_DisputeInference_Expires(t *testing.T) {
+	inferenceHelper, k, ctx, inference := setupDisputedInference(t)
+	ms := inferenceHelper.MessageServer
+
+	revalidate(t, ms, ctx, inference.InferenceId, testutil.Validator, 0.5)
+	dispute, err := k.InferenceDisputes.Get(ctx, inference.InferenceId)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight()+k.GetParams(ctx).ValidationParams.DisputeTimeoutBlocks, dispute.DeadlineBlockHeight)
+
+	k.ExpireDisputes(ctx, dispute.DeadlineBlockHeight-1)
+	has, err := k.InferenceDisputes.Has(ctx, inference.InferenceId)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	inferenceHelper.Mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(testutil.Requester),
+		sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, disputeBond)), "dispute_bond_refunded:"+inference.InferenceId).Return(nil)
+	k.ExpireDisputes(ctx, dispute.DeadlineBlockHeight)
+	has, err = k.InferenceDisputes.Has(ctx, inference.InferenceId)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = k.DisputeDeadlines.Has(ctx, collections.Join(dispute.DeadlineBlockHeight, inference.InferenceId))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// The inference is left to the group vote
+	updated, found := k.GetInference(ctx, inference.InferenceId)
+	require.True(t, found)
+	require.Equal(t, types.InferenceStatus_VOTING, updated.Status)
+}
+
+func TestMsgServer_DisputeInference_Rejected(t *testing.T) {
+	inferenceHelper, k, ctx, inference := setupDisputedInference(t)
+	ms := inferenceHelper.MessageServer
+
+	// Votes of members not chosen for the dispute don't count towards it
+	revalidate(t, ms, ctx, inference.InferenceId, testutil.Executor, 0.99)
+	dispute, err := k.InferenceDisputes.Get(ctx, inference.InferenceId)
+	require.NoError(t, err)
+	require.Empty(t, dispute.PassedVotes)
+
+	revalidate(t, ms, ctx, inference.InferenceId, testutil.Validator, 0.99)
+	inferenceHelper.Mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(testutil.Executor),
+		sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, disputeBond)), "dispute_bond_forfeited:"+inference.InferenceId).Return(nil)
+	revalidate(t, ms, ctx, inference.InferenceId, testutil.Validator2, 0.99)
+
+	updated, found := k.GetInference(ctx, inference.InferenceId)
+	require.True(t, found)
+	require.Equal(t, types.InferenceStatus_VALIDATED, updated.Status)
+	has, err := k.InferenceDisputes.Has(ctx, inference.InferenceId)
+	require.NoError(t, err)
+	require.False(t, has)
+	stats, err := k.GetValidatorEpochStats(ctx, updated.EpochId, sdk.MustAccAddressFromBech32(testutil.Validator))
+	require.NoError(t, err)
+	require.Zero(t, stats.OverturnedInvalidations)
+}
+
+func TestMsgServer_DisputeInference_Rejections(t *testing.T) {
+	inferenceHelper, k, ctx, inference := setupDisputedInference(t)
+	ms := inferenceHelper.MessageServer
+
+	_, err := ms.DisputeInference(ctx, &types.MsgDisputeInference{Creator: testutil.Requester, InferenceId: inference.InferenceId})
+	require.ErrorIs(t, err, types.ErrInferenceNotDisputable)
+
+	inference.Status = types.InferenceStatus_FINISHED
+	require.NoError(t, k.SetInference(ctx, *inference))
+	_, err = ms.DisputeInference(ctx, &types.MsgDisputeInference{Creator: testutil.Requester, InferenceId: inference.InferenceId})
+	require.ErrorIs(t, err, types.ErrDisputeAlreadyOpen)
+
+	_, err = ms.DisputeInference(ctx, &types.MsgDisputeInference{Creator: testutil.Executor, InferenceId: inference.InferenceId})
+	require.ErrorIs(t, err, types.ErrNotInferenceRequester)
+
+	window := k.GetParams(ctx).ValidationParams.DisputeWindowBlocks
+	late := ctx.WithBlockHeight(inference.EndBlockHeight + window + 1)
+	_, err = ms.DisputeInference(late, &types.MsgDisputeInference{Creator: testutil.Requester, InferenceId: inference.InferenceId})
+	require.ErrorIs(t, err, types.ErrDisputeWindowClosed)
+
+	params := k.GetParams(ctx)
+	params.ValidationParams.DisputeTimeoutBlocks = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = ms.DisputeInference(ctx, &types.MsgDisputeInference{Creator: testutil.Requester, InferenceId: inference.InferenceId})
+	require.ErrorIs(t, err, types.ErrDisputesDisabled)
+
+	params.ValidationParams.DisputeValidators = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = ms.DisputeInference(ctx, &types.MsgDisputeInference{Creator: testutil.Requester, InferenceId: inference.InferenceId})
+	require.ErrorIs(t, err, types.ErrDisputesDisabled)
+}
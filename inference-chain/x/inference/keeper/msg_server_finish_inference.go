@@ -54,6 +54,11 @@ func (k msgServer) FinishInference(goCtx context.Context, msg *types.MsgFinishIn
 		return nil, sdkerrors.Wrap(types.ErrInferenceExpired, "inference has already expired")
 	}
 
+	if err := k.checkFinishRequesterKey(ctx, msg, existingInference); err != nil {
+		k.LogError("FinishInference: invalid requester key", types.Inferences, "inferenceId", msg.InferenceId, "requesterKey", msg.RequesterKey, "error", err)
+		return nil, err
+	}
+
 	// Record the current price only if this is the first message (StartInference not processed yet)
 	// This ensures consistent pricing regardless of message arrival order
 	if !existingInference.StartProcessed() {
@@ -75,7 +80,25 @@ func (k msgServer) FinishInference(goCtx context.Context, msg *types.MsgFinishIn
 		BlockTimestamp: ctx.BlockTime().UnixMilli(),
 	}
 
+	// The tokens a started inference reserved from its price quote and the escrow it charged to its requester key,
+	// before the finish replaces them with the actual ones
+	quoteTokensReserved := inferenceQuoteTokens(&existingInference)
+	keyCharge := inferenceKeyCharge(&existingInference)
+	if existingInference.StartProcessed() && existingInference.PaidFromCredit {
+		// The reservation is spent now, anything above the actual cost is refunded with the payments
+		k.SettleDeveloperCredit(ctx, existingInference.RequestedBy, existingInference.EscrowAmount)
+	}
 	inference, payments := calculations.ProcessFinishInference(&existingInference, msg, blockContext, k)
+	if inference.StartProcessed() && inference.PriceQuoteId != 0 {
+		if used := inferenceQuoteTokens(inference); used < quoteTokensReserved {
+			k.ReleasePriceQuoteTokens(ctx, inference.PriceQuoteId, quoteTokensReserved-used)
+		}
+	}
+	if inference.StartProcessed() {
+		if cost := inferenceKeyCharge(inference); cost < keyCharge {
+			k.ReleaseInferenceKeyCharge(ctx, inference, keyCharge-cost)
+		}
+	}
 
 	finalInference, err := k.processInferencePayments(ctx, inference, payments)
 	if err != nil {
@@ -95,6 +118,25 @@ func (k msgServer) FinishInference(goCtx context.Context, msg *types.MsgFinishIn
 	return &types.MsgFinishInferenceResponse{}, nil
 }
 
+// checkFinishRequesterKey makes sure the finish was signed for the requester by the same key as the start, or by
+// a key the requester granted if the start did not arrive yet. Limits of the key are only enforced on start, when
+// the inference is paid for.
+func (k msgServer) checkFinishRequesterKey(ctx sdk.Context, msg *types.MsgFinishInference, existingInference types.Inference) error {
+	if existingInference.StartProcessed() {
+		if existingInference.RequesterKey != msg.RequesterKey {
+			return sdkerrors.Wrapf(types.ErrInvalidSignature, "requester key %s does not match the started inference", msg.RequesterKey)
+		}
+		return nil
+	}
+	if msg.RequesterKey == "" {
+		return nil
+	}
+	if _, found := k.GetInferenceKeyGrant(ctx, msg.RequestedBy, msg.RequesterKey); !found {
+		return sdkerrors.Wrapf(types.ErrInferenceKeyNotFound, "key %s, developer %s", msg.RequesterKey, msg.RequestedBy)
+	}
+	return nil
+}
+
 func (k msgServer) verifyFinishKeys(ctx sdk.Context, msg *types.MsgFinishInference, transferAgent *types.Participant, requestor *types.Participant, executor *types.Participant) error {
 	components := getFinishSignatureComponents(msg)
 	// The extra seconds here need to be high enough to account for a very long inference.
@@ -111,6 +153,7 @@ func (k msgServer) verifyFinishKeys(ctx sdk.Context, msg *types.MsgFinishInferen
 		TransferSignature: msg.TransferSignature,
 		ExecutorSignature: msg.ExecutorSignature,
 		Dev:               requestor,
+		DevKey:            msg.RequesterKey,
 		TransferAgent:     transferAgent,
 		Executor:          executor,
 	}
@@ -141,6 +184,9 @@ func (k msgServer) handleInferenceCompleted(ctx sdk.Context, existingInference *
 			sdk.NewAttribute("inference_id", existingInference.InferenceId),
 		),
 	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventInferenceFinished{InferenceId: existingInference.InferenceId}); err != nil {
+		k.LogError("Failed to emit EventInferenceFinished", types.Inferences, "inferenceId", existingInference.InferenceId, "error", err)
+	}
 
 	executedBy := existingInference.ExecutedBy
 	executor, found := k.GetParticipant(ctx, executedBy)
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// GrantInferenceKey creates or replaces the limits of an inference key of the creator.
+func (k msgServer) GrantInferenceKey(goCtx context.Context, msg *types.MsgGrantInferenceKey) (*types.MsgGrantInferenceKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grant := msg.ToGrant()
+	k.SetInferenceKeyGrant(ctx, grant)
+	k.LogInfo("Inference key granted", types.Inferences, "developer", grant.Developer, "key", grant.KeyAddress,
+		"epochSpendLimit", grant.EpochSpendLimit, "allowedModels", grant.AllowedModels, "expiration", grant.Expiration,
+		"maxTokensPerRequest", grant.MaxTokensPerRequest)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent("inference_key_granted",
+		sdk.NewAttribute("developer", grant.Developer),
+		sdk.NewAttribute("key_address", grant.KeyAddress),
+	))
+	return &types.MsgGrantInferenceKeyResponse{}, nil
+}
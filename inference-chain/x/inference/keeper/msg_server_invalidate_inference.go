@@ -14,9 +14,10 @@ func (k msgServer) InvalidateInference(ctx context.Context, msg *types.MsgInvali
 	if err != nil {
 		return nil, err
 	}
-	// Idempotent, so no error
-	if inference.Status == types.InferenceStatus_INVALIDATED {
-		k.LogDebug("Inference already invalidated", types.Validation, "inferenceId", msg.InferenceId)
+	// The invalidation and revalidation proposals race each other and a dispute majority may decide first:
+	// the first decision wins and the ones after it are ignored
+	if inference.Status == types.InferenceStatus_INVALIDATED || inference.Status == types.InferenceStatus_VALIDATED {
+		k.LogDebug("Inference already decided", types.Validation, "inferenceId", msg.InferenceId, "status", inference.Status)
 		return nil, nil
 	}
 	inference.Status = types.InferenceStatus_INVALIDATED
@@ -54,6 +55,11 @@ func (k msgServer) InvalidateInference(ctx context.Context, msg *types.MsgInvali
 	if err != nil {
 		return nil, err
 	}
+	// A disputed inference is decided by the dispute's validators, not by whoever flagged it
+	if !k.settleDispute(ctx, inference.InferenceId, true) {
+		k.RecordInvalidationOutcome(ctx, *inference, msg.Invalidator, true)
+	}
+	k.EmitInferenceStatus(ctx, inference)
 
 	return &types.MsgInvalidateInferenceResponse{}, nil
 }
@@ -63,15 +69,17 @@ func (k msgServer) refundInvalidatedInference(executor *types.Participant, infer
 	k.SafeLogSubAccountTransaction(ctx, types.ModuleName, executor.Address, types.OwedSubAccount, inference.ActualCost, "inference_invalidated:"+inference.InferenceId)
 	k.LogInfo("Invalid Inference subtracted from Executor CoinBalance ", types.Balances, "inferenceId", inference.InferenceId, "executor", executor.Address, "actualCost", inference.ActualCost, "coinBalance", executor.CoinBalance)
 	// We need to refund the cost, so we have to lookup the person who paid
-	payer, found := k.GetParticipant(ctx, inference.RequestedBy)
+	_, found := k.GetParticipant(ctx, inference.RequestedBy)
 	if !found {
 		k.LogError("Payer not found", types.Validation, "address", inference.RequestedBy)
 		return types.ErrParticipantNotFound
 	}
-	err := k.IssueRefund(ctx, inference.ActualCost, payer.Address, "invalidated_inference:"+inference.InferenceId)
+	err := k.RefundInference(ctx, inference, inference.ActualCost, "invalidated_inference:"+inference.InferenceId)
 	if err != nil {
 		k.LogError("Refund failed", types.Validation, "error", err)
 	}
+	// What the developer got back no longer counts against the key it signed with
+	k.ReleaseInferenceKeyCharge(ctx, inference, inferenceKeyCharge(inference))
 	return nil
 }
 
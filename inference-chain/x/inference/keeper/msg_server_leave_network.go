@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// LeaveNetwork schedules the exit of a participant. It serves the epoch it is in, or the upcoming one if that has
+// already been formed with it, and is left out of the epoch after.
+func (k msgServer) LeaveNetwork(goCtx context.Context, msg *types.MsgLeaveNetwork) (*types.MsgLeaveNetworkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetParticipant(ctx, msg.Creator); !found {
+		return nil, types.ErrParticipantNotFound
+	}
+	if exit, found := k.GetParticipantExit(ctx, msg.Creator); found && !exit.Completed {
+		return nil, types.ErrParticipantAlreadyLeaving
+	}
+
+	exitEpoch, found := k.GetEffectiveEpochIndex(ctx)
+	if !found {
+		return nil, types.ErrEffectiveEpochNotFound
+	}
+	if upcoming, found := k.GetActiveParticipants(ctx, exitEpoch+1); found {
+		if slices.ContainsFunc(upcoming.Participants, func(p *types.ActiveParticipant) bool { return p.Index == msg.Creator }) {
+			exitEpoch++
+		}
+	}
+
+	k.SetParticipantExit(ctx, types.ParticipantExit{
+		Participant:     msg.Creator,
+		ExitEpoch:       exitEpoch,
+		RequestedHeight: ctx.BlockHeight(),
+	})
+	k.LogInfo("Participant leaving the network", types.Participants, "participant", msg.Creator, "exitEpoch", exitEpoch)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent("participant_exit",
+		sdk.NewAttribute("participant", msg.Creator),
+		sdk.NewAttribute("exit_epoch", strconv.FormatUint(exitEpoch, 10)),
+	))
+	return &types.MsgLeaveNetworkResponse{ExitEpoch: exitEpoch}, nil
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// RequestPriceQuote locks the current per-token price of a model for the creator, so a batch of inferences can
+// be planned at a known cost even if UpdateDynamicPricing moves the price meanwhile.
+func (k msgServer) RequestPriceQuote(goCtx context.Context, msg *types.MsgRequestPriceQuote) (*types.MsgRequestPriceQuoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx).DynamicPricingParams
+	if params == nil || params.MaxQuoteDurationBlocks == 0 {
+		return nil, types.ErrPriceQuotesDisabled
+	}
+	if msg.DurationBlocks > params.MaxQuoteDurationBlocks {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPriceQuote, "duration %d exceeds max %d blocks", msg.DurationBlocks, params.MaxQuoteDurationBlocks)
+	}
+	if params.MaxQuoteTokenBudget != 0 && msg.TokenBudget > params.MaxQuoteTokenBudget {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPriceQuote, "token budget %d exceeds max %d", msg.TokenBudget, params.MaxQuoteTokenBudget)
+	}
+	price, err := k.GetModelCurrentPrice(ctx, msg.ModelId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPriceQuote, "no current price for model %s", msg.ModelId)
+	}
+
+	// Ids start at 1, 0 means no quote on MsgStartInference
+	id, err := k.PriceQuoteSequence.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	quote := types.PriceQuote{
+		Id:               id + 1,
+		Developer:        msg.Creator,
+		ModelId:          msg.ModelId,
+		PerTokenPrice:    price,
+		TokenBudget:      msg.TokenBudget,
+		CreatedHeight:    ctx.BlockHeight(),
+		ExpirationHeight: ctx.BlockHeight() + msg.DurationBlocks,
+	}
+	k.SetPriceQuote(ctx, quote)
+	k.LogInfo("Price quote issued", types.Pricing, "quoteId", quote.Id, "developer", quote.Developer, "modelId", quote.ModelId,
+		"price", quote.PerTokenPrice, "tokenBudget", quote.TokenBudget, "expirationHeight", quote.ExpirationHeight)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent("price_quote",
+		sdk.NewAttribute("quote_id", strconv.FormatUint(quote.Id, 10)),
+		sdk.NewAttribute("developer", quote.Developer),
+		sdk.NewAttribute("model_id", quote.ModelId),
+		sdk.NewAttribute("per_token_price", strconv.FormatUint(quote.PerTokenPrice, 10)),
+		sdk.NewAttribute("expiration_height", strconv.FormatInt(quote.ExpirationHeight, 10)),
+	))
+	return &types.MsgRequestPriceQuoteResponse{
+		QuoteId:          quote.Id,
+		PerTokenPrice:    quote.PerTokenPrice,
+		ExpirationHeight: quote.ExpirationHeight,
+	}, nil
+}
@@ -12,8 +12,10 @@ func (k msgServer) RevalidateInference(ctx context.Context, msg *types.MsgRevali
 		return nil, err
 	}
 
-	if inference.Status == types.InferenceStatus_VALIDATED {
-		k.LogDebug("Inference already validated", types.Validation, "inferenceId", msg.InferenceId)
+	// The invalidation and revalidation proposals race each other and a dispute majority may decide first:
+	// the first decision wins and the ones after it are ignored
+	if inference.Status == types.InferenceStatus_INVALIDATED || inference.Status == types.InferenceStatus_VALIDATED {
+		k.LogDebug("Inference already decided", types.Validation, "inferenceId", msg.InferenceId, "status", inference.Status)
 		return nil, nil
 	}
 
@@ -32,6 +34,11 @@ func (k msgServer) RevalidateInference(ctx context.Context, msg *types.MsgRevali
 	if err != nil {
 		return nil, err
 	}
+	// A disputed inference is decided by the dispute's validators, not by whoever flagged it
+	if !k.settleDispute(ctx, inference.InferenceId, false) {
+		k.RecordInvalidationOutcome(ctx, *inference, msg.Invalidator, false)
+	}
+	k.EmitInferenceStatus(ctx, inference)
 
 	return &types.MsgRevalidateInferenceResponse{}, nil
 }
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) RevokeInferenceKey(goCtx context.Context, msg *types.MsgRevokeInferenceKey) (*types.MsgRevokeInferenceKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetInferenceKeyGrant(ctx, msg.Creator, msg.KeyAddress); !found {
+		return nil, errorsmod.Wrapf(types.ErrInferenceKeyNotFound, "key %s, developer %s", msg.KeyAddress, msg.Creator)
+	}
+	if err := k.RemoveInferenceKeyGrant(ctx, msg.Creator, msg.KeyAddress); err != nil {
+		return nil, err
+	}
+	k.LogInfo("Inference key revoked", types.Inferences, "developer", msg.Creator, "key", msg.KeyAddress)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent("inference_key_revoked",
+		sdk.NewAttribute("developer", msg.Creator),
+		sdk.NewAttribute("key_address", msg.KeyAddress),
+	))
+	return &types.MsgRevokeInferenceKeyResponse{}, nil
+}
@@ -48,6 +48,17 @@ func (k msgServer) StartInference(goCtx context.Context, msg *types.MsgStartInfe
 		k.RecordInferencePrice(goCtx, &existingInference, msg.InferenceId)
 	}
 
+	// A quote overrides the recorded price, even if FinishInference came first: payments are only made on start
+	var quote types.PriceQuote
+	if msg.PriceQuoteId != 0 {
+		quote, err = k.GetPriceQuoteFor(ctx, msg.PriceQuoteId, msg.RequestedBy, msg.Model)
+		if err != nil {
+			k.LogError("StartInference: price quote not usable", types.Inferences, "inferenceId", msg.InferenceId, "quoteId", msg.PriceQuoteId, "error", err)
+			return nil, err
+		}
+		existingInference.PerTokenPrice = quote.PerTokenPrice
+	}
+
 	blockContext := calculations.BlockContext{
 		BlockHeight:    ctx.BlockHeight(),
 		BlockTimestamp: ctx.BlockTime().UnixMilli(),
@@ -58,6 +69,21 @@ func (k msgServer) StartInference(goCtx context.Context, msg *types.MsgStartInfe
 		return nil, err
 	}
 
+	if msg.PriceQuoteId != 0 {
+		inference.PriceQuoteId = quote.Id
+		if err := k.ReservePriceQuoteTokens(ctx, quote, inferenceQuoteTokens(inference)); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.RequesterKey != "" {
+		err = k.ChargeInferenceKey(ctx, inference, msg.RequesterKey, payments.EscrowAmount)
+		if err != nil {
+			k.LogError("StartInference: inference key limits not met", types.Inferences, "inferenceId", msg.InferenceId, "requesterKey", msg.RequesterKey, "error", err)
+			return nil, err
+		}
+	}
+
 	finalInference, err := k.processInferencePayments(ctx, inference, payments)
 	if err != nil {
 		return nil, err
@@ -92,6 +118,7 @@ func (k msgServer) verifyKeys(ctx sdk.Context, msg *types.MsgStartInference, age
 		DevSignature:      msg.InferenceId, // Using InferenceId as the dev signature
 		TransferSignature: msg.TransferSignature,
 		Dev:               &dev,
+		DevKey:            msg.RequesterKey,
 		TransferAgent:     &agent,
 	}
 
@@ -169,7 +196,7 @@ func (k msgServer) processInferencePayments(
 		inference.EscrowAmount = escrowAmount
 	}
 	if payments.EscrowAmount < 0 {
-		err := k.IssueRefund(ctx, -payments.EscrowAmount, inference.RequestedBy, "inference_refund:"+inference.InferenceId)
+		err := k.RefundInference(ctx, inference, -payments.EscrowAmount, "inference_refund:"+inference.InferenceId)
 		if err != nil {
 			k.LogError("Unable to Issue Refund for started inference", types.Payments, err)
 		}
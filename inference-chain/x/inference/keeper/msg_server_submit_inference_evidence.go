@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	collateraltypes "github.com/productscience/inference/x/collateral/types"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// SubmitInferenceEvidence slashes and jails an executor that signed two different responses for the same
+// inference id and seed, and rewards the submitter with a share of the slash. Each executor can only be
+// punished once per inference.
+func (k msgServer) SubmitInferenceEvidence(goCtx context.Context, msg *types.MsgSubmitInferenceEvidence) (*types.MsgSubmitInferenceEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	executorAddress, err := sdk.AccAddressFromBech32(msg.Executor)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.GetParticipant(ctx, msg.Executor); !found {
+		return nil, sdkerrors.Wrap(types.ErrParticipantNotFound, msg.Executor)
+	}
+	// Only the executor that actually ran the inference can be held to a response for it
+	inference, found := k.GetInference(ctx, msg.First.InferenceId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInferenceNotFound, "inference with id %s not found", msg.First.InferenceId)
+	}
+	if inference.ExecutedBy != msg.Executor {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidEvidence, "inference %s was not executed by %s", msg.First.InferenceId, msg.Executor)
+	}
+
+	evidenceKey := collections.Join(executorAddress, msg.First.InferenceId)
+	alreadySubmitted, err := k.EquivocationEvidence.Has(ctx, evidenceKey)
+	if err != nil {
+		return nil, err
+	}
+	if alreadySubmitted {
+		return nil, sdkerrors.Wrapf(types.ErrEvidenceAlreadySubmitted, "executor %s, inference %s", msg.Executor, msg.First.InferenceId)
+	}
+
+	// Executors sign with their own key or a granted ML operational key, same as in FinishInference
+	executorKeys, err := k.GetAccountPubKeysWithGrantees(ctx, msg.Executor)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrParticipantNotFound, msg.Executor)
+	}
+	for _, execution := range []types.SignedExecution{msg.First, msg.Second} {
+		if err := calculations.VerifyExecutionSignature(execution, msg.Executor, executorKeys); err != nil {
+			k.LogWarn("SubmitInferenceEvidence: invalid execution signature", types.Validation, "executor", msg.Executor, "inference_id", execution.InferenceId, "error", err)
+			return nil, sdkerrors.Wrapf(types.ErrInvalidSignature, "execution signature for response %s", execution.ResponseHash)
+		}
+	}
+
+	params := k.GetParams(ctx)
+	collateralParams := params.CollateralParams
+	if collateralParams.SlashFractionEquivocation == nil || collateralParams.WhistleblowerRewardFraction == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidEvidence, "equivocation slashing is not configured")
+	}
+	slashFraction, err := collateralParams.SlashFractionEquivocation.ToLegacyDec()
+	if err != nil {
+		return nil, err
+	}
+	rewardFraction, err := collateralParams.WhistleblowerRewardFraction.ToLegacyDec()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.EquivocationEvidence.Set(ctx, evidenceKey); err != nil {
+		return nil, err
+	}
+
+	k.LogInfo("Slashing and jailing executor for equivocation", types.Validation,
+		"executor", msg.Executor,
+		"inference_id", msg.First.InferenceId,
+		"reported_by", msg.Creator,
+		"slash_fraction", slashFraction.String(),
+	)
+	// The executor must restore the collateral it held before the slash to unjail itself
+	requiredCollateral, _ := k.collateralKeeper.GetCollateral(ctx, executorAddress)
+	slashed, rewardCoin, err := k.collateralKeeper.SlashWithReward(ctx, executorAddress, slashFraction, types.ModuleName, rewardFraction)
+	if err != nil {
+		return nil, err
+	}
+	k.collateralKeeper.Jail(ctx, executorAddress, collateraltypes.JailReasonEquivocation, slashed, requiredCollateral)
+
+	// The reward is the whistleblower's share of the slash, moved to the module account instead of being burned
+	reward := rewardCoin.Amount.Int64()
+	if reward > 0 {
+		rewardVestingPeriod := &params.TokenomicsParams.RewardVestingPeriod
+		if err := k.PayParticipantFromModule(ctx, msg.Creator, reward, types.ModuleName, "whistleblower_reward:"+msg.Executor, rewardVestingPeriod); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"inference_evidence",
+			sdk.NewAttribute("inference_id", msg.First.InferenceId),
+			sdk.NewAttribute("executor", msg.Executor),
+			sdk.NewAttribute("reported_by", msg.Creator),
+			sdk.NewAttribute("slashed_amount", slashed.String()),
+			sdk.NewAttribute("reward", strconv.FormatInt(reward, 10)),
+		),
+	)
+
+	return &types.MsgSubmitInferenceEvidenceResponse{
+		SlashedAmount: slashed.Amount.Uint64(),
+		Reward:        uint64(reward),
+	}, nil
+}
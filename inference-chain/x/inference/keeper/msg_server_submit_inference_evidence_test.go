@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/testutil/sample"
+	collateraltypes "github.com/productscience/inference/x/collateral/types"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func signExecution(t *testing.T, executor *MockAccount, inferenceId string, seed int64, responseHash string) types.SignedExecution {
+	execution := types.SignedExecution{
+		InferenceId:      inferenceId,
+		Seed:             seed,
+		ResponseHash:     responseHash,
+		RequestTimestamp: 1700000000,
+		TransferredBy:    testutil.Creator,
+	}
+	components := calculations.GetExecutionSignatureComponents(execution.InferenceId, execution.Seed, execution.ResponseHash,
+		execution.RequestTimestamp, execution.TransferredBy, executor.address)
+	signature, err := calculations.Sign(executor, components, calculations.ExecutionAttestation)
+	require.NoError(t, err)
+	execution.ExecutionSignature = signature
+	return execution
+}
+
+func TestMsgServer_SubmitInferenceEvidence(t *testing.T) {
+	k, ms, ctx, mocks := setupKeeperWithMocks(t)
+	k.SetParams(ctx, types.DefaultParams())
+	executor := NewMockAccount(testutil.Executor)
+	whistleblower := sample.AccAddress()
+	require.NoError(t, k.SetParticipant(ctx, types.Participant{Index: executor.address, Address: executor.address}))
+	require.NoError(t, k.SetInference(ctx, types.Inference{Index: "inference-1", InferenceId: "inference-1", ExecutedBy: executor.address}))
+
+	mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), executor.GetBechAddress()).Return(executor).AnyTimes()
+	mocks.AuthzKeeper.EXPECT().GranterGrants(gomock.Any(), gomock.Any()).Return(&authztypes.QueryGranterGrantsResponse{Grants: []*authztypes.GrantAuthorization{}}, nil).AnyTimes()
+
+	// The collateral held before the slash is what the executor must restore to unjail itself
+	slashed := sdk.NewInt64Coin(types.BaseCoin, 500)
+	collateralBefore := sdk.NewInt64Coin(types.BaseCoin, 1000)
+	getCollateral := mocks.CollateralKeeper.EXPECT().GetCollateral(gomock.Any(), executor.GetBechAddress()).Return(collateralBefore, true).Times(1)
+	// The whistleblower is paid its share of the slash rather than newly minted coins
+	mocks.CollateralKeeper.EXPECT().SlashWithReward(gomock.Any(), executor.GetBechAddress(), math.LegacyNewDecWithPrec(5, 1), types.ModuleName, math.LegacyNewDecWithPrec(1, 1)).
+		Return(slashed, sdk.NewInt64Coin(types.BaseCoin, 50), nil).After(getCollateral).Times(1)
+	mocks.CollateralKeeper.EXPECT().Jail(gomock.Any(), executor.GetBechAddress(), collateraltypes.JailReasonEquivocation, slashed, collateralBefore).Times(1)
+	reward := sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 50))
+	mocks.BankKeeper.EXPECT().MintCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(whistleblower), reward, "whistleblower_reward:"+executor.address).Return(nil).Times(1)
+
+	msg := types.NewMsgSubmitInferenceEvidence(whistleblower, executor.address,
+		signExecution(t, executor, "inference-1", 42, "response-a"),
+		signExecution(t, executor, "inference-1", 42, "response-b"))
+	resp, err := ms.SubmitInferenceEvidence(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), resp.SlashedAmount)
+	require.Equal(t, uint64(50), resp.Reward)
+
+	// The same equivocation cannot be punished twice
+	_, err = ms.SubmitInferenceEvidence(ctx, msg)
+	require.ErrorIs(t, err, types.ErrEvidenceAlreadySubmitted)
+}
+
+func TestMsgServer_SubmitInferenceEvidence_ForgedSignature(t *testing.T) {
+	k, ms, ctx, mocks := setupKeeperWithMocks(t)
+	k.SetParams(ctx, types.DefaultParams())
+	executor := NewMockAccount(testutil.Executor)
+	impostor := NewMockAccount(testutil.Executor)
+	require.NoError(t, k.SetParticipant(ctx, types.Participant{Index: executor.address, Address: executor.address}))
+	require.NoError(t, k.SetInference(ctx, types.Inference{Index: "inference-1", InferenceId: "inference-1", ExecutedBy: executor.address}))
+
+	mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), executor.GetBechAddress()).Return(executor).AnyTimes()
+	mocks.AuthzKeeper.EXPECT().GranterGrants(gomock.Any(), gomock.Any()).Return(&authztypes.QueryGranterGrantsResponse{Grants: []*authztypes.GrantAuthorization{}}, nil).AnyTimes()
+
+	// Slash is never called: the second payload was not signed by the executor
+	msg := types.NewMsgSubmitInferenceEvidence(sample.AccAddress(), executor.address,
+		signExecution(t, executor, "inference-1", 42, "response-a"),
+		signExecution(t, impostor, "inference-1", 42, "response-b"))
+	_, err := ms.SubmitInferenceEvidence(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+}
+
+func TestMsgServer_SubmitInferenceEvidence_NotTheExecutor(t *testing.T) {
+	k, ms, ctx, mocks := setupKeeperWithMocks(t)
+	k.SetParams(ctx, types.DefaultParams())
+	executor := NewMockAccount(testutil.Executor)
+	require.NoError(t, k.SetParticipant(ctx, types.Participant{Index: executor.address, Address: executor.address}))
+	mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), executor.GetBechAddress()).Return(executor).AnyTimes()
+	mocks.AuthzKeeper.EXPECT().GranterGrants(gomock.Any(), gomock.Any()).Return(&authztypes.QueryGranterGrantsResponse{Grants: []*authztypes.GrantAuthorization{}}, nil).AnyTimes()
+
+	msg := types.NewMsgSubmitInferenceEvidence(sample.AccAddress(), executor.address,
+		signExecution(t, executor, "inference-1", 42, "response-a"),
+		signExecution(t, executor, "inference-1", 42, "response-b"))
+
+	// Evidence for an inference that does not exist is rejected
+	_, err := ms.SubmitInferenceEvidence(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInferenceNotFound)
+
+	// So is evidence against a participant that did not execute the inference
+	require.NoError(t, k.SetInference(ctx, types.Inference{Index: "inference-1", InferenceId: "inference-1", ExecutedBy: sample.AccAddress()}))
+	_, err = ms.SubmitInferenceEvidence(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidEvidence)
+}
+
+func TestMsgServer_SubmitInferenceEvidence_RequestSignature(t *testing.T) {
+	k, ms, ctx, mocks := setupKeeperWithMocks(t)
+	k.SetParams(ctx, types.DefaultParams())
+	executor := NewMockAccount(testutil.Executor)
+	require.NoError(t, k.SetParticipant(ctx, types.Participant{Index: executor.address, Address: executor.address}))
+	require.NoError(t, k.SetInference(ctx, types.Inference{Index: "inference-1", InferenceId: "inference-1", ExecutedBy: executor.address}))
+	mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), executor.GetBechAddress()).Return(executor).AnyTimes()
+	mocks.AuthzKeeper.EXPECT().GranterGrants(gomock.Any(), gomock.Any()).Return(&authztypes.QueryGranterGrantsResponse{Grants: []*authztypes.GrantAuthorization{}}, nil).AnyTimes()
+
+	// The executor signs request payloads as ExecutorAgent; such a signature is not an execution attestation
+	requestSigned := signExecution(t, executor, "inference-1", 42, "response-b")
+	components := calculations.GetExecutionSignatureComponents(requestSigned.InferenceId, requestSigned.Seed, requestSigned.ResponseHash,
+		requestSigned.RequestTimestamp, requestSigned.TransferredBy, executor.address)
+	signature, err := calculations.Sign(executor, components, calculations.ExecutorAgent)
+	require.NoError(t, err)
+	requestSigned.ExecutionSignature = signature
+
+	msg := types.NewMsgSubmitInferenceEvidence(sample.AccAddress(), executor.address,
+		signExecution(t, executor, "inference-1", 42, "response-a"), requestSigned)
+	_, err = ms.SubmitInferenceEvidence(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// UpdateParticipantProfile replaces the public profile of a participant, at most max_updates_per_epoch times an
+// epoch. A new inference URL takes effect url_change_delay_blocks later.
+func (k msgServer) UpdateParticipantProfile(goCtx context.Context, msg *types.MsgUpdateParticipantProfile) (*types.MsgUpdateParticipantProfileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParticipantProfileParams(ctx)
+
+	participant, found := k.GetParticipant(ctx, msg.Creator)
+	if !found {
+		return nil, types.ErrParticipantNotFound
+	}
+	epochIndex, found := k.GetEffectiveEpochIndex(ctx)
+	if !found {
+		return nil, types.ErrEffectiveEpochNotFound
+	}
+
+	profile := participant.Profile
+	if profile == nil {
+		profile = &types.ParticipantProfile{}
+	}
+	if profile.LastUpdateEpoch != epochIndex {
+		profile.LastUpdateEpoch = epochIndex
+		profile.UpdatesInEpoch = 0
+	}
+	if profile.UpdatesInEpoch >= params.MaxUpdatesPerEpoch {
+		return nil, errorsmod.Wrapf(types.ErrProfileUpdateRateLimited, "%d updates in epoch %d", profile.UpdatesInEpoch, epochIndex)
+	}
+	profile.UpdatesInEpoch++
+	profile.DisplayName = msg.DisplayName
+	profile.Website = msg.Website
+	profile.Contact = msg.Contact
+	profile.RegionCode = msg.RegionCode
+	profile.SupportedProtocols = msg.SupportedProtocols
+	participant.Profile = profile
+
+	var effectiveHeight int64
+	if msg.InferenceUrl != "" && (msg.InferenceUrl != participant.InferenceUrl || profile.PendingInferenceUrl != "") {
+		effectiveHeight = ctx.BlockHeight() + params.UrlChangeDelayBlocks
+		k.ScheduleInferenceUrlChange(ctx, &participant, msg.InferenceUrl, effectiveHeight)
+	}
+
+	if err := k.SetParticipant(ctx, participant); err != nil {
+		return nil, err
+	}
+	k.LogInfo("Participant profile updated", types.Participants, "participant", msg.Creator, "pendingUrl", profile.PendingInferenceUrl, "urlEffectiveHeight", effectiveHeight)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent("participant_profile_updated",
+		sdk.NewAttribute("participant", msg.Creator),
+		sdk.NewAttribute("pending_inference_url", profile.PendingInferenceUrl),
+	))
+	return &types.MsgUpdateParticipantProfileResponse{UrlEffectiveHeight: effectiveHeight}, nil
+}
@@ -46,6 +46,7 @@ func (k msgServer) Validation(goCtx context.Context, msg *types.MsgValidation) (
 			k.LogError("Failed to add inference to epoch group validations", types.Validation, "inferenceId", msg.InferenceId, "error", err)
 			return nil, err
 		}
+		k.RecordValidation(ctx, inference, msg.Creator)
 	}
 
 	if inference.Status == types.InferenceStatus_INVALIDATED {
@@ -107,7 +108,16 @@ func (k msgServer) Validation(goCtx context.Context, msg *types.MsgValidation) (
 
 	k.LogInfo("Validating inner loop", types.Validation, "inferenceId", inference.InferenceId, "validator", msg.Creator, "passed", passed, "revalidation", msg.Revalidation)
 	if msg.Revalidation {
-		return epochGroup.Revalidate(passed, inference, msg, ctx)
+		response, err := epochGroup.Revalidate(passed, inference, msg, ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.recordDisputeVote(ctx, inference, msg.Creator, passed); err != nil {
+			k.LogError("Failed to record dispute vote", types.Validation, "inferenceId", inference.InferenceId, "error", err)
+			return nil, err
+		}
+		k.RecordRevalidation(ctx, inference, msg.Creator)
+		return response, nil
 	} else if passed {
 		inference.Status = types.InferenceStatus_VALIDATED
 		shouldShare, information := k.inferenceIsBeforeClaimsSet(ctx, inference, epochGroup)
@@ -118,6 +128,7 @@ func (k msgServer) Validation(goCtx context.Context, msg *types.MsgValidation) (
 		}
 		executor.ConsecutiveInvalidInferences = 0
 		executor.CurrentEpochStats.ValidatedInferences++
+		k.RecordValidationResult(ctx, inference, msg.Creator, true)
 	} else {
 		if k.MaximumInvalidationsReached(ctx, sdk.MustAccAddressFromBech32(creator.Address), groupData) {
 			k.LogWarn("Maximum invalidations reached.", types.Validation,
@@ -139,6 +150,7 @@ func (k msgServer) Validation(goCtx context.Context, msg *types.MsgValidation) (
 
 		inference.ProposalDetails = proposalDetails
 		needsRevalidation = true
+		k.RecordValidationResult(ctx, inference, msg.Creator, false)
 	}
 	// Where will we get this number? How much does it vary by model?
 
@@ -170,6 +182,15 @@ func (k msgServer) Validation(goCtx context.Context, msg *types.MsgValidation) (
 			sdk.NewAttribute("needs_revalidation", strconv.FormatBool(needsRevalidation)),
 			sdk.NewAttribute("passed", strconv.FormatBool(passed)),
 		))
+	err = ctx.EventManager().EmitTypedEvent(&types.EventInferenceValidation{
+		InferenceId:       msg.InferenceId,
+		Validator:         msg.Creator,
+		NeedsRevalidation: needsRevalidation,
+		Passed:            passed,
+	})
+	if err != nil {
+		k.LogError("Failed to emit EventInferenceValidation", types.Validation, "inferenceId", msg.InferenceId, "error", err)
+	}
 	return &types.MsgValidationResponse{}, nil
 }
 
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// WithdrawCredit moves unused credit out of reach of new inferences right away, but only pays it out after
+// the withdrawal delay.
+func (k msgServer) WithdrawCredit(goCtx context.Context, msg *types.MsgWithdrawCredit) (*types.MsgWithdrawCreditResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	credit, found := k.GetDeveloperCredit(ctx, msg.Creator)
+	if !found || credit.Available < msg.Amount {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientCredit, "available %d, requested %d", credit.Available, msg.Amount)
+	}
+	credit.Available -= msg.Amount
+	credit.PendingWithdrawal += msg.Amount
+	k.SetDeveloperCredit(ctx, credit)
+
+	releaseHeight := ctx.BlockHeight() + k.GetDeveloperCreditParams(ctx).WithdrawalDelayBlocks
+	k.AddCreditWithdrawal(ctx, types.CreditWithdrawal{
+		Developer:     msg.Creator,
+		Amount:        msg.Amount,
+		ReleaseHeight: releaseHeight,
+	})
+	k.LogInfo("Developer credit withdrawal scheduled", types.Payments, "developer", msg.Creator, "amount", msg.Amount, "releaseHeight", releaseHeight)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent("credit_withdrawal",
+		sdk.NewAttribute("developer", msg.Creator),
+		sdk.NewAttribute("amount", strconv.FormatUint(msg.Amount, 10)),
+		sdk.NewAttribute("release_height", strconv.FormatInt(releaseHeight, 10)),
+	))
+	return &types.MsgWithdrawCreditResponse{ReleaseHeight: releaseHeight}, nil
+}
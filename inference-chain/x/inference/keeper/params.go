@@ -78,3 +78,21 @@ func (k Keeper) GetBandwidthLimitsParams(ctx context.Context) (*types.BandwidthL
 	}
 	return params.BandwidthLimitsParams, nil
 }
+
+// GetDeveloperCreditParams returns developer credit parameters, or the defaults on chains that don't have them yet
+func (k Keeper) GetDeveloperCreditParams(ctx context.Context) *types.DeveloperCreditParams {
+	params := k.GetParams(ctx)
+	if params.DeveloperCreditParams == nil {
+		return types.DefaultDeveloperCreditParams()
+	}
+	return params.DeveloperCreditParams
+}
+
+// GetParticipantProfileParams returns participant profile parameters, or the defaults on chains that don't have them yet
+func (k Keeper) GetParticipantProfileParams(ctx context.Context) *types.ParticipantProfileParams {
+	params := k.GetParams(ctx)
+	if params.ParticipantProfileParams == nil {
+		return types.DefaultParticipantProfileParams()
+	}
+	return params.ParticipantProfileParams
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// A participant leaving the network keeps serving through its exit epoch and is paid for it by the regular
+// settlement. When the next epoch is formed it is left out of the active participants, its own collateral starts
+// unbonding and it is marked inactive. From then on it is not checked for downtime.
+
+func (k Keeper) GetParticipantExit(ctx context.Context, participant string) (types.ParticipantExit, bool) {
+	addr, err := sdk.AccAddressFromBech32(participant)
+	if err != nil {
+		return types.ParticipantExit{}, false
+	}
+	exit, err := k.ParticipantExits.Get(ctx, addr)
+	if err != nil {
+		return types.ParticipantExit{}, false
+	}
+	return exit, true
+}
+
+func (k Keeper) SetParticipantExit(ctx context.Context, exit types.ParticipantExit) {
+	if err := k.ParticipantExits.Set(ctx, sdk.MustAccAddressFromBech32(exit.Participant), exit); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) GetAllParticipantExits(ctx context.Context) []types.ParticipantExit {
+	iter, err := k.ParticipantExits.Iterate(ctx, nil)
+	if err != nil {
+		return nil
+	}
+	exits, err := iter.Values()
+	if err != nil {
+		return nil
+	}
+	return exits
+}
+
+// ExcludeExitingParticipants removes participants whose exit epoch ends before upcomingEpochIndex. A participant
+// whose exit has completed and that passed PoC again rejoins the network, which drops its exit record.
+func (k Keeper) ExcludeExitingParticipants(ctx context.Context, participants []*types.ActiveParticipant, upcomingEpochIndex uint64) []*types.ActiveParticipant {
+	remaining := make([]*types.ActiveParticipant, 0, len(participants))
+	for _, participant := range participants {
+		exit, found := k.GetParticipantExit(ctx, participant.Index)
+		if found && !exit.Completed && exit.ExitEpoch < upcomingEpochIndex {
+			k.LogInfo("Excluding participant leaving the network from the upcoming epoch", types.Participants, "participant", participant.Index, "exitEpoch", exit.ExitEpoch)
+			continue
+		}
+		if found && exit.Completed {
+			k.LogInfo("Participant rejoined the network", types.Participants, "participant", participant.Index, "exitEpoch", exit.ExitEpoch)
+			if err := k.ParticipantExits.Remove(ctx, sdk.MustAccAddressFromBech32(exit.Participant)); err != nil {
+				k.LogError("Failed to remove participant exit", types.Participants, "participant", exit.Participant, "error", err)
+			}
+		}
+		remaining = append(remaining, participant)
+	}
+	return remaining
+}
+
+// CompleteParticipantExits finishes the exits of all participants whose exit epoch has been settled: their
+// collateral starts unbonding and they are marked inactive.
+func (k Keeper) CompleteParticipantExits(ctx context.Context, settledEpochIndex uint64) {
+	for _, exit := range k.GetAllParticipantExits(ctx) {
+		if exit.Completed || exit.ExitEpoch > settledEpochIndex {
+			continue
+		}
+		exit.UnbondedCollateral = k.collateralKeeper.UnbondAllCollateral(ctx, sdk.MustAccAddressFromBech32(exit.Participant))
+		exit.Completed = true
+		k.SetParticipantExit(ctx, exit)
+
+		if participant, found := k.GetParticipant(ctx, exit.Participant); found {
+			participant.Status = types.ParticipantStatus_INACTIVE
+			if err := k.SetParticipant(ctx, participant); err != nil {
+				k.LogError("Failed to mark exited participant inactive", types.Participants, "participant", exit.Participant, "error", err)
+			}
+		}
+		k.LogInfo("Participant left the network", types.Participants, "participant", exit.Participant, "exitEpoch", exit.ExitEpoch, "unbondedCollateral", exit.UnbondedCollateral.String())
+
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("participant_exit_completed",
+			sdk.NewAttribute("participant", exit.Participant),
+			sdk.NewAttribute("exit_epoch", strconv.FormatUint(exit.ExitEpoch, 10)),
+			sdk.NewAttribute("unbonded_collateral", exit.UnbondedCollateral.String()),
+		))
+	}
+}
+
+// exemptFromDowntime reports whether the participant had left the network before the settled epoch.
+func (k Keeper) exemptFromDowntime(ctx context.Context, participant string, settledEpochIndex uint64) bool {
+	exit, found := k.GetParticipantExit(ctx, participant)
+	return found && exit.ExitEpoch < settledEpochIndex
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/testutil"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLeaveNetwork_ExitAfterCurrentEpoch(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	k.SetEffectiveEpochIndex(ctx, 3)
+
+	_, err := ms.LeaveNetwork(ctx, &types.MsgLeaveNetwork{Creator: testutil.Executor})
+	require.ErrorIs(t, err, types.ErrParticipantNotFound)
+
+	require.NoError(t, k.SetParticipant(ctx, types.Participant{Index: testutil.Executor, Address: testutil.Executor, Status: types.ParticipantStatus_ACTIVE}))
+	resp, err := ms.LeaveNetwork(ctx, &types.MsgLeaveNetwork{Creator: testutil.Executor})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.ExitEpoch)
+	_, err = ms.LeaveNetwork(ctx, &types.MsgLeaveNetwork{Creator: testutil.Executor})
+	require.ErrorIs(t, err, types.ErrParticipantAlreadyLeaving)
+
+	// Epoch 4 is formed without the participant
+	candidates := []*types.ActiveParticipant{{Index: testutil.Executor}, {Index: testutil.Executor2}}
+	remaining := k.ExcludeExitingParticipants(ctx, candidates, 4)
+	require.Equal(t, []*types.ActiveParticipant{{Index: testutil.Executor2}}, remaining)
+
+	unbonded := sdk.NewInt64Coin(types.BaseCoin, 5_000)
+	mocks.CollateralKeeper.EXPECT().UnbondAllCollateral(gomock.Any(), sdk.MustAccAddressFromBech32(testutil.Executor)).Return(unbonded)
+	k.CompleteParticipantExits(ctx, 3)
+	// Already completed exits are not processed again
+	k.CompleteParticipantExits(ctx, 4)
+
+	exit, found := k.GetParticipantExit(ctx, testutil.Executor)
+	require.True(t, found)
+	require.True(t, exit.Completed)
+	require.Equal(t, unbonded, exit.UnbondedCollateral)
+	participant, _ := k.GetParticipant(ctx, testutil.Executor)
+	require.Equal(t, types.ParticipantStatus_INACTIVE, participant.Status)
+
+	// Passing PoC again after the exit completed rejoins the network
+	remaining = k.ExcludeExitingParticipants(ctx, candidates, 5)
+	require.Len(t, remaining, 2)
+	_, found = k.GetParticipantExit(ctx, testutil.Executor)
+	require.False(t, found)
+}
+
+func TestLeaveNetwork_AlreadyInUpcomingEpoch(t *testing.T) {
+	k, ctx, _ := keepertest.InferenceKeeperReturningMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	k.SetEffectiveEpochIndex(ctx, 3)
+	require.NoError(t, k.SetParticipant(ctx, types.Participant{Index: testutil.Executor, Address: testutil.Executor}))
+	require.NoError(t, k.SetActiveParticipants(ctx, types.ActiveParticipants{EpochId: 4, Participants: []*types.ActiveParticipant{{Index: testutil.Executor}}}))
+
+	resp, err := ms.LeaveNetwork(ctx, &types.MsgLeaveNetwork{Creator: testutil.Executor})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), resp.ExitEpoch)
+
+	remaining := k.ExcludeExitingParticipants(ctx, []*types.ActiveParticipant{{Index: testutil.Executor}}, 5)
+	require.Empty(t, remaining)
+}
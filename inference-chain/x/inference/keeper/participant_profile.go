@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// A new inference URL doesn't replace the current one right away. It waits in the profile until its effective
+// height, so every node switches to it at the same block.
+
+// ScheduleInferenceUrlChange replaces any pending URL change of the participant with one to url at effectiveHeight.
+func (k Keeper) ScheduleInferenceUrlChange(ctx context.Context, participant *types.Participant, url string, effectiveHeight int64) {
+	addr := sdk.MustAccAddressFromBech32(participant.Address)
+	if participant.Profile == nil {
+		participant.Profile = &types.ParticipantProfile{}
+	}
+	if participant.Profile.PendingInferenceUrl != "" {
+		if err := k.InferenceUrlChanges.Remove(ctx, collections.Join(participant.Profile.InferenceUrlEffectiveHeight, addr)); err != nil {
+			k.LogError("Failed to remove pending inference URL change", types.Participants, "participant", participant.Address, "error", err)
+		}
+	}
+	participant.Profile.PendingInferenceUrl = url
+	participant.Profile.InferenceUrlEffectiveHeight = effectiveHeight
+	if err := k.InferenceUrlChanges.Set(ctx, collections.Join(effectiveHeight, addr)); err != nil {
+		panic(err)
+	}
+}
+
+// ApplyInferenceUrlChanges switches the participants with a URL change due at or before height to their new URL,
+// including their entries in the current and upcoming active participants.
+func (k Keeper) ApplyInferenceUrlChanges(ctx context.Context, height int64) {
+	iter, err := k.InferenceUrlChanges.Iterate(ctx, collections.NewPrefixUntilPairRange[int64, sdk.AccAddress](height))
+	if err != nil {
+		k.LogError("Failed to iterate inference URL changes", types.Participants, "error", err)
+		return
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		k.LogError("Failed to read inference URL changes", types.Participants, "error", err)
+		return
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	changed := make(map[string]string, len(keys))
+	for _, key := range keys {
+		if err := k.InferenceUrlChanges.Remove(ctx, key); err != nil {
+			k.LogError("Failed to remove inference URL change", types.Participants, "participant", key.K2().String(), "error", err)
+		}
+		participant, found := k.GetParticipant(ctx, key.K2().String())
+		if !found || participant.Profile == nil || participant.Profile.PendingInferenceUrl == "" {
+			continue
+		}
+		previous := participant.InferenceUrl
+		participant.InferenceUrl = participant.Profile.PendingInferenceUrl
+		participant.Profile.PendingInferenceUrl = ""
+		participant.Profile.InferenceUrlEffectiveHeight = 0
+		if err := k.SetParticipant(ctx, participant); err != nil {
+			k.LogError("Failed to apply inference URL change", types.Participants, "participant", participant.Address, "error", err)
+			continue
+		}
+		changed[participant.Index] = participant.InferenceUrl
+		k.LogInfo("Inference URL changed", types.Participants, "participant", participant.Address, "previous", previous, "url", participant.InferenceUrl)
+
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("inference_url_changed",
+			sdk.NewAttribute("participant", participant.Address),
+			sdk.NewAttribute("inference_url", participant.InferenceUrl),
+			sdk.NewAttribute("height", strconv.FormatInt(height, 10)),
+		))
+	}
+
+	effectiveEpochIndex, found := k.GetEffectiveEpochIndex(ctx)
+	if !found {
+		return
+	}
+	for _, epochIndex := range []uint64{effectiveEpochIndex, effectiveEpochIndex + 1} {
+		activeParticipants, found := k.GetActiveParticipants(ctx, epochIndex)
+		if !found {
+			continue
+		}
+		updated := false
+		for _, participant := range activeParticipants.Participants {
+			if url, ok := changed[participant.Index]; ok {
+				participant.InferenceUrl = url
+				updated = true
+			}
+		}
+		if !updated {
+			continue
+		}
+		if err := k.SetActiveParticipants(ctx, activeParticipants); err != nil {
+			k.LogError("Failed to update inference URLs of active participants", types.Participants, "epochIndex", epochIndex, "error", err)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/productscience/inference/testutil"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateParticipantProfile_UrlChangeTakesEffectLater(t *testing.T) {
+	k, ctx, _ := keepertest.InferenceKeeperReturningMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	k.SetEffectiveEpochIndex(ctx, 2)
+	ctx = ctx.WithBlockHeight(100)
+	require.NoError(t, k.SetParticipant(ctx, types.Participant{Index: testutil.Executor, Address: testutil.Executor, InferenceUrl: "http://old-node"}))
+	require.NoError(t, k.SetActiveParticipants(ctx, types.ActiveParticipants{EpochId: 2, Participants: []*types.ActiveParticipant{{Index: testutil.Executor, InferenceUrl: "http://old-node"}}}))
+
+	resp, err := ms.UpdateParticipantProfile(ctx, &types.MsgUpdateParticipantProfile{
+		Creator:            testutil.Executor,
+		InferenceUrl:       "https://new-node",
+		DisplayName:        "Host",
+		RegionCode:         "DE",
+		SupportedProtocols: types.ProtocolChatCompletions,
+	})
+	require.NoError(t, err)
+	delay := types.DefaultParticipantProfileParams().UrlChangeDelayBlocks
+	require.Equal(t, 100+delay, resp.UrlEffectiveHeight)
+
+	participant, _ := k.GetParticipant(ctx, testutil.Executor)
+	require.Equal(t, "http://old-node", participant.InferenceUrl)
+	require.Equal(t, "Host", participant.Profile.DisplayName)
+	require.Equal(t, "https://new-node", participant.Profile.PendingInferenceUrl)
+
+	k.ApplyInferenceUrlChanges(ctx, resp.UrlEffectiveHeight-1)
+	participant, _ = k.GetParticipant(ctx, testutil.Executor)
+	require.Equal(t, "http://old-node", participant.InferenceUrl)
+
+	k.ApplyInferenceUrlChanges(ctx, resp.UrlEffectiveHeight)
+	participant, _ = k.GetParticipant(ctx, testutil.Executor)
+	require.Equal(t, "https://new-node", participant.InferenceUrl)
+	require.Empty(t, participant.Profile.PendingInferenceUrl)
+	active, _ := k.GetActiveParticipants(ctx, 2)
+	require.Equal(t, "https://new-node", active.Participants[0].InferenceUrl)
+}
+
+func TestUpdateParticipantProfile_RateLimitedPerEpoch(t *testing.T) {
+	k, ctx, _ := keepertest.InferenceKeeperReturningMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	k.SetEffectiveEpochIndex(ctx, 2)
+	require.NoError(t, k.SetParticipant(ctx, types.Participant{Index: testutil.Executor, Address: testutil.Executor, InferenceUrl: "http://node"}))
+
+	msg := &types.MsgUpdateParticipantProfile{Creator: testutil.Executor, InferenceUrl: "http://node", DisplayName: "Host"}
+	for range types.DefaultParticipantProfileParams().MaxUpdatesPerEpoch {
+		resp, err := ms.UpdateParticipantProfile(ctx, msg)
+		require.NoError(t, err)
+		// The URL is unchanged, so nothing is scheduled
+		require.Zero(t, resp.UrlEffectiveHeight)
+	}
+	_, err := ms.UpdateParticipantProfile(ctx, msg)
+	require.ErrorIs(t, err, types.ErrProfileUpdateRateLimited)
+
+	k.SetEffectiveEpochIndex(ctx, 3)
+	_, err = ms.UpdateParticipantProfile(ctx, msg)
+	require.NoError(t, err)
+}
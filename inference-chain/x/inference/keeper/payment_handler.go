@@ -10,6 +10,11 @@ import (
 )
 
 func (k *Keeper) PutPaymentInEscrow(ctx context.Context, inference *types.Inference, cost int64) (int64, error) {
+	if k.ReserveDeveloperCredit(ctx, inference.RequestedBy, cost, inference.FinishedProcessed()) {
+		k.LogInfo("Escrow drawn from developer credit", types.Payments, "inference", inference.InferenceId, "coins", cost, "payee", inference.RequestedBy)
+		inference.PaidFromCredit = true
+		return cost, nil
+	}
 	payeeAddress, err := sdk.AccAddressFromBech32(inference.RequestedBy)
 	if err != nil {
 		return 0, err
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// Price quotes lock the dynamic price of a model for a developer. An inference started with a quote is charged
+// the quoted price instead of the one recorded by RecordInferencePrice, and reserves its tokens from the quote's
+// budget until it finishes.
+
+func (k Keeper) GetPriceQuote(ctx context.Context, id uint64) (types.PriceQuote, bool) {
+	quote, err := k.PriceQuotes.Get(ctx, id)
+	if err != nil {
+		return types.PriceQuote{}, false
+	}
+	return quote, true
+}
+
+func (k Keeper) SetPriceQuote(ctx context.Context, quote types.PriceQuote) {
+	if err := k.PriceQuotes.Set(ctx, quote.Id, quote); err != nil {
+		panic(err)
+	}
+	if err := k.PriceQuoteExpirations.Set(ctx, collections.Join(quote.ExpirationHeight, quote.Id)); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) GetAllPriceQuotes(ctx context.Context) []types.PriceQuote {
+	iter, err := k.PriceQuotes.Iterate(ctx, nil)
+	if err != nil {
+		return nil
+	}
+	quotes, err := iter.Values()
+	if err != nil {
+		return nil
+	}
+	return quotes
+}
+
+// GetPriceQuoteFor returns the quote an inference of developer for model can be charged with at the current height.
+func (k Keeper) GetPriceQuoteFor(ctx context.Context, id uint64, developer string, model string) (types.PriceQuote, error) {
+	quote, found := k.GetPriceQuote(ctx, id)
+	if !found {
+		return types.PriceQuote{}, errorsmod.Wrapf(types.ErrPriceQuoteNotFound, "quote %d", id)
+	}
+	if quote.Developer != developer || quote.ModelId != model {
+		return types.PriceQuote{}, errorsmod.Wrapf(types.ErrPriceQuoteMismatch, "quote %d is for %s on %s", id, quote.Developer, quote.ModelId)
+	}
+	if sdk.UnwrapSDKContext(ctx).BlockHeight() > quote.ExpirationHeight {
+		return types.PriceQuote{}, errorsmod.Wrapf(types.ErrPriceQuoteExpired, "quote %d expired at height %d", id, quote.ExpirationHeight)
+	}
+	return quote, nil
+}
+
+func (k Keeper) ReservePriceQuoteTokens(ctx context.Context, quote types.PriceQuote, tokens uint64) error {
+	if quote.TokensReserved+tokens > quote.TokenBudget {
+		return errorsmod.Wrapf(types.ErrPriceQuoteBudgetExceeded, "quote %d has %d of %d tokens reserved, %d more requested",
+			quote.Id, quote.TokensReserved, quote.TokenBudget, tokens)
+	}
+	quote.TokensReserved += tokens
+	k.SetPriceQuote(ctx, quote)
+	return nil
+}
+
+// ReleasePriceQuoteTokens returns tokens an inference reserved but did not use to the budget of its quote.
+func (k Keeper) ReleasePriceQuoteTokens(ctx context.Context, id uint64, tokens uint64) {
+	quote, found := k.GetPriceQuote(ctx, id)
+	if !found || tokens == 0 {
+		// Pruned quotes can't be used anymore, nothing to release
+		return
+	}
+	quote.TokensReserved -= min(quote.TokensReserved, tokens)
+	k.SetPriceQuote(ctx, quote)
+}
+
+// PruneExpiredPriceQuotes removes quotes that expired before height.
+func (k Keeper) PruneExpiredPriceQuotes(ctx context.Context, height int64) {
+	iter, err := k.PriceQuoteExpirations.Iterate(ctx, collections.NewPrefixUntilPairRange[int64, uint64](height-1))
+	if err != nil {
+		k.LogError("Failed to iterate price quote expirations", types.Pricing, "error", err)
+		return
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		k.LogError("Failed to read price quote expirations", types.Pricing, "error", err)
+		return
+	}
+	for _, key := range keys {
+		if err := k.PriceQuoteExpirations.Remove(ctx, key); err != nil {
+			k.LogError("Failed to remove price quote expiration", types.Pricing, "quoteId", key.K2(), "error", err)
+		}
+		if err := k.PriceQuotes.Remove(ctx, key.K2()); err != nil {
+			k.LogError("Failed to remove price quote", types.Pricing, "quoteId", key.K2(), "error", err)
+		}
+	}
+}
+
+// inferenceQuoteTokens is what an inference holds of its quote's budget: the tokens it may use while running,
+// the tokens it used once finished.
+func inferenceQuoteTokens(inference *types.Inference) uint64 {
+	if inference.FinishedProcessed() {
+		return inference.PromptTokenCount + inference.CompletionTokenCount
+	}
+	return inference.PromptTokenCount + inference.MaxTokens
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceQuote_LocksPrice(t *testing.T) {
+	h, k, ctx := NewMockInferenceHelper(t)
+	require.NoError(t, k.ModelCurrentPriceMap.Set(ctx, MODEL_ID, 50))
+
+	resp, err := h.MessageServer.RequestPriceQuote(ctx, &types.MsgRequestPriceQuote{
+		Creator:        testutil.Requester,
+		ModelId:        MODEL_ID,
+		DurationBlocks: 100,
+		TokenBudget:    250,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.QuoteId)
+	require.Equal(t, uint64(50), resp.PerTokenPrice)
+
+	// The price moves, the quote keeps the old one
+	require.NoError(t, k.ModelCurrentPriceMap.Set(ctx, MODEL_ID, 500))
+	withQuote := func(maxTokens uint64) func(msg *types.MsgStartInference) {
+		return func(msg *types.MsgStartInference) {
+			msg.PriceQuoteId = resp.QuoteId
+			msg.MaxTokens = maxTokens
+			msg.PromptTokenCount = 10
+		}
+	}
+	inferenceId, err := signAndStartInference(t, h, h.MockRequester, "quoted", withQuote(100))
+	require.NoError(t, err)
+	inference, found := k.GetInference(ctx, inferenceId)
+	require.True(t, found)
+	require.Equal(t, uint64(50), inference.PerTokenPrice)
+	require.Equal(t, int64(110*50), inference.EscrowAmount)
+	require.Equal(t, resp.QuoteId, inference.PriceQuoteId)
+
+	quote, found := k.GetPriceQuote(ctx, resp.QuoteId)
+	require.True(t, found)
+	require.Equal(t, uint64(110), quote.TokensReserved)
+
+	_, err = signAndStartInference(t, h, h.MockRequester, "over budget", withQuote(200))
+	require.ErrorIs(t, err, types.ErrPriceQuoteBudgetExceeded)
+
+	// Without the quote the current price applies
+	inferenceId, err = signAndStartInference(t, h, h.MockRequester, "unquoted", func(msg *types.MsgStartInference) {})
+	require.NoError(t, err)
+	inference, _ = k.GetInference(ctx, inferenceId)
+	require.Equal(t, uint64(500), inference.PerTokenPrice)
+
+	late := ctx.WithBlockHeight(quote.ExpirationHeight + 1)
+	h.context = late
+	_, err = signAndStartInference(t, h, h.MockRequester, "expired", withQuote(10))
+	require.ErrorIs(t, err, types.ErrPriceQuoteExpired)
+
+	k.PruneExpiredPriceQuotes(late, late.BlockHeight())
+	_, found = k.GetPriceQuote(late, resp.QuoteId)
+	require.False(t, found)
+}
+
+func TestPriceQuote_Rejections(t *testing.T) {
+	h, k, ctx := NewMockInferenceHelper(t)
+	require.NoError(t, k.ModelCurrentPriceMap.Set(ctx, MODEL_ID, 50))
+	ms := h.MessageServer
+
+	maxDuration := k.GetParams(ctx).DynamicPricingParams.MaxQuoteDurationBlocks
+	_, err := ms.RequestPriceQuote(ctx, &types.MsgRequestPriceQuote{Creator: testutil.Requester, ModelId: MODEL_ID, DurationBlocks: maxDuration + 1, TokenBudget: 10})
+	require.ErrorIs(t, err, types.ErrInvalidPriceQuote)
+	maxBudget := k.GetParams(ctx).DynamicPricingParams.MaxQuoteTokenBudget
+	require.NotZero(t, maxBudget)
+	_, err = ms.RequestPriceQuote(ctx, &types.MsgRequestPriceQuote{Creator: testutil.Requester, ModelId: MODEL_ID, DurationBlocks: 10, TokenBudget: maxBudget + 1})
+	require.ErrorIs(t, err, types.ErrInvalidPriceQuote)
+	_, err = ms.RequestPriceQuote(ctx, &types.MsgRequestPriceQuote{Creator: testutil.Requester, ModelId: "unknown", DurationBlocks: 10, TokenBudget: 10})
+	require.ErrorIs(t, err, types.ErrInvalidPriceQuote)
+
+	resp, err := ms.RequestPriceQuote(ctx, &types.MsgRequestPriceQuote{Creator: testutil.Executor, ModelId: MODEL_ID, DurationBlocks: 10, TokenBudget: 10})
+	require.NoError(t, err)
+	_, err = signAndStartInference(t, h, h.MockRequester, "someone else's quote", func(msg *types.MsgStartInference) {
+		msg.PriceQuoteId = resp.QuoteId
+	})
+	require.ErrorIs(t, err, types.ErrPriceQuoteMismatch)
+
+	params := k.GetParams(ctx)
+	params.DynamicPricingParams.MaxQuoteDurationBlocks = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = ms.RequestPriceQuote(ctx, &types.MsgRequestPriceQuote{Creator: testutil.Requester, ModelId: MODEL_ID, DurationBlocks: 10, TokenBudget: 10})
+	require.ErrorIs(t, err, types.ErrPriceQuotesDisabled)
+}
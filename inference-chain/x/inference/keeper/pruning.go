@@ -17,22 +17,30 @@ func (k Keeper) Prune(ctx context.Context, currentEpochIndex int64) error {
 	if err != nil {
 		return err
 	}
-	err = k.GetInferencePruner(params).Prune(ctx, k, currentEpochIndex)
+	err = k.GetInferencePruner(params, currentEpochIndex).Prune(ctx, k, currentEpochIndex)
 	if err != nil {
 		return err
 	}
-	err = k.GetPoCBatchesPruner(params).Prune(ctx, k, currentEpochIndex)
+	err = k.GetPoCBatchesPruner(params, currentEpochIndex).Prune(ctx, k, currentEpochIndex)
 	if err != nil {
 		return err
 	}
-	err = k.GetPoCValidationsPruner(params).Prune(ctx, k, currentEpochIndex)
+	err = k.GetPoCValidationsPruner(params, currentEpochIndex).Prune(ctx, k, currentEpochIndex)
+	if err != nil {
+		return err
+	}
+	err = k.GetValidatorEpochStatsPruner(params).Prune(ctx, k, currentEpochIndex)
+	if err != nil {
+		return err
+	}
+	err = k.GetValidatorTargetStatsPruner(params).Prune(ctx, k, currentEpochIndex)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (k Keeper) GetInferencePruner(params types.Params) Pruner[collections.Pair[int64, string], collections.NoValue] {
+func (k Keeper) GetInferencePruner(params types.Params, currentEpochIndex int64) Pruner[collections.Pair[int64, string], collections.NoValue] {
 	return Pruner[collections.Pair[int64, string], collections.NoValue]{
 		Threshold:  params.EpochParams.InferencePruningEpochThreshold,
 		PruningMax: params.EpochParams.InferencePruningMax,
@@ -46,6 +54,13 @@ func (k Keeper) GetInferencePruner(params types.Params) Pruner[collections.Pair[
 		SetLastPruned: func(state *types.PruningState, epoch int64) {
 			state.InferencePrunedEpoch = epoch
 		},
+		Archiver: func(ctx context.Context, epoch int64, key collections.Pair[int64, string]) error {
+			storeKey, err := collections.EncodeKeyWithPrefix(types.InferencesPrefix, k.Inferences.KeyCodec(), key.K2())
+			if err != nil {
+				return err
+			}
+			return k.archivePrunedRecord(ctx, types.ArchiveKindInference, epoch, currentEpochIndex, storeKey)
+		},
 		Remover: func(ctx context.Context, key collections.Pair[int64, string]) error {
 			err := k.Inferences.Remove(ctx, key.K2())
 			if err != nil {
@@ -57,7 +72,7 @@ func (k Keeper) GetInferencePruner(params types.Params) Pruner[collections.Pair[
 	}
 }
 
-func (k Keeper) GetPoCBatchesPruner(params types.Params) Pruner[collections.Triple[int64, sdk.AccAddress, string], types.PoCBatch] {
+func (k Keeper) GetPoCBatchesPruner(params types.Params, currentEpochIndex int64) Pruner[collections.Triple[int64, sdk.AccAddress, string], types.PoCBatch] {
 	return Pruner[collections.Triple[int64, sdk.AccAddress, string], types.PoCBatch]{
 		Threshold:  params.PocParams.PocDataPruningEpochThreshold,
 		PruningMax: params.EpochParams.PocPruningMax,
@@ -77,6 +92,13 @@ func (k Keeper) GetPoCBatchesPruner(params types.Params) Pruner[collections.Trip
 		SetLastPruned: func(state *types.PruningState, epoch int64) {
 			state.PocBatchesPrunedEpoch = epoch
 		},
+		Archiver: func(ctx context.Context, epoch int64, key collections.Triple[int64, sdk.AccAddress, string]) error {
+			storeKey, err := collections.EncodeKeyWithPrefix(k.PoCBatches.GetPrefix(), k.PoCBatches.KeyCodec(), key)
+			if err != nil {
+				return err
+			}
+			return k.archivePrunedRecord(ctx, types.ArchiveKindPoCBatch, epoch, currentEpochIndex, storeKey)
+		},
 		Remover: func(ctx context.Context, key collections.Triple[int64, sdk.AccAddress, string]) error {
 			return k.PoCBatches.Remove(ctx, key)
 		},
@@ -84,7 +106,7 @@ func (k Keeper) GetPoCBatchesPruner(params types.Params) Pruner[collections.Trip
 	}
 }
 
-func (k Keeper) GetPoCValidationsPruner(params types.Params) Pruner[collections.Triple[int64, sdk.AccAddress, sdk.AccAddress], types.PoCValidation] {
+func (k Keeper) GetPoCValidationsPruner(params types.Params, currentEpochIndex int64) Pruner[collections.Triple[int64, sdk.AccAddress, sdk.AccAddress], types.PoCValidation] {
 	return Pruner[collections.Triple[int64, sdk.AccAddress, sdk.AccAddress], types.PoCValidation]{
 		Threshold:  params.PocParams.PocDataPruningEpochThreshold,
 		PruningMax: params.EpochParams.PocPruningMax,
@@ -104,6 +126,13 @@ func (k Keeper) GetPoCValidationsPruner(params types.Params) Pruner[collections.
 		SetLastPruned: func(state *types.PruningState, epoch int64) {
 			state.PocValidationsPrunedEpoch = epoch
 		},
+		Archiver: func(ctx context.Context, epoch int64, key collections.Triple[int64, sdk.AccAddress, sdk.AccAddress]) error {
+			storeKey, err := collections.EncodeKeyWithPrefix(k.PoCValidations.GetPrefix(), k.PoCValidations.KeyCodec(), key)
+			if err != nil {
+				return err
+			}
+			return k.archivePrunedRecord(ctx, types.ArchiveKindPoCValidation, epoch, currentEpochIndex, storeKey)
+		},
 		Remover: func(ctx context.Context, key collections.Triple[int64, sdk.AccAddress, sdk.AccAddress]) error {
 			return k.PoCValidations.Remove(ctx, key)
 		},
@@ -111,15 +140,77 @@ func (k Keeper) GetPoCValidationsPruner(params types.Params) Pruner[collections.
 	}
 }
 
+// GetValidatorEpochStatsPruner prunes validator stats along with the inferences they were counted from
+func (k Keeper) GetValidatorEpochStatsPruner(params types.Params) Pruner[collections.Pair[uint64, sdk.AccAddress], types.ValidatorEpochStats] {
+	return Pruner[collections.Pair[uint64, sdk.AccAddress], types.ValidatorEpochStats]{
+		Threshold:  params.EpochParams.InferencePruningEpochThreshold,
+		PruningMax: params.EpochParams.InferencePruningMax,
+		List:       namedIndexedMap[collections.Pair[uint64, sdk.AccAddress], types.ValidatorEpochStats, ValidatorStatsIndexes]{k.ValidatorEpochStatsMap, "validator_epoch_stats"},
+		Ranger: func(ctx context.Context, epoch int64) collections.Ranger[collections.Pair[uint64, sdk.AccAddress]] {
+			return collections.NewPrefixedPairRange[uint64, sdk.AccAddress](uint64(epoch))
+		},
+		GetLastPruned: func(state types.PruningState) int64 {
+			return state.ValidatorEpochStatsPrunedEpoch
+		},
+		SetLastPruned: func(state *types.PruningState, epoch int64) {
+			state.ValidatorEpochStatsPrunedEpoch = epoch
+		},
+		Remover: func(ctx context.Context, key collections.Pair[uint64, sdk.AccAddress]) error {
+			return k.ValidatorEpochStatsMap.Remove(ctx, key)
+		},
+		Logger: k,
+	}
+}
+
+// GetValidatorTargetStatsPruner prunes per-executor validator stats along with the inferences they were counted from
+func (k Keeper) GetValidatorTargetStatsPruner(params types.Params) Pruner[collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], types.ValidatorTargetStats] {
+	return Pruner[collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], types.ValidatorTargetStats]{
+		Threshold:  params.EpochParams.InferencePruningEpochThreshold,
+		PruningMax: params.EpochParams.InferencePruningMax,
+		List:       k.ValidatorTargetStatsMap,
+		Ranger: func(ctx context.Context, epoch int64) collections.Ranger[collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress]] {
+			return collections.NewPrefixedTripleRange[uint64, sdk.AccAddress, sdk.AccAddress](uint64(epoch))
+		},
+		GetLastPruned: func(state types.PruningState) int64 {
+			return state.ValidatorTargetStatsPrunedEpoch
+		},
+		SetLastPruned: func(state *types.PruningState, epoch int64) {
+			state.ValidatorTargetStatsPrunedEpoch = epoch
+		},
+		Remover: func(ctx context.Context, key collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress]) error {
+			return k.ValidatorTargetStatsMap.Remove(ctx, key)
+		},
+		Logger: k,
+	}
+}
+
+// PrunableList is the part of a collection a Pruner needs to walk it
+type PrunableList[K any, V any] interface {
+	Iterate(ctx context.Context, ranger collections.Ranger[K]) (collections.Iterator[K, V], error)
+	GetName() string
+}
+
+// namedIndexedMap gives an IndexedMap, which has no name of its own, the name pruning logs it under
+type namedIndexedMap[K any, V any, I collections.Indexes[K, V]] struct {
+	*collections.IndexedMap[K, V, I]
+	name string
+}
+
+func (m namedIndexedMap[K, V, I]) GetName() string {
+	return m.name
+}
+
 type Pruner[K any, V any] struct {
 	Threshold     uint64
 	PruningMax    int64
-	List          collections.Map[K, V]
+	List          PrunableList[K, V]
 	Ranger        func(ctx context.Context, epoch int64) collections.Ranger[K]
 	Logger        types.InferenceLogger
 	GetLastPruned func(pruningState types.PruningState) int64
 	SetLastPruned func(pruningState *types.PruningState, epoch int64)
-	Remover       func(ctx context.Context, key K) error
+	// Archiver, if set, is called with the epoch being pruned before each key is removed
+	Archiver func(ctx context.Context, epoch int64, key K) error
+	Remover  func(ctx context.Context, key K) error
 }
 
 func (p Pruner[K, V]) PruneEpoch(ctx context.Context, currentEpochIndex int64, prunesLeft int64) (int64, error) {
@@ -135,6 +226,13 @@ func (p Pruner[K, V]) PruneEpoch(ctx context.Context, currentEpochIndex int64, p
 			p.Logger.LogError("Failed to get key from iterator", types.Pruning, "error", err, "list", p.List.GetName())
 			return prunedCount, err
 		}
+		if p.Archiver != nil {
+			err = p.Archiver(ctx, currentEpochIndex, pk)
+			if err != nil {
+				p.Logger.LogError("Failed to archive pruned entry", types.Pruning, "error", err, "list", p.List.GetName())
+				return prunedCount, err
+			}
+		}
 		err = p.Remover(ctx, pk)
 		if err != nil {
 			p.Logger.LogError("Failed to remove from list to prune", types.Pruning, "error", err, "list", p.List.GetName())
@@ -384,3 +384,93 @@ func TestPoCBatchesPruningMaxLimit_MultiCall_EpochAdvanceAfterEmpty(t *testing.T
 	st, _ = k.PruningState.Get(ctx)
 	require.Equal(t, int64(2), st.PocBatchesPrunedEpoch)
 }
+
+type recordingArchiveSink struct {
+	records []types.ArchivedRecord
+}
+
+func (s *recordingArchiveSink) Archive(_ context.Context, record types.ArchivedRecord) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+// TestPruningArchivesRecords tests that pruned entries reach the archive sink and emit events before deletion
+func TestPruningArchivesRecords(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	require.NoError(t, k.PruningState.Set(ctx, types.PruningState{}))
+	sink := &recordingArchiveSink{}
+	k.SetArchiveSink(sink)
+	ctx = ctx.WithBlockHeight(500)
+
+	inference := types.Inference{
+		Index:   "archived-inference",
+		EpochId: 1,
+		Status:  types.InferenceStatus_FINISHED,
+	}
+	k.SetInferenceWithoutDevStatComputation(ctx, inference)
+	require.NoError(t, k.Epochs.Set(ctx, 2, types.Epoch{Index: 2, PocStartBlockHeight: 20}))
+	k.SetPocBatch(ctx, types.PoCBatch{
+		ParticipantAddress:       mkAddr(1),
+		PocStageStartBlockHeight: 20,
+		BatchId:                  "archived-batch",
+	})
+
+	require.NoError(t, k.Prune(ctx, 4))
+	_, found := k.GetInference(ctx, "archived-inference")
+	require.False(t, found)
+
+	byKind := make(map[string]types.ArchivedRecord)
+	for _, record := range sink.records {
+		byKind[record.Kind] = record
+	}
+	require.Len(t, sink.records, 2)
+
+	inferenceRecord := byKind[types.ArchiveKindInference]
+	require.Equal(t, int64(1), inferenceRecord.Epoch)
+	require.Equal(t, int64(4), inferenceRecord.PruningEpoch)
+	require.Equal(t, int64(500), inferenceRecord.PruningHeight)
+	var archived types.Inference
+	require.NoError(t, k.Codec().Unmarshal(inferenceRecord.Value, &archived))
+	require.Equal(t, "archived-inference", archived.Index)
+
+	batchRecord := byKind[types.ArchiveKindPoCBatch]
+	require.Equal(t, int64(2), batchRecord.Epoch)
+	var batch types.PoCBatch
+	require.NoError(t, k.Codec().Unmarshal(batchRecord.Value, &batch))
+	require.Equal(t, "archived-batch", batch.BatchId)
+
+	prunedEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeRecordPruned {
+			prunedEvents++
+		}
+	}
+	require.Equal(t, 2, prunedEvents)
+}
+
+// TestPruningValidatorStats tests that validator stats are pruned with the inferences of their epoch
+func TestPruningValidatorStats(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	require.NoError(t, k.PruningState.Set(ctx, types.PruningState{}))
+
+	validator, executor := mkAddr(1), mkAddr(2)
+	for epoch := uint64(1); epoch <= 4; epoch++ {
+		k.RecordValidation(ctx, types.Inference{EpochId: epoch, ExecutedBy: executor}, validator)
+	}
+
+	err := k.Prune(ctx, 4) // Current epoch 4, threshold 2
+	require.NoError(t, err)
+
+	history, err := k.ValidatorStatsHistory(ctx, &types.QueryValidatorStatsHistoryRequest{Validator: validator})
+	require.NoError(t, err)
+	require.Len(t, history.Stats, 2)
+	require.Equal(t, uint64(3), history.Stats[0].EpochIndex)
+	require.Len(t, k.GetAllValidatorTargetStats(ctx), 2)
+
+	// Epochs are marked pruned once a pass finds nothing left in them
+	require.NoError(t, k.Prune(ctx, 4))
+	pruningState, err := k.PruningState.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), pruningState.ValidatorEpochStatsPrunedEpoch)
+	require.Equal(t, int64(2), pruningState.ValidatorTargetStatsPrunedEpoch)
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DeveloperCredit(ctx context.Context, req *types.QueryDeveloperCreditRequest) (*types.QueryDeveloperCreditResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Developer); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid developer address")
+	}
+
+	credit, found := k.GetDeveloperCredit(ctx, req.Developer)
+	if !found {
+		credit = types.DeveloperCredit{Developer: req.Developer}
+	}
+	return &types.QueryDeveloperCreditResponse{
+		Credit:      credit,
+		Withdrawals: k.GetCreditWithdrawals(ctx, req.Developer),
+	}, nil
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) InferenceDispute(ctx context.Context, req *types.QueryInferenceDisputeRequest) (*types.QueryInferenceDisputeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	dispute, err := k.InferenceDisputes.Get(ctx, req.InferenceId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "no open dispute")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInferenceDisputeResponse{Dispute: dispute}, nil
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"encoding/base64"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) InferenceKey(ctx context.Context, req *types.QueryInferenceKeyRequest) (*types.QueryInferenceKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	keyAddr, err := sdk.AccAddressFromBech32(req.KeyAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid key address")
+	}
+
+	grant, found := k.GetInferenceKeyGrant(ctx, req.Developer, req.KeyAddress)
+	if !found {
+		return nil, status.Error(codes.NotFound, "inference key not found")
+	}
+	usage, found := k.GetInferenceKeyUsage(ctx, req.Developer, req.KeyAddress)
+	if !found {
+		usage = types.InferenceKeyUsage{Developer: req.Developer, KeyAddress: req.KeyAddress}
+	}
+	epochIndex, _ := k.GetEffectiveEpochIndex(ctx)
+	usage.EpochSpent = usage.SpentInEpoch(epochIndex)
+	usage.EpochIndex = epochIndex
+
+	var pubKey string
+	if acc := k.AccountKeeper.GetAccount(ctx, keyAddr); acc != nil && acc.GetPubKey() != nil {
+		pubKey = base64.StdEncoding.EncodeToString(acc.GetPubKey().Bytes())
+	}
+	return &types.QueryInferenceKeyResponse{Grant: grant, Usage: usage, PubKey: pubKey}, nil
+}
+
+func (k Keeper) InferenceKeys(ctx context.Context, req *types.QueryInferenceKeysRequest) (*types.QueryInferenceKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	developer, err := sdk.AccAddressFromBech32(req.Developer)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid developer address")
+	}
+
+	grants, usages := k.GetInferenceKeys(ctx, developer)
+	return &types.QueryInferenceKeysResponse{Grants: grants, Usages: usages}, nil
+}
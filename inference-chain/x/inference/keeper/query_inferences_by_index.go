@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) InferencesByRequester(ctx context.Context, req *types.QueryInferencesByRequesterRequest) (*types.QueryInferencesByRequesterResponse, error) {
+	if req == nil || req.Requester == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	inferences, pageRes, err := k.paginateInferenceIndex(ctx, k.Inferences.Indexes.Requester, req.Requester, req.EpochId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryInferencesByRequesterResponse{Inference: inferences, Pagination: pageRes}, nil
+}
+
+func (k Keeper) InferencesByExecutor(ctx context.Context, req *types.QueryInferencesByExecutorRequest) (*types.QueryInferencesByExecutorResponse, error) {
+	if req == nil || req.Executor == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	inferences, pageRes, err := k.paginateInferenceIndex(ctx, k.Inferences.Indexes.Executor, req.Executor, req.EpochId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryInferencesByExecutorResponse{Inference: inferences, Pagination: pageRes}, nil
+}
+
+func (k Keeper) InferencesByStatus(ctx context.Context, req *types.QueryInferencesByStatusRequest) (*types.QueryInferencesByStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, ok := types.InferenceStatus_name[int32(req.Status)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+	inferences, pageRes, err := k.paginateInferenceIndex(ctx, k.Inferences.Indexes.Status, req.Status.String(), req.EpochId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryInferencesByStatusResponse{Inference: inferences, Pagination: pageRes}, nil
+}
+
+// paginateInferenceIndex pages through the inferences an index has under ref, only those of epochId unless it is 0
+func (k Keeper) paginateInferenceIndex(ctx context.Context, index *InferenceIndex, ref string, epochId uint64, pageReq *query.PageRequest) ([]types.Inference, *query.PageResponse, error) {
+	prefix := collections.TriplePrefix[string, uint64, string](ref)
+	if epochId != 0 {
+		prefix = collections.TripleSuperPrefix[string, uint64, string](ref, epochId)
+	}
+	inferences, pageRes, err := query.CollectionPaginate(
+		ctx,
+		index.Keys,
+		pageReq,
+		func(key InferenceIndexKey, _ collections.NoValue) (types.Inference, error) {
+			return k.Inferences.Get(ctx, key.K3())
+		},
+		func(o *query.CollectionsPaginateOptions[InferenceIndexKey]) {
+			o.Prefix = &prefix
+		},
+	)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return inferences, pageRes, nil
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func inferenceIds(inferences []types.Inference) []string {
+	ids := make([]string, len(inferences))
+	for i, inference := range inferences {
+		ids[i] = inference.InferenceId
+	}
+	return ids
+}
+
+func setIndexedInference(t *testing.T, k keeper.Keeper, ctx context.Context, id string, epoch uint64, requester, assignedTo string, status types.InferenceStatus) types.Inference {
+	inference := types.Inference{
+		Index:       id,
+		InferenceId: id,
+		EpochId:     epoch,
+		RequestedBy: requester,
+		AssignedTo:  assignedTo,
+		Status:      status,
+	}
+	require.NoError(t, k.SetInferenceWithoutDevStatComputation(ctx, inference))
+	return inference
+}
+
+func TestInferencesByIndex(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	requester, otherRequester, executor, otherExecutor := mkAddr(1), mkAddr(2), mkAddr(3), mkAddr(4)
+
+	setIndexedInference(t, k, ctx, "a", 1, requester, executor, types.InferenceStatus_STARTED)
+	setIndexedInference(t, k, ctx, "b", 1, otherRequester, executor, types.InferenceStatus_STARTED)
+	setIndexedInference(t, k, ctx, "c", 2, requester, otherExecutor, types.InferenceStatus_STARTED)
+
+	byRequester, err := k.InferencesByRequester(ctx, &types.QueryInferencesByRequesterRequest{Requester: requester})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"a", "c"}, inferenceIds(byRequester.Inference))
+
+	byRequester, err = k.InferencesByRequester(ctx, &types.QueryInferencesByRequesterRequest{Requester: requester, EpochId: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"c"}, inferenceIds(byRequester.Inference))
+
+	// Finishing moves the status index and adds the actual executor next to the assigned one
+	b, found := k.GetInference(ctx, "b")
+	require.True(t, found)
+	b.Status = types.InferenceStatus_FINISHED
+	b.ExecutedBy = otherExecutor
+	require.NoError(t, k.SetInferenceWithoutDevStatComputation(ctx, b))
+
+	byStatus, err := k.InferencesByStatus(ctx, &types.QueryInferencesByStatusRequest{Status: types.InferenceStatus_STARTED})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"a", "c"}, inferenceIds(byStatus.Inference))
+	byStatus, err = k.InferencesByStatus(ctx, &types.QueryInferencesByStatusRequest{Status: types.InferenceStatus_FINISHED, EpochId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{"b"}, inferenceIds(byStatus.Inference))
+
+	byExecutor, err := k.InferencesByExecutor(ctx, &types.QueryInferencesByExecutorRequest{Executor: otherExecutor})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"b", "c"}, inferenceIds(byExecutor.Inference))
+	byExecutor, err = k.InferencesByExecutor(ctx, &types.QueryInferencesByExecutorRequest{Executor: executor})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"a", "b"}, inferenceIds(byExecutor.Inference))
+
+	k.RemoveInference(ctx, "a")
+	byExecutor, err = k.InferencesByExecutor(ctx, &types.QueryInferencesByExecutorRequest{Executor: executor})
+	require.NoError(t, err)
+	require.Equal(t, []string{"b"}, inferenceIds(byExecutor.Inference))
+
+	_, err = k.InferencesByRequester(ctx, &types.QueryInferencesByRequesterRequest{})
+	require.Error(t, err)
+	_, err = k.InferencesByStatus(ctx, &types.QueryInferencesByStatusRequest{Status: types.InferenceStatus(42)})
+	require.Error(t, err)
+}
+
+func TestInferencesByIndexPagination(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	requester := mkAddr(1)
+	for i := 0; i < 7; i++ {
+		setIndexedInference(t, k, ctx, fmt.Sprintf("inf-%d", i), 1, requester, mkAddr(2), types.InferenceStatus_FINISHED)
+	}
+
+	var ids []string
+	var next []byte
+	for {
+		res, err := k.InferencesByRequester(ctx, &types.QueryInferencesByRequesterRequest{
+			Requester:  requester,
+			Pagination: &query.PageRequest{Key: next, Limit: 3, CountTotal: next == nil},
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.Inference), 3)
+		if next == nil {
+			require.Equal(t, uint64(7), res.Pagination.Total)
+		}
+		ids = append(ids, inferenceIds(res.Inference)...)
+		next = res.Pagination.NextKey
+		if next == nil {
+			break
+		}
+	}
+	require.Equal(t, []string{"inf-0", "inf-1", "inf-2", "inf-3", "inf-4", "inf-5", "inf-6"}, ids)
+}
+
+func TestPruningRemovesInferenceIndexes(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	require.NoError(t, k.PruningState.Set(ctx, types.PruningState{}))
+	requester, executor := mkAddr(1), mkAddr(2)
+	setIndexedInference(t, k, ctx, "old", 1, requester, executor, types.InferenceStatus_VALIDATED)
+	setIndexedInference(t, k, ctx, "new", 4, requester, executor, types.InferenceStatus_VALIDATED)
+
+	require.NoError(t, k.Prune(ctx, 4))
+
+	for _, index := range []*keeper.InferenceIndex{k.Inferences.Indexes.Requester, k.Inferences.Indexes.Executor, k.Inferences.Indexes.Status} {
+		keys, err := index.Keys.Iterate(ctx, nil)
+		require.NoError(t, err)
+		stored, err := keys.Keys()
+		require.NoError(t, err)
+		require.Len(t, stored, 1)
+		require.Equal(t, "new", stored[0].K3())
+	}
+}
+
+func TestReindexInferences(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	requester := mkAddr(1)
+	setIndexedInference(t, k, ctx, "a", 1, requester, mkAddr(2), types.InferenceStatus_FINISHED)
+	setIndexedInference(t, k, ctx, "b", 2, requester, mkAddr(2), types.InferenceStatus_FINISHED)
+
+	// Drop the index entries, as for inferences stored before the indexes existed
+	require.NoError(t, k.Inferences.Indexes.Requester.Keys.Clear(ctx, nil))
+	res, err := k.InferencesByRequester(ctx, &types.QueryInferencesByRequesterRequest{Requester: requester})
+	require.NoError(t, err)
+	require.Empty(t, res.Inference)
+
+	require.NoError(t, k.ReindexInferences(ctx))
+	res, err = k.InferencesByRequester(ctx, &types.QueryInferencesByRequesterRequest{Requester: requester})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, inferenceIds(res.Inference))
+
+	has, err := k.Inferences.Indexes.Status.Keys.Has(ctx, collections.Join3(types.InferenceStatus_FINISHED.String(), uint64(2), "b"))
+	require.NoError(t, err)
+	require.True(t, has)
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ParticipantExit(ctx context.Context, req *types.QueryParticipantExitRequest) (*types.QueryParticipantExitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	exit, found := k.GetParticipantExit(ctx, req.Participant)
+	if !found {
+		return nil, status.Error(codes.NotFound, "participant exit not found")
+	}
+	return &types.QueryParticipantExitResponse{Exit: exit}, nil
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PriceQuote(ctx context.Context, req *types.QueryPriceQuoteRequest) (*types.QueryPriceQuoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	quote, found := k.GetPriceQuote(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "price quote not found")
+	}
+	return &types.QueryPriceQuoteResponse{Quote: quote}, nil
+}
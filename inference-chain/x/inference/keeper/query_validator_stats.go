@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultValidatorStatsLimit = 100
+
+func (k Keeper) ValidatorStats(ctx context.Context, req *types.QueryValidatorStatsRequest) (*types.QueryValidatorStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	validator, err := sdk.AccAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator address")
+	}
+	stats, err := k.GetValidatorEpochStats(ctx, req.EpochIndex, validator)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryValidatorStatsResponse{Stats: stats}, nil
+}
+
+func (k Keeper) ValidatorStatsHistory(ctx context.Context, req *types.QueryValidatorStatsHistoryRequest) (*types.QueryValidatorStatsHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	validator, err := sdk.AccAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator address")
+	}
+	prefix := collections.PairPrefix[sdk.AccAddress, uint64](validator)
+	history, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.ValidatorEpochStatsMap.Indexes.ByValidator,
+		req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.ValidatorEpochStats, error) {
+			return k.ValidatorEpochStatsMap.Get(ctx, collections.Join(key.K2(), key.K1()))
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Pair[sdk.AccAddress, uint64]]) {
+			o.Prefix = &prefix
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryValidatorStatsHistoryResponse{Stats: history, Pagination: pageRes}, nil
+}
+
+// ValidatorStatsRanked ranks the validators of an epoch by a counter, highest first with ties broken by address.
+// Ranking needs every validator of the epoch, so pagination is by offset and limit rather than by key.
+func (k Keeper) ValidatorStatsRanked(ctx context.Context, req *types.QueryValidatorStatsRankedRequest) (*types.QueryValidatorStatsRankedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	rankValue, ok := validatorStatsRankings[req.RankBy]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid ranking")
+	}
+	if req.Pagination != nil && req.Pagination.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "ranked validator stats are paginated by offset, not key")
+	}
+
+	iter, err := k.ValidatorEpochStatsMap.Iterate(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](req.EpochIndex))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ranked, err := iter.Values()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := rankValue(ranked[i]), rankValue(ranked[j])
+		if a != b {
+			return a > b
+		}
+		return ranked[i].Validator < ranked[j].Validator
+	})
+
+	offset, limit := uint64(0), uint64(defaultValidatorStatsLimit)
+	if req.Pagination != nil {
+		offset = req.Pagination.Offset
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+	total := uint64(len(ranked))
+	start := min(offset, total)
+	end := min(start+limit, total)
+	return &types.QueryValidatorStatsRankedResponse{
+		Stats:      ranked[start:end],
+		Pagination: &query.PageResponse{Total: total},
+	}, nil
+}
+
+var validatorStatsRankings = map[types.ValidatorStatsRanking]func(stats types.ValidatorEpochStats) uint64{
+	types.ValidatorStatsRanking_RANK_BY_VALIDATIONS:              func(stats types.ValidatorEpochStats) uint64 { return stats.Validations },
+	types.ValidatorStatsRanking_RANK_BY_INVALIDATIONS:            func(stats types.ValidatorEpochStats) uint64 { return stats.Invalidations },
+	types.ValidatorStatsRanking_RANK_BY_UPHELD_INVALIDATIONS:     func(stats types.ValidatorEpochStats) uint64 { return stats.UpheldInvalidations },
+	types.ValidatorStatsRanking_RANK_BY_OVERTURNED_INVALIDATIONS: func(stats types.ValidatorEpochStats) uint64 { return stats.OverturnedInvalidations },
+	types.ValidatorStatsRanking_RANK_BY_MISSED:                   func(stats types.ValidatorEpochStats) uint64 { return stats.Missed },
+}
+
+func (k Keeper) ValidatorTargetStats(ctx context.Context, req *types.QueryValidatorTargetStatsRequest) (*types.QueryValidatorTargetStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	validator, err := sdk.AccAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator address")
+	}
+	iter, err := k.ValidatorTargetStatsMap.Iterate(ctx, collections.NewSuperPrefixedTripleRange[uint64, sdk.AccAddress, sdk.AccAddress](req.EpochIndex, validator))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	targets, err := iter.Values()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryValidatorTargetStatsResponse{Stats: targets}, nil
+}
@@ -70,6 +70,7 @@ func setupRealStreamVestingKeepers(t testing.TB) (sdk.Context, keeper.Keeper, st
 		authority.String(),
 		nil,                   // bank keeper
 		bookkeepingBankKeeper, // bank escrow keeper
+		nil,                   // distribution keeper
 	)
 
 	// Create a BLS keeper for testing (similar to testutil/keeper/inference.go)
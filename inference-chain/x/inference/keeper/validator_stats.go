@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// ValidatorStatsIndexes are the secondary indexes of the ValidatorEpochStatsMap
+type ValidatorStatsIndexes struct {
+	// ByValidator indexes the (epoch, validator) keys by validator, for the stats history of one validator
+	ByValidator *indexes.ReversePair[uint64, sdk.AccAddress, types.ValidatorEpochStats]
+}
+
+func (i ValidatorStatsIndexes) IndexesList() []collections.Index[collections.Pair[uint64, sdk.AccAddress], types.ValidatorEpochStats] {
+	return []collections.Index[collections.Pair[uint64, sdk.AccAddress], types.ValidatorEpochStats]{i.ByValidator}
+}
+
+func newValidatorStatsIndexes(sb *collections.SchemaBuilder) ValidatorStatsIndexes {
+	return ValidatorStatsIndexes{
+		ByValidator: indexes.NewReversePair[types.ValidatorEpochStats](
+			sb,
+			types.ValidatorStatsByValidatorPrefix,
+			"validator_stats_by_validator",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey),
+		),
+	}
+}
+
+// GetValidatorEpochStats returns the stats of a validator in an epoch, all zero if it has none
+func (k Keeper) GetValidatorEpochStats(ctx context.Context, epochIndex uint64, validator sdk.AccAddress) (types.ValidatorEpochStats, error) {
+	stats, err := k.ValidatorEpochStatsMap.Get(ctx, collections.Join(epochIndex, validator))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ValidatorEpochStats{EpochIndex: epochIndex, Validator: validator.String()}, nil
+	}
+	return stats, err
+}
+
+func (k Keeper) getValidatorTargetStats(ctx context.Context, epochIndex uint64, validator, executor sdk.AccAddress) (types.ValidatorTargetStats, error) {
+	stats, err := k.ValidatorTargetStatsMap.Get(ctx, collections.Join3(epochIndex, validator, executor))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ValidatorTargetStats{EpochIndex: epochIndex, Validator: validator.String(), Executor: executor.String()}, nil
+	}
+	return stats, err
+}
+
+// SetValidatorEpochStats stores the stats of a validator in an epoch, as imported from genesis
+func (k Keeper) SetValidatorEpochStats(ctx context.Context, stats types.ValidatorEpochStats) {
+	if err := k.ValidatorEpochStatsMap.Set(ctx, collections.Join(stats.EpochIndex, sdk.MustAccAddressFromBech32(stats.Validator)), stats); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) GetAllValidatorEpochStats(ctx context.Context) []types.ValidatorEpochStats {
+	iter, err := k.ValidatorEpochStatsMap.Iterate(ctx, nil)
+	if err != nil {
+		return nil
+	}
+	stats, err := iter.Values()
+	if err != nil {
+		return nil
+	}
+	return stats
+}
+
+// SetValidatorTargetStats stores the stats of a validator against an executor in an epoch, as imported from genesis
+func (k Keeper) SetValidatorTargetStats(ctx context.Context, stats types.ValidatorTargetStats) {
+	key := collections.Join3(stats.EpochIndex, sdk.MustAccAddressFromBech32(stats.Validator), sdk.MustAccAddressFromBech32(stats.Executor))
+	if err := k.ValidatorTargetStatsMap.Set(ctx, key, stats); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) GetAllValidatorTargetStats(ctx context.Context) []types.ValidatorTargetStats {
+	iter, err := k.ValidatorTargetStatsMap.Iterate(ctx, nil)
+	if err != nil {
+		return nil
+	}
+	stats, err := iter.Values()
+	if err != nil {
+		return nil
+	}
+	return stats
+}
+
+// updateValidatorStats applies updateEpoch to the stats of the validator in the epoch of the inference and, when
+// updateTarget is set, updateTarget to its stats against the executor of the inference. Stats are informational,
+// so failures are logged rather than failing the message that caused them.
+func (k Keeper) updateValidatorStats(
+	ctx context.Context,
+	inference types.Inference,
+	validator string,
+	updateEpoch func(stats *types.ValidatorEpochStats),
+	updateTarget func(stats *types.ValidatorTargetStats),
+) {
+	validatorAddr, err := sdk.AccAddressFromBech32(validator)
+	if err != nil {
+		k.LogError("Invalid validator address for stats", types.Validation, "validator", validator, "inferenceId", inference.InferenceId)
+		return
+	}
+	stats, err := k.GetValidatorEpochStats(ctx, inference.EpochId, validatorAddr)
+	if err != nil {
+		k.LogError("Failed to get validator stats", types.Validation, "validator", validator, "epochIndex", inference.EpochId, "error", err)
+		return
+	}
+	updateEpoch(&stats)
+	if err := k.ValidatorEpochStatsMap.Set(ctx, collections.Join(inference.EpochId, validatorAddr), stats); err != nil {
+		k.LogError("Failed to set validator stats", types.Validation, "validator", validator, "epochIndex", inference.EpochId, "error", err)
+		return
+	}
+
+	if updateTarget == nil || inference.ExecutedBy == "" {
+		return
+	}
+	executorAddr, err := sdk.AccAddressFromBech32(inference.ExecutedBy)
+	if err != nil {
+		k.LogError("Invalid executor address for stats", types.Validation, "executor", inference.ExecutedBy, "inferenceId", inference.InferenceId)
+		return
+	}
+	targetStats, err := k.getValidatorTargetStats(ctx, inference.EpochId, validatorAddr, executorAddr)
+	if err != nil {
+		k.LogError("Failed to get validator target stats", types.Validation, "validator", validator, "executor", inference.ExecutedBy, "error", err)
+		return
+	}
+	updateTarget(&targetStats)
+	if err := k.ValidatorTargetStatsMap.Set(ctx, collections.Join3(inference.EpochId, validatorAddr, executorAddr), targetStats); err != nil {
+		k.LogError("Failed to set validator target stats", types.Validation, "validator", validator, "executor", inference.ExecutedBy, "error", err)
+	}
+}
+
+// RecordValidation counts a validation of the inference by the validator, before its result is known
+func (k Keeper) RecordValidation(ctx context.Context, inference types.Inference, validator string) {
+	k.updateValidatorStats(ctx, inference, validator,
+		func(stats *types.ValidatorEpochStats) { stats.Validations++ },
+		func(stats *types.ValidatorTargetStats) { stats.Validations++ },
+	)
+}
+
+// RecordValidationResult counts the validation of the inference by the validator as passed or as an invalidation
+func (k Keeper) RecordValidationResult(ctx context.Context, inference types.Inference, validator string, passed bool) {
+	k.updateValidatorStats(ctx, inference, validator,
+		func(stats *types.ValidatorEpochStats) {
+			if passed {
+				stats.Passed++
+			} else {
+				stats.Invalidations++
+			}
+		},
+		func(stats *types.ValidatorTargetStats) {
+			if passed {
+				stats.Passed++
+			} else {
+				stats.Invalidations++
+			}
+		},
+	)
+}
+
+// RecordRevalidation counts a vote by the validator on an inference flagged by someone else
+func (k Keeper) RecordRevalidation(ctx context.Context, inference types.Inference, validator string) {
+	k.updateValidatorStats(ctx, inference, validator,
+		func(stats *types.ValidatorEpochStats) { stats.Revalidations++ },
+		nil,
+	)
+}
+
+// RecordInvalidationOutcome counts how the vote on an inference flagged by the invalidator ended
+func (k Keeper) RecordInvalidationOutcome(ctx context.Context, inference types.Inference, invalidator string, upheld bool) {
+	k.updateValidatorStats(ctx, inference, invalidator,
+		func(stats *types.ValidatorEpochStats) {
+			if upheld {
+				stats.UpheldInvalidations++
+			} else {
+				stats.OverturnedInvalidations++
+			}
+		},
+		func(stats *types.ValidatorTargetStats) {
+			if upheld {
+				stats.UpheldInvalidations++
+			} else {
+				stats.OverturnedInvalidations++
+			}
+		},
+	)
+}
+
+// RecordMissedValidations stores how many of the inferences the validator had to validate in the epoch it missed.
+// It is computed on claim, and a repeated claim overwrites the earlier numbers.
+func (k Keeper) RecordMissedValidations(ctx context.Context, epochIndex uint64, validator string, mustValidate, missed int) {
+	k.updateValidatorStats(ctx, types.Inference{EpochId: epochIndex}, validator,
+		func(stats *types.ValidatorEpochStats) {
+			stats.MustValidate = uint64(mustValidate)
+			stats.Missed = uint64(missed)
+		},
+		nil,
+	)
+}
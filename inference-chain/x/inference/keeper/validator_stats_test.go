@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/productscience/inference/testutil"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func rankedValidators(stats []types.ValidatorEpochStats) []string {
+	validators := make([]string, len(stats))
+	for i, s := range stats {
+		validators[i] = s.Validator
+	}
+	return validators
+}
+
+func TestValidatorStats(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	validator, executor, otherExecutor := mkAddr(1), mkAddr(2), mkAddr(3)
+	inference := types.Inference{InferenceId: "a", EpochId: 3, ExecutedBy: executor}
+	otherInference := types.Inference{InferenceId: "b", EpochId: 3, ExecutedBy: otherExecutor}
+
+	k.RecordValidation(ctx, inference, validator)
+	k.RecordValidationResult(ctx, inference, validator, false)
+	k.RecordInvalidationOutcome(ctx, inference, validator, true)
+	k.RecordValidation(ctx, otherInference, validator)
+	k.RecordValidationResult(ctx, otherInference, validator, true)
+	k.RecordRevalidation(ctx, types.Inference{InferenceId: "c", EpochId: 3, ExecutedBy: executor}, validator)
+	k.RecordMissedValidations(ctx, 3, validator, 10, 4)
+	k.RecordValidation(ctx, types.Inference{InferenceId: "d", EpochId: 4, ExecutedBy: executor}, validator)
+
+	res, err := k.ValidatorStats(ctx, &types.QueryValidatorStatsRequest{Validator: validator, EpochIndex: 3})
+	require.NoError(t, err)
+	require.Equal(t, types.ValidatorEpochStats{
+		EpochIndex:          3,
+		Validator:           validator,
+		Validations:         2,
+		Passed:              1,
+		Invalidations:       1,
+		UpheldInvalidations: 1,
+		Revalidations:       1,
+		MustValidate:        10,
+		Missed:              4,
+	}, res.Stats)
+
+	targets, err := k.ValidatorTargetStats(ctx, &types.QueryValidatorTargetStatsRequest{Validator: validator, EpochIndex: 3})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.ValidatorTargetStats{
+		{EpochIndex: 3, Validator: validator, Executor: executor, Validations: 1, Invalidations: 1, UpheldInvalidations: 1},
+		{EpochIndex: 3, Validator: validator, Executor: otherExecutor, Validations: 1, Passed: 1},
+	}, targets.Stats)
+
+	history, err := k.ValidatorStatsHistory(ctx, &types.QueryValidatorStatsHistoryRequest{Validator: validator})
+	require.NoError(t, err)
+	require.Len(t, history.Stats, 2)
+	require.Equal(t, uint64(3), history.Stats[0].EpochIndex)
+	require.Equal(t, uint64(4), history.Stats[1].EpochIndex)
+
+	page, err := k.ValidatorStatsHistory(ctx, &types.QueryValidatorStatsHistoryRequest{Validator: validator, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, page.Stats, 1)
+	require.Equal(t, uint64(3), page.Stats[0].EpochIndex)
+	require.Equal(t, uint64(2), page.Pagination.Total)
+	page, err = k.ValidatorStatsHistory(ctx, &types.QueryValidatorStatsHistoryRequest{Validator: validator, Pagination: &query.PageRequest{Key: page.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, page.Stats, 1)
+	require.Equal(t, uint64(4), page.Stats[0].EpochIndex)
+
+	// A validator without stats gets zeros rather than an error
+	res, err = k.ValidatorStats(ctx, &types.QueryValidatorStatsRequest{Validator: executor, EpochIndex: 3})
+	require.NoError(t, err)
+	require.Equal(t, types.ValidatorEpochStats{EpochIndex: 3, Validator: executor}, res.Stats)
+
+	_, err = k.ValidatorStats(ctx, &types.QueryValidatorStatsRequest{Validator: "invalid", EpochIndex: 3})
+	require.Error(t, err)
+}
+
+func TestValidatorStatsRanked(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	validators := []string{mkAddr(1), mkAddr(2), mkAddr(3)}
+	for i, validator := range validators {
+		for n := 0; n <= i; n++ {
+			k.RecordValidation(ctx, types.Inference{EpochId: 1, ExecutedBy: mkAddr(9)}, validator)
+		}
+		k.RecordMissedValidations(ctx, 1, validator, 10, 10-i)
+	}
+	// Another epoch is not ranked along
+	k.RecordMissedValidations(ctx, 2, mkAddr(4), 10, 10)
+
+	res, err := k.ValidatorStatsRanked(ctx, &types.QueryValidatorStatsRankedRequest{EpochIndex: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{validators[2], validators[1], validators[0]}, rankedValidators(res.Stats))
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res, err = k.ValidatorStatsRanked(ctx, &types.QueryValidatorStatsRankedRequest{
+		EpochIndex: 1,
+		RankBy:     types.ValidatorStatsRanking_RANK_BY_MISSED,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{validators[1]}, rankedValidators(res.Stats))
+
+	res, err = k.ValidatorStatsRanked(ctx, &types.QueryValidatorStatsRankedRequest{EpochIndex: 1, Pagination: &query.PageRequest{Offset: 5}})
+	require.NoError(t, err)
+	require.Empty(t, res.Stats)
+
+	_, err = k.ValidatorStatsRanked(ctx, &types.QueryValidatorStatsRankedRequest{EpochIndex: 1, RankBy: types.ValidatorStatsRanking(42)})
+	require.Error(t, err)
+}
+
+func TestMsgServer_Validation_RecordsValidatorStats(t *testing.T) {
+	inferenceHelper, k, ctx := NewMockInferenceHelper(t)
+	createParticipants(t, inferenceHelper.MessageServer, ctx)
+
+	model := &types.Model{Id: MODEL_ID, ValidationThreshold: &types.Decimal{Value: 85, Exponent: -2}}
+	k.SetModel(ctx, model)
+	StubModelSubgroup(t, ctx, k, inferenceHelper.Mocks, model)
+	addMembersToGroupData(k, ctx)
+
+	expected, err := inferenceHelper.StartInference("promptPayload", model.Id, time.Now().UnixNano(), calculations.DefaultMaxTokens)
+	require.NoError(t, err)
+	_, err = inferenceHelper.FinishInference()
+	require.NoError(t, err)
+	_, err = inferenceHelper.MessageServer.Validation(ctx, &types.MsgValidation{
+		InferenceId: expected.InferenceId,
+		Creator:     testutil.Validator,
+		Value:       0.9999,
+	})
+	require.NoError(t, err)
+
+	inference, found := k.GetInference(ctx, expected.InferenceId)
+	require.True(t, found)
+	stats, err := k.GetValidatorEpochStats(ctx, inference.EpochId, sdk.MustAccAddressFromBech32(testutil.Validator))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.Validations)
+	require.Equal(t, uint64(1), stats.Passed)
+
+	targets, err := k.ValidatorTargetStats(ctx, &types.QueryValidatorTargetStatsRequest{Validator: testutil.Validator, EpochIndex: inference.EpochId})
+	require.NoError(t, err)
+	require.Len(t, targets.Stats, 1)
+	require.Equal(t, inference.ExecutedBy, targets.Stats[0].Executor)
+}
+
+func TestInvalidateInference_RecordsUpheldInvalidation(t *testing.T) {
+	k, ms, ctx, mocks := setupInvalidateHarness(t)
+	require.NoError(t, setEffectiveEpoch(ctx, k, 1, mocks))
+
+	executorAddr, payerAddr, invalidator := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	k.SetParticipant(ctx, types.Participant{Index: executorAddr, Address: executorAddr, CurrentEpochStats: &types.CurrentEpochStats{}})
+	k.SetParticipant(ctx, types.Participant{Index: payerAddr, Address: payerAddr, CurrentEpochStats: &types.CurrentEpochStats{}})
+	k.SetInference(ctx, types.Inference{
+		Index:           "upheld",
+		InferenceId:     "upheld",
+		ExecutedBy:      executorAddr,
+		RequestedBy:     payerAddr,
+		Status:          types.InferenceStatus_VOTING,
+		ProposalDetails: &types.ProposalDetails{PolicyAddress: payerAddr},
+		EpochId:         1,
+	})
+
+	// Decided in the next epoch, so nothing is refunded
+	require.NoError(t, setEffectiveEpoch(ctx, k, 2, mocks))
+	mocks.CollateralKeeper.EXPECT().Slash(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := ms.InvalidateInference(ctx, &types.MsgInvalidateInference{Creator: payerAddr, InferenceId: "upheld", Invalidator: invalidator})
+	require.NoError(t, err)
+
+	stats, err := k.GetValidatorEpochStats(ctx, 1, sdk.MustAccAddressFromBech32(invalidator))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.UpheldInvalidations)
+	require.Zero(t, stats.OverturnedInvalidations)
+}
@@ -307,6 +307,84 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Get cached capacity for a specific model",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "model_id"}},
 				},
+				{
+					RpcMethod:      "InferenceDispute",
+					Use:            "inference-dispute [inference-id]",
+					Short:          "Show the open dispute of an inference",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "inference_id"}},
+				},
+				{
+					RpcMethod:      "DeveloperCredit",
+					Use:            "developer-credit [developer]",
+					Short:          "Show the prepaid credit balance and pending withdrawals of a developer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "developer"}},
+				},
+				{
+					RpcMethod:      "InferenceKey",
+					Use:            "inference-key [developer] [key-address]",
+					Short:          "Show the limits and usage of an inference key",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "developer"}, {ProtoField: "key_address"}},
+				},
+				{
+					RpcMethod:      "InferenceKeys",
+					Use:            "inference-keys [developer]",
+					Short:          "List the inference keys of a developer with their usage",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "developer"}},
+				},
+				{
+					RpcMethod:      "PriceQuote",
+					Use:            "price-quote [id]",
+					Short:          "Show a price quote and how much of its token budget is reserved",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "ParticipantExit",
+					Use:            "participant-exit [participant]",
+					Short:          "Show the exit of a participant leaving the network",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "participant"}},
+				},
+				{
+					RpcMethod:      "InferencesByRequester",
+					Use:            "inferences-by-requester [requester]",
+					Short:          "List the stored inferences requested by an address, of one epoch with --epoch-id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "requester"}},
+				},
+				{
+					RpcMethod:      "InferencesByExecutor",
+					Use:            "inferences-by-executor [executor]",
+					Short:          "List the stored inferences assigned to or executed by an address, of one epoch with --epoch-id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "executor"}},
+				},
+				{
+					RpcMethod:      "InferencesByStatus",
+					Use:            "inferences-by-status [status]",
+					Short:          "List the stored inferences with a status (STARTED, FINISHED, VALIDATED, ...), of one epoch with --epoch-id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "status"}},
+				},
+				{
+					RpcMethod:      "ValidatorStats",
+					Use:            "validator-stats [validator] [epoch-index]",
+					Short:          "Show the validation stats of a validator in an epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}, {ProtoField: "epoch_index"}},
+				},
+				{
+					RpcMethod:      "ValidatorStatsHistory",
+					Use:            "validator-stats-history [validator]",
+					Short:          "List the validation stats of a validator for every epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}},
+				},
+				{
+					RpcMethod:      "ValidatorStatsRanked",
+					Use:            "validator-stats-ranked [epoch-index]",
+					Short:          "Rank the validators of an epoch by a stat, chosen with --rank-by (RANK_BY_VALIDATIONS, RANK_BY_MISSED, ...)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "epoch_index"}},
+				},
+				{
+					RpcMethod:      "ValidatorTargetStats",
+					Use:            "validator-target-stats [validator] [epoch-index]",
+					Short:          "List the validation stats of a validator in an epoch per executor validated",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}, {ProtoField: "epoch_index"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -444,6 +522,52 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a setTrainingAllowList tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "authority"}, {ProtoField: "addresses"}},
 				},
+				{
+					RpcMethod:      "DisputeInference",
+					Use:            "dispute-inference [inference-id]",
+					Short:          "Dispute an inference you paid for, posting the dispute bond",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "inference_id"}},
+				},
+				{
+					RpcMethod:      "DepositCredit",
+					Use:            "deposit-credit [amount]",
+					Short:          "Deposit prepaid credit to pay for inferences",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "WithdrawCredit",
+					Use:            "withdraw-credit [amount]",
+					Short:          "Withdraw unused prepaid credit after the withdrawal delay",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "GrantInferenceKey",
+					Use:            "grant-inference-key [key-address]",
+					Short:          "Let a key sign inference requests for you, limits are set with flags",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key_address"}},
+				},
+				{
+					RpcMethod:      "RevokeInferenceKey",
+					Use:            "revoke-inference-key [key-address]",
+					Short:          "Revoke an inference key",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key_address"}},
+				},
+				{
+					RpcMethod:      "RequestPriceQuote",
+					Use:            "request-price-quote [model-id] [duration-blocks] [token-budget]",
+					Short:          "Lock the current price of a model for your inferences",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "model_id"}, {ProtoField: "duration_blocks"}, {ProtoField: "token_budget"}},
+				},
+				{
+					RpcMethod: "LeaveNetwork",
+					Use:       "leave-network",
+					Short:     "Leave the network after the current epoch and start unbonding your collateral",
+				},
+				{
+					RpcMethod: "UpdateParticipantProfile",
+					Use:       "update-participant-profile",
+					Short:     "Update your public profile; a new inference URL takes effect after a delay",
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
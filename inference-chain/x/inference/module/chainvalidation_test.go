@@ -195,6 +195,10 @@ func TestNoCollateralPostGracePeriod(t *testing.T) {
 		GetCollateral(gomock.Any(), addr).
 		Return(sdk.Coin{}, false). // No collateral found
 		Times(1)
+	mocks.CollateralKeeper.EXPECT().
+		GetDelegatedCollateral(gomock.Any(), addr).
+		Return(sdk.NewCoin(types.BaseCoin, math.ZeroInt())).
+		Times(1)
 
 	// Execute the core logic that adjusts weights
 	err = k.AdjustWeightsByCollateral(ctx, participants)
@@ -242,6 +246,10 @@ func TestPostGracePeriod_FullCollateral(t *testing.T) {
 		GetCollateral(gomock.Any(), addr).
 		Return(requiredCollateral, true). // Full collateral found
 		Times(1)
+	mocks.CollateralKeeper.EXPECT().
+		GetDelegatedCollateral(gomock.Any(), addr).
+		Return(sdk.NewCoin(types.BaseCoin, math.ZeroInt())).
+		Times(1)
 
 	// Execute the core logic that adjusts weights
 	err = k.AdjustWeightsByCollateral(ctx, participants)
@@ -289,6 +297,10 @@ func TestPostGracePeriod_PartialCollateral(t *testing.T) {
 		GetCollateral(gomock.Any(), addr).
 		Return(partialCollateral, true). // Partial collateral found
 		Times(1)
+	mocks.CollateralKeeper.EXPECT().
+		GetDelegatedCollateral(gomock.Any(), addr).
+		Return(sdk.NewCoin(types.BaseCoin, math.ZeroInt())).
+		Times(1)
 
 	// Execute the core logic that adjusts weights
 	err = k.AdjustWeightsByCollateral(ctx, participants)
@@ -303,6 +315,47 @@ func TestPostGracePeriod_PartialCollateral(t *testing.T) {
 		"With partial collateral post-grace period, Weight should be BaseWeight + ActivatedWeight")
 }
 
+func TestPostGracePeriod_DelegatedCollateral(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+
+	inferenceParams := types.DefaultParams()
+	inferenceParams.CollateralParams.GracePeriodEndEpoch = 2
+	inferenceParams.CollateralParams.BaseWeightRatio = types.DecimalFromFloat(0.2)
+	inferenceParams.CollateralParams.CollateralPerWeightUnit = types.DecimalFromFloat(1.0)
+	k.SetParams(ctx, inferenceParams)
+
+	k.SetEffectiveEpochIndex(ctx, 4)
+	currentEpoch := types.Epoch{Index: 5}
+	k.SetEpoch(ctx, &currentEpoch)
+
+	participantAddress := sample.AccAddress()
+	participants := []*types.ActiveParticipant{
+		{
+			Index:  participantAddress,
+			Weight: 1000,
+		},
+	}
+
+	// The host has no collateral of its own, delegators back 300 of the eligible 800
+	addr, err := sdk.AccAddressFromBech32(participantAddress)
+	require.NoError(t, err)
+	mocks.CollateralKeeper.EXPECT().
+		GetCollateral(gomock.Any(), addr).
+		Return(sdk.Coin{}, false).
+		Times(1)
+	mocks.CollateralKeeper.EXPECT().
+		GetDelegatedCollateral(gomock.Any(), addr).
+		Return(sdk.NewCoin(types.BaseCoin, math.NewInt(300))).
+		Times(1)
+
+	err = k.AdjustWeightsByCollateral(ctx, participants)
+	require.NoError(t, err)
+
+	// Base Weight 200 + 300 activated by delegated collateral
+	require.Equal(t, int64(500), participants[0].Weight,
+		"Delegated collateral should activate weight like the host's own collateral")
+}
+
 func TestComputeNewWeights(t *testing.T) {
 	sdk.GetConfig().SetBech32PrefixForAccount("gonka", "gonkapub")
 	sdk.GetConfig().SetBech32PrefixForValidator("gonkavaloper", "gonkavaloperpub")
@@ -4,6 +4,7 @@ import (
 	"log"
 	"strings"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -64,6 +65,44 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	for _, credit := range genState.DeveloperCreditList {
+		k.SetDeveloperCredit(ctx, credit)
+	}
+	for _, withdrawal := range genState.CreditWithdrawalList {
+		k.AddCreditWithdrawal(ctx, withdrawal)
+	}
+	for _, grant := range genState.InferenceKeyGrantList {
+		k.SetInferenceKeyGrant(ctx, grant)
+	}
+	for _, usage := range genState.InferenceKeyUsageList {
+		k.SetInferenceKeyUsage(ctx, usage)
+	}
+	var lastQuoteId uint64
+	for _, quote := range genState.PriceQuoteList {
+		k.SetPriceQuote(ctx, quote)
+		lastQuoteId = max(lastQuoteId, quote.Id)
+	}
+	if err := k.PriceQuoteSequence.Set(ctx, lastQuoteId); err != nil {
+		panic(err)
+	}
+	for _, exit := range genState.ParticipantExitList {
+		k.SetParticipantExit(ctx, exit)
+	}
+	for _, stats := range genState.ValidatorEpochStatsList {
+		k.SetValidatorEpochStats(ctx, stats)
+	}
+	for _, stats := range genState.ValidatorTargetStatsList {
+		k.SetValidatorTargetStats(ctx, stats)
+	}
+	// Pending inference URL changes are queued from the profiles of the participants
+	for _, p := range genState.ParticipantList {
+		if p.Profile != nil && p.Profile.PendingInferenceUrl != "" {
+			if err := k.InferenceUrlChanges.Set(ctx, collections.Join(p.Profile.InferenceUrlEffectiveHeight, sdk.MustAccAddressFromBech32(p.Address))); err != nil {
+				panic(err)
+			}
+		}
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -237,6 +276,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// Export participants
 	participants := k.GetAllParticipant(ctx)
 	genesis.ParticipantList = participants
+	genesis.DeveloperCreditList = k.GetAllDeveloperCredits(ctx)
+	genesis.CreditWithdrawalList = k.GetAllCreditWithdrawals(ctx)
+	genesis.InferenceKeyGrantList = k.GetAllInferenceKeyGrants(ctx)
+	genesis.InferenceKeyUsageList = k.GetAllInferenceKeyUsages(ctx)
+	genesis.PriceQuoteList = k.GetAllPriceQuotes(ctx)
+	genesis.ParticipantExitList = k.GetAllParticipantExits(ctx)
+	genesis.ValidatorEpochStatsList = k.GetAllValidatorEpochStats(ctx)
+	genesis.ValidatorTargetStatsList = k.GetAllValidatorTargetStats(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -5,6 +5,7 @@ import (
 
 	keepertest "github.com/productscience/inference/testutil/keeper"
 	"github.com/productscience/inference/testutil/nullify"
+	"github.com/productscience/inference/testutil/sample"
 	inference "github.com/productscience/inference/x/inference/module"
 	"github.com/productscience/inference/x/inference/types"
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,7 @@ import (
 
 func TestGenesis(t *testing.T) {
 	baseGenesis := types.MockedGenesis()
+	validator, executor := sample.AccAddress(), sample.AccAddress()
 	genesisState := types.GenesisState{
 		Params:            types.DefaultParams(),
 		GenesisOnlyParams: types.DefaultGenesisOnlyParams(),
@@ -29,6 +31,12 @@ func TestGenesis(t *testing.T) {
 				ValidationThreshold:    &types.Decimal{Value: 99, Exponent: -2},
 			},
 		},
+		ValidatorEpochStatsList: []types.ValidatorEpochStats{
+			{EpochIndex: 3, Validator: validator, Validations: 5, Passed: 4, Invalidations: 1},
+		},
+		ValidatorTargetStatsList: []types.ValidatorTargetStats{
+			{EpochIndex: 3, Validator: validator, Executor: executor, Validations: 5, Passed: 4, Invalidations: 1},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -44,5 +52,7 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(got)
 
 	require.ElementsMatch(t, genesisState.ModelList, got.ModelList)
+	require.ElementsMatch(t, genesisState.ValidatorEpochStatsList, got.ValidatorEpochStatsList)
+	require.ElementsMatch(t, genesisState.ValidatorTargetStatsList, got.ValidatorTargetStatsList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -23,9 +24,11 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/productscience/inference/testenv"
+	"github.com/productscience/inference/x/inference/archive"
 	"github.com/productscience/inference/x/inference/calculations"
 	"github.com/productscience/inference/x/inference/epochgroup"
 	"github.com/shopspring/decimal"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 
 	// this line is used by starport scaffolding # 1
@@ -144,7 +147,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the module's genesis initialization. It returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) {
@@ -163,7 +168,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
-func (AppModule) ConsensusVersion() uint64 { return 7 }
+func (AppModule) ConsensusVersion() uint64 { return 8 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 func (am AppModule) BeginBlock(ctx context.Context) error {
@@ -200,7 +205,14 @@ func (am AppModule) handleExpiredInference(ctx context.Context, inference types.
 	am.LogInfo("Inference expired, not finished. Issuing refund", types.Inferences, "inferenceId", inference.InferenceId, "executor", inference.AssignedTo)
 	inference.Status = types.InferenceStatus_EXPIRED
 	inference.ActualCost = 0
-	err := am.keeper.IssueRefund(ctx, inference.EscrowAmount, inference.RequestedBy, "expired_inference:"+inference.InferenceId)
+	if inference.PaidFromCredit {
+		am.keeper.SettleDeveloperCredit(ctx, inference.RequestedBy, inference.EscrowAmount)
+	}
+	if inference.PriceQuoteId != 0 {
+		am.keeper.ReleasePriceQuoteTokens(ctx, inference.PriceQuoteId, inference.PromptTokenCount+inference.MaxTokens)
+	}
+	am.keeper.ReleaseInferenceKeyCharge(ctx, &inference, uint64(max(inference.EscrowAmount, 0)))
+	err := am.keeper.RefundInference(ctx, &inference, inference.EscrowAmount, "expired_inference:"+inference.InferenceId)
 	if err != nil {
 		am.LogError("Error issuing refund", types.Inferences, "error", err)
 	}
@@ -208,6 +220,7 @@ func (am AppModule) handleExpiredInference(ctx context.Context, inference types.
 	if err != nil {
 		am.LogError("Error updating inference", types.Inferences, "error", err)
 	}
+	am.keeper.EmitInferenceStatus(ctx, &inference)
 	executor.CurrentEpochStats.MissedRequests++
 	err = am.keeper.SetParticipant(ctx, executor)
 	if err != nil {
@@ -251,6 +264,10 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 	for _, t := range timeouts {
 		am.keeper.RemoveInferenceTimeout(ctx, t.ExpirationHeight, t.InferenceId)
 	}
+	am.keeper.ProcessCreditWithdrawals(ctx, blockHeight)
+	am.keeper.PruneExpiredPriceQuotes(ctx, blockHeight)
+	am.keeper.ExpireDisputes(ctx, blockHeight)
+	am.keeper.ApplyInferenceUrlChanges(ctx, blockHeight)
 
 	err = am.keeper.Prune(ctx, int64(currentEpoch.Index))
 	if err != nil {
@@ -402,6 +419,12 @@ func (am AppModule) onEndOfPoCValidationStage(ctx context.Context, blockHeight i
 		return
 	}
 
+	// Participants jailed by the inference module get no weight until the epoch after they unjail themselves
+	activeParticipants = am.keeper.ExcludeJailedParticipants(ctx, activeParticipants)
+	// Participants that left the network served their last epoch, which has just been settled
+	activeParticipants = am.keeper.ExcludeExitingParticipants(ctx, activeParticipants, upcomingEpoch.Index)
+	am.keeper.CompleteParticipantExits(ctx, effectiveEpoch.Index)
+
 	modelAssigner := NewModelAssigner(am.keeper, am.keeper)
 	modelAssigner.setModelsForParticipants(ctx, activeParticipants, *upcomingEpoch)
 
@@ -769,6 +792,7 @@ type ModuleInputs struct {
 	StreamVestingKeeper types.StreamVestingKeeper
 	AuthzKeeper         authzkeeper.Keeper
 	GetWasmKeeper       func() wasmkeeper.Keeper `optional:"true"`
+	AppOpts             servertypes.AppOptions   `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -803,6 +827,15 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AuthzKeeper,
 		in.GetWasmKeeper,
 	)
+	if in.AppOpts != nil {
+		if archiveDir := cast.ToString(in.AppOpts.Get(archive.FlagArchiveDir)); archiveDir != "" {
+			sink, err := archive.NewFileSink(archiveDir)
+			if err != nil {
+				panic(fmt.Sprintf("failed to open archive dir %s: %v", archiveDir, err))
+			}
+			k.SetArchiveSink(sink)
+		}
+	}
 
 	m := NewAppModule(
 		in.Cdc,
@@ -0,0 +1,32 @@
+package types
+
+import "context"
+
+const (
+	ArchiveKindInference     = "inference"
+	ArchiveKindPoCBatch      = "poc_batch"
+	ArchiveKindPoCValidation = "poc_validation"
+
+	// EventTypeRecordPruned is emitted for every store entry removed by pruning. It carries the store key
+	// and a hash of the value so archived copies can be matched against block results.
+	EventTypeRecordPruned = "record_pruned"
+)
+
+// ArchivedRecord is a raw store entry removed by pruning. Key is the full key in the inference store and
+// Value the bytes stored under it, so the record can be proven against AppHash, which is the app hash of
+// the last state the entry was part of (the one committed at PruningHeight-1).
+type ArchivedRecord struct {
+	Kind          string `json:"kind"`
+	Epoch         int64  `json:"epoch"`
+	PruningEpoch  int64  `json:"pruning_epoch"`
+	PruningHeight int64  `json:"pruning_height"`
+	AppHash       []byte `json:"app_hash"`
+	Key           []byte `json:"key"`
+	Value         []byte `json:"value"`
+}
+
+// ArchiveSink receives records before pruning deletes them. Sinks are node-local, so a failing sink
+// never fails the block.
+type ArchiveSink interface {
+	Archive(ctx context.Context, record ArchivedRecord) error
+}
@@ -89,6 +89,33 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSetTrainingAllowList{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSubmitInferenceEvidence{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDisputeInference{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDepositCredit{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgWithdrawCredit{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrantInferenceKey{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRevokeInferenceKey{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRequestPriceQuote{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgLeaveNetwork{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParticipantProfile{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
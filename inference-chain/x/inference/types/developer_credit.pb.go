@@ -0,0 +1,710 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/inference/developer_credit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DeveloperCredit is a prepaid balance inferences are paid from instead of per-request escrow transfers.
+type DeveloperCredit struct {
+	Developer string `protobuf:"bytes,1,opt,name=developer,proto3" json:"developer,omitempty"`
+	// available can be drawn by new inferences or withdrawn
+	Available uint64 `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	// reserved is held for inferences that started but did not finish yet
+	Reserved uint64 `protobuf:"varint,3,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	// settled is the total spent on finished inferences, net of refunds
+	Settled uint64 `protobuf:"varint,4,opt,name=settled,proto3" json:"settled,omitempty"`
+	// pending_withdrawal was withdrawn but is not paid out yet
+	PendingWithdrawal uint64 `protobuf:"varint,5,opt,name=pending_withdrawal,json=pendingWithdrawal,proto3" json:"pending_withdrawal,omitempty"`
+}
+
+func (m *DeveloperCredit) Reset()         { *m = DeveloperCredit{} }
+func (m *DeveloperCredit) String() string { return proto.CompactTextString(m) }
+func (*DeveloperCredit) ProtoMessage()    {}
+func (*DeveloperCredit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b631a8f78d6a0d7c, []int{0}
+}
+func (m *DeveloperCredit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeveloperCredit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeveloperCredit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeveloperCredit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeveloperCredit.Merge(m, src)
+}
+func (m *DeveloperCredit) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeveloperCredit) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeveloperCredit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeveloperCredit proto.InternalMessageInfo
+
+func (m *DeveloperCredit) GetDeveloper() string {
+	if m != nil {
+		return m.Developer
+	}
+	return ""
+}
+
+func (m *DeveloperCredit) GetAvailable() uint64 {
+	if m != nil {
+		return m.Available
+	}
+	return 0
+}
+
+func (m *DeveloperCredit) GetReserved() uint64 {
+	if m != nil {
+		return m.Reserved
+	}
+	return 0
+}
+
+func (m *DeveloperCredit) GetSettled() uint64 {
+	if m != nil {
+		return m.Settled
+	}
+	return 0
+}
+
+func (m *DeveloperCredit) GetPendingWithdrawal() uint64 {
+	if m != nil {
+		return m.PendingWithdrawal
+	}
+	return 0
+}
+
+type CreditWithdrawal struct {
+	Developer     string `protobuf:"bytes,1,opt,name=developer,proto3" json:"developer,omitempty"`
+	Amount        uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ReleaseHeight int64  `protobuf:"varint,3,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
+}
+
+func (m *CreditWithdrawal) Reset()         { *m = CreditWithdrawal{} }
+func (m *CreditWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*CreditWithdrawal) ProtoMessage()    {}
+func (*CreditWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b631a8f78d6a0d7c, []int{1}
+}
+func (m *CreditWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreditWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreditWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreditWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditWithdrawal.Merge(m, src)
+}
+func (m *CreditWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreditWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreditWithdrawal proto.InternalMessageInfo
+
+func (m *CreditWithdrawal) GetDeveloper() string {
+	if m != nil {
+		return m.Developer
+	}
+	return ""
+}
+
+func (m *CreditWithdrawal) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *CreditWithdrawal) GetReleaseHeight() int64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DeveloperCredit)(nil), "inference.inference.DeveloperCredit")
+	proto.RegisterType((*CreditWithdrawal)(nil), "inference.inference.CreditWithdrawal")
+}
+
+func init() {
+	proto.RegisterFile("inference/inference/developer_credit.proto", fileDescriptor_b631a8f78d6a0d7c)
+}
+
+var fileDescriptor_b631a8f78d6a0d7c = []byte{
+	// 291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x4f, 0x4a, 0xc3, 0x40,
+	0x18, 0xc5, 0x33, 0xb6, 0x56, 0x3b, 0xe0, 0xbf, 0x11, 0x64, 0x10, 0x19, 0x4a, 0x41, 0x28, 0x82,
+	0xe9, 0x42, 0xbc, 0x80, 0xba, 0x70, 0x27, 0x64, 0x23, 0xb8, 0x09, 0x93, 0xcc, 0x67, 0x32, 0x30,
+	0xcd, 0x84, 0xc9, 0x24, 0xd5, 0x5b, 0x78, 0x16, 0x4f, 0xe1, 0xb2, 0x4b, 0x97, 0x92, 0x5c, 0x44,
+	0x1c, 0xd3, 0x44, 0xdc, 0x74, 0xf7, 0xde, 0xfb, 0x3d, 0x3e, 0x1e, 0x7c, 0xf8, 0x42, 0x66, 0xcf,
+	0x60, 0x20, 0x8b, 0x61, 0xde, 0x2b, 0x01, 0x15, 0x28, 0x9d, 0x83, 0x09, 0x63, 0x03, 0x42, 0x5a,
+	0x3f, 0x37, 0xda, 0x6a, 0x72, 0xdc, 0x35, 0xfc, 0x4e, 0x4d, 0xdf, 0x11, 0x3e, 0xb8, 0x5b, 0xf7,
+	0x6f, 0x5d, 0x9d, 0x9c, 0xe1, 0x71, 0x77, 0x82, 0xa2, 0x09, 0x9a, 0x8d, 0x83, 0x3e, 0xf8, 0xa1,
+	0xbc, 0xe2, 0x52, 0xf1, 0x48, 0x01, 0xdd, 0x9a, 0xa0, 0xd9, 0x30, 0xe8, 0x03, 0x72, 0x8a, 0x77,
+	0x0d, 0x14, 0x60, 0x2a, 0x10, 0x74, 0xe0, 0x60, 0xe7, 0x09, 0xc5, 0x3b, 0x05, 0x58, 0xab, 0x40,
+	0xd0, 0xa1, 0x43, 0x6b, 0x4b, 0x2e, 0x31, 0xc9, 0x21, 0x13, 0x32, 0x4b, 0xc2, 0xa5, 0xb4, 0xa9,
+	0x30, 0x7c, 0xc9, 0x15, 0xdd, 0x76, 0xa5, 0xa3, 0x96, 0x3c, 0x76, 0x60, 0xaa, 0xf1, 0xe1, 0xef,
+	0xd4, 0x3e, 0xdb, 0x30, 0xfa, 0x04, 0x8f, 0xf8, 0x42, 0x97, 0x99, 0x6d, 0x17, 0xb7, 0x8e, 0x9c,
+	0xe3, 0x7d, 0x03, 0x0a, 0x78, 0x01, 0x61, 0x0a, 0x32, 0x49, 0xad, 0x1b, 0x3d, 0x08, 0xf6, 0xda,
+	0xf4, 0xde, 0x85, 0x37, 0x0f, 0x1f, 0x35, 0x43, 0xab, 0x9a, 0xa1, 0xaf, 0x9a, 0xa1, 0xb7, 0x86,
+	0x79, 0xab, 0x86, 0x79, 0x9f, 0x0d, 0xf3, 0x9e, 0xae, 0x13, 0x69, 0xd3, 0x32, 0xf2, 0x63, 0xbd,
+	0x98, 0xe7, 0x46, 0x8b, 0x32, 0xb6, 0x45, 0x2c, 0xff, 0x3d, 0xe4, 0xe5, 0x8f, 0xb6, 0xaf, 0x39,
+	0x14, 0xd1, 0xc8, 0xbd, 0xe4, 0xea, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x1d, 0x2d, 0x2f, 0xc0,
+	0x01, 0x00, 0x00,
+}
+
+func (m *DeveloperCredit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeveloperCredit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeveloperCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingWithdrawal != 0 {
+		i = encodeVarintDeveloperCredit(dAtA, i, uint64(m.PendingWithdrawal))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Settled != 0 {
+		i = encodeVarintDeveloperCredit(dAtA, i, uint64(m.Settled))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Reserved != 0 {
+		i = encodeVarintDeveloperCredit(dAtA, i, uint64(m.Reserved))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Available != 0 {
+		i = encodeVarintDeveloperCredit(dAtA, i, uint64(m.Available))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Developer) > 0 {
+		i -= len(m.Developer)
+		copy(dAtA[i:], m.Developer)
+		i = encodeVarintDeveloperCredit(dAtA, i, uint64(len(m.Developer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreditWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreditWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreditWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintDeveloperCredit(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Amount != 0 {
+		i = encodeVarintDeveloperCredit(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Developer) > 0 {
+		i -= len(m.Developer)
+		copy(dAtA[i:], m.Developer)
+		i = encodeVarintDeveloperCredit(dAtA, i, uint64(len(m.Developer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDeveloperCredit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDeveloperCredit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DeveloperCredit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Developer)
+	if l > 0 {
+		n += 1 + l + sovDeveloperCredit(uint64(l))
+	}
+	if m.Available != 0 {
+		n += 1 + sovDeveloperCredit(uint64(m.Available))
+	}
+	if m.Reserved != 0 {
+		n += 1 + sovDeveloperCredit(uint64(m.Reserved))
+	}
+	if m.Settled != 0 {
+		n += 1 + sovDeveloperCredit(uint64(m.Settled))
+	}
+	if m.PendingWithdrawal != 0 {
+		n += 1 + sovDeveloperCredit(uint64(m.PendingWithdrawal))
+	}
+	return n
+}
+
+func (m *CreditWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Developer)
+	if l > 0 {
+		n += 1 + l + sovDeveloperCredit(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovDeveloperCredit(uint64(m.Amount))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovDeveloperCredit(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func sovDeveloperCredit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDeveloperCredit(x uint64) (n int) {
+	return sovDeveloperCredit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DeveloperCredit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeveloperCredit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeveloperCredit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeveloperCredit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeveloperCredit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeveloperCredit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeveloperCredit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Developer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			m.Available = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeveloperCredit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Available |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
+			}
+			m.Reserved = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeveloperCredit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reserved |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+			}
+			m.Settled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeveloperCredit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Settled |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWithdrawal", wireType)
+			}
+			m.PendingWithdrawal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeveloperCredit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingWithdrawal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeveloperCredit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeveloperCredit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreditWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeveloperCredit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreditWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreditWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeveloperCredit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeveloperCredit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeveloperCredit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Developer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeveloperCredit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeveloperCredit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeveloperCredit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeveloperCredit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDeveloperCredit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDeveloperCredit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeveloperCredit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeveloperCredit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDeveloperCredit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDeveloperCredit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDeveloperCredit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDeveloperCredit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDeveloperCredit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDeveloperCredit = fmt.Errorf("proto: unexpected end of group")
+)
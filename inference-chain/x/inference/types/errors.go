@@ -56,4 +56,27 @@ var (
 	ErrSignatureTooOld                       = sdkerrors.Register(ModuleName, 1150, "signature is too old")
 	ErrSignatureInFuture                     = sdkerrors.Register(ModuleName, 1151, "signature is in the future")
 	ErrValidationPayloadDeprecated           = sdkerrors.Register(ModuleName, 1152, "validation response payload is deprecated")
+	ErrInvalidEvidence                       = sdkerrors.Register(ModuleName, 1153, "invalid inference evidence")
+	ErrEvidenceAlreadySubmitted              = sdkerrors.Register(ModuleName, 1154, "evidence for this inference was already submitted")
+	ErrDisputesDisabled                      = sdkerrors.Register(ModuleName, 1155, "inference disputes are disabled")
+	ErrNotInferenceRequester                 = sdkerrors.Register(ModuleName, 1156, "only the requester can dispute an inference")
+	ErrInferenceNotDisputable                = sdkerrors.Register(ModuleName, 1157, "inference cannot be disputed in its current status")
+	ErrDisputeWindowClosed                   = sdkerrors.Register(ModuleName, 1158, "dispute window for this inference is closed")
+	ErrDisputeAlreadyOpen                    = sdkerrors.Register(ModuleName, 1159, "inference is already disputed")
+	ErrNotEnoughDisputeValidators            = sdkerrors.Register(ModuleName, 1160, "not enough validators to resolve the dispute")
+	ErrInsufficientCredit                    = sdkerrors.Register(ModuleName, 1161, "insufficient available developer credit")
+	ErrInferenceKeyNotFound                  = sdkerrors.Register(ModuleName, 1162, "inference key not granted by the requester")
+	ErrInferenceKeyExpired                   = sdkerrors.Register(ModuleName, 1163, "inference key has expired")
+	ErrInferenceKeyModelNotAllowed           = sdkerrors.Register(ModuleName, 1164, "model not allowed for inference key")
+	ErrInferenceKeyMaxTokensExceeded         = sdkerrors.Register(ModuleName, 1165, "max tokens exceed the limit of the inference key")
+	ErrInferenceKeySpendLimitExceeded        = sdkerrors.Register(ModuleName, 1166, "inference key epoch spend limit exceeded")
+	ErrPriceQuotesDisabled                   = sdkerrors.Register(ModuleName, 1167, "price quotes are disabled")
+	ErrInvalidPriceQuote                     = sdkerrors.Register(ModuleName, 1168, "invalid price quote request")
+	ErrPriceQuoteNotFound                    = sdkerrors.Register(ModuleName, 1169, "price quote not found")
+	ErrPriceQuoteMismatch                    = sdkerrors.Register(ModuleName, 1170, "price quote does not belong to this requester and model")
+	ErrPriceQuoteExpired                     = sdkerrors.Register(ModuleName, 1171, "price quote has expired")
+	ErrPriceQuoteBudgetExceeded              = sdkerrors.Register(ModuleName, 1172, "price quote token budget exceeded")
+	ErrParticipantAlreadyLeaving             = sdkerrors.Register(ModuleName, 1173, "participant is already leaving the network")
+	ErrInvalidParticipantProfile             = sdkerrors.Register(ModuleName, 1174, "invalid participant profile")
+	ErrProfileUpdateRateLimited              = sdkerrors.Register(ModuleName, 1175, "participant profile update limit reached for this epoch")
 )
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 	blstypes "github.com/productscience/inference/x/bls/types"
+	collateraltypes "github.com/productscience/inference/x/collateral/types"
 )
 
 // AccountKeeper defines the expected interface for the account module.
@@ -77,7 +78,14 @@ type StakingKeeper interface {
 type CollateralKeeper interface {
 	AdvanceEpoch(ctx context.Context, completedEpoch uint64)
 	GetCollateral(ctx context.Context, participant sdk.AccAddress) (collateral sdk.Coin, found bool)
+	GetDelegatedCollateral(ctx context.Context, host sdk.AccAddress) sdk.Coin
+	GetDelegationsToHost(ctx context.Context, host sdk.AccAddress) []collateraltypes.CollateralDelegation
+	GetCommissionRate(ctx context.Context, host sdk.AccAddress) math.LegacyDec
 	Slash(ctx context.Context, participant sdk.AccAddress, slashFraction math.LegacyDec) (sdk.Coin, error)
+	SlashWithReward(ctx context.Context, participant sdk.AccAddress, slashFraction math.LegacyDec, rewardModule string, rewardFraction math.LegacyDec) (sdk.Coin, sdk.Coin, error)
+	Jail(ctx context.Context, participant sdk.AccAddress, reason string, slashed sdk.Coin, requiredCollateral sdk.Coin) collateraltypes.JailRecord
+	IsJailedForInference(ctx context.Context, participant sdk.AccAddress) bool
+	UnbondAllCollateral(ctx context.Context, participant sdk.AccAddress) sdk.Coin
 }
 
 // StreamVestingKeeper defines the expected interface for the StreamVesting module.
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
@@ -35,6 +41,82 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	credits := make(map[string]bool)
+	for _, credit := range gs.DeveloperCreditList {
+		if _, err := sdk.AccAddressFromBech32(credit.Developer); err != nil {
+			return fmt.Errorf("invalid developer credit address %s: %w", credit.Developer, err)
+		}
+		if credits[credit.Developer] {
+			return fmt.Errorf("duplicate developer credit for %s", credit.Developer)
+		}
+		credits[credit.Developer] = true
+	}
+	for _, withdrawal := range gs.CreditWithdrawalList {
+		if !credits[withdrawal.Developer] {
+			return fmt.Errorf("credit withdrawal for %s without developer credit", withdrawal.Developer)
+		}
+	}
+	grants := make(map[string]bool)
+	for _, grant := range gs.InferenceKeyGrantList {
+		if err := grant.Validate(); err != nil {
+			return err
+		}
+		key := grant.Developer + "/" + grant.KeyAddress
+		if grants[key] {
+			return fmt.Errorf("duplicate inference key %s for %s", grant.KeyAddress, grant.Developer)
+		}
+		grants[key] = true
+	}
+	for _, usage := range gs.InferenceKeyUsageList {
+		if !grants[usage.Developer+"/"+usage.KeyAddress] {
+			return fmt.Errorf("inference key usage for %s without grant from %s", usage.KeyAddress, usage.Developer)
+		}
+	}
+	quotes := make(map[uint64]bool)
+	for _, quote := range gs.PriceQuoteList {
+		if _, err := sdk.AccAddressFromBech32(quote.Developer); err != nil {
+			return fmt.Errorf("invalid price quote developer %s: %w", quote.Developer, err)
+		}
+		if quote.Id == 0 || quotes[quote.Id] {
+			return fmt.Errorf("invalid or duplicate price quote id %d", quote.Id)
+		}
+		quotes[quote.Id] = true
+	}
+	exits := make(map[string]bool)
+	for _, exit := range gs.ParticipantExitList {
+		if _, err := sdk.AccAddressFromBech32(exit.Participant); err != nil {
+			return fmt.Errorf("invalid participant exit address %s: %w", exit.Participant, err)
+		}
+		if exits[exit.Participant] {
+			return fmt.Errorf("duplicate participant exit for %s", exit.Participant)
+		}
+		exits[exit.Participant] = true
+	}
+	epochStats := make(map[string]bool)
+	for _, stats := range gs.ValidatorEpochStatsList {
+		if _, err := sdk.AccAddressFromBech32(stats.Validator); err != nil {
+			return fmt.Errorf("invalid validator stats address %s: %w", stats.Validator, err)
+		}
+		key := fmt.Sprintf("%d/%s", stats.EpochIndex, stats.Validator)
+		if epochStats[key] {
+			return fmt.Errorf("duplicate validator stats for %s in epoch %d", stats.Validator, stats.EpochIndex)
+		}
+		epochStats[key] = true
+	}
+	targetStats := make(map[string]bool)
+	for _, stats := range gs.ValidatorTargetStatsList {
+		if _, err := sdk.AccAddressFromBech32(stats.Validator); err != nil {
+			return fmt.Errorf("invalid validator stats address %s: %w", stats.Validator, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(stats.Executor); err != nil {
+			return fmt.Errorf("invalid validator stats executor %s: %w", stats.Executor, err)
+		}
+		key := fmt.Sprintf("%d/%s/%s", stats.EpochIndex, stats.Validator, stats.Executor)
+		if targetStats[key] {
+			return fmt.Errorf("duplicate validator stats for %s against %s in epoch %d", stats.Validator, stats.Executor, stats.EpochIndex)
+		}
+		targetStats[key] = true
+	}
 
 	return gs.Params.Validate()
 }
@@ -27,12 +27,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the inference module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params            Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	GenesisOnlyParams GenesisOnlyParams `protobuf:"bytes,2,opt,name=genesis_only_params,json=genesisOnlyParams,proto3" json:"genesis_only_params"`
-	ModelList         []Model           `protobuf:"bytes,3,rep,name=model_list,json=modelList,proto3" json:"model_list"`
-	CosmWasmParams    CosmWasmParams    `protobuf:"bytes,4,opt,name=cosm_wasm_params,json=cosmWasmParams,proto3" json:"cosm_wasm_params"`
-	ParticipantList   []Participant     `protobuf:"bytes,5,rep,name=participant_list,json=participantList,proto3" json:"participant_list"`
-	MlnodeVersion     *MLNodeVersion    `protobuf:"bytes,6,opt,name=mlnode_version,json=mlnodeVersion,proto3" json:"mlnode_version,omitempty"`
+	Params                   Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	GenesisOnlyParams        GenesisOnlyParams      `protobuf:"bytes,2,opt,name=genesis_only_params,json=genesisOnlyParams,proto3" json:"genesis_only_params"`
+	ModelList                []Model                `protobuf:"bytes,3,rep,name=model_list,json=modelList,proto3" json:"model_list"`
+	CosmWasmParams           CosmWasmParams         `protobuf:"bytes,4,opt,name=cosm_wasm_params,json=cosmWasmParams,proto3" json:"cosm_wasm_params"`
+	ParticipantList          []Participant          `protobuf:"bytes,5,rep,name=participant_list,json=participantList,proto3" json:"participant_list"`
+	MlnodeVersion            *MLNodeVersion         `protobuf:"bytes,6,opt,name=mlnode_version,json=mlnodeVersion,proto3" json:"mlnode_version,omitempty"`
+	DeveloperCreditList      []DeveloperCredit      `protobuf:"bytes,7,rep,name=developer_credit_list,json=developerCreditList,proto3" json:"developer_credit_list"`
+	CreditWithdrawalList     []CreditWithdrawal     `protobuf:"bytes,8,rep,name=credit_withdrawal_list,json=creditWithdrawalList,proto3" json:"credit_withdrawal_list"`
+	InferenceKeyGrantList    []InferenceKeyGrant    `protobuf:"bytes,9,rep,name=inference_key_grant_list,json=inferenceKeyGrantList,proto3" json:"inference_key_grant_list"`
+	InferenceKeyUsageList    []InferenceKeyUsage    `protobuf:"bytes,10,rep,name=inference_key_usage_list,json=inferenceKeyUsageList,proto3" json:"inference_key_usage_list"`
+	PriceQuoteList           []PriceQuote           `protobuf:"bytes,11,rep,name=price_quote_list,json=priceQuoteList,proto3" json:"price_quote_list"`
+	ParticipantExitList      []ParticipantExit      `protobuf:"bytes,12,rep,name=participant_exit_list,json=participantExitList,proto3" json:"participant_exit_list"`
+	ValidatorEpochStatsList  []ValidatorEpochStats  `protobuf:"bytes,13,rep,name=validator_epoch_stats_list,json=validatorEpochStatsList,proto3" json:"validator_epoch_stats_list"`
+	ValidatorTargetStatsList []ValidatorTargetStats `protobuf:"bytes,14,rep,name=validator_target_stats_list,json=validatorTargetStatsList,proto3" json:"validator_target_stats_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +118,62 @@ func (m *GenesisState) GetMlnodeVersion() *MLNodeVersion {
 	return nil
 }
 
+func (m *GenesisState) GetDeveloperCreditList() []DeveloperCredit {
+	if m != nil {
+		return m.DeveloperCreditList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCreditWithdrawalList() []CreditWithdrawal {
+	if m != nil {
+		return m.CreditWithdrawalList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInferenceKeyGrantList() []InferenceKeyGrant {
+	if m != nil {
+		return m.InferenceKeyGrantList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInferenceKeyUsageList() []InferenceKeyUsage {
+	if m != nil {
+		return m.InferenceKeyUsageList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceQuoteList() []PriceQuote {
+	if m != nil {
+		return m.PriceQuoteList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParticipantExitList() []ParticipantExit {
+	if m != nil {
+		return m.ParticipantExitList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorEpochStatsList() []ValidatorEpochStats {
+	if m != nil {
+		return m.ValidatorEpochStatsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorTargetStatsList() []ValidatorTargetStats {
+	if m != nil {
+		return m.ValidatorTargetStatsList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "inference.inference.GenesisState")
 }
@@ -117,41 +181,57 @@ func init() {
 func init() { proto.RegisterFile("inference/inference/genesis.proto", fileDescriptor_ba05d339ce8ae856) }
 
 var fileDescriptor_ba05d339ce8ae856 = []byte{
-	// 540 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x63, 0x52, 0x22, 0xd5, 0x85, 0xd2, 0xba, 0x1c, 0xa2, 0x20, 0xb9, 0xa1, 0x15, 0x10,
-	0x8a, 0x94, 0x88, 0x56, 0x70, 0x04, 0x29, 0x1c, 0x10, 0x52, 0xa1, 0x28, 0x48, 0x05, 0x71, 0x59,
-	0x6d, 0xd7, 0x53, 0x77, 0x85, 0x77, 0xc7, 0xda, 0x5d, 0x17, 0xf2, 0x16, 0x3c, 0x06, 0x47, 0x1e,
-	0xa3, 0xc7, 0x1e, 0x39, 0x21, 0x94, 0x1c, 0x38, 0xf2, 0x0a, 0x28, 0x6b, 0x3b, 0x49, 0xdb, 0x8d,
-	0x7b, 0x89, 0x26, 0xde, 0x6f, 0xfe, 0xfd, 0x67, 0x76, 0xc6, 0xbf, 0xcf, 0xe5, 0x31, 0x28, 0x90,
-	0x0c, 0x7a, 0xb3, 0x28, 0x06, 0x09, 0x9a, 0xeb, 0x6e, 0xaa, 0xd0, 0x60, 0xb0, 0x31, 0x3d, 0xe8,
-	0x4e, 0xa3, 0xd6, 0x3a, 0x15, 0x5c, 0x62, 0xcf, 0xfe, 0xe6, 0x5c, 0xeb, 0x6e, 0x8c, 0x31, 0xda,
-	0xb0, 0x37, 0x89, 0x8a, 0xaf, 0x6d, 0xd7, 0x05, 0x29, 0x55, 0x54, 0x14, 0xfa, 0xad, 0x6d, 0x17,
-	0x31, 0xbb, 0x33, 0x87, 0x1e, 0x2c, 0x90, 0x31, 0x9c, 0xf1, 0x94, 0x4a, 0x53, 0x60, 0x3b, 0x2e,
-	0x0c, 0x52, 0x64, 0x27, 0x24, 0x56, 0x98, 0xa5, 0x24, 0xa2, 0x86, 0x16, 0xec, 0x23, 0x17, 0xab,
-	0xc1, 0x98, 0x04, 0x08, 0x15, 0x98, 0x4d, 0x45, 0x9f, 0x5e, 0x27, 0x7a, 0x4a, 0x13, 0x1e, 0x51,
-	0xc3, 0x51, 0x96, 0x35, 0x3d, 0x76, 0xa5, 0x18, 0xfc, 0x02, 0x12, 0x05, 0x67, 0x7a, 0xde, 0xc6,
-	0xa6, 0x0b, 0x15, 0x18, 0x41, 0x52, 0xd5, 0x1f, 0x83, 0x29, 0x11, 0x5c, 0x82, 0x2a, 0xa0, 0x27,
-	0x95, 0x4d, 0x24, 0x86, 0x0b, 0xc0, 0xac, 0x2c, 0xe8, 0x79, 0x35, 0x3c, 0x2b, 0x87, 0x44, 0x60,
-	0x28, 0x4f, 0xca, 0xaa, 0xf6, 0x16, 0x37, 0x22, 0x05, 0x75, 0x8c, 0x4a, 0xd0, 0x49, 0xbe, 0xce,
-	0x84, 0xa0, 0x6a, 0x58, 0xd5, 0x0a, 0xfb, 0x72, 0x34, 0x21, 0x59, 0x1a, 0x2b, 0x1a, 0x41, 0x55,
-	0xa5, 0x0c, 0xa5, 0x51, 0x94, 0x99, 0xd2, 0x44, 0xc7, 0xd9, 0xaf, 0x44, 0x62, 0x04, 0xe4, 0x14,
-	0x94, 0xe6, 0x28, 0x73, 0x72, 0xeb, 0x5f, 0xdd, 0xbf, 0xf5, 0x3a, 0x1f, 0xe5, 0x0f, 0x86, 0x1a,
-	0x08, 0x5e, 0xf8, 0x8d, 0x7c, 0xf2, 0x9a, 0x5e, 0xdb, 0xeb, 0xac, 0xec, 0xde, 0xeb, 0x3a, 0x46,
-	0xbb, 0xfb, 0xde, 0x22, 0xfd, 0xe5, 0xb3, 0xdf, 0x9b, 0xb5, 0x1f, 0x7f, 0x7f, 0xee, 0x78, 0x83,
-	0x22, 0x2b, 0xa0, 0xfe, 0x46, 0xb1, 0x1a, 0x04, 0x65, 0x32, 0x24, 0x85, 0xd8, 0x0d, 0x2b, 0xf6,
-	0xd0, 0x29, 0x56, 0xdc, 0x7f, 0x20, 0x93, 0xe1, 0x55, 0xdd, 0xf5, 0xf8, 0xf2, 0x69, 0xf0, 0xd2,
-	0xf7, 0xed, 0xdb, 0x93, 0x84, 0x6b, 0xd3, 0xac, 0xb7, 0xeb, 0x9d, 0x95, 0xdd, 0x96, 0x53, 0xf9,
-	0xed, 0x04, 0xeb, 0x2f, 0x4d, 0xd4, 0x06, 0xcb, 0x36, 0x67, 0x9f, 0x6b, 0x13, 0x7c, 0xf2, 0xd7,
-	0x18, 0x6a, 0x41, 0xbe, 0x52, 0x2d, 0x4a, 0x83, 0x4b, 0xd6, 0xe0, 0xb6, 0x53, 0xe6, 0x15, 0x6a,
-	0xf1, 0x91, 0x6a, 0x71, 0xd5, 0xdd, 0x2a, 0xbb, 0x70, 0x14, 0x1c, 0xfa, 0x6b, 0x73, 0x0b, 0x97,
-	0x1b, 0xbc, 0x69, 0x0d, 0xb6, 0x17, 0xf5, 0xb1, 0x84, 0xe7, 0x65, 0xef, 0xcc, 0x89, 0x58, 0xc7,
-	0x6f, 0xfc, 0xd5, 0x8b, 0xcf, 0xd7, 0x6c, 0x58, 0xbf, 0x5b, 0xee, 0xb2, 0xf7, 0xdf, 0x61, 0x04,
-	0x87, 0x39, 0x39, 0xb8, 0x9d, 0x67, 0x16, 0x7f, 0xfb, 0x07, 0x67, 0xa3, 0xd0, 0x3b, 0x1f, 0x85,
-	0xde, 0x9f, 0x51, 0xe8, 0x7d, 0x1f, 0x87, 0xb5, 0xf3, 0x71, 0x58, 0xfb, 0x35, 0x0e, 0x6b, 0x9f,
-	0x9f, 0xc5, 0xdc, 0x9c, 0x64, 0x47, 0x5d, 0x86, 0xa2, 0x97, 0x2a, 0x8c, 0x32, 0x66, 0x34, 0xe3,
-	0x97, 0xa6, 0xe8, 0xdb, 0xfc, 0x82, 0x0d, 0x53, 0xd0, 0x47, 0x0d, 0x3b, 0x49, 0x7b, 0xff, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0xa3, 0x97, 0x91, 0x30, 0x27, 0x05, 0x00, 0x00,
+	// 798 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x4f, 0x1b, 0x39,
+	0x14, 0x4f, 0x16, 0x96, 0x5d, 0x0c, 0x64, 0x21, 0xc0, 0x6e, 0x14, 0xa4, 0x90, 0x85, 0x65, 0x37,
+	0xb0, 0x52, 0xa2, 0x82, 0xda, 0x63, 0x2b, 0x41, 0x11, 0x42, 0xa5, 0xa5, 0xa5, 0x2d, 0x54, 0x3d,
+	0x60, 0x99, 0x99, 0xc7, 0x60, 0x31, 0x1e, 0x4f, 0x6d, 0x4f, 0x20, 0x5f, 0xa2, 0xea, 0xc7, 0xe8,
+	0xb1, 0x1f, 0x83, 0x23, 0xc7, 0x9e, 0xaa, 0x0a, 0x0e, 0xfd, 0x1a, 0xd5, 0x78, 0x3c, 0xc9, 0x24,
+	0x38, 0xd3, 0x5e, 0x22, 0x67, 0xde, 0xef, 0xcf, 0x7b, 0xcf, 0xcf, 0x0f, 0xfd, 0x4d, 0x83, 0x53,
+	0x10, 0x10, 0x38, 0xd0, 0xea, 0x9d, 0x3c, 0x08, 0x40, 0x52, 0xd9, 0x0c, 0x05, 0x57, 0xbc, 0x3c,
+	0xdb, 0x0d, 0x34, 0xbb, 0xa7, 0xea, 0x0c, 0x61, 0x34, 0xe0, 0x2d, 0xfd, 0x9b, 0xe0, 0xaa, 0x73,
+	0x1e, 0xf7, 0xb8, 0x3e, 0xb6, 0xe2, 0x93, 0xf9, 0x5a, 0xb7, 0x19, 0x84, 0x44, 0x10, 0x66, 0xf4,
+	0xab, 0xcb, 0x36, 0x44, 0xcf, 0x33, 0x01, 0xad, 0x0c, 0x91, 0x51, 0xd4, 0xa1, 0x21, 0x09, 0x94,
+	0x81, 0xad, 0xd9, 0x60, 0x10, 0x72, 0xe7, 0x0c, 0x7b, 0x82, 0x47, 0x21, 0x76, 0x89, 0x22, 0x06,
+	0xfb, 0x9f, 0x0d, 0x2b, 0x41, 0x29, 0x1f, 0x30, 0x61, 0x3c, 0xea, 0x8a, 0xde, 0xfb, 0x91, 0x68,
+	0x9b, 0xf8, 0xd4, 0x25, 0x8a, 0xf2, 0x20, 0xad, 0x69, 0xd5, 0x46, 0x51, 0xfc, 0x1c, 0x02, 0xce,
+	0xa8, 0x23, 0xb3, 0x69, 0x2c, 0xda, 0xa0, 0x8c, 0xbb, 0xe0, 0xe7, 0xf5, 0x47, 0xf1, 0x10, 0x33,
+	0x1a, 0x80, 0x30, 0xa0, 0xff, 0x73, 0x9b, 0x88, 0x15, 0x65, 0xc0, 0xa3, 0xb4, 0xa0, 0x07, 0xf9,
+	0xe0, 0x5e, 0x39, 0xd8, 0x05, 0x45, 0xa8, 0x9f, 0x56, 0xb5, 0x31, 0xbc, 0x11, 0x21, 0x88, 0x53,
+	0x2e, 0x18, 0x89, 0xf9, 0x32, 0x62, 0x8c, 0x88, 0x4e, 0x5e, 0x2b, 0xf4, 0xcd, 0x11, 0x1f, 0x47,
+	0xa1, 0x27, 0x88, 0x0b, 0x79, 0x95, 0x3a, 0x3c, 0x50, 0x82, 0x38, 0x2a, 0x4d, 0xa2, 0x61, 0xed,
+	0x97, 0x1f, 0x70, 0x17, 0x70, 0x1b, 0x84, 0xa4, 0x3c, 0xc8, 0x1b, 0x06, 0x17, 0xda, 0xe0, 0xf3,
+	0x10, 0x04, 0x76, 0x04, 0xb8, 0x54, 0xe5, 0x0d, 0x43, 0xaf, 0x25, 0xe7, 0xd0, 0xc9, 0x1d, 0x44,
+	0x41, 0x1d, 0xc0, 0xef, 0x22, 0xae, 0x20, 0xcf, 0x3b, 0x33, 0xaf, 0x18, 0x2e, 0xa9, 0xca, 0xeb,
+	0x90, 0xb9, 0x04, 0x2e, 0xb0, 0x54, 0x24, 0x2d, 0x7e, 0xe9, 0x3d, 0x42, 0x93, 0x3b, 0xc9, 0xeb,
+	0x7c, 0xa9, 0x88, 0x82, 0xf2, 0x43, 0x34, 0x96, 0x3c, 0xa6, 0x4a, 0xb1, 0x5e, 0x6c, 0x4c, 0xac,
+	0x2f, 0x34, 0x2d, 0xaf, 0xb5, 0xf9, 0x5c, 0x43, 0x36, 0xc7, 0xaf, 0xbe, 0x2c, 0x16, 0x3e, 0x7e,
+	0xfb, 0xb4, 0x56, 0x3c, 0x30, 0xac, 0x32, 0x41, 0xb3, 0xe6, 0xb5, 0x63, 0x1e, 0xf8, 0x1d, 0x6c,
+	0xc4, 0x7e, 0xd1, 0x62, 0xff, 0x5a, 0xc5, 0x8c, 0xff, 0x7e, 0xe0, 0x77, 0xee, 0xea, 0xce, 0x78,
+	0x83, 0xd1, 0xf2, 0x23, 0x84, 0xf4, 0x38, 0x63, 0x9f, 0x4a, 0x55, 0x19, 0xa9, 0x8f, 0x34, 0x26,
+	0xd6, 0xab, 0x56, 0xe5, 0xa7, 0x31, 0x6c, 0x73, 0x34, 0x56, 0x3b, 0x18, 0xd7, 0x9c, 0x3d, 0x2a,
+	0x55, 0xf9, 0x0d, 0x9a, 0x76, 0xb8, 0x64, 0xf8, 0x82, 0x48, 0x96, 0x26, 0x38, 0xaa, 0x13, 0x5c,
+	0xb6, 0xca, 0x6c, 0x71, 0xc9, 0x8e, 0x88, 0x64, 0x77, 0xb3, 0x2b, 0x39, 0x7d, 0xa1, 0xf2, 0x21,
+	0x9a, 0xce, 0xde, 0x89, 0x4e, 0xf0, 0x57, 0x9d, 0x60, 0x7d, 0x58, 0x1f, 0x53, 0x70, 0x56, 0xf6,
+	0x8f, 0x8c, 0x88, 0xce, 0x78, 0x17, 0x95, 0xfa, 0x27, 0xb2, 0x32, 0xa6, 0xf3, 0x5d, 0xb2, 0x97,
+	0xbd, 0xf7, 0x8c, 0xbb, 0x70, 0x98, 0x20, 0x0f, 0xa6, 0x12, 0xa6, 0xf9, 0x5b, 0x3e, 0x46, 0xf3,
+	0x83, 0x23, 0x9b, 0xe4, 0xf9, 0x9b, 0xce, 0xf3, 0x1f, 0xab, 0xe2, 0xe3, 0x94, 0xb1, 0xa5, 0x09,
+	0xa6, 0xa5, 0xb3, 0x6e, 0xff, 0x67, 0x9d, 0x2a, 0x41, 0x7f, 0x1a, 0xd5, 0x0b, 0xaa, 0xce, 0x5c,
+	0x41, 0x2e, 0x88, 0xb9, 0xa9, 0xdf, 0xb5, 0xc1, 0x8a, 0xbd, 0xc5, 0x9a, 0x72, 0xd4, 0x65, 0x18,
+	0x87, 0x39, 0x67, 0xe0, 0xbb, 0xb6, 0x00, 0x54, 0xe9, 0x7b, 0x49, 0xd8, 0x13, 0xdd, 0x6e, 0x8f,
+	0xd7, 0x47, 0x86, 0x0e, 0xda, 0x6e, 0x7a, 0x7a, 0x02, 0x9d, 0x9d, 0x98, 0x62, 0x5c, 0xe6, 0xe9,
+	0x60, 0xc0, 0x6e, 0x13, 0x49, 0xe2, 0x41, 0x62, 0x83, 0x7e, 0xd2, 0xe6, 0x75, 0x4c, 0xb1, 0xd9,
+	0xe8, 0x80, 0xb6, 0xd9, 0x47, 0xd3, 0x99, 0xe7, 0x9e, 0xc8, 0x4f, 0x68, 0xf9, 0x45, 0xfb, 0xcc,
+	0xc4, 0xe0, 0x17, 0x31, 0xd6, 0xe8, 0x96, 0xc2, 0xee, 0x17, 0x2d, 0x78, 0x8c, 0xe6, 0x07, 0x17,
+	0x43, 0xa2, 0x3a, 0x99, 0x73, 0xc3, 0x99, 0x49, 0xdc, 0xbe, 0xec, 0xdd, 0x70, 0xd8, 0xff, 0x59,
+	0xeb, 0x9f, 0xa3, 0x6a, 0x6f, 0x99, 0x24, 0xdb, 0x5a, 0xaf, 0x94, 0xc4, 0x64, 0x4a, 0x9b, 0x34,
+	0xac, 0x26, 0x87, 0x29, 0x6d, 0x3b, 0x66, 0xc5, 0x0b, 0x47, 0x1a, 0xa3, 0xbf, 0xda, 0x77, 0x43,
+	0xda, 0x2c, 0x40, 0x0b, 0x3d, 0x33, 0x45, 0x84, 0x07, 0x2a, 0xeb, 0x56, 0xd2, 0x6e, 0xab, 0xf9,
+	0x6e, 0xaf, 0x34, 0x2d, 0x6b, 0x57, 0x69, 0x5b, 0x62, 0xb1, 0xdf, 0xe6, 0xfe, 0xd5, 0x4d, 0xad,
+	0x78, 0x7d, 0x53, 0x2b, 0x7e, 0xbd, 0xa9, 0x15, 0x3f, 0xdc, 0xd6, 0x0a, 0xd7, 0xb7, 0xb5, 0xc2,
+	0xe7, 0xdb, 0x5a, 0xe1, 0xed, 0x7d, 0x8f, 0xaa, 0xb3, 0xe8, 0xa4, 0xe9, 0x70, 0xd6, 0x0a, 0x05,
+	0x77, 0x23, 0x47, 0x49, 0x87, 0x0e, 0x6c, 0xd9, 0xcb, 0xcc, 0x59, 0x75, 0x42, 0x90, 0x27, 0x63,
+	0x7a, 0xd1, 0x6e, 0x7c, 0x1f, 0x00, 0x0b, 0xa2, 0xbc, 0xa6, 0x19, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -174,6 +254,118 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorTargetStatsList) > 0 {
+		for iNdEx := len(m.ValidatorTargetStatsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorTargetStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ValidatorEpochStatsList) > 0 {
+		for iNdEx := len(m.ValidatorEpochStatsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorEpochStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ParticipantExitList) > 0 {
+		for iNdEx := len(m.ParticipantExitList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParticipantExitList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PriceQuoteList) > 0 {
+		for iNdEx := len(m.PriceQuoteList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceQuoteList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.InferenceKeyUsageList) > 0 {
+		for iNdEx := len(m.InferenceKeyUsageList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InferenceKeyUsageList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.InferenceKeyGrantList) > 0 {
+		for iNdEx := len(m.InferenceKeyGrantList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InferenceKeyGrantList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.CreditWithdrawalList) > 0 {
+		for iNdEx := len(m.CreditWithdrawalList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreditWithdrawalList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DeveloperCreditList) > 0 {
+		for iNdEx := len(m.DeveloperCreditList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeveloperCreditList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.MlnodeVersion != nil {
 		{
 			size, err := m.MlnodeVersion.MarshalToSizedBuffer(dAtA[:i])
@@ -286,6 +478,54 @@ func (m *GenesisState) Size() (n int) {
 		l = m.MlnodeVersion.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.DeveloperCreditList) > 0 {
+		for _, e := range m.DeveloperCreditList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CreditWithdrawalList) > 0 {
+		for _, e := range m.CreditWithdrawalList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InferenceKeyGrantList) > 0 {
+		for _, e := range m.InferenceKeyGrantList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InferenceKeyUsageList) > 0 {
+		for _, e := range m.InferenceKeyUsageList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceQuoteList) > 0 {
+		for _, e := range m.PriceQuoteList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ParticipantExitList) > 0 {
+		for _, e := range m.ParticipantExitList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorEpochStatsList) > 0 {
+		for _, e := range m.ValidatorEpochStatsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorTargetStatsList) > 0 {
+		for _, e := range m.ValidatorTargetStatsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -527,6 +767,278 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperCreditList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperCreditList = append(m.DeveloperCreditList, DeveloperCredit{})
+			if err := m.DeveloperCreditList[len(m.DeveloperCreditList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditWithdrawalList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditWithdrawalList = append(m.CreditWithdrawalList, CreditWithdrawal{})
+			if err := m.CreditWithdrawalList[len(m.CreditWithdrawalList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceKeyGrantList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceKeyGrantList = append(m.InferenceKeyGrantList, InferenceKeyGrant{})
+			if err := m.InferenceKeyGrantList[len(m.InferenceKeyGrantList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceKeyUsageList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceKeyUsageList = append(m.InferenceKeyUsageList, InferenceKeyUsage{})
+			if err := m.InferenceKeyUsageList[len(m.InferenceKeyUsageList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceQuoteList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceQuoteList = append(m.PriceQuoteList, PriceQuote{})
+			if err := m.PriceQuoteList[len(m.PriceQuoteList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantExitList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantExitList = append(m.ParticipantExitList, ParticipantExit{})
+			if err := m.ParticipantExitList[len(m.ParticipantExitList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorEpochStatsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorEpochStatsList = append(m.ValidatorEpochStatsList, ValidatorEpochStats{})
+			if err := m.ValidatorEpochStatsList[len(m.ValidatorEpochStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorTargetStatsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorTargetStatsList = append(m.ValidatorTargetStatsList, ValidatorTargetStats{})
+			if err := m.ValidatorTargetStatsList[len(m.ValidatorTargetStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
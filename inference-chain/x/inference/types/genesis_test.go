@@ -3,11 +3,14 @@ package types_test
 import (
 	"testing"
 
+	"github.com/productscience/inference/testutil/sample"
 	"github.com/productscience/inference/x/inference/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	developer := sample.AccAddress()
+	key := sample.AccAddress()
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -26,6 +29,72 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid developer credit",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				DeveloperCreditList:  []types.DeveloperCredit{{Developer: developer, Available: 100, PendingWithdrawal: 10}},
+				CreditWithdrawalList: []types.CreditWithdrawal{{Developer: developer, Amount: 10, ReleaseHeight: 50}},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate developer credit",
+			genState: &types.GenesisState{
+				Params:              types.DefaultParams(),
+				DeveloperCreditList: []types.DeveloperCredit{{Developer: developer}, {Developer: developer}},
+			},
+			valid: false,
+		},
+		{
+			desc: "withdrawal without developer credit",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				CreditWithdrawalList: []types.CreditWithdrawal{{Developer: developer, Amount: 10}},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid inference key",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				InferenceKeyGrantList: []types.InferenceKeyGrant{{Developer: developer, KeyAddress: key, EpochSpendLimit: 100}},
+				InferenceKeyUsageList: []types.InferenceKeyUsage{{Developer: developer, KeyAddress: key, EpochSpent: 10}},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate inference key",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				InferenceKeyGrantList: []types.InferenceKeyGrant{{Developer: developer, KeyAddress: key}, {Developer: developer, KeyAddress: key}},
+			},
+			valid: false,
+		},
+		{
+			desc: "inference key usage without grant",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				InferenceKeyUsageList: []types.InferenceKeyUsage{{Developer: developer, KeyAddress: key}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate price quote",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				PriceQuoteList: []types.PriceQuote{{Id: 1, Developer: developer}, {Id: 1, Developer: developer}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated participant exit",
+			genState: &types.GenesisState{
+				Params:              types.DefaultParams(),
+				ParticipantExitList: []types.ParticipantExit{{Participant: developer, ExitEpoch: 1}, {Participant: developer, ExitEpoch: 2}},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
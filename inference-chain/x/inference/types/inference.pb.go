@@ -152,6 +152,10 @@ type Inference struct {
 	ExecutionSignature       string           `protobuf:"bytes,30,opt,name=execution_signature,json=executionSignature,proto3" json:"execution_signature,omitempty"`
 	OriginalPrompt           string           `protobuf:"bytes,31,opt,name=original_prompt,json=originalPrompt,proto3" json:"original_prompt,omitempty"`
 	PerTokenPrice            uint64           `protobuf:"varint,32,opt,name=per_token_price,json=perTokenPrice,proto3" json:"per_token_price,omitempty"`
+	PaidFromCredit           bool             `protobuf:"varint,33,opt,name=paid_from_credit,json=paidFromCredit,proto3" json:"paid_from_credit,omitempty"`
+	RequesterKey             string           `protobuf:"bytes,34,opt,name=requester_key,json=requesterKey,proto3" json:"requester_key,omitempty"`
+	PriceQuoteId             uint64           `protobuf:"varint,35,opt,name=price_quote_id,json=priceQuoteId,proto3" json:"price_quote_id,omitempty"`
+	RequesterKeyEpoch        uint64           `protobuf:"varint,36,opt,name=requester_key_epoch,json=requesterKeyEpoch,proto3" json:"requester_key_epoch,omitempty"`
 }
 
 func (m *Inference) Reset()         { *m = Inference{} }
@@ -411,6 +415,34 @@ func (m *Inference) GetPerTokenPrice() uint64 {
 	return 0
 }
 
+func (m *Inference) GetPaidFromCredit() bool {
+	if m != nil {
+		return m.PaidFromCredit
+	}
+	return false
+}
+
+func (m *Inference) GetRequesterKey() string {
+	if m != nil {
+		return m.RequesterKey
+	}
+	return ""
+}
+
+func (m *Inference) GetPriceQuoteId() uint64 {
+	if m != nil {
+		return m.PriceQuoteId
+	}
+	return 0
+}
+
+func (m *Inference) GetRequesterKeyEpoch() uint64 {
+	if m != nil {
+		return m.RequesterKeyEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("inference.inference.InferenceStatus", InferenceStatus_name, InferenceStatus_value)
 	proto.RegisterType((*ProposalDetails)(nil), "inference.inference.ProposalDetails")
@@ -422,63 +454,69 @@ func init() {
 }
 
 var fileDescriptor_ce060d6da7916311 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xdb, 0x6e, 0xdb, 0xc6,
-	0x16, 0x35, 0xe3, 0xab, 0xb6, 0xae, 0x1e, 0xc9, 0x39, 0x93, 0xd3, 0x44, 0x51, 0x6e, 0xad, 0x7a,
-	0x93, 0xdb, 0xb4, 0x7d, 0x2b, 0x0a, 0x48, 0x91, 0x1b, 0x13, 0x28, 0x6c, 0x41, 0x12, 0x8c, 0xa2,
-	0x2f, 0xc4, 0x98, 0x9c, 0x48, 0x44, 0x28, 0x0e, 0x3b, 0x33, 0x4a, 0xa5, 0xbf, 0xe8, 0x0f, 0xf4,
-	0x3b, 0xfa, 0x0b, 0x7d, 0xcc, 0x63, 0x1f, 0x0b, 0xfb, 0x47, 0x8a, 0xd9, 0xc3, 0x8b, 0x22, 0xb8,
-	0x6f, 0xe4, 0x5a, 0x6b, 0xef, 0xd9, 0xdc, 0xb3, 0x16, 0x08, 0xcf, 0xc2, 0xf8, 0x0d, 0x97, 0x3c,
-	0xf6, 0xf9, 0xe9, 0x1d, 0x4f, 0xbd, 0x44, 0x0a, 0x2d, 0x48, 0xb3, 0x00, 0xf2, 0xa7, 0xa7, 0x7f,
-	0x38, 0x50, 0x1f, 0x49, 0x91, 0x08, 0xc5, 0xa2, 0x21, 0xd7, 0x2c, 0x8c, 0x14, 0xf9, 0x1a, 0x4e,
-	0x24, 0xf7, 0xde, 0xb1, 0x28, 0x0c, 0x98, 0xe6, 0x5e, 0x22, 0xa2, 0xd0, 0x5f, 0x7b, 0x61, 0x40,
-	0x9d, 0x8e, 0xd3, 0xdd, 0x1b, 0x13, 0xc9, 0xaf, 0x52, 0x6e, 0x84, 0x94, 0x1b, 0x90, 0xaf, 0xa0,
-	0x15, 0xc6, 0x77, 0x54, 0xdc, 0xb3, 0x15, 0x05, 0x97, 0x57, 0xbc, 0x80, 0x5a, 0x2a, 0x63, 0x41,
-	0x20, 0xb9, 0x52, 0x74, 0xb7, 0xe3, 0x74, 0x4b, 0xe3, 0xaa, 0x45, 0xfb, 0x16, 0x7c, 0xfa, 0x27,
-	0x40, 0xc9, 0xcd, 0xa6, 0x25, 0x2d, 0xd8, 0x0f, 0xe3, 0x80, 0xaf, 0x70, 0x92, 0xd2, 0xd8, 0xbe,
-	0x90, 0x27, 0x50, 0xc9, 0x3f, 0x28, 0x3b, 0xb4, 0x34, 0x2e, 0xe7, 0x98, 0x1b, 0x90, 0xc7, 0x50,
-	0x4e, 0xa4, 0x58, 0x24, 0xda, 0x9b, 0x33, 0x35, 0x4f, 0x8f, 0x02, 0x0b, 0x9d, 0x33, 0x35, 0xc7,
-	0x71, 0xac, 0x20, 0x61, 0xeb, 0x48, 0xb0, 0x80, 0xee, 0xa5, 0xe3, 0x20, 0x3a, 0xb2, 0x20, 0x79,
-	0x06, 0x55, 0xc9, 0x55, 0x22, 0x62, 0xc5, 0x6d, 0xa7, 0x7d, 0x54, 0x55, 0x32, 0x10, 0x7b, 0x7d,
-	0x0a, 0x8d, 0x5c, 0x94, 0x75, 0x3b, 0x40, 0x5d, 0x3d, 0xc3, 0xb3, 0x7e, 0x5f, 0x00, 0x49, 0x8f,
-	0xd5, 0xe2, 0x2d, 0x8f, 0x3d, 0x5f, 0x2c, 0x63, 0x4d, 0x0f, 0x71, 0x6b, 0x0d, 0xcb, 0x4c, 0x0d,
-	0xf1, 0xca, 0xe0, 0xe4, 0x5b, 0xb8, 0xef, 0x8b, 0x45, 0x12, 0x71, 0x1d, 0x8a, 0xf8, 0x83, 0x8a,
-	0x23, 0xac, 0x68, 0x15, 0xec, 0x46, 0xd5, 0x13, 0xa8, 0x48, 0xfe, 0xeb, 0x92, 0x2b, 0xcd, 0x03,
-	0xef, 0x7a, 0x4d, 0x4b, 0x76, 0x3d, 0x39, 0x36, 0x58, 0x9b, 0xf5, 0xf0, 0x15, 0xf7, 0x97, 0xa9,
-	0x02, 0xec, 0x7a, 0x32, 0x68, 0xb0, 0x26, 0xdf, 0xc3, 0x81, 0xd2, 0x4c, 0x2f, 0x15, 0x2d, 0x77,
-	0x9c, 0x6e, 0xed, 0xe5, 0xf3, 0xde, 0x1d, 0x66, 0xea, 0xe5, 0x17, 0x35, 0x41, 0xed, 0x38, 0xad,
-	0x31, 0x5f, 0xa9, 0x34, 0x93, 0xda, 0xbb, 0x8e, 0x84, 0xff, 0xd6, 0x9b, 0xf3, 0x70, 0x36, 0xd7,
-	0xb4, 0xd2, 0x71, 0xba, 0xbb, 0xe3, 0x06, 0x32, 0x03, 0x43, 0x9c, 0x23, 0x4e, 0xba, 0xd0, 0xe0,
-	0x71, 0xf0, 0xa1, 0xb6, 0x8a, 0xda, 0x1a, 0x8f, 0x83, 0x4d, 0xe5, 0x4b, 0x38, 0xd9, 0xec, 0xab,
-	0xc3, 0x05, 0x57, 0x9a, 0x2d, 0x12, 0x5a, 0x43, 0x79, 0xb3, 0x68, 0x3d, 0xcd, 0x28, 0xd2, 0x83,
-	0x66, 0xd1, 0xbd, 0xa8, 0xa8, 0x63, 0xc5, 0x71, 0x76, 0x40, 0xa1, 0x6f, 0xc1, 0xfe, 0x42, 0x04,
-	0x3c, 0xa2, 0x0d, 0x6b, 0x39, 0x7c, 0x21, 0x8f, 0x00, 0x16, 0x6c, 0x65, 0xaf, 0x40, 0xd1, 0x63,
-	0xdc, 0x7e, 0x69, 0xc1, 0x56, 0xb8, 0x76, 0x65, 0xf6, 0xc9, 0x7c, 0xbd, 0x64, 0x91, 0xe7, 0x0b,
-	0xa5, 0x29, 0xc1, 0xe6, 0x60, 0xa1, 0x57, 0x42, 0x69, 0xe3, 0x23, 0xae, 0x7c, 0x29, 0x7e, 0xf3,
-	0xd8, 0x02, 0x2f, 0xb0, 0x89, 0x92, 0x8a, 0x05, 0xfb, 0x88, 0x91, 0x4b, 0x30, 0x16, 0xc0, 0x68,
-	0x7a, 0x81, 0xcd, 0x26, 0x6d, 0x75, 0x9c, 0x6e, 0xf9, 0x3f, 0xd6, 0xbf, 0x95, 0xe3, 0x71, 0x3d,
-	0xd9, 0x0a, 0xf6, 0x73, 0xa8, 0xf1, 0x44, 0xf8, 0x73, 0x6f, 0x26, 0xc5, 0x32, 0x31, 0x51, 0x39,
-	0xc1, 0xc9, 0x2b, 0x88, 0xbe, 0x36, 0xa0, 0xcd, 0x0a, 0x53, 0x2a, 0x9c, 0xc5, 0x3c, 0xf0, 0xb4,
-	0xa0, 0xf7, 0xad, 0x19, 0x32, 0x68, 0x2a, 0x8c, 0xa1, 0xb2, 0x38, 0xa3, 0x5d, 0xfe, 0xd7, 0xd9,
-	0x35, 0x86, 0xca, 0xb1, 0xc1, 0xda, 0x48, 0x62, 0x11, 0x70, 0xef, 0x1d, 0x97, 0x2a, 0x14, 0x31,
-	0xa5, 0xd6, 0x73, 0x06, 0xbb, 0xb2, 0x10, 0x79, 0x00, 0x47, 0x76, 0x98, 0x30, 0xa0, 0x0f, 0x70,
-	0x8c, 0x43, 0x7c, 0x77, 0x03, 0xf2, 0x03, 0x3c, 0xb4, 0x54, 0x22, 0x7c, 0xef, 0x0e, 0xe7, 0xfc,
-	0x1f, 0xe5, 0x14, 0x35, 0x23, 0xe1, 0x4f, 0xb6, 0x1d, 0xf4, 0x02, 0x6a, 0x5a, 0xb2, 0x58, 0xbd,
-	0xe1, 0x52, 0xda, 0x11, 0x3f, 0xb2, 0x61, 0xde, 0x40, 0x07, 0x6b, 0xf2, 0x39, 0x1c, 0xa7, 0x21,
-	0xd8, 0x30, 0xc2, 0x43, 0xeb, 0xca, 0x94, 0x28, 0x7c, 0xf0, 0x25, 0x90, 0xac, 0xda, 0x33, 0x9b,
-	0x60, 0x7a, 0x29, 0x39, 0x7d, 0x84, 0x7d, 0x8f, 0x33, 0x66, 0x92, 0x11, 0xe4, 0x14, 0x9a, 0x36,
-	0x3e, 0x26, 0xa9, 0x85, 0xbe, 0x8d, 0x7a, 0x92, 0x53, 0x45, 0xc1, 0x27, 0x50, 0x17, 0x32, 0x9c,
-	0x85, 0x31, 0x8b, 0x3c, 0x1b, 0x7c, 0xfa, 0x18, 0xc5, 0xb5, 0x0c, 0x1e, 0x21, 0x4a, 0x3e, 0x86,
-	0x7a, 0xc2, 0x65, 0x9a, 0xfe, 0x44, 0x86, 0x3e, 0xa7, 0x1d, 0xdc, 0x47, 0x35, 0xe1, 0x12, 0xfd,
-	0x37, 0x32, 0xe0, 0x67, 0x1c, 0xea, 0x5b, 0x79, 0x24, 0x65, 0x38, 0x9c, 0x4c, 0xfb, 0xe3, 0xe9,
-	0xd9, 0xb0, 0xb1, 0x43, 0x2a, 0x70, 0xf4, 0xa3, 0x7b, 0xe1, 0x4e, 0xce, 0xcf, 0x86, 0x0d, 0x87,
-	0x54, 0xa1, 0x74, 0xd5, 0xff, 0xc9, 0x1d, 0xf6, 0x0d, 0x79, 0x8f, 0xd4, 0xa1, 0xec, 0x5e, 0x14,
-	0xc0, 0x2e, 0x01, 0x38, 0xb8, 0xba, 0x9c, 0xba, 0x17, 0xaf, 0x1b, 0x7b, 0xa6, 0xcd, 0xd9, 0xcf,
-	0x23, 0x77, 0x7c, 0x36, 0x6c, 0xec, 0x0f, 0x2e, 0xff, 0xba, 0x69, 0x3b, 0xef, 0x6f, 0xda, 0xce,
-	0x3f, 0x37, 0x6d, 0xe7, 0xf7, 0xdb, 0xf6, 0xce, 0xfb, 0xdb, 0xf6, 0xce, 0xdf, 0xb7, 0xed, 0x9d,
-	0x5f, 0xbe, 0x9b, 0x85, 0x7a, 0xbe, 0xbc, 0xee, 0xf9, 0x62, 0x71, 0x9a, 0x48, 0x11, 0x2c, 0x7d,
-	0xad, 0xfc, 0x70, 0xeb, 0x27, 0xb5, 0xda, 0x78, 0xd6, 0xeb, 0x84, 0xab, 0xeb, 0x03, 0xfc, 0x5b,
-	0x7d, 0xf3, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x1f, 0x5d, 0xa2, 0xd4, 0x06, 0x00, 0x00,
+	// 978 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x95, 0xcf, 0x52, 0x1b, 0xc7,
+	0x13, 0xc7, 0x59, 0x63, 0x30, 0x6a, 0x09, 0x49, 0x0c, 0xe0, 0xdf, 0xf8, 0x17, 0x5b, 0x16, 0x7f,
+	0x9c, 0x28, 0xff, 0x20, 0x71, 0x92, 0x5b, 0x2a, 0x55, 0x08, 0xb0, 0xd9, 0x4a, 0x0a, 0x14, 0xa1,
+	0xa2, 0x52, 0xb9, 0x4c, 0x0d, 0x3b, 0x03, 0xda, 0x42, 0xbb, 0xb3, 0x9e, 0x19, 0x39, 0xe8, 0x2d,
+	0xf2, 0x02, 0x79, 0x9f, 0x1c, 0x7d, 0xcc, 0x21, 0x87, 0x14, 0xbc, 0x48, 0x6a, 0x7a, 0x76, 0xb5,
+	0x82, 0x22, 0xb7, 0xdd, 0x4f, 0x7f, 0xbb, 0xa7, 0xd5, 0xfb, 0xed, 0x11, 0x6c, 0xc5, 0xe9, 0x85,
+	0xd4, 0x32, 0x8d, 0xe4, 0xee, 0x03, 0x4f, 0x3b, 0x99, 0x56, 0x56, 0x91, 0xd5, 0x12, 0x4c, 0x9f,
+	0x36, 0xff, 0x08, 0xa0, 0xd1, 0xd3, 0x2a, 0x53, 0x86, 0x8f, 0x0e, 0xa4, 0xe5, 0xf1, 0xc8, 0x90,
+	0xaf, 0x61, 0x5d, 0x4b, 0xf6, 0x9e, 0x8f, 0x62, 0xc1, 0xad, 0x64, 0x99, 0x1a, 0xc5, 0xd1, 0x84,
+	0xc5, 0x82, 0x06, 0xed, 0xa0, 0xf3, 0xb8, 0x4f, 0xb4, 0x3c, 0xcb, 0x63, 0x3d, 0x0c, 0x85, 0x82,
+	0x7c, 0x05, 0x6b, 0x71, 0xfa, 0x40, 0xc6, 0x23, 0x9f, 0x51, 0xc6, 0xa6, 0x19, 0xaf, 0xa0, 0x9e,
+	0xcb, 0xb8, 0x10, 0x5a, 0x1a, 0x43, 0xe7, 0xdb, 0x41, 0xa7, 0xd2, 0x5f, 0xf6, 0x74, 0xcf, 0xc3,
+	0xcd, 0xbf, 0xab, 0x50, 0x09, 0x8b, 0x6e, 0xc9, 0x1a, 0x2c, 0xc4, 0xa9, 0x90, 0xd7, 0xd8, 0x49,
+	0xa5, 0xef, 0x5f, 0xc8, 0x06, 0xd4, 0xa6, 0x3f, 0xa8, 0x38, 0xb4, 0xd2, 0xaf, 0x4e, 0x59, 0x28,
+	0xc8, 0x4b, 0xa8, 0x66, 0x5a, 0x25, 0x99, 0x65, 0x43, 0x6e, 0x86, 0xf9, 0x51, 0xe0, 0xd1, 0x11,
+	0x37, 0x43, 0x6c, 0xc7, 0x0b, 0x32, 0x3e, 0x19, 0x29, 0x2e, 0xe8, 0xe3, 0xbc, 0x1d, 0xa4, 0x3d,
+	0x0f, 0xc9, 0x16, 0x2c, 0x6b, 0x69, 0x32, 0x95, 0x1a, 0xe9, 0x2b, 0x2d, 0xa0, 0xaa, 0x56, 0x40,
+	0xac, 0xf5, 0x29, 0x34, 0xa7, 0xa2, 0xa2, 0xda, 0x22, 0xea, 0x1a, 0x05, 0x2f, 0xea, 0x7d, 0x01,
+	0x24, 0x3f, 0xd6, 0xaa, 0x2b, 0x99, 0xb2, 0x48, 0x8d, 0x53, 0x4b, 0x9f, 0xe0, 0xd4, 0x9a, 0x3e,
+	0x32, 0x70, 0x81, 0x7d, 0xc7, 0xc9, 0xb7, 0xf0, 0x34, 0x52, 0x49, 0x36, 0x92, 0x36, 0x56, 0xe9,
+	0x9d, 0x8c, 0x25, 0xcc, 0x58, 0x2b, 0xa3, 0x33, 0x59, 0x1b, 0x50, 0xd3, 0xf2, 0xdd, 0x58, 0x1a,
+	0x2b, 0x05, 0x3b, 0x9f, 0xd0, 0x8a, 0x1f, 0xcf, 0x94, 0x75, 0x27, 0x6e, 0x3c, 0xf2, 0x5a, 0x46,
+	0xe3, 0x5c, 0x01, 0x7e, 0x3c, 0x05, 0xea, 0x4e, 0xc8, 0xf7, 0xb0, 0x68, 0x2c, 0xb7, 0x63, 0x43,
+	0xab, 0xed, 0xa0, 0x53, 0x7f, 0xbd, 0xbd, 0xf3, 0x80, 0x99, 0x76, 0xa6, 0x1f, 0xea, 0x14, 0xb5,
+	0xfd, 0x3c, 0xc7, 0xfd, 0x4a, 0x63, 0xb9, 0xb6, 0xec, 0x7c, 0xa4, 0xa2, 0x2b, 0x36, 0x94, 0xf1,
+	0xe5, 0xd0, 0xd2, 0x5a, 0x3b, 0xe8, 0xcc, 0xf7, 0x9b, 0x18, 0xe9, 0xba, 0xc0, 0x11, 0x72, 0xd2,
+	0x81, 0xa6, 0x4c, 0xc5, 0x5d, 0xed, 0x32, 0x6a, 0xeb, 0x32, 0x15, 0xb3, 0xca, 0xd7, 0xb0, 0x3e,
+	0x5b, 0xd7, 0xc6, 0x89, 0x34, 0x96, 0x27, 0x19, 0xad, 0xa3, 0x7c, 0xb5, 0x2c, 0x3d, 0x28, 0x42,
+	0x64, 0x07, 0x56, 0xcb, 0xea, 0x65, 0x46, 0x03, 0x33, 0x56, 0x8a, 0x03, 0x4a, 0xfd, 0x1a, 0x2c,
+	0x24, 0x4a, 0xc8, 0x11, 0x6d, 0x7a, 0xcb, 0xe1, 0x0b, 0x79, 0x01, 0x90, 0xf0, 0x6b, 0xff, 0x09,
+	0x0c, 0x5d, 0xc1, 0xe9, 0x57, 0x12, 0x7e, 0x8d, 0x63, 0x37, 0x6e, 0x9e, 0x3c, 0xb2, 0x63, 0x3e,
+	0x62, 0x91, 0x32, 0x96, 0x12, 0x2c, 0x0e, 0x1e, 0xed, 0x2b, 0x63, 0x9d, 0x8f, 0xa4, 0x89, 0xb4,
+	0xfa, 0x8d, 0xf1, 0x04, 0x3f, 0xe0, 0x2a, 0x4a, 0x6a, 0x1e, 0xee, 0x21, 0x23, 0x27, 0xe0, 0x2c,
+	0x80, 0xab, 0xc9, 0x84, 0xdf, 0x4d, 0xba, 0xd6, 0x0e, 0x3a, 0xd5, 0xff, 0x18, 0xff, 0xbd, 0x3d,
+	0xee, 0x37, 0xb2, 0x7b, 0x8b, 0xbd, 0x0d, 0x75, 0x99, 0xa9, 0x68, 0xc8, 0x2e, 0xb5, 0x1a, 0x67,
+	0x6e, 0x55, 0xd6, 0xb1, 0xf3, 0x1a, 0xd2, 0xb7, 0x0e, 0xfa, 0x5d, 0xe1, 0xc6, 0xc4, 0x97, 0xa9,
+	0x14, 0xcc, 0x2a, 0xfa, 0xd4, 0x9b, 0xa1, 0x40, 0x03, 0xe5, 0x0c, 0x55, 0xac, 0x33, 0xda, 0xe5,
+	0x7f, 0xed, 0x79, 0x67, 0xa8, 0x29, 0xeb, 0x4e, 0x9c, 0x24, 0x55, 0x42, 0xb2, 0xf7, 0x52, 0x9b,
+	0x58, 0xa5, 0x94, 0x7a, 0xcf, 0x39, 0x76, 0xe6, 0x11, 0x79, 0x06, 0x4b, 0xbe, 0x99, 0x58, 0xd0,
+	0x67, 0xd8, 0xc6, 0x13, 0x7c, 0x0f, 0x05, 0xf9, 0x01, 0x9e, 0xfb, 0x50, 0xa6, 0x22, 0xf6, 0x80,
+	0x73, 0xfe, 0x8f, 0x72, 0x8a, 0x9a, 0x9e, 0x8a, 0x4e, 0xef, 0x3b, 0xe8, 0x15, 0xd4, 0xad, 0xe6,
+	0xa9, 0xb9, 0x90, 0x5a, 0xfb, 0x16, 0x3f, 0xf2, 0xcb, 0x3c, 0x43, 0xbb, 0x13, 0xf2, 0x39, 0xac,
+	0xe4, 0x4b, 0x30, 0x63, 0x84, 0xe7, 0xde, 0x95, 0x79, 0xa0, 0xf4, 0xc1, 0x97, 0x40, 0x8a, 0x6c,
+	0xe6, 0x26, 0xc1, 0xed, 0x58, 0x4b, 0xfa, 0x02, 0xeb, 0xae, 0x14, 0x91, 0xd3, 0x22, 0x40, 0x76,
+	0x61, 0xd5, 0xaf, 0x8f, 0xdb, 0xd4, 0x52, 0xdf, 0x42, 0x3d, 0x99, 0x86, 0xca, 0x84, 0x4f, 0xa0,
+	0xa1, 0x74, 0x7c, 0x19, 0xa7, 0x7c, 0xc4, 0xfc, 0xe2, 0xd3, 0x97, 0x28, 0xae, 0x17, 0xb8, 0x87,
+	0x94, 0x7c, 0x0c, 0x8d, 0x4c, 0xea, 0x7c, 0xfb, 0x33, 0x1d, 0x47, 0x92, 0xb6, 0x71, 0x1e, 0xcb,
+	0x99, 0xd4, 0xe8, 0xbf, 0x9e, 0x83, 0x6e, 0x8d, 0x32, 0x1e, 0x0b, 0x76, 0xa1, 0x55, 0xc2, 0x22,
+	0x2d, 0x45, 0x6c, 0xe9, 0x46, 0x3b, 0xe8, 0x2c, 0xf5, 0xeb, 0x8e, 0xbf, 0xd1, 0x2a, 0xd9, 0x47,
+	0xea, 0x2f, 0x35, 0x7f, 0x19, 0x68, 0x76, 0x25, 0x27, 0x74, 0xb3, 0xb8, 0xd4, 0x72, 0xf8, 0xa3,
+	0x9c, 0x38, 0xef, 0xe0, 0x61, 0xec, 0xdd, 0x58, 0x59, 0xbc, 0x66, 0xb7, 0xbc, 0x77, 0x90, 0xfe,
+	0xec, 0x60, 0x28, 0xdc, 0x76, 0xdd, 0x29, 0xc5, 0xf0, 0x1b, 0xd1, 0x6d, 0x94, 0xae, 0xcc, 0x16,
+	0x3c, 0x74, 0x81, 0xcf, 0x24, 0x34, 0xee, 0x5d, 0x1a, 0xa4, 0x0a, 0x4f, 0x4e, 0x07, 0x7b, 0xfd,
+	0xc1, 0xe1, 0x41, 0x73, 0x8e, 0xd4, 0x60, 0xe9, 0x4d, 0x78, 0x1c, 0x9e, 0x1e, 0x1d, 0x1e, 0x34,
+	0x03, 0xb2, 0x0c, 0x95, 0xb3, 0xbd, 0x9f, 0xc2, 0x83, 0x3d, 0x17, 0x7c, 0x44, 0x1a, 0x50, 0x0d,
+	0x8f, 0x4b, 0x30, 0x4f, 0x00, 0x16, 0xcf, 0x4e, 0x06, 0xe1, 0xf1, 0xdb, 0xe6, 0x63, 0x57, 0xe6,
+	0xf0, 0x97, 0x5e, 0xd8, 0x3f, 0x3c, 0x68, 0x2e, 0x74, 0x4f, 0xfe, 0xbc, 0x69, 0x05, 0x1f, 0x6e,
+	0x5a, 0xc1, 0x3f, 0x37, 0xad, 0xe0, 0xf7, 0xdb, 0xd6, 0xdc, 0x87, 0xdb, 0xd6, 0xdc, 0x5f, 0xb7,
+	0xad, 0xb9, 0x5f, 0xbf, 0xbb, 0x8c, 0xed, 0x70, 0x7c, 0xbe, 0x13, 0xa9, 0x64, 0x37, 0xd3, 0x4a,
+	0x8c, 0x23, 0x6b, 0xa2, 0xf8, 0xde, 0x3f, 0xe9, 0xf5, 0xcc, 0xb3, 0x9d, 0x64, 0xd2, 0x9c, 0x2f,
+	0xe2, 0x5f, 0xea, 0x37, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x77, 0x24, 0x77, 0xeb, 0x79, 0x07,
+	0x00, 0x00,
 }
 
 func (m *ProposalDetails) Marshal() (dAtA []byte, err error) {
@@ -541,6 +579,41 @@ func (m *Inference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RequesterKeyEpoch != 0 {
+		i = encodeVarintInference(dAtA, i, uint64(m.RequesterKeyEpoch))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.PriceQuoteId != 0 {
+		i = encodeVarintInference(dAtA, i, uint64(m.PriceQuoteId))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.RequesterKey) > 0 {
+		i -= len(m.RequesterKey)
+		copy(dAtA[i:], m.RequesterKey)
+		i = encodeVarintInference(dAtA, i, uint64(len(m.RequesterKey)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.PaidFromCredit {
+		i--
+		if m.PaidFromCredit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.PerTokenPrice != 0 {
 		i = encodeVarintInference(dAtA, i, uint64(m.PerTokenPrice))
 		i--
@@ -930,6 +1003,19 @@ func (m *Inference) Size() (n int) {
 	if m.PerTokenPrice != 0 {
 		n += 2 + sovInference(uint64(m.PerTokenPrice))
 	}
+	if m.PaidFromCredit {
+		n += 3
+	}
+	l = len(m.RequesterKey)
+	if l > 0 {
+		n += 2 + l + sovInference(uint64(l))
+	}
+	if m.PriceQuoteId != 0 {
+		n += 2 + sovInference(uint64(m.PriceQuoteId))
+	}
+	if m.RequesterKeyEpoch != 0 {
+		n += 2 + sovInference(uint64(m.RequesterKeyEpoch))
+	}
 	return n
 }
 
@@ -1921,6 +2007,96 @@ func (m *Inference) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidFromCredit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PaidFromCredit = bool(v != 0)
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequesterKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInference
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequesterKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceQuoteId", wireType)
+			}
+			m.PriceQuoteId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceQuoteId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequesterKeyEpoch", wireType)
+			}
+			m.RequesterKeyEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequesterKeyEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInference(dAtA[iNdEx:])
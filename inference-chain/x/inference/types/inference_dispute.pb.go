@@ -0,0 +1,701 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/inference/inference_dispute.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InferenceDispute is an open challenge of a single inference by the developer who paid for it.
+type InferenceDispute struct {
+	InferenceId string `protobuf:"bytes,1,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	Developer   string `protobuf:"bytes,2,opt,name=developer,proto3" json:"developer,omitempty"`
+	Executor    string `protobuf:"bytes,3,opt,name=executor,proto3" json:"executor,omitempty"`
+	Bond        uint64 `protobuf:"varint,4,opt,name=bond,proto3" json:"bond,omitempty"`
+	// validators chosen at random to re-run the inference
+	Validators         []string `protobuf:"bytes,5,rep,name=validators,proto3" json:"validators,omitempty"`
+	PassedVotes        []string `protobuf:"bytes,6,rep,name=passed_votes,json=passedVotes,proto3" json:"passed_votes,omitempty"`
+	FailedVotes        []string `protobuf:"bytes,7,rep,name=failed_votes,json=failedVotes,proto3" json:"failed_votes,omitempty"`
+	CreatedBlockHeight int64    `protobuf:"varint,8,opt,name=created_block_height,json=createdBlockHeight,proto3" json:"created_block_height,omitempty"`
+	// height after which a dispute no majority has decided expires and the bond is refunded
+	DeadlineBlockHeight int64 `protobuf:"varint,9,opt,name=deadline_block_height,json=deadlineBlockHeight,proto3" json:"deadline_block_height,omitempty"`
+}
+
+func (m *InferenceDispute) Reset()         { *m = InferenceDispute{} }
+func (m *InferenceDispute) String() string { return proto.CompactTextString(m) }
+func (*InferenceDispute) ProtoMessage()    {}
+func (*InferenceDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f3ab6fdb2fa9a3c, []int{0}
+}
+func (m *InferenceDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InferenceDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InferenceDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InferenceDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InferenceDispute.Merge(m, src)
+}
+func (m *InferenceDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *InferenceDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_InferenceDispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InferenceDispute proto.InternalMessageInfo
+
+func (m *InferenceDispute) GetInferenceId() string {
+	if m != nil {
+		return m.InferenceId
+	}
+	return ""
+}
+
+func (m *InferenceDispute) GetDeveloper() string {
+	if m != nil {
+		return m.Developer
+	}
+	return ""
+}
+
+func (m *InferenceDispute) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
+}
+
+func (m *InferenceDispute) GetBond() uint64 {
+	if m != nil {
+		return m.Bond
+	}
+	return 0
+}
+
+func (m *InferenceDispute) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *InferenceDispute) GetPassedVotes() []string {
+	if m != nil {
+		return m.PassedVotes
+	}
+	return nil
+}
+
+func (m *InferenceDispute) GetFailedVotes() []string {
+	if m != nil {
+		return m.FailedVotes
+	}
+	return nil
+}
+
+func (m *InferenceDispute) GetCreatedBlockHeight() int64 {
+	if m != nil {
+		return m.CreatedBlockHeight
+	}
+	return 0
+}
+
+func (m *InferenceDispute) GetDeadlineBlockHeight() int64 {
+	if m != nil {
+		return m.DeadlineBlockHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InferenceDispute)(nil), "inference.inference.InferenceDispute")
+}
+
+func init() {
+	proto.RegisterFile("inference/inference/inference_dispute.proto", fileDescriptor_0f3ab6fdb2fa9a3c)
+}
+
+var fileDescriptor_0f3ab6fdb2fa9a3c = []byte{
+	// 320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0x4b, 0xf3, 0x30,
+	0x18, 0xc7, 0x97, 0x6d, 0xef, 0xde, 0x35, 0xf3, 0x20, 0x99, 0x42, 0x10, 0x09, 0xd5, 0x53, 0x41,
+	0xd8, 0x44, 0xf1, 0x0b, 0x0c, 0x0f, 0xee, 0x24, 0xf4, 0xe0, 0xc1, 0x4b, 0x69, 0x93, 0x67, 0x5b,
+	0xb0, 0x36, 0x25, 0x49, 0xc7, 0xfc, 0x16, 0x7e, 0x2c, 0x6f, 0xee, 0xe8, 0x51, 0xb6, 0x2f, 0x22,
+	0x4d, 0x5d, 0x57, 0xc5, 0xdb, 0xff, 0xf9, 0xff, 0x7e, 0x4f, 0x08, 0x3c, 0xf8, 0x42, 0x66, 0x33,
+	0xd0, 0x90, 0x71, 0x18, 0xff, 0x91, 0x22, 0x21, 0x4d, 0x5e, 0x58, 0x18, 0xe5, 0x5a, 0x59, 0x45,
+	0x86, 0x35, 0x18, 0xd5, 0xe9, 0xfc, 0xbd, 0x8d, 0x0f, 0xa7, 0xbb, 0xe9, 0xb6, 0xf2, 0xc9, 0x19,
+	0x3e, 0xd8, 0x3f, 0x22, 0x05, 0x45, 0x3e, 0x0a, 0xbc, 0x70, 0x50, 0x77, 0x53, 0x41, 0x4e, 0xb1,
+	0x27, 0x60, 0x09, 0xa9, 0xca, 0x41, 0xd3, 0xb6, 0xe3, 0xfb, 0x82, 0x9c, 0xe0, 0x3e, 0xac, 0x80,
+	0x17, 0x56, 0x69, 0xda, 0x71, 0xb0, 0x9e, 0x09, 0xc1, 0xdd, 0x44, 0x65, 0x82, 0x76, 0x7d, 0x14,
+	0x74, 0x43, 0x97, 0x09, 0xc3, 0x78, 0x19, 0xa7, 0x52, 0xc4, 0x56, 0x69, 0x43, 0xff, 0xf9, 0x9d,
+	0xc0, 0x0b, 0x1b, 0x4d, 0xf9, 0xa1, 0x3c, 0x36, 0x06, 0x44, 0xb4, 0x54, 0x16, 0x0c, 0xed, 0x39,
+	0x63, 0x50, 0x75, 0x0f, 0x65, 0x55, 0x2a, 0xb3, 0x58, 0xa6, 0xb5, 0xf2, 0xbf, 0x52, 0xaa, 0xae,
+	0x52, 0x2e, 0xf1, 0x11, 0xd7, 0x10, 0x5b, 0x10, 0x51, 0x92, 0x2a, 0xfe, 0x14, 0x2d, 0x40, 0xce,
+	0x17, 0x96, 0xf6, 0x7d, 0x14, 0x74, 0x42, 0xf2, 0xcd, 0x26, 0x25, 0xba, 0x73, 0x84, 0x5c, 0xe1,
+	0x63, 0x01, 0xb1, 0x48, 0x65, 0x06, 0x3f, 0x57, 0x3c, 0xb7, 0x32, 0xdc, 0xc1, 0xc6, 0xce, 0xe4,
+	0xfe, 0x6d, 0xc3, 0xd0, 0x7a, 0xc3, 0xd0, 0xe7, 0x86, 0xa1, 0xd7, 0x2d, 0x6b, 0xad, 0xb7, 0xac,
+	0xf5, 0xb1, 0x65, 0xad, 0xc7, 0x9b, 0xb9, 0xb4, 0x8b, 0x22, 0x19, 0x71, 0xf5, 0x3c, 0xce, 0xb5,
+	0x12, 0x05, 0xb7, 0x86, 0xcb, 0x5f, 0xd7, 0x5b, 0x35, 0xb2, 0x7d, 0xc9, 0xc1, 0x24, 0x3d, 0x77,
+	0xbe, 0xeb, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x78, 0x2c, 0x69, 0x7e, 0xed, 0x01, 0x00, 0x00,
+}
+
+func (m *InferenceDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InferenceDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InferenceDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadlineBlockHeight != 0 {
+		i = encodeVarintInferenceDispute(dAtA, i, uint64(m.DeadlineBlockHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreatedBlockHeight != 0 {
+		i = encodeVarintInferenceDispute(dAtA, i, uint64(m.CreatedBlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.FailedVotes) > 0 {
+		for iNdEx := len(m.FailedVotes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailedVotes[iNdEx])
+			copy(dAtA[i:], m.FailedVotes[iNdEx])
+			i = encodeVarintInferenceDispute(dAtA, i, uint64(len(m.FailedVotes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PassedVotes) > 0 {
+		for iNdEx := len(m.PassedVotes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PassedVotes[iNdEx])
+			copy(dAtA[i:], m.PassedVotes[iNdEx])
+			i = encodeVarintInferenceDispute(dAtA, i, uint64(len(m.PassedVotes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintInferenceDispute(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Bond != 0 {
+		i = encodeVarintInferenceDispute(dAtA, i, uint64(m.Bond))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintInferenceDispute(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Developer) > 0 {
+		i -= len(m.Developer)
+		copy(dAtA[i:], m.Developer)
+		i = encodeVarintInferenceDispute(dAtA, i, uint64(len(m.Developer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintInferenceDispute(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInferenceDispute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInferenceDispute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InferenceDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovInferenceDispute(uint64(l))
+	}
+	l = len(m.Developer)
+	if l > 0 {
+		n += 1 + l + sovInferenceDispute(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovInferenceDispute(uint64(l))
+	}
+	if m.Bond != 0 {
+		n += 1 + sovInferenceDispute(uint64(m.Bond))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovInferenceDispute(uint64(l))
+		}
+	}
+	if len(m.PassedVotes) > 0 {
+		for _, s := range m.PassedVotes {
+			l = len(s)
+			n += 1 + l + sovInferenceDispute(uint64(l))
+		}
+	}
+	if len(m.FailedVotes) > 0 {
+		for _, s := range m.FailedVotes {
+			l = len(s)
+			n += 1 + l + sovInferenceDispute(uint64(l))
+		}
+	}
+	if m.CreatedBlockHeight != 0 {
+		n += 1 + sovInferenceDispute(uint64(m.CreatedBlockHeight))
+	}
+	if m.DeadlineBlockHeight != 0 {
+		n += 1 + sovInferenceDispute(uint64(m.DeadlineBlockHeight))
+	}
+	return n
+}
+
+func sovInferenceDispute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInferenceDispute(x uint64) (n int) {
+	return sovInferenceDispute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InferenceDispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInferenceDispute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InferenceDispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InferenceDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Developer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			m.Bond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bond |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassedVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PassedVotes = append(m.PassedVotes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedVotes = append(m.FailedVotes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBlockHeight", wireType)
+			}
+			m.CreatedBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineBlockHeight", wireType)
+			}
+			m.DeadlineBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInferenceDispute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInferenceDispute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInferenceDispute(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInferenceDispute
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInferenceDispute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInferenceDispute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInferenceDispute
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInferenceDispute
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInferenceDispute
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInferenceDispute        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInferenceDispute          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInferenceDispute = fmt.Errorf("proto: unexpected end of group")
+)
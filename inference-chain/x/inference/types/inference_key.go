@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (g InferenceKeyGrant) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(g.Developer); err != nil {
+		return fmt.Errorf("invalid inference key developer %s: %w", g.Developer, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(g.KeyAddress); err != nil {
+		return fmt.Errorf("invalid inference key address %s: %w", g.KeyAddress, err)
+	}
+	if g.Developer == g.KeyAddress {
+		return fmt.Errorf("developer %s cannot grant an inference key to itself", g.Developer)
+	}
+	if g.Expiration < 0 {
+		return fmt.Errorf("inference key expiration cannot be negative")
+	}
+	return nil
+}
+
+// CheckRequest verifies that a request for model with maxTokens, made at blockTime (unix seconds), is within the
+// limits of the grant. The epoch spend limit is checked separately against the usage of the key.
+func (g InferenceKeyGrant) CheckRequest(model string, maxTokens uint64, blockTime int64) error {
+	if g.Expiration != 0 && blockTime >= g.Expiration {
+		return ErrInferenceKeyExpired
+	}
+	if len(g.AllowedModels) > 0 && !slices.Contains(g.AllowedModels, model) {
+		return errorsmod.Wrap(ErrInferenceKeyModelNotAllowed, model)
+	}
+	if g.MaxTokensPerRequest != 0 && maxTokens > g.MaxTokensPerRequest {
+		return errorsmod.Wrapf(ErrInferenceKeyMaxTokensExceeded, "%d > %d", maxTokens, g.MaxTokensPerRequest)
+	}
+	return nil
+}
+
+// SpentInEpoch is what the key spent in epochIndex, usage of an earlier epoch doesn't count.
+func (u InferenceKeyUsage) SpentInEpoch(epochIndex uint64) uint64 {
+	if u.EpochIndex != epochIndex {
+		return 0
+	}
+	return u.EpochSpent
+}
@@ -0,0 +1,908 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/inference/inference_key.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InferenceKeyGrant lets a sub-key sign inference requests on behalf of a developer, within limits.
+type InferenceKeyGrant struct {
+	Developer  string `protobuf:"bytes,1,opt,name=developer,proto3" json:"developer,omitempty"`
+	KeyAddress string `protobuf:"bytes,2,opt,name=key_address,json=keyAddress,proto3" json:"key_address,omitempty"`
+	// epoch_spend_limit caps what the inferences of the key cost per epoch, 0 is unlimited. A running inference
+	// counts with its escrow, a finished one with its actual cost, expired and refunded ones not at all.
+	EpochSpendLimit uint64 `protobuf:"varint,3,opt,name=epoch_spend_limit,json=epochSpendLimit,proto3" json:"epoch_spend_limit,omitempty"`
+	// allowed_models the key can request, empty allows all models
+	AllowedModels []string `protobuf:"bytes,4,rep,name=allowed_models,json=allowedModels,proto3" json:"allowed_models,omitempty"`
+	// expiration is the unix time in seconds the key stops being valid at, 0 never expires
+	Expiration int64 `protobuf:"varint,5,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// max_tokens_per_request caps the max tokens of a single request, 0 is unlimited
+	MaxTokensPerRequest uint64 `protobuf:"varint,6,opt,name=max_tokens_per_request,json=maxTokensPerRequest,proto3" json:"max_tokens_per_request,omitempty"`
+}
+
+func (m *InferenceKeyGrant) Reset()         { *m = InferenceKeyGrant{} }
+func (m *InferenceKeyGrant) String() string { return proto.CompactTextString(m) }
+func (*InferenceKeyGrant) ProtoMessage()    {}
+func (*InferenceKeyGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7de173b86371a39d, []int{0}
+}
+func (m *InferenceKeyGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InferenceKeyGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InferenceKeyGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InferenceKeyGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InferenceKeyGrant.Merge(m, src)
+}
+func (m *InferenceKeyGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *InferenceKeyGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_InferenceKeyGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InferenceKeyGrant proto.InternalMessageInfo
+
+func (m *InferenceKeyGrant) GetDeveloper() string {
+	if m != nil {
+		return m.Developer
+	}
+	return ""
+}
+
+func (m *InferenceKeyGrant) GetKeyAddress() string {
+	if m != nil {
+		return m.KeyAddress
+	}
+	return ""
+}
+
+func (m *InferenceKeyGrant) GetEpochSpendLimit() uint64 {
+	if m != nil {
+		return m.EpochSpendLimit
+	}
+	return 0
+}
+
+func (m *InferenceKeyGrant) GetAllowedModels() []string {
+	if m != nil {
+		return m.AllowedModels
+	}
+	return nil
+}
+
+func (m *InferenceKeyGrant) GetExpiration() int64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *InferenceKeyGrant) GetMaxTokensPerRequest() uint64 {
+	if m != nil {
+		return m.MaxTokensPerRequest
+	}
+	return 0
+}
+
+type InferenceKeyUsage struct {
+	Developer      string `protobuf:"bytes,1,opt,name=developer,proto3" json:"developer,omitempty"`
+	KeyAddress     string `protobuf:"bytes,2,opt,name=key_address,json=keyAddress,proto3" json:"key_address,omitempty"`
+	EpochIndex     uint64 `protobuf:"varint,3,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	EpochSpent     uint64 `protobuf:"varint,4,opt,name=epoch_spent,json=epochSpent,proto3" json:"epoch_spent,omitempty"`
+	TotalSpent     uint64 `protobuf:"varint,5,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent,omitempty"`
+	InferenceCount uint64 `protobuf:"varint,6,opt,name=inference_count,json=inferenceCount,proto3" json:"inference_count,omitempty"`
+}
+
+func (m *InferenceKeyUsage) Reset()         { *m = InferenceKeyUsage{} }
+func (m *InferenceKeyUsage) String() string { return proto.CompactTextString(m) }
+func (*InferenceKeyUsage) ProtoMessage()    {}
+func (*InferenceKeyUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7de173b86371a39d, []int{1}
+}
+func (m *InferenceKeyUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InferenceKeyUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InferenceKeyUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InferenceKeyUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InferenceKeyUsage.Merge(m, src)
+}
+func (m *InferenceKeyUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *InferenceKeyUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_InferenceKeyUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InferenceKeyUsage proto.InternalMessageInfo
+
+func (m *InferenceKeyUsage) GetDeveloper() string {
+	if m != nil {
+		return m.Developer
+	}
+	return ""
+}
+
+func (m *InferenceKeyUsage) GetKeyAddress() string {
+	if m != nil {
+		return m.KeyAddress
+	}
+	return ""
+}
+
+func (m *InferenceKeyUsage) GetEpochIndex() uint64 {
+	if m != nil {
+		return m.EpochIndex
+	}
+	return 0
+}
+
+func (m *InferenceKeyUsage) GetEpochSpent() uint64 {
+	if m != nil {
+		return m.EpochSpent
+	}
+	return 0
+}
+
+func (m *InferenceKeyUsage) GetTotalSpent() uint64 {
+	if m != nil {
+		return m.TotalSpent
+	}
+	return 0
+}
+
+func (m *InferenceKeyUsage) GetInferenceCount() uint64 {
+	if m != nil {
+		return m.InferenceCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InferenceKeyGrant)(nil), "inference.inference.InferenceKeyGrant")
+	proto.RegisterType((*InferenceKeyUsage)(nil), "inference.inference.InferenceKeyUsage")
+}
+
+func init() {
+	proto.RegisterFile("inference/inference/inference_key.proto", fileDescriptor_7de173b86371a39d)
+}
+
+var fileDescriptor_7de173b86371a39d = []byte{
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xcd, 0xca, 0xd3, 0x40,
+	0x14, 0x86, 0x3b, 0xb6, 0x5f, 0xa1, 0x53, 0x6c, 0x69, 0x0a, 0x92, 0x85, 0xa4, 0xa1, 0x20, 0x0d,
+	0x2e, 0xda, 0x45, 0xf1, 0x02, 0xd4, 0x85, 0x14, 0x15, 0x25, 0xea, 0xc6, 0xcd, 0x30, 0xcd, 0x1c,
+	0xdb, 0x21, 0xc9, 0xcc, 0x38, 0x33, 0xd1, 0xe4, 0x2e, 0xbc, 0x2c, 0x97, 0x5d, 0xba, 0x12, 0x69,
+	0xef, 0xc2, 0x95, 0x64, 0xd2, 0x3f, 0x8a, 0xbb, 0x6f, 0x77, 0xf2, 0xbc, 0x0f, 0x84, 0xf7, 0xcc,
+	0xc1, 0x33, 0x2e, 0xbe, 0x80, 0x06, 0x91, 0xc0, 0xe2, 0x3f, 0x13, 0x49, 0xa1, 0x9a, 0x2b, 0x2d,
+	0xad, 0xf4, 0xc6, 0x67, 0x38, 0x3f, 0x4f, 0xd3, 0xbf, 0x08, 0x8f, 0x56, 0xa7, 0xaf, 0xd7, 0x50,
+	0xbd, 0xd2, 0x54, 0x58, 0xef, 0x31, 0xee, 0x31, 0xf8, 0x06, 0x99, 0x54, 0xa0, 0x7d, 0x14, 0xa2,
+	0xa8, 0x17, 0x5f, 0x80, 0x37, 0xc1, 0xfd, 0x14, 0x2a, 0x42, 0x19, 0xd3, 0x60, 0x8c, 0xff, 0xc0,
+	0xe5, 0x38, 0x85, 0xea, 0x79, 0x43, 0xbc, 0xa7, 0x78, 0x04, 0x4a, 0x26, 0x5b, 0x62, 0x14, 0x08,
+	0x46, 0x32, 0x9e, 0x73, 0xeb, 0xb7, 0x43, 0x14, 0x75, 0xe2, 0xa1, 0x0b, 0x3e, 0xd4, 0xfc, 0x4d,
+	0x8d, 0xbd, 0x27, 0x78, 0x40, 0xb3, 0x4c, 0x7e, 0x07, 0x46, 0x72, 0xc9, 0x20, 0x33, 0x7e, 0x27,
+	0x6c, 0x47, 0xbd, 0xf8, 0xe1, 0x91, 0xbe, 0x75, 0xd0, 0x0b, 0x30, 0x86, 0x52, 0x71, 0x4d, 0x2d,
+	0x97, 0xc2, 0xbf, 0x0b, 0x51, 0xd4, 0x8e, 0xaf, 0x88, 0xb7, 0xc4, 0x8f, 0x72, 0x5a, 0x12, 0x2b,
+	0x53, 0x10, 0x86, 0x28, 0xd0, 0x44, 0xc3, 0xd7, 0x02, 0x8c, 0xf5, 0xbb, 0xee, 0xbf, 0xe3, 0x9c,
+	0x96, 0x1f, 0x5d, 0xf8, 0x1e, 0x74, 0xdc, 0x44, 0xd3, 0xdf, 0x37, 0xe5, 0x3f, 0x19, 0xba, 0x81,
+	0xfb, 0x96, 0x9f, 0xe0, 0x7e, 0x53, 0x9e, 0x0b, 0x06, 0xe5, 0xb1, 0x36, 0x76, 0x68, 0x55, 0x93,
+	0x8b, 0x50, 0x6f, 0xc7, 0xfa, 0x9d, 0x2b, 0xa1, 0xde, 0x8b, 0xad, 0x05, 0x2b, 0x2d, 0xcd, 0x8e,
+	0xc2, 0x5d, 0x23, 0x38, 0xd4, 0x08, 0x33, 0x3c, 0xbc, 0x3c, 0x70, 0x22, 0x0b, 0x71, 0x6a, 0x39,
+	0x38, 0xe3, 0x97, 0x35, 0x7d, 0xf1, 0xee, 0xe7, 0x3e, 0x40, 0xbb, 0x7d, 0x80, 0xfe, 0xec, 0x03,
+	0xf4, 0xe3, 0x10, 0xb4, 0x76, 0x87, 0xa0, 0xf5, 0xeb, 0x10, 0xb4, 0x3e, 0x3f, 0xdb, 0x70, 0xbb,
+	0x2d, 0xd6, 0xf3, 0x44, 0xe6, 0x0b, 0xa5, 0x25, 0x2b, 0x12, 0x6b, 0x12, 0x7e, 0x73, 0x45, 0xe5,
+	0xd5, 0x6c, 0x2b, 0x05, 0x66, 0xdd, 0x75, 0xa7, 0xb4, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0xf7,
+	0x3d, 0x32, 0xeb, 0x75, 0x02, 0x00, 0x00,
+}
+
+func (m *InferenceKeyGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InferenceKeyGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InferenceKeyGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTokensPerRequest != 0 {
+		i = encodeVarintInferenceKey(dAtA, i, uint64(m.MaxTokensPerRequest))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Expiration != 0 {
+		i = encodeVarintInferenceKey(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllowedModels) > 0 {
+		for iNdEx := len(m.AllowedModels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedModels[iNdEx])
+			copy(dAtA[i:], m.AllowedModels[iNdEx])
+			i = encodeVarintInferenceKey(dAtA, i, uint64(len(m.AllowedModels[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EpochSpendLimit != 0 {
+		i = encodeVarintInferenceKey(dAtA, i, uint64(m.EpochSpendLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.KeyAddress) > 0 {
+		i -= len(m.KeyAddress)
+		copy(dAtA[i:], m.KeyAddress)
+		i = encodeVarintInferenceKey(dAtA, i, uint64(len(m.KeyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Developer) > 0 {
+		i -= len(m.Developer)
+		copy(dAtA[i:], m.Developer)
+		i = encodeVarintInferenceKey(dAtA, i, uint64(len(m.Developer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InferenceKeyUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InferenceKeyUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InferenceKeyUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InferenceCount != 0 {
+		i = encodeVarintInferenceKey(dAtA, i, uint64(m.InferenceCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalSpent != 0 {
+		i = encodeVarintInferenceKey(dAtA, i, uint64(m.TotalSpent))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EpochSpent != 0 {
+		i = encodeVarintInferenceKey(dAtA, i, uint64(m.EpochSpent))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EpochIndex != 0 {
+		i = encodeVarintInferenceKey(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.KeyAddress) > 0 {
+		i -= len(m.KeyAddress)
+		copy(dAtA[i:], m.KeyAddress)
+		i = encodeVarintInferenceKey(dAtA, i, uint64(len(m.KeyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Developer) > 0 {
+		i -= len(m.Developer)
+		copy(dAtA[i:], m.Developer)
+		i = encodeVarintInferenceKey(dAtA, i, uint64(len(m.Developer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInferenceKey(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInferenceKey(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InferenceKeyGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Developer)
+	if l > 0 {
+		n += 1 + l + sovInferenceKey(uint64(l))
+	}
+	l = len(m.KeyAddress)
+	if l > 0 {
+		n += 1 + l + sovInferenceKey(uint64(l))
+	}
+	if m.EpochSpendLimit != 0 {
+		n += 1 + sovInferenceKey(uint64(m.EpochSpendLimit))
+	}
+	if len(m.AllowedModels) > 0 {
+		for _, s := range m.AllowedModels {
+			l = len(s)
+			n += 1 + l + sovInferenceKey(uint64(l))
+		}
+	}
+	if m.Expiration != 0 {
+		n += 1 + sovInferenceKey(uint64(m.Expiration))
+	}
+	if m.MaxTokensPerRequest != 0 {
+		n += 1 + sovInferenceKey(uint64(m.MaxTokensPerRequest))
+	}
+	return n
+}
+
+func (m *InferenceKeyUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Developer)
+	if l > 0 {
+		n += 1 + l + sovInferenceKey(uint64(l))
+	}
+	l = len(m.KeyAddress)
+	if l > 0 {
+		n += 1 + l + sovInferenceKey(uint64(l))
+	}
+	if m.EpochIndex != 0 {
+		n += 1 + sovInferenceKey(uint64(m.EpochIndex))
+	}
+	if m.EpochSpent != 0 {
+		n += 1 + sovInferenceKey(uint64(m.EpochSpent))
+	}
+	if m.TotalSpent != 0 {
+		n += 1 + sovInferenceKey(uint64(m.TotalSpent))
+	}
+	if m.InferenceCount != 0 {
+		n += 1 + sovInferenceKey(uint64(m.InferenceCount))
+	}
+	return n
+}
+
+func sovInferenceKey(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInferenceKey(x uint64) (n int) {
+	return sovInferenceKey(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InferenceKeyGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInferenceKey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InferenceKeyGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InferenceKeyGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Developer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochSpendLimit", wireType)
+			}
+			m.EpochSpendLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochSpendLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedModels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedModels = append(m.AllowedModels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			m.Expiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokensPerRequest", wireType)
+			}
+			m.MaxTokensPerRequest = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTokensPerRequest |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInferenceKey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInferenceKey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InferenceKeyUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInferenceKey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InferenceKeyUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InferenceKeyUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Developer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			}
+			m.EpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochSpent", wireType)
+			}
+			m.EpochSpent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochSpent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSpent", wireType)
+			}
+			m.TotalSpent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSpent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceCount", wireType)
+			}
+			m.InferenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InferenceCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInferenceKey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInferenceKey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInferenceKey(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInferenceKey
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInferenceKey
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInferenceKey
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInferenceKey
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInferenceKey
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInferenceKey
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInferenceKey        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInferenceKey          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInferenceKey = fmt.Errorf("proto: unexpected end of group")
+)
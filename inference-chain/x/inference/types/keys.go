@@ -44,6 +44,25 @@ var (
 	PruningStatePrefix               = collections.NewPrefix(21)
 	InferencesToPrunePrefix          = collections.NewPrefix(22)
 	ActiveInvalidationsPrefix        = collections.NewPrefix(23)
+	EquivocationEvidencePrefix       = collections.NewPrefix(24)
+	InferenceDisputesPrefix          = collections.NewPrefix(25)
+	DeveloperCreditsPrefix           = collections.NewPrefix(26)
+	CreditWithdrawalsPrefix          = collections.NewPrefix(27)
+	InferenceKeyGrantsPrefix         = collections.NewPrefix(28)
+	InferenceKeyUsagesPrefix         = collections.NewPrefix(29)
+	PriceQuotesPrefix                = collections.NewPrefix(30)
+	PriceQuoteSequencePrefix         = collections.NewPrefix(31)
+	PriceQuoteExpirationsPrefix      = collections.NewPrefix(32)
+	ParticipantExitsPrefix           = collections.NewPrefix(33)
+	InferenceUrlChangesPrefix        = collections.NewPrefix(34)
+	InferencesByRequesterPrefix      = collections.NewPrefix(35)
+	InferencesByExecutorPrefix       = collections.NewPrefix(36)
+	InferencesByStatusPrefix         = collections.NewPrefix(37)
+	ValidatorStatsPrefix             = collections.NewPrefix(38)
+	ValidatorStatsByValidatorPrefix  = collections.NewPrefix(39)
+	ValidatorTargetStatsPrefix       = collections.NewPrefix(40)
+	DisputeDeadlinesPrefix           = collections.NewPrefix(41)
+	WithdrawalsByDeveloperPrefix     = collections.NewPrefix(42)
 	ParamsKey                        = []byte("p_inference")
 )
 
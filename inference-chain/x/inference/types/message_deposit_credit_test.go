@@ -0,0 +1,56 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDepositCredit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDepositCredit
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDepositCredit{
+				Creator: "invalid_address",
+				Amount:  100,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg: MsgDepositCredit{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "amount overflows",
+			msg: MsgDepositCredit{
+				Creator: sample.AccAddress(),
+				Amount:  math.MaxInt64 + 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgDepositCredit{
+				Creator: sample.AccAddress(),
+				Amount:  100,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
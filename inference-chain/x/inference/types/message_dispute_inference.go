@@ -0,0 +1,28 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgDisputeInference{}
+
+func NewMsgDisputeInference(creator string, inferenceID string) *MsgDisputeInference {
+	return &MsgDisputeInference{
+		Creator:     creator,
+		InferenceId: inferenceID,
+	}
+}
+
+func (msg *MsgDisputeInference) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if strings.TrimSpace(msg.InferenceId) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "inference_id is required")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDisputeInference_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDisputeInference
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDisputeInference{
+				Creator:     "invalid_address",
+				InferenceId: "iid",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing inference id",
+			msg: MsgDisputeInference{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgDisputeInference{
+				Creator:     sample.AccAddress(),
+				InferenceId: "iid",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -38,6 +38,12 @@ func (msg *MsgFinishInference) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(strings.TrimSpace(msg.RequestedBy)); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid requested_by address (%s)", err)
 	}
+	// optional inference key signing for requested_by
+	if msg.RequesterKey != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.RequesterKey); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid requester_key address (%s)", err)
+		}
+	}
 	// all required fields non-empty
 	if strings.TrimSpace(msg.ResponseHash) == "" {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "response_hash is required")
@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgGrantInferenceKey{}
+
+func NewMsgGrantInferenceKey(creator string, keyAddress string, epochSpendLimit uint64, allowedModels []string, expiration int64, maxTokensPerRequest uint64) *MsgGrantInferenceKey {
+	return &MsgGrantInferenceKey{
+		Creator:             creator,
+		KeyAddress:          keyAddress,
+		EpochSpendLimit:     epochSpendLimit,
+		AllowedModels:       allowedModels,
+		Expiration:          expiration,
+		MaxTokensPerRequest: maxTokensPerRequest,
+	}
+}
+
+func (msg *MsgGrantInferenceKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.ToGrant().Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func (msg *MsgGrantInferenceKey) ToGrant() InferenceKeyGrant {
+	return InferenceKeyGrant{
+		Developer:           msg.Creator,
+		KeyAddress:          msg.KeyAddress,
+		EpochSpendLimit:     msg.EpochSpendLimit,
+		AllowedModels:       msg.AllowedModels,
+		Expiration:          msg.Expiration,
+		MaxTokensPerRequest: msg.MaxTokensPerRequest,
+	}
+}
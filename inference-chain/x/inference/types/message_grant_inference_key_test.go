@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgGrantInferenceKey_ValidateBasic(t *testing.T) {
+	developer := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgGrantInferenceKey
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgGrantInferenceKey{
+				Creator:    "invalid_address",
+				KeyAddress: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid key address",
+			msg: MsgGrantInferenceKey{
+				Creator:    developer,
+				KeyAddress: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "key is the developer",
+			msg: MsgGrantInferenceKey{
+				Creator:    developer,
+				KeyAddress: developer,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "negative expiration",
+			msg: MsgGrantInferenceKey{
+				Creator:    developer,
+				KeyAddress: sample.AccAddress(),
+				Expiration: -1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgGrantInferenceKey{
+				Creator:             developer,
+				KeyAddress:          sample.AccAddress(),
+				EpochSpendLimit:     1_000_000,
+				AllowedModels:       []string{"Qwen/Qwen2.5-7B-Instruct"},
+				Expiration:          1_900_000_000,
+				MaxTokensPerRequest: 2_000,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
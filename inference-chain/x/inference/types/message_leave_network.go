@@ -0,0 +1,22 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgLeaveNetwork{}
+
+func NewMsgLeaveNetwork(creator string) *MsgLeaveNetwork {
+	return &MsgLeaveNetwork{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgLeaveNetwork) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRequestPriceQuote{}
+
+func NewMsgRequestPriceQuote(creator string, modelId string, durationBlocks int64, tokenBudget uint64) *MsgRequestPriceQuote {
+	return &MsgRequestPriceQuote{
+		Creator:        creator,
+		ModelId:        modelId,
+		DurationBlocks: durationBlocks,
+		TokenBudget:    tokenBudget,
+	}
+}
+
+func (msg *MsgRequestPriceQuote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if strings.TrimSpace(msg.ModelId) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "model_id is required")
+	}
+	if msg.DurationBlocks <= 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "duration_blocks must be > 0")
+	}
+	if msg.TokenBudget == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "token_budget must be > 0")
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRequestPriceQuote_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRequestPriceQuote
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRequestPriceQuote{
+				Creator:        "invalid_address",
+				ModelId:        "model",
+				DurationBlocks: 100,
+				TokenBudget:    1_000,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing model",
+			msg: MsgRequestPriceQuote{
+				Creator:        sample.AccAddress(),
+				DurationBlocks: 100,
+				TokenBudget:    1_000,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero duration",
+			msg: MsgRequestPriceQuote{
+				Creator:     sample.AccAddress(),
+				ModelId:     "model",
+				TokenBudget: 1_000,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero budget",
+			msg: MsgRequestPriceQuote{
+				Creator:        sample.AccAddress(),
+				ModelId:        "model",
+				DurationBlocks: 100,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgRequestPriceQuote{
+				Creator:        sample.AccAddress(),
+				ModelId:        "model",
+				DurationBlocks: 100,
+				TokenBudget:    1_000,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
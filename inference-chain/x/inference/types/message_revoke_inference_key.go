@@ -0,0 +1,26 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRevokeInferenceKey{}
+
+func NewMsgRevokeInferenceKey(creator string, keyAddress string) *MsgRevokeInferenceKey {
+	return &MsgRevokeInferenceKey{
+		Creator:    creator,
+		KeyAddress: keyAddress,
+	}
+}
+
+func (msg *MsgRevokeInferenceKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.KeyAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid key address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRevokeInferenceKey_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRevokeInferenceKey
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRevokeInferenceKey{
+				Creator:    "invalid_address",
+				KeyAddress: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid key address",
+			msg: MsgRevokeInferenceKey{
+				Creator:    sample.AccAddress(),
+				KeyAddress: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg: MsgRevokeInferenceKey{
+				Creator:    sample.AccAddress(),
+				KeyAddress: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
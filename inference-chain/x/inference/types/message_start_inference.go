@@ -33,6 +33,12 @@ func (msg *MsgStartInference) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(strings.TrimSpace(msg.AssignedTo)); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid assigned_to address (%s)", err)
 	}
+	// optional inference key signing for requested_by
+	if msg.RequesterKey != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.RequesterKey); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid requester_key address (%s)", err)
+		}
+	}
 	// required strings
 	if err := utils.ValidateBase64RSig64("inference_id", strings.TrimSpace(msg.InferenceId)); err != nil {
 		return err
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSubmitInferenceEvidence{}
+
+func NewMsgSubmitInferenceEvidence(creator string, executor string, first SignedExecution, second SignedExecution) *MsgSubmitInferenceEvidence {
+	return &MsgSubmitInferenceEvidence{
+		Creator:  creator,
+		Executor: executor,
+		First:    first,
+		Second:   second,
+	}
+}
+
+func (msg *MsgSubmitInferenceEvidence) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Executor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid executor address (%s)", err)
+	}
+	if msg.Creator == msg.Executor {
+		return errorsmod.Wrap(ErrInvalidEvidence, "executor cannot report itself")
+	}
+	for _, execution := range []SignedExecution{msg.First, msg.Second} {
+		if strings.TrimSpace(execution.InferenceId) == "" {
+			return errorsmod.Wrap(ErrInvalidEvidence, "inference_id is required")
+		}
+		if execution.ExecutionSignature == "" {
+			return errorsmod.Wrap(ErrInvalidEvidence, "execution_signature is required")
+		}
+	}
+	if msg.First.InferenceId != msg.Second.InferenceId || msg.First.Seed != msg.Second.Seed {
+		return errorsmod.Wrap(ErrInvalidEvidence, "executions must be for the same inference id and seed")
+	}
+	if msg.First.ResponseHash == msg.Second.ResponseHash {
+		return errorsmod.Wrap(ErrInvalidEvidence, "executions must have different response hashes")
+	}
+	return nil
+}
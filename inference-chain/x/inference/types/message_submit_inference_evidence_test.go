@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSubmitInferenceEvidence_ValidateBasic(t *testing.T) {
+	executor := sample.AccAddress()
+	first := SignedExecution{InferenceId: "iid", Seed: 7, ResponseHash: "hash-1", ExecutionSignature: "sig-1"}
+	second := SignedExecution{InferenceId: "iid", Seed: 7, ResponseHash: "hash-2", ExecutionSignature: "sig-2"}
+	otherSeed := second
+	otherSeed.Seed = 8
+	sameResponse := second
+	sameResponse.ResponseHash = first.ResponseHash
+
+	tests := []struct {
+		name string
+		msg  MsgSubmitInferenceEvidence
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  MsgSubmitInferenceEvidence{Creator: "invalid_address", Executor: executor, First: first, Second: second},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "self report",
+			msg:  MsgSubmitInferenceEvidence{Creator: executor, Executor: executor, First: first, Second: second},
+			err:  ErrInvalidEvidence,
+		}, {
+			name: "different seed",
+			msg:  MsgSubmitInferenceEvidence{Creator: sample.AccAddress(), Executor: executor, First: first, Second: otherSeed},
+			err:  ErrInvalidEvidence,
+		}, {
+			name: "same response",
+			msg:  MsgSubmitInferenceEvidence{Creator: sample.AccAddress(), Executor: executor, First: first, Second: sameResponse},
+			err:  ErrInvalidEvidence,
+		}, {
+			name: "valid",
+			msg:  MsgSubmitInferenceEvidence{Creator: sample.AccAddress(), Executor: executor, First: first, Second: second},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
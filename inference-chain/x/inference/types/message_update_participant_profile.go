@@ -0,0 +1,37 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/x/inference/utils"
+)
+
+var _ sdk.Msg = &MsgUpdateParticipantProfile{}
+
+func NewMsgUpdateParticipantProfile(creator string, inferenceUrl string, displayName string, website string, contact string, regionCode string, supportedProtocols uint32) *MsgUpdateParticipantProfile {
+	return &MsgUpdateParticipantProfile{
+		Creator:            creator,
+		InferenceUrl:       inferenceUrl,
+		DisplayName:        displayName,
+		Website:            website,
+		Contact:            contact,
+		RegionCode:         regionCode,
+		SupportedProtocols: supportedProtocols,
+	}
+}
+
+func (msg *MsgUpdateParticipantProfile) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	// inference_url optional; if provided, must be a valid http/https URL
+	if strings.TrimSpace(msg.InferenceUrl) != "" {
+		if err := utils.ValidateURL("inference_url", msg.InferenceUrl); err != nil {
+			return err
+		}
+	}
+	return ValidateProfileFields(msg.DisplayName, msg.Website, msg.Contact, msg.RegionCode, msg.SupportedProtocols)
+}
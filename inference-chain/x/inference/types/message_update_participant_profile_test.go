@@ -0,0 +1,80 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateParticipantProfile_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateParticipantProfile
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateParticipantProfile{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid inference url",
+			msg: MsgUpdateParticipantProfile{
+				Creator:      sample.AccAddress(),
+				InferenceUrl: "ftp://node.example.com",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "display name too long",
+			msg: MsgUpdateParticipantProfile{
+				Creator:     sample.AccAddress(),
+				DisplayName: strings.Repeat("a", MaxProfileDisplayNameLength+1),
+			},
+			err: ErrInvalidParticipantProfile,
+		}, {
+			name: "invalid region code",
+			msg: MsgUpdateParticipantProfile{
+				Creator:    sample.AccAddress(),
+				RegionCode: "usa",
+			},
+			err: ErrInvalidParticipantProfile,
+		}, {
+			name: "unknown protocol flag",
+			msg: MsgUpdateParticipantProfile{
+				Creator:            sample.AccAddress(),
+				SupportedProtocols: AllProtocols + 1,
+			},
+			err: ErrInvalidParticipantProfile,
+		}, {
+			name: "valid",
+			msg: MsgUpdateParticipantProfile{
+				Creator:            sample.AccAddress(),
+				InferenceUrl:       "https://node.example.com",
+				DisplayName:        "Example Host",
+				Website:            "https://example.com",
+				Contact:            "ops@example.com",
+				RegionCode:         "DE",
+				SupportedProtocols: ProtocolChatCompletions | ProtocolStreaming,
+			},
+		}, {
+			name: "empty profile",
+			msg: MsgUpdateParticipantProfile{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgWithdrawCredit{}
+
+func NewMsgWithdrawCredit(creator string, amount uint64) *MsgWithdrawCredit {
+	return &MsgWithdrawCredit{
+		Creator: creator,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgWithdrawCredit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Amount == 0 || msg.Amount > math.MaxInt64 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amount %d", msg.Amount)
+	}
+	return nil
+}
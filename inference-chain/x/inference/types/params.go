@@ -16,6 +16,8 @@ var (
 	KeyGracePeriodEndEpoch               = []byte("GracePeriodEndEpoch")
 	KeyBaseWeightRatio                   = []byte("BaseWeightRatio")
 	KeyCollateralPerWeightUnit           = []byte("CollateralPerWeightUnit")
+	KeySlashFractionEquivocation         = []byte("SlashFractionEquivocation")
+	KeyWhistleblowerRewardFraction       = []byte("WhistleblowerRewardFraction")
 	// Vesting parameter keys for TokenomicsParams
 	KeyWorkVestingPeriod     = []byte("WorkVestingPeriod")
 	KeyRewardVestingPeriod   = []byte("RewardVestingPeriod")
@@ -79,13 +81,15 @@ func DefaultGenesisOnlyParams() GenesisOnlyParams {
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return Params{
-		EpochParams:          DefaultEpochParams(),
-		ValidationParams:     DefaultValidationParams(),
-		PocParams:            DefaultPocParams(),
-		TokenomicsParams:     DefaultTokenomicsParams(),
-		CollateralParams:     DefaultCollateralParams(),
-		BitcoinRewardParams:  DefaultBitcoinRewardParams(),
-		DynamicPricingParams: DefaultDynamicPricingParams(),
+		EpochParams:              DefaultEpochParams(),
+		ValidationParams:         DefaultValidationParams(),
+		PocParams:                DefaultPocParams(),
+		TokenomicsParams:         DefaultTokenomicsParams(),
+		CollateralParams:         DefaultCollateralParams(),
+		BitcoinRewardParams:      DefaultBitcoinRewardParams(),
+		DynamicPricingParams:     DefaultDynamicPricingParams(),
+		DeveloperCreditParams:    DefaultDeveloperCreditParams(),
+		ParticipantProfileParams: DefaultParticipantProfileParams(),
 	}
 }
 
@@ -121,6 +125,10 @@ func DefaultValidationParams() *ValidationParams {
 		MissRequestsPenalty:         DecimalFromFloat(1.0),
 		TimestampExpiration:         60,
 		TimestampAdvance:            30,
+		DisputeBond:                 1_000_000_000, // 1 gonka
+		DisputeWindowBlocks:         100,
+		DisputeValidators:           5,
+		DisputeTimeoutBlocks:        600,
 	}
 }
 
@@ -153,6 +161,8 @@ func DefaultCollateralParams() *CollateralParams {
 		GracePeriodEndEpoch:               180,
 		BaseWeightRatio:                   DecimalFromFloat(0.2),
 		CollateralPerWeightUnit:           DecimalFromFloat(1),
+		SlashFractionEquivocation:         DecimalFromFloat(0.50),
+		WhistleblowerRewardFraction:       DecimalFromFloat(0.10),
 	}
 }
 
@@ -168,6 +178,19 @@ func DefaultBitcoinRewardParams() *BitcoinRewardParams {
 	}
 }
 
+func DefaultDeveloperCreditParams() *DeveloperCreditParams {
+	return &DeveloperCreditParams{
+		WithdrawalDelayBlocks: 200,
+	}
+}
+
+func DefaultParticipantProfileParams() *ParticipantProfileParams {
+	return &ParticipantProfileParams{
+		MaxUpdatesPerEpoch:   2,
+		UrlChangeDelayBlocks: 20,
+	}
+}
+
 func DefaultDynamicPricingParams() *DynamicPricingParams {
 	return &DynamicPricingParams{
 		StabilityZoneLowerBound:   DecimalFromFloat(0.40), // Lower bound of stability zone (40%)
@@ -178,6 +201,8 @@ func DefaultDynamicPricingParams() *DynamicPricingParams {
 		BasePerTokenPrice:         100,                    // Initial per-token price after grace period (100 ngonka)
 		GracePeriodEndEpoch:       90,                     // Grace period ends at epoch 90
 		GracePeriodPerTokenPrice:  0,                      // Free inference during grace period (0 ngonka)
+		MaxQuoteDurationBlocks:    600,                    // Price quotes lock a price for up to about an hour
+		MaxQuoteTokenBudget:       1_000_000,              // A quote covers at most 1M tokens
 	}
 }
 
@@ -194,6 +219,8 @@ func (p *CollateralParams) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyGracePeriodEndEpoch, &p.GracePeriodEndEpoch, validateEpoch),
 		paramtypes.NewParamSetPair(KeyBaseWeightRatio, &p.BaseWeightRatio, validateBaseWeightRatio),
 		paramtypes.NewParamSetPair(KeyCollateralPerWeightUnit, &p.CollateralPerWeightUnit, validateCollateralPerWeightUnit),
+		paramtypes.NewParamSetPair(KeySlashFractionEquivocation, &p.SlashFractionEquivocation, validateSlashFraction),
+		paramtypes.NewParamSetPair(KeyWhistleblowerRewardFraction, &p.WhistleblowerRewardFraction, validatePercentage),
 	}
 }
 
@@ -311,6 +338,31 @@ func (p Params) Validate() error {
 	if err := p.DynamicPricingParams.Validate(); err != nil {
 		return err
 	}
+	// Chains upgraded from before developer credit have no params for it yet
+	if p.DeveloperCreditParams != nil {
+		if err := p.DeveloperCreditParams.Validate(); err != nil {
+			return err
+		}
+	}
+	if p.ParticipantProfileParams != nil {
+		if err := p.ParticipantProfileParams.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *DeveloperCreditParams) Validate() error {
+	if p.WithdrawalDelayBlocks < 0 {
+		return fmt.Errorf("withdrawal delay blocks cannot be negative")
+	}
+	return nil
+}
+
+func (p *ParticipantProfileParams) Validate() error {
+	if p.UrlChangeDelayBlocks < 0 {
+		return fmt.Errorf("url change delay blocks cannot be negative")
+	}
 	return nil
 }
 
@@ -344,6 +396,12 @@ func (p *ValidationParams) Validate() error {
 	if p.TimestampAdvance <= 0 {
 		return fmt.Errorf("timestamp advance must be positive")
 	}
+	if p.DisputeWindowBlocks < 0 {
+		return fmt.Errorf("dispute window blocks cannot be negative")
+	}
+	if p.DisputeTimeoutBlocks < 0 {
+		return fmt.Errorf("dispute timeout blocks cannot be negative")
+	}
 	return nil
 }
 
@@ -395,6 +453,17 @@ func (p *CollateralParams) Validate() error {
 	if err := validateCollateralPerWeightUnit(p.CollateralPerWeightUnit); err != nil {
 		return errors.Wrap(err, "invalid collateral_per_weight_unit")
 	}
+	// Unset on chains upgraded from before equivocation evidence existed, which disables it
+	if p.SlashFractionEquivocation != nil {
+		if err := validateSlashFraction(p.SlashFractionEquivocation); err != nil {
+			return errors.Wrap(err, "invalid slash_fraction_equivocation")
+		}
+	}
+	if p.WhistleblowerRewardFraction != nil {
+		if err := validatePercentage(p.WhistleblowerRewardFraction); err != nil {
+			return errors.Wrap(err, "invalid whistleblower_reward_fraction")
+		}
+	}
 	return nil
 }
 
@@ -473,6 +542,9 @@ func (p *DynamicPricingParams) Validate() error {
 	if err := validateEpoch(p.GracePeriodEndEpoch); err != nil {
 		return errors.Wrap(err, "invalid grace_period_end_epoch")
 	}
+	if p.MaxQuoteDurationBlocks < 0 {
+		return fmt.Errorf("max_quote_duration_blocks cannot be negative")
+	}
 
 	// Validate stability zone bounds are logically consistent
 	lowerBound := p.StabilityZoneLowerBound.ToFloat()
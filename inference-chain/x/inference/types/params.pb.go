@@ -27,14 +27,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	EpochParams           *EpochParams           `protobuf:"bytes,1,opt,name=epoch_params,json=epochParams,proto3" json:"epoch_params,omitempty"`
-	ValidationParams      *ValidationParams      `protobuf:"bytes,2,opt,name=validation_params,json=validationParams,proto3" json:"validation_params,omitempty"`
-	PocParams             *PocParams             `protobuf:"bytes,3,opt,name=poc_params,json=pocParams,proto3" json:"poc_params,omitempty"`
-	TokenomicsParams      *TokenomicsParams      `protobuf:"bytes,4,opt,name=tokenomics_params,json=tokenomicsParams,proto3" json:"tokenomics_params,omitempty"`
-	CollateralParams      *CollateralParams      `protobuf:"bytes,5,opt,name=collateral_params,json=collateralParams,proto3" json:"collateral_params,omitempty"`
-	BitcoinRewardParams   *BitcoinRewardParams   `protobuf:"bytes,6,opt,name=bitcoin_reward_params,json=bitcoinRewardParams,proto3" json:"bitcoin_reward_params,omitempty"`
-	DynamicPricingParams  *DynamicPricingParams  `protobuf:"bytes,7,opt,name=dynamic_pricing_params,json=dynamicPricingParams,proto3" json:"dynamic_pricing_params,omitempty"`
-	BandwidthLimitsParams *BandwidthLimitsParams `protobuf:"bytes,8,opt,name=bandwidth_limits_params,json=bandwidthLimitsParams,proto3" json:"bandwidth_limits_params,omitempty"`
+	EpochParams              *EpochParams              `protobuf:"bytes,1,opt,name=epoch_params,json=epochParams,proto3" json:"epoch_params,omitempty"`
+	ValidationParams         *ValidationParams         `protobuf:"bytes,2,opt,name=validation_params,json=validationParams,proto3" json:"validation_params,omitempty"`
+	PocParams                *PocParams                `protobuf:"bytes,3,opt,name=poc_params,json=pocParams,proto3" json:"poc_params,omitempty"`
+	TokenomicsParams         *TokenomicsParams         `protobuf:"bytes,4,opt,name=tokenomics_params,json=tokenomicsParams,proto3" json:"tokenomics_params,omitempty"`
+	CollateralParams         *CollateralParams         `protobuf:"bytes,5,opt,name=collateral_params,json=collateralParams,proto3" json:"collateral_params,omitempty"`
+	BitcoinRewardParams      *BitcoinRewardParams      `protobuf:"bytes,6,opt,name=bitcoin_reward_params,json=bitcoinRewardParams,proto3" json:"bitcoin_reward_params,omitempty"`
+	DynamicPricingParams     *DynamicPricingParams     `protobuf:"bytes,7,opt,name=dynamic_pricing_params,json=dynamicPricingParams,proto3" json:"dynamic_pricing_params,omitempty"`
+	BandwidthLimitsParams    *BandwidthLimitsParams    `protobuf:"bytes,8,opt,name=bandwidth_limits_params,json=bandwidthLimitsParams,proto3" json:"bandwidth_limits_params,omitempty"`
+	DeveloperCreditParams    *DeveloperCreditParams    `protobuf:"bytes,9,opt,name=developer_credit_params,json=developerCreditParams,proto3" json:"developer_credit_params,omitempty"`
+	ParticipantProfileParams *ParticipantProfileParams `protobuf:"bytes,10,opt,name=participant_profile_params,json=participantProfileParams,proto3" json:"participant_profile_params,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -126,6 +128,20 @@ func (m *Params) GetBandwidthLimitsParams() *BandwidthLimitsParams {
 	return nil
 }
 
+func (m *Params) GetDeveloperCreditParams() *DeveloperCreditParams {
+	if m != nil {
+		return m.DeveloperCreditParams
+	}
+	return nil
+}
+
+func (m *Params) GetParticipantProfileParams() *ParticipantProfileParams {
+	if m != nil {
+		return m.ParticipantProfileParams
+	}
+	return nil
+}
+
 type GenesisOnlyParams struct {
 	TotalSupply                             int64    `protobuf:"varint,1,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
 	OriginatorSupply                        int64    `protobuf:"varint,2,opt,name=originator_supply,json=originatorSupply,proto3" json:"originator_supply,omitempty"`
@@ -546,6 +562,10 @@ type ValidationParams struct {
 	TimestampExpiration         int64    `protobuf:"varint,13,opt,name=timestamp_expiration,json=timestampExpiration,proto3" json:"timestamp_expiration,omitempty"`
 	TimestampAdvance            int64    `protobuf:"varint,14,opt,name=timestamp_advance,json=timestampAdvance,proto3" json:"timestamp_advance,omitempty"`
 	EstimatedLimitsPerBlockKb   uint64   `protobuf:"varint,15,opt,name=estimated_limits_per_block_kb,json=estimatedLimitsPerBlockKb,proto3" json:"estimated_limits_per_block_kb,omitempty"`
+	DisputeBond                 uint64   `protobuf:"varint,16,opt,name=dispute_bond,json=disputeBond,proto3" json:"dispute_bond,omitempty"`
+	DisputeWindowBlocks         int64    `protobuf:"varint,17,opt,name=dispute_window_blocks,json=disputeWindowBlocks,proto3" json:"dispute_window_blocks,omitempty"`
+	DisputeValidators           uint32   `protobuf:"varint,18,opt,name=dispute_validators,json=disputeValidators,proto3" json:"dispute_validators,omitempty"`
+	DisputeTimeoutBlocks        int64    `protobuf:"varint,19,opt,name=dispute_timeout_blocks,json=disputeTimeoutBlocks,proto3" json:"dispute_timeout_blocks,omitempty"`
 }
 
 func (m *ValidationParams) Reset()         { *m = ValidationParams{} }
@@ -686,6 +706,34 @@ func (m *ValidationParams) GetEstimatedLimitsPerBlockKb() uint64 {
 	return 0
 }
 
+func (m *ValidationParams) GetDisputeBond() uint64 {
+	if m != nil {
+		return m.DisputeBond
+	}
+	return 0
+}
+
+func (m *ValidationParams) GetDisputeWindowBlocks() int64 {
+	if m != nil {
+		return m.DisputeWindowBlocks
+	}
+	return 0
+}
+
+func (m *ValidationParams) GetDisputeValidators() uint32 {
+	if m != nil {
+		return m.DisputeValidators
+	}
+	return 0
+}
+
+func (m *ValidationParams) GetDisputeTimeoutBlocks() int64 {
+	if m != nil {
+		return m.DisputeTimeoutBlocks
+	}
+	return 0
+}
+
 type PocParams struct {
 	DefaultDifficulty            int32  `protobuf:"varint,1,opt,name=default_difficulty,json=defaultDifficulty,proto3" json:"default_difficulty,omitempty"`
 	ValidationSampleSize         int32  `protobuf:"varint,2,opt,name=validation_sample_size,json=validationSampleSize,proto3" json:"validation_sample_size,omitempty"`
@@ -811,6 +859,11 @@ type CollateralParams struct {
 	BaseWeightRatio *Decimal `protobuf:"bytes,5,opt,name=base_weight_ratio,json=baseWeightRatio,proto3" json:"base_weight_ratio,omitempty"`
 	// CollateralPerWeightUnit is the amount of collateral required per unit of weight
 	CollateralPerWeightUnit *Decimal `protobuf:"bytes,6,opt,name=collateral_per_weight_unit,json=collateralPerWeightUnit,proto3" json:"collateral_per_weight_unit,omitempty"`
+	// slash_fraction_equivocation is the percentage of collateral to slash when an executor is proven
+	// to have signed two different responses for the same inference.
+	SlashFractionEquivocation *Decimal `protobuf:"bytes,7,opt,name=slash_fraction_equivocation,json=slashFractionEquivocation,proto3" json:"slash_fraction_equivocation,omitempty"`
+	// whistleblower_reward_fraction is the share of an equivocation slash paid to whoever submitted the evidence.
+	WhistleblowerRewardFraction *Decimal `protobuf:"bytes,8,opt,name=whistleblower_reward_fraction,json=whistleblowerRewardFraction,proto3" json:"whistleblower_reward_fraction,omitempty"`
 }
 
 func (m *CollateralParams) Reset()         { *m = CollateralParams{} }
@@ -888,6 +941,20 @@ func (m *CollateralParams) GetCollateralPerWeightUnit() *Decimal {
 	return nil
 }
 
+func (m *CollateralParams) GetSlashFractionEquivocation() *Decimal {
+	if m != nil {
+		return m.SlashFractionEquivocation
+	}
+	return nil
+}
+
+func (m *CollateralParams) GetWhistleblowerRewardFraction() *Decimal {
+	if m != nil {
+		return m.WhistleblowerRewardFraction
+	}
+	return nil
+}
+
 // BitcoinRewardParams defines the parameters for Bitcoin-style reward system.
 type BitcoinRewardParams struct {
 	// use_bitcoin_rewards enables/disables the Bitcoin-style reward system (default: true for production, false for safe deployment)
@@ -1006,6 +1073,10 @@ type DynamicPricingParams struct {
 	GracePeriodEndEpoch uint64 `protobuf:"varint,7,opt,name=grace_period_end_epoch,json=gracePeriodEndEpoch,proto3" json:"grace_period_end_epoch,omitempty"`
 	// grace_period_per_token_price is the per-token price during grace period (default 0 for free)
 	GracePeriodPerTokenPrice uint64 `protobuf:"varint,8,opt,name=grace_period_per_token_price,json=gracePeriodPerTokenPrice,proto3" json:"grace_period_per_token_price,omitempty"`
+	// max_quote_duration_blocks is the longest a price quote can lock a price for, 0 disables price quotes
+	MaxQuoteDurationBlocks int64 `protobuf:"varint,9,opt,name=max_quote_duration_blocks,json=maxQuoteDurationBlocks,proto3" json:"max_quote_duration_blocks,omitempty"`
+	// max_quote_token_budget is the most tokens a single price quote can cover, 0 for unlimited
+	MaxQuoteTokenBudget uint64 `protobuf:"varint,10,opt,name=max_quote_token_budget,json=maxQuoteTokenBudget,proto3" json:"max_quote_token_budget,omitempty"`
 }
 
 func (m *DynamicPricingParams) Reset()         { *m = DynamicPricingParams{} }
@@ -1097,6 +1168,20 @@ func (m *DynamicPricingParams) GetGracePeriodPerTokenPrice() uint64 {
 	return 0
 }
 
+func (m *DynamicPricingParams) GetMaxQuoteDurationBlocks() int64 {
+	if m != nil {
+		return m.MaxQuoteDurationBlocks
+	}
+	return 0
+}
+
+func (m *DynamicPricingParams) GetMaxQuoteTokenBudget() uint64 {
+	if m != nil {
+		return m.MaxQuoteTokenBudget
+	}
+	return 0
+}
+
 // BandwidthLimitsParams defines the parameters for request bandwidth limitations.
 type BandwidthLimitsParams struct {
 	// estimated_limits_per_block_kb is the maximum KB per block that can be consumed
@@ -1189,6 +1274,107 @@ func (m *BandwidthLimitsParams) GetInvalidationsLimitCurve() uint64 {
 	return 0
 }
 
+// DeveloperCreditParams defines the parameters for prepaid developer credit.
+type DeveloperCreditParams struct {
+	// withdrawal_delay_blocks is how long withdrawn credit is held before it is paid out
+	WithdrawalDelayBlocks int64 `protobuf:"varint,1,opt,name=withdrawal_delay_blocks,json=withdrawalDelayBlocks,proto3" json:"withdrawal_delay_blocks,omitempty"`
+}
+
+func (m *DeveloperCreditParams) Reset()         { *m = DeveloperCreditParams{} }
+func (m *DeveloperCreditParams) String() string { return proto.CompactTextString(m) }
+func (*DeveloperCreditParams) ProtoMessage()    {}
+func (*DeveloperCreditParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cf34332021bbe94, []int{11}
+}
+func (m *DeveloperCreditParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeveloperCreditParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeveloperCreditParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeveloperCreditParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeveloperCreditParams.Merge(m, src)
+}
+func (m *DeveloperCreditParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeveloperCreditParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeveloperCreditParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeveloperCreditParams proto.InternalMessageInfo
+
+func (m *DeveloperCreditParams) GetWithdrawalDelayBlocks() int64 {
+	if m != nil {
+		return m.WithdrawalDelayBlocks
+	}
+	return 0
+}
+
+// ParticipantProfileParams defines the parameters for participant profile updates.
+type ParticipantProfileParams struct {
+	// max_updates_per_epoch is how many times a participant can update its profile in an epoch
+	MaxUpdatesPerEpoch uint32 `protobuf:"varint,1,opt,name=max_updates_per_epoch,json=maxUpdatesPerEpoch,proto3" json:"max_updates_per_epoch,omitempty"`
+	// url_change_delay_blocks is how long after an update a new inference URL takes effect
+	UrlChangeDelayBlocks int64 `protobuf:"varint,2,opt,name=url_change_delay_blocks,json=urlChangeDelayBlocks,proto3" json:"url_change_delay_blocks,omitempty"`
+}
+
+func (m *ParticipantProfileParams) Reset()         { *m = ParticipantProfileParams{} }
+func (m *ParticipantProfileParams) String() string { return proto.CompactTextString(m) }
+func (*ParticipantProfileParams) ProtoMessage()    {}
+func (*ParticipantProfileParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cf34332021bbe94, []int{12}
+}
+func (m *ParticipantProfileParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantProfileParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantProfileParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantProfileParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantProfileParams.Merge(m, src)
+}
+func (m *ParticipantProfileParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantProfileParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantProfileParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantProfileParams proto.InternalMessageInfo
+
+func (m *ParticipantProfileParams) GetMaxUpdatesPerEpoch() uint32 {
+	if m != nil {
+		return m.MaxUpdatesPerEpoch
+	}
+	return 0
+}
+
+func (m *ParticipantProfileParams) GetUrlChangeDelayBlocks() int64 {
+	if m != nil {
+		return m.UrlChangeDelayBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "inference.inference.Params")
 	proto.RegisterType((*GenesisOnlyParams)(nil), "inference.inference.GenesisOnlyParams")
@@ -1201,157 +1387,179 @@ func init() {
 	proto.RegisterType((*BitcoinRewardParams)(nil), "inference.inference.BitcoinRewardParams")
 	proto.RegisterType((*DynamicPricingParams)(nil), "inference.inference.DynamicPricingParams")
 	proto.RegisterType((*BandwidthLimitsParams)(nil), "inference.inference.BandwidthLimitsParams")
+	proto.RegisterType((*DeveloperCreditParams)(nil), "inference.inference.DeveloperCreditParams")
+	proto.RegisterType((*ParticipantProfileParams)(nil), "inference.inference.ParticipantProfileParams")
 }
 
 func init() { proto.RegisterFile("inference/inference/params.proto", fileDescriptor_3cf34332021bbe94) }
 
 var fileDescriptor_3cf34332021bbe94 = []byte{
-	// 2309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x59, 0x4d, 0x73, 0x13, 0xc9,
-	0xf9, 0xc7, 0xd8, 0xc6, 0xb8, 0x0d, 0x7f, 0xcb, 0x2d, 0xcb, 0x96, 0x8d, 0x31, 0x86, 0x7f, 0x36,
-	0x61, 0x21, 0x6b, 0x27, 0x6c, 0x36, 0x9b, 0x62, 0xb3, 0x54, 0x8c, 0x6d, 0x58, 0xd7, 0xe2, 0x45,
-	0x19, 0x83, 0xb7, 0x42, 0x51, 0x35, 0xd5, 0x9a, 0x69, 0x49, 0x5d, 0x9e, 0xe9, 0x9e, 0xed, 0xee,
-	0xb1, 0x24, 0x3e, 0x42, 0x72, 0xc9, 0x47, 0xc8, 0x21, 0x1f, 0x80, 0x4f, 0x90, 0x73, 0x8e, 0x5b,
-	0x39, 0xe5, 0x96, 0x14, 0x1c, 0x92, 0x7b, 0x4e, 0xb9, 0xa5, 0xfa, 0xe9, 0x9e, 0x17, 0x8d, 0x05,
-	0x68, 0x2f, 0x94, 0xfc, 0x3c, 0xcf, 0xef, 0xd7, 0x2f, 0xcf, 0x6b, 0x0f, 0x68, 0x8b, 0xf1, 0x0e,
-	0x95, 0x94, 0x07, 0x74, 0xa7, 0xf8, 0x95, 0x10, 0x49, 0x62, 0xb5, 0x9d, 0x48, 0xa1, 0x05, 0xae,
-	0xe7, 0xf2, 0xed, 0xfc, 0xd7, 0xfa, 0x12, 0x89, 0x19, 0x17, 0x3b, 0xf0, 0xaf, 0xb5, 0x5b, 0x5f,
-	0xee, 0x8a, 0xae, 0x80, 0x9f, 0x3b, 0xe6, 0x97, 0x93, 0xae, 0x05, 0x42, 0xc5, 0x42, 0xf9, 0x56,
-	0x61, 0xff, 0xb0, 0xaa, 0x5b, 0x7f, 0x9b, 0x45, 0x97, 0x5a, 0xb0, 0x12, 0xde, 0x43, 0x57, 0x68,
-	0x22, 0x82, 0x9e, 0x6f, 0x57, 0x6e, 0x4e, 0x6d, 0x4d, 0xdd, 0x5e, 0xb8, 0xb7, 0xb5, 0x3d, 0x66,
-	0xe9, 0xed, 0x03, 0x63, 0x68, 0x71, 0xde, 0x02, 0x2d, 0xfe, 0xc0, 0x1e, 0x5a, 0x3a, 0x23, 0x11,
-	0x0b, 0x89, 0x66, 0x82, 0x67, 0x4c, 0x17, 0x81, 0xe9, 0xa3, 0xb1, 0x4c, 0x27, 0xb9, 0xb5, 0xa3,
-	0xab, 0x9d, 0x55, 0x24, 0xf8, 0x4b, 0x84, 0x12, 0x11, 0x64, 0x64, 0xd3, 0x40, 0xb6, 0x39, 0x96,
-	0xac, 0x25, 0x02, 0xc7, 0x32, 0x9f, 0x64, 0x3f, 0xcd, 0x96, 0xb4, 0x38, 0xa5, 0x5c, 0xc4, 0x2c,
-	0x50, 0x19, 0xcb, 0xcc, 0x7b, 0xb6, 0xf4, 0x2c, 0xb7, 0xce, 0xb6, 0xa4, 0x2b, 0x12, 0xc3, 0x19,
-	0x88, 0x28, 0x22, 0x9a, 0x4a, 0x12, 0x65, 0x9c, 0xb3, 0xef, 0xe1, 0xdc, 0xcb, 0xad, 0x33, 0xce,
-	0xa0, 0x22, 0xc1, 0x2f, 0x51, 0xa3, 0xcd, 0x74, 0x20, 0x18, 0xf7, 0x25, 0xed, 0x13, 0x19, 0x66,
-	0xbc, 0x97, 0x80, 0xf7, 0xf6, 0x58, 0xde, 0x87, 0x16, 0xe1, 0x01, 0xc0, 0x51, 0xd7, 0xdb, 0xe7,
-	0x85, 0xd8, 0x47, 0x2b, 0xe1, 0x90, 0x93, 0x98, 0x05, 0x7e, 0x22, 0x59, 0xc0, 0x78, 0x37, 0xa3,
-	0x9f, 0x03, 0xfa, 0x8f, 0xc7, 0xd2, 0xef, 0x5b, 0x48, 0xcb, 0x22, 0x1c, 0xff, 0x72, 0x38, 0x46,
-	0x8a, 0xdb, 0x68, 0xb5, 0x4d, 0x78, 0xd8, 0x67, 0xa1, 0xee, 0xf9, 0x11, 0x8b, 0x99, 0xce, 0x2f,
-	0xfb, 0x32, 0xac, 0x70, 0x67, 0xfc, 0x01, 0x32, 0xcc, 0x13, 0x80, 0xb8, 0x25, 0x1a, 0xed, 0x71,
-	0xe2, 0xfb, 0x1f, 0xfd, 0xfb, 0x4f, 0x37, 0xa6, 0x7e, 0xff, 0xaf, 0xd7, 0x77, 0x36, 0x8a, 0x3c,
-	0x19, 0x94, 0x72, 0xc6, 0x9a, 0xdd, 0x7a, 0x3d, 0x87, 0x96, 0x1e, 0x53, 0x4e, 0x15, 0x53, 0x4f,
-	0x79, 0x34, 0x74, 0x1b, 0xbc, 0x89, 0xae, 0x68, 0xa1, 0x49, 0xe4, 0xab, 0x34, 0x49, 0xa2, 0x21,
-	0xc4, 0xf7, 0xb4, 0xb7, 0x00, 0xb2, 0x63, 0x10, 0xe1, 0xbb, 0x68, 0x49, 0x48, 0xd6, 0x65, 0x9c,
-	0x68, 0x21, 0x33, 0xbb, 0x8b, 0x60, 0x57, 0x2b, 0x14, 0xce, 0xf8, 0x8e, 0x89, 0xab, 0x24, 0xf3,
-	0x15, 0x89, 0x45, 0xca, 0x35, 0x44, 0xe7, 0xb4, 0xb7, 0xa8, 0x45, 0x62, 0x6f, 0x7f, 0x17, 0xc4,
-	0xf8, 0x17, 0x68, 0x45, 0x69, 0xc2, 0x43, 0x63, 0x39, 0x0a, 0x98, 0x01, 0xc0, 0x72, 0xa6, 0x1d,
-	0x41, 0x7d, 0x81, 0xd6, 0x13, 0x49, 0x4d, 0xda, 0x76, 0x25, 0x89, 0x63, 0x1a, 0xfa, 0x8a, 0x44,
-	0x34, 0x43, 0xce, 0x02, 0x72, 0x35, 0x91, 0xb4, 0x95, 0x1b, 0x1c, 0x93, 0x88, 0x3a, 0xf0, 0x0d,
-	0xb4, 0x50, 0x6c, 0xcf, 0x06, 0xd1, 0xac, 0x87, 0xf2, 0x8d, 0xc1, 0x7d, 0xd8, 0x13, 0xfa, 0xa1,
-	0x09, 0x6e, 0x88, 0x83, 0x79, 0x6f, 0xc1, 0xca, 0xf6, 0x8d, 0xa8, 0x72, 0xc4, 0x84, 0x4a, 0x26,
-	0x42, 0xf0, 0x66, 0xf9, 0x88, 0x2d, 0x10, 0xe3, 0x9f, 0x22, 0x5c, 0xb6, 0x25, 0x43, 0x91, 0x6a,
-	0xd5, 0x9c, 0xb7, 0x97, 0x57, 0x18, 0x5b, 0x39, 0x7e, 0x80, 0x36, 0xce, 0x5b, 0x9b, 0x15, 0xfc,
-	0x98, 0x71, 0x2a, 0x9b, 0x08, 0x70, 0xcd, 0x2a, 0xae, 0x45, 0xe5, 0x91, 0xd1, 0xe3, 0xcf, 0xd0,
-	0x6a, 0x09, 0x1f, 0x93, 0x81, 0x1f, 0xa6, 0x12, 0x8a, 0x46, 0x73, 0xc1, 0xde, 0x68, 0x0e, 0x3d,
-	0x22, 0x83, 0x7d, 0xa7, 0xc3, 0x01, 0xba, 0x61, 0x6c, 0x19, 0x0f, 0xd9, 0x19, 0x0b, 0x53, 0x93,
-	0xbb, 0xa2, 0x4f, 0xa5, 0x59, 0x38, 0xa0, 0x5c, 0x93, 0x2e, 0x6d, 0x5e, 0x81, 0x60, 0xdd, 0x18,
-	0x9f, 0x0e, 0x34, 0x60, 0x31, 0x89, 0xbc, 0x8d, 0x98, 0x0c, 0x0e, 0x73, 0x8e, 0x96, 0xa1, 0x68,
-	0xe5, 0x0c, 0xf8, 0x57, 0xa8, 0xd9, 0xb5, 0xd1, 0xe7, 0x77, 0x53, 0x22, 0x43, 0x46, 0xb8, 0x4f,
-	0x39, 0x69, 0x47, 0x34, 0x6c, 0x5e, 0xdd, 0x9a, 0xba, 0x7d, 0xd9, 0x5b, 0x71, 0xfa, 0xc7, 0x4e,
-	0x7d, 0x60, 0xb5, 0xf8, 0x25, 0xba, 0x7b, 0x0e, 0xc9, 0xa9, 0xee, 0x0b, 0x79, 0xea, 0xc7, 0x44,
-	0xa7, 0x92, 0xe9, 0xa1, 0xaf, 0x7b, 0x92, 0xaa, 0x9e, 0x88, 0xc2, 0xe6, 0xff, 0xc1, 0x49, 0x7f,
-	0x52, 0x21, 0xfb, 0xc6, 0x02, 0x8e, 0x9c, 0xfd, 0xb3, 0xcc, 0x1c, 0xbf, 0x44, 0xd7, 0xce, 0xb1,
-	0xc7, 0x69, 0xa4, 0x59, 0x12, 0x31, 0x2a, 0x9b, 0x8b, 0x13, 0x1c, 0x7c, 0xad, 0xb2, 0xd6, 0x51,
-	0x0e, 0xc7, 0xbf, 0x46, 0xeb, 0xe7, 0xd8, 0x49, 0x18, 0x4a, 0xaa, 0x14, 0x55, 0xcd, 0xda, 0xd6,
-	0xf4, 0xed, 0x79, 0xaf, 0x59, 0x81, 0xef, 0x66, 0xfa, 0x5b, 0xff, 0x98, 0x41, 0xb5, 0x6a, 0xdd,
-	0xc5, 0x2f, 0xd0, 0xba, 0x4a, 0xdb, 0x8a, 0x85, 0x43, 0x5f, 0xd2, 0x30, 0x0d, 0xa0, 0xa7, 0x30,
-	0xae, 0xa9, 0x3c, 0x23, 0x91, 0xeb, 0x4f, 0xef, 0xdf, 0x6f, 0xd3, 0xe1, 0xbd, 0x0c, 0x7e, 0xe8,
-	0xd0, 0xf8, 0x04, 0x35, 0xcf, 0x73, 0xbb, 0xcc, 0xba, 0x38, 0x01, 0xf3, 0x4a, 0x95, 0xd9, 0xa5,
-	0xdd, 0x0b, 0xb4, 0x1e, 0xa4, 0x52, 0x52, 0xae, 0xfd, 0x8c, 0xbf, 0x14, 0x5c, 0xd3, 0x93, 0xec,
-	0xd9, 0xe1, 0x8f, 0x2d, 0xbc, 0x14, 0x58, 0xbf, 0x43, 0xeb, 0xe5, 0x8a, 0x13, 0x45, 0xa2, 0x4f,
-	0x43, 0xbf, 0x43, 0x58, 0x94, 0x4a, 0xea, 0x5a, 0xda, 0xfb, 0xb9, 0x57, 0x8b, 0xc2, 0x64, 0xd1,
-	0x8f, 0x2c, 0x18, 0x7f, 0x89, 0xae, 0x19, 0x6a, 0x48, 0x3e, 0xdf, 0x74, 0xdb, 0xef, 0x52, 0x12,
-	0xb1, 0x0e, 0x0b, 0x6c, 0x4e, 0xcd, 0xe6, 0xe9, 0x08, 0xe9, 0xd7, 0x12, 0xc1, 0x6f, 0xcb, 0x7a,
-	0xbc, 0x8d, 0xea, 0x10, 0xa4, 0x67, 0x54, 0x69, 0x68, 0x2d, 0xb6, 0x54, 0x98, 0xa2, 0x33, 0xe3,
-	0x2d, 0x19, 0xd5, 0x89, 0xd5, 0xb8, 0x62, 0x71, 0x0f, 0x35, 0xdc, 0x29, 0x2a, 0x88, 0x39, 0x40,
-	0xd4, 0xad, 0x72, 0x14, 0xf3, 0x39, 0x6a, 0x16, 0x5b, 0xac, 0xc0, 0x2e, 0x03, 0xac, 0x91, 0xed,
-	0x6f, 0x04, 0x78, 0x7f, 0xc6, 0x74, 0x8d, 0x5b, 0xaf, 0x67, 0xd1, 0x42, 0x69, 0x6c, 0x31, 0xe5,
-	0xcf, 0x8e, 0x3b, 0x11, 0xe5, 0x5d, 0xdd, 0xcb, 0xda, 0x01, 0xc8, 0x9e, 0x80, 0x08, 0x7f, 0x8c,
-	0x6a, 0xd6, 0xa4, 0x94, 0x25, 0xb6, 0x1b, 0x2c, 0x82, 0xbc, 0x14, 0xfd, 0x37, 0x90, 0x45, 0xfa,
-	0xaa, 0xc7, 0x3a, 0x59, 0x1b, 0x40, 0x20, 0x3a, 0x36, 0x12, 0xfc, 0x1b, 0x74, 0x3d, 0xa4, 0x1d,
-	0x92, 0x46, 0xda, 0x4f, 0x39, 0xd3, 0xbe, 0xe8, 0xf8, 0x81, 0x88, 0x93, 0x54, 0x53, 0xe8, 0xc7,
-	0xd4, 0x35, 0x82, 0x35, 0x67, 0xf4, 0x9c, 0x33, 0xfd, 0xb4, 0xb3, 0x67, 0x2d, 0x4c, 0xa3, 0xa5,
-	0xa6, 0xc0, 0x1a, 0xc7, 0x28, 0x13, 0x0a, 0x45, 0xb5, 0xb3, 0x9e, 0xa9, 0x25, 0x22, 0x38, 0x36,
-	0x8a, 0xbc, 0xd2, 0xdd, 0x43, 0x0d, 0x63, 0x4d, 0x07, 0x41, 0x8f, 0xf0, 0x32, 0xe0, 0x12, 0x00,
-	0xea, 0x89, 0x08, 0x0e, 0x9c, 0x2e, 0xc7, 0xfc, 0x0c, 0x2d, 0x1b, 0x4c, 0x69, 0x80, 0x0b, 0x69,
-	0x44, 0x86, 0xe0, 0x94, 0x69, 0xcf, 0xac, 0x5e, 0x4c, 0x6b, 0xfb, 0x46, 0x83, 0x7f, 0x89, 0x56,
-	0xab, 0x88, 0x6c, 0x1d, 0xdb, 0x26, 0x1a, 0xa3, 0xa0, 0x6c, 0xa5, 0xcf, 0x51, 0x53, 0x51, 0xed,
-	0x73, 0xda, 0xcf, 0xb0, 0x42, 0x2a, 0xb7, 0x9a, 0x6d, 0x19, 0x0d, 0x45, 0xf5, 0x37, 0xb4, 0x7f,
-	0x92, 0x6b, 0xed, 0x82, 0x0f, 0xd0, 0xb5, 0x3c, 0xaa, 0xcb, 0xcb, 0x06, 0xa9, 0x16, 0x9d, 0x8e,
-	0x6b, 0x1b, 0x6b, 0xb9, 0x49, 0xb1, 0xf4, 0x1e, 0x18, 0xe0, 0x43, 0x74, 0xb3, 0xc0, 0x27, 0x32,
-	0xe5, 0x26, 0x88, 0xac, 0xe7, 0x8a, 0xba, 0xba, 0x00, 0xd1, 0xb4, 0x99, 0x1b, 0xb6, 0xac, 0x1d,
-	0x44, 0x4f, 0x51, 0x4e, 0xef, 0xa1, 0xc6, 0x79, 0xaa, 0x98, 0x0c, 0xa0, 0x83, 0x4c, 0x7b, 0xf5,
-	0x2a, 0xfc, 0x88, 0x0c, 0xf0, 0x8f, 0xd1, 0x22, 0x8c, 0xb2, 0x25, 0xeb, 0xab, 0x60, 0x7d, 0xd5,
-	0xcc, 0xab, 0xb9, 0x9d, 0x0b, 0xd9, 0xff, 0xce, 0xa1, 0x5a, 0x75, 0x3e, 0xc6, 0x4f, 0x50, 0xbd,
-	0x43, 0x22, 0x45, 0xfd, 0x44, 0x28, 0xa6, 0xd9, 0x19, 0xf5, 0x25, 0xd1, 0x74, 0xa2, 0x6a, 0xb8,
-	0x04, 0xc0, 0x96, 0xc3, 0x79, 0x44, 0x53, 0xe3, 0x88, 0xd8, 0x0c, 0x9c, 0x24, 0x4e, 0xfc, 0x34,
-	0xf1, 0x63, 0x4a, 0x54, 0x2a, 0x69, 0x4c, 0xb9, 0xb6, 0x63, 0xfb, 0xac, 0xd7, 0x88, 0x19, 0xf7,
-	0x48, 0x9c, 0x3c, 0x4f, 0x8e, 0x4a, 0x4a, 0xfc, 0x05, 0x42, 0x09, 0x51, 0xca, 0xf8, 0x20, 0x9d,
-	0xac, 0xae, 0xcd, 0x1b, 0xfb, 0x13, 0x63, 0x8e, 0x3d, 0xb4, 0x62, 0x56, 0x2d, 0xf9, 0x8f, 0x9c,
-	0x51, 0x69, 0x0a, 0xe4, 0x24, 0x45, 0x6c, 0x39, 0x66, 0xbc, 0xb8, 0x96, 0x5d, 0x8b, 0x04, 0x4e,
-	0x32, 0x18, 0xc7, 0x39, 0x3b, 0x11, 0x27, 0x19, 0x9c, 0xe7, 0xbc, 0x8b, 0x96, 0xe8, 0x20, 0x61,
-	0x36, 0x68, 0xfd, 0x76, 0x24, 0x82, 0x53, 0xe5, 0x12, 0xa8, 0x56, 0x28, 0x1e, 0x82, 0x1c, 0xdf,
-	0x42, 0x57, 0x21, 0x90, 0x94, 0xaf, 0x05, 0x78, 0x76, 0xae, 0x54, 0x51, 0xd4, 0x33, 0x61, 0xfc,
-	0xbf, 0x87, 0x36, 0x3b, 0x69, 0x14, 0x95, 0x77, 0xa9, 0x25, 0xe9, 0x74, 0x58, 0x90, 0x45, 0xb0,
-	0x4d, 0x9b, 0x6b, 0xc6, 0xaa, 0xd8, 0xcf, 0x33, 0x6b, 0xe3, 0x62, 0xf8, 0xfc, 0xed, 0xf5, 0x48,
-	0xd4, 0xe9, 0xbb, 0xd4, 0xf9, 0x61, 0xb7, 0xf7, 0x95, 0x45, 0xe2, 0x5d, 0x74, 0xbd, 0xc2, 0x59,
-	0xd9, 0x97, 0xcd, 0xac, 0xf5, 0x11, 0xf0, 0x98, 0x6d, 0x29, 0x55, 0x6a, 0x77, 0x19, 0x76, 0x61,
-	0xb2, 0x6d, 0x29, 0x55, 0xf4, 0x3a, 0xc7, 0xd9, 0x42, 0x0d, 0xe0, 0x94, 0xf4, 0xbb, 0x94, 0x2a,
-	0x98, 0x10, 0x39, 0x89, 0xf4, 0x70, 0xa2, 0x29, 0xad, 0x6e, 0xa0, 0x9e, 0x43, 0xb6, 0x2c, 0x10,
-	0xff, 0x1c, 0x2d, 0x6b, 0x16, 0x53, 0xa5, 0x4d, 0xc4, 0x17, 0x3e, 0x74, 0x69, 0x58, 0xcf, 0x75,
-	0x07, 0xb9, 0xca, 0x44, 0x41, 0x01, 0x21, 0xe1, 0x19, 0xe1, 0x01, 0x75, 0xb3, 0x57, 0x2d, 0x57,
-	0xec, 0x5a, 0xb9, 0xa9, 0xf3, 0xa6, 0xf9, 0xc4, 0x44, 0xd3, 0x30, 0x7f, 0x06, 0x51, 0x69, 0x83,
-	0xc7, 0x3f, 0x6d, 0xc3, 0x98, 0x35, 0xe3, 0xad, 0xe5, 0x46, 0xee, 0x81, 0x43, 0x25, 0x84, 0xd1,
-	0xd7, 0x6d, 0x97, 0xfb, 0x7f, 0x99, 0x42, 0xf3, 0xf9, 0x73, 0x16, 0x7f, 0x82, 0x70, 0xd6, 0x3d,
-	0x42, 0x66, 0xee, 0x3c, 0x35, 0x97, 0x30, 0x05, 0x09, 0xba, 0xe4, 0x34, 0xfb, 0xb9, 0xc2, 0x3c,
-	0x37, 0x4a, 0x9e, 0x54, 0x24, 0x4e, 0x22, 0xea, 0x2b, 0xf6, 0x8a, 0xba, 0x9c, 0x5e, 0x2e, 0xb4,
-	0xc7, 0xa0, 0x3c, 0x66, 0xaf, 0x28, 0x7e, 0x84, 0xb6, 0x4c, 0x71, 0x0a, 0x89, 0x26, 0xef, 0x2c,
-	0x8d, 0xd3, 0xb0, 0xfb, 0x8d, 0x44, 0x04, 0xfb, 0x44, 0x93, 0xb1, 0x85, 0xd1, 0x1d, 0x60, 0x17,
-	0xcd, 0x39, 0x47, 0xe0, 0x65, 0x34, 0x6b, 0xcb, 0x84, 0xed, 0xb1, 0xf6, 0x0f, 0xbc, 0x8e, 0x2e,
-	0xd3, 0x41, 0x22, 0x38, 0x75, 0x13, 0xd7, 0xac, 0x97, 0xff, 0xed, 0x28, 0xfe, 0x30, 0x83, 0x6a,
-	0xd5, 0x87, 0xb3, 0x09, 0x33, 0x15, 0x11, 0xd5, 0xf3, 0x3b, 0x92, 0x64, 0x13, 0x21, 0x1c, 0x67,
-	0xa2, 0x12, 0xb8, 0x0c, 0xd8, 0x47, 0x0e, 0x7a, 0x68, 0x91, 0xf8, 0x19, 0x5a, 0xad, 0x70, 0x86,
-	0xa2, 0xcf, 0x8d, 0x6f, 0x27, 0x9a, 0x05, 0x1b, 0x23, 0xa4, 0xfb, 0x0e, 0x8a, 0x63, 0xf4, 0xa3,
-	0x8c, 0xc6, 0x37, 0xa1, 0x48, 0xc3, 0x72, 0x6e, 0x8c, 0xde, 0xe9, 0x87, 0x96, 0xb8, 0x99, 0x31,
-	0x1d, 0x01, 0x51, 0x91, 0x28, 0x45, 0x3f, 0xfa, 0x14, 0xad, 0x74, 0x25, 0x31, 0xbd, 0x08, 0xc6,
-	0x1e, 0x9f, 0xf2, 0xd0, 0xba, 0x0f, 0x8a, 0xea, 0x8c, 0x57, 0x07, 0xad, 0x9d, 0x89, 0x0e, 0x78,
-	0x08, 0x4e, 0xc3, 0x5f, 0xa1, 0xa5, 0x36, 0x51, 0xd4, 0xef, 0x53, 0xd6, 0xed, 0x69, 0x1f, 0x22,
-	0x7e, 0xa2, 0x82, 0xb9, 0x68, 0x60, 0xdf, 0x02, 0xca, 0x33, 0x20, 0x33, 0x9c, 0x96, 0x3f, 0x89,
-	0x50, 0x99, 0x71, 0x9a, 0x71, 0xc7, 0x7d, 0xc3, 0xf8, 0xc0, 0x70, 0x5a, 0xfa, 0x24, 0x42, 0xa5,
-	0xe5, 0x36, 0x73, 0x90, 0x8b, 0x86, 0xff, 0x4c, 0xa3, 0xfa, 0x98, 0xcf, 0x1d, 0x66, 0xf6, 0x4c,
-	0x15, 0xf5, 0x47, 0xbf, 0x9d, 0xd8, 0xcf, 0x57, 0x97, 0xbd, 0xa5, 0x54, 0xd1, 0x11, 0x90, 0x32,
-	0x53, 0x0e, 0xe3, 0x4c, 0x33, 0x12, 0xb9, 0xe8, 0xb6, 0x08, 0xf0, 0xf4, 0x8c, 0x87, 0x9d, 0x0e,
-	0xae, 0xc7, 0x42, 0x4c, 0xaf, 0x0b, 0x69, 0x40, 0x86, 0xb6, 0xd3, 0x4e, 0xd4, 0xeb, 0xc0, 0x1e,
-	0x3a, 0xec, 0xff, 0xa3, 0xab, 0xd9, 0xbb, 0xa8, 0xec, 0x8d, 0x2b, 0x4e, 0x68, 0xdd, 0x70, 0x82,
-	0x9a, 0xa9, 0x66, 0x11, 0x7b, 0xe5, 0x3a, 0x8d, 0xe0, 0xa9, 0xf2, 0x3b, 0x24, 0xd0, 0x42, 0x4e,
-	0xe4, 0x8d, 0x95, 0x12, 0xfa, 0xa1, 0x01, 0x3f, 0x02, 0xac, 0x71, 0x0a, 0xf4, 0x9b, 0x40, 0xd8,
-	0x8e, 0x36, 0xca, 0x3c, 0x91, 0x53, 0x0c, 0x7e, 0xcf, 0xc1, 0xcb, 0xd4, 0x3e, 0xba, 0x9e, 0x10,
-	0x09, 0xd7, 0x38, 0x9e, 0x7d, 0x6e, 0x02, 0xf6, 0x75, 0x47, 0x31, 0x66, 0x81, 0x6c, 0x6c, 0x9f,
-	0x41, 0xcb, 0xe3, 0xbe, 0x42, 0x99, 0xa3, 0x29, 0x4d, 0xda, 0x2c, 0x32, 0x6f, 0xe2, 0x57, 0x82,
-	0x53, 0x3f, 0x82, 0xa7, 0x7c, 0x5b, 0xa4, 0x7c, 0xb2, 0x5a, 0xb0, 0x9a, 0xe3, 0x5f, 0x08, 0x4e,
-	0x9f, 0x18, 0xf4, 0x43, 0x03, 0x1e, 0x43, 0x9d, 0x26, 0x49, 0x4e, 0x7d, 0xf1, 0x07, 0x53, 0x3f,
-	0x37, 0x68, 0x4b, 0xfd, 0x18, 0xd5, 0x60, 0xdc, 0xf7, 0x69, 0x44, 0x94, 0x66, 0x01, 0xd3, 0xc3,
-	0x89, 0x02, 0x6a, 0x11, 0x50, 0x07, 0x39, 0xc8, 0x0c, 0xc2, 0xe5, 0x88, 0xe9, 0x33, 0x1e, 0x8a,
-	0x7e, 0x31, 0x7d, 0xdb, 0x20, 0x5b, 0x2b, 0x99, 0x7c, 0x0b, 0x16, 0xf9, 0x04, 0xfe, 0x09, 0xaa,
-	0x9b, 0x86, 0x6f, 0x8e, 0x05, 0x5f, 0x37, 0xdd, 0x2b, 0x64, 0x16, 0x70, 0xb5, 0x98, 0xf1, 0x16,
-	0x95, 0xf0, 0x20, 0xb7, 0x8f, 0x8f, 0x1d, 0xb4, 0x0c, 0x75, 0xa2, 0x6a, 0xef, 0x5e, 0x78, 0x46,
-	0x37, 0x0a, 0x78, 0x77, 0x35, 0x9a, 0x7b, 0x77, 0x35, 0x7a, 0x80, 0x36, 0x46, 0x40, 0xd5, 0xd5,
-	0xec, 0x33, 0xaf, 0x59, 0x82, 0x8e, 0x2c, 0xea, 0x42, 0xe6, 0xcf, 0xd3, 0xa8, 0x31, 0xf6, 0xb3,
-	0xe2, 0x87, 0x9b, 0xf3, 0xd4, 0x07, 0x9a, 0x33, 0x3e, 0x44, 0xf8, 0xb4, 0x0d, 0x18, 0xc6, 0x93,
-	0x54, 0xdb, 0xdd, 0x4d, 0x14, 0x12, 0x8b, 0xa7, 0xed, 0x16, 0x95, 0x87, 0x06, 0x05, 0x3b, 0xc6,
-	0x5f, 0xa3, 0xba, 0xa3, 0x12, 0xa9, 0x2e, 0xb8, 0x26, 0x89, 0x86, 0x1a, 0x70, 0x3d, 0x05, 0x98,
-	0x25, 0xdb, 0x41, 0x75, 0xd7, 0x06, 0xc1, 0xbd, 0xca, 0x9e, 0xce, 0x85, 0x01, 0x1e, 0x51, 0xc1,
-	0x99, 0xec, 0x43, 0xaa, 0x0c, 0x70, 0x53, 0x82, 0x7b, 0x50, 0xdb, 0x38, 0x58, 0x1b, 0x31, 0xb1,
-	0xa3, 0x82, 0x7b, 0x8d, 0xdf, 0x47, 0x6b, 0x63, 0x16, 0xf4, 0x83, 0x54, 0x9e, 0x65, 0x51, 0xb1,
-	0x7a, 0x7e, 0xd9, 0x3d, 0xa3, 0xb6, 0x6e, 0x7a, 0xf8, 0xf4, 0xaf, 0x6f, 0x36, 0xa7, 0xbe, 0x7f,
-	0xb3, 0x39, 0xf5, 0xcf, 0x37, 0x9b, 0x53, 0x7f, 0x7c, 0xbb, 0x79, 0xe1, 0xfb, 0xb7, 0x9b, 0x17,
-	0xfe, 0xfe, 0x76, 0xf3, 0xc2, 0x8b, 0xcf, 0xba, 0x4c, 0xf7, 0xd2, 0xf6, 0x76, 0x20, 0xe2, 0x9d,
-	0x44, 0x8a, 0x30, 0x0d, 0xb4, 0x0a, 0x58, 0xe5, 0xff, 0x47, 0xca, 0xdf, 0x7d, 0xf5, 0x30, 0xa1,
-	0xaa, 0x7d, 0x09, 0xfe, 0x4b, 0xe3, 0xd3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x42, 0x13, 0x25,
-	0x91, 0x4f, 0x19, 0x00, 0x00,
+	// 2632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x59, 0x4f, 0x73, 0x1b, 0xb7,
+	0x15, 0x0f, 0x2d, 0xc9, 0xb2, 0x20, 0xbb, 0xa2, 0x40, 0x51, 0xa2, 0x64, 0x5b, 0x56, 0xdc, 0xa6,
+	0x75, 0x92, 0xc6, 0x6e, 0x9c, 0x26, 0x69, 0x93, 0x26, 0x53, 0xeb, 0x8f, 0x13, 0x4f, 0xac, 0x98,
+	0x59, 0xd9, 0xce, 0xd4, 0x93, 0x99, 0x2d, 0xb8, 0x0b, 0x92, 0x18, 0xed, 0x02, 0x6b, 0x00, 0x2b,
+	0x4a, 0xfe, 0x08, 0x39, 0xf5, 0x23, 0x64, 0xa6, 0xfd, 0x00, 0xb9, 0xf4, 0xda, 0x73, 0x67, 0x7a,
+	0xc9, 0xb1, 0xb7, 0x76, 0x92, 0x43, 0x7b, 0xef, 0x17, 0xe8, 0xe0, 0x01, 0xd8, 0x5d, 0xae, 0x28,
+	0x99, 0xb9, 0x68, 0x28, 0xbc, 0xf7, 0xfb, 0x3d, 0xfc, 0x79, 0xff, 0x80, 0x45, 0x5b, 0x8c, 0xf7,
+	0xa9, 0xa4, 0x3c, 0xa2, 0x77, 0xca, 0x5f, 0x19, 0x91, 0x24, 0x55, 0xb7, 0x33, 0x29, 0xb4, 0xc0,
+	0xad, 0x62, 0xfc, 0x76, 0xf1, 0x6b, 0x63, 0x99, 0xa4, 0x8c, 0x8b, 0x3b, 0xf0, 0xd7, 0xea, 0x6d,
+	0xac, 0x0c, 0xc4, 0x40, 0xc0, 0xcf, 0x3b, 0xe6, 0x97, 0x1b, 0x5d, 0x8f, 0x84, 0x4a, 0x85, 0x0a,
+	0xad, 0xc0, 0xfe, 0x63, 0x45, 0x37, 0xff, 0x3c, 0x8f, 0x2e, 0x76, 0xc1, 0x12, 0xde, 0x41, 0x97,
+	0x69, 0x26, 0xa2, 0x61, 0x68, 0x2d, 0x77, 0x1a, 0x5b, 0x8d, 0x5b, 0x8b, 0x77, 0xb7, 0x6e, 0x4f,
+	0x30, 0x7d, 0x7b, 0xcf, 0x28, 0x5a, 0x5c, 0xb0, 0x48, 0xcb, 0x7f, 0x70, 0x80, 0x96, 0x8f, 0x48,
+	0xc2, 0x62, 0xa2, 0x99, 0xe0, 0x9e, 0xe9, 0x02, 0x30, 0xbd, 0x36, 0x91, 0xe9, 0x69, 0xa1, 0xed,
+	0xe8, 0x9a, 0x47, 0xb5, 0x11, 0xfc, 0x11, 0x42, 0x99, 0x88, 0x3c, 0xd9, 0x0c, 0x90, 0x6d, 0x4e,
+	0x24, 0xeb, 0x8a, 0xc8, 0xb1, 0x2c, 0x64, 0xfe, 0xa7, 0x99, 0x92, 0x16, 0x87, 0x94, 0x8b, 0x94,
+	0x45, 0xca, 0xb3, 0xcc, 0x9e, 0x33, 0xa5, 0xc7, 0x85, 0xb6, 0x9f, 0x92, 0xae, 0x8d, 0x18, 0xce,
+	0x48, 0x24, 0x09, 0xd1, 0x54, 0x92, 0xc4, 0x73, 0xce, 0x9d, 0xc3, 0xb9, 0x53, 0x68, 0x7b, 0xce,
+	0xa8, 0x36, 0x82, 0xbf, 0x42, 0xed, 0x1e, 0xd3, 0x91, 0x60, 0x3c, 0x94, 0x74, 0x44, 0x64, 0xec,
+	0x79, 0x2f, 0x02, 0xef, 0xad, 0x89, 0xbc, 0xdb, 0x16, 0x11, 0x00, 0xc0, 0x51, 0xb7, 0x7a, 0xa7,
+	0x07, 0x71, 0x88, 0x56, 0xe3, 0x13, 0x4e, 0x52, 0x16, 0x85, 0x99, 0x64, 0x11, 0xe3, 0x03, 0x4f,
+	0x3f, 0x0f, 0xf4, 0xaf, 0x4f, 0xa4, 0xdf, 0xb5, 0x90, 0xae, 0x45, 0x38, 0xfe, 0x95, 0x78, 0xc2,
+	0x28, 0xee, 0xa1, 0xb5, 0x1e, 0xe1, 0xf1, 0x88, 0xc5, 0x7a, 0x18, 0x26, 0x2c, 0x65, 0xba, 0xd8,
+	0xec, 0x4b, 0x60, 0xe1, 0x8d, 0xc9, 0x0b, 0xf0, 0x98, 0x87, 0x00, 0x71, 0x26, 0xda, 0xbd, 0x49,
+	0xc3, 0xc6, 0x46, 0x4c, 0x8f, 0x68, 0x22, 0x32, 0x2a, 0xc3, 0x48, 0xd2, 0x98, 0x69, 0x6f, 0x63,
+	0xe1, 0x1c, 0x1b, 0xbb, 0x1e, 0xb3, 0x03, 0x10, 0x6f, 0x23, 0x9e, 0x34, 0x8c, 0x0f, 0xd1, 0x46,
+	0x46, 0xa4, 0x66, 0x11, 0xcb, 0x08, 0xd7, 0x26, 0x66, 0xfa, 0x2c, 0xa1, 0xde, 0x0c, 0x02, 0x33,
+	0x6f, 0x4d, 0xf6, 0xbe, 0x12, 0xd6, 0xb5, 0x28, 0x67, 0xa9, 0x93, 0x9d, 0x21, 0xf9, 0xe0, 0xb5,
+	0xff, 0x7e, 0x73, 0xa3, 0xf1, 0xf5, 0x7f, 0xbe, 0x7d, 0xe3, 0x5a, 0x19, 0xf8, 0xc7, 0x95, 0x24,
+	0x60, 0xd5, 0x6e, 0x7e, 0x3b, 0x8f, 0x96, 0x3f, 0xa1, 0x9c, 0x2a, 0xa6, 0x1e, 0xf1, 0xe4, 0xc4,
+	0xcd, 0xf4, 0x55, 0x74, 0x59, 0x0b, 0x4d, 0x92, 0x50, 0xe5, 0x59, 0x96, 0x9c, 0x40, 0xc0, 0xce,
+	0x04, 0x8b, 0x30, 0x76, 0x00, 0x43, 0xf8, 0x4d, 0xb4, 0x2c, 0x24, 0x1b, 0x30, 0x4e, 0xb4, 0x90,
+	0x5e, 0xef, 0x02, 0xe8, 0x35, 0x4b, 0x81, 0x53, 0x7e, 0xc3, 0x04, 0x4a, 0xe6, 0x9d, 0x8f, 0xa4,
+	0x22, 0xe7, 0x1a, 0xc2, 0x6d, 0x26, 0x58, 0xd2, 0x22, 0xb3, 0xee, 0x74, 0x0f, 0x86, 0xf1, 0xaf,
+	0xd1, 0xaa, 0xd2, 0x84, 0xc7, 0x46, 0x73, 0x1c, 0x30, 0x0b, 0x80, 0x15, 0x2f, 0x1d, 0x43, 0x7d,
+	0x88, 0x36, 0x32, 0x49, 0xcd, 0x9e, 0x0e, 0x24, 0x49, 0x53, 0x1a, 0x87, 0x8a, 0x24, 0xd4, 0x23,
+	0xe7, 0x00, 0xb9, 0x96, 0x49, 0xda, 0x2d, 0x14, 0x0e, 0x48, 0x42, 0x1d, 0xf8, 0x06, 0x5a, 0x2c,
+	0xa7, 0x67, 0xa3, 0x62, 0x2e, 0x40, 0xc5, 0xc4, 0x60, 0x3f, 0xec, 0x0a, 0xc3, 0xd8, 0x44, 0x2b,
+	0x38, 0xf6, 0x42, 0xb0, 0x68, 0xc7, 0x76, 0xcd, 0x50, 0x6d, 0x89, 0x19, 0x95, 0x4c, 0xc4, 0xe0,
+	0x9e, 0xd5, 0x25, 0x76, 0x61, 0x18, 0xff, 0x12, 0xe1, 0xaa, 0x2e, 0x39, 0x11, 0xb9, 0xb6, 0x7e,
+	0x36, 0x63, 0x32, 0x82, 0x57, 0xb6, 0xe3, 0xf8, 0x63, 0x74, 0xed, 0xb4, 0xb6, 0xb1, 0x10, 0xa6,
+	0x8c, 0x53, 0x09, 0x8e, 0x33, 0x13, 0x74, 0xea, 0xb8, 0x2e, 0x95, 0xfb, 0x46, 0x8e, 0xdf, 0x45,
+	0x6b, 0x15, 0x7c, 0x4a, 0x8e, 0xc3, 0x38, 0x97, 0x90, 0x05, 0x3b, 0x8b, 0x76, 0x47, 0x0b, 0xe8,
+	0x3e, 0x39, 0xde, 0x75, 0x32, 0x1c, 0xa1, 0x1b, 0x46, 0x97, 0xf1, 0x98, 0x1d, 0xb1, 0x38, 0x37,
+	0xc9, 0x48, 0x8c, 0xa8, 0x34, 0x86, 0x23, 0xca, 0x35, 0x19, 0xd0, 0xce, 0x65, 0x70, 0xd9, 0x6b,
+	0x67, 0x44, 0x46, 0xc4, 0x52, 0x92, 0x04, 0xd7, 0x52, 0x72, 0xfc, 0xa0, 0xe0, 0xe8, 0x1a, 0x8a,
+	0x6e, 0xc1, 0x80, 0x7f, 0x83, 0x3a, 0x03, 0xeb, 0x7d, 0xe1, 0x20, 0x27, 0x32, 0x66, 0x84, 0x87,
+	0x94, 0x93, 0x5e, 0x42, 0xe3, 0xce, 0x95, 0xad, 0xc6, 0xad, 0x4b, 0xc1, 0xaa, 0x93, 0x7f, 0xe2,
+	0xc4, 0x7b, 0x56, 0x8a, 0xbf, 0x42, 0x6f, 0x9e, 0x42, 0x72, 0xaa, 0x47, 0x42, 0x1e, 0x86, 0x29,
+	0xd1, 0xb9, 0x64, 0xfa, 0x24, 0xd4, 0x43, 0x49, 0xd5, 0x50, 0x24, 0x71, 0xe7, 0x27, 0xb0, 0xd2,
+	0x5f, 0xd4, 0xc8, 0x3e, 0xb7, 0x80, 0x7d, 0xa7, 0xff, 0xd8, 0xab, 0xe3, 0xaf, 0xd0, 0xd5, 0x53,
+	0xec, 0x69, 0x9e, 0x68, 0x96, 0x25, 0x8c, 0xca, 0xce, 0xd2, 0x14, 0x0b, 0x5f, 0xaf, 0xd9, 0xda,
+	0x2f, 0xe0, 0xf8, 0x77, 0x68, 0xe3, 0x14, 0x3b, 0x89, 0x63, 0x49, 0x95, 0xa2, 0xaa, 0xd3, 0xdc,
+	0x9a, 0xb9, 0xb5, 0x10, 0x74, 0x6a, 0xf0, 0x7b, 0x5e, 0x7e, 0xf3, 0x5f, 0xb3, 0xa8, 0x59, 0x2f,
+	0x24, 0xf8, 0x19, 0xda, 0x50, 0x79, 0x4f, 0xb1, 0xf8, 0x24, 0x94, 0x34, 0xce, 0x23, 0x28, 0x92,
+	0x8c, 0x6b, 0x2a, 0x8f, 0x48, 0xe2, 0x0a, 0xee, 0xf9, 0xf3, 0xed, 0x38, 0x7c, 0xe0, 0xe1, 0x0f,
+	0x1c, 0x1a, 0x3f, 0x45, 0x9d, 0xd3, 0xdc, 0x2e, 0xb2, 0x2e, 0x4c, 0xc1, 0xbc, 0x5a, 0x67, 0x76,
+	0x61, 0xf7, 0x0c, 0x6d, 0x44, 0xb9, 0x94, 0x94, 0xeb, 0xd0, 0xf3, 0x57, 0x9c, 0x6b, 0x66, 0x9a,
+	0x39, 0x3b, 0xfc, 0x81, 0x85, 0x57, 0x1c, 0xeb, 0x0f, 0x68, 0xa3, 0x9a, 0x71, 0x92, 0x44, 0x8c,
+	0x68, 0x1c, 0xf6, 0x09, 0x4b, 0x72, 0x49, 0x5d, 0x8d, 0x3e, 0x9f, 0x7b, 0xad, 0x4c, 0x4c, 0x16,
+	0x7d, 0xdf, 0x82, 0xf1, 0x47, 0xe8, 0xaa, 0xa1, 0x86, 0xe0, 0x0b, 0x4d, 0xfb, 0xf0, 0x3c, 0x27,
+	0x09, 0xeb, 0xb3, 0xc8, 0xc6, 0xd4, 0x5c, 0x11, 0x8e, 0x10, 0x7e, 0x5d, 0x11, 0x7d, 0x51, 0x95,
+	0xe3, 0xdb, 0xa8, 0x05, 0x4e, 0x7a, 0x44, 0x95, 0x86, 0x5a, 0x69, 0x53, 0x85, 0x49, 0x3a, 0xb3,
+	0xc1, 0xb2, 0x11, 0x3d, 0xb5, 0x12, 0x97, 0x2c, 0xee, 0xa2, 0xb6, 0x5b, 0x45, 0x0d, 0x31, 0x0f,
+	0x88, 0x96, 0x15, 0x8e, 0x63, 0xde, 0x47, 0x9d, 0x72, 0x8a, 0x35, 0xd8, 0x25, 0x80, 0xb5, 0xfd,
+	0xfc, 0xc6, 0x80, 0x1f, 0xcc, 0x9a, 0xaa, 0x71, 0xf3, 0xdb, 0x39, 0xb4, 0x58, 0xe9, 0xc3, 0x4c,
+	0xfa, 0xb3, 0xfd, 0x5b, 0x42, 0xf9, 0x40, 0x0f, 0x7d, 0x39, 0x80, 0xb1, 0x87, 0x30, 0x84, 0x5f,
+	0x47, 0x4d, 0xab, 0x52, 0x89, 0x12, 0x5b, 0x0d, 0x96, 0x60, 0xbc, 0xe2, 0xfd, 0x37, 0x90, 0x45,
+	0x86, 0x6a, 0xc8, 0xfa, 0xbe, 0x0c, 0x20, 0x18, 0x3a, 0x30, 0x23, 0xf8, 0xf7, 0xe8, 0x7a, 0x4c,
+	0xfb, 0x24, 0x4f, 0x74, 0x98, 0x73, 0xa6, 0x43, 0xd1, 0x0f, 0x23, 0x91, 0x66, 0xb9, 0xa6, 0xd0,
+	0x60, 0x50, 0x57, 0x08, 0xd6, 0x9d, 0xd2, 0x13, 0xce, 0xf4, 0xa3, 0xfe, 0x8e, 0xd5, 0x30, 0x9d,
+	0x03, 0x35, 0x09, 0xd6, 0x1c, 0x8c, 0x32, 0xae, 0x50, 0x66, 0x3b, 0x7b, 0x32, 0xcd, 0x4c, 0x44,
+	0x07, 0x46, 0x50, 0x64, 0xba, 0xbb, 0xa8, 0x6d, 0xb4, 0xe9, 0x71, 0x34, 0x24, 0xbc, 0x0a, 0xb8,
+	0x08, 0x80, 0x56, 0x26, 0xa2, 0x3d, 0x27, 0x2b, 0x30, 0xbf, 0x42, 0x2b, 0x06, 0x53, 0xe9, 0x48,
+	0x63, 0x9a, 0x90, 0x13, 0x38, 0x94, 0x99, 0xc0, 0x58, 0x2f, 0xdb, 0xcf, 0x5d, 0x23, 0xc1, 0xef,
+	0xa1, 0xb5, 0x3a, 0xc2, 0xdb, 0xb1, 0x65, 0xa2, 0x3d, 0x0e, 0xf2, 0x96, 0xde, 0x47, 0x1d, 0x45,
+	0x75, 0xc8, 0xe9, 0xc8, 0x63, 0x85, 0x54, 0xce, 0x9a, 0x2d, 0x19, 0x6d, 0x45, 0xf5, 0xe7, 0x74,
+	0xf4, 0xb4, 0x90, 0x5a, 0x83, 0x1f, 0xa3, 0xab, 0x85, 0x57, 0x57, 0xcd, 0x46, 0xb9, 0x16, 0xfd,
+	0xbe, 0x2b, 0x1b, 0xeb, 0x85, 0x4a, 0x69, 0x7a, 0x07, 0x14, 0xf0, 0x03, 0xf4, 0x6a, 0x89, 0xcf,
+	0x64, 0xce, 0x8d, 0x13, 0xd9, 0x93, 0x2b, 0xf3, 0xea, 0x22, 0x78, 0xd3, 0x66, 0xa1, 0xd8, 0xb5,
+	0x7a, 0xe0, 0x3d, 0x65, 0x3a, 0xbd, 0x8b, 0xda, 0xa7, 0xa9, 0x52, 0x72, 0x0c, 0x15, 0x64, 0x26,
+	0x68, 0xd5, 0xe1, 0xfb, 0xe4, 0x18, 0xff, 0x1c, 0x2d, 0x41, 0x6f, 0x5e, 0xd1, 0xbe, 0x02, 0xda,
+	0x57, 0x4c, 0x03, 0x5e, 0xe8, 0x39, 0x97, 0xfd, 0xeb, 0x02, 0x6a, 0xd6, 0x1b, 0x7e, 0xfc, 0x10,
+	0xb5, 0xfa, 0x24, 0x51, 0x34, 0xcc, 0x84, 0x62, 0x9a, 0x1d, 0xd1, 0x50, 0x12, 0x4d, 0xa7, 0xca,
+	0x86, 0xcb, 0x00, 0xec, 0x3a, 0x5c, 0x40, 0x34, 0x35, 0x07, 0x91, 0x9a, 0x0e, 0x9a, 0xa4, 0x59,
+	0x98, 0x67, 0x61, 0x4a, 0x89, 0xca, 0x25, 0x4d, 0x29, 0xd7, 0xf6, 0x1e, 0x32, 0x17, 0xb4, 0x53,
+	0xc6, 0x03, 0x92, 0x66, 0x4f, 0xb2, 0xfd, 0x8a, 0x10, 0x7f, 0x88, 0x50, 0x46, 0x94, 0x32, 0x67,
+	0x90, 0x4f, 0x97, 0xd7, 0x16, 0x8c, 0xfe, 0x53, 0xa3, 0x8e, 0x03, 0xb4, 0x6a, 0xac, 0x56, 0xce,
+	0x8f, 0x1c, 0x51, 0x69, 0x12, 0xe4, 0x34, 0x49, 0x6c, 0x25, 0x65, 0xbc, 0xdc, 0x96, 0x7b, 0x16,
+	0x09, 0x9c, 0xe4, 0x78, 0x12, 0xe7, 0xdc, 0x54, 0x9c, 0xe4, 0xf8, 0x34, 0xe7, 0x9b, 0x68, 0x99,
+	0x1e, 0x67, 0xcc, 0x3a, 0x6d, 0xd8, 0x4b, 0x44, 0x74, 0xa8, 0x5c, 0x00, 0x35, 0x4b, 0xc1, 0x36,
+	0x8c, 0xe3, 0x9b, 0xe8, 0x0a, 0x38, 0x92, 0x0a, 0xb5, 0x80, 0x93, 0x9d, 0xaf, 0x64, 0x14, 0xf5,
+	0x58, 0x98, 0xf3, 0xdf, 0x41, 0x9b, 0xfd, 0x3c, 0x49, 0xaa, 0xb3, 0xd4, 0x92, 0xf4, 0xfb, 0x2c,
+	0xf2, 0x1e, 0x6c, 0xc3, 0xe6, 0xaa, 0xd1, 0x2a, 0xe7, 0xf3, 0xd8, 0xea, 0x38, 0x1f, 0x3e, 0xbd,
+	0x7b, 0x43, 0x92, 0xf4, 0x47, 0x2e, 0x74, 0x7e, 0xdc, 0xee, 0x7d, 0x6a, 0x91, 0xf8, 0x1e, 0xba,
+	0x5e, 0xe3, 0xac, 0xcd, 0xcb, 0x46, 0xd6, 0xc6, 0x18, 0x78, 0xc2, 0xb4, 0x94, 0xaa, 0x94, 0x3b,
+	0x8f, 0x5d, 0x9c, 0x6e, 0x5a, 0x4a, 0x95, 0xb5, 0xce, 0x71, 0x76, 0x51, 0x1b, 0x38, 0x25, 0x7d,
+	0x9e, 0x53, 0x05, 0x1d, 0x22, 0x27, 0x89, 0x3e, 0x99, 0xaa, 0x4b, 0x6b, 0x19, 0x68, 0xe0, 0x90,
+	0x5d, 0x0b, 0xc4, 0x6f, 0xa3, 0x15, 0xcd, 0x52, 0xaa, 0xb4, 0xf1, 0xf8, 0xf2, 0x0c, 0x5d, 0x18,
+	0xb6, 0x0a, 0xd9, 0x5e, 0x21, 0x32, 0x5e, 0x50, 0x42, 0x48, 0x7c, 0x44, 0x78, 0x44, 0x5d, 0xef,
+	0xd5, 0x2c, 0x04, 0xf7, 0xec, 0xb8, 0xc9, 0xf3, 0xa6, 0xf8, 0xa4, 0x44, 0xd3, 0xb8, 0xb8, 0xd7,
+	0x51, 0x69, 0x9d, 0x27, 0x3c, 0xec, 0x41, 0x9b, 0x35, 0x1b, 0xac, 0x17, 0x4a, 0xee, 0xc6, 0x46,
+	0x25, 0xb8, 0xd1, 0x67, 0x3d, 0x53, 0x98, 0x62, 0xa6, 0xa0, 0x32, 0xf4, 0x04, 0x8f, 0x3b, 0x4d,
+	0x00, 0x2c, 0xba, 0xb1, 0x6d, 0xc1, 0x21, 0xf5, 0x78, 0x95, 0x11, 0xe3, 0xb1, 0x18, 0x79, 0xdf,
+	0x5c, 0xb6, 0xab, 0x70, 0xc2, 0x2f, 0x41, 0xe6, 0xdc, 0xf3, 0x2d, 0x84, 0x3d, 0xa6, 0x4c, 0xb9,
+	0x1d, 0xbc, 0xd5, 0xb8, 0x75, 0x25, 0x58, 0x76, 0x92, 0x32, 0xdb, 0x9a, 0x1b, 0x8b, 0x57, 0x37,
+	0x6b, 0x14, 0xb9, 0xf6, 0x36, 0x5a, 0xb6, 0xbf, 0x76, 0xd2, 0xc7, 0x56, 0x68, 0x8d, 0xb8, 0xbc,
+	0xf5, 0xb7, 0x06, 0x5a, 0x28, 0xde, 0x16, 0xc0, 0xb0, 0xab, 0x7c, 0x31, 0x33, 0xfe, 0x92, 0x9b,
+	0x03, 0x6c, 0x40, 0x72, 0x59, 0x76, 0x92, 0xdd, 0x42, 0x60, 0x0c, 0x57, 0xbc, 0x50, 0x91, 0x34,
+	0x4b, 0x68, 0xa8, 0xd8, 0x0b, 0xea, 0xf2, 0xd1, 0x4a, 0x29, 0x3d, 0x00, 0xe1, 0x01, 0x7b, 0x41,
+	0xf1, 0x7d, 0xb4, 0x65, 0x12, 0x6b, 0x4c, 0x34, 0x39, 0x33, 0xad, 0xcf, 0xc0, 0x46, 0x5e, 0xcb,
+	0x44, 0xb4, 0x4b, 0x34, 0x99, 0x98, 0xd4, 0xdd, 0x02, 0xee, 0xa1, 0x79, 0xe7, 0x44, 0x78, 0x05,
+	0xcd, 0xd9, 0x14, 0x67, 0xfb, 0x03, 0xfb, 0x0f, 0xde, 0x40, 0x97, 0xe8, 0x71, 0x26, 0x38, 0x75,
+	0xdd, 0xe2, 0x5c, 0x50, 0xfc, 0xef, 0x28, 0xfe, 0x31, 0x87, 0x9a, 0xf5, 0x57, 0x0c, 0x13, 0x22,
+	0x2a, 0x21, 0x6a, 0x18, 0xf6, 0x25, 0xf1, 0xdd, 0x2c, 0x2c, 0x67, 0xaa, 0xf4, 0xbd, 0x02, 0xd8,
+	0xfb, 0x0e, 0xfa, 0xc0, 0x22, 0xf1, 0x63, 0xb4, 0x56, 0xe3, 0x8c, 0xc5, 0x88, 0x9b, 0x33, 0x9b,
+	0xaa, 0x8f, 0x6d, 0x8f, 0x91, 0xee, 0x3a, 0x28, 0x4e, 0xd1, 0xcf, 0x3c, 0x4d, 0x68, 0xc2, 0x88,
+	0xc6, 0xd5, 0xb8, 0x1e, 0xdf, 0xd3, 0x97, 0x99, 0x78, 0xd5, 0x33, 0xed, 0x03, 0x51, 0x19, 0xe4,
+	0x65, 0x2d, 0x7d, 0x07, 0xad, 0x0e, 0x24, 0x31, 0x75, 0x14, 0x5a, 0xb6, 0x90, 0xf2, 0xd8, 0x1e,
+	0x1f, 0x14, 0x84, 0xd9, 0xa0, 0x05, 0x52, 0xdb, 0xcf, 0xed, 0xf1, 0x18, 0x0e, 0x0d, 0x7f, 0x8a,
+	0x96, 0x7b, 0x44, 0xd1, 0x70, 0x44, 0xd9, 0x60, 0xa8, 0x43, 0x88, 0xd6, 0xa9, 0x92, 0xfd, 0x92,
+	0x81, 0x7d, 0x09, 0xa8, 0xc0, 0x80, 0x4c, 0x63, 0x5d, 0x7d, 0x9f, 0xa2, 0xd2, 0x73, 0x9a, 0x56,
+	0xcd, 0x3d, 0x28, 0xbd, 0xa4, 0xb1, 0xae, 0xbc, 0x4f, 0x51, 0x69, 0xb9, 0x4d, 0x0f, 0x67, 0x2e,
+	0x5d, 0xb5, 0xe3, 0xa1, 0xcf, 0x73, 0x76, 0x24, 0x5c, 0x63, 0x3d, 0x3f, 0xcd, 0xa5, 0x6b, 0xec,
+	0x88, 0xf6, 0x2a, 0x70, 0xfc, 0x47, 0x74, 0x7d, 0x34, 0x64, 0x4a, 0x27, 0xb4, 0x97, 0xc0, 0x45,
+	0xd6, 0x75, 0xd5, 0xde, 0x98, 0x7b, 0x4b, 0x3a, 0x9f, 0xff, 0xea, 0x18, 0x85, 0xbd, 0x1e, 0x78,
+	0x6b, 0xce, 0x9b, 0xff, 0x37, 0x83, 0x5a, 0x13, 0xde, 0xce, 0x4c, 0xdf, 0x9f, 0x2b, 0x1a, 0x8e,
+	0x3f, 0xc4, 0xd9, 0xb7, 0xd0, 0x4b, 0xc1, 0x72, 0xae, 0xe8, 0x18, 0x48, 0x99, 0x0e, 0x93, 0x71,
+	0xa6, 0x19, 0x49, 0x5c, 0x74, 0x5a, 0x04, 0x78, 0xea, 0x6c, 0x80, 0x9d, 0x0c, 0x8e, 0xd7, 0x42,
+	0x4c, 0x9f, 0x11, 0xd3, 0x88, 0x9c, 0xd8, 0x2e, 0x67, 0xaa, 0x3e, 0x03, 0xf4, 0xa1, 0xbb, 0xf9,
+	0x29, 0xba, 0xe2, 0xef, 0xa4, 0x55, 0x6f, 0xba, 0xec, 0x06, 0xad, 0x1b, 0x3d, 0x45, 0x9d, 0x5c,
+	0xb3, 0x84, 0xbd, 0x70, 0x55, 0x5e, 0xf0, 0x5c, 0x85, 0x7d, 0x12, 0x69, 0x21, 0xa7, 0xf2, 0xa6,
+	0xd5, 0x0a, 0x7a, 0xdb, 0x80, 0xef, 0x03, 0xd6, 0x38, 0x15, 0xd4, 0xfa, 0x48, 0xd8, 0x6e, 0x62,
+	0x9c, 0x79, 0x2a, 0xa7, 0x32, 0xf8, 0x1d, 0x07, 0xaf, 0x52, 0x87, 0xe8, 0x3a, 0xbc, 0x91, 0x91,
+	0xb3, 0xd8, 0xa7, 0x71, 0xab, 0x0d, 0x47, 0x31, 0xc1, 0x80, 0x3b, 0xf5, 0x6f, 0xe6, 0xd0, 0xca,
+	0xa4, 0x27, 0x4d, 0xb3, 0x34, 0xa5, 0x49, 0x8f, 0x25, 0x4c, 0x9f, 0x84, 0x2f, 0x04, 0xa7, 0xa1,
+	0xf5, 0xbe, 0x9e, 0xc8, 0xf9, 0x74, 0xb9, 0x6c, 0xad, 0xc0, 0x3f, 0x13, 0x9c, 0x3e, 0x34, 0xe8,
+	0x6d, 0x03, 0x9e, 0x40, 0x9d, 0x67, 0x59, 0x41, 0x7d, 0xe1, 0x47, 0x53, 0x3f, 0x31, 0x68, 0x4b,
+	0xfd, 0x09, 0x6a, 0xc2, 0x55, 0x2b, 0xa4, 0x09, 0x51, 0x9a, 0x45, 0x4c, 0x9f, 0x4c, 0xe5, 0x50,
+	0x4b, 0x80, 0xda, 0x2b, 0x40, 0xe6, 0x12, 0x52, 0xf5, 0x18, 0x57, 0x82, 0x8b, 0x9b, 0x8f, 0x75,
+	0xb2, 0xf5, 0x8a, 0x8a, 0x2d, 0xc4, 0xc5, 0xed, 0xe7, 0x2d, 0xd4, 0x32, 0xcd, 0x96, 0x59, 0x16,
+	0x3c, 0x95, 0xbb, 0x1b, 0xe0, 0x1c, 0xe0, 0x9a, 0x29, 0xe3, 0x5d, 0x2a, 0xe1, 0x31, 0xc4, 0x5e,
+	0xfc, 0xee, 0xa0, 0x15, 0xc8, 0x73, 0x75, 0x7d, 0x77, 0xbb, 0x36, 0xb2, 0x71, 0xc0, 0xd9, 0xd9,
+	0x74, 0xfe, 0xec, 0x6c, 0xfa, 0x31, 0xba, 0x36, 0x06, 0xaa, 0x5b, 0xb3, 0x57, 0xec, 0x4e, 0x05,
+	0x3a, 0x6e, 0xf4, 0xb7, 0x68, 0xdd, 0xf4, 0xdf, 0xcf, 0x73, 0xa1, 0xcb, 0xdb, 0xa6, 0xef, 0x19,
+	0xec, 0x9d, 0xce, 0x34, 0xe8, 0x5f, 0x18, 0xb9, 0xdf, 0x09, 0xd7, 0x9a, 0xbc, 0x63, 0x5b, 0x77,
+	0x0b, 0xb5, 0x36, 0x7b, 0x79, 0x3c, 0xa0, 0x1a, 0xba, 0xce, 0xd9, 0xa0, 0xe5, 0x71, 0x60, 0x6e,
+	0x1b, 0x44, 0xce, 0x45, 0xff, 0x32, 0x83, 0xda, 0x13, 0xdf, 0xc4, 0x5f, 0xde, 0x88, 0x35, 0x5e,
+	0xd6, 0x88, 0x3d, 0x40, 0xf8, 0xb0, 0x07, 0x18, 0xc6, 0xb3, 0x5c, 0xdb, 0x99, 0x4d, 0xe5, 0x82,
+	0x4b, 0x87, 0xbd, 0x2e, 0x95, 0x0f, 0x0c, 0x0a, 0xa6, 0x8c, 0x3f, 0x43, 0x2d, 0x47, 0x25, 0x72,
+	0x5d, 0x72, 0x4d, 0xe3, 0x7d, 0x4d, 0xe0, 0x7a, 0x04, 0x30, 0x4b, 0x76, 0x07, 0xb5, 0x5c, 0xdb,
+	0x00, 0x9b, 0xa8, 0xec, 0xea, 0x9c, 0xdb, 0xe1, 0x31, 0x11, 0xac, 0xc9, 0x5e, 0x9a, 0xab, 0x00,
+	0xd7, 0x55, 0xb9, 0xc7, 0x13, 0xeb, 0x77, 0xeb, 0x63, 0x2a, 0xb6, 0xb5, 0x72, 0x2f, 0x2f, 0x1f,
+	0xa0, 0xf5, 0x09, 0x06, 0xc3, 0x28, 0x97, 0x47, 0xde, 0x0b, 0xd7, 0x4e, 0x9b, 0xdd, 0x31, 0x62,
+	0x77, 0x4c, 0x4f, 0x50, 0x7b, 0xe2, 0x57, 0x05, 0xfc, 0x1e, 0x5a, 0x1b, 0x31, 0x3d, 0x8c, 0x25,
+	0x19, 0x91, 0xc4, 0x3e, 0x00, 0x78, 0x9f, 0xb1, 0x0d, 0x57, 0xbb, 0x14, 0xc3, 0x0b, 0xc0, 0x58,
+	0xa3, 0xf9, 0x75, 0x03, 0x75, 0xce, 0xfa, 0x8c, 0x80, 0xdf, 0x46, 0x6d, 0xe3, 0x55, 0x79, 0x16,
+	0x13, 0x4d, 0xed, 0xd9, 0xdb, 0x20, 0x68, 0x40, 0xcf, 0x8b, 0x53, 0x72, 0xfc, 0xc4, 0xca, 0xba,
+	0x54, 0xda, 0x18, 0x78, 0x17, 0xad, 0xe5, 0x32, 0x09, 0xfd, 0x93, 0x49, 0x75, 0x36, 0xf6, 0xdd,
+	0x67, 0x25, 0x97, 0xc9, 0x8e, 0x7d, 0x34, 0xa9, 0x4f, 0x66, 0xfb, 0xd1, 0xdf, 0xbf, 0xdf, 0x6c,
+	0x7c, 0xf7, 0xfd, 0x66, 0xe3, 0xdf, 0xdf, 0x6f, 0x36, 0xfe, 0xf4, 0xc3, 0xe6, 0x2b, 0xdf, 0xfd,
+	0xb0, 0xf9, 0xca, 0x3f, 0x7f, 0xd8, 0x7c, 0xe5, 0xd9, 0xbb, 0x03, 0xa6, 0x87, 0x79, 0xef, 0x76,
+	0x24, 0xd2, 0x3b, 0x99, 0x14, 0x71, 0x1e, 0x69, 0x15, 0xb1, 0xda, 0x07, 0xcc, 0xea, 0x77, 0x0c,
+	0x7d, 0x92, 0x51, 0xd5, 0xbb, 0x08, 0xdf, 0x1c, 0xdf, 0xf9, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0x7f, 0xfa, 0x4a, 0x2e, 0xf0, 0x1c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1397,6 +1605,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.BandwidthLimitsParams.Equal(that1.BandwidthLimitsParams) {
 		return false
 	}
+	if !this.DeveloperCreditParams.Equal(that1.DeveloperCreditParams) {
+		return false
+	}
+	if !this.ParticipantProfileParams.Equal(that1.ParticipantProfileParams) {
+		return false
+	}
 	return true
 }
 func (this *TokenomicsParams) Equal(that interface{}) bool {
@@ -1568,6 +1782,18 @@ func (this *ValidationParams) Equal(that interface{}) bool {
 	if this.EstimatedLimitsPerBlockKb != that1.EstimatedLimitsPerBlockKb {
 		return false
 	}
+	if this.DisputeBond != that1.DisputeBond {
+		return false
+	}
+	if this.DisputeWindowBlocks != that1.DisputeWindowBlocks {
+		return false
+	}
+	if this.DisputeValidators != that1.DisputeValidators {
+		return false
+	}
+	if this.DisputeTimeoutBlocks != that1.DisputeTimeoutBlocks {
+		return false
+	}
 	return true
 }
 func (this *PocParams) Equal(that interface{}) bool {
@@ -1664,6 +1890,12 @@ func (this *CollateralParams) Equal(that interface{}) bool {
 	if !this.CollateralPerWeightUnit.Equal(that1.CollateralPerWeightUnit) {
 		return false
 	}
+	if !this.SlashFractionEquivocation.Equal(that1.SlashFractionEquivocation) {
+		return false
+	}
+	if !this.WhistleblowerRewardFraction.Equal(that1.WhistleblowerRewardFraction) {
+		return false
+	}
 	return true
 }
 func (this *BitcoinRewardParams) Equal(that interface{}) bool {
@@ -1751,6 +1983,12 @@ func (this *DynamicPricingParams) Equal(that interface{}) bool {
 	if this.GracePeriodPerTokenPrice != that1.GracePeriodPerTokenPrice {
 		return false
 	}
+	if this.MaxQuoteDurationBlocks != that1.MaxQuoteDurationBlocks {
+		return false
+	}
+	if this.MaxQuoteTokenBudget != that1.MaxQuoteTokenBudget {
+		return false
+	}
 	return true
 }
 func (this *BandwidthLimitsParams) Equal(that interface{}) bool {
@@ -1792,6 +2030,57 @@ func (this *BandwidthLimitsParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DeveloperCreditParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeveloperCreditParams)
+	if !ok {
+		that2, ok := that.(DeveloperCreditParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WithdrawalDelayBlocks != that1.WithdrawalDelayBlocks {
+		return false
+	}
+	return true
+}
+func (this *ParticipantProfileParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ParticipantProfileParams)
+	if !ok {
+		that2, ok := that.(ParticipantProfileParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxUpdatesPerEpoch != that1.MaxUpdatesPerEpoch {
+		return false
+	}
+	if this.UrlChangeDelayBlocks != that1.UrlChangeDelayBlocks {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1812,6 +2101,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ParticipantProfileParams != nil {
+		{
+			size, err := m.ParticipantProfileParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.DeveloperCreditParams != nil {
+		{
+			size, err := m.DeveloperCreditParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.BandwidthLimitsParams != nil {
 		{
 			size, err := m.BandwidthLimitsParams.MarshalToSizedBuffer(dAtA[:i])
@@ -2240,6 +2553,34 @@ func (m *ValidationParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DisputeTimeoutBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeTimeoutBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.DisputeValidators != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeValidators))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.DisputeWindowBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeWindowBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.DisputeBond != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeBond))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.EstimatedLimitsPerBlockKb != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.EstimatedLimitsPerBlockKb))
 		i--
@@ -2458,6 +2799,30 @@ func (m *CollateralParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WhistleblowerRewardFraction != nil {
+		{
+			size, err := m.WhistleblowerRewardFraction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SlashFractionEquivocation != nil {
+		{
+			size, err := m.SlashFractionEquivocation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.CollateralPerWeightUnit != nil {
 		{
 			size, err := m.CollateralPerWeightUnit.MarshalToSizedBuffer(dAtA[:i])
@@ -2637,6 +3002,16 @@ func (m *DynamicPricingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxQuoteTokenBudget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxQuoteTokenBudget))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxQuoteDurationBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxQuoteDurationBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.GracePeriodPerTokenPrice != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.GracePeriodPerTokenPrice))
 		i--
@@ -2768,27 +3143,88 @@ func (m *BandwidthLimitsParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DeveloperCreditParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DeveloperCreditParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeveloperCreditParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EpochParams != nil {
-		l = m.EpochParams.Size()
-		n += 1 + l + sovParams(uint64(l))
-	}
+	if m.WithdrawalDelayBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawalDelayBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantProfileParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantProfileParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantProfileParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UrlChangeDelayBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UrlChangeDelayBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxUpdatesPerEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUpdatesPerEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochParams != nil {
+		l = m.EpochParams.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	if m.ValidationParams != nil {
 		l = m.ValidationParams.Size()
 		n += 1 + l + sovParams(uint64(l))
@@ -2817,6 +3253,14 @@ func (m *Params) Size() (n int) {
 		l = m.BandwidthLimitsParams.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.DeveloperCreditParams != nil {
+		l = m.DeveloperCreditParams.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.ParticipantProfileParams != nil {
+		l = m.ParticipantProfileParams.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -3026,6 +3470,18 @@ func (m *ValidationParams) Size() (n int) {
 	if m.EstimatedLimitsPerBlockKb != 0 {
 		n += 1 + sovParams(uint64(m.EstimatedLimitsPerBlockKb))
 	}
+	if m.DisputeBond != 0 {
+		n += 2 + sovParams(uint64(m.DisputeBond))
+	}
+	if m.DisputeWindowBlocks != 0 {
+		n += 2 + sovParams(uint64(m.DisputeWindowBlocks))
+	}
+	if m.DisputeValidators != 0 {
+		n += 2 + sovParams(uint64(m.DisputeValidators))
+	}
+	if m.DisputeTimeoutBlocks != 0 {
+		n += 2 + sovParams(uint64(m.DisputeTimeoutBlocks))
+	}
 	return n
 }
 
@@ -3091,6 +3547,14 @@ func (m *CollateralParams) Size() (n int) {
 		l = m.CollateralPerWeightUnit.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.SlashFractionEquivocation != nil {
+		l = m.SlashFractionEquivocation.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.WhistleblowerRewardFraction != nil {
+		l = m.WhistleblowerRewardFraction.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -3161,6 +3625,12 @@ func (m *DynamicPricingParams) Size() (n int) {
 	if m.GracePeriodPerTokenPrice != 0 {
 		n += 1 + sovParams(uint64(m.GracePeriodPerTokenPrice))
 	}
+	if m.MaxQuoteDurationBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaxQuoteDurationBlocks))
+	}
+	if m.MaxQuoteTokenBudget != 0 {
+		n += 1 + sovParams(uint64(m.MaxQuoteTokenBudget))
+	}
 	return n
 }
 
@@ -3193,6 +3663,33 @@ func (m *BandwidthLimitsParams) Size() (n int) {
 	return n
 }
 
+func (m *DeveloperCreditParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WithdrawalDelayBlocks != 0 {
+		n += 1 + sovParams(uint64(m.WithdrawalDelayBlocks))
+	}
+	return n
+}
+
+func (m *ParticipantProfileParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxUpdatesPerEpoch != 0 {
+		n += 1 + sovParams(uint64(m.MaxUpdatesPerEpoch))
+	}
+	if m.UrlChangeDelayBlocks != 0 {
+		n += 1 + sovParams(uint64(m.UrlChangeDelayBlocks))
+	}
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3516,6 +4013,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperCreditParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeveloperCreditParams == nil {
+				m.DeveloperCreditParams = &DeveloperCreditParams{}
+			}
+			if err := m.DeveloperCreditParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantProfileParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParticipantProfileParams == nil {
+				m.ParticipantProfileParams = &ParticipantProfileParams{}
+			}
+			if err := m.ParticipantProfileParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -4952,6 +5521,82 @@ func (m *ValidationParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeBond", wireType)
+			}
+			m.DisputeBond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeBond |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeWindowBlocks", wireType)
+			}
+			m.DisputeWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeWindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeValidators", wireType)
+			}
+			m.DisputeValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeTimeoutBlocks", wireType)
+			}
+			m.DisputeTimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeTimeoutBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -5396,6 +6041,78 @@ func (m *CollateralParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEquivocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashFractionEquivocation == nil {
+				m.SlashFractionEquivocation = &Decimal{}
+			}
+			if err := m.SlashFractionEquivocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhistleblowerRewardFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WhistleblowerRewardFraction == nil {
+				m.WhistleblowerRewardFraction = &Decimal{}
+			}
+			if err := m.WhistleblowerRewardFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -5901,6 +6618,44 @@ func (m *DynamicPricingParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQuoteDurationBlocks", wireType)
+			}
+			m.MaxQuoteDurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQuoteDurationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQuoteTokenBudget", wireType)
+			}
+			m.MaxQuoteTokenBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQuoteTokenBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6120,6 +6875,163 @@ func (m *BandwidthLimitsParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeveloperCreditParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeveloperCreditParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeveloperCreditParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalDelayBlocks", wireType)
+			}
+			m.WithdrawalDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalDelayBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantProfileParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantProfileParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantProfileParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUpdatesPerEpoch", wireType)
+			}
+			m.MaxUpdatesPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUpdatesPerEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UrlChangeDelayBlocks", wireType)
+			}
+			m.UrlChangeDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UrlChangeDelayBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
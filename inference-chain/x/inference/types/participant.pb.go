@@ -57,20 +57,21 @@ func (ParticipantStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 type Participant struct {
-	Index                        string             `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	Address                      string             `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Weight                       int32              `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
-	JoinTime                     int64              `protobuf:"varint,4,opt,name=join_time,json=joinTime,proto3" json:"join_time,omitempty"`
-	JoinHeight                   int64              `protobuf:"varint,5,opt,name=join_height,json=joinHeight,proto3" json:"join_height,omitempty"`
-	LastInferenceTime            int64              `protobuf:"varint,6,opt,name=last_inference_time,json=lastInferenceTime,proto3" json:"last_inference_time,omitempty"`
-	InferenceUrl                 string             `protobuf:"bytes,7,opt,name=inference_url,json=inferenceUrl,proto3" json:"inference_url,omitempty"`
-	Status                       ParticipantStatus  `protobuf:"varint,8,opt,name=status,proto3,enum=inference.inference.ParticipantStatus" json:"status,omitempty"`
-	CoinBalance                  int64              `protobuf:"varint,9,opt,name=coin_balance,json=coinBalance,proto3" json:"coin_balance,omitempty"`
-	ValidatorKey                 string             `protobuf:"bytes,10,opt,name=validator_key,json=validatorKey,proto3" json:"validator_key,omitempty"`
-	ConsecutiveInvalidInferences int64              `protobuf:"varint,11,opt,name=consecutive_invalid_inferences,json=consecutiveInvalidInferences,proto3" json:"consecutive_invalid_inferences,omitempty"`
-	WorkerPublicKey              string             `protobuf:"bytes,12,opt,name=worker_public_key,json=workerPublicKey,proto3" json:"worker_public_key,omitempty"`
-	EpochsCompleted              uint32             `protobuf:"varint,13,opt,name=epochs_completed,json=epochsCompleted,proto3" json:"epochs_completed,omitempty"`
-	CurrentEpochStats            *CurrentEpochStats `protobuf:"bytes,14,opt,name=current_epoch_stats,json=currentEpochStats,proto3" json:"current_epoch_stats,omitempty"`
+	Index                        string              `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Address                      string              `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Weight                       int32               `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	JoinTime                     int64               `protobuf:"varint,4,opt,name=join_time,json=joinTime,proto3" json:"join_time,omitempty"`
+	JoinHeight                   int64               `protobuf:"varint,5,opt,name=join_height,json=joinHeight,proto3" json:"join_height,omitempty"`
+	LastInferenceTime            int64               `protobuf:"varint,6,opt,name=last_inference_time,json=lastInferenceTime,proto3" json:"last_inference_time,omitempty"`
+	InferenceUrl                 string              `protobuf:"bytes,7,opt,name=inference_url,json=inferenceUrl,proto3" json:"inference_url,omitempty"`
+	Status                       ParticipantStatus   `protobuf:"varint,8,opt,name=status,proto3,enum=inference.inference.ParticipantStatus" json:"status,omitempty"`
+	CoinBalance                  int64               `protobuf:"varint,9,opt,name=coin_balance,json=coinBalance,proto3" json:"coin_balance,omitempty"`
+	ValidatorKey                 string              `protobuf:"bytes,10,opt,name=validator_key,json=validatorKey,proto3" json:"validator_key,omitempty"`
+	ConsecutiveInvalidInferences int64               `protobuf:"varint,11,opt,name=consecutive_invalid_inferences,json=consecutiveInvalidInferences,proto3" json:"consecutive_invalid_inferences,omitempty"`
+	WorkerPublicKey              string              `protobuf:"bytes,12,opt,name=worker_public_key,json=workerPublicKey,proto3" json:"worker_public_key,omitempty"`
+	EpochsCompleted              uint32              `protobuf:"varint,13,opt,name=epochs_completed,json=epochsCompleted,proto3" json:"epochs_completed,omitempty"`
+	CurrentEpochStats            *CurrentEpochStats  `protobuf:"bytes,14,opt,name=current_epoch_stats,json=currentEpochStats,proto3" json:"current_epoch_stats,omitempty"`
+	Profile                      *ParticipantProfile `protobuf:"bytes,15,opt,name=profile,proto3" json:"profile,omitempty"`
 }
 
 func (m *Participant) Reset()         { *m = Participant{} }
@@ -204,6 +205,126 @@ func (m *Participant) GetCurrentEpochStats() *CurrentEpochStats {
 	return nil
 }
 
+func (m *Participant) GetProfile() *ParticipantProfile {
+	if m != nil {
+		return m.Profile
+	}
+	return nil
+}
+
+// ParticipantProfile is the public metadata a participant publishes about itself.
+type ParticipantProfile struct {
+	DisplayName string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Website     string `protobuf:"bytes,2,opt,name=website,proto3" json:"website,omitempty"`
+	Contact     string `protobuf:"bytes,3,opt,name=contact,proto3" json:"contact,omitempty"`
+	// region_code is an ISO 3166-1 alpha-2 country code
+	RegionCode string `protobuf:"bytes,4,opt,name=region_code,json=regionCode,proto3" json:"region_code,omitempty"`
+	// supported_protocols is a bit set of the ProtocolFlag values
+	SupportedProtocols uint32 `protobuf:"varint,5,opt,name=supported_protocols,json=supportedProtocols,proto3" json:"supported_protocols,omitempty"`
+	// pending_inference_url replaces inference_url at inference_url_effective_height
+	PendingInferenceUrl         string `protobuf:"bytes,6,opt,name=pending_inference_url,json=pendingInferenceUrl,proto3" json:"pending_inference_url,omitempty"`
+	InferenceUrlEffectiveHeight int64  `protobuf:"varint,7,opt,name=inference_url_effective_height,json=inferenceUrlEffectiveHeight,proto3" json:"inference_url_effective_height,omitempty"`
+	// updates_in_epoch counts the updates made in last_update_epoch, for the per-epoch limit
+	LastUpdateEpoch uint64 `protobuf:"varint,8,opt,name=last_update_epoch,json=lastUpdateEpoch,proto3" json:"last_update_epoch,omitempty"`
+	UpdatesInEpoch  uint32 `protobuf:"varint,9,opt,name=updates_in_epoch,json=updatesInEpoch,proto3" json:"updates_in_epoch,omitempty"`
+}
+
+func (m *ParticipantProfile) Reset()         { *m = ParticipantProfile{} }
+func (m *ParticipantProfile) String() string { return proto.CompactTextString(m) }
+func (*ParticipantProfile) ProtoMessage()    {}
+func (*ParticipantProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2bc555767052d80, []int{1}
+}
+func (m *ParticipantProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantProfile.Merge(m, src)
+}
+func (m *ParticipantProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantProfile proto.InternalMessageInfo
+
+func (m *ParticipantProfile) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *ParticipantProfile) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
+}
+
+func (m *ParticipantProfile) GetContact() string {
+	if m != nil {
+		return m.Contact
+	}
+	return ""
+}
+
+func (m *ParticipantProfile) GetRegionCode() string {
+	if m != nil {
+		return m.RegionCode
+	}
+	return ""
+}
+
+func (m *ParticipantProfile) GetSupportedProtocols() uint32 {
+	if m != nil {
+		return m.SupportedProtocols
+	}
+	return 0
+}
+
+func (m *ParticipantProfile) GetPendingInferenceUrl() string {
+	if m != nil {
+		return m.PendingInferenceUrl
+	}
+	return ""
+}
+
+func (m *ParticipantProfile) GetInferenceUrlEffectiveHeight() int64 {
+	if m != nil {
+		return m.InferenceUrlEffectiveHeight
+	}
+	return 0
+}
+
+func (m *ParticipantProfile) GetLastUpdateEpoch() uint64 {
+	if m != nil {
+		return m.LastUpdateEpoch
+	}
+	return 0
+}
+
+func (m *ParticipantProfile) GetUpdatesInEpoch() uint32 {
+	if m != nil {
+		return m.UpdatesInEpoch
+	}
+	return 0
+}
+
 type CurrentEpochStats struct {
 	InferenceCount        uint64 `protobuf:"varint,1,opt,name=inference_count,json=inferenceCount,proto3" json:"inference_count,omitempty"`
 	MissedRequests        uint64 `protobuf:"varint,2,opt,name=missed_requests,json=missedRequests,proto3" json:"missed_requests,omitempty"`
@@ -218,7 +339,7 @@ func (m *CurrentEpochStats) Reset()         { *m = CurrentEpochStats{} }
 func (m *CurrentEpochStats) String() string { return proto.CompactTextString(m) }
 func (*CurrentEpochStats) ProtoMessage()    {}
 func (*CurrentEpochStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d2bc555767052d80, []int{1}
+	return fileDescriptor_d2bc555767052d80, []int{2}
 }
 func (m *CurrentEpochStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,6 +420,7 @@ func (m *CurrentEpochStats) GetInvalidatedInferences() uint64 {
 func init() {
 	proto.RegisterEnum("inference.inference.ParticipantStatus", ParticipantStatus_name, ParticipantStatus_value)
 	proto.RegisterType((*Participant)(nil), "inference.inference.Participant")
+	proto.RegisterType((*ParticipantProfile)(nil), "inference.inference.ParticipantProfile")
 	proto.RegisterType((*CurrentEpochStats)(nil), "inference.inference.CurrentEpochStats")
 }
 
@@ -307,48 +429,60 @@ func init() {
 }
 
 var fileDescriptor_d2bc555767052d80 = []byte{
-	// 648 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x5d, 0x6f, 0xd3, 0x3c,
-	0x14, 0xc7, 0x9b, 0x2d, 0x6d, 0xb7, 0x93, 0xbe, 0xba, 0x7b, 0xa6, 0x48, 0xcf, 0xa3, 0x3c, 0xdd,
-	0xd0, 0xa0, 0xec, 0xa2, 0x13, 0xa0, 0xdd, 0x22, 0x6d, 0x5d, 0x81, 0x08, 0x28, 0x55, 0xf6, 0x22,
-	0xc4, 0x4d, 0x94, 0x3a, 0x66, 0x35, 0x4b, 0x93, 0x60, 0x3b, 0x7b, 0xf9, 0x16, 0x7c, 0x20, 0x3e,
-	0x00, 0x97, 0xbb, 0xe4, 0x12, 0x6d, 0xe2, 0x7b, 0x20, 0xdb, 0x6d, 0x5a, 0x36, 0xb8, 0xf3, 0xf9,
-	0xfd, 0xff, 0xe7, 0xf8, 0xd8, 0xc7, 0x32, 0x6c, 0xd1, 0xf8, 0x23, 0x61, 0x24, 0xc6, 0x64, 0x67,
-	0xbe, 0x4a, 0x03, 0x26, 0x28, 0xa6, 0x69, 0x10, 0x8b, 0x6e, 0xca, 0x12, 0x91, 0xa0, 0x56, 0x2e,
-	0x76, 0xf3, 0xd5, 0xe6, 0x4f, 0x13, 0xac, 0xe1, 0xdc, 0x8a, 0xd6, 0xa0, 0x48, 0xe3, 0x90, 0x5c,
-	0xda, 0x46, 0xdb, 0xe8, 0xac, 0x7a, 0x3a, 0x40, 0x36, 0x94, 0x83, 0x30, 0x64, 0x84, 0x73, 0x7b,
-	0x49, 0xf1, 0x59, 0x88, 0xd6, 0xa1, 0x74, 0x41, 0xe8, 0xe9, 0x58, 0xd8, 0xcb, 0x6d, 0xa3, 0x53,
-	0xf4, 0xa6, 0x11, 0xfa, 0x17, 0x56, 0x3f, 0x25, 0x34, 0xf6, 0x05, 0x9d, 0x10, 0xdb, 0x6c, 0x1b,
-	0x9d, 0x65, 0x6f, 0x45, 0x82, 0x23, 0x3a, 0x21, 0xe8, 0x7f, 0xb0, 0x94, 0x38, 0xd6, 0x99, 0x45,
-	0x25, 0x83, 0x44, 0xaf, 0x74, 0x76, 0x17, 0x5a, 0x51, 0xc0, 0x85, 0x9f, 0xf7, 0xa9, 0xeb, 0x94,
-	0x94, 0xb1, 0x29, 0x25, 0x77, 0xa6, 0xa8, 0x82, 0x0f, 0xa0, 0x3a, 0xb7, 0x66, 0x2c, 0xb2, 0xcb,
-	0xaa, 0xcb, 0x4a, 0x0e, 0x8f, 0x59, 0x84, 0x9e, 0x43, 0x89, 0x8b, 0x40, 0x64, 0xdc, 0x5e, 0x69,
-	0x1b, 0x9d, 0xda, 0xd3, 0x87, 0xdd, 0x3f, 0x5c, 0x48, 0x77, 0xe1, 0x32, 0x0e, 0x95, 0xdb, 0x9b,
-	0x66, 0xa1, 0x0d, 0xa8, 0x60, 0xd9, 0xf5, 0x28, 0x88, 0x82, 0x18, 0x13, 0x7b, 0x55, 0x75, 0x63,
-	0x49, 0xb6, 0xaf, 0x91, 0xec, 0xe3, 0x3c, 0x88, 0x68, 0x18, 0x88, 0x84, 0xf9, 0x67, 0xe4, 0xca,
-	0x06, 0xdd, 0x47, 0x0e, 0x5f, 0x93, 0x2b, 0x74, 0x00, 0x0e, 0x4e, 0x62, 0x4e, 0x70, 0x26, 0xe8,
-	0x39, 0xf1, 0x69, 0xac, 0xd4, 0xf9, 0x59, 0xb9, 0x6d, 0xa9, 0xca, 0xff, 0x2d, 0xb8, 0x5c, 0x6d,
-	0xca, 0x4f, 0xcd, 0xd1, 0x36, 0x34, 0x2f, 0x12, 0x76, 0x46, 0x98, 0x9f, 0x66, 0xa3, 0x88, 0x62,
-	0xb5, 0x5d, 0x45, 0x6d, 0x57, 0xd7, 0xc2, 0x50, 0x71, 0xb9, 0xe3, 0x63, 0x68, 0x90, 0x34, 0xc1,
-	0x63, 0xee, 0xe3, 0x64, 0x92, 0x46, 0x44, 0x90, 0xd0, 0xae, 0xb6, 0x8d, 0x4e, 0xd5, 0xab, 0x6b,
-	0xde, 0x9b, 0x61, 0x74, 0x02, 0x2d, 0x9c, 0x31, 0x46, 0x62, 0xe1, 0x2b, 0xc9, 0x97, 0x87, 0xe7,
-	0x76, 0xad, 0x6d, 0x74, 0xac, 0xbf, 0xdc, 0x58, 0x4f, 0xfb, 0xfb, 0xd2, 0x2e, 0xaf, 0x8c, 0x7b,
-	0x4d, 0x7c, 0x17, 0x6d, 0x7e, 0x5d, 0x82, 0xe6, 0x3d, 0x23, 0x7a, 0x04, 0xf5, 0xf9, 0xdc, 0x70,
-	0x92, 0xc5, 0x42, 0xbd, 0x3b, 0xd3, 0xab, 0xe5, 0xb8, 0x27, 0xa9, 0x34, 0x4e, 0x28, 0xe7, 0x24,
-	0xf4, 0x19, 0xf9, 0x9c, 0x11, 0x2e, 0xf4, 0x43, 0x34, 0xbd, 0x9a, 0xc6, 0xde, 0x94, 0xca, 0x21,
-	0x91, 0x80, 0xc5, 0x24, 0xf4, 0xe5, 0x5c, 0xb8, 0x7a, 0x95, 0xa6, 0x67, 0x69, 0xd6, 0x93, 0x08,
-	0x6d, 0x41, 0x8d, 0x91, 0x8b, 0x80, 0x85, 0xb9, 0xc9, 0x54, 0xa6, 0xea, 0x8c, 0x6a, 0xdb, 0x06,
-	0x54, 0x46, 0xd9, 0x42, 0xa5, 0xa2, 0xae, 0xa4, 0x99, 0xb6, 0x3c, 0x81, 0xb5, 0xe9, 0x64, 0xc9,
-	0x6f, 0xf3, 0x2b, 0x29, 0x6b, 0x2b, 0xd7, 0x16, 0xc6, 0xb6, 0x0b, 0xeb, 0xd3, 0x81, 0xdf, 0x4d,
-	0x2a, 0xab, 0xa4, 0x7f, 0x16, 0xd4, 0x79, 0xda, 0xf6, 0x7b, 0x68, 0xde, 0x7b, 0x98, 0xa8, 0x0e,
-	0xd6, 0xf1, 0xe0, 0x70, 0xd8, 0xef, 0xb9, 0x2f, 0xdc, 0xfe, 0x41, 0xa3, 0x80, 0x00, 0x4a, 0x7b,
-	0xbd, 0x23, 0xf7, 0xa4, 0xdf, 0x30, 0x50, 0x05, 0x56, 0xdc, 0xc1, 0x34, 0x5a, 0x42, 0x16, 0x94,
-	0xdd, 0xc1, 0xc9, 0xde, 0x1b, 0xf7, 0xa0, 0xb1, 0x2c, 0x03, 0x6f, 0xef, 0xed, 0xd0, 0x1d, 0xbc,
-	0x6c, 0x98, 0xfb, 0xef, 0xbe, 0xdd, 0x38, 0xc6, 0xf5, 0x8d, 0x63, 0xfc, 0xb8, 0x71, 0x8c, 0x2f,
-	0xb7, 0x4e, 0xe1, 0xfa, 0xd6, 0x29, 0x7c, 0xbf, 0x75, 0x0a, 0x1f, 0x76, 0x4f, 0xa9, 0x18, 0x67,
-	0xa3, 0x2e, 0x4e, 0x26, 0x3b, 0x29, 0x4b, 0xc2, 0x0c, 0x0b, 0x8e, 0xe9, 0x9d, 0x6f, 0xe6, 0x72,
-	0x61, 0x2d, 0xae, 0x52, 0xc2, 0x47, 0x25, 0xf5, 0xdb, 0x3c, 0xfb, 0x15, 0x00, 0x00, 0xff, 0xff,
-	0x33, 0x32, 0xac, 0xfa, 0x96, 0x04, 0x00, 0x00,
+	// 846 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xce, 0x36, 0xfe, 0x89, 0x8f, 0xff, 0xc7, 0x6d, 0xb5, 0x52, 0x91, 0x71, 0x83, 0x4a, 0x4d,
+	0x2e, 0x1c, 0x51, 0xd4, 0x5b, 0xa4, 0xd4, 0x31, 0xb0, 0x02, 0x8c, 0xb5, 0x6d, 0x22, 0xc4, 0xcd,
+	0x6a, 0x3d, 0x7b, 0x92, 0x0c, 0x5d, 0xcf, 0x2c, 0x33, 0xb3, 0x4d, 0xf3, 0x0e, 0x5c, 0xf0, 0x18,
+	0x3c, 0x04, 0x0f, 0xc0, 0x65, 0x2f, 0xb9, 0x44, 0xc9, 0x8b, 0xa0, 0x99, 0x59, 0xaf, 0x9d, 0x04,
+	0xc4, 0xdd, 0x9e, 0xef, 0xfb, 0xce, 0x99, 0x33, 0x73, 0xbe, 0x63, 0xc3, 0x33, 0xc6, 0xcf, 0x50,
+	0x22, 0xa7, 0x78, 0xb8, 0xf9, 0xca, 0x62, 0xa9, 0x19, 0x65, 0x59, 0xcc, 0xf5, 0x24, 0x93, 0x42,
+	0x0b, 0x32, 0x28, 0xc9, 0x49, 0xf9, 0xb5, 0xff, 0x7b, 0x15, 0x9a, 0x8b, 0x8d, 0x94, 0x3c, 0x84,
+	0x2a, 0xe3, 0x09, 0xbe, 0xf7, 0xbd, 0x91, 0x37, 0x6e, 0x84, 0x2e, 0x20, 0x3e, 0xd4, 0xe3, 0x24,
+	0x91, 0xa8, 0x94, 0xff, 0xc0, 0xe2, 0xeb, 0x90, 0x3c, 0x86, 0xda, 0x25, 0xb2, 0xf3, 0x0b, 0xed,
+	0xef, 0x8e, 0xbc, 0x71, 0x35, 0x2c, 0x22, 0xf2, 0x04, 0x1a, 0x3f, 0x0b, 0xc6, 0x23, 0xcd, 0x56,
+	0xe8, 0x57, 0x46, 0xde, 0x78, 0x37, 0xdc, 0x33, 0xc0, 0x1b, 0xb6, 0x42, 0xf2, 0x31, 0x34, 0x2d,
+	0x79, 0xe1, 0x32, 0xab, 0x96, 0x06, 0x03, 0x7d, 0xe3, 0xb2, 0x27, 0x30, 0x48, 0x63, 0xa5, 0xa3,
+	0xb2, 0x4f, 0x57, 0xa7, 0x66, 0x85, 0x7d, 0x43, 0x05, 0x6b, 0xc6, 0x16, 0xfc, 0x04, 0xda, 0x1b,
+	0x69, 0x2e, 0x53, 0xbf, 0x6e, 0xbb, 0x6c, 0x95, 0xe0, 0x89, 0x4c, 0xc9, 0x97, 0x50, 0x53, 0x3a,
+	0xd6, 0xb9, 0xf2, 0xf7, 0x46, 0xde, 0xb8, 0xf3, 0xe2, 0xd3, 0xc9, 0xbf, 0x3c, 0xc8, 0x64, 0xeb,
+	0x31, 0x5e, 0x5b, 0x75, 0x58, 0x64, 0x91, 0xa7, 0xd0, 0xa2, 0xa6, 0xeb, 0x65, 0x9c, 0xc6, 0x9c,
+	0xa2, 0xdf, 0xb0, 0xdd, 0x34, 0x0d, 0xf6, 0xca, 0x41, 0xa6, 0x8f, 0x77, 0x71, 0xca, 0x92, 0x58,
+	0x0b, 0x19, 0xbd, 0xc5, 0x2b, 0x1f, 0x5c, 0x1f, 0x25, 0xf8, 0x2d, 0x5e, 0x91, 0x63, 0x18, 0x52,
+	0xc1, 0x15, 0xd2, 0x5c, 0xb3, 0x77, 0x18, 0x31, 0x6e, 0xd9, 0xcd, 0x5d, 0x95, 0xdf, 0xb4, 0x95,
+	0x3f, 0xda, 0x52, 0x05, 0x4e, 0x54, 0xde, 0x5a, 0x91, 0x03, 0xe8, 0x5f, 0x0a, 0xf9, 0x16, 0x65,
+	0x94, 0xe5, 0xcb, 0x94, 0x51, 0x7b, 0x5c, 0xcb, 0x1e, 0xd7, 0x75, 0xc4, 0xc2, 0xe2, 0xe6, 0xc4,
+	0xcf, 0xa0, 0x87, 0x99, 0xa0, 0x17, 0x2a, 0xa2, 0x62, 0x95, 0xa5, 0xa8, 0x31, 0xf1, 0xdb, 0x23,
+	0x6f, 0xdc, 0x0e, 0xbb, 0x0e, 0x9f, 0xae, 0x61, 0x72, 0x0a, 0x03, 0x9a, 0x4b, 0x89, 0x5c, 0x47,
+	0x96, 0x8a, 0xcc, 0xe5, 0x95, 0xdf, 0x19, 0x79, 0xe3, 0xe6, 0x7f, 0xbc, 0xd8, 0xd4, 0xe9, 0x67,
+	0x46, 0x6e, 0x9e, 0x4c, 0x85, 0x7d, 0x7a, 0x17, 0x22, 0x47, 0x50, 0xcf, 0xa4, 0x38, 0x63, 0x29,
+	0xfa, 0x5d, 0x5b, 0xeb, 0xf9, 0xff, 0xbd, 0xfe, 0xc2, 0xc9, 0xc3, 0x75, 0xde, 0xfe, 0xaf, 0xbb,
+	0x40, 0xee, 0xf3, 0x66, 0x2c, 0x09, 0x53, 0x59, 0x1a, 0x5f, 0x45, 0x3c, 0x5e, 0x61, 0x61, 0xdc,
+	0x66, 0x81, 0xcd, 0xe3, 0x15, 0x1a, 0xfb, 0x5e, 0xe2, 0x52, 0x31, 0x8d, 0x6b, 0xfb, 0x16, 0xa1,
+	0x61, 0xa8, 0xe0, 0x3a, 0xa6, 0xce, 0xbf, 0x8d, 0x70, 0x1d, 0x1a, 0x8f, 0x4a, 0x3c, 0x67, 0x82,
+	0x47, 0x54, 0x24, 0xce, 0xc2, 0x8d, 0x10, 0x1c, 0x34, 0x15, 0x09, 0x92, 0x43, 0x18, 0xa8, 0x3c,
+	0xcb, 0x84, 0xd4, 0x98, 0x44, 0x76, 0xc3, 0xa8, 0x48, 0x95, 0x35, 0x73, 0x3b, 0x24, 0x25, 0xb5,
+	0x58, 0x33, 0xe4, 0x05, 0x3c, 0xca, 0x90, 0x27, 0x8c, 0x9f, 0x47, 0xb7, 0xcd, 0x5a, 0xb3, 0xb5,
+	0x07, 0x05, 0x19, 0x6c, 0x7b, 0x76, 0x0a, 0xc3, 0x5b, 0xda, 0x08, 0xcf, 0xce, 0x90, 0x5a, 0xdf,
+	0x14, 0xcb, 0x53, 0xb7, 0x5e, 0x79, 0xb2, 0xed, 0xf4, 0xd9, 0x5a, 0x53, 0x6c, 0xd3, 0x01, 0xd8,
+	0x95, 0x89, 0xf2, 0x2c, 0x89, 0x35, 0xba, 0xb9, 0xda, 0x1d, 0xa8, 0x84, 0x5d, 0x43, 0x9c, 0x58,
+	0xdc, 0x0e, 0x8b, 0x8c, 0xa1, 0xe7, 0x64, 0x2a, 0x62, 0xbc, 0x90, 0x36, 0xec, 0x95, 0x3a, 0x05,
+	0x1e, 0x70, 0xab, 0xdc, 0xff, 0xe3, 0x01, 0xf4, 0xef, 0x8d, 0x9e, 0x3c, 0x87, 0xee, 0xa6, 0x61,
+	0x2a, 0x72, 0xae, 0xed, 0x40, 0x2a, 0x61, 0xa7, 0x84, 0xa7, 0x06, 0x35, 0xc2, 0x15, 0x53, 0x0a,
+	0x93, 0x48, 0xe2, 0x2f, 0x39, 0x2a, 0xed, 0x7e, 0x5a, 0x2a, 0x61, 0xc7, 0xc1, 0x61, 0x81, 0x9a,
+	0xf9, 0x62, 0x2c, 0x39, 0x26, 0x91, 0xd9, 0x34, 0x65, 0xe7, 0x54, 0x09, 0x9b, 0x0e, 0x9b, 0x1a,
+	0x88, 0x3c, 0x83, 0x8e, 0xc4, 0xcb, 0x58, 0x26, 0xa5, 0xa8, 0x62, 0x45, 0xed, 0x35, 0xea, 0x64,
+	0x4f, 0xa1, 0xb5, 0xcc, 0xb7, 0x2a, 0x55, 0x5d, 0x25, 0x87, 0x39, 0xc9, 0xe7, 0xf0, 0xb0, 0xd8,
+	0x55, 0xbc, 0xb5, 0x91, 0x35, 0x2b, 0x1d, 0x94, 0xdc, 0xd6, 0x22, 0xbe, 0x84, 0xc7, 0xc5, 0x0a,
+	0xdf, 0x4d, 0xaa, 0xdb, 0xa4, 0x47, 0x5b, 0xec, 0x26, 0xed, 0xe0, 0x47, 0xe8, 0xdf, 0xfb, 0xa9,
+	0x21, 0x5d, 0x68, 0x9e, 0xcc, 0x5f, 0x2f, 0x66, 0xd3, 0xe0, 0xab, 0x60, 0x76, 0xdc, 0xdb, 0x21,
+	0x00, 0xb5, 0xa3, 0xe9, 0x9b, 0xe0, 0x74, 0xd6, 0xf3, 0x48, 0x0b, 0xf6, 0x82, 0x79, 0x11, 0x3d,
+	0x20, 0x4d, 0xa8, 0x07, 0xf3, 0xd3, 0xa3, 0xef, 0x82, 0xe3, 0xde, 0xae, 0x09, 0xc2, 0xa3, 0xef,
+	0x17, 0xc1, 0xfc, 0xeb, 0x5e, 0xe5, 0xd5, 0x0f, 0x7f, 0x5e, 0x0f, 0xbd, 0x0f, 0xd7, 0x43, 0xef,
+	0xef, 0xeb, 0xa1, 0xf7, 0xdb, 0xcd, 0x70, 0xe7, 0xc3, 0xcd, 0x70, 0xe7, 0xaf, 0x9b, 0xe1, 0xce,
+	0x4f, 0x2f, 0xcf, 0x99, 0xbe, 0xc8, 0x97, 0x13, 0x2a, 0x56, 0x87, 0x99, 0x14, 0x49, 0x4e, 0xb5,
+	0xa2, 0xec, 0xce, 0x1f, 0xc7, 0xfb, 0xad, 0x6f, 0x7d, 0x95, 0xa1, 0x5a, 0xd6, 0xac, 0xbb, 0xbf,
+	0xf8, 0x27, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x54, 0xc5, 0xd1, 0x68, 0x06, 0x00, 0x00,
 }
 
 func (m *Participant) Marshal() (dAtA []byte, err error) {
@@ -371,6 +505,18 @@ func (m *Participant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Profile != nil {
+		{
+			size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParticipant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.CurrentEpochStats != nil {
 		{
 			size, err := m.CurrentEpochStats.MarshalToSizedBuffer(dAtA[:i])
@@ -461,6 +607,84 @@ func (m *Participant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParticipantProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatesInEpoch != 0 {
+		i = encodeVarintParticipant(dAtA, i, uint64(m.UpdatesInEpoch))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastUpdateEpoch != 0 {
+		i = encodeVarintParticipant(dAtA, i, uint64(m.LastUpdateEpoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.InferenceUrlEffectiveHeight != 0 {
+		i = encodeVarintParticipant(dAtA, i, uint64(m.InferenceUrlEffectiveHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PendingInferenceUrl) > 0 {
+		i -= len(m.PendingInferenceUrl)
+		copy(dAtA[i:], m.PendingInferenceUrl)
+		i = encodeVarintParticipant(dAtA, i, uint64(len(m.PendingInferenceUrl)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SupportedProtocols != 0 {
+		i = encodeVarintParticipant(dAtA, i, uint64(m.SupportedProtocols))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RegionCode) > 0 {
+		i -= len(m.RegionCode)
+		copy(dAtA[i:], m.RegionCode)
+		i = encodeVarintParticipant(dAtA, i, uint64(len(m.RegionCode)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contact) > 0 {
+		i -= len(m.Contact)
+		copy(dAtA[i:], m.Contact)
+		i = encodeVarintParticipant(dAtA, i, uint64(len(m.Contact)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintParticipant(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = encodeVarintParticipant(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CurrentEpochStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -584,6 +808,51 @@ func (m *Participant) Size() (n int) {
 		l = m.CurrentEpochStats.Size()
 		n += 1 + l + sovParticipant(uint64(l))
 	}
+	if m.Profile != nil {
+		l = m.Profile.Size()
+		n += 1 + l + sovParticipant(uint64(l))
+	}
+	return n
+}
+
+func (m *ParticipantProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DisplayName)
+	if l > 0 {
+		n += 1 + l + sovParticipant(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovParticipant(uint64(l))
+	}
+	l = len(m.Contact)
+	if l > 0 {
+		n += 1 + l + sovParticipant(uint64(l))
+	}
+	l = len(m.RegionCode)
+	if l > 0 {
+		n += 1 + l + sovParticipant(uint64(l))
+	}
+	if m.SupportedProtocols != 0 {
+		n += 1 + sovParticipant(uint64(m.SupportedProtocols))
+	}
+	l = len(m.PendingInferenceUrl)
+	if l > 0 {
+		n += 1 + l + sovParticipant(uint64(l))
+	}
+	if m.InferenceUrlEffectiveHeight != 0 {
+		n += 1 + sovParticipant(uint64(m.InferenceUrlEffectiveHeight))
+	}
+	if m.LastUpdateEpoch != 0 {
+		n += 1 + sovParticipant(uint64(m.LastUpdateEpoch))
+	}
+	if m.UpdatesInEpoch != 0 {
+		n += 1 + sovParticipant(uint64(m.UpdatesInEpoch))
+	}
 	return n
 }
 
@@ -1000,6 +1269,328 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profile == nil {
+				m.Profile = &ParticipantProfile{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegionCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportedProtocols", wireType)
+			}
+			m.SupportedProtocols = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupportedProtocols |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingInferenceUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingInferenceUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceUrlEffectiveHeight", wireType)
+			}
+			m.InferenceUrlEffectiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InferenceUrlEffectiveHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateEpoch", wireType)
+			}
+			m.LastUpdateEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatesInEpoch", wireType)
+			}
+			m.UpdatesInEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatesInEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParticipant(dAtA[iNdEx:])
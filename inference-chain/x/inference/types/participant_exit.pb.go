@@ -0,0 +1,498 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/inference/participant_exit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ParticipantExit records a participant leaving the network. The participant serves through exit_epoch, is paid for
+// it by the regular settlement and is not carried into the next epoch.
+type ParticipantExit struct {
+	Participant     string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	ExitEpoch       uint64 `protobuf:"varint,2,opt,name=exit_epoch,json=exitEpoch,proto3" json:"exit_epoch,omitempty"`
+	RequestedHeight int64  `protobuf:"varint,3,opt,name=requested_height,json=requestedHeight,proto3" json:"requested_height,omitempty"`
+	// completed is set once exit_epoch has been settled and the collateral unbonding started
+	Completed          bool       `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	UnbondedCollateral types.Coin `protobuf:"bytes,5,opt,name=unbonded_collateral,json=unbondedCollateral,proto3" json:"unbonded_collateral"`
+}
+
+func (m *ParticipantExit) Reset()         { *m = ParticipantExit{} }
+func (m *ParticipantExit) String() string { return proto.CompactTextString(m) }
+func (*ParticipantExit) ProtoMessage()    {}
+func (*ParticipantExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e69ff957ddba876, []int{0}
+}
+func (m *ParticipantExit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantExit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantExit.Merge(m, src)
+}
+func (m *ParticipantExit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantExit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantExit proto.InternalMessageInfo
+
+func (m *ParticipantExit) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *ParticipantExit) GetExitEpoch() uint64 {
+	if m != nil {
+		return m.ExitEpoch
+	}
+	return 0
+}
+
+func (m *ParticipantExit) GetRequestedHeight() int64 {
+	if m != nil {
+		return m.RequestedHeight
+	}
+	return 0
+}
+
+func (m *ParticipantExit) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+func (m *ParticipantExit) GetUnbondedCollateral() types.Coin {
+	if m != nil {
+		return m.UnbondedCollateral
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*ParticipantExit)(nil), "inference.inference.ParticipantExit")
+}
+
+func init() {
+	proto.RegisterFile("inference/inference/participant_exit.proto", fileDescriptor_6e69ff957ddba876)
+}
+
+var fileDescriptor_6e69ff957ddba876 = []byte{
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xb1, 0x4e, 0x83, 0x40,
+	0x18, 0xc7, 0x39, 0x5b, 0x8d, 0x5c, 0x87, 0x2a, 0x75, 0xc0, 0x46, 0x91, 0x38, 0x61, 0x07, 0x48,
+	0x35, 0xbe, 0x40, 0x9b, 0x26, 0x6e, 0x1a, 0x12, 0x17, 0x17, 0x02, 0xc7, 0x27, 0x5c, 0x02, 0xf7,
+	0x21, 0x1c, 0xa6, 0xbe, 0x85, 0x8f, 0xe1, 0xe8, 0x63, 0x74, 0xec, 0xe8, 0x64, 0x4c, 0x3b, 0xb8,
+	0xfb, 0x04, 0x06, 0x5a, 0x69, 0xe3, 0x72, 0xf9, 0xe5, 0x77, 0xdf, 0xdd, 0x7d, 0xff, 0xfb, 0xe8,
+	0x80, 0x8b, 0x47, 0xc8, 0x41, 0x30, 0x70, 0x36, 0x94, 0xf9, 0xb9, 0xe4, 0x8c, 0x67, 0xbe, 0x90,
+	0x1e, 0x4c, 0xb9, 0xb4, 0xb3, 0x1c, 0x25, 0x6a, 0xbd, 0xa6, 0xc2, 0x6e, 0xa8, 0x7f, 0xe8, 0xa7,
+	0x5c, 0xa0, 0x53, 0xaf, 0xab, 0xba, 0xfe, 0x51, 0x84, 0x11, 0xd6, 0xe8, 0x54, 0xb4, 0xb6, 0x06,
+	0xc3, 0x22, 0xc5, 0xc2, 0x09, 0xfc, 0x02, 0x9c, 0xe7, 0x61, 0x00, 0xd2, 0x1f, 0x3a, 0x0c, 0xb9,
+	0x58, 0xed, 0x9f, 0xff, 0x10, 0xda, 0xbd, 0xdb, 0x3c, 0x3c, 0x99, 0x72, 0xa9, 0x99, 0xb4, 0xb3,
+	0xd5, 0x8b, 0x4e, 0x4c, 0x62, 0xa9, 0xee, 0xb6, 0xd2, 0x4e, 0x29, 0xad, 0x3a, 0xf4, 0x20, 0x43,
+	0x16, 0xeb, 0x3b, 0x26, 0xb1, 0xda, 0xae, 0x5a, 0x99, 0x49, 0x25, 0xb4, 0x0b, 0x7a, 0x90, 0xc3,
+	0x53, 0x09, 0x85, 0x84, 0xd0, 0x8b, 0x81, 0x47, 0xb1, 0xd4, 0x5b, 0x26, 0xb1, 0x5a, 0x6e, 0xb7,
+	0xf1, 0x37, 0xb5, 0xd6, 0x4e, 0xa8, 0xca, 0x30, 0xcd, 0x12, 0x90, 0x10, 0xea, 0x6d, 0x93, 0x58,
+	0xfb, 0xee, 0x46, 0x68, 0xf7, 0xb4, 0x57, 0x8a, 0x00, 0x45, 0x08, 0xa1, 0xc7, 0x30, 0x49, 0x7c,
+	0x09, 0xb9, 0x9f, 0xe8, 0xbb, 0x26, 0xb1, 0x3a, 0x97, 0xc7, 0xf6, 0x2a, 0x9b, 0x5d, 0x65, 0xb3,
+	0xd7, 0xd9, 0xec, 0x31, 0x72, 0x31, 0x52, 0x67, 0x9f, 0x67, 0xca, 0xdb, 0xf7, 0xfb, 0x80, 0xb8,
+	0xda, 0xdf, 0x05, 0xe3, 0xe6, 0xfc, 0xe8, 0x76, 0xb6, 0x30, 0xc8, 0x7c, 0x61, 0x90, 0xaf, 0x85,
+	0x41, 0x5e, 0x97, 0x86, 0x32, 0x5f, 0x1a, 0xca, 0xc7, 0xd2, 0x50, 0x1e, 0xae, 0x23, 0x2e, 0xe3,
+	0x32, 0xb0, 0x19, 0xa6, 0x4e, 0x96, 0x63, 0x58, 0x32, 0x59, 0x30, 0xfe, 0x6f, 0x50, 0xd3, 0x2d,
+	0x96, 0x2f, 0x19, 0x14, 0xc1, 0x5e, 0xfd, 0x99, 0x57, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x79,
+	0x51, 0x08, 0x51, 0xd8, 0x01, 0x00, 0x00,
+}
+
+func (m *ParticipantExit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantExit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnbondedCollateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParticipantExit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RequestedHeight != 0 {
+		i = encodeVarintParticipantExit(dAtA, i, uint64(m.RequestedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExitEpoch != 0 {
+		i = encodeVarintParticipantExit(dAtA, i, uint64(m.ExitEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintParticipantExit(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParticipantExit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParticipantExit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParticipantExit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovParticipantExit(uint64(l))
+	}
+	if m.ExitEpoch != 0 {
+		n += 1 + sovParticipantExit(uint64(m.ExitEpoch))
+	}
+	if m.RequestedHeight != 0 {
+		n += 1 + sovParticipantExit(uint64(m.RequestedHeight))
+	}
+	if m.Completed {
+		n += 2
+	}
+	l = m.UnbondedCollateral.Size()
+	n += 1 + l + sovParticipantExit(uint64(l))
+	return n
+}
+
+func sovParticipantExit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParticipantExit(x uint64) (n int) {
+	return sovParticipantExit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParticipantExit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipantExit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantExit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantExit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantExit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipantExit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantExit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitEpoch", wireType)
+			}
+			m.ExitEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantExit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedHeight", wireType)
+			}
+			m.RequestedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantExit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantExit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondedCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantExit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipantExit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantExit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondedCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipantExit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipantExit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParticipantExit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParticipantExit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParticipantExit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParticipantExit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParticipantExit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParticipantExit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParticipantExit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParticipantExit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParticipantExit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParticipantExit = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/productscience/inference/x/inference/utils"
+)
+
+// Bits of ParticipantProfile.SupportedProtocols
+const (
+	ProtocolChatCompletions uint32 = 1 << iota
+	ProtocolStreaming
+	ProtocolToolCalls
+	ProtocolLogprobs
+
+	AllProtocols = ProtocolChatCompletions | ProtocolStreaming | ProtocolToolCalls | ProtocolLogprobs
+)
+
+const (
+	MaxProfileDisplayNameLength = 64
+	MaxProfileWebsiteLength     = 256
+	MaxProfileContactLength     = 128
+)
+
+var regionCodeRegexp = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// ValidateProfileFields checks the public metadata of a profile. All fields are optional.
+func ValidateProfileFields(displayName, website, contact, regionCode string, supportedProtocols uint32) error {
+	if len(displayName) > MaxProfileDisplayNameLength {
+		return errorsmod.Wrapf(ErrInvalidParticipantProfile, "display name longer than %d", MaxProfileDisplayNameLength)
+	}
+	if len(website) > MaxProfileWebsiteLength {
+		return errorsmod.Wrapf(ErrInvalidParticipantProfile, "website longer than %d", MaxProfileWebsiteLength)
+	}
+	if strings.TrimSpace(website) != "" {
+		if err := utils.ValidateURL("website", website); err != nil {
+			return errorsmod.Wrap(ErrInvalidParticipantProfile, err.Error())
+		}
+	}
+	if len(contact) > MaxProfileContactLength {
+		return errorsmod.Wrapf(ErrInvalidParticipantProfile, "contact longer than %d", MaxProfileContactLength)
+	}
+	if regionCode != "" && !regionCodeRegexp.MatchString(regionCode) {
+		return errorsmod.Wrapf(ErrInvalidParticipantProfile, "region code %q is not an ISO 3166-1 alpha-2 code", regionCode)
+	}
+	if supportedProtocols&^AllProtocols != 0 {
+		return errorsmod.Wrapf(ErrInvalidParticipantProfile, "unknown protocol flags %#x", supportedProtocols&^AllProtocols)
+	}
+	return nil
+}
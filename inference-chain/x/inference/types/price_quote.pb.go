@@ -0,0 +1,592 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/inference/price_quote.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PriceQuote locks the per-token price of a model for a developer, for a limited number of blocks and tokens.
+type PriceQuote struct {
+	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Developer     string `protobuf:"bytes,2,opt,name=developer,proto3" json:"developer,omitempty"`
+	ModelId       string `protobuf:"bytes,3,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	PerTokenPrice uint64 `protobuf:"varint,4,opt,name=per_token_price,json=perTokenPrice,proto3" json:"per_token_price,omitempty"`
+	// token_budget is the total of prompt and completion tokens inferences can use at the quoted price
+	TokenBudget uint64 `protobuf:"varint,5,opt,name=token_budget,json=tokenBudget,proto3" json:"token_budget,omitempty"`
+	// tokens_reserved are held by inferences using the quote: prompt and max tokens while running,
+	// the tokens actually used once finished
+	TokensReserved uint64 `protobuf:"varint,6,opt,name=tokens_reserved,json=tokensReserved,proto3" json:"tokens_reserved,omitempty"`
+	CreatedHeight  int64  `protobuf:"varint,7,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// expiration_height is the last height inferences can start with the quote
+	ExpirationHeight int64 `protobuf:"varint,8,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *PriceQuote) Reset()         { *m = PriceQuote{} }
+func (m *PriceQuote) String() string { return proto.CompactTextString(m) }
+func (*PriceQuote) ProtoMessage()    {}
+func (*PriceQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_972b5041472cd511, []int{0}
+}
+func (m *PriceQuote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceQuote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceQuote.Merge(m, src)
+}
+func (m *PriceQuote) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceQuote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceQuote proto.InternalMessageInfo
+
+func (m *PriceQuote) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PriceQuote) GetDeveloper() string {
+	if m != nil {
+		return m.Developer
+	}
+	return ""
+}
+
+func (m *PriceQuote) GetModelId() string {
+	if m != nil {
+		return m.ModelId
+	}
+	return ""
+}
+
+func (m *PriceQuote) GetPerTokenPrice() uint64 {
+	if m != nil {
+		return m.PerTokenPrice
+	}
+	return 0
+}
+
+func (m *PriceQuote) GetTokenBudget() uint64 {
+	if m != nil {
+		return m.TokenBudget
+	}
+	return 0
+}
+
+func (m *PriceQuote) GetTokensReserved() uint64 {
+	if m != nil {
+		return m.TokensReserved
+	}
+	return 0
+}
+
+func (m *PriceQuote) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *PriceQuote) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PriceQuote)(nil), "inference.inference.PriceQuote")
+}
+
+func init() {
+	proto.RegisterFile("inference/inference/price_quote.proto", fileDescriptor_972b5041472cd511)
+}
+
+var fileDescriptor_972b5041472cd511 = []byte{
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcd, 0x4e, 0x32, 0x31,
+	0x14, 0x86, 0xe9, 0xc0, 0xc7, 0x4f, 0x3f, 0x01, 0xad, 0x9b, 0x9a, 0x98, 0x06, 0x4d, 0x50, 0x12,
+	0x13, 0x58, 0x18, 0x6f, 0x80, 0x95, 0xae, 0xd4, 0x89, 0x2b, 0x37, 0x0d, 0xb4, 0x47, 0x68, 0x84,
+	0x69, 0xed, 0x74, 0x08, 0xde, 0x85, 0x37, 0xe0, 0xfd, 0xb8, 0x64, 0xe9, 0xd2, 0xc0, 0x8d, 0x18,
+	0x0e, 0xc8, 0x18, 0x77, 0xef, 0x79, 0xde, 0x27, 0xed, 0x49, 0x0e, 0x6d, 0x9b, 0xe4, 0x09, 0x3c,
+	0x24, 0x0a, 0x7a, 0x79, 0x72, 0xde, 0x28, 0x90, 0x2f, 0x99, 0x0d, 0xd0, 0x75, 0xde, 0x06, 0xcb,
+	0x0e, 0x77, 0x65, 0x77, 0x97, 0x4e, 0xdf, 0x23, 0x4a, 0xef, 0xd6, 0xea, 0xfd, 0xda, 0x64, 0x0d,
+	0x1a, 0x19, 0xcd, 0x49, 0x8b, 0x74, 0x4a, 0x71, 0x64, 0x34, 0x3b, 0xa6, 0x35, 0x0d, 0x33, 0x98,
+	0x58, 0x07, 0x9e, 0x47, 0x2d, 0xd2, 0xa9, 0xc5, 0x39, 0x60, 0x47, 0xb4, 0x3a, 0xb5, 0x1a, 0x26,
+	0xd2, 0x68, 0x5e, 0xc4, 0xb2, 0x82, 0xf3, 0x8d, 0x66, 0x67, 0xb4, 0xe9, 0xc0, 0xcb, 0x60, 0x9f,
+	0x21, 0x91, 0xb8, 0x0b, 0x2f, 0xe1, 0xab, 0x75, 0x07, 0xfe, 0x61, 0x4d, 0xf1, 0x57, 0x76, 0x42,
+	0xf7, 0x36, 0xce, 0x30, 0xd3, 0x23, 0x08, 0xfc, 0x1f, 0x4a, 0xff, 0x91, 0xf5, 0x11, 0xb1, 0x73,
+	0xda, 0xc4, 0x31, 0x95, 0x1e, 0x52, 0xf0, 0x33, 0xd0, 0xbc, 0x8c, 0x56, 0x63, 0x83, 0xe3, 0x2d,
+	0x65, 0x6d, 0xda, 0x50, 0x1e, 0x06, 0x01, 0xb4, 0x1c, 0x83, 0x19, 0x8d, 0x03, 0xaf, 0xb4, 0x48,
+	0xa7, 0x18, 0xd7, 0xb7, 0xf4, 0x1a, 0x21, 0xbb, 0xa0, 0x07, 0x30, 0x77, 0xc6, 0x0f, 0x82, 0xb1,
+	0xc9, 0x8f, 0x59, 0x45, 0x73, 0x3f, 0x2f, 0x36, 0x72, 0xff, 0xf6, 0x63, 0x29, 0xc8, 0x62, 0x29,
+	0xc8, 0xd7, 0x52, 0x90, 0xb7, 0x95, 0x28, 0x2c, 0x56, 0xa2, 0xf0, 0xb9, 0x12, 0x85, 0xc7, 0xab,
+	0x91, 0x09, 0xe3, 0x6c, 0xd8, 0x55, 0x76, 0xda, 0x73, 0xde, 0xea, 0x4c, 0x85, 0x54, 0x99, 0x3f,
+	0x57, 0x98, 0xff, 0xca, 0xe1, 0xd5, 0x41, 0x3a, 0x2c, 0xe3, 0x31, 0x2e, 0xbf, 0x03, 0x00, 0x00,
+	0xff, 0xff, 0x75, 0x02, 0xa0, 0x21, 0xb5, 0x01, 0x00, 0x00,
+}
+
+func (m *PriceQuote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceQuote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceQuote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintPriceQuote(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintPriceQuote(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TokensReserved != 0 {
+		i = encodeVarintPriceQuote(dAtA, i, uint64(m.TokensReserved))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TokenBudget != 0 {
+		i = encodeVarintPriceQuote(dAtA, i, uint64(m.TokenBudget))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PerTokenPrice != 0 {
+		i = encodeVarintPriceQuote(dAtA, i, uint64(m.PerTokenPrice))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ModelId) > 0 {
+		i -= len(m.ModelId)
+		copy(dAtA[i:], m.ModelId)
+		i = encodeVarintPriceQuote(dAtA, i, uint64(len(m.ModelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Developer) > 0 {
+		i -= len(m.Developer)
+		copy(dAtA[i:], m.Developer)
+		i = encodeVarintPriceQuote(dAtA, i, uint64(len(m.Developer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPriceQuote(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPriceQuote(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPriceQuote(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PriceQuote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPriceQuote(uint64(m.Id))
+	}
+	l = len(m.Developer)
+	if l > 0 {
+		n += 1 + l + sovPriceQuote(uint64(l))
+	}
+	l = len(m.ModelId)
+	if l > 0 {
+		n += 1 + l + sovPriceQuote(uint64(l))
+	}
+	if m.PerTokenPrice != 0 {
+		n += 1 + sovPriceQuote(uint64(m.PerTokenPrice))
+	}
+	if m.TokenBudget != 0 {
+		n += 1 + sovPriceQuote(uint64(m.TokenBudget))
+	}
+	if m.TokensReserved != 0 {
+		n += 1 + sovPriceQuote(uint64(m.TokensReserved))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovPriceQuote(uint64(m.CreatedHeight))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovPriceQuote(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func sovPriceQuote(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPriceQuote(x uint64) (n int) {
+	return sovPriceQuote(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PriceQuote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceQuote
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceQuote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceQuote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceQuote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Developer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceQuote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceQuote
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceQuote
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Developer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceQuote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceQuote
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceQuote
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerTokenPrice", wireType)
+			}
+			m.PerTokenPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceQuote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerTokenPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenBudget", wireType)
+			}
+			m.TokenBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceQuote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensReserved", wireType)
+			}
+			m.TokensReserved = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceQuote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokensReserved |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceQuote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceQuote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceQuote(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceQuote
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPriceQuote(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPriceQuote
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceQuote
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceQuote
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPriceQuote
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPriceQuote
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPriceQuote
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPriceQuote        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPriceQuote          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPriceQuote = fmt.Errorf("proto: unexpected end of group")
+)
@@ -23,9 +23,11 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type PruningState struct {
-	PocBatchesPrunedEpoch     int64 `protobuf:"varint,1,opt,name=poc_batches_pruned_epoch,json=pocBatchesPrunedEpoch,proto3" json:"poc_batches_pruned_epoch,omitempty"`
-	PocValidationsPrunedEpoch int64 `protobuf:"varint,2,opt,name=poc_validations_pruned_epoch,json=pocValidationsPrunedEpoch,proto3" json:"poc_validations_pruned_epoch,omitempty"`
-	InferencePrunedEpoch      int64 `protobuf:"varint,3,opt,name=inference_pruned_epoch,json=inferencePrunedEpoch,proto3" json:"inference_pruned_epoch,omitempty"`
+	PocBatchesPrunedEpoch           int64 `protobuf:"varint,1,opt,name=poc_batches_pruned_epoch,json=pocBatchesPrunedEpoch,proto3" json:"poc_batches_pruned_epoch,omitempty"`
+	PocValidationsPrunedEpoch       int64 `protobuf:"varint,2,opt,name=poc_validations_pruned_epoch,json=pocValidationsPrunedEpoch,proto3" json:"poc_validations_pruned_epoch,omitempty"`
+	InferencePrunedEpoch            int64 `protobuf:"varint,3,opt,name=inference_pruned_epoch,json=inferencePrunedEpoch,proto3" json:"inference_pruned_epoch,omitempty"`
+	ValidatorEpochStatsPrunedEpoch  int64 `protobuf:"varint,4,opt,name=validator_epoch_stats_pruned_epoch,json=validatorEpochStatsPrunedEpoch,proto3" json:"validator_epoch_stats_pruned_epoch,omitempty"`
+	ValidatorTargetStatsPrunedEpoch int64 `protobuf:"varint,5,opt,name=validator_target_stats_pruned_epoch,json=validatorTargetStatsPrunedEpoch,proto3" json:"validator_target_stats_pruned_epoch,omitempty"`
 }
 
 func (m *PruningState) Reset()         { *m = PruningState{} }
@@ -82,6 +84,20 @@ func (m *PruningState) GetInferencePrunedEpoch() int64 {
 	return 0
 }
 
+func (m *PruningState) GetValidatorEpochStatsPrunedEpoch() int64 {
+	if m != nil {
+		return m.ValidatorEpochStatsPrunedEpoch
+	}
+	return 0
+}
+
+func (m *PruningState) GetValidatorTargetStatsPrunedEpoch() int64 {
+	if m != nil {
+		return m.ValidatorTargetStatsPrunedEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PruningState)(nil), "inference.inference.PruningState")
 }
@@ -91,22 +107,25 @@ func init() {
 }
 
 var fileDescriptor_f3e942f788f930ee = []byte{
-	// 232 bytes of a gzipped FileDescriptorProto
+	// 280 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcf, 0xcc, 0x4b, 0x4b,
 	0x2d, 0x4a, 0xcd, 0x4b, 0x4e, 0xd5, 0x47, 0xb0, 0x0a, 0x8a, 0x4a, 0xf3, 0x32, 0xf3, 0xd2, 0xe3,
 	0x8b, 0x4b, 0x12, 0x4b, 0x52, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x84, 0xe1, 0xd2, 0x7a,
-	0x70, 0x96, 0xd2, 0x3e, 0x46, 0x2e, 0x9e, 0x00, 0x88, 0xe2, 0x60, 0x90, 0x5a, 0x21, 0x73, 0x2e,
+	0x70, 0x96, 0xd2, 0x0d, 0x26, 0x2e, 0x9e, 0x00, 0x88, 0xe2, 0x60, 0x90, 0x5a, 0x21, 0x73, 0x2e,
 	0x89, 0x82, 0xfc, 0xe4, 0xf8, 0xa4, 0xc4, 0x92, 0xe4, 0x8c, 0xd4, 0xe2, 0x78, 0x90, 0x41, 0xa9,
 	0x29, 0xf1, 0xa9, 0x05, 0xf9, 0xc9, 0x19, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0xa2, 0x05,
 	0xf9, 0xc9, 0x4e, 0x10, 0xe9, 0x00, 0xb0, 0xac, 0x2b, 0x48, 0x52, 0xc8, 0x9e, 0x4b, 0x06, 0xa4,
 	0xb1, 0x2c, 0x31, 0x27, 0x33, 0x25, 0xb1, 0x24, 0x33, 0x3f, 0x0f, 0x4d, 0x33, 0x13, 0x58, 0xb3,
 	0x64, 0x41, 0x7e, 0x72, 0x18, 0x42, 0x09, 0xb2, 0x01, 0x26, 0x5c, 0x62, 0x70, 0x77, 0xa1, 0x6a,
-	0x65, 0x06, 0x6b, 0x15, 0x81, 0xcb, 0x22, 0xe9, 0x72, 0xf2, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
-	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc6, 0x63, 0x39, 0x86, 0x28, 0xd3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
-	0xfd, 0x82, 0xa2, 0xfc, 0x94, 0xd2, 0xe4, 0x92, 0xe2, 0xe4, 0x4c, 0xb4, 0x80, 0xaa, 0x40, 0x62,
-	0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x43, 0xcb, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0x7a, 0xfb, 0x5d, 0x3e, 0x58, 0x01, 0x00, 0x00,
+	0x65, 0x06, 0x6b, 0x15, 0x81, 0xcb, 0x22, 0xeb, 0xf2, 0xe2, 0x52, 0x82, 0x5a, 0x99, 0x5f, 0x04,
+	0x51, 0x0e, 0xf6, 0x34, 0x9a, 0xe5, 0x2c, 0x60, 0x13, 0xe4, 0xe0, 0x2a, 0xc1, 0x7a, 0x41, 0x1e,
+	0x46, 0x71, 0x81, 0x0f, 0x97, 0x32, 0xc2, 0xac, 0x92, 0xc4, 0xa2, 0xf4, 0xd4, 0x12, 0x6c, 0x86,
+	0xb1, 0x82, 0x0d, 0x93, 0x87, 0x2b, 0x0d, 0x01, 0xab, 0x44, 0x37, 0xcd, 0xc9, 0xff, 0xc4, 0x23,
+	0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2,
+	0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4,
+	0x92, 0xf3, 0x73, 0xf5, 0x0b, 0x8a, 0xf2, 0x53, 0x4a, 0x93, 0x4b, 0x8a, 0x93, 0x33, 0xd1, 0xa2,
+	0xb0, 0x02, 0x89, 0x5d, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x8e, 0x47, 0x63, 0xc0, 0x00,
+	0x99, 0x3b, 0x37, 0x69, 0xf2, 0x01, 0x00, 0x00,
 }
 
 func (m *PruningState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +148,16 @@ func (m *PruningState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ValidatorTargetStatsPrunedEpoch != 0 {
+		i = encodeVarintPruningState(dAtA, i, uint64(m.ValidatorTargetStatsPrunedEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ValidatorEpochStatsPrunedEpoch != 0 {
+		i = encodeVarintPruningState(dAtA, i, uint64(m.ValidatorEpochStatsPrunedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.InferencePrunedEpoch != 0 {
 		i = encodeVarintPruningState(dAtA, i, uint64(m.InferencePrunedEpoch))
 		i--
@@ -173,6 +202,12 @@ func (m *PruningState) Size() (n int) {
 	if m.InferencePrunedEpoch != 0 {
 		n += 1 + sovPruningState(uint64(m.InferencePrunedEpoch))
 	}
+	if m.ValidatorEpochStatsPrunedEpoch != 0 {
+		n += 1 + sovPruningState(uint64(m.ValidatorEpochStatsPrunedEpoch))
+	}
+	if m.ValidatorTargetStatsPrunedEpoch != 0 {
+		n += 1 + sovPruningState(uint64(m.ValidatorTargetStatsPrunedEpoch))
+	}
 	return n
 }
 
@@ -268,6 +303,44 @@ func (m *PruningState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorEpochStatsPrunedEpoch", wireType)
+			}
+			m.ValidatorEpochStatsPrunedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruningState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorEpochStatsPrunedEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorTargetStatsPrunedEpoch", wireType)
+			}
+			m.ValidatorTargetStatsPrunedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruningState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorTargetStatsPrunedEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPruningState(dAtA[iNdEx:])
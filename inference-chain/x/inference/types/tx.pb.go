@@ -165,6 +165,10 @@ type MsgStartInference struct {
 	RequestTimestamp  int64  `protobuf:"varint,12,opt,name=request_timestamp,json=requestTimestamp,proto3" json:"request_timestamp,omitempty"`
 	TransferSignature string `protobuf:"bytes,14,opt,name=transfer_signature,json=transferSignature,proto3" json:"transfer_signature,omitempty"`
 	OriginalPrompt    string `protobuf:"bytes,15,opt,name=original_prompt,json=originalPrompt,proto3" json:"original_prompt,omitempty"`
+	// requester_key is the inference key that signed for requested_by, empty if requested_by signed itself
+	RequesterKey string `protobuf:"bytes,16,opt,name=requester_key,json=requesterKey,proto3" json:"requester_key,omitempty"`
+	// price_quote_id charges the inference at the price of a quote of requested_by, 0 for the current price
+	PriceQuoteId uint64 `protobuf:"varint,17,opt,name=price_quote_id,json=priceQuoteId,proto3" json:"price_quote_id,omitempty"`
 }
 
 func (m *MsgStartInference) Reset()         { *m = MsgStartInference{} }
@@ -291,6 +295,20 @@ func (m *MsgStartInference) GetOriginalPrompt() string {
 	return ""
 }
 
+func (m *MsgStartInference) GetRequesterKey() string {
+	if m != nil {
+		return m.RequesterKey
+	}
+	return ""
+}
+
+func (m *MsgStartInference) GetPriceQuoteId() uint64 {
+	if m != nil {
+		return m.PriceQuoteId
+	}
+	return 0
+}
+
 type MsgStartInferenceResponse struct {
 	InferenceIndex string `protobuf:"bytes,1,opt,name=inference_index,json=inferenceIndex,proto3" json:"inference_index,omitempty"`
 }
@@ -350,6 +368,7 @@ type MsgFinishInference struct {
 	RequestedBy          string `protobuf:"bytes,12,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
 	OriginalPrompt       string `protobuf:"bytes,13,opt,name=original_prompt,json=originalPrompt,proto3" json:"original_prompt,omitempty"`
 	Model                string `protobuf:"bytes,14,opt,name=model,proto3" json:"model,omitempty"`
+	RequesterKey         string `protobuf:"bytes,15,opt,name=requester_key,json=requesterKey,proto3" json:"requester_key,omitempty"`
 }
 
 func (m *MsgFinishInference) Reset()         { *m = MsgFinishInference{} }
@@ -483,6 +502,13 @@ func (m *MsgFinishInference) GetModel() string {
 	return ""
 }
 
+func (m *MsgFinishInference) GetRequesterKey() string {
+	if m != nil {
+		return m.RequesterKey
+	}
+	return ""
+}
+
 type MsgFinishInferenceResponse struct {
 	InferenceIndex string `protobuf:"bytes,1,opt,name=inference_index,json=inferenceIndex,proto3" json:"inference_index,omitempty"`
 }
@@ -3350,2896 +3376,2817 @@ func (m *MsgSetTrainingAllowListResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetTrainingAllowListResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("inference.inference.TrainingRole", TrainingRole_name, TrainingRole_value)
-	proto.RegisterType((*MsgUpdateParams)(nil), "inference.inference.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "inference.inference.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgStartInference)(nil), "inference.inference.MsgStartInference")
-	proto.RegisterType((*MsgStartInferenceResponse)(nil), "inference.inference.MsgStartInferenceResponse")
-	proto.RegisterType((*MsgFinishInference)(nil), "inference.inference.MsgFinishInference")
-	proto.RegisterType((*MsgFinishInferenceResponse)(nil), "inference.inference.MsgFinishInferenceResponse")
-	proto.RegisterType((*MsgSubmitNewParticipant)(nil), "inference.inference.MsgSubmitNewParticipant")
-	proto.RegisterType((*MsgSubmitNewParticipantResponse)(nil), "inference.inference.MsgSubmitNewParticipantResponse")
-	proto.RegisterType((*MsgValidation)(nil), "inference.inference.MsgValidation")
-	proto.RegisterType((*MsgValidationResponse)(nil), "inference.inference.MsgValidationResponse")
-	proto.RegisterType((*MsgSubmitNewUnfundedParticipant)(nil), "inference.inference.MsgSubmitNewUnfundedParticipant")
-	proto.RegisterType((*MsgSubmitNewUnfundedParticipantResponse)(nil), "inference.inference.MsgSubmitNewUnfundedParticipantResponse")
-	proto.RegisterType((*MsgInvalidateInference)(nil), "inference.inference.MsgInvalidateInference")
-	proto.RegisterType((*MsgInvalidateInferenceResponse)(nil), "inference.inference.MsgInvalidateInferenceResponse")
-	proto.RegisterType((*MsgRevalidateInference)(nil), "inference.inference.MsgRevalidateInference")
-	proto.RegisterType((*MsgRevalidateInferenceResponse)(nil), "inference.inference.MsgRevalidateInferenceResponse")
-	proto.RegisterType((*MsgClaimRewards)(nil), "inference.inference.MsgClaimRewards")
-	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "inference.inference.MsgClaimRewardsResponse")
-	proto.RegisterType((*MsgSubmitPocBatch)(nil), "inference.inference.MsgSubmitPocBatch")
-	proto.RegisterType((*MsgSubmitPocBatchResponse)(nil), "inference.inference.MsgSubmitPocBatchResponse")
-	proto.RegisterType((*MsgSubmitPocValidation)(nil), "inference.inference.MsgSubmitPocValidation")
-	proto.RegisterType((*MsgSubmitPocValidationResponse)(nil), "inference.inference.MsgSubmitPocValidationResponse")
-	proto.RegisterType((*MsgSubmitSeed)(nil), "inference.inference.MsgSubmitSeed")
-	proto.RegisterType((*MsgSubmitSeedResponse)(nil), "inference.inference.MsgSubmitSeedResponse")
-	proto.RegisterType((*MsgSubmitUnitOfComputePriceProposal)(nil), "inference.inference.MsgSubmitUnitOfComputePriceProposal")
-	proto.RegisterType((*MsgSubmitUnitOfComputePriceProposalResponse)(nil), "inference.inference.MsgSubmitUnitOfComputePriceProposalResponse")
-	proto.RegisterType((*MsgRegisterModel)(nil), "inference.inference.MsgRegisterModel")
-	proto.RegisterType((*MsgRegisterModelResponse)(nil), "inference.inference.MsgRegisterModelResponse")
-	proto.RegisterType((*MsgCreateTrainingTask)(nil), "inference.inference.MsgCreateTrainingTask")
-	proto.RegisterType((*MsgCreateTrainingTaskResponse)(nil), "inference.inference.MsgCreateTrainingTaskResponse")
-	proto.RegisterType((*MsgSubmitHardwareDiff)(nil), "inference.inference.MsgSubmitHardwareDiff")
-	proto.RegisterType((*MsgSubmitHardwareDiffResponse)(nil), "inference.inference.MsgSubmitHardwareDiffResponse")
-	proto.RegisterType((*MsgClaimTrainingTaskForAssignment)(nil), "inference.inference.MsgClaimTrainingTaskForAssignment")
-	proto.RegisterType((*MsgClaimTrainingTaskForAssignmentResponse)(nil), "inference.inference.MsgClaimTrainingTaskForAssignmentResponse")
-	proto.RegisterType((*MsgAssignTrainingTask)(nil), "inference.inference.MsgAssignTrainingTask")
-	proto.RegisterType((*MsgAssignTrainingTaskResponse)(nil), "inference.inference.MsgAssignTrainingTaskResponse")
-	proto.RegisterType((*MsgCreatePartialUpgrade)(nil), "inference.inference.MsgCreatePartialUpgrade")
-	proto.RegisterType((*MsgCreatePartialUpgradeResponse)(nil), "inference.inference.MsgCreatePartialUpgradeResponse")
-	proto.RegisterType((*MsgSubmitTrainingKvRecord)(nil), "inference.inference.MsgSubmitTrainingKvRecord")
-	proto.RegisterType((*MsgSubmitTrainingKvRecordResponse)(nil), "inference.inference.MsgSubmitTrainingKvRecordResponse")
-	proto.RegisterType((*MsgJoinTraining)(nil), "inference.inference.MsgJoinTraining")
-	proto.RegisterType((*MsgJoinTrainingResponse)(nil), "inference.inference.MsgJoinTrainingResponse")
-	proto.RegisterType((*MsgTrainingHeartbeat)(nil), "inference.inference.MsgTrainingHeartbeat")
-	proto.RegisterType((*MsgTrainingHeartbeatResponse)(nil), "inference.inference.MsgTrainingHeartbeatResponse")
-	proto.RegisterType((*MsgSetBarrier)(nil), "inference.inference.MsgSetBarrier")
-	proto.RegisterType((*MsgSetBarrierResponse)(nil), "inference.inference.MsgSetBarrierResponse")
-	proto.RegisterType((*MsgJoinTrainingStatus)(nil), "inference.inference.MsgJoinTrainingStatus")
-	proto.RegisterType((*MsgJoinTrainingStatusResponse)(nil), "inference.inference.MsgJoinTrainingStatusResponse")
-	proto.RegisterType((*MsgCreateDummyTrainingTask)(nil), "inference.inference.MsgCreateDummyTrainingTask")
-	proto.RegisterType((*MsgCreateDummyTrainingTaskResponse)(nil), "inference.inference.MsgCreateDummyTrainingTaskResponse")
-	proto.RegisterType((*MsgBridgeExchange)(nil), "inference.inference.MsgBridgeExchange")
-	proto.RegisterType((*MsgBridgeExchangeResponse)(nil), "inference.inference.MsgBridgeExchangeResponse")
-	proto.RegisterType((*MsgAddUserToTrainingAllowList)(nil), "inference.inference.MsgAddUserToTrainingAllowList")
-	proto.RegisterType((*MsgAddUserToTrainingAllowListResponse)(nil), "inference.inference.MsgAddUserToTrainingAllowListResponse")
-	proto.RegisterType((*MsgRemoveUserFromTrainingAllowList)(nil), "inference.inference.MsgRemoveUserFromTrainingAllowList")
-	proto.RegisterType((*MsgRemoveUserFromTrainingAllowListResponse)(nil), "inference.inference.MsgRemoveUserFromTrainingAllowListResponse")
-	proto.RegisterType((*MsgSetTrainingAllowList)(nil), "inference.inference.MsgSetTrainingAllowList")
-	proto.RegisterType((*MsgSetTrainingAllowListResponse)(nil), "inference.inference.MsgSetTrainingAllowListResponse")
+// SignedExecution is an executor's signed statement that it produced response_hash for an inference.
+// execution_signature signs calculations.GetExecutionSignatureComponents with the executor's key. The executor's
+// api returns it to the requester in the X-Execution-Signature trailer of the chat completion response.
+type SignedExecution struct {
+	InferenceId        string `protobuf:"bytes,1,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	Seed               int64  `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	ResponseHash       string `protobuf:"bytes,3,opt,name=response_hash,json=responseHash,proto3" json:"response_hash,omitempty"`
+	RequestTimestamp   int64  `protobuf:"varint,4,opt,name=request_timestamp,json=requestTimestamp,proto3" json:"request_timestamp,omitempty"`
+	TransferredBy      string `protobuf:"bytes,5,opt,name=transferred_by,json=transferredBy,proto3" json:"transferred_by,omitempty"`
+	ExecutionSignature string `protobuf:"bytes,6,opt,name=execution_signature,json=executionSignature,proto3" json:"execution_signature,omitempty"`
+}
+
+func (m *SignedExecution) Reset()         { *m = SignedExecution{} }
+func (m *SignedExecution) String() string { return proto.CompactTextString(m) }
+func (*SignedExecution) ProtoMessage()    {}
+func (*SignedExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{58}
+}
+func (m *SignedExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() { proto.RegisterFile("inference/inference/tx.proto", fileDescriptor_09b36d0241b9acd5) }
-
-var fileDescriptor_09b36d0241b9acd5 = []byte{
-	// 3064 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0xdc, 0xc8,
-	0xb1, 0x37, 0x35, 0xa3, 0x91, 0x54, 0xfa, 0xb0, 0x44, 0xeb, 0xc9, 0xe3, 0xb1, 0x56, 0x96, 0xc7,
-	0xcf, 0xb6, 0x2c, 0x59, 0xd2, 0x5b, 0xef, 0x27, 0xb4, 0x0f, 0x7e, 0xcf, 0x92, 0xbd, 0x6b, 0xef,
-	0xae, 0x6c, 0x81, 0x92, 0x17, 0xc9, 0x26, 0x01, 0xd1, 0x43, 0xf6, 0x70, 0x08, 0x0d, 0xd9, 0x74,
-	0xb3, 0x47, 0xd2, 0x64, 0x2f, 0x8b, 0x04, 0xb9, 0x04, 0x39, 0x04, 0x01, 0x82, 0x04, 0x49, 0xb0,
-	0x40, 0x10, 0x04, 0x08, 0x90, 0x8b, 0x0f, 0xb9, 0xe4, 0x1c, 0x04, 0xd8, 0xe3, 0x62, 0x81, 0x00,
-	0x01, 0x16, 0x08, 0x82, 0xdd, 0x83, 0xff, 0x82, 0x00, 0x39, 0x06, 0xdd, 0x4d, 0x72, 0x48, 0x0e,
-	0xc9, 0x19, 0x39, 0x46, 0xb0, 0x17, 0x7b, 0xba, 0xfa, 0x57, 0xdd, 0x55, 0xdd, 0x55, 0xd5, 0x55,
-	0x45, 0xc1, 0xa2, 0xed, 0x36, 0x31, 0xc5, 0xae, 0x81, 0x37, 0x7b, 0xbf, 0xd8, 0xc9, 0x86, 0x47,
-	0x09, 0x23, 0xea, 0xb9, 0x88, 0xb6, 0x11, 0xfd, 0xaa, 0xcd, 0x21, 0xc7, 0x76, 0xc9, 0xa6, 0xf8,
-	0x57, 0xe2, 0x6a, 0xe7, 0x0d, 0xe2, 0x3b, 0xc4, 0xdf, 0x74, 0x7c, 0x6b, 0xf3, 0xe8, 0x65, 0xfe,
-	0x5f, 0x30, 0x71, 0x41, 0x4e, 0xe8, 0x62, 0xb4, 0x29, 0x07, 0xc1, 0xd4, 0xbc, 0x45, 0x2c, 0x22,
-	0xe9, 0xfc, 0x57, 0xc8, 0x60, 0x11, 0x62, 0xb5, 0xf1, 0xa6, 0x18, 0x35, 0x3a, 0xcd, 0x4d, 0xe4,
-	0x76, 0x83, 0xa9, 0xe5, 0x2c, 0x51, 0x3d, 0x44, 0x91, 0x13, 0x2e, 0x79, 0x3d, 0x53, 0x19, 0x8a,
-	0x6c, 0xd7, 0x76, 0x2d, 0x9d, 0x21, 0xff, 0xb0, 0x08, 0xd8, 0x42, 0xd4, 0x3c, 0x46, 0x14, 0xeb,
-	0x2e, 0x31, 0x71, 0x00, 0xbc, 0x96, 0x05, 0x74, 0x31, 0x3b, 0x26, 0xf4, 0x30, 0x8e, 0xbb, 0x99,
-	0x85, 0x6b, 0x50, 0xdb, 0xb4, 0xb0, 0xce, 0x28, 0x72, 0x7d, 0x64, 0x30, 0x9b, 0xb8, 0x12, 0x5d,
-	0xff, 0x93, 0x02, 0x67, 0x77, 0x7d, 0xeb, 0xb1, 0x67, 0x22, 0x86, 0xf7, 0x84, 0x06, 0xea, 0xeb,
-	0x30, 0x81, 0x3a, 0xac, 0x45, 0xa8, 0xcd, 0xba, 0x55, 0x65, 0x59, 0x59, 0x99, 0xd8, 0xae, 0x7e,
-	0xfe, 0x87, 0xf5, 0xf9, 0xe0, 0xcc, 0xee, 0x98, 0x26, 0xc5, 0xbe, 0xbf, 0xcf, 0xa8, 0xed, 0x5a,
-	0x5a, 0x0f, 0xaa, 0xde, 0x86, 0x8a, 0x3c, 0x83, 0xea, 0xc8, 0xb2, 0xb2, 0x32, 0x79, 0xeb, 0xe2,
-	0x46, 0xc6, 0x9d, 0x6d, 0xc8, 0x4d, 0xb6, 0x27, 0x3e, 0xfd, 0xdb, 0xa5, 0x33, 0xbf, 0x7b, 0xf6,
-	0x74, 0x55, 0xd1, 0x02, 0xae, 0xad, 0x37, 0xbf, 0xf7, 0xec, 0xe9, 0x6a, 0x6f, 0xbd, 0x1f, 0x3e,
-	0x7b, 0xba, 0x7a, 0xb5, 0xa7, 0xc2, 0x49, 0x4c, 0x9d, 0x94, 0xc4, 0xf5, 0x0b, 0x70, 0x3e, 0x45,
-	0xd2, 0xb0, 0xef, 0x11, 0xd7, 0xc7, 0xf5, 0x7f, 0x94, 0x60, 0x6e, 0xd7, 0xb7, 0xf6, 0x19, 0xa2,
-	0xec, 0x41, 0xb8, 0x80, 0x5a, 0x85, 0x31, 0x83, 0x62, 0xc4, 0x08, 0x95, 0x0a, 0x6a, 0xe1, 0x50,
-	0xbd, 0x0c, 0x53, 0xd1, 0x3e, 0xba, 0x6d, 0x0a, 0x55, 0x26, 0xb4, 0xc9, 0x88, 0xf6, 0xc0, 0x54,
-	0x2f, 0xc1, 0xa4, 0x47, 0x89, 0xe3, 0x31, 0xbd, 0x85, 0xfc, 0x56, 0xb5, 0x24, 0x10, 0x20, 0x49,
-	0xf7, 0x91, 0xdf, 0x52, 0xaf, 0xc2, 0x4c, 0x00, 0xf0, 0x50, 0xb7, 0x4d, 0x90, 0x59, 0x2d, 0x0b,
-	0xcc, 0xb4, 0xa4, 0xee, 0x49, 0xa2, 0x3a, 0x0f, 0xa3, 0x0e, 0x31, 0x71, 0xbb, 0x5a, 0x11, 0xb3,
-	0x72, 0xc0, 0x05, 0xa0, 0xf8, 0x49, 0x07, 0xfb, 0x0c, 0x9b, 0x7a, 0xa3, 0x5b, 0x1d, 0x93, 0x02,
-	0x44, 0xb4, 0xed, 0x2e, 0x17, 0x00, 0xf9, 0xbe, 0x6d, 0xb9, 0xd8, 0xd4, 0x19, 0xa9, 0x8e, 0x4b,
-	0x01, 0x42, 0xd2, 0x01, 0xe1, 0x6b, 0x70, 0x8b, 0xd0, 0x8f, 0x30, 0xf5, 0x6d, 0xe2, 0x56, 0x27,
-	0xe4, 0x1a, 0x9c, 0xf6, 0x81, 0x24, 0xa9, 0x2f, 0x01, 0x38, 0xe8, 0x44, 0x67, 0xe4, 0x10, 0xbb,
-	0x7e, 0x15, 0x96, 0x95, 0x95, 0xb2, 0x36, 0xe1, 0xa0, 0x93, 0x03, 0x41, 0x50, 0x6f, 0x82, 0x1a,
-	0xa8, 0x20, 0x10, 0xba, 0x41, 0x3a, 0x2e, 0xab, 0x4e, 0x0a, 0xd8, 0xac, 0x9c, 0x11, 0xc8, 0x1d,
-	0x4e, 0x57, 0xd7, 0x60, 0x2e, 0x90, 0x4f, 0x67, 0xb6, 0x83, 0x7d, 0x86, 0x1c, 0xaf, 0x3a, 0xb5,
-	0xac, 0xac, 0x94, 0xb4, 0xd9, 0x60, 0xe2, 0x20, 0xa4, 0xab, 0xeb, 0xa0, 0x0a, 0x3b, 0x6c, 0x62,
-	0xaa, 0x73, 0x89, 0x11, 0xeb, 0x50, 0x5c, 0x9d, 0x11, 0x22, 0xce, 0x85, 0x33, 0xfb, 0xe1, 0x84,
-	0x7a, 0x1d, 0xce, 0x12, 0x6a, 0x5b, 0xb6, 0x8b, 0xda, 0xba, 0xdc, 0xb8, 0x7a, 0x56, 0x60, 0x67,
-	0x42, 0xf2, 0x9e, 0xa0, 0x6e, 0x4d, 0x71, 0xf3, 0x09, 0xef, 0xb1, 0x7e, 0x17, 0x2e, 0xf4, 0x5d,
-	0x7b, 0x68, 0x14, 0x7c, 0xcd, 0xd8, 0x25, 0xbb, 0x26, 0x3e, 0x09, 0xcc, 0x60, 0xa6, 0x77, 0xcf,
-	0x9c, 0x5a, 0xff, 0xb4, 0x0c, 0xea, 0xae, 0x6f, 0xbd, 0x6d, 0xbb, 0xb6, 0xdf, 0x7a, 0x41, 0xe6,
-	0x73, 0x05, 0xa6, 0x69, 0x20, 0x48, 0xdc, 0x80, 0xa6, 0x42, 0xa2, 0x30, 0xa1, 0x1b, 0x30, 0x1b,
-	0x81, 0x92, 0x46, 0x74, 0x36, 0xa4, 0x87, 0x66, 0x94, 0x7d, 0x55, 0xa3, 0x39, 0x57, 0xf5, 0x2a,
-	0x2c, 0x18, 0xc4, 0xf1, 0xda, 0x98, 0x07, 0x81, 0x04, 0x47, 0x45, 0x70, 0xcc, 0xf7, 0x66, 0x63,
-	0x5c, 0x97, 0x60, 0x12, 0x9f, 0x60, 0xa3, 0x93, 0xb0, 0x49, 0x08, 0x49, 0xdb, 0x5d, 0x6e, 0xf2,
-	0xe1, 0xd5, 0x51, 0x89, 0x91, 0x56, 0x39, 0x1d, 0xa3, 0x6e, 0x77, 0xb3, 0x0d, 0x65, 0xe2, 0x54,
-	0x86, 0x02, 0x79, 0x86, 0xb2, 0x0e, 0xaa, 0x14, 0x88, 0xc4, 0xe1, 0x93, 0x12, 0x1e, 0xce, 0xf4,
-	0xe0, 0x69, 0x3f, 0x9b, 0xea, 0xf7, 0xb3, 0x0c, 0xd3, 0x9b, 0xce, 0x32, 0xbd, 0x9e, 0x27, 0xcf,
-	0xc4, 0x3c, 0x39, 0x65, 0x90, 0xf7, 0xa0, 0xd6, 0x6f, 0x49, 0xa7, 0xb7, 0xc8, 0x9f, 0x29, 0x22,
-	0xd6, 0xed, 0x77, 0x1a, 0x8e, 0xcd, 0x1e, 0xe2, 0xe3, 0x3d, 0x44, 0x99, 0x6d, 0xd8, 0x1e, 0x72,
-	0x59, 0x81, 0x59, 0xce, 0x42, 0xa9, 0x43, 0xdb, 0x81, 0x35, 0xf2, 0x9f, 0xdc, 0x0a, 0x8f, 0x50,
-	0xdb, 0x36, 0xf9, 0xb4, 0x7e, 0x88, 0xbb, 0xa1, 0x15, 0x46, 0xc4, 0xf7, 0x70, 0x97, 0x07, 0x09,
-	0xfe, 0xbc, 0x60, 0x89, 0x90, 0xf6, 0x37, 0x21, 0x29, 0xef, 0xe1, 0x6e, 0x4a, 0xc1, 0x26, 0x5c,
-	0xca, 0x11, 0x2c, 0xd2, 0x72, 0x0d, 0xe6, 0xbc, 0x1e, 0x39, 0xa1, 0xe7, 0x6c, 0x6c, 0x42, 0x68,
-	0xaa, 0x2e, 0x40, 0xc5, 0x67, 0x88, 0x75, 0xfc, 0x40, 0xec, 0x60, 0x54, 0xff, 0xa7, 0x02, 0xd3,
-	0xbb, 0xbe, 0xf5, 0x81, 0x14, 0x94, 0xc7, 0xb2, 0x7c, 0xbd, 0x67, 0x60, 0x24, 0x72, 0xc2, 0x11,
-	0xdb, 0xec, 0x73, 0xcf, 0x52, 0xbf, 0x7b, 0xae, 0xe7, 0x79, 0xde, 0xf6, 0x48, 0x55, 0xe9, 0xf7,
-	0xbe, 0x3e, 0x6f, 0x1e, 0xcd, 0xf0, 0xe6, 0x79, 0x18, 0x3d, 0x42, 0xed, 0x0e, 0x16, 0x3e, 0xa6,
-	0x68, 0x72, 0xa0, 0xd6, 0xb9, 0x05, 0x1e, 0x45, 0x6a, 0x08, 0xaf, 0x1a, 0xd7, 0x12, 0xb4, 0xd4,
-	0x11, 0x9f, 0x87, 0xff, 0x4a, 0x68, 0x1e, 0xbd, 0x72, 0x9f, 0x2b, 0xc9, 0xc3, 0x7f, 0xec, 0x36,
-	0x3b, 0xae, 0x89, 0xcd, 0xe1, 0xac, 0xa3, 0x0a, 0x63, 0x48, 0x3e, 0xea, 0xc1, 0x51, 0x85, 0xc3,
-	0xd0, 0x6e, 0x4a, 0x3d, 0xbb, 0x39, 0x0f, 0x63, 0x5e, 0xa7, 0x11, 0xb3, 0x87, 0x8a, 0xd7, 0x69,
-	0x70, 0x5b, 0xe9, 0x33, 0xa8, 0xd1, 0x81, 0x06, 0x55, 0x29, 0x36, 0xa8, 0x1b, 0x70, 0x7d, 0x80,
-	0x4e, 0x91, 0xfe, 0x3f, 0x50, 0x60, 0x61, 0xd7, 0xb7, 0x1e, 0xb8, 0xc1, 0x6e, 0xf8, 0x05, 0xc5,
-	0xea, 0x65, 0x98, 0xb4, 0xdd, 0x48, 0x83, 0x9e, 0xb9, 0x44, 0xa4, 0x94, 0xc8, 0xcb, 0xb0, 0x94,
-	0x2d, 0x46, 0x5a, 0x52, 0x0d, 0x7f, 0x2d, 0x24, 0xcd, 0x10, 0x23, 0x92, 0xd4, 0x15, 0x99, 0xe1,
-	0x4e, 0x1b, 0xd9, 0x8e, 0x86, 0x8f, 0x11, 0x35, 0xfd, 0x02, 0x09, 0x55, 0x28, 0xfb, 0x18, 0x4b,
-	0xc9, 0x4a, 0x9a, 0xf8, 0x2d, 0x1e, 0x0d, 0x8f, 0x18, 0xad, 0xc0, 0xcf, 0x4b, 0xe2, 0x7d, 0x01,
-	0x41, 0x12, 0x1e, 0x9e, 0x92, 0xe8, 0x81, 0x08, 0x6c, 0xf1, 0xfd, 0xa2, 0xb8, 0xb1, 0x00, 0x15,
-	0xe4, 0x88, 0x47, 0x4a, 0x11, 0x8b, 0x04, 0x23, 0x4e, 0xa7, 0xd8, 0xef, 0xb4, 0x59, 0x18, 0x22,
-	0xe4, 0xa8, 0xfe, 0x85, 0x22, 0x93, 0x3e, 0x61, 0x3a, 0x7b, 0xc4, 0xd8, 0x46, 0xcc, 0x68, 0x15,
-	0x48, 0x7f, 0x1b, 0x16, 0x3d, 0x62, 0xe8, 0x3e, 0x43, 0x16, 0xe6, 0xff, 0x52, 0xa6, 0x37, 0xda,
-	0xc4, 0x38, 0xd4, 0x5b, 0xd8, 0xb6, 0x5a, 0x2c, 0xd0, 0xaa, 0xea, 0x11, 0x63, 0x9f, 0x43, 0x44,
-	0x56, 0xb1, 0xcd, 0x01, 0xf7, 0xc5, 0xbc, 0x7a, 0x01, 0xc6, 0x1b, 0x7c, 0x8b, 0x5e, 0x48, 0x19,
-	0x13, 0xe3, 0x07, 0x26, 0x17, 0xd1, 0x25, 0xae, 0x81, 0xfd, 0x6a, 0x79, 0xb9, 0xb4, 0x52, 0xd2,
-	0x82, 0x11, 0x3f, 0x30, 0xd3, 0xf6, 0xf9, 0x3b, 0x5d, 0x5a, 0x51, 0x34, 0xf1, 0x9b, 0xfb, 0x96,
-	0x48, 0xdb, 0x6c, 0x33, 0x70, 0x8d, 0x0a, 0x1f, 0x3e, 0x30, 0x53, 0x07, 0x75, 0x51, 0xa6, 0x36,
-	0x09, 0xe5, 0xa2, 0x5b, 0xfb, 0x63, 0x49, 0xd8, 0x57, 0x34, 0x3b, 0x54, 0x98, 0xdc, 0x84, 0x73,
-	0xf1, 0xb8, 0x9c, 0x0c, 0x06, 0x6a, 0x6c, 0x2a, 0xc8, 0xfd, 0x07, 0x1e, 0x58, 0x69, 0xc0, 0x81,
-	0x9d, 0xe6, 0x54, 0x44, 0x84, 0x35, 0xb0, 0x7d, 0x84, 0x4d, 0x5d, 0x4c, 0x56, 0xc4, 0xe4, 0x54,
-	0x48, 0xbc, 0xcb, 0x41, 0x17, 0x60, 0x9c, 0xea, 0x0c, 0x51, 0x0b, 0x33, 0x11, 0x47, 0x15, 0x6d,
-	0x8c, 0x1e, 0x88, 0x21, 0x7f, 0x5a, 0x9b, 0x14, 0x75, 0x4c, 0x9d, 0xb5, 0x28, 0xf6, 0x5b, 0xa4,
-	0x6d, 0x8a, 0xdc, 0x44, 0xd1, 0x66, 0x04, 0xf9, 0x20, 0xa4, 0xaa, 0x17, 0x61, 0xc2, 0xd5, 0x03,
-	0x8f, 0x09, 0x92, 0x92, 0x71, 0x37, 0xf0, 0x65, 0x9e, 0x5d, 0x78, 0x94, 0x34, 0x50, 0xc3, 0x6e,
-	0xdb, 0xac, 0xab, 0xb7, 0x88, 0x8b, 0x7d, 0x26, 0x92, 0x11, 0x45, 0x9b, 0x8b, 0xcd, 0xdc, 0x17,
-	0x13, 0x3c, 0x1f, 0x92, 0x9b, 0x9a, 0x98, 0x61, 0x83, 0x61, 0x53, 0x24, 0x22, 0xe3, 0xda, 0xb4,
-	0xa0, 0xde, 0x0d, 0x88, 0x99, 0x3e, 0x99, 0x71, 0x75, 0xd1, 0xed, 0x1e, 0x89, 0xa7, 0x4f, 0x22,
-	0xf6, 0xb9, 0x8f, 0xe5, 0xdf, 0x69, 0xca, 0xfb, 0x46, 0xd2, 0xde, 0xa7, 0x2e, 0xc2, 0x44, 0x2f,
-	0x4d, 0x92, 0x56, 0xdb, 0x23, 0x64, 0x3e, 0x3c, 0xbd, 0x7d, 0x23, 0x81, 0x0c, 0xb8, 0x12, 0x4d,
-	0x3c, 0x76, 0x6d, 0xf6, 0xa8, 0xb9, 0x43, 0x1c, 0xaf, 0xc3, 0xf0, 0x1e, 0xb5, 0x0d, 0xbc, 0x47,
-	0x89, 0x47, 0x7c, 0xd4, 0x2e, 0x10, 0x73, 0x1e, 0x46, 0x3d, 0x0e, 0x0d, 0x04, 0x94, 0x83, 0xd4,
-	0xee, 0xeb, 0xb0, 0x36, 0xc4, 0x26, 0x91, 0x4c, 0xbf, 0x28, 0xc1, 0xac, 0x88, 0x6d, 0x96, 0xed,
-	0x33, 0x4c, 0x77, 0x45, 0x59, 0xb5, 0xd8, 0x57, 0xd4, 0xc6, 0x4b, 0x57, 0x59, 0xd2, 0x79, 0xc4,
-	0x97, 0xb9, 0xe0, 0x48, 0x54, 0xd2, 0x09, 0xd2, 0x76, 0x37, 0x48, 0x24, 0x4a, 0x51, 0x22, 0xb1,
-	0x05, 0xb5, 0x8e, 0x6b, 0x33, 0x5f, 0x27, 0x4d, 0xdd, 0x90, 0xc2, 0xe8, 0x1e, 0xa6, 0x32, 0xa1,
-	0x16, 0x2f, 0x63, 0x59, 0x5b, 0x10, 0x88, 0x9e, 0xb0, 0x98, 0x8a, 0x8c, 0x9a, 0xbb, 0x79, 0xab,
-	0xa9, 0x53, 0xec, 0x91, 0xe0, 0x8d, 0xac, 0xb4, 0x9a, 0x1a, 0xf6, 0x08, 0x37, 0xc0, 0x96, 0x58,
-	0xce, 0xb1, 0x59, 0x10, 0x01, 0xc6, 0x5b, 0x9c, 0xdd, 0xb1, 0x99, 0x28, 0xd8, 0xb8, 0x26, 0x3a,
-	0xa2, 0x96, 0x5f, 0x1d, 0x5b, 0x2e, 0x71, 0x0d, 0x04, 0xe5, 0x0e, 0xb5, 0x7c, 0xf5, 0x1c, 0x8c,
-	0x1e, 0xe9, 0x14, 0x39, 0xc2, 0xb6, 0xcb, 0x5a, 0xf9, 0x48, 0x43, 0x8e, 0xfa, 0x3f, 0x30, 0xcf,
-	0x5a, 0x94, 0x74, 0xac, 0x96, 0xd7, 0x61, 0x42, 0x3e, 0xe1, 0x67, 0xc2, 0xb8, 0xcb, 0x9a, 0xda,
-	0x9b, 0xdb, 0xc3, 0xf4, 0x21, 0x9f, 0x51, 0x1f, 0xc1, 0x7c, 0x2f, 0xfb, 0x88, 0x79, 0x0c, 0x88,
-	0x8a, 0x7e, 0x31, 0xb3, 0xa2, 0xbf, 0x8b, 0x0d, 0xdb, 0x41, 0x6d, 0xed, 0x5c, 0x8f, 0x33, 0x72,
-	0xaa, 0xad, 0x99, 0x64, 0x51, 0x5f, 0xaf, 0x41, 0x35, 0x7d, 0x37, 0xd1, 0xc5, 0x7d, 0xa1, 0x08,
-	0x33, 0xdb, 0xe1, 0xd7, 0x8e, 0x0f, 0x82, 0x66, 0xc9, 0x01, 0xf2, 0x0f, 0x0b, 0xec, 0xe7, 0x3b,
-	0xa0, 0x46, 0xdd, 0x12, 0x8a, 0x7d, 0xd2, 0xa1, 0x3c, 0xaa, 0x8c, 0x2c, 0x97, 0x56, 0x26, 0x6f,
-	0x6d, 0x64, 0x8a, 0x1b, 0x2e, 0x7c, 0x3f, 0x60, 0xd3, 0x42, 0x2e, 0x6d, 0xae, 0x95, 0x26, 0xa9,
-	0x6f, 0x41, 0xc5, 0x20, 0x6e, 0xd3, 0xb6, 0xc4, 0xdd, 0x4f, 0xde, 0xba, 0x52, 0xb8, 0xe4, 0x8e,
-	0x80, 0x6a, 0x01, 0x4b, 0xca, 0x8a, 0x3f, 0x80, 0x97, 0x32, 0x95, 0x8b, 0x5e, 0xb9, 0xd7, 0xa0,
-	0xcc, 0x90, 0x7f, 0x28, 0x34, 0x9c, 0xbc, 0x75, 0xb9, 0x70, 0x27, 0xc1, 0x28, 0xe0, 0xf5, 0x3f,
-	0x2b, 0x31, 0xe7, 0x0c, 0x95, 0xba, 0x6b, 0x37, 0x9b, 0x05, 0xa7, 0xf6, 0x0e, 0x4c, 0xbb, 0xf8,
-	0xf8, 0x11, 0x3f, 0x7f, 0xbb, 0x69, 0x8b, 0x77, 0xbb, 0x94, 0xbb, 0x67, 0xb8, 0xe6, 0x43, 0x62,
-	0x62, 0x2d, 0xc9, 0xa7, 0xbe, 0x05, 0x63, 0x14, 0x3b, 0xe4, 0x08, 0x73, 0xe7, 0x18, 0x72, 0x89,
-	0x90, 0x23, 0x75, 0x3e, 0x97, 0xc4, 0xf9, 0xf4, 0xab, 0x11, 0x99, 0x87, 0x09, 0x97, 0xc3, 0x04,
-	0x21, 0x7e, 0x0c, 0x6f, 0x13, 0x7a, 0x47, 0xb4, 0x3e, 0x1c, 0x5c, 0x98, 0xe5, 0x9e, 0x87, 0x31,
-	0x7e, 0x5e, 0x61, 0xfe, 0x54, 0xd6, 0x2a, 0x7c, 0xd8, 0xf7, 0xba, 0xae, 0xc1, 0x8d, 0x81, 0xbb,
-	0x44, 0x22, 0xfd, 0x5a, 0x9e, 0xbd, 0x9c, 0x19, 0xd2, 0x62, 0xf3, 0xe4, 0x50, 0xdf, 0x81, 0x89,
-	0xa0, 0x87, 0x83, 0xfd, 0xe0, 0x34, 0x6f, 0x0c, 0x34, 0x82, 0x3b, 0x01, 0x87, 0xd6, 0xe3, 0xcd,
-	0x3c, 0xd7, 0x7e, 0x11, 0x23, 0x25, 0x7e, 0x2f, 0x4b, 0x4a, 0x69, 0x99, 0x22, 0xbb, 0x46, 0xed,
-	0xc7, 0x9e, 0x45, 0x91, 0x89, 0x07, 0x84, 0xcd, 0x05, 0xa8, 0xc4, 0x32, 0xa4, 0xb2, 0x16, 0x8c,
-	0x78, 0x32, 0x1a, 0xeb, 0x35, 0x85, 0xc9, 0x68, 0xbc, 0xfd, 0xb4, 0x02, 0x67, 0x91, 0x67, 0x6f,
-	0xdb, 0x2e, 0xa2, 0x36, 0xf6, 0xdf, 0xf5, 0x89, 0x1b, 0xb6, 0x37, 0x52, 0xe4, 0xbe, 0x00, 0x72,
-	0x59, 0x54, 0x3a, 0x59, 0xc2, 0x46, 0x0a, 0xfd, 0x56, 0x89, 0x65, 0x48, 0xa1, 0xca, 0xef, 0x1d,
-	0x69, 0xd8, 0x20, 0xb4, 0xe8, 0xc9, 0x5c, 0x80, 0xe0, 0x2a, 0x52, 0x17, 0xb3, 0x0c, 0x93, 0xb1,
-	0x1c, 0x28, 0x54, 0x27, 0x46, 0xe2, 0x75, 0x52, 0xaf, 0x22, 0xe2, 0x3f, 0x7b, 0x25, 0x9f, 0x0c,
-	0xf1, 0x72, 0x90, 0xba, 0x99, 0x2b, 0xc2, 0xa0, 0xb3, 0xc5, 0x8c, 0x94, 0xe9, 0x88, 0x34, 0xfc,
-	0x5d, 0x62, 0x47, 0x97, 0x57, 0xa0, 0xc1, 0x16, 0x94, 0x28, 0x7e, 0x12, 0xf4, 0x5f, 0x57, 0x32,
-	0x8d, 0x27, 0xbe, 0x92, 0x26, 0x9b, 0x1d, 0x1a, 0x67, 0x4a, 0xc9, 0xf6, 0x4d, 0x61, 0x13, 0x49,
-	0x70, 0x10, 0xa7, 0x6e, 0x47, 0x85, 0xb9, 0x8c, 0x54, 0xd7, 0x32, 0xf7, 0xd9, 0x7d, 0x9f, 0x3b,
-	0xbb, 0x60, 0xde, 0x17, 0xe8, 0xa8, 0x80, 0x3f, 0x86, 0xf9, 0x5d, 0xdf, 0x8a, 0xc2, 0x30, 0x46,
-	0x94, 0x35, 0x30, 0x2a, 0x72, 0xdd, 0x37, 0xe2, 0x6a, 0x5d, 0xcd, 0x8e, 0x30, 0xe1, 0x32, 0x05,
-	0x3a, 0x7d, 0x08, 0x8b, 0x59, 0x1b, 0x47, 0x8a, 0x6d, 0x41, 0x99, 0x97, 0xed, 0x85, 0x6a, 0xf5,
-	0x71, 0x69, 0x82, 0xa7, 0xfe, 0x44, 0x66, 0x66, 0x98, 0x6d, 0x23, 0x4a, 0x6d, 0x4c, 0x0b, 0xb4,
-	0x79, 0x33, 0xae, 0x4d, 0xf6, 0x2e, 0xbd, 0x75, 0x0a, 0xd4, 0x39, 0x90, 0x71, 0x3f, 0x06, 0x0d,
-	0xf4, 0x78, 0x2b, 0xa1, 0xc7, 0xf5, 0x81, 0x3b, 0x24, 0x14, 0xf9, 0x48, 0xac, 0x1a, 0xbf, 0x78,
-	0x79, 0x7d, 0xff, 0x11, 0xab, 0xd3, 0x45, 0xac, 0xea, 0xdf, 0xfc, 0x85, 0xd9, 0xde, 0x47, 0xa2,
-	0x0b, 0x27, 0xa3, 0xc7, 0xdd, 0x8e, 0xe3, 0x74, 0x87, 0x0c, 0xda, 0xe1, 0xdb, 0x3c, 0x72, 0xaa,
-	0xb7, 0x39, 0xa5, 0xdd, 0xb7, 0xa0, 0x9e, 0xbf, 0xf9, 0xbf, 0x9b, 0x06, 0xfc, 0x65, 0x44, 0xd4,
-	0xbc, 0xdb, 0xe2, 0x4b, 0xcf, 0xbd, 0x13, 0xa3, 0x85, 0x5c, 0x4b, 0xc4, 0xef, 0x5e, 0x53, 0x20,
-	0x88, 0xdf, 0x11, 0x81, 0x07, 0x36, 0xd9, 0xc9, 0xdc, 0x69, 0x21, 0xdb, 0x0d, 0xdb, 0x0a, 0x31,
-	0x12, 0x8f, 0xd3, 0x06, 0x71, 0x19, 0x45, 0x46, 0x58, 0xfb, 0x05, 0xe1, 0x2f, 0x4d, 0x56, 0xeb,
-	0x30, 0x45, 0x8e, 0x5d, 0x4c, 0x43, 0x98, 0x8c, 0x85, 0x09, 0x9a, 0xd8, 0x8f, 0x8f, 0xf7, 0x44,
-	0xcb, 0x28, 0x08, 0x8d, 0x71, 0x52, 0xac, 0xd2, 0x0f, 0x2a, 0xe0, 0xa0, 0xd2, 0x5f, 0x86, 0x49,
-	0x51, 0x60, 0x3e, 0xec, 0x38, 0x0d, 0x4c, 0xc3, 0x8f, 0x22, 0x31, 0x92, 0xec, 0xa6, 0x19, 0xd8,
-	0xf6, 0x64, 0xfb, 0x30, 0xe8, 0x3f, 0x27, 0x68, 0x31, 0x8c, 0xaf, 0x11, 0xc2, 0x82, 0xef, 0x22,
-	0x09, 0x5a, 0xf0, 0xde, 0x44, 0x67, 0x54, 0x5f, 0x13, 0x6f, 0x49, 0xf2, 0x58, 0xa3, 0xbb, 0x92,
-	0x65, 0x81, 0x12, 0x96, 0x05, 0xf5, 0x4f, 0x14, 0xf9, 0xd8, 0x9a, 0xe6, 0x63, 0x9f, 0xa7, 0xfb,
-	0xe1, 0x3d, 0xdd, 0x69, 0xb7, 0xc9, 0xf1, 0xfb, 0xbc, 0x50, 0x2d, 0x7e, 0x50, 0xf3, 0x3b, 0x71,
-	0xaf, 0x41, 0x99, 0x92, 0xb6, 0x2c, 0xd4, 0x66, 0x06, 0x58, 0x85, 0x46, 0xda, 0xdc, 0x9b, 0x49,
-	0x1b, 0xf7, 0xbd, 0x9e, 0xd7, 0xe1, 0x6a, 0xa1, 0x7c, 0xd1, 0xb3, 0xf3, 0x1b, 0x45, 0x18, 0xab,
-	0x26, 0x52, 0x35, 0x0e, 0x7e, 0x9b, 0x12, 0xe7, 0x6b, 0xa7, 0xce, 0x4d, 0x58, 0x1d, 0x2c, 0x64,
-	0xa4, 0xd3, 0xaf, 0x82, 0xde, 0x39, 0x66, 0xa7, 0x55, 0x84, 0xcf, 0x4a, 0xc9, 0x83, 0xe2, 0x82,
-	0xcf, 0x86, 0x84, 0x17, 0xa5, 0x8c, 0xcc, 0x6c, 0xb2, 0xa4, 0x0b, 0x35, 0x58, 0x5d, 0x87, 0xa9,
-	0xf8, 0x42, 0xea, 0x34, 0x4c, 0x68, 0x8f, 0xde, 0xbf, 0xa7, 0xdf, 0xfb, 0xc6, 0xbd, 0x9d, 0xd9,
-	0x33, 0xea, 0x0c, 0x80, 0x18, 0xee, 0x1f, 0xdc, 0xd1, 0x0e, 0x66, 0x95, 0x5b, 0x3f, 0xbd, 0x08,
-	0xa5, 0x5d, 0xdf, 0x52, 0x1b, 0x30, 0x95, 0xf8, 0xc2, 0xfb, 0xdf, 0xd9, 0x51, 0x33, 0xf9, 0x09,
-	0xb5, 0x76, 0x73, 0x18, 0x54, 0xe4, 0x0a, 0x2d, 0x98, 0x49, 0x7d, 0x64, 0xbd, 0x96, 0xc7, 0x9f,
-	0xc4, 0xd5, 0x36, 0x86, 0xc3, 0x45, 0x3b, 0x1d, 0xc2, 0xd9, 0xf4, 0x07, 0xb9, 0xeb, 0x79, 0x4b,
-	0xa4, 0x80, 0xb5, 0xcd, 0x21, 0x81, 0xd1, 0x66, 0xdf, 0x85, 0xf9, 0xcc, 0x6f, 0x2d, 0xb9, 0x87,
-	0x93, 0x85, 0xae, 0xbd, 0x7a, 0x1a, 0x74, 0xb4, 0xf7, 0xb7, 0x01, 0x62, 0xed, 0xbb, 0x7a, 0xde,
-	0x1a, 0x3d, 0x4c, 0x6d, 0x75, 0x30, 0x26, 0x5a, 0xfd, 0x27, 0x0a, 0x2c, 0x16, 0x7e, 0x30, 0x18,
-	0x2c, 0x74, 0x06, 0x57, 0xed, 0x7f, 0x9f, 0x87, 0x2b, 0x12, 0xea, 0x18, 0xce, 0x65, 0x35, 0xf1,
-	0xd7, 0xf2, 0x16, 0xcd, 0x00, 0xd7, 0x5e, 0x39, 0x05, 0x38, 0xbe, 0x71, 0x56, 0x4f, 0x3e, 0x77,
-	0xe3, 0x0c, 0x70, 0xfe, 0xc6, 0x05, 0x6d, 0x76, 0xee, 0x9b, 0x89, 0x1e, 0x7b, 0xae, 0x6f, 0xc6,
-	0x51, 0xf9, 0xbe, 0x99, 0xd9, 0x3f, 0xe7, 0xbe, 0x99, 0xec, 0x85, 0x5f, 0x2b, 0xbe, 0xa5, 0x10,
-	0x57, 0xe0, 0x9b, 0x99, 0xed, 0x67, 0x7e, 0x8c, 0x59, 0xad, 0xe7, 0xb5, 0x81, 0xcb, 0xc4, 0x8c,
-	0xf8, 0x95, 0x53, 0x80, 0xe3, 0xbe, 0x12, 0x6b, 0x8b, 0xd6, 0x8b, 0x97, 0xe0, 0x98, 0x7c, 0x5f,
-	0xe9, 0x6f, 0x73, 0xaa, 0x9f, 0x28, 0xb0, 0x3c, 0xb0, 0xc9, 0xf9, 0x66, 0xf1, 0x82, 0xf9, 0x9c,
-	0xb5, 0xff, 0x7f, 0x5e, 0xce, 0x48, 0x40, 0x0c, 0xd3, 0xc9, 0x7e, 0xe7, 0xd5, 0x7c, 0x5b, 0x8c,
-	0xc1, 0x6a, 0xeb, 0x43, 0xc1, 0xa2, 0x6d, 0x18, 0xa8, 0x19, 0xdd, 0xb9, 0xdc, 0x93, 0xec, 0xc7,
-	0xd6, 0x6e, 0x0d, 0x8f, 0x8d, 0xef, 0x9a, 0xd1, 0xdd, 0x1a, 0x70, 0x7f, 0x71, 0x6c, 0xfe, 0xae,
-	0xf9, 0xed, 0x26, 0x1e, 0xf9, 0x33, 0x5b, 0x22, 0x37, 0x8b, 0x35, 0x48, 0xa2, 0xf3, 0x23, 0x7f,
-	0x51, 0x07, 0x43, 0xfd, 0xb9, 0x02, 0x4b, 0x03, 0x1a, 0x5d, 0xaf, 0x17, 0x46, 0x80, 0x5c, 0xbe,
-	0xda, 0xed, 0xe7, 0xe3, 0x8b, 0x5f, 0x46, 0x46, 0xbb, 0x2b, 0xf7, 0x32, 0xfa, 0xb1, 0xf9, 0x97,
-	0x91, 0xdf, 0xa3, 0x52, 0x3f, 0x56, 0x60, 0x21, 0xa7, 0x9f, 0x33, 0x20, 0x44, 0xa5, 0xf1, 0xb5,
-	0xd7, 0x4f, 0x87, 0x8f, 0x07, 0xea, 0x44, 0x17, 0x26, 0x37, 0x50, 0xc7, 0x51, 0xf9, 0x81, 0x3a,
-	0xb3, 0xb5, 0xf2, 0x04, 0xe6, 0xfa, 0xfb, 0x22, 0x37, 0xf2, 0x96, 0xe8, 0x83, 0xd6, 0x5e, 0x1e,
-	0x1a, 0x9a, 0x08, 0x9c, 0xbd, 0xae, 0x45, 0x7e, 0xe0, 0x8c, 0x30, 0x05, 0x81, 0xb3, 0xbf, 0x15,
-	0xc1, 0x40, 0xcd, 0x68, 0x25, 0xac, 0x0e, 0x73, 0x28, 0x12, 0x9b, 0x6f, 0x2d, 0x05, 0x5d, 0x82,
-	0xef, 0x2b, 0x70, 0x3e, 0xaf, 0xc6, 0xdf, 0x2c, 0x76, 0xc8, 0x3e, 0x86, 0xda, 0x1b, 0xa7, 0x64,
-	0x88, 0xbf, 0xba, 0xa9, 0x6a, 0x3c, 0xf7, 0xd5, 0x4d, 0xe2, 0xf2, 0x5f, 0xdd, 0x9c, 0x32, 0xf4,
-	0x47, 0x0a, 0xd4, 0x0a, 0x6a, 0xce, 0x7c, 0x87, 0xcb, 0xe5, 0xa9, 0x6d, 0x9d, 0x9e, 0x27, 0x12,
-	0xe7, 0x97, 0x0a, 0x5c, 0x1a, 0x54, 0x38, 0xbe, 0x91, 0xff, 0xf0, 0x14, 0x32, 0xd6, 0xfe, 0xef,
-	0x39, 0x19, 0x13, 0x19, 0x7d, 0x56, 0x05, 0x78, 0xb3, 0xc0, 0xac, 0xfb, 0xc5, 0x78, 0xf5, 0x34,
-	0xe8, 0x70, 0xef, 0xda, 0xe8, 0xc7, 0xcf, 0x9e, 0xae, 0x2a, 0xdb, 0x8f, 0x3e, 0xfd, 0x72, 0x49,
-	0xf9, 0xec, 0xcb, 0x25, 0xe5, 0xef, 0x5f, 0x2e, 0x29, 0x3f, 0xfe, 0x6a, 0xe9, 0xcc, 0x67, 0x5f,
-	0x2d, 0x9d, 0xf9, 0xeb, 0x57, 0x4b, 0x67, 0x3e, 0x7c, 0xcd, 0xb2, 0x59, 0xab, 0xd3, 0xd8, 0x30,
-	0x88, 0xb3, 0xe9, 0x51, 0x62, 0x76, 0x0c, 0xe6, 0x1b, 0x76, 0xea, 0xaf, 0x79, 0xe3, 0x7f, 0x0a,
-	0xcb, 0xba, 0x1e, 0xf6, 0x1b, 0x15, 0xf1, 0xd7, 0xbc, 0xaf, 0xfc, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0x1b, 0x6c, 0x32, 0xcb, 0x44, 0x2d, 0x00, 0x00,
+func (m *SignedExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	StartInference(ctx context.Context, in *MsgStartInference, opts ...grpc.CallOption) (*MsgStartInferenceResponse, error)
-	FinishInference(ctx context.Context, in *MsgFinishInference, opts ...grpc.CallOption) (*MsgFinishInferenceResponse, error)
-	SubmitNewParticipant(ctx context.Context, in *MsgSubmitNewParticipant, opts ...grpc.CallOption) (*MsgSubmitNewParticipantResponse, error)
-	Validation(ctx context.Context, in *MsgValidation, opts ...grpc.CallOption) (*MsgValidationResponse, error)
-	SubmitNewUnfundedParticipant(ctx context.Context, in *MsgSubmitNewUnfundedParticipant, opts ...grpc.CallOption) (*MsgSubmitNewUnfundedParticipantResponse, error)
-	InvalidateInference(ctx context.Context, in *MsgInvalidateInference, opts ...grpc.CallOption) (*MsgInvalidateInferenceResponse, error)
-	RevalidateInference(ctx context.Context, in *MsgRevalidateInference, opts ...grpc.CallOption) (*MsgRevalidateInferenceResponse, error)
-	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
-	SubmitPocBatch(ctx context.Context, in *MsgSubmitPocBatch, opts ...grpc.CallOption) (*MsgSubmitPocBatchResponse, error)
-	SubmitPocValidation(ctx context.Context, in *MsgSubmitPocValidation, opts ...grpc.CallOption) (*MsgSubmitPocValidationResponse, error)
-	SubmitSeed(ctx context.Context, in *MsgSubmitSeed, opts ...grpc.CallOption) (*MsgSubmitSeedResponse, error)
-	SubmitUnitOfComputePriceProposal(ctx context.Context, in *MsgSubmitUnitOfComputePriceProposal, opts ...grpc.CallOption) (*MsgSubmitUnitOfComputePriceProposalResponse, error)
-	RegisterModel(ctx context.Context, in *MsgRegisterModel, opts ...grpc.CallOption) (*MsgRegisterModelResponse, error)
-	CreateTrainingTask(ctx context.Context, in *MsgCreateTrainingTask, opts ...grpc.CallOption) (*MsgCreateTrainingTaskResponse, error)
-	SubmitHardwareDiff(ctx context.Context, in *MsgSubmitHardwareDiff, opts ...grpc.CallOption) (*MsgSubmitHardwareDiffResponse, error)
-	CreatePartialUpgrade(ctx context.Context, in *MsgCreatePartialUpgrade, opts ...grpc.CallOption) (*MsgCreatePartialUpgradeResponse, error)
-	ClaimTrainingTaskForAssignment(ctx context.Context, in *MsgClaimTrainingTaskForAssignment, opts ...grpc.CallOption) (*MsgClaimTrainingTaskForAssignmentResponse, error)
-	AssignTrainingTask(ctx context.Context, in *MsgAssignTrainingTask, opts ...grpc.CallOption) (*MsgAssignTrainingTaskResponse, error)
-	SubmitTrainingKvRecord(ctx context.Context, in *MsgSubmitTrainingKvRecord, opts ...grpc.CallOption) (*MsgSubmitTrainingKvRecordResponse, error)
-	JoinTraining(ctx context.Context, in *MsgJoinTraining, opts ...grpc.CallOption) (*MsgJoinTrainingResponse, error)
-	TrainingHeartbeat(ctx context.Context, in *MsgTrainingHeartbeat, opts ...grpc.CallOption) (*MsgTrainingHeartbeatResponse, error)
-	SetBarrier(ctx context.Context, in *MsgSetBarrier, opts ...grpc.CallOption) (*MsgSetBarrierResponse, error)
-	JoinTrainingStatus(ctx context.Context, in *MsgJoinTrainingStatus, opts ...grpc.CallOption) (*MsgJoinTrainingStatusResponse, error)
-	CreateDummyTrainingTask(ctx context.Context, in *MsgCreateDummyTrainingTask, opts ...grpc.CallOption) (*MsgCreateDummyTrainingTaskResponse, error)
-	BridgeExchange(ctx context.Context, in *MsgBridgeExchange, opts ...grpc.CallOption) (*MsgBridgeExchangeResponse, error)
-	AddUserToTrainingAllowList(ctx context.Context, in *MsgAddUserToTrainingAllowList, opts ...grpc.CallOption) (*MsgAddUserToTrainingAllowListResponse, error)
-	RemoveUserFromTrainingAllowList(ctx context.Context, in *MsgRemoveUserFromTrainingAllowList, opts ...grpc.CallOption) (*MsgRemoveUserFromTrainingAllowListResponse, error)
-	SetTrainingAllowList(ctx context.Context, in *MsgSetTrainingAllowList, opts ...grpc.CallOption) (*MsgSetTrainingAllowListResponse, error)
+func (m *SignedExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedExecution.Merge(m, src)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *SignedExecution) XXX_Size() int {
+	return m.Size()
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *SignedExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedExecution.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SignedExecution proto.InternalMessageInfo
+
+func (m *SignedExecution) GetInferenceId() string {
+	if m != nil {
+		return m.InferenceId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) StartInference(ctx context.Context, in *MsgStartInference, opts ...grpc.CallOption) (*MsgStartInferenceResponse, error) {
-	out := new(MsgStartInferenceResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/StartInference", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SignedExecution) GetSeed() int64 {
+	if m != nil {
+		return m.Seed
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) FinishInference(ctx context.Context, in *MsgFinishInference, opts ...grpc.CallOption) (*MsgFinishInferenceResponse, error) {
-	out := new(MsgFinishInferenceResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/FinishInference", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SignedExecution) GetResponseHash() string {
+	if m != nil {
+		return m.ResponseHash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitNewParticipant(ctx context.Context, in *MsgSubmitNewParticipant, opts ...grpc.CallOption) (*MsgSubmitNewParticipantResponse, error) {
-	out := new(MsgSubmitNewParticipantResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitNewParticipant", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SignedExecution) GetRequestTimestamp() int64 {
+	if m != nil {
+		return m.RequestTimestamp
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) Validation(ctx context.Context, in *MsgValidation, opts ...grpc.CallOption) (*MsgValidationResponse, error) {
-	out := new(MsgValidationResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/Validation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SignedExecution) GetTransferredBy() string {
+	if m != nil {
+		return m.TransferredBy
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitNewUnfundedParticipant(ctx context.Context, in *MsgSubmitNewUnfundedParticipant, opts ...grpc.CallOption) (*MsgSubmitNewUnfundedParticipantResponse, error) {
-	out := new(MsgSubmitNewUnfundedParticipantResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitNewUnfundedParticipant", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SignedExecution) GetExecutionSignature() string {
+	if m != nil {
+		return m.ExecutionSignature
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) InvalidateInference(ctx context.Context, in *MsgInvalidateInference, opts ...grpc.CallOption) (*MsgInvalidateInferenceResponse, error) {
-	out := new(MsgInvalidateInferenceResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/InvalidateInference", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgSubmitInferenceEvidence proves that an executor signed two different responses for the same
+// inference id and seed. The executor is slashed and jailed, the creator receives a reward.
+type MsgSubmitInferenceEvidence struct {
+	Creator  string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Executor string          `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+	First    SignedExecution `protobuf:"bytes,3,opt,name=first,proto3" json:"first"`
+	Second   SignedExecution `protobuf:"bytes,4,opt,name=second,proto3" json:"second"`
 }
 
-func (c *msgClient) RevalidateInference(ctx context.Context, in *MsgRevalidateInference, opts ...grpc.CallOption) (*MsgRevalidateInferenceResponse, error) {
-	out := new(MsgRevalidateInferenceResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RevalidateInference", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitInferenceEvidence) Reset()         { *m = MsgSubmitInferenceEvidence{} }
+func (m *MsgSubmitInferenceEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitInferenceEvidence) ProtoMessage()    {}
+func (*MsgSubmitInferenceEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{59}
+}
+func (m *MsgSubmitInferenceEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitInferenceEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitInferenceEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitInferenceEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitInferenceEvidence.Merge(m, src)
+}
+func (m *MsgSubmitInferenceEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitInferenceEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitInferenceEvidence.DiscardUnknown(m)
 }
 
-func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
-	out := new(MsgClaimRewardsResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ClaimRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitInferenceEvidence proto.InternalMessageInfo
+
+func (m *MsgSubmitInferenceEvidence) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitPocBatch(ctx context.Context, in *MsgSubmitPocBatch, opts ...grpc.CallOption) (*MsgSubmitPocBatchResponse, error) {
-	out := new(MsgSubmitPocBatchResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitPocBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitInferenceEvidence) GetExecutor() string {
+	if m != nil {
+		return m.Executor
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitPocValidation(ctx context.Context, in *MsgSubmitPocValidation, opts ...grpc.CallOption) (*MsgSubmitPocValidationResponse, error) {
-	out := new(MsgSubmitPocValidationResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitPocValidation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitInferenceEvidence) GetFirst() SignedExecution {
+	if m != nil {
+		return m.First
 	}
-	return out, nil
+	return SignedExecution{}
 }
 
-func (c *msgClient) SubmitSeed(ctx context.Context, in *MsgSubmitSeed, opts ...grpc.CallOption) (*MsgSubmitSeedResponse, error) {
-	out := new(MsgSubmitSeedResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitSeed", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitInferenceEvidence) GetSecond() SignedExecution {
+	if m != nil {
+		return m.Second
 	}
-	return out, nil
+	return SignedExecution{}
 }
 
-func (c *msgClient) SubmitUnitOfComputePriceProposal(ctx context.Context, in *MsgSubmitUnitOfComputePriceProposal, opts ...grpc.CallOption) (*MsgSubmitUnitOfComputePriceProposalResponse, error) {
-	out := new(MsgSubmitUnitOfComputePriceProposalResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitUnitOfComputePriceProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSubmitInferenceEvidenceResponse struct {
+	SlashedAmount uint64 `protobuf:"varint,1,opt,name=slashed_amount,json=slashedAmount,proto3" json:"slashed_amount,omitempty"`
+	Reward        uint64 `protobuf:"varint,2,opt,name=reward,proto3" json:"reward,omitempty"`
 }
 
-func (c *msgClient) RegisterModel(ctx context.Context, in *MsgRegisterModel, opts ...grpc.CallOption) (*MsgRegisterModelResponse, error) {
-	out := new(MsgRegisterModelResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterModel", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSubmitInferenceEvidenceResponse) Reset()         { *m = MsgSubmitInferenceEvidenceResponse{} }
+func (m *MsgSubmitInferenceEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitInferenceEvidenceResponse) ProtoMessage()    {}
+func (*MsgSubmitInferenceEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{60}
 }
-
-func (c *msgClient) CreateTrainingTask(ctx context.Context, in *MsgCreateTrainingTask, opts ...grpc.CallOption) (*MsgCreateTrainingTaskResponse, error) {
-	out := new(MsgCreateTrainingTaskResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreateTrainingTask", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSubmitInferenceEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) SubmitHardwareDiff(ctx context.Context, in *MsgSubmitHardwareDiff, opts ...grpc.CallOption) (*MsgSubmitHardwareDiffResponse, error) {
-	out := new(MsgSubmitHardwareDiffResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitHardwareDiff", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitInferenceEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitInferenceEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitInferenceEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitInferenceEvidenceResponse.Merge(m, src)
+}
+func (m *MsgSubmitInferenceEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitInferenceEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitInferenceEvidenceResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreatePartialUpgrade(ctx context.Context, in *MsgCreatePartialUpgrade, opts ...grpc.CallOption) (*MsgCreatePartialUpgradeResponse, error) {
-	out := new(MsgCreatePartialUpgradeResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreatePartialUpgrade", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitInferenceEvidenceResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitInferenceEvidenceResponse) GetSlashedAmount() uint64 {
+	if m != nil {
+		return m.SlashedAmount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) ClaimTrainingTaskForAssignment(ctx context.Context, in *MsgClaimTrainingTaskForAssignment, opts ...grpc.CallOption) (*MsgClaimTrainingTaskForAssignmentResponse, error) {
-	out := new(MsgClaimTrainingTaskForAssignmentResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ClaimTrainingTaskForAssignment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitInferenceEvidenceResponse) GetReward() uint64 {
+	if m != nil {
+		return m.Reward
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) AssignTrainingTask(ctx context.Context, in *MsgAssignTrainingTask, opts ...grpc.CallOption) (*MsgAssignTrainingTaskResponse, error) {
-	out := new(MsgAssignTrainingTaskResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AssignTrainingTask", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgDisputeInference struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	InferenceId string `protobuf:"bytes,2,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
 }
 
-func (c *msgClient) SubmitTrainingKvRecord(ctx context.Context, in *MsgSubmitTrainingKvRecord, opts ...grpc.CallOption) (*MsgSubmitTrainingKvRecordResponse, error) {
-	out := new(MsgSubmitTrainingKvRecordResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitTrainingKvRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDisputeInference) Reset()         { *m = MsgDisputeInference{} }
+func (m *MsgDisputeInference) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeInference) ProtoMessage()    {}
+func (*MsgDisputeInference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{61}
+}
+func (m *MsgDisputeInference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisputeInference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisputeInference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDisputeInference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeInference.Merge(m, src)
+}
+func (m *MsgDisputeInference) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisputeInference) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeInference.DiscardUnknown(m)
 }
 
-func (c *msgClient) JoinTraining(ctx context.Context, in *MsgJoinTraining, opts ...grpc.CallOption) (*MsgJoinTrainingResponse, error) {
-	out := new(MsgJoinTrainingResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/JoinTraining", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDisputeInference proto.InternalMessageInfo
+
+func (m *MsgDisputeInference) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) TrainingHeartbeat(ctx context.Context, in *MsgTrainingHeartbeat, opts ...grpc.CallOption) (*MsgTrainingHeartbeatResponse, error) {
-	out := new(MsgTrainingHeartbeatResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/TrainingHeartbeat", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDisputeInference) GetInferenceId() string {
+	if m != nil {
+		return m.InferenceId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetBarrier(ctx context.Context, in *MsgSetBarrier, opts ...grpc.CallOption) (*MsgSetBarrierResponse, error) {
-	out := new(MsgSetBarrierResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SetBarrier", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgDisputeInferenceResponse struct {
+	Validators []string `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
-func (c *msgClient) JoinTrainingStatus(ctx context.Context, in *MsgJoinTrainingStatus, opts ...grpc.CallOption) (*MsgJoinTrainingStatusResponse, error) {
-	out := new(MsgJoinTrainingStatusResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/JoinTrainingStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDisputeInferenceResponse) Reset()         { *m = MsgDisputeInferenceResponse{} }
+func (m *MsgDisputeInferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeInferenceResponse) ProtoMessage()    {}
+func (*MsgDisputeInferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{62}
+}
+func (m *MsgDisputeInferenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisputeInferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisputeInferenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDisputeInferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeInferenceResponse.Merge(m, src)
+}
+func (m *MsgDisputeInferenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisputeInferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeInferenceResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateDummyTrainingTask(ctx context.Context, in *MsgCreateDummyTrainingTask, opts ...grpc.CallOption) (*MsgCreateDummyTrainingTaskResponse, error) {
-	out := new(MsgCreateDummyTrainingTaskResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreateDummyTrainingTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDisputeInferenceResponse proto.InternalMessageInfo
+
+func (m *MsgDisputeInferenceResponse) GetValidators() []string {
+	if m != nil {
+		return m.Validators
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) BridgeExchange(ctx context.Context, in *MsgBridgeExchange, opts ...grpc.CallOption) (*MsgBridgeExchangeResponse, error) {
-	out := new(MsgBridgeExchangeResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/BridgeExchange", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgDepositCredit struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount  uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (c *msgClient) AddUserToTrainingAllowList(ctx context.Context, in *MsgAddUserToTrainingAllowList, opts ...grpc.CallOption) (*MsgAddUserToTrainingAllowListResponse, error) {
-	out := new(MsgAddUserToTrainingAllowListResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AddUserToTrainingAllowList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDepositCredit) Reset()         { *m = MsgDepositCredit{} }
+func (m *MsgDepositCredit) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositCredit) ProtoMessage()    {}
+func (*MsgDepositCredit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{63}
+}
+func (m *MsgDepositCredit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositCredit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositCredit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDepositCredit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositCredit.Merge(m, src)
+}
+func (m *MsgDepositCredit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositCredit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositCredit.DiscardUnknown(m)
 }
 
-func (c *msgClient) RemoveUserFromTrainingAllowList(ctx context.Context, in *MsgRemoveUserFromTrainingAllowList, opts ...grpc.CallOption) (*MsgRemoveUserFromTrainingAllowListResponse, error) {
-	out := new(MsgRemoveUserFromTrainingAllowListResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RemoveUserFromTrainingAllowList", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDepositCredit proto.InternalMessageInfo
+
+func (m *MsgDepositCredit) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetTrainingAllowList(ctx context.Context, in *MsgSetTrainingAllowList, opts ...grpc.CallOption) (*MsgSetTrainingAllowListResponse, error) {
-	out := new(MsgSetTrainingAllowListResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SetTrainingAllowList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDepositCredit) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	StartInference(context.Context, *MsgStartInference) (*MsgStartInferenceResponse, error)
-	FinishInference(context.Context, *MsgFinishInference) (*MsgFinishInferenceResponse, error)
-	SubmitNewParticipant(context.Context, *MsgSubmitNewParticipant) (*MsgSubmitNewParticipantResponse, error)
-	Validation(context.Context, *MsgValidation) (*MsgValidationResponse, error)
-	SubmitNewUnfundedParticipant(context.Context, *MsgSubmitNewUnfundedParticipant) (*MsgSubmitNewUnfundedParticipantResponse, error)
-	InvalidateInference(context.Context, *MsgInvalidateInference) (*MsgInvalidateInferenceResponse, error)
-	RevalidateInference(context.Context, *MsgRevalidateInference) (*MsgRevalidateInferenceResponse, error)
-	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
-	SubmitPocBatch(context.Context, *MsgSubmitPocBatch) (*MsgSubmitPocBatchResponse, error)
-	SubmitPocValidation(context.Context, *MsgSubmitPocValidation) (*MsgSubmitPocValidationResponse, error)
-	SubmitSeed(context.Context, *MsgSubmitSeed) (*MsgSubmitSeedResponse, error)
-	SubmitUnitOfComputePriceProposal(context.Context, *MsgSubmitUnitOfComputePriceProposal) (*MsgSubmitUnitOfComputePriceProposalResponse, error)
-	RegisterModel(context.Context, *MsgRegisterModel) (*MsgRegisterModelResponse, error)
-	CreateTrainingTask(context.Context, *MsgCreateTrainingTask) (*MsgCreateTrainingTaskResponse, error)
-	SubmitHardwareDiff(context.Context, *MsgSubmitHardwareDiff) (*MsgSubmitHardwareDiffResponse, error)
-	CreatePartialUpgrade(context.Context, *MsgCreatePartialUpgrade) (*MsgCreatePartialUpgradeResponse, error)
-	ClaimTrainingTaskForAssignment(context.Context, *MsgClaimTrainingTaskForAssignment) (*MsgClaimTrainingTaskForAssignmentResponse, error)
-	AssignTrainingTask(context.Context, *MsgAssignTrainingTask) (*MsgAssignTrainingTaskResponse, error)
-	SubmitTrainingKvRecord(context.Context, *MsgSubmitTrainingKvRecord) (*MsgSubmitTrainingKvRecordResponse, error)
-	JoinTraining(context.Context, *MsgJoinTraining) (*MsgJoinTrainingResponse, error)
-	TrainingHeartbeat(context.Context, *MsgTrainingHeartbeat) (*MsgTrainingHeartbeatResponse, error)
-	SetBarrier(context.Context, *MsgSetBarrier) (*MsgSetBarrierResponse, error)
-	JoinTrainingStatus(context.Context, *MsgJoinTrainingStatus) (*MsgJoinTrainingStatusResponse, error)
-	CreateDummyTrainingTask(context.Context, *MsgCreateDummyTrainingTask) (*MsgCreateDummyTrainingTaskResponse, error)
-	BridgeExchange(context.Context, *MsgBridgeExchange) (*MsgBridgeExchangeResponse, error)
-	AddUserToTrainingAllowList(context.Context, *MsgAddUserToTrainingAllowList) (*MsgAddUserToTrainingAllowListResponse, error)
-	RemoveUserFromTrainingAllowList(context.Context, *MsgRemoveUserFromTrainingAllowList) (*MsgRemoveUserFromTrainingAllowListResponse, error)
-	SetTrainingAllowList(context.Context, *MsgSetTrainingAllowList) (*MsgSetTrainingAllowListResponse, error)
+type MsgDepositCreditResponse struct {
+	Available uint64 `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgDepositCreditResponse) Reset()         { *m = MsgDepositCreditResponse{} }
+func (m *MsgDepositCreditResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositCreditResponse) ProtoMessage()    {}
+func (*MsgDepositCreditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{64}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgDepositCreditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) StartInference(ctx context.Context, req *MsgStartInference) (*MsgStartInferenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartInference not implemented")
+func (m *MsgDepositCreditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositCreditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) FinishInference(ctx context.Context, req *MsgFinishInference) (*MsgFinishInferenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinishInference not implemented")
+func (m *MsgDepositCreditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositCreditResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SubmitNewParticipant(ctx context.Context, req *MsgSubmitNewParticipant) (*MsgSubmitNewParticipantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitNewParticipant not implemented")
+func (m *MsgDepositCreditResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) Validation(ctx context.Context, req *MsgValidation) (*MsgValidationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validation not implemented")
+func (m *MsgDepositCreditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositCreditResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) SubmitNewUnfundedParticipant(ctx context.Context, req *MsgSubmitNewUnfundedParticipant) (*MsgSubmitNewUnfundedParticipantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitNewUnfundedParticipant not implemented")
+
+var xxx_messageInfo_MsgDepositCreditResponse proto.InternalMessageInfo
+
+func (m *MsgDepositCreditResponse) GetAvailable() uint64 {
+	if m != nil {
+		return m.Available
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) InvalidateInference(ctx context.Context, req *MsgInvalidateInference) (*MsgInvalidateInferenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InvalidateInference not implemented")
+
+type MsgWithdrawCredit struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount  uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
-func (*UnimplementedMsgServer) RevalidateInference(ctx context.Context, req *MsgRevalidateInference) (*MsgRevalidateInferenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevalidateInference not implemented")
+
+func (m *MsgWithdrawCredit) Reset()         { *m = MsgWithdrawCredit{} }
+func (m *MsgWithdrawCredit) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawCredit) ProtoMessage()    {}
+func (*MsgWithdrawCredit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{65}
 }
-func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+func (m *MsgWithdrawCredit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SubmitPocBatch(ctx context.Context, req *MsgSubmitPocBatch) (*MsgSubmitPocBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitPocBatch not implemented")
+func (m *MsgWithdrawCredit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawCredit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SubmitPocValidation(ctx context.Context, req *MsgSubmitPocValidation) (*MsgSubmitPocValidationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitPocValidation not implemented")
+func (m *MsgWithdrawCredit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawCredit.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SubmitSeed(ctx context.Context, req *MsgSubmitSeed) (*MsgSubmitSeedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitSeed not implemented")
+func (m *MsgWithdrawCredit) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SubmitUnitOfComputePriceProposal(ctx context.Context, req *MsgSubmitUnitOfComputePriceProposal) (*MsgSubmitUnitOfComputePriceProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitUnitOfComputePriceProposal not implemented")
+func (m *MsgWithdrawCredit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawCredit.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) RegisterModel(ctx context.Context, req *MsgRegisterModel) (*MsgRegisterModelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterModel not implemented")
+
+var xxx_messageInfo_MsgWithdrawCredit proto.InternalMessageInfo
+
+func (m *MsgWithdrawCredit) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) CreateTrainingTask(ctx context.Context, req *MsgCreateTrainingTask) (*MsgCreateTrainingTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTrainingTask not implemented")
+
+func (m *MsgWithdrawCredit) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) SubmitHardwareDiff(ctx context.Context, req *MsgSubmitHardwareDiff) (*MsgSubmitHardwareDiffResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitHardwareDiff not implemented")
+
+type MsgWithdrawCreditResponse struct {
+	ReleaseHeight int64 `protobuf:"varint,1,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
 }
-func (*UnimplementedMsgServer) CreatePartialUpgrade(ctx context.Context, req *MsgCreatePartialUpgrade) (*MsgCreatePartialUpgradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePartialUpgrade not implemented")
+
+func (m *MsgWithdrawCreditResponse) Reset()         { *m = MsgWithdrawCreditResponse{} }
+func (m *MsgWithdrawCreditResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawCreditResponse) ProtoMessage()    {}
+func (*MsgWithdrawCreditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{66}
 }
-func (*UnimplementedMsgServer) ClaimTrainingTaskForAssignment(ctx context.Context, req *MsgClaimTrainingTaskForAssignment) (*MsgClaimTrainingTaskForAssignmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimTrainingTaskForAssignment not implemented")
+func (m *MsgWithdrawCreditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) AssignTrainingTask(ctx context.Context, req *MsgAssignTrainingTask) (*MsgAssignTrainingTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignTrainingTask not implemented")
+func (m *MsgWithdrawCreditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawCreditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SubmitTrainingKvRecord(ctx context.Context, req *MsgSubmitTrainingKvRecord) (*MsgSubmitTrainingKvRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitTrainingKvRecord not implemented")
+func (m *MsgWithdrawCreditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawCreditResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) JoinTraining(ctx context.Context, req *MsgJoinTraining) (*MsgJoinTrainingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinTraining not implemented")
+func (m *MsgWithdrawCreditResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) TrainingHeartbeat(ctx context.Context, req *MsgTrainingHeartbeat) (*MsgTrainingHeartbeatResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TrainingHeartbeat not implemented")
+func (m *MsgWithdrawCreditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawCreditResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) SetBarrier(ctx context.Context, req *MsgSetBarrier) (*MsgSetBarrierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetBarrier not implemented")
+
+var xxx_messageInfo_MsgWithdrawCreditResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawCreditResponse) GetReleaseHeight() int64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) JoinTrainingStatus(ctx context.Context, req *MsgJoinTrainingStatus) (*MsgJoinTrainingStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinTrainingStatus not implemented")
+
+type MsgGrantInferenceKey struct {
+	Creator             string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	KeyAddress          string   `protobuf:"bytes,2,opt,name=key_address,json=keyAddress,proto3" json:"key_address,omitempty"`
+	EpochSpendLimit     uint64   `protobuf:"varint,3,opt,name=epoch_spend_limit,json=epochSpendLimit,proto3" json:"epoch_spend_limit,omitempty"`
+	AllowedModels       []string `protobuf:"bytes,4,rep,name=allowed_models,json=allowedModels,proto3" json:"allowed_models,omitempty"`
+	Expiration          int64    `protobuf:"varint,5,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	MaxTokensPerRequest uint64   `protobuf:"varint,6,opt,name=max_tokens_per_request,json=maxTokensPerRequest,proto3" json:"max_tokens_per_request,omitempty"`
 }
-func (*UnimplementedMsgServer) CreateDummyTrainingTask(ctx context.Context, req *MsgCreateDummyTrainingTask) (*MsgCreateDummyTrainingTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDummyTrainingTask not implemented")
+
+func (m *MsgGrantInferenceKey) Reset()         { *m = MsgGrantInferenceKey{} }
+func (m *MsgGrantInferenceKey) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantInferenceKey) ProtoMessage()    {}
+func (*MsgGrantInferenceKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{67}
 }
-func (*UnimplementedMsgServer) BridgeExchange(ctx context.Context, req *MsgBridgeExchange) (*MsgBridgeExchangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BridgeExchange not implemented")
+func (m *MsgGrantInferenceKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) AddUserToTrainingAllowList(ctx context.Context, req *MsgAddUserToTrainingAllowList) (*MsgAddUserToTrainingAllowListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddUserToTrainingAllowList not implemented")
+func (m *MsgGrantInferenceKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantInferenceKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RemoveUserFromTrainingAllowList(ctx context.Context, req *MsgRemoveUserFromTrainingAllowList) (*MsgRemoveUserFromTrainingAllowListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserFromTrainingAllowList not implemented")
+func (m *MsgGrantInferenceKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantInferenceKey.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SetTrainingAllowList(ctx context.Context, req *MsgSetTrainingAllowList) (*MsgSetTrainingAllowListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetTrainingAllowList not implemented")
+func (m *MsgGrantInferenceKey) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgGrantInferenceKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantInferenceKey.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgGrantInferenceKey proto.InternalMessageInfo
+
+func (m *MsgGrantInferenceKey) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_StartInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStartInference)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StartInference(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/StartInference",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StartInference(ctx, req.(*MsgStartInference))
+func (m *MsgGrantInferenceKey) GetKeyAddress() string {
+	if m != nil {
+		return m.KeyAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_FinishInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFinishInference)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FinishInference(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/FinishInference",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FinishInference(ctx, req.(*MsgFinishInference))
+func (m *MsgGrantInferenceKey) GetEpochSpendLimit() uint64 {
+	if m != nil {
+		return m.EpochSpendLimit
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SubmitNewParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitNewParticipant)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitNewParticipant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitNewParticipant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitNewParticipant(ctx, req.(*MsgSubmitNewParticipant))
+func (m *MsgGrantInferenceKey) GetAllowedModels() []string {
+	if m != nil {
+		return m.AllowedModels
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_Validation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgValidation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Validation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/Validation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Validation(ctx, req.(*MsgValidation))
+func (m *MsgGrantInferenceKey) GetExpiration() int64 {
+	if m != nil {
+		return m.Expiration
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SubmitNewUnfundedParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitNewUnfundedParticipant)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitNewUnfundedParticipant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitNewUnfundedParticipant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitNewUnfundedParticipant(ctx, req.(*MsgSubmitNewUnfundedParticipant))
+func (m *MsgGrantInferenceKey) GetMaxTokensPerRequest() uint64 {
+	if m != nil {
+		return m.MaxTokensPerRequest
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_InvalidateInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInvalidateInference)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InvalidateInference(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/InvalidateInference",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InvalidateInference(ctx, req.(*MsgInvalidateInference))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgGrantInferenceKeyResponse struct {
 }
 
-func _Msg_RevalidateInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevalidateInference)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevalidateInference(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RevalidateInference",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevalidateInference(ctx, req.(*MsgRevalidateInference))
+func (m *MsgGrantInferenceKeyResponse) Reset()         { *m = MsgGrantInferenceKeyResponse{} }
+func (m *MsgGrantInferenceKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantInferenceKeyResponse) ProtoMessage()    {}
+func (*MsgGrantInferenceKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{68}
+}
+func (m *MsgGrantInferenceKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantInferenceKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantInferenceKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgGrantInferenceKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantInferenceKeyResponse.Merge(m, src)
+}
+func (m *MsgGrantInferenceKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantInferenceKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantInferenceKeyResponse.DiscardUnknown(m)
 }
 
-func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimRewards)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/ClaimRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgGrantInferenceKeyResponse proto.InternalMessageInfo
+
+type MsgRevokeInferenceKey struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	KeyAddress string `protobuf:"bytes,2,opt,name=key_address,json=keyAddress,proto3" json:"key_address,omitempty"`
 }
 
-func _Msg_SubmitPocBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitPocBatch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitPocBatch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitPocBatch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitPocBatch(ctx, req.(*MsgSubmitPocBatch))
+func (m *MsgRevokeInferenceKey) Reset()         { *m = MsgRevokeInferenceKey{} }
+func (m *MsgRevokeInferenceKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeInferenceKey) ProtoMessage()    {}
+func (*MsgRevokeInferenceKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{69}
+}
+func (m *MsgRevokeInferenceKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeInferenceKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeInferenceKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRevokeInferenceKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeInferenceKey.Merge(m, src)
+}
+func (m *MsgRevokeInferenceKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeInferenceKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeInferenceKey.DiscardUnknown(m)
 }
 
-func _Msg_SubmitPocValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitPocValidation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitPocValidation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitPocValidation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitPocValidation(ctx, req.(*MsgSubmitPocValidation))
+var xxx_messageInfo_MsgRevokeInferenceKey proto.InternalMessageInfo
+
+func (m *MsgRevokeInferenceKey) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitSeed)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitSeed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitSeed",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitSeed(ctx, req.(*MsgSubmitSeed))
+func (m *MsgRevokeInferenceKey) GetKeyAddress() string {
+	if m != nil {
+		return m.KeyAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitUnitOfComputePriceProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitUnitOfComputePriceProposal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitUnitOfComputePriceProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitUnitOfComputePriceProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitUnitOfComputePriceProposal(ctx, req.(*MsgSubmitUnitOfComputePriceProposal))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgRevokeInferenceKeyResponse struct {
 }
 
-func _Msg_RegisterModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterModel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterModel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RegisterModel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterModel(ctx, req.(*MsgRegisterModel))
+func (m *MsgRevokeInferenceKeyResponse) Reset()         { *m = MsgRevokeInferenceKeyResponse{} }
+func (m *MsgRevokeInferenceKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeInferenceKeyResponse) ProtoMessage()    {}
+func (*MsgRevokeInferenceKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{70}
+}
+func (m *MsgRevokeInferenceKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeInferenceKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeInferenceKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRevokeInferenceKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeInferenceKeyResponse.Merge(m, src)
+}
+func (m *MsgRevokeInferenceKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeInferenceKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeInferenceKeyResponse.DiscardUnknown(m)
 }
 
-func _Msg_CreateTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateTrainingTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateTrainingTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/CreateTrainingTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateTrainingTask(ctx, req.(*MsgCreateTrainingTask))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRevokeInferenceKeyResponse proto.InternalMessageInfo
+
+type MsgRequestPriceQuote struct {
+	Creator        string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ModelId        string `protobuf:"bytes,2,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	DurationBlocks int64  `protobuf:"varint,3,opt,name=duration_blocks,json=durationBlocks,proto3" json:"duration_blocks,omitempty"`
+	TokenBudget    uint64 `protobuf:"varint,4,opt,name=token_budget,json=tokenBudget,proto3" json:"token_budget,omitempty"`
 }
 
-func _Msg_SubmitHardwareDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitHardwareDiff)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitHardwareDiff(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitHardwareDiff",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitHardwareDiff(ctx, req.(*MsgSubmitHardwareDiff))
+func (m *MsgRequestPriceQuote) Reset()         { *m = MsgRequestPriceQuote{} }
+func (m *MsgRequestPriceQuote) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestPriceQuote) ProtoMessage()    {}
+func (*MsgRequestPriceQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{71}
+}
+func (m *MsgRequestPriceQuote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestPriceQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestPriceQuote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRequestPriceQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestPriceQuote.Merge(m, src)
+}
+func (m *MsgRequestPriceQuote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestPriceQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestPriceQuote.DiscardUnknown(m)
 }
 
-func _Msg_CreatePartialUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePartialUpgrade)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePartialUpgrade(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/CreatePartialUpgrade",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePartialUpgrade(ctx, req.(*MsgCreatePartialUpgrade))
+var xxx_messageInfo_MsgRequestPriceQuote proto.InternalMessageInfo
+
+func (m *MsgRequestPriceQuote) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ClaimTrainingTaskForAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimTrainingTaskForAssignment)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimTrainingTaskForAssignment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/ClaimTrainingTaskForAssignment",
+func (m *MsgRequestPriceQuote) GetModelId() string {
+	if m != nil {
+		return m.ModelId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimTrainingTaskForAssignment(ctx, req.(*MsgClaimTrainingTaskForAssignment))
+	return ""
+}
+
+func (m *MsgRequestPriceQuote) GetDurationBlocks() int64 {
+	if m != nil {
+		return m.DurationBlocks
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_AssignTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAssignTrainingTask)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgRequestPriceQuote) GetTokenBudget() uint64 {
+	if m != nil {
+		return m.TokenBudget
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AssignTrainingTask(ctx, in)
+	return 0
+}
+
+type MsgRequestPriceQuoteResponse struct {
+	QuoteId          uint64 `protobuf:"varint,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	PerTokenPrice    uint64 `protobuf:"varint,2,opt,name=per_token_price,json=perTokenPrice,proto3" json:"per_token_price,omitempty"`
+	ExpirationHeight int64  `protobuf:"varint,3,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *MsgRequestPriceQuoteResponse) Reset()         { *m = MsgRequestPriceQuoteResponse{} }
+func (m *MsgRequestPriceQuoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestPriceQuoteResponse) ProtoMessage()    {}
+func (*MsgRequestPriceQuoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{72}
+}
+func (m *MsgRequestPriceQuoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestPriceQuoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestPriceQuoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/AssignTrainingTask",
+}
+func (m *MsgRequestPriceQuoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestPriceQuoteResponse.Merge(m, src)
+}
+func (m *MsgRequestPriceQuoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestPriceQuoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestPriceQuoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestPriceQuoteResponse proto.InternalMessageInfo
+
+func (m *MsgRequestPriceQuoteResponse) GetQuoteId() uint64 {
+	if m != nil {
+		return m.QuoteId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AssignTrainingTask(ctx, req.(*MsgAssignTrainingTask))
+	return 0
+}
+
+func (m *MsgRequestPriceQuoteResponse) GetPerTokenPrice() uint64 {
+	if m != nil {
+		return m.PerTokenPrice
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SubmitTrainingKvRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitTrainingKvRecord)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitTrainingKvRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitTrainingKvRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitTrainingKvRecord(ctx, req.(*MsgSubmitTrainingKvRecord))
+func (m *MsgRequestPriceQuoteResponse) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_JoinTraining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgJoinTraining)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).JoinTraining(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/JoinTraining",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).JoinTraining(ctx, req.(*MsgJoinTraining))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgLeaveNetwork struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func _Msg_TrainingHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTrainingHeartbeat)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).TrainingHeartbeat(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/TrainingHeartbeat",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TrainingHeartbeat(ctx, req.(*MsgTrainingHeartbeat))
+func (m *MsgLeaveNetwork) Reset()         { *m = MsgLeaveNetwork{} }
+func (m *MsgLeaveNetwork) String() string { return proto.CompactTextString(m) }
+func (*MsgLeaveNetwork) ProtoMessage()    {}
+func (*MsgLeaveNetwork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{73}
+}
+func (m *MsgLeaveNetwork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeaveNetwork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeaveNetwork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgLeaveNetwork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeaveNetwork.Merge(m, src)
+}
+func (m *MsgLeaveNetwork) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeaveNetwork) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeaveNetwork.DiscardUnknown(m)
 }
 
-func _Msg_SetBarrier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetBarrier)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetBarrier(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SetBarrier",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetBarrier(ctx, req.(*MsgSetBarrier))
+var xxx_messageInfo_MsgLeaveNetwork proto.InternalMessageInfo
+
+func (m *MsgLeaveNetwork) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_JoinTrainingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgJoinTrainingStatus)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).JoinTrainingStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/JoinTrainingStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).JoinTrainingStatus(ctx, req.(*MsgJoinTrainingStatus))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgLeaveNetworkResponse struct {
+	ExitEpoch uint64 `protobuf:"varint,1,opt,name=exit_epoch,json=exitEpoch,proto3" json:"exit_epoch,omitempty"`
 }
 
-func _Msg_CreateDummyTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateDummyTrainingTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateDummyTrainingTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/CreateDummyTrainingTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateDummyTrainingTask(ctx, req.(*MsgCreateDummyTrainingTask))
+func (m *MsgLeaveNetworkResponse) Reset()         { *m = MsgLeaveNetworkResponse{} }
+func (m *MsgLeaveNetworkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLeaveNetworkResponse) ProtoMessage()    {}
+func (*MsgLeaveNetworkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{74}
+}
+func (m *MsgLeaveNetworkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeaveNetworkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeaveNetworkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgLeaveNetworkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeaveNetworkResponse.Merge(m, src)
+}
+func (m *MsgLeaveNetworkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeaveNetworkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeaveNetworkResponse.DiscardUnknown(m)
 }
 
-func _Msg_BridgeExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBridgeExchange)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BridgeExchange(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/BridgeExchange",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BridgeExchange(ctx, req.(*MsgBridgeExchange))
+var xxx_messageInfo_MsgLeaveNetworkResponse proto.InternalMessageInfo
+
+func (m *MsgLeaveNetworkResponse) GetExitEpoch() uint64 {
+	if m != nil {
+		return m.ExitEpoch
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_AddUserToTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddUserToTrainingAllowList)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddUserToTrainingAllowList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/AddUserToTrainingAllowList",
+// MsgUpdateParticipantProfile replaces the profile of a participant. An empty inference_url keeps the current one.
+type MsgUpdateParticipantProfile struct {
+	Creator            string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	InferenceUrl       string `protobuf:"bytes,2,opt,name=inference_url,json=inferenceUrl,proto3" json:"inference_url,omitempty"`
+	DisplayName        string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Website            string `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
+	Contact            string `protobuf:"bytes,5,opt,name=contact,proto3" json:"contact,omitempty"`
+	RegionCode         string `protobuf:"bytes,6,opt,name=region_code,json=regionCode,proto3" json:"region_code,omitempty"`
+	SupportedProtocols uint32 `protobuf:"varint,7,opt,name=supported_protocols,json=supportedProtocols,proto3" json:"supported_protocols,omitempty"`
+}
+
+func (m *MsgUpdateParticipantProfile) Reset()         { *m = MsgUpdateParticipantProfile{} }
+func (m *MsgUpdateParticipantProfile) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParticipantProfile) ProtoMessage()    {}
+func (*MsgUpdateParticipantProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{75}
+}
+func (m *MsgUpdateParticipantProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParticipantProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParticipantProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddUserToTrainingAllowList(ctx, req.(*MsgAddUserToTrainingAllowList))
+}
+func (m *MsgUpdateParticipantProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParticipantProfile.Merge(m, src)
+}
+func (m *MsgUpdateParticipantProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParticipantProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParticipantProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParticipantProfile proto.InternalMessageInfo
+
+func (m *MsgUpdateParticipantProfile) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RemoveUserFromTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveUserFromTrainingAllowList)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgUpdateParticipantProfile) GetInferenceUrl() string {
+	if m != nil {
+		return m.InferenceUrl
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveUserFromTrainingAllowList(ctx, in)
+	return ""
+}
+
+func (m *MsgUpdateParticipantProfile) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RemoveUserFromTrainingAllowList",
+	return ""
+}
+
+func (m *MsgUpdateParticipantProfile) GetWebsite() string {
+	if m != nil {
+		return m.Website
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveUserFromTrainingAllowList(ctx, req.(*MsgRemoveUserFromTrainingAllowList))
+	return ""
+}
+
+func (m *MsgUpdateParticipantProfile) GetContact() string {
+	if m != nil {
+		return m.Contact
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetTrainingAllowList)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgUpdateParticipantProfile) GetRegionCode() string {
+	if m != nil {
+		return m.RegionCode
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetTrainingAllowList(ctx, in)
+	return ""
+}
+
+func (m *MsgUpdateParticipantProfile) GetSupportedProtocols() uint32 {
+	if m != nil {
+		return m.SupportedProtocols
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SetTrainingAllowList",
+	return 0
+}
+
+type MsgUpdateParticipantProfileResponse struct {
+	// url_effective_height is when the new inference URL takes effect, 0 if it did not change
+	UrlEffectiveHeight int64 `protobuf:"varint,1,opt,name=url_effective_height,json=urlEffectiveHeight,proto3" json:"url_effective_height,omitempty"`
+}
+
+func (m *MsgUpdateParticipantProfileResponse) Reset()         { *m = MsgUpdateParticipantProfileResponse{} }
+func (m *MsgUpdateParticipantProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParticipantProfileResponse) ProtoMessage()    {}
+func (*MsgUpdateParticipantProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{76}
+}
+func (m *MsgUpdateParticipantProfileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParticipantProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParticipantProfileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetTrainingAllowList(ctx, req.(*MsgSetTrainingAllowList))
+}
+func (m *MsgUpdateParticipantProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParticipantProfileResponse.Merge(m, src)
+}
+func (m *MsgUpdateParticipantProfileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParticipantProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParticipantProfileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParticipantProfileResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateParticipantProfileResponse) GetUrlEffectiveHeight() int64 {
+	if m != nil {
+		return m.UrlEffectiveHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "inference.inference.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "StartInference",
-			Handler:    _Msg_StartInference_Handler,
-		},
-		{
-			MethodName: "FinishInference",
-			Handler:    _Msg_FinishInference_Handler,
-		},
-		{
-			MethodName: "SubmitNewParticipant",
-			Handler:    _Msg_SubmitNewParticipant_Handler,
-		},
-		{
-			MethodName: "Validation",
-			Handler:    _Msg_Validation_Handler,
-		},
-		{
-			MethodName: "SubmitNewUnfundedParticipant",
-			Handler:    _Msg_SubmitNewUnfundedParticipant_Handler,
-		},
-		{
-			MethodName: "InvalidateInference",
-			Handler:    _Msg_InvalidateInference_Handler,
-		},
-		{
-			MethodName: "RevalidateInference",
-			Handler:    _Msg_RevalidateInference_Handler,
-		},
-		{
-			MethodName: "ClaimRewards",
-			Handler:    _Msg_ClaimRewards_Handler,
-		},
-		{
-			MethodName: "SubmitPocBatch",
-			Handler:    _Msg_SubmitPocBatch_Handler,
-		},
-		{
-			MethodName: "SubmitPocValidation",
-			Handler:    _Msg_SubmitPocValidation_Handler,
-		},
-		{
-			MethodName: "SubmitSeed",
-			Handler:    _Msg_SubmitSeed_Handler,
-		},
-		{
-			MethodName: "SubmitUnitOfComputePriceProposal",
-			Handler:    _Msg_SubmitUnitOfComputePriceProposal_Handler,
-		},
-		{
-			MethodName: "RegisterModel",
-			Handler:    _Msg_RegisterModel_Handler,
-		},
-		{
-			MethodName: "CreateTrainingTask",
-			Handler:    _Msg_CreateTrainingTask_Handler,
-		},
-		{
-			MethodName: "SubmitHardwareDiff",
-			Handler:    _Msg_SubmitHardwareDiff_Handler,
-		},
-		{
-			MethodName: "CreatePartialUpgrade",
-			Handler:    _Msg_CreatePartialUpgrade_Handler,
-		},
-		{
-			MethodName: "ClaimTrainingTaskForAssignment",
-			Handler:    _Msg_ClaimTrainingTaskForAssignment_Handler,
-		},
-		{
-			MethodName: "AssignTrainingTask",
-			Handler:    _Msg_AssignTrainingTask_Handler,
-		},
-		{
-			MethodName: "SubmitTrainingKvRecord",
-			Handler:    _Msg_SubmitTrainingKvRecord_Handler,
-		},
-		{
-			MethodName: "JoinTraining",
-			Handler:    _Msg_JoinTraining_Handler,
-		},
-		{
-			MethodName: "TrainingHeartbeat",
-			Handler:    _Msg_TrainingHeartbeat_Handler,
-		},
-		{
-			MethodName: "SetBarrier",
-			Handler:    _Msg_SetBarrier_Handler,
-		},
-		{
-			MethodName: "JoinTrainingStatus",
-			Handler:    _Msg_JoinTrainingStatus_Handler,
-		},
-		{
-			MethodName: "CreateDummyTrainingTask",
-			Handler:    _Msg_CreateDummyTrainingTask_Handler,
-		},
-		{
-			MethodName: "BridgeExchange",
-			Handler:    _Msg_BridgeExchange_Handler,
-		},
-		{
-			MethodName: "AddUserToTrainingAllowList",
-			Handler:    _Msg_AddUserToTrainingAllowList_Handler,
-		},
-		{
-			MethodName: "RemoveUserFromTrainingAllowList",
-			Handler:    _Msg_RemoveUserFromTrainingAllowList_Handler,
-		},
-		{
-			MethodName: "SetTrainingAllowList",
-			Handler:    _Msg_SetTrainingAllowList_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "inference/inference/tx.proto",
-}
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterEnum("inference.inference.TrainingRole", TrainingRole_name, TrainingRole_value)
+	proto.RegisterType((*MsgUpdateParams)(nil), "inference.inference.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "inference.inference.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgStartInference)(nil), "inference.inference.MsgStartInference")
+	proto.RegisterType((*MsgStartInferenceResponse)(nil), "inference.inference.MsgStartInferenceResponse")
+	proto.RegisterType((*MsgFinishInference)(nil), "inference.inference.MsgFinishInference")
+	proto.RegisterType((*MsgFinishInferenceResponse)(nil), "inference.inference.MsgFinishInferenceResponse")
+	proto.RegisterType((*MsgSubmitNewParticipant)(nil), "inference.inference.MsgSubmitNewParticipant")
+	proto.RegisterType((*MsgSubmitNewParticipantResponse)(nil), "inference.inference.MsgSubmitNewParticipantResponse")
+	proto.RegisterType((*MsgValidation)(nil), "inference.inference.MsgValidation")
+	proto.RegisterType((*MsgValidationResponse)(nil), "inference.inference.MsgValidationResponse")
+	proto.RegisterType((*MsgSubmitNewUnfundedParticipant)(nil), "inference.inference.MsgSubmitNewUnfundedParticipant")
+	proto.RegisterType((*MsgSubmitNewUnfundedParticipantResponse)(nil), "inference.inference.MsgSubmitNewUnfundedParticipantResponse")
+	proto.RegisterType((*MsgInvalidateInference)(nil), "inference.inference.MsgInvalidateInference")
+	proto.RegisterType((*MsgInvalidateInferenceResponse)(nil), "inference.inference.MsgInvalidateInferenceResponse")
+	proto.RegisterType((*MsgRevalidateInference)(nil), "inference.inference.MsgRevalidateInference")
+	proto.RegisterType((*MsgRevalidateInferenceResponse)(nil), "inference.inference.MsgRevalidateInferenceResponse")
+	proto.RegisterType((*MsgClaimRewards)(nil), "inference.inference.MsgClaimRewards")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "inference.inference.MsgClaimRewardsResponse")
+	proto.RegisterType((*MsgSubmitPocBatch)(nil), "inference.inference.MsgSubmitPocBatch")
+	proto.RegisterType((*MsgSubmitPocBatchResponse)(nil), "inference.inference.MsgSubmitPocBatchResponse")
+	proto.RegisterType((*MsgSubmitPocValidation)(nil), "inference.inference.MsgSubmitPocValidation")
+	proto.RegisterType((*MsgSubmitPocValidationResponse)(nil), "inference.inference.MsgSubmitPocValidationResponse")
+	proto.RegisterType((*MsgSubmitSeed)(nil), "inference.inference.MsgSubmitSeed")
+	proto.RegisterType((*MsgSubmitSeedResponse)(nil), "inference.inference.MsgSubmitSeedResponse")
+	proto.RegisterType((*MsgSubmitUnitOfComputePriceProposal)(nil), "inference.inference.MsgSubmitUnitOfComputePriceProposal")
+	proto.RegisterType((*MsgSubmitUnitOfComputePriceProposalResponse)(nil), "inference.inference.MsgSubmitUnitOfComputePriceProposalResponse")
+	proto.RegisterType((*MsgRegisterModel)(nil), "inference.inference.MsgRegisterModel")
+	proto.RegisterType((*MsgRegisterModelResponse)(nil), "inference.inference.MsgRegisterModelResponse")
+	proto.RegisterType((*MsgCreateTrainingTask)(nil), "inference.inference.MsgCreateTrainingTask")
+	proto.RegisterType((*MsgCreateTrainingTaskResponse)(nil), "inference.inference.MsgCreateTrainingTaskResponse")
+	proto.RegisterType((*MsgSubmitHardwareDiff)(nil), "inference.inference.MsgSubmitHardwareDiff")
+	proto.RegisterType((*MsgSubmitHardwareDiffResponse)(nil), "inference.inference.MsgSubmitHardwareDiffResponse")
+	proto.RegisterType((*MsgClaimTrainingTaskForAssignment)(nil), "inference.inference.MsgClaimTrainingTaskForAssignment")
+	proto.RegisterType((*MsgClaimTrainingTaskForAssignmentResponse)(nil), "inference.inference.MsgClaimTrainingTaskForAssignmentResponse")
+	proto.RegisterType((*MsgAssignTrainingTask)(nil), "inference.inference.MsgAssignTrainingTask")
+	proto.RegisterType((*MsgAssignTrainingTaskResponse)(nil), "inference.inference.MsgAssignTrainingTaskResponse")
+	proto.RegisterType((*MsgCreatePartialUpgrade)(nil), "inference.inference.MsgCreatePartialUpgrade")
+	proto.RegisterType((*MsgCreatePartialUpgradeResponse)(nil), "inference.inference.MsgCreatePartialUpgradeResponse")
+	proto.RegisterType((*MsgSubmitTrainingKvRecord)(nil), "inference.inference.MsgSubmitTrainingKvRecord")
+	proto.RegisterType((*MsgSubmitTrainingKvRecordResponse)(nil), "inference.inference.MsgSubmitTrainingKvRecordResponse")
+	proto.RegisterType((*MsgJoinTraining)(nil), "inference.inference.MsgJoinTraining")
+	proto.RegisterType((*MsgJoinTrainingResponse)(nil), "inference.inference.MsgJoinTrainingResponse")
+	proto.RegisterType((*MsgTrainingHeartbeat)(nil), "inference.inference.MsgTrainingHeartbeat")
+	proto.RegisterType((*MsgTrainingHeartbeatResponse)(nil), "inference.inference.MsgTrainingHeartbeatResponse")
+	proto.RegisterType((*MsgSetBarrier)(nil), "inference.inference.MsgSetBarrier")
+	proto.RegisterType((*MsgSetBarrierResponse)(nil), "inference.inference.MsgSetBarrierResponse")
+	proto.RegisterType((*MsgJoinTrainingStatus)(nil), "inference.inference.MsgJoinTrainingStatus")
+	proto.RegisterType((*MsgJoinTrainingStatusResponse)(nil), "inference.inference.MsgJoinTrainingStatusResponse")
+	proto.RegisterType((*MsgCreateDummyTrainingTask)(nil), "inference.inference.MsgCreateDummyTrainingTask")
+	proto.RegisterType((*MsgCreateDummyTrainingTaskResponse)(nil), "inference.inference.MsgCreateDummyTrainingTaskResponse")
+	proto.RegisterType((*MsgBridgeExchange)(nil), "inference.inference.MsgBridgeExchange")
+	proto.RegisterType((*MsgBridgeExchangeResponse)(nil), "inference.inference.MsgBridgeExchangeResponse")
+	proto.RegisterType((*MsgAddUserToTrainingAllowList)(nil), "inference.inference.MsgAddUserToTrainingAllowList")
+	proto.RegisterType((*MsgAddUserToTrainingAllowListResponse)(nil), "inference.inference.MsgAddUserToTrainingAllowListResponse")
+	proto.RegisterType((*MsgRemoveUserFromTrainingAllowList)(nil), "inference.inference.MsgRemoveUserFromTrainingAllowList")
+	proto.RegisterType((*MsgRemoveUserFromTrainingAllowListResponse)(nil), "inference.inference.MsgRemoveUserFromTrainingAllowListResponse")
+	proto.RegisterType((*MsgSetTrainingAllowList)(nil), "inference.inference.MsgSetTrainingAllowList")
+	proto.RegisterType((*MsgSetTrainingAllowListResponse)(nil), "inference.inference.MsgSetTrainingAllowListResponse")
+	proto.RegisterType((*SignedExecution)(nil), "inference.inference.SignedExecution")
+	proto.RegisterType((*MsgSubmitInferenceEvidence)(nil), "inference.inference.MsgSubmitInferenceEvidence")
+	proto.RegisterType((*MsgSubmitInferenceEvidenceResponse)(nil), "inference.inference.MsgSubmitInferenceEvidenceResponse")
+	proto.RegisterType((*MsgDisputeInference)(nil), "inference.inference.MsgDisputeInference")
+	proto.RegisterType((*MsgDisputeInferenceResponse)(nil), "inference.inference.MsgDisputeInferenceResponse")
+	proto.RegisterType((*MsgDepositCredit)(nil), "inference.inference.MsgDepositCredit")
+	proto.RegisterType((*MsgDepositCreditResponse)(nil), "inference.inference.MsgDepositCreditResponse")
+	proto.RegisterType((*MsgWithdrawCredit)(nil), "inference.inference.MsgWithdrawCredit")
+	proto.RegisterType((*MsgWithdrawCreditResponse)(nil), "inference.inference.MsgWithdrawCreditResponse")
+	proto.RegisterType((*MsgGrantInferenceKey)(nil), "inference.inference.MsgGrantInferenceKey")
+	proto.RegisterType((*MsgGrantInferenceKeyResponse)(nil), "inference.inference.MsgGrantInferenceKeyResponse")
+	proto.RegisterType((*MsgRevokeInferenceKey)(nil), "inference.inference.MsgRevokeInferenceKey")
+	proto.RegisterType((*MsgRevokeInferenceKeyResponse)(nil), "inference.inference.MsgRevokeInferenceKeyResponse")
+	proto.RegisterType((*MsgRequestPriceQuote)(nil), "inference.inference.MsgRequestPriceQuote")
+	proto.RegisterType((*MsgRequestPriceQuoteResponse)(nil), "inference.inference.MsgRequestPriceQuoteResponse")
+	proto.RegisterType((*MsgLeaveNetwork)(nil), "inference.inference.MsgLeaveNetwork")
+	proto.RegisterType((*MsgLeaveNetworkResponse)(nil), "inference.inference.MsgLeaveNetworkResponse")
+	proto.RegisterType((*MsgUpdateParticipantProfile)(nil), "inference.inference.MsgUpdateParticipantProfile")
+	proto.RegisterType((*MsgUpdateParticipantProfileResponse)(nil), "inference.inference.MsgUpdateParticipantProfileResponse")
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+func init() { proto.RegisterFile("inference/inference/tx.proto", fileDescriptor_09b36d0241b9acd5) }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_09b36d0241b9acd5 = []byte{
+	// 3943 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x4b, 0x8c, 0x1c, 0x49,
+	0x56, 0xce, 0xae, 0xfe, 0xbe, 0xfe, 0xa7, 0x1b, 0xbb, 0x5c, 0xe3, 0x69, 0xf7, 0x94, 0xd7, 0x76,
+	0xbb, 0xfd, 0xe9, 0x59, 0xcf, 0xcf, 0x78, 0x60, 0x58, 0x77, 0xbb, 0x67, 0xc6, 0xbb, 0x6e, 0xbb,
+	0xc9, 0x6e, 0xcf, 0xc2, 0x02, 0xca, 0xcd, 0xca, 0x8c, 0xaa, 0x4a, 0x75, 0x55, 0x46, 0x3a, 0x32,
+	0xaa, 0xdb, 0xcd, 0x5e, 0x56, 0x20, 0x40, 0x42, 0x08, 0x21, 0x38, 0x80, 0x00, 0xad, 0x84, 0xd0,
+	0x4a, 0x48, 0x5c, 0xe6, 0xc0, 0x85, 0x1b, 0x12, 0x42, 0x9a, 0xe3, 0x6a, 0x25, 0x24, 0xa4, 0x95,
+	0x56, 0x68, 0x46, 0x62, 0x4e, 0x5c, 0x38, 0x71, 0x44, 0xf1, 0x22, 0x32, 0x2a, 0xff, 0xd5, 0x3d,
+	0x58, 0xab, 0xb9, 0xd8, 0x15, 0x2f, 0x5e, 0x44, 0xbc, 0xf7, 0xe2, 0xfd, 0xe2, 0xbd, 0x6c, 0xb8,
+	0xec, 0x07, 0x6d, 0xc2, 0x48, 0xe0, 0x92, 0xcd, 0xe1, 0x2f, 0xfe, 0xf2, 0x6e, 0xc8, 0x28, 0xa7,
+	0xe6, 0x79, 0x0d, 0xbb, 0xab, 0x7f, 0x35, 0x96, 0x9d, 0xbe, 0x1f, 0xd0, 0x4d, 0xfc, 0x57, 0xe2,
+	0x35, 0x2e, 0xba, 0x34, 0xea, 0xd3, 0x68, 0xb3, 0x1f, 0x75, 0x36, 0x8f, 0xbe, 0x29, 0xfe, 0x53,
+	0x13, 0x97, 0xe4, 0x84, 0x8d, 0xa3, 0x4d, 0x39, 0x50, 0x53, 0x2b, 0x1d, 0xda, 0xa1, 0x12, 0x2e,
+	0x7e, 0xc5, 0x0b, 0x3a, 0x94, 0x76, 0x7a, 0x64, 0x13, 0x47, 0xad, 0x41, 0x7b, 0xd3, 0x09, 0x4e,
+	0xd4, 0xd4, 0x5a, 0x11, 0xa9, 0xa1, 0xc3, 0x9c, 0x7e, 0xbc, 0xe5, 0x8d, 0x42, 0x66, 0x98, 0xe3,
+	0x07, 0x7e, 0xd0, 0xb1, 0xb9, 0x13, 0x1d, 0x56, 0x21, 0x76, 0x1d, 0xe6, 0x1d, 0x3b, 0x8c, 0xd8,
+	0x01, 0xf5, 0x88, 0x42, 0xbc, 0x5e, 0x84, 0x18, 0x10, 0x7e, 0x4c, 0xd9, 0x61, 0x12, 0xef, 0x76,
+	0x11, 0x5e, 0x8b, 0xf9, 0x5e, 0x87, 0xd8, 0x9c, 0x39, 0x41, 0xe4, 0xb8, 0xdc, 0xa7, 0x81, 0xc4,
+	0x6e, 0xfe, 0xab, 0x01, 0x8b, 0xbb, 0x51, 0xe7, 0x79, 0xe8, 0x39, 0x9c, 0xec, 0x21, 0x07, 0xe6,
+	0xbb, 0x30, 0xe3, 0x0c, 0x78, 0x97, 0x32, 0x9f, 0x9f, 0xd4, 0x8d, 0x35, 0x63, 0x7d, 0x66, 0xab,
+	0xfe, 0xd3, 0x7f, 0xba, 0xb3, 0xa2, 0x64, 0xf6, 0xd0, 0xf3, 0x18, 0x89, 0xa2, 0x7d, 0xce, 0xfc,
+	0xa0, 0x63, 0x0d, 0x51, 0xcd, 0x0f, 0x60, 0x52, 0xca, 0xa0, 0x3e, 0xb6, 0x66, 0xac, 0xcf, 0xde,
+	0x7b, 0xed, 0x6e, 0xc1, 0x9d, 0xdd, 0x95, 0x87, 0x6c, 0xcd, 0x7c, 0xf6, 0xf3, 0x2b, 0xe7, 0xfe,
+	0xe1, 0xcb, 0x4f, 0x37, 0x0c, 0x4b, 0xad, 0x7a, 0x70, 0xff, 0xf7, 0xbe, 0xfc, 0x74, 0x63, 0xb8,
+	0xdf, 0x1f, 0x7f, 0xf9, 0xe9, 0xc6, 0xb5, 0x21, 0x0b, 0x2f, 0x13, 0xec, 0x64, 0x28, 0x6e, 0x5e,
+	0x82, 0x8b, 0x19, 0x90, 0x45, 0xa2, 0x90, 0x06, 0x11, 0x69, 0xfe, 0xcb, 0x38, 0x2c, 0xef, 0x46,
+	0x9d, 0x7d, 0xee, 0x30, 0xfe, 0x38, 0xde, 0xc0, 0xac, 0xc3, 0x94, 0xcb, 0x88, 0xc3, 0x29, 0x93,
+	0x0c, 0x5a, 0xf1, 0xd0, 0x7c, 0x03, 0xe6, 0xf4, 0x39, 0xb6, 0xef, 0x21, 0x2b, 0x33, 0xd6, 0xac,
+	0x86, 0x3d, 0xf6, 0xcc, 0x2b, 0x30, 0x1b, 0x32, 0xda, 0x0f, 0xb9, 0xdd, 0x75, 0xa2, 0x6e, 0xbd,
+	0x86, 0x18, 0x20, 0x41, 0x1f, 0x3b, 0x51, 0xd7, 0xbc, 0x06, 0x0b, 0x0a, 0x21, 0x74, 0x4e, 0x7a,
+	0xd4, 0xf1, 0xea, 0xe3, 0x88, 0x33, 0x2f, 0xa1, 0x7b, 0x12, 0x68, 0xae, 0xc0, 0x44, 0x9f, 0x7a,
+	0xa4, 0x57, 0x9f, 0xc4, 0x59, 0x39, 0x10, 0x04, 0x30, 0xf2, 0x62, 0x40, 0x22, 0x4e, 0x3c, 0xbb,
+	0x75, 0x52, 0x9f, 0x92, 0x04, 0x68, 0xd8, 0xd6, 0x89, 0x20, 0xc0, 0x89, 0x22, 0xbf, 0x13, 0x10,
+	0xcf, 0xe6, 0xb4, 0x3e, 0x2d, 0x09, 0x88, 0x41, 0x07, 0x54, 0xec, 0x21, 0x34, 0xc2, 0x3e, 0x22,
+	0x2c, 0xf2, 0x69, 0x50, 0x9f, 0x91, 0x7b, 0x08, 0xd8, 0x27, 0x12, 0x64, 0xbe, 0x0e, 0xd0, 0x77,
+	0x5e, 0xda, 0x9c, 0x1e, 0x92, 0x20, 0xaa, 0xc3, 0x9a, 0xb1, 0x3e, 0x6e, 0xcd, 0xf4, 0x9d, 0x97,
+	0x07, 0x08, 0x30, 0x6f, 0x83, 0xa9, 0x58, 0x40, 0x0c, 0xdb, 0xa5, 0x83, 0x80, 0xd7, 0x67, 0x11,
+	0x6d, 0x49, 0xce, 0x20, 0xe6, 0xb6, 0x80, 0x9b, 0xb7, 0x60, 0x59, 0xd1, 0x67, 0x73, 0xbf, 0x4f,
+	0x22, 0xee, 0xf4, 0xc3, 0xfa, 0xdc, 0x9a, 0xb1, 0x5e, 0xb3, 0x96, 0xd4, 0xc4, 0x41, 0x0c, 0x37,
+	0xef, 0x80, 0x89, 0x7a, 0xd8, 0x26, 0xcc, 0x16, 0x14, 0x3b, 0x7c, 0xc0, 0x48, 0x7d, 0x01, 0x49,
+	0x5c, 0x8e, 0x67, 0xf6, 0xe3, 0x09, 0xf3, 0x06, 0x2c, 0x52, 0xe6, 0x77, 0xfc, 0xc0, 0xe9, 0xd9,
+	0xf2, 0xe0, 0xfa, 0x22, 0xe2, 0x2e, 0xc4, 0xe0, 0x3d, 0x84, 0x9a, 0x57, 0x61, 0x3e, 0x16, 0x12,
+	0xb3, 0x0f, 0xc9, 0x49, 0x7d, 0x09, 0xd1, 0xb4, 0x34, 0xd9, 0x77, 0xc8, 0x89, 0xf9, 0x0d, 0x71,
+	0x35, 0xbe, 0x4b, 0xec, 0x17, 0x03, 0xca, 0xf1, 0x82, 0x97, 0x91, 0xa7, 0x39, 0x84, 0xfe, 0xba,
+	0x00, 0x3e, 0xf6, 0x1e, 0xcc, 0x09, 0x4d, 0x8c, 0x55, 0xa2, 0xf9, 0x08, 0x2e, 0xe5, 0x34, 0x28,
+	0xd6, 0x2f, 0x41, 0x5e, 0x42, 0x5f, 0x02, 0x8f, 0xbc, 0x54, 0x1a, 0xb5, 0x30, 0x54, 0x19, 0x01,
+	0x6d, 0xfe, 0xf7, 0x38, 0x98, 0xbb, 0x51, 0xe7, 0x43, 0x3f, 0xf0, 0xa3, 0xee, 0x2b, 0xd2, 0x44,
+	0x64, 0x59, 0x12, 0x92, 0xd4, 0xc5, 0xb9, 0x18, 0x88, 0xda, 0x78, 0x13, 0x96, 0x34, 0x52, 0x5a,
+	0x1f, 0x17, 0x63, 0x78, 0xac, 0x91, 0xc5, 0xb7, 0x3e, 0x51, 0x72, 0xeb, 0x6f, 0xc3, 0x05, 0x97,
+	0xf6, 0xc3, 0x1e, 0x11, 0xfe, 0x24, 0xb5, 0x62, 0x12, 0x57, 0xac, 0x0c, 0x67, 0x13, 0xab, 0xae,
+	0xc0, 0x2c, 0x79, 0x49, 0xdc, 0x41, 0x4a, 0xbd, 0x21, 0x06, 0x6d, 0x9d, 0x08, 0xeb, 0x89, 0xb5,
+	0x80, 0x49, 0x1c, 0xa9, 0xe0, 0xf3, 0x09, 0xe8, 0xd6, 0x49, 0xb1, 0xce, 0xcd, 0x9c, 0x49, 0xe7,
+	0xa0, 0x4c, 0xe7, 0xee, 0x80, 0x29, 0x09, 0xa2, 0x49, 0xf4, 0x59, 0x89, 0x1e, 0xcf, 0x0c, 0xd1,
+	0xb3, 0x26, 0x3b, 0x97, 0x37, 0xd9, 0x02, 0x2d, 0x9e, 0x2f, 0xd4, 0x62, 0xed, 0x14, 0x16, 0x92,
+	0x4e, 0x21, 0xa7, 0xdb, 0x8b, 0x79, 0xdd, 0xce, 0x68, 0xed, 0x0e, 0x34, 0xf2, 0xea, 0x76, 0x76,
+	0xb5, 0xfd, 0x4b, 0x03, 0x7d, 0xeb, 0xfe, 0xa0, 0xd5, 0xf7, 0xf9, 0x53, 0x72, 0xbc, 0xe7, 0x30,
+	0xee, 0xbb, 0x7e, 0xe8, 0x04, 0xbc, 0x42, 0x77, 0x97, 0xa0, 0x36, 0x60, 0x3d, 0xa5, 0xb2, 0xe2,
+	0xa7, 0xe0, 0xe0, 0xc8, 0xe9, 0xf9, 0x9e, 0x98, 0x46, 0x0e, 0x94, 0xaa, 0x6a, 0xa0, 0xb0, 0xce,
+	0xd7, 0x01, 0x44, 0x38, 0x53, 0x3c, 0x4a, 0x25, 0x9d, 0x91, 0x90, 0x3c, 0x83, 0x6d, 0xb8, 0x52,
+	0x42, 0x98, 0xe6, 0xf2, 0x16, 0x2c, 0x87, 0x43, 0x70, 0x8a, 0xcf, 0xa5, 0xc4, 0x04, 0x72, 0x6a,
+	0x5e, 0x80, 0xc9, 0x88, 0x3b, 0x7c, 0x10, 0x29, 0xb2, 0xd5, 0xa8, 0xf9, 0xbf, 0x06, 0xcc, 0xef,
+	0x46, 0x9d, 0x4f, 0x24, 0xa1, 0xc2, 0x77, 0x96, 0xf3, 0xbd, 0x00, 0x63, 0xda, 0x52, 0xc7, 0x7c,
+	0x2f, 0x67, 0xc3, 0xb5, 0xbc, 0x0d, 0xdf, 0x29, 0x33, 0xcf, 0xad, 0xb1, 0xba, 0x91, 0x37, 0xd1,
+	0x9c, 0xc9, 0x4f, 0x14, 0x98, 0xfc, 0x0a, 0x4c, 0x1c, 0x39, 0xbd, 0x01, 0x41, 0x43, 0x34, 0x2c,
+	0x39, 0x30, 0x9b, 0x42, 0x4d, 0x8f, 0x34, 0x1b, 0x68, 0x7a, 0xd3, 0x56, 0x0a, 0x96, 0x11, 0xf1,
+	0x45, 0xf8, 0xa5, 0x14, 0xe7, 0x3a, 0xaa, 0xfe, 0xd4, 0x48, 0x0b, 0xff, 0x79, 0xd0, 0x1e, 0x04,
+	0x1e, 0xf1, 0x4e, 0xa7, 0x1d, 0x75, 0x98, 0x72, 0x64, 0x12, 0xa1, 0x44, 0x15, 0x0f, 0x63, 0xbd,
+	0xa9, 0x0d, 0xf5, 0xe6, 0x22, 0x4c, 0x85, 0x83, 0x56, 0x42, 0x1f, 0x26, 0xc3, 0x41, 0x4b, 0xe8,
+	0x4a, 0x4e, 0xa1, 0x26, 0x46, 0x2a, 0xd4, 0x64, 0xb5, 0x42, 0xdd, 0x84, 0x1b, 0x23, 0x78, 0xd2,
+	0xfc, 0xff, 0x81, 0x01, 0x17, 0x76, 0xa3, 0xce, 0xe3, 0x40, 0x9d, 0x46, 0x5e, 0x91, 0x43, 0x5f,
+	0x83, 0x59, 0x3f, 0xd0, 0x1c, 0x0c, 0xd5, 0x45, 0x83, 0x32, 0x24, 0xaf, 0xc1, 0x6a, 0x31, 0x19,
+	0x59, 0x4a, 0x2d, 0xf2, 0xb5, 0xa0, 0xb4, 0x80, 0x0c, 0x4d, 0x69, 0x80, 0x99, 0xe8, 0x76, 0xcf,
+	0xf1, 0xfb, 0x16, 0x39, 0x76, 0x98, 0x17, 0x55, 0x50, 0x68, 0xc2, 0x78, 0x44, 0x88, 0xa4, 0xac,
+	0x66, 0xe1, 0x6f, 0x8c, 0x2c, 0x21, 0x75, 0xbb, 0xca, 0xce, 0x6b, 0x18, 0x84, 0x00, 0x41, 0x68,
+	0xe1, 0x19, 0x8a, 0x1e, 0xa3, 0x63, 0x4b, 0x9e, 0xa7, 0xfd, 0xc6, 0x05, 0x98, 0x74, 0xfa, 0x18,
+	0xc9, 0x0c, 0xdc, 0x44, 0x8d, 0x04, 0x9c, 0x91, 0x68, 0xd0, 0xe3, 0xb1, 0x8b, 0x90, 0xa3, 0xe6,
+	0xcf, 0x0c, 0x99, 0x64, 0xa2, 0xea, 0xec, 0x51, 0x77, 0xcb, 0xe1, 0x6e, 0xb7, 0x82, 0xfa, 0x0f,
+	0xe0, 0x72, 0x48, 0x5d, 0x3b, 0xe2, 0x4e, 0x87, 0x88, 0x7f, 0x19, 0xb7, 0x5b, 0x3d, 0xea, 0x1e,
+	0xda, 0x5d, 0xe2, 0x77, 0xba, 0x5c, 0x71, 0x55, 0x0f, 0xa9, 0xbb, 0x2f, 0x50, 0x30, 0xf5, 0xd8,
+	0x12, 0x08, 0x1f, 0xe3, 0xbc, 0x79, 0x09, 0xa6, 0x5b, 0xe2, 0x88, 0xa1, 0x4b, 0x99, 0xc2, 0xf1,
+	0x63, 0x4f, 0x90, 0x18, 0xd0, 0xc0, 0x25, 0x51, 0x7d, 0x7c, 0xad, 0xb6, 0x5e, 0xb3, 0xd4, 0x48,
+	0x08, 0xcc, 0xf3, 0x23, 0x11, 0xcc, 0x6b, 0xeb, 0x86, 0x85, 0xbf, 0x85, 0x6d, 0x61, 0x9a, 0xe8,
+	0x7b, 0xca, 0x34, 0x26, 0xc5, 0x30, 0x97, 0xff, 0xbc, 0x26, 0xf3, 0x9f, 0x14, 0x73, 0xfa, 0xd6,
+	0xfe, 0xb9, 0x86, 0xfa, 0xa5, 0x67, 0x4f, 0xe5, 0x26, 0x37, 0xe1, 0x7c, 0xd2, 0x2f, 0xa7, 0x9d,
+	0x81, 0x99, 0x98, 0x52, 0x6f, 0x8d, 0x91, 0x02, 0xab, 0x8d, 0x10, 0xd8, 0x59, 0xa4, 0x82, 0x1e,
+	0xd6, 0x25, 0xfe, 0x11, 0xf1, 0x6c, 0x9c, 0x9c, 0xc4, 0xc9, 0xb9, 0x18, 0xf8, 0x48, 0x20, 0x5d,
+	0x82, 0x69, 0x66, 0x73, 0x87, 0x75, 0x08, 0x47, 0x3f, 0x6a, 0x58, 0x53, 0xec, 0x00, 0x87, 0x22,
+	0xb4, 0xb6, 0x99, 0x33, 0xf0, 0x6c, 0xde, 0x65, 0x24, 0xea, 0xd2, 0x9e, 0x87, 0x09, 0x8c, 0x61,
+	0x2d, 0x20, 0xf8, 0x20, 0x86, 0x9a, 0xaf, 0xc1, 0x4c, 0x60, 0x2b, 0x8b, 0x51, 0x99, 0xcb, 0x74,
+	0xa0, 0x6c, 0x59, 0xa4, 0x20, 0x21, 0xa3, 0x2d, 0xa7, 0xe5, 0xf7, 0x7c, 0x7e, 0x62, 0x77, 0x69,
+	0x40, 0x22, 0x8e, 0x19, 0x8b, 0x61, 0x2d, 0x27, 0x66, 0x3e, 0xc6, 0x09, 0x91, 0x34, 0xc9, 0x43,
+	0x3d, 0xc2, 0x89, 0xcb, 0x89, 0x87, 0xd9, 0xca, 0xb4, 0x35, 0x8f, 0xd0, 0x47, 0x0a, 0x58, 0x68,
+	0x93, 0x05, 0x57, 0xa7, 0x6f, 0xf7, 0x08, 0x43, 0x9f, 0xc4, 0xd8, 0x17, 0x36, 0x56, 0x7e, 0xa7,
+	0x19, 0xeb, 0x1b, 0xcb, 0x5a, 0x9f, 0x79, 0x19, 0x66, 0x86, 0xb9, 0x94, 0xd4, 0xda, 0x21, 0xa0,
+	0x30, 0xf0, 0x0c, 0xcf, 0xd5, 0x04, 0xb9, 0x70, 0x55, 0x4f, 0x3c, 0x0f, 0x7c, 0xfe, 0xac, 0xbd,
+	0x4d, 0xfb, 0xe1, 0x80, 0x93, 0x3d, 0x91, 0xbf, 0xef, 0x31, 0x1a, 0xd2, 0xc8, 0xe9, 0x55, 0x90,
+	0xb9, 0x02, 0x13, 0x98, 0xea, 0x2b, 0x02, 0xe5, 0x20, 0x73, 0xfa, 0x1d, 0xb8, 0x75, 0x8a, 0x43,
+	0x34, 0x4d, 0x7f, 0x5d, 0x83, 0x25, 0xf4, 0x6d, 0x1d, 0x5f, 0xa4, 0x62, 0xbb, 0x98, 0xb1, 0x5d,
+	0xce, 0x3d, 0xa2, 0x93, 0x4f, 0x65, 0xf9, 0x84, 0x0c, 0x69, 0x24, 0x13, 0xc6, 0x31, 0xfd, 0x84,
+	0x44, 0xd0, 0xd6, 0x89, 0x4a, 0x24, 0x6a, 0x3a, 0x91, 0x78, 0x00, 0x8d, 0x41, 0xe0, 0xf3, 0xc8,
+	0xa6, 0x6d, 0xdb, 0x95, 0xc4, 0xd8, 0x21, 0x61, 0x32, 0xeb, 0xc6, 0xc8, 0x38, 0x6e, 0x5d, 0x40,
+	0x8c, 0x21, 0xb1, 0x84, 0x61, 0xda, 0x2d, 0xcc, 0xbc, 0xdb, 0xb6, 0x19, 0x09, 0xa9, 0x8a, 0x91,
+	0x93, 0xdd, 0xb6, 0x45, 0x42, 0x2a, 0x14, 0xb0, 0x8b, 0xdb, 0xf5, 0x7d, 0xae, 0x3c, 0xc0, 0x74,
+	0x57, 0x2c, 0xef, 0xfb, 0x1c, 0x1f, 0x88, 0x82, 0x13, 0xdb, 0x61, 0x9d, 0xa8, 0x3e, 0xb5, 0x56,
+	0x13, 0x1c, 0x20, 0xe4, 0x21, 0xeb, 0x44, 0xe6, 0x79, 0x98, 0x38, 0xb2, 0x99, 0xd3, 0x47, 0xdd,
+	0x1e, 0xb7, 0xc6, 0x8f, 0x2c, 0xa7, 0x6f, 0xbe, 0x09, 0x2b, 0xbc, 0xcb, 0xe8, 0xa0, 0xd3, 0x0d,
+	0x07, 0x1c, 0xe9, 0x43, 0x3b, 0x43, 0xe5, 0x1e, 0xb7, 0xcc, 0xe1, 0xdc, 0x1e, 0x61, 0x4f, 0xc5,
+	0x8c, 0xf9, 0x0c, 0x56, 0x86, 0xd9, 0x47, 0xc2, 0x62, 0x00, 0x2b, 0x08, 0x97, 0x0b, 0x2b, 0x08,
+	0x8f, 0x88, 0xeb, 0xf7, 0x9d, 0x9e, 0x75, 0x7e, 0xb8, 0x52, 0x1b, 0xd5, 0x83, 0x85, 0x74, 0x11,
+	0xa1, 0xd9, 0x80, 0x7a, 0xf6, 0x6e, 0xf4, 0xc5, 0xfd, 0xcc, 0x40, 0x35, 0xdb, 0x16, 0xd7, 0x4e,
+	0x0e, 0x54, 0x71, 0xe6, 0xc0, 0x89, 0x0e, 0x2b, 0xf4, 0xe7, 0x77, 0xc0, 0xd4, 0xd5, 0x19, 0x46,
+	0x22, 0x3a, 0x60, 0xc2, 0xab, 0x8c, 0xad, 0xd5, 0xd6, 0x67, 0xef, 0xdd, 0x2d, 0x24, 0x37, 0xde,
+	0xf8, 0x63, 0xb5, 0xcc, 0x8a, 0x57, 0x59, 0xcb, 0xdd, 0x2c, 0xc8, 0x7c, 0x1f, 0x26, 0x5d, 0x1a,
+	0xb4, 0xfd, 0x0e, 0xde, 0xfd, 0xec, 0xbd, 0xab, 0x95, 0x5b, 0x6e, 0x23, 0xaa, 0xa5, 0x96, 0x64,
+	0xb4, 0xf8, 0x13, 0x78, 0xbd, 0x90, 0x39, 0x1d, 0xe5, 0xde, 0x81, 0x71, 0xee, 0x44, 0x87, 0xc8,
+	0xe1, 0xec, 0xbd, 0x37, 0x2a, 0x4f, 0xc2, 0x85, 0x88, 0xde, 0xfc, 0x37, 0x23, 0x61, 0x9c, 0x31,
+	0x53, 0x8f, 0xfc, 0x76, 0xbb, 0x42, 0x6a, 0x1f, 0xc1, 0x7c, 0x40, 0x8e, 0x9f, 0x09, 0xf9, 0xfb,
+	0x6d, 0x1f, 0xe3, 0x76, 0xad, 0xf4, 0xcc, 0x78, 0xcf, 0xa7, 0xd4, 0x23, 0x56, 0x7a, 0x9d, 0xf9,
+	0x3e, 0x4c, 0x31, 0xd2, 0xa7, 0x47, 0x44, 0x18, 0xc7, 0x29, 0xb7, 0x88, 0x57, 0x64, 0xe4, 0x73,
+	0x05, 0xe5, 0x93, 0x67, 0x43, 0xab, 0x87, 0x07, 0x6f, 0xc4, 0x09, 0x42, 0x52, 0x0c, 0x1f, 0x52,
+	0xf6, 0x10, 0x4b, 0x2d, 0x7d, 0x52, 0x99, 0xe5, 0x5e, 0x84, 0x29, 0x21, 0xaf, 0x38, 0x7f, 0x1a,
+	0xb7, 0x26, 0xc5, 0x30, 0x17, 0x5d, 0x6f, 0xc1, 0xcd, 0x91, 0xa7, 0x68, 0x92, 0xfe, 0x4e, 0xca,
+	0x5e, 0xce, 0x9c, 0x52, 0x63, 0xcb, 0xe8, 0x30, 0x3f, 0x82, 0x19, 0x55, 0x33, 0x22, 0x91, 0x92,
+	0xe6, 0xcd, 0x91, 0x4a, 0xf0, 0x50, 0xad, 0xb0, 0x86, 0x6b, 0x0b, 0xe5, 0x9a, 0x27, 0x51, 0x33,
+	0xf1, 0x8f, 0xf2, 0x49, 0x29, 0x35, 0x13, 0xb3, 0x6b, 0xa7, 0xf7, 0x3c, 0xec, 0x30, 0xc7, 0x23,
+	0x23, 0xdc, 0xe6, 0x05, 0x98, 0x4c, 0x64, 0x48, 0xe3, 0x96, 0x1a, 0x89, 0x64, 0x34, 0x51, 0xdb,
+	0x8a, 0x93, 0xd1, 0x64, 0xb9, 0x6b, 0x1d, 0x16, 0x9d, 0xd0, 0xdf, 0xf2, 0x03, 0x87, 0xf9, 0x24,
+	0xfa, 0x76, 0x44, 0x83, 0xb8, 0x06, 0x92, 0x01, 0xe7, 0x1c, 0xc8, 0x1b, 0xf8, 0xd2, 0x29, 0x22,
+	0x56, 0x33, 0xf4, 0x63, 0x23, 0x91, 0x21, 0xc5, 0x2c, 0x7f, 0xe7, 0xc8, 0x22, 0x2e, 0x65, 0x55,
+	0x21, 0xf3, 0x02, 0xa8, 0xab, 0xc8, 0x5c, 0xcc, 0x1a, 0xcc, 0x26, 0x72, 0xa0, 0x98, 0x9d, 0x04,
+	0x48, 0xbc, 0x93, 0x86, 0x2f, 0x22, 0xf1, 0x73, 0xf8, 0xe4, 0x93, 0x2e, 0x5e, 0x0e, 0x32, 0x37,
+	0x73, 0x15, 0x15, 0xba, 0x98, 0x4c, 0xcd, 0xcc, 0x00, 0xd3, 0xf0, 0x6f, 0x53, 0x5f, 0x5f, 0x5e,
+	0x05, 0x07, 0x0f, 0xa0, 0xc6, 0xc8, 0x0b, 0x55, 0xef, 0x5d, 0x2f, 0x54, 0x9e, 0xe4, 0x4e, 0x96,
+	0x2c, 0x57, 0x58, 0x62, 0x51, 0x86, 0xb6, 0xdf, 0x44, 0x9d, 0x48, 0x23, 0x2b, 0x3f, 0xf5, 0x81,
+	0x7e, 0x98, 0x4b, 0x4f, 0x75, 0xbd, 0xf0, 0x9c, 0xdd, 0x27, 0xc2, 0xd8, 0x71, 0xf1, 0x3e, 0x62,
+	0xeb, 0x07, 0xfc, 0x31, 0xac, 0xec, 0x46, 0x1d, 0xed, 0x86, 0x89, 0xc3, 0x78, 0x8b, 0x38, 0x55,
+	0xa6, 0xfb, 0x5e, 0x92, 0xad, 0x6b, 0xc5, 0x1e, 0x26, 0xde, 0xa6, 0x82, 0xa7, 0xef, 0xc1, 0xe5,
+	0xa2, 0x83, 0x35, 0x63, 0x0f, 0x60, 0x5c, 0x3c, 0xdb, 0x2b, 0xd9, 0xca, 0xad, 0xb2, 0x70, 0x4d,
+	0xf3, 0x85, 0xcc, 0xcc, 0x08, 0xdf, 0x72, 0x18, 0xf3, 0x09, 0xab, 0xe0, 0xe6, 0x7e, 0x92, 0x9b,
+	0xe2, 0x53, 0x86, 0xfb, 0x54, 0xb0, 0x73, 0x20, 0xfd, 0x7e, 0x02, 0x55, 0xf1, 0xf1, 0x7e, 0x8a,
+	0x8f, 0x1b, 0x23, 0x4f, 0x48, 0x31, 0xf2, 0x03, 0xdc, 0x35, 0x79, 0xf1, 0xf2, 0xfa, 0x7e, 0x21,
+	0x5a, 0x67, 0xa3, 0xaf, 0xca, 0x1f, 0xfe, 0xca, 0x74, 0xef, 0x07, 0x58, 0x85, 0x93, 0xde, 0xe3,
+	0xd1, 0xa0, 0xdf, 0x3f, 0x39, 0xa5, 0xd3, 0x8e, 0x63, 0xf3, 0xd8, 0x99, 0x62, 0x73, 0x86, 0xbb,
+	0xdf, 0x82, 0x66, 0xf9, 0xe1, 0xff, 0xdf, 0x34, 0xe0, 0xdf, 0xc7, 0xf0, 0xcd, 0xbb, 0x85, 0x9d,
+	0xa5, 0x9d, 0x97, 0x6e, 0xd7, 0x09, 0x3a, 0xe8, 0xbf, 0x87, 0x45, 0x01, 0xe5, 0xbf, 0x35, 0x40,
+	0x38, 0x36, 0x59, 0xee, 0xdc, 0xee, 0x3a, 0x7e, 0x10, 0x97, 0x15, 0x12, 0x20, 0xe1, 0xa7, 0x5d,
+	0x1a, 0x70, 0xe6, 0xb8, 0xf1, 0xdb, 0x4f, 0xb9, 0xbf, 0x2c, 0xd8, 0x6c, 0xc2, 0x1c, 0x3d, 0x0e,
+	0x08, 0x8b, 0xd1, 0xa4, 0x2f, 0x4c, 0xc1, 0xf0, 0x3c, 0x31, 0xde, 0xc3, 0x92, 0x91, 0x72, 0x8d,
+	0x49, 0x50, 0xe2, 0xa5, 0xaf, 0x5e, 0xc0, 0xea, 0xa5, 0xbf, 0x06, 0xb3, 0xf8, 0xc0, 0x7c, 0x3a,
+	0xe8, 0xb7, 0x08, 0x8b, 0x9b, 0x30, 0x09, 0x90, 0xac, 0xa6, 0xb9, 0xc4, 0x0f, 0x65, 0xf9, 0x50,
+	0x15, 0xa9, 0x53, 0xb0, 0x04, 0x4e, 0x64, 0x51, 0xca, 0x55, 0x1f, 0x26, 0x05, 0x53, 0xf1, 0x46,
+	0xcb, 0xa8, 0x79, 0x0b, 0x63, 0x49, 0x5a, 0xac, 0xfa, 0xae, 0xe4, 0xb3, 0xc0, 0x88, 0x9f, 0x05,
+	0xcd, 0x1f, 0x19, 0x32, 0xd8, 0x7a, 0xde, 0xf3, 0x48, 0xa4, 0xfb, 0xf1, 0x3d, 0x3d, 0xec, 0xf5,
+	0xe8, 0xf1, 0x13, 0xf1, 0x50, 0xad, 0x0e, 0xa8, 0xe5, 0x95, 0xb8, 0x77, 0x60, 0x9c, 0xd1, 0x9e,
+	0x7c, 0xa8, 0x2d, 0x8c, 0xd0, 0x0a, 0x8b, 0xf6, 0x84, 0x35, 0xd3, 0x1e, 0xc9, 0x45, 0xcf, 0x1b,
+	0x70, 0xad, 0x92, 0x3e, 0x1d, 0x76, 0xfe, 0xde, 0x40, 0x65, 0xb5, 0x30, 0x55, 0x13, 0xc8, 0x1f,
+	0x32, 0xda, 0xff, 0xda, 0xb1, 0x73, 0x1b, 0x36, 0x46, 0x13, 0xa9, 0x79, 0xfa, 0x5b, 0x55, 0x3b,
+	0x27, 0xfc, 0xac, 0x8c, 0x88, 0x59, 0x49, 0xb9, 0x7a, 0x5c, 0x88, 0xd9, 0x18, 0xf0, 0xaa, 0x98,
+	0x91, 0x99, 0x4d, 0x11, 0x75, 0x9a, 0x83, 0xff, 0x31, 0x60, 0x71, 0x1f, 0xbb, 0x8a, 0x3b, 0xd8,
+	0xf5, 0x10, 0xa9, 0x54, 0xb6, 0x38, 0x68, 0xe4, 0x8b, 0x83, 0x45, 0xd5, 0xb9, 0x53, 0xf5, 0xaa,
+	0x0a, 0x9b, 0x3a, 0xe3, 0x25, 0x4d, 0x9d, 0x7c, 0xa3, 0x68, 0xa2, 0xa8, 0x51, 0xb4, 0x09, 0xe7,
+	0x49, 0x4c, 0x7c, 0xa2, 0x9b, 0x23, 0xed, 0xdd, 0xd4, 0x53, 0xba, 0x9d, 0xd3, 0xfc, 0x2f, 0x03,
+	0x9d, 0xb6, 0xcc, 0x93, 0x74, 0x9d, 0x72, 0xe7, 0xc8, 0xf7, 0x46, 0x94, 0x4d, 0x1b, 0x30, 0x1d,
+	0x37, 0x87, 0x94, 0xfe, 0xe9, 0xb1, 0xf9, 0x2d, 0x98, 0x68, 0xfb, 0x2c, 0xe2, 0xea, 0x5d, 0xf7,
+	0x8d, 0xe2, 0x20, 0x99, 0x16, 0xf5, 0xd6, 0xf8, 0x67, 0x3f, 0xbf, 0x72, 0xce, 0x92, 0x0b, 0xcd,
+	0x2d, 0x98, 0x8c, 0x88, 0x4b, 0x03, 0xd9, 0x1e, 0x38, 0xdb, 0x16, 0x6a, 0x65, 0x26, 0x3e, 0xb8,
+	0x68, 0x72, 0x25, 0x7c, 0x6a, 0x9f, 0x73, 0x0d, 0x16, 0xa2, 0x9e, 0x13, 0x75, 0x89, 0x67, 0xa7,
+	0x8a, 0xa2, 0xf3, 0x0a, 0xfa, 0x30, 0x51, 0x1b, 0x3d, 0x76, 0x98, 0x4e, 0x66, 0xe5, 0xa8, 0xf9,
+	0x7d, 0x38, 0xbf, 0x1b, 0x75, 0x1e, 0xf9, 0x51, 0x38, 0x78, 0x55, 0xc5, 0xe7, 0x0c, 0x1b, 0xbf,
+	0x0a, 0xaf, 0x15, 0x9c, 0xa0, 0xe9, 0x5f, 0x05, 0xd0, 0xde, 0x55, 0x84, 0x71, 0x61, 0x4c, 0x09,
+	0x48, 0xd3, 0xc2, 0xea, 0xcd, 0x23, 0x12, 0xd2, 0xc8, 0xe7, 0xdb, 0x8c, 0x78, 0x3e, 0xaf, 0xce,
+	0xd9, 0x95, 0x14, 0xc6, 0x92, 0xa5, 0xe1, 0x0c, 0x49, 0xf7, 0xb1, 0xea, 0x90, 0xda, 0x53, 0xd3,
+	0x23, 0x6c, 0xfb, 0xc8, 0xf1, 0x7b, 0x4e, 0xab, 0x47, 0x94, 0x28, 0x87, 0x80, 0xe6, 0x3e, 0x46,
+	0xd5, 0xef, 0xfa, 0xbc, 0xeb, 0x31, 0xe7, 0xf8, 0x15, 0x91, 0xb3, 0x85, 0x31, 0x25, 0xbd, 0x69,
+	0xf2, 0x7e, 0x19, 0xe9, 0x11, 0x47, 0xd8, 0xa5, 0x7c, 0x5c, 0x19, 0x68, 0x70, 0xf3, 0x0a, 0x2a,
+	0x4b, 0xa8, 0xcd, 0x3f, 0x1a, 0xc3, 0x34, 0xfa, 0x23, 0x86, 0x0d, 0x33, 0x25, 0x64, 0x11, 0x42,
+	0x2b, 0x4b, 0x82, 0x87, 0xe4, 0x24, 0x53, 0xde, 0x85, 0x43, 0x72, 0x12, 0xc7, 0xe7, 0x0d, 0x58,
+	0x96, 0x35, 0xc3, 0x28, 0x24, 0x81, 0x67, 0xf7, 0xfc, 0xbe, 0xcf, 0x55, 0xdd, 0x7e, 0x11, 0x27,
+	0xf6, 0x05, 0xfc, 0x89, 0x00, 0x0b, 0x32, 0x1d, 0xe1, 0x9f, 0x88, 0x67, 0x63, 0x15, 0x4a, 0x96,
+	0x72, 0x67, 0xac, 0x79, 0x05, 0xc5, 0xd2, 0x4e, 0x24, 0x6e, 0x9b, 0xbc, 0x0c, 0x7d, 0x26, 0x5b,
+	0x5c, 0x13, 0xc8, 0x49, 0x02, 0x62, 0xbe, 0x05, 0x17, 0x86, 0xdf, 0x3d, 0x60, 0x89, 0x4a, 0xf9,
+	0x15, 0xd5, 0xb4, 0x3e, 0xaf, 0xbf, 0x81, 0xd8, 0xd3, 0xc9, 0x70, 0x46, 0x9a, 0xab, 0x98, 0xd6,
+	0xe7, 0x04, 0xa1, 0x9d, 0xe6, 0xf7, 0x31, 0xa3, 0xb5, 0xc8, 0x11, 0x3d, 0x24, 0xaf, 0x48, 0x52,
+	0x85, 0x4f, 0xec, 0xfc, 0x09, 0xc9, 0x17, 0xe9, 0x0a, 0x62, 0x20, 0xfd, 0x7b, 0xfa, 0xdb, 0x86,
+	0x0a, 0x12, 0x2e, 0xc1, 0xb4, 0xac, 0xf7, 0x69, 0x93, 0x9b, 0xc2, 0xf1, 0x63, 0xcf, 0xbc, 0x01,
+	0x8b, 0xde, 0x40, 0xca, 0x4f, 0x96, 0xdd, 0x23, 0x55, 0x70, 0x5f, 0x88, 0xc1, 0x58, 0x6b, 0x8f,
+	0x84, 0xe9, 0xca, 0xcf, 0x00, 0x5a, 0x03, 0xaf, 0x43, 0xb8, 0xaa, 0x4b, 0xce, 0x22, 0x6c, 0x0b,
+	0x41, 0x19, 0x46, 0xfe, 0xd4, 0x40, 0x59, 0xe6, 0xe8, 0xd4, 0xca, 0x79, 0x09, 0xa6, 0xf5, 0x97,
+	0x1a, 0xd2, 0x56, 0xa6, 0x5e, 0xc8, 0x8f, 0x34, 0xcc, 0xeb, 0xb0, 0xa8, 0x2b, 0xa0, 0x76, 0xb2,
+	0xa6, 0x3b, 0x1f, 0xaa, 0xca, 0x27, 0xee, 0x27, 0x62, 0xca, 0xf0, 0xfe, 0xd3, 0x0d, 0x83, 0xa5,
+	0xe1, 0x84, 0xd2, 0xf2, 0x5f, 0xc6, 0xd7, 0xef, 0x13, 0xe2, 0x1c, 0x91, 0xa7, 0xf2, 0xdb, 0xaa,
+	0x72, 0x91, 0xe5, 0x6c, 0xfe, 0x62, 0x66, 0xa9, 0xe6, 0xe2, 0x75, 0xa1, 0x94, 0x3e, 0xb7, 0x51,
+	0xa7, 0x63, 0x9b, 0x17, 0x90, 0x1d, 0x01, 0x68, 0xfe, 0xc5, 0x18, 0x7a, 0x30, 0xfd, 0xfd, 0x52,
+	0xfc, 0xcc, 0xdf, 0x63, 0xb4, 0xed, 0xf7, 0xaa, 0x2e, 0xed, 0x2a, 0xcc, 0x0f, 0x7d, 0xe5, 0xb0,
+	0xe3, 0x3e, 0x74, 0xa0, 0xcf, 0x19, 0x7e, 0x51, 0xe4, 0xf9, 0x51, 0xd8, 0x73, 0x4e, 0xec, 0xc0,
+	0xe9, 0xc7, 0xb5, 0xf7, 0x59, 0x05, 0x7b, 0xea, 0xf4, 0xf1, 0x84, 0x63, 0xd2, 0x8a, 0x7c, 0x4e,
+	0x54, 0x1e, 0x1d, 0x0f, 0xf1, 0x6c, 0x1a, 0x70, 0xc7, 0xe5, 0x2a, 0xba, 0xc6, 0x43, 0xa1, 0xb3,
+	0x8c, 0x74, 0x84, 0x4c, 0x5d, 0xea, 0xc5, 0xf1, 0x14, 0x24, 0x68, 0x9b, 0x7a, 0x44, 0x04, 0xde,
+	0x68, 0x10, 0x86, 0x94, 0x71, 0xe2, 0xc9, 0xcf, 0xee, 0x5c, 0xda, 0x8b, 0x30, 0x97, 0x9e, 0xb7,
+	0x4c, 0x3d, 0xb5, 0x17, 0xcf, 0x64, 0xe4, 0xf9, 0x5d, 0x2c, 0xf5, 0x97, 0x09, 0x45, 0xcb, 0xf6,
+	0x4d, 0x58, 0x19, 0xb0, 0x9e, 0x4d, 0xda, 0x6d, 0xe2, 0x72, 0xff, 0x28, 0xe3, 0xc4, 0xcc, 0x01,
+	0xeb, 0xed, 0xc4, 0x53, 0xf2, 0x8e, 0x37, 0xee, 0xc0, 0x5c, 0x32, 0x3b, 0x32, 0xe7, 0x61, 0xc6,
+	0x7a, 0xf6, 0x64, 0xc7, 0xde, 0xf9, 0x8d, 0x9d, 0xed, 0xa5, 0x73, 0xe6, 0x02, 0x00, 0x0e, 0xf7,
+	0x0f, 0x1e, 0x5a, 0x07, 0x4b, 0xc6, 0xbd, 0x1f, 0x5f, 0x85, 0xda, 0x6e, 0xd4, 0x31, 0x5b, 0x30,
+	0x97, 0xfa, 0x4c, 0xae, 0x38, 0xfe, 0x66, 0xbe, 0x43, 0x6b, 0xdc, 0x3e, 0x0d, 0x96, 0x66, 0xa6,
+	0x0b, 0x0b, 0x99, 0x2f, 0xd5, 0xae, 0x97, 0xad, 0x4f, 0xe3, 0x35, 0xee, 0x9e, 0x0e, 0x4f, 0x9f,
+	0x74, 0x08, 0x8b, 0xd9, 0x4f, 0x91, 0x6e, 0x94, 0x6d, 0x91, 0x41, 0x6c, 0x6c, 0x9e, 0x12, 0x51,
+	0x1f, 0xf6, 0xbb, 0xb0, 0x52, 0xf8, 0x01, 0x49, 0xa9, 0x70, 0x8a, 0xb0, 0x1b, 0x6f, 0x9f, 0x05,
+	0x5b, 0x9f, 0xfd, 0xdb, 0x00, 0x89, 0x9e, 0x64, 0xb3, 0x6c, 0x8f, 0x21, 0x4e, 0x63, 0x63, 0x34,
+	0x8e, 0xde, 0xfd, 0xcf, 0x0d, 0xb8, 0x5c, 0xf9, 0x15, 0xc4, 0x68, 0xa2, 0x0b, 0x56, 0x35, 0x7e,
+	0xe5, 0xab, 0xac, 0xd2, 0x44, 0x1d, 0xc3, 0xf9, 0xa2, 0x2f, 0x13, 0x6e, 0x95, 0x6d, 0x5a, 0x80,
+	0xdc, 0x78, 0xeb, 0x0c, 0xc8, 0xc9, 0x83, 0x8b, 0x3e, 0x34, 0x28, 0x3d, 0xb8, 0x00, 0xb9, 0xfc,
+	0xe0, 0x8a, 0x6f, 0x07, 0x84, 0x6d, 0xa6, 0x3e, 0x1c, 0x28, 0xb5, 0xcd, 0x24, 0x56, 0xb9, 0x6d,
+	0x16, 0x7e, 0x14, 0x20, 0x6c, 0x33, 0xdd, 0xe0, 0xbf, 0x5e, 0x7d, 0x4b, 0x31, 0x5e, 0x85, 0x6d,
+	0x16, 0xf6, 0xd4, 0x85, 0x18, 0x8b, 0xfa, 0xe9, 0xb7, 0x46, 0x6e, 0x93, 0x50, 0xe2, 0xb7, 0xce,
+	0x80, 0x9c, 0xb4, 0x95, 0x44, 0xaf, 0xb7, 0x59, 0xbd, 0x85, 0xc0, 0x29, 0xb7, 0x95, 0x7c, 0xef,
+	0xd6, 0xfc, 0x91, 0x01, 0x6b, 0x23, 0x3b, 0xb7, 0xf7, 0xab, 0x37, 0x2c, 0x5f, 0xd9, 0xf8, 0xd6,
+	0x57, 0x5d, 0xa9, 0x09, 0x24, 0x30, 0x9f, 0x6e, 0xe2, 0x5e, 0x2b, 0xd7, 0xc5, 0x04, 0x5a, 0xe3,
+	0xce, 0xa9, 0xd0, 0xf4, 0x31, 0x1c, 0xcc, 0x82, 0x96, 0x63, 0xa9, 0x24, 0xf3, 0xb8, 0x8d, 0x7b,
+	0xa7, 0xc7, 0x4d, 0x9e, 0x5a, 0xd0, 0xb2, 0x1b, 0x71, 0x7f, 0x49, 0xdc, 0xf2, 0x53, 0xcb, 0x7b,
+	0x68, 0xc2, 0xf3, 0x17, 0xf6, 0x79, 0x6e, 0x57, 0x73, 0x90, 0xc6, 0x2e, 0xf7, 0xfc, 0x55, 0x6d,
+	0x19, 0xf3, 0xaf, 0x0c, 0x58, 0x1d, 0xd1, 0xbd, 0x7b, 0xb7, 0xd2, 0x03, 0x94, 0xae, 0x6b, 0x7c,
+	0xf0, 0xd5, 0xd6, 0x25, 0x2f, 0xa3, 0xa0, 0x87, 0x57, 0x7a, 0x19, 0x79, 0xdc, 0xf2, 0xcb, 0x28,
+	0x6f, 0xbc, 0x99, 0x3f, 0x34, 0xe0, 0x42, 0x49, 0x93, 0x6a, 0x84, 0x8b, 0xca, 0xe2, 0x37, 0xde,
+	0x3d, 0x1b, 0x7e, 0xd2, 0x51, 0xa7, 0x5a, 0x4b, 0xa5, 0x8e, 0x3a, 0x89, 0x55, 0xee, 0xa8, 0x0b,
+	0xfb, 0x45, 0x2f, 0x60, 0x39, 0xdf, 0xec, 0xb9, 0x59, 0xb6, 0x45, 0x0e, 0xb5, 0xf1, 0xcd, 0x53,
+	0xa3, 0xa6, 0x1c, 0xe7, 0xb0, 0x15, 0x53, 0xee, 0x38, 0x35, 0x4e, 0x85, 0xe3, 0xcc, 0xf7, 0x57,
+	0x38, 0x98, 0x05, 0xfd, 0x91, 0x8d, 0xd3, 0x08, 0x45, 0xe2, 0x96, 0x6b, 0x4b, 0x45, 0xeb, 0xe3,
+	0xf7, 0x0d, 0xb8, 0x58, 0xd6, 0xb8, 0xd8, 0xac, 0x36, 0xc8, 0xdc, 0x82, 0xc6, 0x7b, 0x67, 0x5c,
+	0x90, 0x8c, 0xba, 0x99, 0x16, 0x43, 0x69, 0xd4, 0x4d, 0xe3, 0x95, 0x47, 0xdd, 0x92, 0xda, 0xfa,
+	0x9f, 0x18, 0xd0, 0xa8, 0x28, 0xa4, 0x97, 0x1b, 0x5c, 0xe9, 0x9a, 0xc6, 0x83, 0xb3, 0xaf, 0xd1,
+	0xe4, 0xfc, 0x8d, 0x01, 0x57, 0x46, 0x55, 0xc3, 0xdf, 0x2b, 0x0f, 0x3c, 0x95, 0x0b, 0x1b, 0xbf,
+	0xf6, 0x15, 0x17, 0xa6, 0x32, 0xfa, 0xa2, 0xb2, 0xf6, 0xed, 0x0a, 0xb5, 0xce, 0x93, 0xf1, 0xf6,
+	0x59, 0xb0, 0x53, 0x8a, 0x59, 0x56, 0x9c, 0xdd, 0xac, 0xf6, 0x4b, 0xb9, 0x05, 0xe5, 0x8a, 0x39,
+	0xaa, 0x2c, 0x1a, 0xc0, 0x52, 0xae, 0xa8, 0xb9, 0x5e, 0xb6, 0x59, 0x16, 0xb3, 0xf1, 0xe6, 0x69,
+	0x31, 0x93, 0xc9, 0x49, 0xba, 0x46, 0x59, 0x9a, 0x9c, 0xa4, 0xd0, 0xca, 0x93, 0x93, 0xe2, 0xea,
+	0x64, 0x17, 0x16, 0x32, 0xc5, 0xc7, 0x52, 0x7b, 0x4b, 0xe3, 0x95, 0xdb, 0x5b, 0x49, 0xdd, 0xf1,
+	0x05, 0x2c, 0xe7, 0x8b, 0x89, 0xa5, 0x6e, 0x3a, 0x87, 0x5a, 0xee, 0xa6, 0x4b, 0x2b, 0x73, 0xc2,
+	0x91, 0x16, 0x94, 0xe5, 0x36, 0x2a, 0x5e, 0x1c, 0x19, 0xdc, 0x72, 0x47, 0x5a, 0x5e, 0x8c, 0x13,
+	0x8c, 0xe6, 0x0b, 0x71, 0x37, 0xcb, 0x37, 0xca, 0xa0, 0x96, 0x33, 0x5a, 0x5e, 0x36, 0x6b, 0xc1,
+	0x5c, 0xaa, 0x86, 0x55, 0x1a, 0x66, 0x93, 0x58, 0xe5, 0x61, 0xb6, 0xb0, 0xa8, 0xf5, 0x87, 0x06,
+	0xd4, 0x4b, 0x4b, 0x56, 0x6f, 0x8e, 0x2c, 0x7b, 0x64, 0x56, 0x34, 0xee, 0x9f, 0x75, 0x45, 0x4c,
+	0x48, 0x63, 0xe2, 0x87, 0x5f, 0x7e, 0xba, 0x61, 0x6c, 0x3d, 0xfb, 0xec, 0xf3, 0x55, 0xe3, 0x27,
+	0x9f, 0xaf, 0x1a, 0xff, 0xf9, 0xf9, 0xaa, 0xf1, 0x67, 0x5f, 0xac, 0x9e, 0xfb, 0xc9, 0x17, 0xab,
+	0xe7, 0xfe, 0xe3, 0x8b, 0xd5, 0x73, 0xdf, 0x7b, 0xa7, 0xe3, 0xf3, 0xee, 0xa0, 0x75, 0xd7, 0xa5,
+	0xfd, 0xcd, 0x90, 0x51, 0x6f, 0xe0, 0xf2, 0xc8, 0xf5, 0x33, 0x7f, 0x22, 0x99, 0xfc, 0xfb, 0x42,
+	0x7e, 0x12, 0x92, 0xa8, 0x35, 0x89, 0x35, 0xab, 0xb7, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x1f,
+	0xfe, 0xd3, 0xcb, 0x99, 0x3a, 0x00, 0x00,
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-func (m *MsgStartInference) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
 
-func (m *MsgStartInference) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	StartInference(ctx context.Context, in *MsgStartInference, opts ...grpc.CallOption) (*MsgStartInferenceResponse, error)
+	FinishInference(ctx context.Context, in *MsgFinishInference, opts ...grpc.CallOption) (*MsgFinishInferenceResponse, error)
+	SubmitNewParticipant(ctx context.Context, in *MsgSubmitNewParticipant, opts ...grpc.CallOption) (*MsgSubmitNewParticipantResponse, error)
+	Validation(ctx context.Context, in *MsgValidation, opts ...grpc.CallOption) (*MsgValidationResponse, error)
+	SubmitNewUnfundedParticipant(ctx context.Context, in *MsgSubmitNewUnfundedParticipant, opts ...grpc.CallOption) (*MsgSubmitNewUnfundedParticipantResponse, error)
+	InvalidateInference(ctx context.Context, in *MsgInvalidateInference, opts ...grpc.CallOption) (*MsgInvalidateInferenceResponse, error)
+	RevalidateInference(ctx context.Context, in *MsgRevalidateInference, opts ...grpc.CallOption) (*MsgRevalidateInferenceResponse, error)
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	SubmitPocBatch(ctx context.Context, in *MsgSubmitPocBatch, opts ...grpc.CallOption) (*MsgSubmitPocBatchResponse, error)
+	SubmitPocValidation(ctx context.Context, in *MsgSubmitPocValidation, opts ...grpc.CallOption) (*MsgSubmitPocValidationResponse, error)
+	SubmitSeed(ctx context.Context, in *MsgSubmitSeed, opts ...grpc.CallOption) (*MsgSubmitSeedResponse, error)
+	SubmitUnitOfComputePriceProposal(ctx context.Context, in *MsgSubmitUnitOfComputePriceProposal, opts ...grpc.CallOption) (*MsgSubmitUnitOfComputePriceProposalResponse, error)
+	RegisterModel(ctx context.Context, in *MsgRegisterModel, opts ...grpc.CallOption) (*MsgRegisterModelResponse, error)
+	CreateTrainingTask(ctx context.Context, in *MsgCreateTrainingTask, opts ...grpc.CallOption) (*MsgCreateTrainingTaskResponse, error)
+	SubmitHardwareDiff(ctx context.Context, in *MsgSubmitHardwareDiff, opts ...grpc.CallOption) (*MsgSubmitHardwareDiffResponse, error)
+	CreatePartialUpgrade(ctx context.Context, in *MsgCreatePartialUpgrade, opts ...grpc.CallOption) (*MsgCreatePartialUpgradeResponse, error)
+	ClaimTrainingTaskForAssignment(ctx context.Context, in *MsgClaimTrainingTaskForAssignment, opts ...grpc.CallOption) (*MsgClaimTrainingTaskForAssignmentResponse, error)
+	AssignTrainingTask(ctx context.Context, in *MsgAssignTrainingTask, opts ...grpc.CallOption) (*MsgAssignTrainingTaskResponse, error)
+	SubmitTrainingKvRecord(ctx context.Context, in *MsgSubmitTrainingKvRecord, opts ...grpc.CallOption) (*MsgSubmitTrainingKvRecordResponse, error)
+	JoinTraining(ctx context.Context, in *MsgJoinTraining, opts ...grpc.CallOption) (*MsgJoinTrainingResponse, error)
+	TrainingHeartbeat(ctx context.Context, in *MsgTrainingHeartbeat, opts ...grpc.CallOption) (*MsgTrainingHeartbeatResponse, error)
+	SetBarrier(ctx context.Context, in *MsgSetBarrier, opts ...grpc.CallOption) (*MsgSetBarrierResponse, error)
+	JoinTrainingStatus(ctx context.Context, in *MsgJoinTrainingStatus, opts ...grpc.CallOption) (*MsgJoinTrainingStatusResponse, error)
+	CreateDummyTrainingTask(ctx context.Context, in *MsgCreateDummyTrainingTask, opts ...grpc.CallOption) (*MsgCreateDummyTrainingTaskResponse, error)
+	BridgeExchange(ctx context.Context, in *MsgBridgeExchange, opts ...grpc.CallOption) (*MsgBridgeExchangeResponse, error)
+	AddUserToTrainingAllowList(ctx context.Context, in *MsgAddUserToTrainingAllowList, opts ...grpc.CallOption) (*MsgAddUserToTrainingAllowListResponse, error)
+	RemoveUserFromTrainingAllowList(ctx context.Context, in *MsgRemoveUserFromTrainingAllowList, opts ...grpc.CallOption) (*MsgRemoveUserFromTrainingAllowListResponse, error)
+	SetTrainingAllowList(ctx context.Context, in *MsgSetTrainingAllowList, opts ...grpc.CallOption) (*MsgSetTrainingAllowListResponse, error)
+	SubmitInferenceEvidence(ctx context.Context, in *MsgSubmitInferenceEvidence, opts ...grpc.CallOption) (*MsgSubmitInferenceEvidenceResponse, error)
+	DisputeInference(ctx context.Context, in *MsgDisputeInference, opts ...grpc.CallOption) (*MsgDisputeInferenceResponse, error)
+	DepositCredit(ctx context.Context, in *MsgDepositCredit, opts ...grpc.CallOption) (*MsgDepositCreditResponse, error)
+	WithdrawCredit(ctx context.Context, in *MsgWithdrawCredit, opts ...grpc.CallOption) (*MsgWithdrawCreditResponse, error)
+	GrantInferenceKey(ctx context.Context, in *MsgGrantInferenceKey, opts ...grpc.CallOption) (*MsgGrantInferenceKeyResponse, error)
+	RevokeInferenceKey(ctx context.Context, in *MsgRevokeInferenceKey, opts ...grpc.CallOption) (*MsgRevokeInferenceKeyResponse, error)
+	RequestPriceQuote(ctx context.Context, in *MsgRequestPriceQuote, opts ...grpc.CallOption) (*MsgRequestPriceQuoteResponse, error)
+	LeaveNetwork(ctx context.Context, in *MsgLeaveNetwork, opts ...grpc.CallOption) (*MsgLeaveNetworkResponse, error)
+	UpdateParticipantProfile(ctx context.Context, in *MsgUpdateParticipantProfile, opts ...grpc.CallOption) (*MsgUpdateParticipantProfileResponse, error)
 }
 
-func (m *MsgStartInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OriginalPrompt) > 0 {
-		i -= len(m.OriginalPrompt)
-		copy(dAtA[i:], m.OriginalPrompt)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPrompt)))
-		i--
-		dAtA[i] = 0x7a
-	}
-	if len(m.TransferSignature) > 0 {
-		i -= len(m.TransferSignature)
-		copy(dAtA[i:], m.TransferSignature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferSignature)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.RequestTimestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RequestTimestamp))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.PromptTokenCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PromptTokenCount))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.MaxTokens != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxTokens))
-		i--
-		dAtA[i] = 0x50
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.NodeVersion) > 0 {
-		i -= len(m.NodeVersion)
-		copy(dAtA[i:], m.NodeVersion)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeVersion)))
-		i--
-		dAtA[i] = 0x4a
+	return out, nil
+}
+
+func (c *msgClient) StartInference(ctx context.Context, in *MsgStartInference, opts ...grpc.CallOption) (*MsgStartInferenceResponse, error) {
+	out := new(MsgStartInferenceResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/StartInference", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.AssignedTo) > 0 {
-		i -= len(m.AssignedTo)
-		copy(dAtA[i:], m.AssignedTo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AssignedTo)))
-		i--
-		dAtA[i] = 0x42
+	return out, nil
+}
+
+func (c *msgClient) FinishInference(ctx context.Context, in *MsgFinishInference, opts ...grpc.CallOption) (*MsgFinishInferenceResponse, error) {
+	out := new(MsgFinishInferenceResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/FinishInference", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RequestedBy) > 0 {
-		i -= len(m.RequestedBy)
-		copy(dAtA[i:], m.RequestedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestedBy)))
-		i--
-		dAtA[i] = 0x3a
+	return out, nil
+}
+
+func (c *msgClient) SubmitNewParticipant(ctx context.Context, in *MsgSubmitNewParticipant, opts ...grpc.CallOption) (*MsgSubmitNewParticipantResponse, error) {
+	out := new(MsgSubmitNewParticipantResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitNewParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Model) > 0 {
-		i -= len(m.Model)
-		copy(dAtA[i:], m.Model)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Model)))
-		i--
-		dAtA[i] = 0x32
+	return out, nil
+}
+
+func (c *msgClient) Validation(ctx context.Context, in *MsgValidation, opts ...grpc.CallOption) (*MsgValidationResponse, error) {
+	out := new(MsgValidationResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/Validation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PromptPayload) > 0 {
-		i -= len(m.PromptPayload)
-		copy(dAtA[i:], m.PromptPayload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PromptPayload)))
-		i--
-		dAtA[i] = 0x22
+	return out, nil
+}
+
+func (c *msgClient) SubmitNewUnfundedParticipant(ctx context.Context, in *MsgSubmitNewUnfundedParticipant, opts ...grpc.CallOption) (*MsgSubmitNewUnfundedParticipantResponse, error) {
+	out := new(MsgSubmitNewUnfundedParticipantResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitNewUnfundedParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PromptHash) > 0 {
-		i -= len(m.PromptHash)
-		copy(dAtA[i:], m.PromptHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PromptHash)))
-		i--
-		dAtA[i] = 0x1a
+	return out, nil
+}
+
+func (c *msgClient) InvalidateInference(ctx context.Context, in *MsgInvalidateInference, opts ...grpc.CallOption) (*MsgInvalidateInferenceResponse, error) {
+	out := new(MsgInvalidateInferenceResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/InvalidateInference", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.InferenceId) > 0 {
-		i -= len(m.InferenceId)
-		copy(dAtA[i:], m.InferenceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) RevalidateInference(ctx context.Context, in *MsgRevalidateInference, opts ...grpc.CallOption) (*MsgRevalidateInferenceResponse, error) {
+	out := new(MsgRevalidateInferenceResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RevalidateInference", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgStartInferenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitPocBatch(ctx context.Context, in *MsgSubmitPocBatch, opts ...grpc.CallOption) (*MsgSubmitPocBatchResponse, error) {
+	out := new(MsgSubmitPocBatchResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitPocBatch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgStartInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitPocValidation(ctx context.Context, in *MsgSubmitPocValidation, opts ...grpc.CallOption) (*MsgSubmitPocValidationResponse, error) {
+	out := new(MsgSubmitPocValidationResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitPocValidation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgStartInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InferenceIndex) > 0 {
-		i -= len(m.InferenceIndex)
-		copy(dAtA[i:], m.InferenceIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceIndex)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SubmitSeed(ctx context.Context, in *MsgSubmitSeed, opts ...grpc.CallOption) (*MsgSubmitSeedResponse, error) {
+	out := new(MsgSubmitSeedResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitSeed", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgFinishInference) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitUnitOfComputePriceProposal(ctx context.Context, in *MsgSubmitUnitOfComputePriceProposal, opts ...grpc.CallOption) (*MsgSubmitUnitOfComputePriceProposalResponse, error) {
+	out := new(MsgSubmitUnitOfComputePriceProposalResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitUnitOfComputePriceProposal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgFinishInference) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterModel(ctx context.Context, in *MsgRegisterModel, opts ...grpc.CallOption) (*MsgRegisterModelResponse, error) {
+	out := new(MsgRegisterModelResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterModel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFinishInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Model) > 0 {
-		i -= len(m.Model)
-		copy(dAtA[i:], m.Model)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Model)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.OriginalPrompt) > 0 {
-		i -= len(m.OriginalPrompt)
-		copy(dAtA[i:], m.OriginalPrompt)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPrompt)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.RequestedBy) > 0 {
-		i -= len(m.RequestedBy)
-		copy(dAtA[i:], m.RequestedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestedBy)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.ExecutorSignature) > 0 {
-		i -= len(m.ExecutorSignature)
-		copy(dAtA[i:], m.ExecutorSignature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutorSignature)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.TransferSignature) > 0 {
-		i -= len(m.TransferSignature)
-		copy(dAtA[i:], m.TransferSignature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferSignature)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.RequestTimestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RequestTimestamp))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.TransferredBy) > 0 {
-		i -= len(m.TransferredBy)
-		copy(dAtA[i:], m.TransferredBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferredBy)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.ExecutedBy) > 0 {
-		i -= len(m.ExecutedBy)
-		copy(dAtA[i:], m.ExecutedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutedBy)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.CompletionTokenCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CompletionTokenCount))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.PromptTokenCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PromptTokenCount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.ResponsePayload) > 0 {
-		i -= len(m.ResponsePayload)
-		copy(dAtA[i:], m.ResponsePayload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponsePayload)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ResponseHash) > 0 {
-		i -= len(m.ResponseHash)
-		copy(dAtA[i:], m.ResponseHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponseHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.InferenceId) > 0 {
-		i -= len(m.InferenceId)
-		copy(dAtA[i:], m.InferenceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CreateTrainingTask(ctx context.Context, in *MsgCreateTrainingTask, opts ...grpc.CallOption) (*MsgCreateTrainingTaskResponse, error) {
+	out := new(MsgCreateTrainingTaskResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreateTrainingTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgFinishInferenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitHardwareDiff(ctx context.Context, in *MsgSubmitHardwareDiff, opts ...grpc.CallOption) (*MsgSubmitHardwareDiffResponse, error) {
+	out := new(MsgSubmitHardwareDiffResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitHardwareDiff", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgFinishInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreatePartialUpgrade(ctx context.Context, in *MsgCreatePartialUpgrade, opts ...grpc.CallOption) (*MsgCreatePartialUpgradeResponse, error) {
+	out := new(MsgCreatePartialUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreatePartialUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFinishInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InferenceIndex) > 0 {
-		i -= len(m.InferenceIndex)
-		copy(dAtA[i:], m.InferenceIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceIndex)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ClaimTrainingTaskForAssignment(ctx context.Context, in *MsgClaimTrainingTaskForAssignment, opts ...grpc.CallOption) (*MsgClaimTrainingTaskForAssignmentResponse, error) {
+	out := new(MsgClaimTrainingTaskForAssignmentResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ClaimTrainingTaskForAssignment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSubmitNewParticipant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AssignTrainingTask(ctx context.Context, in *MsgAssignTrainingTask, opts ...grpc.CallOption) (*MsgAssignTrainingTaskResponse, error) {
+	out := new(MsgAssignTrainingTaskResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AssignTrainingTask", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSubmitNewParticipant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitTrainingKvRecord(ctx context.Context, in *MsgSubmitTrainingKvRecord, opts ...grpc.CallOption) (*MsgSubmitTrainingKvRecordResponse, error) {
+	out := new(MsgSubmitTrainingKvRecordResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitTrainingKvRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSubmitNewParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WorkerKey) > 0 {
-		i -= len(m.WorkerKey)
-		copy(dAtA[i:], m.WorkerKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WorkerKey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ValidatorKey) > 0 {
-		i -= len(m.ValidatorKey)
-		copy(dAtA[i:], m.ValidatorKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorKey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) JoinTraining(ctx context.Context, in *MsgJoinTraining, opts ...grpc.CallOption) (*MsgJoinTrainingResponse, error) {
+	out := new(MsgJoinTrainingResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/JoinTraining", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSubmitNewParticipantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TrainingHeartbeat(ctx context.Context, in *MsgTrainingHeartbeat, opts ...grpc.CallOption) (*MsgTrainingHeartbeatResponse, error) {
+	out := new(MsgTrainingHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/TrainingHeartbeat", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSubmitNewParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetBarrier(ctx context.Context, in *MsgSetBarrier, opts ...grpc.CallOption) (*MsgSetBarrierResponse, error) {
+	out := new(MsgSetBarrierResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SetBarrier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSubmitNewParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) JoinTrainingStatus(ctx context.Context, in *MsgJoinTrainingStatus, opts ...grpc.CallOption) (*MsgJoinTrainingStatusResponse, error) {
+	out := new(MsgJoinTrainingStatusResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/JoinTrainingStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ParticipantIndex) > 0 {
-		i -= len(m.ParticipantIndex)
-		copy(dAtA[i:], m.ParticipantIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ParticipantIndex)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) CreateDummyTrainingTask(ctx context.Context, in *MsgCreateDummyTrainingTask, opts ...grpc.CallOption) (*MsgCreateDummyTrainingTaskResponse, error) {
+	out := new(MsgCreateDummyTrainingTaskResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreateDummyTrainingTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgValidation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BridgeExchange(ctx context.Context, in *MsgBridgeExchange, opts ...grpc.CallOption) (*MsgBridgeExchangeResponse, error) {
+	out := new(MsgBridgeExchangeResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/BridgeExchange", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgValidation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Revalidation {
-		i--
-		if m.Revalidation {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Value != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
-		i--
-		dAtA[i] = 0x31
-	}
-	if len(m.ResponseHash) > 0 {
-		i -= len(m.ResponseHash)
-		copy(dAtA[i:], m.ResponseHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponseHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ResponsePayload) > 0 {
-		i -= len(m.ResponsePayload)
-		copy(dAtA[i:], m.ResponsePayload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponsePayload)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.InferenceId) > 0 {
-		i -= len(m.InferenceId)
-		copy(dAtA[i:], m.InferenceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) AddUserToTrainingAllowList(ctx context.Context, in *MsgAddUserToTrainingAllowList, opts ...grpc.CallOption) (*MsgAddUserToTrainingAllowListResponse, error) {
+	out := new(MsgAddUserToTrainingAllowListResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AddUserToTrainingAllowList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgValidationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveUserFromTrainingAllowList(ctx context.Context, in *MsgRemoveUserFromTrainingAllowList, opts ...grpc.CallOption) (*MsgRemoveUserFromTrainingAllowListResponse, error) {
+	out := new(MsgRemoveUserFromTrainingAllowListResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RemoveUserFromTrainingAllowList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgValidationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSubmitNewUnfundedParticipant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetTrainingAllowList(ctx context.Context, in *MsgSetTrainingAllowList, opts ...grpc.CallOption) (*MsgSetTrainingAllowListResponse, error) {
+	out := new(MsgSetTrainingAllowListResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SetTrainingAllowList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitNewUnfundedParticipant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgSubmitNewUnfundedParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WorkerKey) > 0 {
-		i -= len(m.WorkerKey)
-		copy(dAtA[i:], m.WorkerKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WorkerKey)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ValidatorKey) > 0 {
-		i -= len(m.ValidatorKey)
-		copy(dAtA[i:], m.ValidatorKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorKey)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SubmitInferenceEvidence(ctx context.Context, in *MsgSubmitInferenceEvidence, opts ...grpc.CallOption) (*MsgSubmitInferenceEvidenceResponse, error) {
+	out := new(MsgSubmitInferenceEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitInferenceEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSubmitNewUnfundedParticipantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DisputeInference(ctx context.Context, in *MsgDisputeInference, opts ...grpc.CallOption) (*MsgDisputeInferenceResponse, error) {
+	out := new(MsgDisputeInferenceResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/DisputeInference", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitNewUnfundedParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitNewUnfundedParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgInvalidateInference) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DepositCredit(ctx context.Context, in *MsgDepositCredit, opts ...grpc.CallOption) (*MsgDepositCreditResponse, error) {
+	out := new(MsgDepositCreditResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/DepositCredit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgInvalidateInference) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgInvalidateInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Invalidator) > 0 {
-		i -= len(m.Invalidator)
-		copy(dAtA[i:], m.Invalidator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Invalidator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.InferenceId) > 0 {
-		i -= len(m.InferenceId)
-		copy(dAtA[i:], m.InferenceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) WithdrawCredit(ctx context.Context, in *MsgWithdrawCredit, opts ...grpc.CallOption) (*MsgWithdrawCreditResponse, error) {
+	out := new(MsgWithdrawCreditResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/WithdrawCredit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgInvalidateInferenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GrantInferenceKey(ctx context.Context, in *MsgGrantInferenceKey, opts ...grpc.CallOption) (*MsgGrantInferenceKeyResponse, error) {
+	out := new(MsgGrantInferenceKeyResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/GrantInferenceKey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgInvalidateInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RevokeInferenceKey(ctx context.Context, in *MsgRevokeInferenceKey, opts ...grpc.CallOption) (*MsgRevokeInferenceKeyResponse, error) {
+	out := new(MsgRevokeInferenceKeyResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RevokeInferenceKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgInvalidateInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RequestPriceQuote(ctx context.Context, in *MsgRequestPriceQuote, opts ...grpc.CallOption) (*MsgRequestPriceQuoteResponse, error) {
+	out := new(MsgRequestPriceQuoteResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RequestPriceQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRevalidateInference) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) LeaveNetwork(ctx context.Context, in *MsgLeaveNetwork, opts ...grpc.CallOption) (*MsgLeaveNetworkResponse, error) {
+	out := new(MsgLeaveNetworkResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/LeaveNetwork", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRevalidateInference) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParticipantProfile(ctx context.Context, in *MsgUpdateParticipantProfile, opts ...grpc.CallOption) (*MsgUpdateParticipantProfileResponse, error) {
+	out := new(MsgUpdateParticipantProfileResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/UpdateParticipantProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRevalidateInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Invalidator) > 0 {
-		i -= len(m.Invalidator)
-		copy(dAtA[i:], m.Invalidator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Invalidator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.InferenceId) > 0 {
-		i -= len(m.InferenceId)
-		copy(dAtA[i:], m.InferenceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	StartInference(context.Context, *MsgStartInference) (*MsgStartInferenceResponse, error)
+	FinishInference(context.Context, *MsgFinishInference) (*MsgFinishInferenceResponse, error)
+	SubmitNewParticipant(context.Context, *MsgSubmitNewParticipant) (*MsgSubmitNewParticipantResponse, error)
+	Validation(context.Context, *MsgValidation) (*MsgValidationResponse, error)
+	SubmitNewUnfundedParticipant(context.Context, *MsgSubmitNewUnfundedParticipant) (*MsgSubmitNewUnfundedParticipantResponse, error)
+	InvalidateInference(context.Context, *MsgInvalidateInference) (*MsgInvalidateInferenceResponse, error)
+	RevalidateInference(context.Context, *MsgRevalidateInference) (*MsgRevalidateInferenceResponse, error)
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	SubmitPocBatch(context.Context, *MsgSubmitPocBatch) (*MsgSubmitPocBatchResponse, error)
+	SubmitPocValidation(context.Context, *MsgSubmitPocValidation) (*MsgSubmitPocValidationResponse, error)
+	SubmitSeed(context.Context, *MsgSubmitSeed) (*MsgSubmitSeedResponse, error)
+	SubmitUnitOfComputePriceProposal(context.Context, *MsgSubmitUnitOfComputePriceProposal) (*MsgSubmitUnitOfComputePriceProposalResponse, error)
+	RegisterModel(context.Context, *MsgRegisterModel) (*MsgRegisterModelResponse, error)
+	CreateTrainingTask(context.Context, *MsgCreateTrainingTask) (*MsgCreateTrainingTaskResponse, error)
+	SubmitHardwareDiff(context.Context, *MsgSubmitHardwareDiff) (*MsgSubmitHardwareDiffResponse, error)
+	CreatePartialUpgrade(context.Context, *MsgCreatePartialUpgrade) (*MsgCreatePartialUpgradeResponse, error)
+	ClaimTrainingTaskForAssignment(context.Context, *MsgClaimTrainingTaskForAssignment) (*MsgClaimTrainingTaskForAssignmentResponse, error)
+	AssignTrainingTask(context.Context, *MsgAssignTrainingTask) (*MsgAssignTrainingTaskResponse, error)
+	SubmitTrainingKvRecord(context.Context, *MsgSubmitTrainingKvRecord) (*MsgSubmitTrainingKvRecordResponse, error)
+	JoinTraining(context.Context, *MsgJoinTraining) (*MsgJoinTrainingResponse, error)
+	TrainingHeartbeat(context.Context, *MsgTrainingHeartbeat) (*MsgTrainingHeartbeatResponse, error)
+	SetBarrier(context.Context, *MsgSetBarrier) (*MsgSetBarrierResponse, error)
+	JoinTrainingStatus(context.Context, *MsgJoinTrainingStatus) (*MsgJoinTrainingStatusResponse, error)
+	CreateDummyTrainingTask(context.Context, *MsgCreateDummyTrainingTask) (*MsgCreateDummyTrainingTaskResponse, error)
+	BridgeExchange(context.Context, *MsgBridgeExchange) (*MsgBridgeExchangeResponse, error)
+	AddUserToTrainingAllowList(context.Context, *MsgAddUserToTrainingAllowList) (*MsgAddUserToTrainingAllowListResponse, error)
+	RemoveUserFromTrainingAllowList(context.Context, *MsgRemoveUserFromTrainingAllowList) (*MsgRemoveUserFromTrainingAllowListResponse, error)
+	SetTrainingAllowList(context.Context, *MsgSetTrainingAllowList) (*MsgSetTrainingAllowListResponse, error)
+	SubmitInferenceEvidence(context.Context, *MsgSubmitInferenceEvidence) (*MsgSubmitInferenceEvidenceResponse, error)
+	DisputeInference(context.Context, *MsgDisputeInference) (*MsgDisputeInferenceResponse, error)
+	DepositCredit(context.Context, *MsgDepositCredit) (*MsgDepositCreditResponse, error)
+	WithdrawCredit(context.Context, *MsgWithdrawCredit) (*MsgWithdrawCreditResponse, error)
+	GrantInferenceKey(context.Context, *MsgGrantInferenceKey) (*MsgGrantInferenceKeyResponse, error)
+	RevokeInferenceKey(context.Context, *MsgRevokeInferenceKey) (*MsgRevokeInferenceKeyResponse, error)
+	RequestPriceQuote(context.Context, *MsgRequestPriceQuote) (*MsgRequestPriceQuoteResponse, error)
+	LeaveNetwork(context.Context, *MsgLeaveNetwork) (*MsgLeaveNetworkResponse, error)
+	UpdateParticipantProfile(context.Context, *MsgUpdateParticipantProfile) (*MsgUpdateParticipantProfileResponse, error)
 }
 
-func (m *MsgRevalidateInferenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgRevalidateInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
-
-func (m *MsgRevalidateInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) StartInference(ctx context.Context, req *MsgStartInference) (*MsgStartInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartInference not implemented")
 }
-
-func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) FinishInference(ctx context.Context, req *MsgFinishInference) (*MsgFinishInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishInference not implemented")
+}
+func (*UnimplementedMsgServer) SubmitNewParticipant(ctx context.Context, req *MsgSubmitNewParticipant) (*MsgSubmitNewParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitNewParticipant not implemented")
+}
+func (*UnimplementedMsgServer) Validation(ctx context.Context, req *MsgValidation) (*MsgValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validation not implemented")
+}
+func (*UnimplementedMsgServer) SubmitNewUnfundedParticipant(ctx context.Context, req *MsgSubmitNewUnfundedParticipant) (*MsgSubmitNewUnfundedParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitNewUnfundedParticipant not implemented")
+}
+func (*UnimplementedMsgServer) InvalidateInference(ctx context.Context, req *MsgInvalidateInference) (*MsgInvalidateInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateInference not implemented")
+}
+func (*UnimplementedMsgServer) RevalidateInference(ctx context.Context, req *MsgRevalidateInference) (*MsgRevalidateInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevalidateInference not implemented")
+}
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (*UnimplementedMsgServer) SubmitPocBatch(ctx context.Context, req *MsgSubmitPocBatch) (*MsgSubmitPocBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPocBatch not implemented")
+}
+func (*UnimplementedMsgServer) SubmitPocValidation(ctx context.Context, req *MsgSubmitPocValidation) (*MsgSubmitPocValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPocValidation not implemented")
+}
+func (*UnimplementedMsgServer) SubmitSeed(ctx context.Context, req *MsgSubmitSeed) (*MsgSubmitSeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSeed not implemented")
+}
+func (*UnimplementedMsgServer) SubmitUnitOfComputePriceProposal(ctx context.Context, req *MsgSubmitUnitOfComputePriceProposal) (*MsgSubmitUnitOfComputePriceProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitUnitOfComputePriceProposal not implemented")
+}
+func (*UnimplementedMsgServer) RegisterModel(ctx context.Context, req *MsgRegisterModel) (*MsgRegisterModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterModel not implemented")
+}
+func (*UnimplementedMsgServer) CreateTrainingTask(ctx context.Context, req *MsgCreateTrainingTask) (*MsgCreateTrainingTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTrainingTask not implemented")
+}
+func (*UnimplementedMsgServer) SubmitHardwareDiff(ctx context.Context, req *MsgSubmitHardwareDiff) (*MsgSubmitHardwareDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitHardwareDiff not implemented")
+}
+func (*UnimplementedMsgServer) CreatePartialUpgrade(ctx context.Context, req *MsgCreatePartialUpgrade) (*MsgCreatePartialUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePartialUpgrade not implemented")
+}
+func (*UnimplementedMsgServer) ClaimTrainingTaskForAssignment(ctx context.Context, req *MsgClaimTrainingTaskForAssignment) (*MsgClaimTrainingTaskForAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimTrainingTaskForAssignment not implemented")
+}
+func (*UnimplementedMsgServer) AssignTrainingTask(ctx context.Context, req *MsgAssignTrainingTask) (*MsgAssignTrainingTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignTrainingTask not implemented")
+}
+func (*UnimplementedMsgServer) SubmitTrainingKvRecord(ctx context.Context, req *MsgSubmitTrainingKvRecord) (*MsgSubmitTrainingKvRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTrainingKvRecord not implemented")
+}
+func (*UnimplementedMsgServer) JoinTraining(ctx context.Context, req *MsgJoinTraining) (*MsgJoinTrainingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinTraining not implemented")
+}
+func (*UnimplementedMsgServer) TrainingHeartbeat(ctx context.Context, req *MsgTrainingHeartbeat) (*MsgTrainingHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrainingHeartbeat not implemented")
+}
+func (*UnimplementedMsgServer) SetBarrier(ctx context.Context, req *MsgSetBarrier) (*MsgSetBarrierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBarrier not implemented")
+}
+func (*UnimplementedMsgServer) JoinTrainingStatus(ctx context.Context, req *MsgJoinTrainingStatus) (*MsgJoinTrainingStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinTrainingStatus not implemented")
+}
+func (*UnimplementedMsgServer) CreateDummyTrainingTask(ctx context.Context, req *MsgCreateDummyTrainingTask) (*MsgCreateDummyTrainingTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDummyTrainingTask not implemented")
+}
+func (*UnimplementedMsgServer) BridgeExchange(ctx context.Context, req *MsgBridgeExchange) (*MsgBridgeExchangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeExchange not implemented")
+}
+func (*UnimplementedMsgServer) AddUserToTrainingAllowList(ctx context.Context, req *MsgAddUserToTrainingAllowList) (*MsgAddUserToTrainingAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserToTrainingAllowList not implemented")
+}
+func (*UnimplementedMsgServer) RemoveUserFromTrainingAllowList(ctx context.Context, req *MsgRemoveUserFromTrainingAllowList) (*MsgRemoveUserFromTrainingAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserFromTrainingAllowList not implemented")
+}
+func (*UnimplementedMsgServer) SetTrainingAllowList(ctx context.Context, req *MsgSetTrainingAllowList) (*MsgSetTrainingAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrainingAllowList not implemented")
+}
+func (*UnimplementedMsgServer) SubmitInferenceEvidence(ctx context.Context, req *MsgSubmitInferenceEvidence) (*MsgSubmitInferenceEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitInferenceEvidence not implemented")
+}
+func (*UnimplementedMsgServer) DisputeInference(ctx context.Context, req *MsgDisputeInference) (*MsgDisputeInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisputeInference not implemented")
+}
+func (*UnimplementedMsgServer) DepositCredit(ctx context.Context, req *MsgDepositCredit) (*MsgDepositCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositCredit not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawCredit(ctx context.Context, req *MsgWithdrawCredit) (*MsgWithdrawCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCredit not implemented")
+}
+func (*UnimplementedMsgServer) GrantInferenceKey(ctx context.Context, req *MsgGrantInferenceKey) (*MsgGrantInferenceKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantInferenceKey not implemented")
+}
+func (*UnimplementedMsgServer) RevokeInferenceKey(ctx context.Context, req *MsgRevokeInferenceKey) (*MsgRevokeInferenceKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInferenceKey not implemented")
+}
+func (*UnimplementedMsgServer) RequestPriceQuote(ctx context.Context, req *MsgRequestPriceQuote) (*MsgRequestPriceQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPriceQuote not implemented")
+}
+func (*UnimplementedMsgServer) LeaveNetwork(ctx context.Context, req *MsgLeaveNetwork) (*MsgLeaveNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveNetwork not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParticipantProfile(ctx context.Context, req *MsgUpdateParticipantProfile) (*MsgUpdateParticipantProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParticipantProfile not implemented")
 }
 
-func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
-		i--
-		dAtA[i] = 0x18
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Seed != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Seed))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/UpdateParams",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_StartInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStartInference)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Result) > 0 {
-		i -= len(m.Result)
-		copy(dAtA[i:], m.Result)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Result)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).StartInference(ctx, in)
 	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/StartInference",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartInference(ctx, req.(*MsgStartInference))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_FinishInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinishInference)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitPocBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).FinishInference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/FinishInference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinishInference(ctx, req.(*MsgFinishInference))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NodeId) > 0 {
-		i -= len(m.NodeId)
-		copy(dAtA[i:], m.NodeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeId)))
-		i--
-		dAtA[i] = 0x32
+func _Msg_SubmitNewParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitNewParticipant)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Dist) > 0 {
-		for iNdEx := len(m.Dist) - 1; iNdEx >= 0; iNdEx-- {
-			f2 := math.Float64bits(float64(m.Dist[iNdEx]))
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f2))
-		}
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Dist)*8))
-		i--
-		dAtA[i] = 0x2a
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitNewParticipant(ctx, in)
 	}
-	if len(m.Nonces) > 0 {
-		dAtA4 := make([]byte, len(m.Nonces)*10)
-		var j3 int
-		for _, num1 := range m.Nonces {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x22
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitNewParticipant",
 	}
-	if len(m.BatchId) > 0 {
-		i -= len(m.BatchId)
-		copy(dAtA[i:], m.BatchId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BatchId)))
-		i--
-		dAtA[i] = 0x1a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitNewParticipant(ctx, req.(*MsgSubmitNewParticipant))
 	}
-	if m.PocStageStartBlockHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
-		i--
-		dAtA[i] = 0x10
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Validation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgValidation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).Validation(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/Validation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Validation(ctx, req.(*MsgValidation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocBatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SubmitNewUnfundedParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitNewUnfundedParticipant)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitNewUnfundedParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitNewUnfundedParticipant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitNewUnfundedParticipant(ctx, req.(*MsgSubmitNewUnfundedParticipant))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocBatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_InvalidateInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInvalidateInference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InvalidateInference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/InvalidateInference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InvalidateInference(ctx, req.(*MsgInvalidateInference))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_RevalidateInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevalidateInference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevalidateInference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RevalidateInference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevalidateInference(ctx, req.(*MsgRevalidateInference))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocValidation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocValidation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SubmitPocBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPocBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitPocBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitPocBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitPocBatch(ctx, req.(*MsgSubmitPocBatch))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FraudDetected {
-		i--
-		if m.FraudDetected {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
+func _Msg_SubmitPocValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPocValidation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ProbabilityHonest != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ProbabilityHonest))))
-		i--
-		dAtA[i] = 0x51
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitPocValidation(ctx, in)
 	}
-	if m.NInvalid != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NInvalid))
-		i--
-		dAtA[i] = 0x48
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitPocValidation",
 	}
-	if m.FraudThreshold != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FraudThreshold))))
-		i--
-		dAtA[i] = 0x41
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitPocValidation(ctx, req.(*MsgSubmitPocValidation))
 	}
-	if m.RTarget != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RTarget))))
-		i--
-		dAtA[i] = 0x39
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSeed)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ReceivedDist) > 0 {
-		for iNdEx := len(m.ReceivedDist) - 1; iNdEx >= 0; iNdEx-- {
-			f5 := math.Float64bits(float64(m.ReceivedDist[iNdEx]))
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f5))
-		}
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceivedDist)*8))
-		i--
-		dAtA[i] = 0x32
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSeed(ctx, in)
 	}
-	if len(m.Dist) > 0 {
-		for iNdEx := len(m.Dist) - 1; iNdEx >= 0; iNdEx-- {
-			f6 := math.Float64bits(float64(m.Dist[iNdEx]))
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f6))
-		}
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Dist)*8))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitSeed",
 	}
-	if len(m.Nonces) > 0 {
-		dAtA8 := make([]byte, len(m.Nonces)*10)
-		var j7 int
-		for _, num1 := range m.Nonces {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintTx(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSeed(ctx, req.(*MsgSubmitSeed))
 	}
-	if m.PocStageStartBlockHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
-		i--
-		dAtA[i] = 0x18
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitUnitOfComputePriceProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitUnitOfComputePriceProposal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ParticipantAddress) > 0 {
-		i -= len(m.ParticipantAddress)
-		copy(dAtA[i:], m.ParticipantAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ParticipantAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitUnitOfComputePriceProposal(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitUnitOfComputePriceProposal",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitUnitOfComputePriceProposal(ctx, req.(*MsgSubmitUnitOfComputePriceProposal))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocValidationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RegisterModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterModel)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitPocValidationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitPocValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RegisterModel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterModel(ctx, req.(*MsgRegisterModel))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitSeed) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTrainingTask)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitSeed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTrainingTask(ctx, in)
 	}
-	if m.EpochIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/CreateTrainingTask",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTrainingTask(ctx, req.(*MsgCreateTrainingTask))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitSeedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SubmitHardwareDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitHardwareDiff)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitSeedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitSeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitHardwareDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitHardwareDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitHardwareDiff(ctx, req.(*MsgSubmitHardwareDiff))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitUnitOfComputePriceProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreatePartialUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePartialUpgrade)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitUnitOfComputePriceProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitUnitOfComputePriceProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Price != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Price))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePartialUpgrade(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/CreatePartialUpgrade",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePartialUpgrade(ctx, req.(*MsgCreatePartialUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitUnitOfComputePriceProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ClaimTrainingTaskForAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimTrainingTaskForAssignment)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitUnitOfComputePriceProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimTrainingTaskForAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/ClaimTrainingTaskForAssignment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimTrainingTaskForAssignment(ctx, req.(*MsgClaimTrainingTaskForAssignment))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitUnitOfComputePriceProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRegisterModel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AssignTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAssignTrainingTask)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).AssignTrainingTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/AssignTrainingTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AssignTrainingTask(ctx, req.(*MsgAssignTrainingTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterModel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SubmitTrainingKvRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitTrainingKvRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitTrainingKvRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitTrainingKvRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitTrainingKvRecord(ctx, req.(*MsgSubmitTrainingKvRecord))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValidationThreshold != nil {
-		{
-			size, err := m.ValidationThreshold.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+func _Msg_JoinTraining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinTraining)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ThroughputPerNonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ThroughputPerNonce))
-		i--
-		dAtA[i] = 0x48
+	if interceptor == nil {
+		return srv.(MsgServer).JoinTraining(ctx, in)
 	}
-	if m.VRam != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VRam))
-		i--
-		dAtA[i] = 0x40
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/JoinTraining",
 	}
-	if len(m.ModelArgs) > 0 {
-		for iNdEx := len(m.ModelArgs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ModelArgs[iNdEx])
-			copy(dAtA[i:], m.ModelArgs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ModelArgs[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinTraining(ctx, req.(*MsgJoinTraining))
 	}
-	if len(m.HfCommit) > 0 {
-		i -= len(m.HfCommit)
-		copy(dAtA[i:], m.HfCommit)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HfCommit)))
-		i--
-		dAtA[i] = 0x32
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TrainingHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTrainingHeartbeat)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.HfRepo) > 0 {
-		i -= len(m.HfRepo)
-		copy(dAtA[i:], m.HfRepo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HfRepo)))
-		i--
-		dAtA[i] = 0x2a
+	if interceptor == nil {
+		return srv.(MsgServer).TrainingHeartbeat(ctx, in)
 	}
-	if m.UnitsOfComputePerToken != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UnitsOfComputePerToken))
-		i--
-		dAtA[i] = 0x20
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/TrainingHeartbeat",
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x1a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TrainingHeartbeat(ctx, req.(*MsgTrainingHeartbeat))
 	}
-	if len(m.ProposedBy) > 0 {
-		i -= len(m.ProposedBy)
-		copy(dAtA[i:], m.ProposedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProposedBy)))
-		i--
-		dAtA[i] = 0x12
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBarrier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBarrier)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).SetBarrier(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SetBarrier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBarrier(ctx, req.(*MsgSetBarrier))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterModelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_JoinTrainingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinTrainingStatus)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).JoinTrainingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/JoinTrainingStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinTrainingStatus(ctx, req.(*MsgJoinTrainingStatus))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterModelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CreateDummyTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDummyTrainingTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDummyTrainingTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/CreateDummyTrainingTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDummyTrainingTask(ctx, req.(*MsgCreateDummyTrainingTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterModelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_BridgeExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBridgeExchange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BridgeExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/BridgeExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BridgeExchange(ctx, req.(*MsgBridgeExchange))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateTrainingTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddUserToTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddUserToTrainingAllowList)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddUserToTrainingAllowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/AddUserToTrainingAllowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddUserToTrainingAllowList(ctx, req.(*MsgAddUserToTrainingAllowList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateTrainingTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_RemoveUserFromTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveUserFromTrainingAllowList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveUserFromTrainingAllowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RemoveUserFromTrainingAllowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveUserFromTrainingAllowList(ctx, req.(*MsgRemoveUserFromTrainingAllowList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Config != nil {
-		{
-			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func _Msg_SetTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTrainingAllowList)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.HardwareResources) > 0 {
-		for iNdEx := len(m.HardwareResources) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HardwareResources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTrainingAllowList(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SetTrainingAllowList",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTrainingAllowList(ctx, req.(*MsgSetTrainingAllowList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SubmitInferenceEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitInferenceEvidence)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Task != nil {
-		{
-			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitInferenceEvidence(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitInferenceEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitInferenceEvidence(ctx, req.(*MsgSubmitInferenceEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitHardwareDiff) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DisputeInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisputeInference)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitHardwareDiff) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitHardwareDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Removed) > 0 {
-		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).DisputeInference(ctx, in)
 	}
-	if len(m.NewOrModified) > 0 {
-		for iNdEx := len(m.NewOrModified) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NewOrModified[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/DisputeInference",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisputeInference(ctx, req.(*MsgDisputeInference))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitHardwareDiffResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DepositCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositCredit)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitHardwareDiffResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitHardwareDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).DepositCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/DepositCredit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositCredit(ctx, req.(*MsgDepositCredit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimTrainingTaskForAssignment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_WithdrawCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawCredit)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/WithdrawCredit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawCredit(ctx, req.(*MsgWithdrawCredit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimTrainingTaskForAssignment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_GrantInferenceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantInferenceKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantInferenceKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/GrantInferenceKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantInferenceKey(ctx, req.(*MsgGrantInferenceKey))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimTrainingTaskForAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TaskId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x10
+func _Msg_RevokeInferenceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeInferenceKey)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeInferenceKey(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RevokeInferenceKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeInferenceKey(ctx, req.(*MsgRevokeInferenceKey))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimTrainingTaskForAssignmentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RequestPriceQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestPriceQuote)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RequestPriceQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RequestPriceQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestPriceQuote(ctx, req.(*MsgRequestPriceQuote))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimTrainingTaskForAssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_LeaveNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeaveNetwork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LeaveNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/LeaveNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeaveNetwork(ctx, req.(*MsgLeaveNetwork))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimTrainingTaskForAssignmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_UpdateParticipantProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParticipantProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParticipantProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/UpdateParticipantProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParticipantProfile(ctx, req.(*MsgUpdateParticipantProfile))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAssignTrainingTask) Marshal() (dAtA []byte, err error) {
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inference.inference.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "StartInference",
+			Handler:    _Msg_StartInference_Handler,
+		},
+		{
+			MethodName: "FinishInference",
+			Handler:    _Msg_FinishInference_Handler,
+		},
+		{
+			MethodName: "SubmitNewParticipant",
+			Handler:    _Msg_SubmitNewParticipant_Handler,
+		},
+		{
+			MethodName: "Validation",
+			Handler:    _Msg_Validation_Handler,
+		},
+		{
+			MethodName: "SubmitNewUnfundedParticipant",
+			Handler:    _Msg_SubmitNewUnfundedParticipant_Handler,
+		},
+		{
+			MethodName: "InvalidateInference",
+			Handler:    _Msg_InvalidateInference_Handler,
+		},
+		{
+			MethodName: "RevalidateInference",
+			Handler:    _Msg_RevalidateInference_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "SubmitPocBatch",
+			Handler:    _Msg_SubmitPocBatch_Handler,
+		},
+		{
+			MethodName: "SubmitPocValidation",
+			Handler:    _Msg_SubmitPocValidation_Handler,
+		},
+		{
+			MethodName: "SubmitSeed",
+			Handler:    _Msg_SubmitSeed_Handler,
+		},
+		{
+			MethodName: "SubmitUnitOfComputePriceProposal",
+			Handler:    _Msg_SubmitUnitOfComputePriceProposal_Handler,
+		},
+		{
+			MethodName: "RegisterModel",
+			Handler:    _Msg_RegisterModel_Handler,
+		},
+		{
+			MethodName: "CreateTrainingTask",
+			Handler:    _Msg_CreateTrainingTask_Handler,
+		},
+		{
+			MethodName: "SubmitHardwareDiff",
+			Handler:    _Msg_SubmitHardwareDiff_Handler,
+		},
+		{
+			MethodName: "CreatePartialUpgrade",
+			Handler:    _Msg_CreatePartialUpgrade_Handler,
+		},
+		{
+			MethodName: "ClaimTrainingTaskForAssignment",
+			Handler:    _Msg_ClaimTrainingTaskForAssignment_Handler,
+		},
+		{
+			MethodName: "AssignTrainingTask",
+			Handler:    _Msg_AssignTrainingTask_Handler,
+		},
+		{
+			MethodName: "SubmitTrainingKvRecord",
+			Handler:    _Msg_SubmitTrainingKvRecord_Handler,
+		},
+		{
+			MethodName: "JoinTraining",
+			Handler:    _Msg_JoinTraining_Handler,
+		},
+		{
+			MethodName: "TrainingHeartbeat",
+			Handler:    _Msg_TrainingHeartbeat_Handler,
+		},
+		{
+			MethodName: "SetBarrier",
+			Handler:    _Msg_SetBarrier_Handler,
+		},
+		{
+			MethodName: "JoinTrainingStatus",
+			Handler:    _Msg_JoinTrainingStatus_Handler,
+		},
+		{
+			MethodName: "CreateDummyTrainingTask",
+			Handler:    _Msg_CreateDummyTrainingTask_Handler,
+		},
+		{
+			MethodName: "BridgeExchange",
+			Handler:    _Msg_BridgeExchange_Handler,
+		},
+		{
+			MethodName: "AddUserToTrainingAllowList",
+			Handler:    _Msg_AddUserToTrainingAllowList_Handler,
+		},
+		{
+			MethodName: "RemoveUserFromTrainingAllowList",
+			Handler:    _Msg_RemoveUserFromTrainingAllowList_Handler,
+		},
+		{
+			MethodName: "SetTrainingAllowList",
+			Handler:    _Msg_SetTrainingAllowList_Handler,
+		},
+		{
+			MethodName: "SubmitInferenceEvidence",
+			Handler:    _Msg_SubmitInferenceEvidence_Handler,
+		},
+		{
+			MethodName: "DisputeInference",
+			Handler:    _Msg_DisputeInference_Handler,
+		},
+		{
+			MethodName: "DepositCredit",
+			Handler:    _Msg_DepositCredit_Handler,
+		},
+		{
+			MethodName: "WithdrawCredit",
+			Handler:    _Msg_WithdrawCredit_Handler,
+		},
+		{
+			MethodName: "GrantInferenceKey",
+			Handler:    _Msg_GrantInferenceKey_Handler,
+		},
+		{
+			MethodName: "RevokeInferenceKey",
+			Handler:    _Msg_RevokeInferenceKey_Handler,
+		},
+		{
+			MethodName: "RequestPriceQuote",
+			Handler:    _Msg_RequestPriceQuote_Handler,
+		},
+		{
+			MethodName: "LeaveNetwork",
+			Handler:    _Msg_LeaveNetwork_Handler,
+		},
+		{
+			MethodName: "UpdateParticipantProfile",
+			Handler:    _Msg_UpdateParticipantProfile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inference/inference/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6249,46 +6196,37 @@ func (m *MsgAssignTrainingTask) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAssignTrainingTask) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAssignTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Assignees) > 0 {
-		for iNdEx := len(m.Assignees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Assignees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.TaskId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAssignTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6298,12 +6236,12 @@ func (m *MsgAssignTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAssignTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAssignTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6311,7 +6249,7 @@ func (m *MsgAssignTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePartialUpgrade) Marshal() (dAtA []byte, err error) {
+func (m *MsgStartInference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6321,69 +6259,151 @@ func (m *MsgCreatePartialUpgrade) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreatePartialUpgrade) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgStartInference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreatePartialUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgStartInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ApiBinariesJson) > 0 {
-		i -= len(m.ApiBinariesJson)
-		copy(dAtA[i:], m.ApiBinariesJson)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ApiBinariesJson)))
+	if m.PriceQuoteId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PriceQuoteId))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	if len(m.NodeVersion) > 0 {
-		i -= len(m.NodeVersion)
-		copy(dAtA[i:], m.NodeVersion)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeVersion)))
+	if len(m.RequesterKey) > 0 {
+		i -= len(m.RequesterKey)
+		copy(dAtA[i:], m.RequesterKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequesterKey)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+	if len(m.OriginalPrompt) > 0 {
+		i -= len(m.OriginalPrompt)
+		copy(dAtA[i:], m.OriginalPrompt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPrompt)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x7a
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.TransferSignature) > 0 {
+		i -= len(m.TransferSignature)
+		copy(dAtA[i:], m.TransferSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferSignature)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x72
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCreatePartialUpgradeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.RequestTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestTimestamp))
+		i--
+		dAtA[i] = 0x60
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreatePartialUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	if m.PromptTokenCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PromptTokenCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxTokens != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxTokens))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.NodeVersion) > 0 {
+		i -= len(m.NodeVersion)
+		copy(dAtA[i:], m.NodeVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeVersion)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.AssignedTo) > 0 {
+		i -= len(m.AssignedTo)
+		copy(dAtA[i:], m.AssignedTo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssignedTo)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RequestedBy) > 0 {
+		i -= len(m.RequestedBy)
+		copy(dAtA[i:], m.RequestedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Model) > 0 {
+		i -= len(m.Model)
+		copy(dAtA[i:], m.Model)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Model)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PromptPayload) > 0 {
+		i -= len(m.PromptPayload)
+		copy(dAtA[i:], m.PromptPayload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PromptPayload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PromptHash) > 0 {
+		i -= len(m.PromptHash)
+		copy(dAtA[i:], m.PromptHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PromptHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartInferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreatePartialUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgStartInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.InferenceIndex) > 0 {
+		i -= len(m.InferenceIndex)
+		copy(dAtA[i:], m.InferenceIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitTrainingKvRecord) Marshal() (dAtA []byte, err error) {
+func (m *MsgFinishInference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6393,41 +6413,107 @@ func (m *MsgSubmitTrainingKvRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitTrainingKvRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFinishInference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitTrainingKvRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFinishInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+	if len(m.RequesterKey) > 0 {
+		i -= len(m.RequesterKey)
+		copy(dAtA[i:], m.RequesterKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequesterKey)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x7a
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
+	if len(m.Model) > 0 {
+		i -= len(m.Model)
+		copy(dAtA[i:], m.Model)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Model)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.OriginalPrompt) > 0 {
+		i -= len(m.OriginalPrompt)
+		copy(dAtA[i:], m.OriginalPrompt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPrompt)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RequestedBy) > 0 {
+		i -= len(m.RequestedBy)
+		copy(dAtA[i:], m.RequestedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestedBy)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ExecutorSignature) > 0 {
+		i -= len(m.ExecutorSignature)
+		copy(dAtA[i:], m.ExecutorSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutorSignature)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.TransferSignature) > 0 {
+		i -= len(m.TransferSignature)
+		copy(dAtA[i:], m.TransferSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferSignature)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RequestTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestTimestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.TransferredBy) > 0 {
+		i -= len(m.TransferredBy)
+		copy(dAtA[i:], m.TransferredBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferredBy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ExecutedBy) > 0 {
+		i -= len(m.ExecutedBy)
+		copy(dAtA[i:], m.ExecutedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CompletionTokenCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompletionTokenCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PromptTokenCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PromptTokenCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ResponsePayload) > 0 {
+		i -= len(m.ResponsePayload)
+		copy(dAtA[i:], m.ResponsePayload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponsePayload)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Participant) > 0 {
-		i -= len(m.Participant)
-		copy(dAtA[i:], m.Participant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+	if len(m.ResponseHash) > 0 {
+		i -= len(m.ResponseHash)
+		copy(dAtA[i:], m.ResponseHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponseHash)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.TaskId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -6439,7 +6525,7 @@ func (m *MsgSubmitTrainingKvRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitTrainingKvRecordResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgFinishInferenceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6449,20 +6535,27 @@ func (m *MsgSubmitTrainingKvRecordResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitTrainingKvRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFinishInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitTrainingKvRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFinishInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.InferenceIndex) > 0 {
+		i -= len(m.InferenceIndex)
+		copy(dAtA[i:], m.InferenceIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinTraining) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitNewParticipant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6472,25 +6565,34 @@ func (m *MsgJoinTraining) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTraining) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewParticipant) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinTraining) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Req != nil {
-		{
-			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.WorkerKey) > 0 {
+		i -= len(m.WorkerKey)
+		copy(dAtA[i:], m.WorkerKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WorkerKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValidatorKey) > 0 {
+		i -= len(m.ValidatorKey)
+		copy(dAtA[i:], m.ValidatorKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6504,7 +6606,7 @@ func (m *MsgJoinTraining) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinTrainingResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitNewParticipantResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6514,32 +6616,34 @@ func (m *MsgJoinTrainingResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTrainingResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinTrainingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.ParticipantIndex) > 0 {
+		i -= len(m.ParticipantIndex)
+		copy(dAtA[i:], m.ParticipantIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ParticipantIndex)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgTrainingHeartbeat) Marshal() (dAtA []byte, err error) {
+func (m *MsgValidation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6549,26 +6653,58 @@ func (m *MsgTrainingHeartbeat) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgTrainingHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgValidation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTrainingHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Req != nil {
-		{
-			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	if m.Revalidation {
+		i--
+		if m.Revalidation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x38
+	}
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if len(m.ResponseHash) > 0 {
+		i -= len(m.ResponseHash)
+		copy(dAtA[i:], m.ResponseHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponseHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ResponsePayload) > 0 {
+		i -= len(m.ResponsePayload)
+		copy(dAtA[i:], m.ResponsePayload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponsePayload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
@@ -6581,7 +6717,7 @@ func (m *MsgTrainingHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgTrainingHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgValidationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6591,32 +6727,20 @@ func (m *MsgTrainingHeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgTrainingHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgValidationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTrainingHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
-		{
-			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetBarrier) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitNewUnfundedParticipant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6626,25 +6750,48 @@ func (m *MsgSetBarrier) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetBarrier) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewUnfundedParticipant) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetBarrier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewUnfundedParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Req != nil {
-		{
-			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.WorkerKey) > 0 {
+		i -= len(m.WorkerKey)
+		copy(dAtA[i:], m.WorkerKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WorkerKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ValidatorKey) > 0 {
+		i -= len(m.ValidatorKey)
+		copy(dAtA[i:], m.ValidatorKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6658,7 +6805,7 @@ func (m *MsgSetBarrier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetBarrierResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitNewUnfundedParticipantResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6668,32 +6815,20 @@ func (m *MsgSetBarrierResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetBarrierResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewUnfundedParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetBarrierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewUnfundedParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
-		{
-			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinTrainingStatus) Marshal() (dAtA []byte, err error) {
+func (m *MsgInvalidateInference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6703,25 +6838,27 @@ func (m *MsgJoinTrainingStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTrainingStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInvalidateInference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinTrainingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInvalidateInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Req != nil {
-		{
-			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.Invalidator) > 0 {
+		i -= len(m.Invalidator)
+		copy(dAtA[i:], m.Invalidator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Invalidator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6735,7 +6872,7 @@ func (m *MsgJoinTrainingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinTrainingStatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgInvalidateInferenceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6745,32 +6882,20 @@ func (m *MsgJoinTrainingStatusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTrainingStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInvalidateInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinTrainingStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInvalidateInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDummyTrainingTask) Marshal() (dAtA []byte, err error) {
+func (m *MsgRevalidateInference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6780,25 +6905,27 @@ func (m *MsgCreateDummyTrainingTask) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateDummyTrainingTask) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRevalidateInference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateDummyTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRevalidateInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Task != nil {
-		{
-			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.Invalidator) > 0 {
+		i -= len(m.Invalidator)
+		copy(dAtA[i:], m.Invalidator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Invalidator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6812,7 +6939,7 @@ func (m *MsgCreateDummyTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDummyTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRevalidateInferenceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6822,32 +6949,20 @@ func (m *MsgCreateDummyTrainingTaskResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateDummyTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRevalidateInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateDummyTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRevalidateInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Task != nil {
-		{
-			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBridgeExchange) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6857,83 +6972,37 @@ func (m *MsgBridgeExchange) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBridgeExchange) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBridgeExchange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ReceiptsRoot) > 0 {
-		i -= len(m.ReceiptsRoot)
-		copy(dAtA[i:], m.ReceiptsRoot)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptsRoot)))
+	if m.EpochIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x18
 	}
-	if len(m.ReceiptIndex) > 0 {
-		i -= len(m.ReceiptIndex)
-		copy(dAtA[i:], m.ReceiptIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptIndex)))
+	if m.Seed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Seed))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x10
 	}
-	if len(m.BlockNumber) > 0 {
-		i -= len(m.BlockNumber)
-		copy(dAtA[i:], m.BlockNumber)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockNumber)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.OwnerPubKey) > 0 {
-		i -= len(m.OwnerPubKey)
-		copy(dAtA[i:], m.OwnerPubKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerPubKey)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OriginChain) > 0 {
-		i -= len(m.OriginChain)
-		copy(dAtA[i:], m.OriginChain)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginChain)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBridgeExchangeResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6943,27 +7012,32 @@ func (m *MsgBridgeExchangeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBridgeExchangeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBridgeExchangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Result)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddUserToTrainingAllowList) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitPocBatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6973,39 +7047,75 @@ func (m *MsgAddUserToTrainingAllowList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddUserToTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocBatch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddUserToTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Role != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeId)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x32
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+	if len(m.Dist) > 0 {
+		for iNdEx := len(m.Dist) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float64bits(float64(m.Dist[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f2))
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Dist)*8))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Nonces) > 0 {
+		dAtA4 := make([]byte, len(m.Nonces)*10)
+		var j3 int
+		for _, num1 := range m.Nonces {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BatchId) > 0 {
+		i -= len(m.BatchId)
+		copy(dAtA[i:], m.BatchId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BatchId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PocStageStartBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddUserToTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitPocBatchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7015,12 +7125,12 @@ func (m *MsgAddUserToTrainingAllowListResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddUserToTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocBatchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddUserToTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7028,7 +7138,7 @@ func (m *MsgAddUserToTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveUserFromTrainingAllowList) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitPocValidation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7038,39 +7148,111 @@ func (m *MsgRemoveUserFromTrainingAllowList) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveUserFromTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocValidation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveUserFromTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Role != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+	if m.FraudDetected {
+		i--
+		if m.FraudDetected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ProbabilityHonest != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ProbabilityHonest))))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.NInvalid != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NInvalid))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.FraudThreshold != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FraudThreshold))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.RTarget != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RTarget))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if len(m.ReceivedDist) > 0 {
+		for iNdEx := len(m.ReceivedDist) - 1; iNdEx >= 0; iNdEx-- {
+			f5 := math.Float64bits(float64(m.ReceivedDist[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f5))
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceivedDist)*8))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Dist) > 0 {
+		for iNdEx := len(m.Dist) - 1; iNdEx >= 0; iNdEx-- {
+			f6 := math.Float64bits(float64(m.Dist[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f6))
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Dist)*8))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Nonces) > 0 {
+		dAtA8 := make([]byte, len(m.Nonces)*10)
+		var j7 int
+		for _, num1 := range m.Nonces {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PocStageStartBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+	if len(m.ParticipantAddress) > 0 {
+		i -= len(m.ParticipantAddress)
+		copy(dAtA[i:], m.ParticipantAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ParticipantAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveUserFromTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitPocValidationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7080,12 +7262,12 @@ func (m *MsgRemoveUserFromTrainingAllowListResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveUserFromTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocValidationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveUserFromTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7093,7 +7275,7 @@ func (m *MsgRemoveUserFromTrainingAllowListResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTrainingAllowList) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitSeed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7103,41 +7285,39 @@ func (m *MsgSetTrainingAllowList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitSeed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Role != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.EpochIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitSeedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7147,12 +7327,12 @@ func (m *MsgSetTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitSeedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitSeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7160,1121 +7340,5894 @@ func (m *MsgSetTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSubmitUnitOfComputePriceProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSubmitUnitOfComputePriceProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitUnitOfComputePriceProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Price != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitUnitOfComputePriceProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitUnitOfComputePriceProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitUnitOfComputePriceProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStartInference) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterModel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterModel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InferenceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PromptHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PromptPayload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ValidationThreshold != nil {
+		{
+			size, err := m.ValidationThreshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.Model)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ThroughputPerNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ThroughputPerNonce))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.RequestedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.VRam != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VRam))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.AssignedTo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ModelArgs) > 0 {
+		for iNdEx := len(m.ModelArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ModelArgs[iNdEx])
+			copy(dAtA[i:], m.ModelArgs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ModelArgs[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	l = len(m.NodeVersion)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.HfCommit) > 0 {
+		i -= len(m.HfCommit)
+		copy(dAtA[i:], m.HfCommit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HfCommit)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.MaxTokens != 0 {
-		n += 1 + sovTx(uint64(m.MaxTokens))
+	if len(m.HfRepo) > 0 {
+		i -= len(m.HfRepo)
+		copy(dAtA[i:], m.HfRepo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HfRepo)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.PromptTokenCount != 0 {
-		n += 1 + sovTx(uint64(m.PromptTokenCount))
+	if m.UnitsOfComputePerToken != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnitsOfComputePerToken))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.RequestTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.RequestTimestamp))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.TransferSignature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ProposedBy) > 0 {
+		i -= len(m.ProposedBy)
+		copy(dAtA[i:], m.ProposedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProposedBy)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.OriginalPrompt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStartInferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.InferenceIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRegisterModelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgFinishInference) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRegisterModelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterModelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InferenceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ResponseHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ResponsePayload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PromptTokenCount != 0 {
-		n += 1 + sovTx(uint64(m.PromptTokenCount))
-	}
-	if m.CompletionTokenCount != 0 {
-		n += 1 + sovTx(uint64(m.CompletionTokenCount))
-	}
-	l = len(m.ExecutedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TransferredBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.RequestTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.RequestTimestamp))
-	}
-	l = len(m.TransferSignature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ExecutorSignature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RequestedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OriginalPrompt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Model)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFinishInferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.InferenceIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateTrainingTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitNewParticipant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateTrainingTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ValidatorKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.HardwareResources) > 0 {
+		for iNdEx := len(m.HardwareResources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HardwareResources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.WorkerKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitNewParticipantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ParticipantIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgValidation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitHardwareDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitHardwareDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitHardwareDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InferenceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ResponsePayload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ResponseHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Value != 0 {
-		n += 9
+	if len(m.NewOrModified) > 0 {
+		for iNdEx := len(m.NewOrModified) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewOrModified[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Revalidation {
-		n += 2
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgValidationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitHardwareDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitNewUnfundedParticipant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.WorkerKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSubmitHardwareDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitNewUnfundedParticipantResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSubmitHardwareDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInvalidateInference) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClaimTrainingTaskForAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimTrainingTaskForAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimTrainingTaskForAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InferenceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Invalidator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInvalidateInferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClaimTrainingTaskForAssignmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimTrainingTaskForAssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimTrainingTaskForAssignmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevalidateInference) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAssignTrainingTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAssignTrainingTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAssignTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Assignees) > 0 {
+		for iNdEx := len(m.Assignees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assignees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.InferenceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Invalidator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevalidateInferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAssignTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAssignTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAssignTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimRewards) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreatePartialUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePartialUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePartialUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ApiBinariesJson) > 0 {
+		i -= len(m.ApiBinariesJson)
+		copy(dAtA[i:], m.ApiBinariesJson)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ApiBinariesJson)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Seed != 0 {
-		n += 1 + sovTx(uint64(m.Seed))
+	if len(m.NodeVersion) > 0 {
+		i -= len(m.NodeVersion)
+		copy(dAtA[i:], m.NodeVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeVersion)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.EpochIndex != 0 {
-		n += 1 + sovTx(uint64(m.EpochIndex))
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
-	}
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreatePartialUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitPocBatch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PocStageStartBlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
-	}
-	l = len(m.BatchId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Nonces) > 0 {
-		l = 0
-		for _, e := range m.Nonces {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if len(m.Dist) > 0 {
-		n += 1 + sovTx(uint64(len(m.Dist)*8)) + len(m.Dist)*8
-	}
-	l = len(m.NodeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgCreatePartialUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitPocBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreatePartialUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitPocValidation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitTrainingKvRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitTrainingKvRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitTrainingKvRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ParticipantAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PocStageStartBlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
-	}
-	if len(m.Nonces) > 0 {
-		l = 0
-		for _, e := range m.Nonces {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if len(m.Dist) > 0 {
-		n += 1 + sovTx(uint64(len(m.Dist)*8)) + len(m.Dist)*8
-	}
-	if len(m.ReceivedDist) > 0 {
-		n += 1 + sovTx(uint64(len(m.ReceivedDist)*8)) + len(m.ReceivedDist)*8
-	}
-	if m.RTarget != 0 {
-		n += 9
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.FraudThreshold != 0 {
-		n += 9
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.NInvalid != 0 {
-		n += 1 + sovTx(uint64(m.NInvalid))
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ProbabilityHonest != 0 {
-		n += 9
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.FraudDetected {
-		n += 2
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitPocValidationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitTrainingKvRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitTrainingKvRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitTrainingKvRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitSeed) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgJoinTraining) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinTraining) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinTraining) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.EpochIndex != 0 {
-		n += 1 + sovTx(uint64(m.EpochIndex))
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitSeedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgJoinTrainingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitUnitOfComputePriceProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgJoinTrainingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinTrainingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Price != 0 {
-		n += 1 + sovTx(uint64(m.Price))
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitUnitOfComputePriceProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTrainingHeartbeat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterModel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgTrainingHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTrainingHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProposedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.UnitsOfComputePerToken != 0 {
-		n += 1 + sovTx(uint64(m.UnitsOfComputePerToken))
-	}
-	l = len(m.HfRepo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.HfCommit)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.ModelArgs) > 0 {
-		for _, s := range m.ModelArgs {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.VRam != 0 {
-		n += 1 + sovTx(uint64(m.VRam))
-	}
-	if m.ThroughputPerNonce != 0 {
-		n += 1 + sovTx(uint64(m.ThroughputPerNonce))
-	}
-	if m.ValidationThreshold != nil {
-		l = m.ValidationThreshold.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterModelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTrainingHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateTrainingTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.HardwareResources) > 0 {
-		for _, e := range m.HardwareResources {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgTrainingHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateTrainingTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgTrainingHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitHardwareDiff) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.NewOrModified) > 0 {
-		for _, e := range m.NewOrModified {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Removed) > 0 {
-		for _, e := range m.Removed {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgSetBarrier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitHardwareDiffResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetBarrier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClaimTrainingTaskForAssignment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetBarrier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.TaskId != 0 {
-		n += 1 + sovTx(uint64(m.TaskId))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimTrainingTaskForAssignmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetBarrierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAssignTrainingTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetBarrierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBarrierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovTx(uint64(m.TaskId))
-	}
-	if len(m.Assignees) > 0 {
-		for _, e := range m.Assignees {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAssignTrainingTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgJoinTrainingStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreatePartialUpgrade) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
-	}
-	l = len(m.NodeVersion)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ApiBinariesJson)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgCreatePartialUpgradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgJoinTrainingStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitTrainingKvRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgJoinTrainingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovTx(uint64(m.TaskId))
-	}
-	l = len(m.Participant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitTrainingKvRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgJoinTrainingStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTraining) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Req != nil {
-		l = m.Req.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgJoinTrainingStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinTrainingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgJoinTrainingStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovTx(uint64(l))
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTrainingHeartbeat) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Req != nil {
-		l = m.Req.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateDummyTrainingTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgTrainingHeartbeatResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Resp != nil {
-		l = m.Resp.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgCreateDummyTrainingTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetBarrier) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateDummyTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Req != nil {
-		l = m.Req.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetBarrierResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Resp != nil {
-		l = m.Resp.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateDummyTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTrainingStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Req != nil {
-		l = m.Req.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgCreateDummyTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinTrainingStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateDummyTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDummyTrainingTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgBridgeExchange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateDummyTrainingTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgBridgeExchange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBridgeExchange) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBridgeExchange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ReceiptsRoot) > 0 {
+		i -= len(m.ReceiptsRoot)
+		copy(dAtA[i:], m.ReceiptsRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptsRoot)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.OriginChain)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ReceiptIndex) > 0 {
+		i -= len(m.ReceiptIndex)
+		copy(dAtA[i:], m.ReceiptIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptIndex)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BlockNumber) > 0 {
+		i -= len(m.BlockNumber)
+		copy(dAtA[i:], m.BlockNumber)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockNumber)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.OwnerPubKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OwnerPubKey) > 0 {
+		i -= len(m.OwnerPubKey)
+		copy(dAtA[i:], m.OwnerPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerPubKey)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.BlockNumber)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ReceiptIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OriginChain) > 0 {
+		i -= len(m.OriginChain)
+		copy(dAtA[i:], m.OriginChain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginChain)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ReceiptsRoot)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBridgeExchangeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgBridgeExchangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddUserToTrainingAllowList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBridgeExchangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBridgeExchangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Role != 0 {
-		n += 1 + sovTx(uint64(m.Role))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddUserToTrainingAllowListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddUserToTrainingAllowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveUserFromTrainingAllowList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddUserToTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddUserToTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Role != 0 {
-		n += 1 + sovTx(uint64(m.Role))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveUserFromTrainingAllowListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddUserToTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddUserToTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddUserToTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTrainingAllowList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveUserFromTrainingAllowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveUserFromTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveUserFromTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveUserFromTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveUserFromTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveUserFromTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTrainingAllowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SignedExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExecutionSignature) > 0 {
+		i -= len(m.ExecutionSignature)
+		copy(dAtA[i:], m.ExecutionSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutionSignature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TransferredBy) > 0 {
+		i -= len(m.TransferredBy)
+		copy(dAtA[i:], m.TransferredBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferredBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RequestTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ResponseHash) > 0 {
+		i -= len(m.ResponseHash)
+		copy(dAtA[i:], m.ResponseHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponseHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Seed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Seed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitInferenceEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitInferenceEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitInferenceEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Second.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.First.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitInferenceEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitInferenceEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitInferenceEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reward != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Reward))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SlashedAmount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SlashedAmount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeInference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeInference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeInferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositCredit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositCredit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositCreditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositCreditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositCreditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Available != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Available))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawCredit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawCredit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawCreditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawCreditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawCreditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantInferenceKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantInferenceKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantInferenceKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTokensPerRequest != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxTokensPerRequest))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Expiration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllowedModels) > 0 {
+		for iNdEx := len(m.AllowedModels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedModels[iNdEx])
+			copy(dAtA[i:], m.AllowedModels[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedModels[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EpochSpendLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochSpendLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.KeyAddress) > 0 {
+		i -= len(m.KeyAddress)
+		copy(dAtA[i:], m.KeyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantInferenceKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantInferenceKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantInferenceKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeInferenceKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeInferenceKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeInferenceKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyAddress) > 0 {
+		i -= len(m.KeyAddress)
+		copy(dAtA[i:], m.KeyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeInferenceKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeInferenceKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeInferenceKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestPriceQuote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestPriceQuote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestPriceQuote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TokenBudget != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TokenBudget))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DurationBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DurationBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ModelId) > 0 {
+		i -= len(m.ModelId)
+		copy(dAtA[i:], m.ModelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestPriceQuoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestPriceQuoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestPriceQuoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PerTokenPrice != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PerTokenPrice))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.QuoteId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QuoteId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveNetwork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveNetwork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveNetwork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveNetworkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveNetworkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveNetworkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExitEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExitEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParticipantProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParticipantProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParticipantProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SupportedProtocols != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SupportedProtocols))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RegionCode) > 0 {
+		i -= len(m.RegionCode)
+		copy(dAtA[i:], m.RegionCode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RegionCode)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Contact) > 0 {
+		i -= len(m.Contact)
+		copy(dAtA[i:], m.Contact)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contact)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InferenceUrl) > 0 {
+		i -= len(m.InferenceUrl)
+		copy(dAtA[i:], m.InferenceUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParticipantProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParticipantProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParticipantProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UrlEffectiveHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UrlEffectiveHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStartInference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PromptHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PromptPayload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Model)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RequestedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssignedTo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NodeVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxTokens != 0 {
+		n += 1 + sovTx(uint64(m.MaxTokens))
+	}
+	if m.PromptTokenCount != 0 {
+		n += 1 + sovTx(uint64(m.PromptTokenCount))
+	}
+	if m.RequestTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.RequestTimestamp))
+	}
+	l = len(m.TransferSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginalPrompt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RequesterKey)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.PriceQuoteId != 0 {
+		n += 2 + sovTx(uint64(m.PriceQuoteId))
+	}
+	return n
+}
+
+func (m *MsgStartInferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InferenceIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFinishInference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ResponseHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ResponsePayload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PromptTokenCount != 0 {
+		n += 1 + sovTx(uint64(m.PromptTokenCount))
+	}
+	if m.CompletionTokenCount != 0 {
+		n += 1 + sovTx(uint64(m.CompletionTokenCount))
+	}
+	l = len(m.ExecutedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TransferredBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RequestTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.RequestTimestamp))
+	}
+	l = len(m.TransferSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExecutorSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RequestedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginalPrompt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Model)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RequesterKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFinishInferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InferenceIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitNewParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WorkerKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitNewParticipantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParticipantIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ResponsePayload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ResponseHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.Revalidation {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgValidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitNewUnfundedParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WorkerKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitNewUnfundedParticipantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInvalidateInference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Invalidator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInvalidateInferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevalidateInference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Invalidator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevalidateInferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Seed != 0 {
+		n += 1 + sovTx(uint64(m.Seed))
+	}
+	if m.EpochIndex != 0 {
+		n += 1 + sovTx(uint64(m.EpochIndex))
+	}
+	return n
+}
+
+func (m *MsgClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitPocBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PocStageStartBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
+	}
+	l = len(m.BatchId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Nonces) > 0 {
+		l = 0
+		for _, e := range m.Nonces {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Dist) > 0 {
+		n += 1 + sovTx(uint64(len(m.Dist)*8)) + len(m.Dist)*8
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitPocBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitPocValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ParticipantAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PocStageStartBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
+	}
+	if len(m.Nonces) > 0 {
+		l = 0
+		for _, e := range m.Nonces {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Dist) > 0 {
+		n += 1 + sovTx(uint64(len(m.Dist)*8)) + len(m.Dist)*8
+	}
+	if len(m.ReceivedDist) > 0 {
+		n += 1 + sovTx(uint64(len(m.ReceivedDist)*8)) + len(m.ReceivedDist)*8
+	}
+	if m.RTarget != 0 {
+		n += 9
+	}
+	if m.FraudThreshold != 0 {
+		n += 9
+	}
+	if m.NInvalid != 0 {
+		n += 1 + sovTx(uint64(m.NInvalid))
+	}
+	if m.ProbabilityHonest != 0 {
+		n += 9
+	}
+	if m.FraudDetected {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSubmitPocValidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitSeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochIndex != 0 {
+		n += 1 + sovTx(uint64(m.EpochIndex))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitSeedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitUnitOfComputePriceProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 1 + sovTx(uint64(m.Price))
+	}
+	return n
+}
+
+func (m *MsgSubmitUnitOfComputePriceProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProposedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnitsOfComputePerToken != 0 {
+		n += 1 + sovTx(uint64(m.UnitsOfComputePerToken))
+	}
+	l = len(m.HfRepo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HfCommit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ModelArgs) > 0 {
+		for _, s := range m.ModelArgs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.VRam != 0 {
+		n += 1 + sovTx(uint64(m.VRam))
+	}
+	if m.ThroughputPerNonce != 0 {
+		n += 1 + sovTx(uint64(m.ThroughputPerNonce))
+	}
+	if m.ValidationThreshold != nil {
+		l = m.ValidationThreshold.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterModelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateTrainingTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.HardwareResources) > 0 {
+		for _, e := range m.HardwareResources {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateTrainingTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitHardwareDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NewOrModified) > 0 {
+		for _, e := range m.NewOrModified {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, e := range m.Removed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitHardwareDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimTrainingTaskForAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	return n
+}
+
+func (m *MsgClaimTrainingTaskForAssignmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAssignTrainingTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	if len(m.Assignees) > 0 {
+		for _, e := range m.Assignees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAssignTrainingTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePartialUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = len(m.NodeVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ApiBinariesJson)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreatePartialUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitTrainingKvRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitTrainingKvRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgJoinTraining) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgJoinTrainingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTrainingHeartbeat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTrainingHeartbeatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBarrier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBarrierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgJoinTrainingStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgJoinTrainingStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDummyTrainingTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDummyTrainingTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBridgeExchange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginChain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OwnerPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlockNumber)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReceiptIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReceiptsRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBridgeExchangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddUserToTrainingAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgAddUserToTrainingAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveUserFromTrainingAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgRemoveUserFromTrainingAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTrainingAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgSetTrainingAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SignedExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Seed != 0 {
+		n += 1 + sovTx(uint64(m.Seed))
+	}
+	l = len(m.ResponseHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RequestTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.RequestTimestamp))
+	}
+	l = len(m.TransferredBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExecutionSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitInferenceEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.First.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Second.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitInferenceEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashedAmount != 0 {
+		n += 1 + sovTx(uint64(m.SlashedAmount))
+	}
+	if m.Reward != 0 {
+		n += 1 + sovTx(uint64(m.Reward))
+	}
+	return n
+}
+
+func (m *MsgDisputeInference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisputeInferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDepositCredit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgDepositCreditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Available != 0 {
+		n += 1 + sovTx(uint64(m.Available))
+	}
+	return n
+}
+
+func (m *MsgWithdrawCredit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgWithdrawCreditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovTx(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func (m *MsgGrantInferenceKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochSpendLimit != 0 {
+		n += 1 + sovTx(uint64(m.EpochSpendLimit))
+	}
+	if len(m.AllowedModels) > 0 {
+		for _, s := range m.AllowedModels {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Expiration != 0 {
+		n += 1 + sovTx(uint64(m.Expiration))
+	}
+	if m.MaxTokensPerRequest != 0 {
+		n += 1 + sovTx(uint64(m.MaxTokensPerRequest))
+	}
+	return n
+}
+
+func (m *MsgGrantInferenceKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeInferenceKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeInferenceKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestPriceQuote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DurationBlocks != 0 {
+		n += 1 + sovTx(uint64(m.DurationBlocks))
+	}
+	if m.TokenBudget != 0 {
+		n += 1 + sovTx(uint64(m.TokenBudget))
+	}
+	return n
+}
+
+func (m *MsgRequestPriceQuoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QuoteId != 0 {
+		n += 1 + sovTx(uint64(m.QuoteId))
+	}
+	if m.PerTokenPrice != 0 {
+		n += 1 + sovTx(uint64(m.PerTokenPrice))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *MsgLeaveNetwork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLeaveNetworkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExitEpoch != 0 {
+		n += 1 + sovTx(uint64(m.ExitEpoch))
+	}
+	return n
+}
+
+func (m *MsgUpdateParticipantProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DisplayName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contact)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RegionCode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SupportedProtocols != 0 {
+		n += 1 + sovTx(uint64(m.SupportedProtocols))
+	}
+	return n
+}
+
+func (m *MsgUpdateParticipantProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UrlEffectiveHeight != 0 {
+		n += 1 + sovTx(uint64(m.UrlEffectiveHeight))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartInference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartInference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromptHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromptHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromptPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromptPayload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Model = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssignedTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokens", wireType)
+			}
+			m.MaxTokens = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTokens |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromptTokenCount", wireType)
+			}
+			m.PromptTokenCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PromptTokenCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimestamp", wireType)
+			}
+			m.RequestTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPrompt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalPrompt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequesterKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequesterKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceQuoteId", wireType)
+			}
+			m.PriceQuoteId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceQuoteId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartInferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartInferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinishInference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinishInference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponseHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponsePayload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromptTokenCount", wireType)
+			}
+			m.PromptTokenCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PromptTokenCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTokenCount", wireType)
+			}
+			m.CompletionTokenCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionTokenCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferredBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferredBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimestamp", wireType)
+			}
+			m.RequestTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutorSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPrompt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalPrompt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Model = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequesterKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequesterKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinishInferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinishInferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinishInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitNewParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitNewParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitNewParticipantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitNewParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgValidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponsePayload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponseHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revalidation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revalidation = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgValidationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValidationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitNewUnfundedParticipantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Role != 0 {
-		n += 1 + sovTx(uint64(m.Role))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInvalidateInference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInvalidateInference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalidator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invalidator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSetTrainingAllowListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgInvalidateInferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInvalidateInferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInvalidateInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8293,19 +13246,51 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevalidateInference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevalidateInference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8333,13 +13318,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalidator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8349,24 +13334,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Invalidator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8389,7 +13373,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevalidateInferenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8412,10 +13396,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevalidateInferenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevalidateInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8439,7 +13423,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8462,10 +13446,10 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartInference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartInference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8501,10 +13485,10 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
 			}
-			var stringLen uint64
+			m.Seed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8514,29 +13498,16 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Seed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InferenceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromptHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
 			}
-			var stringLen uint64
+			m.EpochIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8546,61 +13517,66 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PromptHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromptPayload", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PromptPayload = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8610,27 +13586,14 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Model = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8658,43 +13621,61 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestedBy = string(dAtA[iNdEx:postIndex])
+			m.Result = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignedTo", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssignedTo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitPocBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitPocBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8722,51 +13703,13 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeVersion = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokens", wireType)
-			}
-			m.MaxTokens = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxTokens |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromptTokenCount", wireType)
-			}
-			m.PromptTokenCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PromptTokenCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
 			}
-			m.RequestTimestamp = 0
+			m.PocStageStartBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8776,14 +13719,14 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestTimestamp |= int64(b&0x7F) << shift
+				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8811,11 +13754,141 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferSignature = string(dAtA[iNdEx:postIndex])
+			m.BatchId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nonces = append(m.Nonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Nonces) == 0 {
+					m.Nonces = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nonces = append(m.Nonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+		case 5:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Dist = append(m.Dist, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Dist) == 0 {
+					m.Dist = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Dist = append(m.Dist, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPrompt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8843,7 +13916,7 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalPrompt = string(dAtA[iNdEx:postIndex])
+			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8862,71 +13935,39 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 	}
 
 	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgStartInferenceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartInferenceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceIndex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitPocBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitPocBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitPocBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8948,7 +13989,7 @@ func (m *MsgStartInferenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8971,10 +14012,10 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinishInference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPocValidation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinishInference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPocValidation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9011,7 +14052,7 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9039,77 +14080,13 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			m.ParticipantAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ResponseHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePayload", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ResponsePayload = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromptTokenCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
 			}
-			m.PromptTokenCount = 0
+			m.PocStageStartBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9119,214 +14096,222 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PromptTokenCount |= uint64(b&0x7F) << shift
+				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTokenCount", wireType)
-			}
-			m.CompletionTokenCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Nonces = append(m.Nonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CompletionTokenCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
 				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExecutedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferredBy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				elementCount = count
+				if elementCount != 0 && len(m.Nonces) == 0 {
+					m.Nonces = make([]int64, 0, elementCount)
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TransferredBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimestamp", wireType)
-			}
-			m.RequestTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nonces = append(m.Nonces, v)
 				}
-				if iNdEx >= l {
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+		case 5:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Dist = append(m.Dist, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferSignature", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Dist) == 0 {
+					m.Dist = make([]float64, 0, elementCount)
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TransferSignature = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorSignature", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Dist = append(m.Dist, v2)
 				}
-				if iNdEx >= l {
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
+			}
+		case 6:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.ReceivedDist = append(m.ReceivedDist, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExecutorSignature = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.ReceivedDist) == 0 {
+					m.ReceivedDist = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.ReceivedDist = append(m.ReceivedDist, v2)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedDist", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RTarget", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RTarget = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudThreshold", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPrompt", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FraudThreshold = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NInvalid", wireType)
 			}
-			var stringLen uint64
+			m.NInvalid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9336,29 +14321,27 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NInvalid |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbabilityHonest", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalPrompt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ProbabilityHonest = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudDetected", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9366,26 +14349,14 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Model = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.FraudDetected = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9407,7 +14378,7 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinishInferenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPocValidationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9430,44 +14401,12 @@ func (m *MsgFinishInferenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinishInferenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPocValidationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinishInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPocValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceIndex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InferenceIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9489,7 +14428,7 @@ func (m *MsgFinishInferenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9512,10 +14451,10 @@ func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitNewParticipant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitSeed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitNewParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitSeed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9551,10 +14490,10 @@ func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
 			}
-			var stringLen uint64
+			m.EpochIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9564,27 +14503,14 @@ func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9612,40 +14538,58 @@ func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorKey = string(dAtA[iNdEx:postIndex])
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSeedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSeedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9667,7 +14611,7 @@ func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9690,15 +14634,15 @@ func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitNewParticipantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitNewParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9726,13 +14670,13 @@ func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParticipantIndex = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var stringLen uint64
+			m.Price = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9742,24 +14686,61 @@ func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Price |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9781,7 +14762,7 @@ func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValidation) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9800,19 +14781,51 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValidation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValidation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterModel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterModel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9840,9 +14853,9 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.ProposedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
@@ -9874,9 +14887,28 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitsOfComputePerToken", wireType)
+			}
+			m.UnitsOfComputePerToken = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnitsOfComputePerToken |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HfRepo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9904,11 +14936,11 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			m.HfRepo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HfCommit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9936,11 +14968,11 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResponsePayload = string(dAtA[iNdEx:postIndex])
+			m.HfCommit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelArgs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9968,24 +15000,32 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResponseHash = string(dAtA[iNdEx:postIndex])
+			m.ModelArgs = append(m.ModelArgs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRam", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.VRam = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VRam |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Value = float64(math.Float64frombits(v))
-		case 7:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revalidation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThroughputPerNonce", wireType)
 			}
-			var v int
+			m.ThroughputPerNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9995,12 +15035,47 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ThroughputPerNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Revalidation = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidationThreshold == nil {
+				m.ValidationThreshold = &Decimal{}
+			}
+			if err := m.ValidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10022,7 +15097,7 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValidationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterModelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10045,10 +15120,10 @@ func (m *MsgValidationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValidationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterModelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterModelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10072,134 +15147,38 @@ func (m *MsgValidationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipant: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTrainingTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10227,13 +15206,13 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HardwareResources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10243,29 +15222,31 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorKey = string(dAtA[iNdEx:postIndex])
+			m.HardwareResources = append(m.HardwareResources, &TrainingHardwareResources{})
+			if err := m.HardwareResources[len(m.HardwareResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10275,23 +15256,27 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerKey = string(dAtA[iNdEx:postIndex])
+			if m.Config == nil {
+				m.Config = &TrainingConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10314,7 +15299,7 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitNewUnfundedParticipantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10337,12 +15322,48 @@ func (m *MsgSubmitNewUnfundedParticipantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateTrainingTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &TrainingTask{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10364,7 +15385,7 @@ func (m *MsgSubmitNewUnfundedParticipantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10387,10 +15408,10 @@ func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInvalidateInference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitHardwareDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInvalidateInference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitHardwareDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10427,9 +15448,9 @@ func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOrModified", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10439,29 +15460,31 @@ func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			m.NewOrModified = append(m.NewOrModified, &HardwareNode{})
+			if err := m.NewOrModified[len(m.NewOrModified)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Invalidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10471,23 +15494,25 @@ func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Invalidator = string(dAtA[iNdEx:postIndex])
+			m.Removed = append(m.Removed, &HardwareNode{})
+			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10510,7 +15535,7 @@ func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInvalidateInferenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitHardwareDiffResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10533,10 +15558,10 @@ func (m *MsgInvalidateInferenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInvalidateInferenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitHardwareDiffResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInvalidateInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitHardwareDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10560,7 +15585,7 @@ func (m *MsgInvalidateInferenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10583,10 +15608,10 @@ func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevalidateInference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevalidateInference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10622,42 +15647,10 @@ func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InferenceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Invalidator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var stringLen uint64
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10667,24 +15660,11 @@ func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Invalidator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10706,7 +15686,7 @@ func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevalidateInferenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimTrainingTaskForAssignmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10729,10 +15709,10 @@ func (m *MsgRevalidateInferenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevalidateInferenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevalidateInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10756,7 +15736,7 @@ func (m *MsgRevalidateInferenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10779,10 +15759,10 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAssignTrainingTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAssignTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10819,9 +15799,9 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			m.Seed = 0
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10831,16 +15811,16 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seed |= int64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignees", wireType)
 			}
-			m.EpochIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10850,11 +15830,26 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochIndex |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assignees = append(m.Assignees, &TrainingTaskAssignee{})
+			if err := m.Assignees[len(m.Assignees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10876,7 +15871,7 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAssignTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10899,63 +15894,12 @@ func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAssignTrainingTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAssignTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10977,7 +15921,7 @@ func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11000,15 +15944,15 @@ func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitPocBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePartialUpgrade: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitPocBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePartialUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11036,13 +15980,13 @@ func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.PocStageStartBlockHeight = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11052,14 +15996,14 @@ func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11087,141 +16031,11 @@ func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchId = string(dAtA[iNdEx:postIndex])
+			m.NodeVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Nonces = append(m.Nonces, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Nonces) == 0 {
-					m.Nonces = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Nonces = append(m.Nonces, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
-			}
-		case 5:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
-				}
-				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				v2 := float64(math.Float64frombits(v))
-				m.Dist = append(m.Dist, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.Dist) == 0 {
-					m.Dist = make([]float64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					v2 := float64(math.Float64frombits(v))
-					m.Dist = append(m.Dist, v2)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
-			}
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiBinariesJson", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11249,7 +16063,7 @@ func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeId = string(dAtA[iNdEx:postIndex])
+			m.ApiBinariesJson = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11272,7 +16086,7 @@ func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitPocBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePartialUpgradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11295,10 +16109,10 @@ func (m *MsgSubmitPocBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitPocBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePartialUpgradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitPocBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePartialUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11322,7 +16136,7 @@ func (m *MsgSubmitPocBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11345,10 +16159,10 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitPocValidation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitTrainingKvRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitPocValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitTrainingKvRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11384,42 +16198,10 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ParticipantAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			m.PocStageStartBlockHeight = 0
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11429,222 +16211,48 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Nonces = append(m.Nonces, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Nonces) == 0 {
-					m.Nonces = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Nonces = append(m.Nonces, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
-			}
-		case 5:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
-				}
-				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				v2 := float64(math.Float64frombits(v))
-				m.Dist = append(m.Dist, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.Dist) == 0 {
-					m.Dist = make([]float64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					v2 := float64(math.Float64frombits(v))
-					m.Dist = append(m.Dist, v2)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
-		case 6:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
-				}
-				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				v2 := float64(math.Float64frombits(v))
-				m.ReceivedDist = append(m.ReceivedDist, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.ReceivedDist) == 0 {
-					m.ReceivedDist = make([]float64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					v2 := float64(math.Float64frombits(v))
-					m.ReceivedDist = append(m.ReceivedDist, v2)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedDist", wireType)
-			}
-		case 7:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RTarget", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.RTarget = float64(math.Float64frombits(v))
-		case 8:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FraudThreshold", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.FraudThreshold = float64(math.Float64frombits(v))
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NInvalid", wireType)
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.NInvalid = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11654,27 +16262,29 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NInvalid |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProbabilityHonest", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.ProbabilityHonest = float64(math.Float64frombits(v))
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FraudDetected", wireType)
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11684,12 +16294,24 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FraudDetected = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11711,7 +16333,7 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitPocValidationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitTrainingKvRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11734,10 +16356,10 @@ func (m *MsgSubmitPocValidationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitPocValidationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitTrainingKvRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitPocValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitTrainingKvRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11761,7 +16383,7 @@ func (m *MsgSubmitPocValidationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11784,10 +16406,10 @@ func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSeed: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinTraining: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSeed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinTraining: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11823,29 +16445,10 @@ func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
-			}
-			m.EpochIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11855,23 +16458,27 @@ func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			if m.Req == nil {
+				m.Req = &JoinTrainingRequest{}
+			}
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11894,7 +16501,7 @@ func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSeedResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinTrainingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11917,12 +16524,48 @@ func (m *MsgSubmitSeedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSeedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinTrainingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinTrainingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &MLNodeTrainStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11944,7 +16587,7 @@ func (m *MsgSubmitSeedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11967,10 +16610,10 @@ func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTrainingHeartbeat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTrainingHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12006,10 +16649,10 @@ func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
 			}
-			m.Price = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12019,11 +16662,28 @@ func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Req == nil {
+				m.Req = &HeartbeatRequest{}
+			}
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12045,7 +16705,7 @@ func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTrainingHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12068,12 +16728,48 @@ func (m *MsgSubmitUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTrainingHeartbeatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTrainingHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resp == nil {
+				m.Resp = &HeartbeatResponse{}
+			}
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12095,7 +16791,7 @@ func (m *MsgSubmitUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12118,79 +16814,15 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterModel: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterModel: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProposedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			return fmt.Errorf("proto: MsgSetBarrier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBarrier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12218,32 +16850,13 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnitsOfComputePerToken", wireType)
-			}
-			m.UnitsOfComputePerToken = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnitsOfComputePerToken |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HfRepo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12253,29 +16866,83 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HfRepo = string(dAtA[iNdEx:postIndex])
+			if m.Req == nil {
+				m.Req = &SetBarrierRequest{}
+			}
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBarrierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBarrierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBarrierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HfCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12285,27 +16952,81 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HfCommit = string(dAtA[iNdEx:postIndex])
+			if m.Resp == nil {
+				m.Resp = &SetBarrierResponse{}
+			}
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinTrainingStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinTrainingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModelArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12333,49 +17054,11 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModelArgs = append(m.ModelArgs, string(dAtA[iNdEx:postIndex]))
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VRam", wireType)
-			}
-			m.VRam = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VRam |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThroughputPerNonce", wireType)
-			}
-			m.ThroughputPerNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ThroughputPerNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidationThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12402,10 +17085,10 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValidationThreshold == nil {
-				m.ValidationThreshold = &Decimal{}
+			if m.Req == nil {
+				m.Req = &JoinTrainingRequest{}
 			}
-			if err := m.ValidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12430,7 +17113,7 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterModelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinTrainingStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12453,12 +17136,48 @@ func (m *MsgRegisterModelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterModelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinTrainingStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterModelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinTrainingStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &MLNodeTrainStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12480,7 +17199,7 @@ func (m *MsgRegisterModelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12503,10 +17222,10 @@ func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTrainingTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateDummyTrainingTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateDummyTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12543,41 +17262,7 @@ func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HardwareResources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HardwareResources = append(m.HardwareResources, &TrainingHardwareResources{})
-			if err := m.HardwareResources[len(m.HardwareResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12604,10 +17289,10 @@ func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &TrainingConfig{}
+			if m.Task == nil {
+				m.Task = &TrainingTask{}
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12632,7 +17317,7 @@ func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTrainingTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateDummyTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12655,10 +17340,10 @@ func (m *MsgCreateTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTrainingTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateDummyTrainingTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateDummyTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12718,7 +17403,7 @@ func (m *MsgCreateTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
+func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12741,15 +17426,207 @@ func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitHardwareDiff: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBridgeExchange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitHardwareDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBridgeExchange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12777,13 +17654,13 @@ func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.BlockNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOrModified", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptIndex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12793,31 +17670,29 @@ func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOrModified = append(m.NewOrModified, &HardwareNode{})
-			if err := m.NewOrModified[len(m.NewOrModified)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ReceiptIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12827,25 +17702,23 @@ func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Removed = append(m.Removed, &HardwareNode{})
-			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12868,7 +17741,7 @@ func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitHardwareDiffResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBridgeExchangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12891,12 +17764,44 @@ func (m *MsgSubmitHardwareDiffResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitHardwareDiffResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBridgeExchangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitHardwareDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBridgeExchangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -12918,7 +17823,7 @@ func (m *MsgSubmitHardwareDiffResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
+func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12941,15 +17846,15 @@ func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignment: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddUserToTrainingAllowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddUserToTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12977,13 +17882,45 @@ func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			m.TaskId = 0
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12993,7 +17930,7 @@ func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= uint64(b&0x7F) << shift
+				m.Role |= TrainingRole(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13019,7 +17956,7 @@ func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimTrainingTaskForAssignmentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddUserToTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13042,10 +17979,10 @@ func (m *MsgClaimTrainingTaskForAssignmentResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddUserToTrainingAllowListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddUserToTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13069,7 +18006,7 @@ func (m *MsgClaimTrainingTaskForAssignmentResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13092,15 +18029,15 @@ func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAssignTrainingTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAssignTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13128,13 +18065,13 @@ func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.TaskId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13144,16 +18081,29 @@ func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assignees", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var msglen int
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13163,26 +18113,11 @@ func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Role |= TrainingRole(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Assignees = append(m.Assignees, &TrainingTaskAssignee{})
-			if err := m.Assignees[len(m.Assignees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13204,7 +18139,7 @@ func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAssignTrainingTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveUserFromTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13227,10 +18162,10 @@ func (m *MsgAssignTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAssignTrainingTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAssignTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13254,7 +18189,7 @@ func (m *MsgAssignTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13277,66 +18212,15 @@ func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePartialUpgrade: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTrainingAllowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePartialUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13364,11 +18248,11 @@ func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeVersion = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiBinariesJson", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13396,8 +18280,27 @@ func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiBinariesJson = string(dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= TrainingRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13419,7 +18322,7 @@ func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePartialUpgradeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13442,10 +18345,10 @@ func (m *MsgCreatePartialUpgradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePartialUpgradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTrainingAllowListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePartialUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13469,7 +18372,7 @@ func (m *MsgCreatePartialUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
+func (m *SignedExecution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13492,15 +18395,15 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitTrainingKvRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignedExecution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitTrainingKvRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignedExecution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13528,13 +18431,13 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
 			}
-			m.TaskId = 0
+			m.Seed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13544,14 +18447,14 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= uint64(b&0x7F) << shift
+				m.Seed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13579,11 +18482,30 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
+			m.ResponseHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimestamp", wireType)
+			}
+			m.RequestTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferredBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13611,11 +18533,11 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.TransferredBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionSignature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13643,7 +18565,7 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ExecutionSignature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13666,7 +18588,7 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitTrainingKvRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitInferenceEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13689,65 +18611,47 @@ func (m *MsgSubmitTrainingKvRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitTrainingKvRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitInferenceEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitTrainingKvRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitInferenceEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinTraining: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinTraining: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13775,11 +18679,11 @@ func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13806,10 +18710,40 @@ func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Req == nil {
-				m.Req = &JoinTrainingRequest{}
+			if err := m.First.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Second", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Second.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13834,7 +18768,7 @@ func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinTrainingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitInferenceEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13857,17 +18791,17 @@ func (m *MsgJoinTrainingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinTrainingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitInferenceEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinTrainingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitInferenceEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
 			}
-			var msglen int
+			m.SlashedAmount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13877,28 +18811,30 @@ func (m *MsgJoinTrainingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SlashedAmount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Status == nil {
-				m.Status = &MLNodeTrainStatus{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Reward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13920,7 +18856,7 @@ func (m *MsgJoinTrainingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
+func (m *MsgDisputeInference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13943,10 +18879,10 @@ func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTrainingHeartbeat: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisputeInference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTrainingHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisputeInference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13983,9 +18919,9 @@ func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13995,27 +18931,23 @@ func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Req == nil {
-				m.Req = &HeartbeatRequest{}
-			}
-			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14038,7 +18970,7 @@ func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTrainingHeartbeatResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisputeInferenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14061,17 +18993,17 @@ func (m *MsgTrainingHeartbeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTrainingHeartbeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisputeInferenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTrainingHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisputeInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14081,27 +19013,23 @@ func (m *MsgTrainingHeartbeatResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resp == nil {
-				m.Resp = &HeartbeatResponse{}
-			}
-			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14124,7 +19052,7 @@ func (m *MsgTrainingHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositCredit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14147,10 +19075,10 @@ func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBarrier: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositCredit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBarrier: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositCredit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14186,10 +19114,10 @@ func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14199,28 +19127,11 @@ func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Req == nil {
-				m.Req = &SetBarrierRequest{}
-			}
-			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14242,7 +19153,7 @@ func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBarrierResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositCreditResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14265,17 +19176,17 @@ func (m *MsgSetBarrierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBarrierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositCreditResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBarrierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositCreditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
-			var msglen int
+			m.Available = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14285,28 +19196,11 @@ func (m *MsgSetBarrierResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Available |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Resp == nil {
-				m.Resp = &SetBarrierResponse{}
-			}
-			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14328,7 +19222,7 @@ func (m *MsgSetBarrierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawCredit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14351,10 +19245,10 @@ func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinTrainingStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawCredit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinTrainingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawCredit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14390,10 +19284,10 @@ func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14403,28 +19297,11 @@ func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Req == nil {
-				m.Req = &JoinTrainingRequest{}
-			}
-			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14446,7 +19323,7 @@ func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinTrainingStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawCreditResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14469,17 +19346,17 @@ func (m *MsgJoinTrainingStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinTrainingStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawCreditResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinTrainingStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawCreditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
 			}
-			var msglen int
+			m.ReleaseHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14489,28 +19366,11 @@ func (m *MsgJoinTrainingStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ReleaseHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Status == nil {
-				m.Status = &MLNodeTrainStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14532,7 +19392,7 @@ func (m *MsgJoinTrainingStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantInferenceKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14555,10 +19415,10 @@ func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDummyTrainingTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantInferenceKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDummyTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantInferenceKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14595,9 +19455,9 @@ func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14607,28 +19467,113 @@ func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &TrainingTask{}
+			m.KeyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochSpendLimit", wireType)
 			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EpochSpendLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochSpendLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedModels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.AllowedModels = append(m.AllowedModels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			m.Expiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokensPerRequest", wireType)
+			}
+			m.MaxTokensPerRequest = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTokensPerRequest |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14650,7 +19595,7 @@ func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDummyTrainingTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantInferenceKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14673,48 +19618,12 @@ func (m *MsgCreateDummyTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDummyTrainingTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantInferenceKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDummyTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantInferenceKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Task == nil {
-				m.Task = &TrainingTask{}
-			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14736,7 +19645,7 @@ func (m *MsgCreateDummyTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeInferenceKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14759,15 +19668,15 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBridgeExchange: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeInferenceKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBridgeExchange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeInferenceKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14795,11 +19704,11 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14827,107 +19736,111 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginChain = string(dAtA[iNdEx:postIndex])
+			m.KeyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeInferenceKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerPubKey", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeInferenceKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeInferenceKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestPriceQuote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerPubKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestPriceQuote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestPriceQuote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14955,11 +19868,11 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14987,13 +19900,13 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockNumber = string(dAtA[iNdEx:postIndex])
+			m.ModelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptIndex", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationBlocks", wireType)
 			}
-			var stringLen uint64
+			m.DurationBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15003,29 +19916,16 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DurationBlocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReceiptIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenBudget", wireType)
 			}
-			var stringLen uint64
+			m.TokenBudget = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15035,24 +19935,11 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TokenBudget |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15074,7 +19961,7 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBridgeExchangeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestPriceQuoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15097,17 +19984,17 @@ func (m *MsgBridgeExchangeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBridgeExchangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestPriceQuoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBridgeExchangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestPriceQuoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteId", wireType)
 			}
-			var stringLen uint64
+			m.QuoteId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15117,24 +20004,49 @@ func (m *MsgBridgeExchangeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.QuoteId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerTokenPrice", wireType)
+			}
+			m.PerTokenPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerTokenPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15156,7 +20068,7 @@ func (m *MsgBridgeExchangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveNetwork) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15179,47 +20091,15 @@ func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddUserToTrainingAllowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveNetwork: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddUserToTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveNetwork: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15247,27 +20127,8 @@ func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
-			}
-			m.Role = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Role |= TrainingRole(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15289,7 +20150,7 @@ func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddUserToTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveNetworkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15312,12 +20173,31 @@ func (m *MsgAddUserToTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddUserToTrainingAllowListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveNetworkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddUserToTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveNetworkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitEpoch", wireType)
+			}
+			m.ExitEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15339,7 +20219,7 @@ func (m *MsgAddUserToTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParticipantProfile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15362,15 +20242,15 @@ func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParticipantProfile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParticipantProfile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15398,11 +20278,11 @@ func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15430,13 +20310,13 @@ func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.InferenceUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
 			}
-			m.Role = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15446,114 +20326,59 @@ func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= TrainingRole(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRemoveUserFromTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTrainingAllowList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15581,11 +20406,11 @@ func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Contact = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionCode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15613,13 +20438,13 @@ func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.RegionCode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportedProtocols", wireType)
 			}
-			m.Role = 0
+			m.SupportedProtocols = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15629,7 +20454,7 @@ func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= TrainingRole(b&0x7F) << shift
+				m.SupportedProtocols |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15655,7 +20480,7 @@ func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParticipantProfileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15678,12 +20503,31 @@ func (m *MsgSetTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTrainingAllowListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParticipantProfileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParticipantProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UrlEffectiveHeight", wireType)
+			}
+			m.UrlEffectiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UrlEffectiveHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
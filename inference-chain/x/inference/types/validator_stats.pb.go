@@ -0,0 +1,1124 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/inference/validator_stats.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ValidatorStatsRanking is the counter validators of an epoch are ranked by, highest first
+type ValidatorStatsRanking int32
+
+const (
+	ValidatorStatsRanking_RANK_BY_VALIDATIONS              ValidatorStatsRanking = 0
+	ValidatorStatsRanking_RANK_BY_INVALIDATIONS            ValidatorStatsRanking = 1
+	ValidatorStatsRanking_RANK_BY_UPHELD_INVALIDATIONS     ValidatorStatsRanking = 2
+	ValidatorStatsRanking_RANK_BY_OVERTURNED_INVALIDATIONS ValidatorStatsRanking = 3
+	ValidatorStatsRanking_RANK_BY_MISSED                   ValidatorStatsRanking = 4
+)
+
+var ValidatorStatsRanking_name = map[int32]string{
+	0: "RANK_BY_VALIDATIONS",
+	1: "RANK_BY_INVALIDATIONS",
+	2: "RANK_BY_UPHELD_INVALIDATIONS",
+	3: "RANK_BY_OVERTURNED_INVALIDATIONS",
+	4: "RANK_BY_MISSED",
+}
+
+var ValidatorStatsRanking_value = map[string]int32{
+	"RANK_BY_VALIDATIONS":              0,
+	"RANK_BY_INVALIDATIONS":            1,
+	"RANK_BY_UPHELD_INVALIDATIONS":     2,
+	"RANK_BY_OVERTURNED_INVALIDATIONS": 3,
+	"RANK_BY_MISSED":                   4,
+}
+
+func (x ValidatorStatsRanking) String() string {
+	return proto.EnumName(ValidatorStatsRanking_name, int32(x))
+}
+
+func (ValidatorStatsRanking) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_04a01e1c2b9d0ba8, []int{0}
+}
+
+// ValidatorEpochStats aggregates how one validator validated the inferences of one epoch
+type ValidatorEpochStats struct {
+	EpochIndex uint64 `protobuf:"varint,1,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	Validator  string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// validations counts every inference validated, whatever the result
+	Validations uint64 `protobuf:"varint,3,opt,name=validations,proto3" json:"validations,omitempty"`
+	Passed      uint64 `protobuf:"varint,4,opt,name=passed,proto3" json:"passed,omitempty"`
+	// invalidations counts the inferences flagged as invalid, each of which starts an invalidation vote
+	Invalidations uint64 `protobuf:"varint,5,opt,name=invalidations,proto3" json:"invalidations,omitempty"`
+	// upheld_invalidations and overturned_invalidations count how those votes ended
+	UpheldInvalidations     uint64 `protobuf:"varint,6,opt,name=upheld_invalidations,json=upheldInvalidations,proto3" json:"upheld_invalidations,omitempty"`
+	OverturnedInvalidations uint64 `protobuf:"varint,7,opt,name=overturned_invalidations,json=overturnedInvalidations,proto3" json:"overturned_invalidations,omitempty"`
+	// revalidations counts the votes cast on inferences flagged by others
+	Revalidations uint64 `protobuf:"varint,8,opt,name=revalidations,proto3" json:"revalidations,omitempty"`
+	// must_validate and missed are recorded when the validator claims rewards for the epoch
+	MustValidate uint64 `protobuf:"varint,9,opt,name=must_validate,json=mustValidate,proto3" json:"must_validate,omitempty"`
+	Missed       uint64 `protobuf:"varint,10,opt,name=missed,proto3" json:"missed,omitempty"`
+}
+
+func (m *ValidatorEpochStats) Reset()         { *m = ValidatorEpochStats{} }
+func (m *ValidatorEpochStats) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEpochStats) ProtoMessage()    {}
+func (*ValidatorEpochStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04a01e1c2b9d0ba8, []int{0}
+}
+func (m *ValidatorEpochStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorEpochStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorEpochStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorEpochStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEpochStats.Merge(m, src)
+}
+func (m *ValidatorEpochStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorEpochStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEpochStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorEpochStats proto.InternalMessageInfo
+
+func (m *ValidatorEpochStats) GetEpochIndex() uint64 {
+	if m != nil {
+		return m.EpochIndex
+	}
+	return 0
+}
+
+func (m *ValidatorEpochStats) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorEpochStats) GetValidations() uint64 {
+	if m != nil {
+		return m.Validations
+	}
+	return 0
+}
+
+func (m *ValidatorEpochStats) GetPassed() uint64 {
+	if m != nil {
+		return m.Passed
+	}
+	return 0
+}
+
+func (m *ValidatorEpochStats) GetInvalidations() uint64 {
+	if m != nil {
+		return m.Invalidations
+	}
+	return 0
+}
+
+func (m *ValidatorEpochStats) GetUpheldInvalidations() uint64 {
+	if m != nil {
+		return m.UpheldInvalidations
+	}
+	return 0
+}
+
+func (m *ValidatorEpochStats) GetOverturnedInvalidations() uint64 {
+	if m != nil {
+		return m.OverturnedInvalidations
+	}
+	return 0
+}
+
+func (m *ValidatorEpochStats) GetRevalidations() uint64 {
+	if m != nil {
+		return m.Revalidations
+	}
+	return 0
+}
+
+func (m *ValidatorEpochStats) GetMustValidate() uint64 {
+	if m != nil {
+		return m.MustValidate
+	}
+	return 0
+}
+
+func (m *ValidatorEpochStats) GetMissed() uint64 {
+	if m != nil {
+		return m.Missed
+	}
+	return 0
+}
+
+// ValidatorTargetStats aggregates how one validator validated the inferences of one executor in an epoch
+type ValidatorTargetStats struct {
+	EpochIndex              uint64 `protobuf:"varint,1,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	Validator               string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Executor                string `protobuf:"bytes,3,opt,name=executor,proto3" json:"executor,omitempty"`
+	Validations             uint64 `protobuf:"varint,4,opt,name=validations,proto3" json:"validations,omitempty"`
+	Passed                  uint64 `protobuf:"varint,5,opt,name=passed,proto3" json:"passed,omitempty"`
+	Invalidations           uint64 `protobuf:"varint,6,opt,name=invalidations,proto3" json:"invalidations,omitempty"`
+	UpheldInvalidations     uint64 `protobuf:"varint,7,opt,name=upheld_invalidations,json=upheldInvalidations,proto3" json:"upheld_invalidations,omitempty"`
+	OverturnedInvalidations uint64 `protobuf:"varint,8,opt,name=overturned_invalidations,json=overturnedInvalidations,proto3" json:"overturned_invalidations,omitempty"`
+}
+
+func (m *ValidatorTargetStats) Reset()         { *m = ValidatorTargetStats{} }
+func (m *ValidatorTargetStats) String() string { return proto.CompactTextString(m) }
+func (*ValidatorTargetStats) ProtoMessage()    {}
+func (*ValidatorTargetStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04a01e1c2b9d0ba8, []int{1}
+}
+func (m *ValidatorTargetStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorTargetStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorTargetStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorTargetStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorTargetStats.Merge(m, src)
+}
+func (m *ValidatorTargetStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorTargetStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorTargetStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorTargetStats proto.InternalMessageInfo
+
+func (m *ValidatorTargetStats) GetEpochIndex() uint64 {
+	if m != nil {
+		return m.EpochIndex
+	}
+	return 0
+}
+
+func (m *ValidatorTargetStats) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorTargetStats) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
+}
+
+func (m *ValidatorTargetStats) GetValidations() uint64 {
+	if m != nil {
+		return m.Validations
+	}
+	return 0
+}
+
+func (m *ValidatorTargetStats) GetPassed() uint64 {
+	if m != nil {
+		return m.Passed
+	}
+	return 0
+}
+
+func (m *ValidatorTargetStats) GetInvalidations() uint64 {
+	if m != nil {
+		return m.Invalidations
+	}
+	return 0
+}
+
+func (m *ValidatorTargetStats) GetUpheldInvalidations() uint64 {
+	if m != nil {
+		return m.UpheldInvalidations
+	}
+	return 0
+}
+
+func (m *ValidatorTargetStats) GetOverturnedInvalidations() uint64 {
+	if m != nil {
+		return m.OverturnedInvalidations
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("inference.inference.ValidatorStatsRanking", ValidatorStatsRanking_name, ValidatorStatsRanking_value)
+	proto.RegisterType((*ValidatorEpochStats)(nil), "inference.inference.ValidatorEpochStats")
+	proto.RegisterType((*ValidatorTargetStats)(nil), "inference.inference.ValidatorTargetStats")
+}
+
+func init() {
+	proto.RegisterFile("inference/inference/validator_stats.proto", fileDescriptor_04a01e1c2b9d0ba8)
+}
+
+var fileDescriptor_04a01e1c2b9d0ba8 = []byte{
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0x8f, 0x93, 0x34, 0x4d, 0x5e, 0x09, 0xaa, 0x2e, 0x2d, 0x35, 0xa8, 0x32, 0x56, 0xc9, 0x50,
+	0x18, 0x52, 0x21, 0xc4, 0xc0, 0x98, 0x2a, 0x96, 0xb0, 0x28, 0x09, 0x72, 0xd2, 0x48, 0xb0, 0x58,
+	0xae, 0xfd, 0x48, 0x4e, 0x34, 0xb6, 0x75, 0x77, 0xae, 0xc2, 0x07, 0x60, 0xe7, 0x5b, 0xf0, 0x31,
+	0x58, 0x19, 0x33, 0x32, 0xa2, 0xe4, 0x8b, 0x20, 0x5f, 0x62, 0xc7, 0xf6, 0x10, 0x09, 0xd4, 0xed,
+	0xfd, 0xfe, 0xc9, 0x7e, 0xef, 0xa7, 0x83, 0xe7, 0xd4, 0xff, 0x8c, 0x0c, 0x7d, 0x17, 0x2f, 0xb6,
+	0xd3, 0x9d, 0x73, 0x4b, 0x3d, 0x47, 0x04, 0xcc, 0xe6, 0xc2, 0x11, 0xbc, 0x13, 0xb2, 0x40, 0x04,
+	0xa4, 0x95, 0x1a, 0x3a, 0xe9, 0x74, 0xf6, 0xad, 0x02, 0xad, 0x71, 0x62, 0x37, 0xc2, 0xc0, 0x9d,
+	0x0e, 0xe3, 0x08, 0x79, 0x0a, 0x07, 0x18, 0x23, 0x9b, 0xfa, 0x1e, 0xce, 0x55, 0x45, 0x57, 0xce,
+	0xab, 0x16, 0x48, 0xca, 0x8c, 0x19, 0x72, 0x0a, 0x8d, 0xf4, 0x33, 0x6a, 0x59, 0x57, 0xce, 0x1b,
+	0xd6, 0x96, 0x20, 0x3a, 0x1c, 0x6c, 0x00, 0x0d, 0x7c, 0xae, 0x56, 0x64, 0x3c, 0x4b, 0x91, 0x47,
+	0x50, 0x0b, 0x1d, 0xce, 0xd1, 0x53, 0xab, 0x52, 0xdc, 0x20, 0xd2, 0x86, 0x26, 0xf5, 0xb3, 0xd9,
+	0x3d, 0x29, 0xe7, 0x49, 0xf2, 0x12, 0x8e, 0xa2, 0x70, 0x8a, 0xb7, 0x9e, 0x9d, 0x37, 0xd7, 0xa4,
+	0xb9, 0xb5, 0xd6, 0xcc, 0x5c, 0xe4, 0x0d, 0xa8, 0xc1, 0x1d, 0x32, 0x11, 0x31, 0x1f, 0x8b, 0xb1,
+	0x7d, 0x19, 0x3b, 0xd9, 0xea, 0xf9, 0x68, 0x1b, 0x9a, 0x0c, 0xb3, 0xfe, 0xfa, 0xfa, 0x9f, 0x72,
+	0x24, 0x79, 0x06, 0xcd, 0x59, 0xc4, 0x85, 0xbd, 0xe1, 0x50, 0x6d, 0x48, 0xd7, 0x83, 0x98, 0xdc,
+	0x9c, 0x18, 0xe3, 0xb5, 0x67, 0x54, 0xae, 0x0d, 0xeb, 0xb5, 0xd7, 0xe8, 0xec, 0x67, 0x19, 0x8e,
+	0xd2, 0x1e, 0x46, 0x0e, 0x9b, 0xa0, 0xb8, 0x97, 0x22, 0x9e, 0x40, 0x1d, 0xe7, 0xe8, 0x46, 0xb1,
+	0x58, 0x91, 0x62, 0x8a, 0x8b, 0x25, 0x55, 0x77, 0x95, 0xb4, 0xb7, 0xbb, 0xa4, 0xda, 0xbf, 0x94,
+	0xb4, 0xff, 0x7f, 0x25, 0xd5, 0x77, 0x96, 0xf4, 0xe2, 0x87, 0x02, 0xc7, 0xe9, 0x05, 0xe5, 0xed,
+	0x2c, 0xc7, 0xff, 0x42, 0xfd, 0x09, 0x39, 0x81, 0x96, 0xd5, 0xed, 0xbf, 0xb3, 0x2f, 0x3f, 0xda,
+	0xe3, 0xee, 0x95, 0xd9, 0xeb, 0x8e, 0xcc, 0x41, 0x7f, 0x78, 0x58, 0x22, 0x8f, 0xe1, 0x38, 0x11,
+	0xcc, 0x7e, 0x56, 0x52, 0x88, 0x0e, 0xa7, 0x89, 0x74, 0xfd, 0xe1, 0xad, 0x71, 0xd5, 0x2b, 0x38,
+	0xca, 0xa4, 0x0d, 0x7a, 0xe2, 0x18, 0x8c, 0x0d, 0x6b, 0x74, 0x6d, 0xf5, 0x8d, 0xa2, 0xab, 0x42,
+	0x08, 0x3c, 0x4c, 0x5c, 0xef, 0xcd, 0xe1, 0xd0, 0xe8, 0x1d, 0x56, 0x2f, 0x07, 0xbf, 0x96, 0x9a,
+	0xb2, 0x58, 0x6a, 0xca, 0x9f, 0xa5, 0xa6, 0x7c, 0x5f, 0x69, 0xa5, 0xc5, 0x4a, 0x2b, 0xfd, 0x5e,
+	0x69, 0xa5, 0x4f, 0xaf, 0x27, 0x54, 0x4c, 0xa3, 0x9b, 0x8e, 0x1b, 0xcc, 0x2e, 0x42, 0x16, 0x78,
+	0x91, 0x2b, 0xb8, 0x4b, 0x0b, 0xaf, 0x7b, 0x9e, 0x99, 0xc5, 0xd7, 0x10, 0xf9, 0x4d, 0x4d, 0x3e,
+	0xf0, 0x57, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xfc, 0xe3, 0xc7, 0x0d, 0x04, 0x00, 0x00,
+}
+
+func (m *ValidatorEpochStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEpochStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEpochStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Missed != 0 {
+		i = encodeVarintValidatorStats(dAtA, i, uint64(m.Missed))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MustValidate != 0 {
+		i = encodeVarintValidatorStats(dAtA, i, uint64(m.MustValidate))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Revalidations != 0 {
+		i = encodeVarintValidatorStats(dAtA, i, uint64(m.Revalidations))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.OverturnedInvalidations != 0 {
+		i = encodeVarintValidatorStats(dAtA, i, uint64(m.OverturnedInvalidations))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UpheldInvalidations != 0 {
+		i = encodeVarintValidatorStats(dAtA, i, uint64(m.UpheldInvalidations))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Invalidations != 0 {
+		i = encodeVarintValidatorStats(dAtA, i, uint64(m.Invalidations))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Passed != 0 {
+		i = encodeVarintValidatorStats(dAtA, i, uint64(m.Passed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Validations != 0 {
+		i = encodeVarintValidatorStats(dAtA, i, uint64(m.Validations))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintValidatorStats(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochIndex != 0 {
+		i = encodeVarintValidatorStats(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorTargetStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorTargetStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorTargetStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OverturnedInvalidations != 0 {
+		i = encodeVarintValidatorStats(dAtA, i, uint64(m.OverturnedInvalidations))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UpheldInvalidations != 0 {
+		i = encodeVarintValidatorStats(dAtA, i, uint64(m.UpheldInvalidations))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Invalidations != 0 {
+		i = encodeVarintValidatorStats(dAtA, i, uint64(m.Invalidations))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Passed != 0 {
+		i = encodeVarintValidatorStats(dAtA, i, uint64(m.Passed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Validations != 0 {
+		i = encodeVarintValidatorStats(dAtA, i, uint64(m.Validations))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintValidatorStats(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintValidatorStats(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochIndex != 0 {
+		i = encodeVarintValidatorStats(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidatorStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidatorStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorEpochStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochIndex != 0 {
+		n += 1 + sovValidatorStats(uint64(m.EpochIndex))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovValidatorStats(uint64(l))
+	}
+	if m.Validations != 0 {
+		n += 1 + sovValidatorStats(uint64(m.Validations))
+	}
+	if m.Passed != 0 {
+		n += 1 + sovValidatorStats(uint64(m.Passed))
+	}
+	if m.Invalidations != 0 {
+		n += 1 + sovValidatorStats(uint64(m.Invalidations))
+	}
+	if m.UpheldInvalidations != 0 {
+		n += 1 + sovValidatorStats(uint64(m.UpheldInvalidations))
+	}
+	if m.OverturnedInvalidations != 0 {
+		n += 1 + sovValidatorStats(uint64(m.OverturnedInvalidations))
+	}
+	if m.Revalidations != 0 {
+		n += 1 + sovValidatorStats(uint64(m.Revalidations))
+	}
+	if m.MustValidate != 0 {
+		n += 1 + sovValidatorStats(uint64(m.MustValidate))
+	}
+	if m.Missed != 0 {
+		n += 1 + sovValidatorStats(uint64(m.Missed))
+	}
+	return n
+}
+
+func (m *ValidatorTargetStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochIndex != 0 {
+		n += 1 + sovValidatorStats(uint64(m.EpochIndex))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovValidatorStats(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovValidatorStats(uint64(l))
+	}
+	if m.Validations != 0 {
+		n += 1 + sovValidatorStats(uint64(m.Validations))
+	}
+	if m.Passed != 0 {
+		n += 1 + sovValidatorStats(uint64(m.Passed))
+	}
+	if m.Invalidations != 0 {
+		n += 1 + sovValidatorStats(uint64(m.Invalidations))
+	}
+	if m.UpheldInvalidations != 0 {
+		n += 1 + sovValidatorStats(uint64(m.UpheldInvalidations))
+	}
+	if m.OverturnedInvalidations != 0 {
+		n += 1 + sovValidatorStats(uint64(m.OverturnedInvalidations))
+	}
+	return n
+}
+
+func sovValidatorStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidatorStats(x uint64) (n int) {
+	return sovValidatorStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorEpochStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEpochStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEpochStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			}
+			m.EpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+			}
+			m.Validations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Validations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			m.Passed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Passed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalidations", wireType)
+			}
+			m.Invalidations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Invalidations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpheldInvalidations", wireType)
+			}
+			m.UpheldInvalidations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpheldInvalidations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverturnedInvalidations", wireType)
+			}
+			m.OverturnedInvalidations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverturnedInvalidations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revalidations", wireType)
+			}
+			m.Revalidations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revalidations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MustValidate", wireType)
+			}
+			m.MustValidate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MustValidate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
+			}
+			m.Missed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Missed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorTargetStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorTargetStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorTargetStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			}
+			m.EpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+			}
+			m.Validations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Validations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			m.Passed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Passed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalidations", wireType)
+			}
+			m.Invalidations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Invalidations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpheldInvalidations", wireType)
+			}
+			m.UpheldInvalidations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpheldInvalidations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverturnedInvalidations", wireType)
+			}
+			m.OverturnedInvalidations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverturnedInvalidations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidatorStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidatorStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidatorStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidatorStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidatorStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidatorStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidatorStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidatorStats = fmt.Errorf("proto: unexpected end of group")
+)
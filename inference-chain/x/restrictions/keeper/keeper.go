@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -23,6 +24,10 @@ type (
 
 		accountKeeper types.AccountKeeper
 		bankKeeper    types.BankKeeper
+
+		Schema                collections.Schema
+		ScopedExemptions      collections.Map[string, types.TransferExemption]
+		ScopedExemptionUsages collections.Map[collections.Pair[string, sdk.AccAddress], types.TransferExemptionUsage]
 	}
 )
 
@@ -39,7 +44,8 @@ func NewKeeper(
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
 	}
 
-	return Keeper{
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
 		cdc:           cdc,
 		storeService:  storeService,
 		authority:     authority,
@@ -47,6 +53,16 @@ func NewKeeper(
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 	}
+	k.ScopedExemptions = collections.NewMap(sb, types.TransferExemptionsPrefix, "transfer_exemptions", collections.StringKey, codec.CollValue[types.TransferExemption](cdc))
+	k.ScopedExemptionUsages = collections.NewMap(sb, types.TransferExemptionUsagesPrefix, "transfer_exemption_usages", collections.PairKeyCodec(collections.StringKey, sdk.AccAddressKey), codec.CollValue[types.TransferExemptionUsage](cdc))
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
 }
 
 // GetAuthority returns the module's authority.
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/restrictions/types"
+)
+
+// AddTransferExemption stores a new governance-approved transfer exemption
+func (k msgServer) AddTransferExemption(goCtx context.Context, req *types.MsgAddTransferExemption) (*types.MsgAddTransferExemptionResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+	if err := req.Exemption.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidExemption, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetTransferExemption(ctx, req.Exemption.ExemptionId); found {
+		return nil, errorsmod.Wrapf(types.ErrExemptionAlreadyExists, "exemption ID %s", req.Exemption.ExemptionId)
+	}
+
+	exemption := req.Exemption
+	exemption.Revoked = false
+	exemption.RevokedHeight = 0
+	k.SetTransferExemption(ctx, exemption)
+	k.Logger().Info("Transfer exemption added", "exemptionId", exemption.ExemptionId, "expiryHeight", exemption.ExpiryHeight)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExemptionAdded,
+			sdk.NewAttribute(types.AttributeKeyExemptionId, exemption.ExemptionId),
+			sdk.NewAttribute(types.AttributeKeyAmount, exemption.AmountPerEpoch.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatUint(exemption.ExpiryHeight, 10)),
+		),
+	)
+
+	return &types.MsgAddTransferExemptionResponse{}, nil
+}
+
+// RevokeTransferExemption stops a transfer exemption from applying and drops its usage records
+func (k msgServer) RevokeTransferExemption(goCtx context.Context, req *types.MsgRevokeTransferExemption) (*types.MsgRevokeTransferExemptionResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	exemption, found := k.GetTransferExemption(ctx, req.ExemptionId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrExemptionNotFound, "exemption ID %s not found", req.ExemptionId)
+	}
+	if exemption.Revoked {
+		return nil, errorsmod.Wrapf(types.ErrExemptionRevoked, "exemption ID %s revoked at height %d", req.ExemptionId, exemption.RevokedHeight)
+	}
+
+	exemption.Revoked = true
+	exemption.RevokedHeight = uint64(ctx.BlockHeight())
+	k.SetTransferExemption(ctx, exemption)
+	if err := k.removeTransferExemptionUsages(ctx, exemption.ExemptionId); err != nil {
+		return nil, err
+	}
+	k.Logger().Info("Transfer exemption revoked", "exemptionId", exemption.ExemptionId)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExemptionRevoked,
+			sdk.NewAttribute(types.AttributeKeyExemptionId, exemption.ExemptionId),
+		),
+	)
+
+	return &types.MsgRevokeTransferExemptionResponse{}, nil
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/restrictions/types"
+)
+
+func testTransferExemption() types.TransferExemption {
+	return types.TransferExemption{
+		ExemptionId:       "scoped-1",
+		FromAddresses:     []string{testutil.Creator},
+		ToAddresses:       []string{testutil.Requester, testutil.Executor},
+		AmountPerEpoch:    sdk.NewCoins(sdk.NewInt64Coin("ngonka", 1000)),
+		EpochLengthBlocks: 100,
+		ExpiryHeight:      10_000,
+		Justification:     "Test scoped exemption",
+	}
+}
+
+func TestMsgAddTransferExemption(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+
+	_, err := ms.AddTransferExemption(ctx, &types.MsgAddTransferExemption{Authority: testutil.Creator, Exemption: testTransferExemption()})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	invalid := testTransferExemption()
+	invalid.FromAddresses = nil
+	_, err = ms.AddTransferExemption(ctx, &types.MsgAddTransferExemption{Authority: k.GetAuthority(), Exemption: invalid})
+	require.ErrorIs(t, err, types.ErrInvalidExemption)
+
+	_, err = ms.AddTransferExemption(ctx, &types.MsgAddTransferExemption{Authority: k.GetAuthority(), Exemption: testTransferExemption()})
+	require.NoError(t, err)
+	_, err = ms.AddTransferExemption(ctx, &types.MsgAddTransferExemption{Authority: k.GetAuthority(), Exemption: testTransferExemption()})
+	require.ErrorIs(t, err, types.ErrExemptionAlreadyExists)
+
+	// Adding an exemption leaves params alone
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+	exemption, found := k.GetTransferExemption(ctx, "scoped-1")
+	require.True(t, found)
+	require.Equal(t, testTransferExemption(), exemption)
+}
+
+func TestTransferExemptionAllowance(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(150)
+	params := types.DefaultParams()
+	params.RestrictionEndBlock = 2_000_000
+	require.NoError(t, k.SetParams(sdkCtx, params))
+	_, err := ms.AddTransferExemption(sdkCtx, &types.MsgAddTransferExemption{Authority: k.GetAuthority(), Exemption: testTransferExemption()})
+	require.NoError(t, err)
+
+	creator := sdk.MustAccAddressFromBech32(testutil.Creator)
+	requester := sdk.MustAccAddressFromBech32(testutil.Requester)
+	executor := sdk.MustAccAddressFromBech32(testutil.Executor)
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("ngonka", amount)) }
+
+	// Allowed senders and destinations only
+	_, err = k.SendRestrictionFn(sdkCtx, requester, creator, coins(10))
+	require.ErrorIs(t, err, types.ErrTransferRestricted)
+	_, err = k.SendRestrictionFn(sdkCtx, creator, creator, coins(10))
+	require.ErrorIs(t, err, types.ErrTransferRestricted)
+
+	// The allowance is shared across destinations within the epoch
+	_, err = k.SendRestrictionFn(sdkCtx, creator, requester, coins(600))
+	require.NoError(t, err)
+	_, err = k.SendRestrictionFn(sdkCtx, creator, executor, coins(500))
+	require.ErrorIs(t, err, types.ErrTransferRestricted)
+	_, err = k.SendRestrictionFn(sdkCtx, creator, executor, coins(400))
+	require.NoError(t, err)
+
+	resp, err := k.TransferRestrictionStatus(sdkCtx, &types.QueryTransferRestrictionStatusRequest{AccountAddress: testutil.Creator})
+	require.NoError(t, err)
+	require.Equal(t, []types.ExemptionAllowance{{
+		ExemptionId:    "scoped-1",
+		Epoch:          1,
+		Remaining:      sdk.NewCoins(),
+		EpochEndHeight: 200,
+		ExpiryHeight:   10_000,
+	}}, resp.Allowances)
+
+	// The allowance is restored in the next epoch
+	sdkCtx = sdkCtx.WithBlockHeight(200)
+	resp, err = k.TransferRestrictionStatus(sdkCtx, &types.QueryTransferRestrictionStatusRequest{AccountAddress: testutil.Creator})
+	require.NoError(t, err)
+	require.Equal(t, coins(1000), resp.Allowances[0].Remaining)
+	_, err = k.SendRestrictionFn(sdkCtx, creator, requester, coins(1000))
+	require.NoError(t, err)
+
+	// Revocation ends the exemption and drops its usage
+	_, err = ms.RevokeTransferExemption(sdkCtx, &types.MsgRevokeTransferExemption{Authority: k.GetAuthority(), ExemptionId: "scoped-1"})
+	require.NoError(t, err)
+	_, err = ms.RevokeTransferExemption(sdkCtx, &types.MsgRevokeTransferExemption{Authority: k.GetAuthority(), ExemptionId: "scoped-1"})
+	require.ErrorIs(t, err, types.ErrExemptionRevoked)
+	require.Empty(t, k.GetAllTransferExemptionUsages(sdkCtx))
+	sdkCtx = sdkCtx.WithBlockHeight(300)
+	_, err = k.SendRestrictionFn(sdkCtx, creator, requester, coins(1))
+	require.ErrorIs(t, err, types.ErrTransferRestricted)
+	resp, err = k.TransferRestrictionStatus(sdkCtx, &types.QueryTransferRestrictionStatusRequest{AccountAddress: testutil.Creator})
+	require.NoError(t, err)
+	require.Empty(t, resp.Allowances)
+}
@@ -33,10 +33,21 @@ func (k Keeper) TransferRestrictionStatus(goCtx context.Context, req *types.Quer
 		remainingBlocks = 0
 	}
 
+	// Report the account's remaining exemption allowances if requested
+	var allowances []types.ExemptionAllowance
+	if req.AccountAddress != "" {
+		addr, err := sdk.AccAddressFromBech32(req.AccountAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid account address: %s", err)
+		}
+		allowances = k.ExemptionAllowances(ctx, addr)
+	}
+
 	return &types.QueryTransferRestrictionStatusResponse{
 		IsActive:            isActive,
 		RestrictionEndBlock: restrictionEndBlock,
 		CurrentBlockHeight:  currentHeight,
 		RemainingBlocks:     remainingBlocks,
+		Allowances:          allowances,
 	}, nil
 }
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/restrictions/types"
+)
+
+// SetTransferExemption stores a transfer exemption
+func (k Keeper) SetTransferExemption(ctx context.Context, exemption types.TransferExemption) {
+	if err := k.ScopedExemptions.Set(ctx, exemption.ExemptionId, exemption); err != nil {
+		panic(err)
+	}
+}
+
+// GetTransferExemption returns a transfer exemption by its ID
+func (k Keeper) GetTransferExemption(ctx context.Context, exemptionId string) (types.TransferExemption, bool) {
+	exemption, err := k.ScopedExemptions.Get(ctx, exemptionId)
+	if err != nil {
+		return types.TransferExemption{}, false
+	}
+	return exemption, true
+}
+
+// GetAllTransferExemptions returns all transfer exemptions, revoked and expired ones included
+func (k Keeper) GetAllTransferExemptions(ctx context.Context) []types.TransferExemption {
+	iter, err := k.ScopedExemptions.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	exemptions, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return exemptions
+}
+
+// SetTransferExemptionUsage stores what an account transferred under an exemption in its current epoch
+func (k Keeper) SetTransferExemptionUsage(ctx context.Context, usage types.TransferExemptionUsage) {
+	addr := sdk.MustAccAddressFromBech32(usage.AccountAddress)
+	if err := k.ScopedExemptionUsages.Set(ctx, collections.Join(usage.ExemptionId, addr), usage); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllTransferExemptionUsages returns the recorded usage of all exemptions
+func (k Keeper) GetAllTransferExemptionUsages(ctx context.Context) []types.TransferExemptionUsage {
+	iter, err := k.ScopedExemptionUsages.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	usages, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return usages
+}
+
+func (k Keeper) getTransferExemptionUsage(ctx context.Context, exemptionId string, addr sdk.AccAddress) types.TransferExemptionUsage {
+	usage, err := k.ScopedExemptionUsages.Get(ctx, collections.Join(exemptionId, addr))
+	if err != nil {
+		return types.TransferExemptionUsage{ExemptionId: exemptionId, AccountAddress: addr.String()}
+	}
+	return usage
+}
+
+// removeTransferExemptionUsages drops the recorded usage of an exemption by all accounts
+func (k Keeper) removeTransferExemptionUsages(ctx context.Context, exemptionId string) error {
+	return k.ScopedExemptionUsages.Clear(ctx, collections.NewPrefixedPairRange[string, sdk.AccAddress](exemptionId))
+}
+
+// UseTransferExemption looks for an active exemption that lets from send amt to to within its remaining
+// allowance for the current epoch. If there is one, amt is counted against that allowance.
+func (k Keeper) UseTransferExemption(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) bool {
+	height := uint64(ctx.BlockHeight())
+	fromStr, toStr := from.String(), to.String()
+
+	var used *types.TransferExemption
+	err := k.ScopedExemptions.Walk(ctx, nil, func(_ string, exemption types.TransferExemption) (bool, error) {
+		if !exemption.IsActive(height) || !exemption.AllowsSender(fromStr) || !exemption.AllowsDestination(toStr) {
+			return false, nil
+		}
+		usage := k.getTransferExemptionUsage(ctx, exemption.ExemptionId, from)
+		epoch := exemption.Epoch(height)
+		remaining := exemption.Remaining(usage, epoch)
+		if !amt.IsAllLTE(remaining) {
+			return false, nil
+		}
+		if usage.Epoch != epoch {
+			usage.Epoch = epoch
+			usage.Used = sdk.NewCoins()
+		}
+		usage.Used = usage.Used.Add(amt...)
+		k.SetTransferExemptionUsage(ctx, usage)
+		used = &exemption
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExemptionUsed,
+				sdk.NewAttribute(types.AttributeKeyExemptionId, exemption.ExemptionId),
+				sdk.NewAttribute(types.AttributeKeyFromAddress, fromStr),
+				sdk.NewAttribute(types.AttributeKeyToAddress, toStr),
+				sdk.NewAttribute(types.AttributeKeyAmount, amt.String()),
+				sdk.NewAttribute(types.AttributeKeyRemaining, remaining.Sub(amt...).String()),
+			),
+		)
+		return true, nil
+	})
+	if err != nil {
+		k.Logger().Error("Failed to walk transfer exemptions", "error", err)
+		return false
+	}
+	return used != nil
+}
+
+// ExemptionAllowances returns what an account may still send in the current epoch under every active exemption
+// that lists it as a sender
+func (k Keeper) ExemptionAllowances(ctx sdk.Context, addr sdk.AccAddress) []types.ExemptionAllowance {
+	height := uint64(ctx.BlockHeight())
+	allowances := []types.ExemptionAllowance{}
+	for _, exemption := range k.GetAllTransferExemptions(ctx) {
+		if !exemption.IsActive(height) || !exemption.AllowsSender(addr.String()) {
+			continue
+		}
+		epoch := exemption.Epoch(height)
+		allowances = append(allowances, types.ExemptionAllowance{
+			ExemptionId:    exemption.ExemptionId,
+			Epoch:          epoch,
+			Remaining:      exemption.Remaining(k.getTransferExemptionUsage(ctx, exemption.ExemptionId, addr), epoch),
+			EpochEndHeight: (epoch + 1) * exemption.EpochLengthBlocks,
+			ExpiryHeight:   exemption.ExpiryHeight,
+		})
+	}
+	return allowances
+}
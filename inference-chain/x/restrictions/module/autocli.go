@@ -21,7 +21,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "TransferRestrictionStatus",
 					Use:       "status",
 					Short:     "Query current transfer restriction status",
-					Long:      "Query the current status of transfer restrictions including whether they are active, end block, current block, and remaining blocks.",
+					Long:      "Query the current status of transfer restrictions including whether they are active, end block, current block, and remaining blocks. With --account-address it also shows the account's remaining transfer exemption allowances.",
 				},
 				{
 					RpcMethod: "TransferExemptions",
@@ -63,6 +63,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "denom"},
 					},
 				},
+				{
+					RpcMethod: "AddTransferExemption",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RevokeTransferExemption",
+					Skip:      true, // skipped because authority gated
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
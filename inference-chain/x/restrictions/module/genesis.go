@@ -13,12 +13,20 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
 	}
+	for _, exemption := range genState.TransferExemptions {
+		k.SetTransferExemption(ctx, exemption)
+	}
+	for _, usage := range genState.TransferExemptionUsages {
+		k.SetTransferExemptionUsage(ctx, usage)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.TransferExemptions = k.GetAllTransferExemptions(ctx)
+	genesis.TransferExemptionUsages = k.GetAllTransferExemptionUsages(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -13,6 +13,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 		&MsgExecuteEmergencyTransfer{},
+		&MsgAddTransferExemption{},
+		&MsgRevokeTransferExemption{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
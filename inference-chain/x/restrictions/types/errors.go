@@ -17,4 +17,6 @@ var (
 	ErrExemptionAmountExceeded     = sdkerrors.Register(ModuleName, 1106, "transfer amount exceeds exemption maximum")
 	ErrExemptionUsageLimitExceeded = sdkerrors.Register(ModuleName, 1107, "exemption usage limit exceeded")
 	ErrTransferRestricted          = sdkerrors.Register(ModuleName, 1108, "transfer restricted during bootstrap period")
+	ErrExemptionAlreadyExists      = sdkerrors.Register(ModuleName, 1109, "transfer exemption already exists")
+	ErrExemptionRevoked            = sdkerrors.Register(ModuleName, 1110, "transfer exemption already revoked")
 )
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks that the exemption names its senders and destinations and caps what they may transfer
+func (e TransferExemption) Validate() error {
+	if e.ExemptionId == "" {
+		return fmt.Errorf("exemption ID cannot be empty")
+	}
+	if err := validateAllowlist("from", e.FromAddresses); err != nil {
+		return err
+	}
+	if err := validateAllowlist("to", e.ToAddresses); err != nil {
+		return err
+	}
+	if !e.AmountPerEpoch.IsValid() || e.AmountPerEpoch.IsZero() {
+		return fmt.Errorf("invalid amount per epoch: %s", e.AmountPerEpoch)
+	}
+	if e.EpochLengthBlocks == 0 {
+		return fmt.Errorf("epoch length must be greater than 0")
+	}
+	if e.ExpiryHeight == 0 {
+		return fmt.Errorf("expiry height must be greater than 0")
+	}
+	return nil
+}
+
+func validateAllowlist(side string, addresses []string) error {
+	if len(addresses) == 0 {
+		return fmt.Errorf("%s allowlist cannot be empty", side)
+	}
+	for _, address := range addresses {
+		if address == "*" {
+			continue
+		}
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid %s address %s: %w", side, address, err)
+		}
+	}
+	return nil
+}
+
+// IsActive reports whether the exemption applies at height
+func (e TransferExemption) IsActive(height uint64) bool {
+	return !e.Revoked && height < e.ExpiryHeight
+}
+
+// AllowsSender reports whether the from allowlist covers address
+func (e TransferExemption) AllowsSender(address string) bool {
+	return slices.Contains(e.FromAddresses, "*") || slices.Contains(e.FromAddresses, address)
+}
+
+// AllowsDestination reports whether the to allowlist covers address
+func (e TransferExemption) AllowsDestination(address string) bool {
+	return slices.Contains(e.ToAddresses, "*") || slices.Contains(e.ToAddresses, address)
+}
+
+// Epoch returns the allowance epoch that height falls in
+func (e TransferExemption) Epoch(height uint64) uint64 {
+	return height / e.EpochLengthBlocks
+}
+
+// Remaining returns what an account may still transfer in epoch given its recorded usage
+func (e TransferExemption) Remaining(usage TransferExemptionUsage, epoch uint64) sdk.Coins {
+	if usage.Epoch != epoch {
+		return e.AmountPerEpoch
+	}
+	remaining := sdk.NewCoins()
+	for _, coin := range e.AmountPerEpoch {
+		left := coin.Amount.Sub(usage.Used.AmountOf(coin.Denom))
+		if left.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(coin.Denom, left))
+		}
+	}
+	return remaining
+}
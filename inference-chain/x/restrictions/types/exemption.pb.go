@@ -0,0 +1,1443 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/restrictions/exemption.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransferExemption lets the listed senders transfer to the listed destinations during the restriction period,
+// up to an amount per epoch of epoch_length_blocks blocks
+type TransferExemption struct {
+	ExemptionId string `protobuf:"bytes,1,opt,name=exemption_id,json=exemptionId,proto3" json:"exemption_id,omitempty"`
+	// from_addresses are the senders the exemption applies to, "*" for any
+	FromAddresses []string `protobuf:"bytes,2,rep,name=from_addresses,json=fromAddresses,proto3" json:"from_addresses,omitempty"`
+	// to_addresses are the destinations the exemption applies to, "*" for any
+	ToAddresses []string `protobuf:"bytes,3,rep,name=to_addresses,json=toAddresses,proto3" json:"to_addresses,omitempty"`
+	// amount_per_epoch is what each sender may transfer under the exemption in one epoch
+	AmountPerEpoch    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount_per_epoch,json=amountPerEpoch,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount_per_epoch"`
+	EpochLengthBlocks uint64                                   `protobuf:"varint,5,opt,name=epoch_length_blocks,json=epochLengthBlocks,proto3" json:"epoch_length_blocks,omitempty"`
+	// expiry_height is the first block height the exemption no longer applies at
+	ExpiryHeight  uint64 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	Revoked       bool   `protobuf:"varint,7,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	RevokedHeight uint64 `protobuf:"varint,8,opt,name=revoked_height,json=revokedHeight,proto3" json:"revoked_height,omitempty"`
+	Justification string `protobuf:"bytes,9,opt,name=justification,proto3" json:"justification,omitempty"`
+}
+
+func (m *TransferExemption) Reset()         { *m = TransferExemption{} }
+func (m *TransferExemption) String() string { return proto.CompactTextString(m) }
+func (*TransferExemption) ProtoMessage()    {}
+func (*TransferExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b09fd2e164ee1067, []int{0}
+}
+func (m *TransferExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferExemption.Merge(m, src)
+}
+func (m *TransferExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferExemption proto.InternalMessageInfo
+
+func (m *TransferExemption) GetExemptionId() string {
+	if m != nil {
+		return m.ExemptionId
+	}
+	return ""
+}
+
+func (m *TransferExemption) GetFromAddresses() []string {
+	if m != nil {
+		return m.FromAddresses
+	}
+	return nil
+}
+
+func (m *TransferExemption) GetToAddresses() []string {
+	if m != nil {
+		return m.ToAddresses
+	}
+	return nil
+}
+
+func (m *TransferExemption) GetAmountPerEpoch() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AmountPerEpoch
+	}
+	return nil
+}
+
+func (m *TransferExemption) GetEpochLengthBlocks() uint64 {
+	if m != nil {
+		return m.EpochLengthBlocks
+	}
+	return 0
+}
+
+func (m *TransferExemption) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *TransferExemption) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+func (m *TransferExemption) GetRevokedHeight() uint64 {
+	if m != nil {
+		return m.RevokedHeight
+	}
+	return 0
+}
+
+func (m *TransferExemption) GetJustification() string {
+	if m != nil {
+		return m.Justification
+	}
+	return ""
+}
+
+// TransferExemptionUsage is what an account transferred under an exemption in its current epoch
+type TransferExemptionUsage struct {
+	ExemptionId    string                                   `protobuf:"bytes,1,opt,name=exemption_id,json=exemptionId,proto3" json:"exemption_id,omitempty"`
+	AccountAddress string                                   `protobuf:"bytes,2,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+	Epoch          uint64                                   `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Used           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=used,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"used"`
+}
+
+func (m *TransferExemptionUsage) Reset()         { *m = TransferExemptionUsage{} }
+func (m *TransferExemptionUsage) String() string { return proto.CompactTextString(m) }
+func (*TransferExemptionUsage) ProtoMessage()    {}
+func (*TransferExemptionUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b09fd2e164ee1067, []int{1}
+}
+func (m *TransferExemptionUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferExemptionUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferExemptionUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferExemptionUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferExemptionUsage.Merge(m, src)
+}
+func (m *TransferExemptionUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferExemptionUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferExemptionUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferExemptionUsage proto.InternalMessageInfo
+
+func (m *TransferExemptionUsage) GetExemptionId() string {
+	if m != nil {
+		return m.ExemptionId
+	}
+	return ""
+}
+
+func (m *TransferExemptionUsage) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+func (m *TransferExemptionUsage) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *TransferExemptionUsage) GetUsed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Used
+	}
+	return nil
+}
+
+// ExemptionAllowance is what an account may still transfer under an exemption in the current epoch
+type ExemptionAllowance struct {
+	ExemptionId string                                   `protobuf:"bytes,1,opt,name=exemption_id,json=exemptionId,proto3" json:"exemption_id,omitempty"`
+	Epoch       uint64                                   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Remaining   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
+	// epoch_end_height is the height the allowance is restored at
+	EpochEndHeight uint64 `protobuf:"varint,4,opt,name=epoch_end_height,json=epochEndHeight,proto3" json:"epoch_end_height,omitempty"`
+	ExpiryHeight   uint64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *ExemptionAllowance) Reset()         { *m = ExemptionAllowance{} }
+func (m *ExemptionAllowance) String() string { return proto.CompactTextString(m) }
+func (*ExemptionAllowance) ProtoMessage()    {}
+func (*ExemptionAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b09fd2e164ee1067, []int{2}
+}
+func (m *ExemptionAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExemptionAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExemptionAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExemptionAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExemptionAllowance.Merge(m, src)
+}
+func (m *ExemptionAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExemptionAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExemptionAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExemptionAllowance proto.InternalMessageInfo
+
+func (m *ExemptionAllowance) GetExemptionId() string {
+	if m != nil {
+		return m.ExemptionId
+	}
+	return ""
+}
+
+func (m *ExemptionAllowance) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ExemptionAllowance) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
+}
+
+func (m *ExemptionAllowance) GetEpochEndHeight() uint64 {
+	if m != nil {
+		return m.EpochEndHeight
+	}
+	return 0
+}
+
+func (m *ExemptionAllowance) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TransferExemption)(nil), "inference.restrictions.TransferExemption")
+	proto.RegisterType((*TransferExemptionUsage)(nil), "inference.restrictions.TransferExemptionUsage")
+	proto.RegisterType((*ExemptionAllowance)(nil), "inference.restrictions.ExemptionAllowance")
+}
+
+func init() {
+	proto.RegisterFile("inference/restrictions/exemption.proto", fileDescriptor_b09fd2e164ee1067)
+}
+
+var fileDescriptor_b09fd2e164ee1067 = []byte{
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x93, 0xf4, 0x27, 0xdb, 0x26, 0xb4, 0xa6, 0xaa, 0x4c, 0x0e, 0x4e, 0x08, 0x7f, 0x16,
+	0x12, 0xb6, 0x0a, 0x42, 0x42, 0xdc, 0x1a, 0x14, 0x09, 0x24, 0x0e, 0xc8, 0x82, 0x0b, 0x17, 0x6b,
+	0xb3, 0x9e, 0x38, 0x4b, 0xe2, 0x5d, 0x6b, 0x77, 0x53, 0x52, 0x9e, 0x81, 0x03, 0x8f, 0xc0, 0x11,
+	0x71, 0xe2, 0x09, 0x38, 0xf7, 0xd8, 0x23, 0x27, 0xa8, 0x92, 0x03, 0x3c, 0x06, 0xf2, 0xda, 0x71,
+	0x52, 0xe5, 0xd2, 0x4b, 0x2f, 0xc9, 0xfa, 0xdb, 0x6f, 0x67, 0xbe, 0x99, 0x6f, 0x34, 0xe8, 0x3e,
+	0x65, 0x03, 0x10, 0xc0, 0x08, 0x78, 0x02, 0xa4, 0x12, 0x94, 0x28, 0xca, 0x99, 0xf4, 0x60, 0x0a,
+	0x71, 0x92, 0x1e, 0xdd, 0x44, 0x70, 0xc5, 0xcd, 0xc3, 0x82, 0xe7, 0xae, 0xf2, 0x9a, 0xfb, 0x38,
+	0xa6, 0x8c, 0x7b, 0xfa, 0x37, 0xa3, 0x36, 0x6d, 0xc2, 0x65, 0xcc, 0xa5, 0xd7, 0xc7, 0x12, 0xbc,
+	0x93, 0xa3, 0x3e, 0x28, 0x7c, 0xe4, 0x11, 0x4e, 0xf3, 0x50, 0xcd, 0x83, 0x88, 0x47, 0x5c, 0x1f,
+	0xbd, 0xf4, 0x94, 0xa1, 0x9d, 0x9f, 0x15, 0xb4, 0xff, 0x56, 0x60, 0x26, 0x07, 0x20, 0x7a, 0x8b,
+	0xe4, 0xe6, 0x6d, 0xb4, 0x5b, 0x28, 0x09, 0x68, 0x68, 0x19, 0x6d, 0xc3, 0xa9, 0xf9, 0x3b, 0x05,
+	0xf6, 0x2a, 0x34, 0xef, 0xa1, 0xc6, 0x40, 0xf0, 0x38, 0xc0, 0x61, 0x28, 0x40, 0x4a, 0x90, 0x56,
+	0xb9, 0x5d, 0x71, 0x6a, 0x7e, 0x3d, 0x45, 0x8f, 0x17, 0x60, 0x1a, 0x49, 0xf1, 0x15, 0x52, 0x45,
+	0x93, 0x76, 0x14, 0x5f, 0x52, 0x3e, 0xa1, 0x3d, 0x1c, 0xf3, 0x09, 0x53, 0x41, 0x02, 0x22, 0x80,
+	0x84, 0x93, 0xa1, 0x55, 0x6d, 0x57, 0x9c, 0x9d, 0xc7, 0xb7, 0xdc, 0xac, 0x26, 0x37, 0xad, 0xc9,
+	0xcd, 0x6b, 0x72, 0x5f, 0x70, 0xca, 0xba, 0x4f, 0xcf, 0x7e, 0xb7, 0x4a, 0xdf, 0xff, 0xb4, 0x9c,
+	0x88, 0xaa, 0xe1, 0xa4, 0xef, 0x12, 0x1e, 0x7b, 0x79, 0x03, 0xb2, 0xbf, 0x47, 0x32, 0x1c, 0x79,
+	0xea, 0x34, 0x01, 0xa9, 0x1f, 0xc8, 0x6f, 0x7f, 0x7f, 0x3c, 0x34, 0xfc, 0x46, 0x96, 0xe9, 0x0d,
+	0x88, 0x5e, 0x9a, 0xc7, 0x74, 0xd1, 0x4d, 0x9d, 0x30, 0x18, 0x03, 0x8b, 0xd4, 0x30, 0xe8, 0x8f,
+	0x39, 0x19, 0x49, 0x6b, 0xa3, 0x6d, 0x38, 0x55, 0x7f, 0x5f, 0x5f, 0xbd, 0xd6, 0x37, 0x5d, 0x7d,
+	0x61, 0xde, 0x41, 0x75, 0x98, 0x26, 0x54, 0x9c, 0x06, 0x43, 0xa0, 0xd1, 0x50, 0x59, 0x9b, 0x9a,
+	0xb9, 0x9b, 0x81, 0x2f, 0x35, 0x66, 0x5a, 0x68, 0x4b, 0xc0, 0x09, 0x1f, 0x41, 0x68, 0x6d, 0xb5,
+	0x0d, 0x67, 0xdb, 0x5f, 0x7c, 0xa6, 0x4d, 0xcb, 0x8f, 0x8b, 0xf7, 0xdb, 0xfa, 0x7d, 0x3d, 0x47,
+	0xf3, 0x00, 0x77, 0x51, 0xfd, 0xc3, 0x44, 0x2a, 0x3a, 0xa0, 0x04, 0xa7, 0xed, 0xb6, 0x6a, 0xba,
+	0xff, 0x97, 0xc1, 0xe7, 0xd5, 0x7f, 0x5f, 0x5b, 0x46, 0xe7, 0xc2, 0x40, 0x87, 0x6b, 0x06, 0xbe,
+	0x93, 0x38, 0x82, 0xab, 0xb8, 0xf8, 0x00, 0xdd, 0xc0, 0x84, 0xe8, 0xe6, 0xe7, 0x1e, 0x59, 0x65,
+	0xcd, 0x6a, 0xe4, 0x70, 0x6e, 0x93, 0x79, 0x80, 0x36, 0x32, 0x67, 0x2a, 0x5a, 0x70, 0xf6, 0x61,
+	0x86, 0xa8, 0x3a, 0x91, 0x10, 0x5e, 0x9b, 0x5d, 0x3a, 0x7a, 0xe7, 0x73, 0x19, 0x99, 0x45, 0x69,
+	0xc7, 0xe3, 0x31, 0xff, 0x88, 0x19, 0xb9, 0x52, 0x79, 0x85, 0xea, 0xf2, 0xaa, 0x6a, 0x86, 0x6a,
+	0x02, 0x62, 0x4c, 0x19, 0x65, 0x91, 0x1e, 0xc8, 0xeb, 0x90, 0xbe, 0x4c, 0x61, 0x3a, 0x68, 0x2f,
+	0x1b, 0x32, 0x60, 0x85, 0xef, 0x55, 0x2d, 0xa8, 0xa1, 0xf1, 0x1e, 0x5b, 0x18, 0xbf, 0x36, 0x5e,
+	0x1b, 0xeb, 0xe3, 0xd5, 0xf5, 0xcf, 0x66, 0xb6, 0x71, 0x3e, 0xb3, 0x8d, 0x8b, 0x99, 0x6d, 0x7c,
+	0x99, 0xdb, 0xa5, 0xf3, 0xb9, 0x5d, 0xfa, 0x35, 0xb7, 0x4b, 0xef, 0x9f, 0xad, 0x48, 0x4c, 0x04,
+	0x0f, 0x27, 0x44, 0x49, 0x42, 0xf5, 0x96, 0x59, 0xee, 0x9b, 0xe9, 0xe5, 0x8d, 0xa3, 0x85, 0xf7,
+	0x37, 0xf5, 0x36, 0x78, 0xf2, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x14, 0xf6, 0x14, 0x24, 0x98, 0x04,
+	0x00, 0x00,
+}
+
+func (this *TransferExemption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransferExemption)
+	if !ok {
+		that2, ok := that.(TransferExemption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ExemptionId != that1.ExemptionId {
+		return false
+	}
+	if len(this.FromAddresses) != len(that1.FromAddresses) {
+		return false
+	}
+	for i := range this.FromAddresses {
+		if this.FromAddresses[i] != that1.FromAddresses[i] {
+			return false
+		}
+	}
+	if len(this.ToAddresses) != len(that1.ToAddresses) {
+		return false
+	}
+	for i := range this.ToAddresses {
+		if this.ToAddresses[i] != that1.ToAddresses[i] {
+			return false
+		}
+	}
+	if len(this.AmountPerEpoch) != len(that1.AmountPerEpoch) {
+		return false
+	}
+	for i := range this.AmountPerEpoch {
+		if !this.AmountPerEpoch[i].Equal(&that1.AmountPerEpoch[i]) {
+			return false
+		}
+	}
+	if this.EpochLengthBlocks != that1.EpochLengthBlocks {
+		return false
+	}
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
+	}
+	if this.Revoked != that1.Revoked {
+		return false
+	}
+	if this.RevokedHeight != that1.RevokedHeight {
+		return false
+	}
+	if this.Justification != that1.Justification {
+		return false
+	}
+	return true
+}
+func (m *TransferExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Justification) > 0 {
+		i -= len(m.Justification)
+		copy(dAtA[i:], m.Justification)
+		i = encodeVarintExemption(dAtA, i, uint64(len(m.Justification)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RevokedHeight != 0 {
+		i = encodeVarintExemption(dAtA, i, uint64(m.RevokedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintExemption(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EpochLengthBlocks != 0 {
+		i = encodeVarintExemption(dAtA, i, uint64(m.EpochLengthBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AmountPerEpoch) > 0 {
+		for iNdEx := len(m.AmountPerEpoch) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountPerEpoch[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExemption(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ToAddresses) > 0 {
+		for iNdEx := len(m.ToAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ToAddresses[iNdEx])
+			copy(dAtA[i:], m.ToAddresses[iNdEx])
+			i = encodeVarintExemption(dAtA, i, uint64(len(m.ToAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FromAddresses) > 0 {
+		for iNdEx := len(m.FromAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FromAddresses[iNdEx])
+			copy(dAtA[i:], m.FromAddresses[iNdEx])
+			i = encodeVarintExemption(dAtA, i, uint64(len(m.FromAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExemptionId) > 0 {
+		i -= len(m.ExemptionId)
+		copy(dAtA[i:], m.ExemptionId)
+		i = encodeVarintExemption(dAtA, i, uint64(len(m.ExemptionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferExemptionUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferExemptionUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferExemptionUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Used) > 0 {
+		for iNdEx := len(m.Used) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Used[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExemption(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintExemption(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintExemption(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExemptionId) > 0 {
+		i -= len(m.ExemptionId)
+		copy(dAtA[i:], m.ExemptionId)
+		i = encodeVarintExemption(dAtA, i, uint64(len(m.ExemptionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExemptionAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExemptionAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExemptionAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintExemption(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EpochEndHeight != 0 {
+		i = encodeVarintExemption(dAtA, i, uint64(m.EpochEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExemption(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintExemption(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ExemptionId) > 0 {
+		i -= len(m.ExemptionId)
+		copy(dAtA[i:], m.ExemptionId)
+		i = encodeVarintExemption(dAtA, i, uint64(len(m.ExemptionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExemption(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExemption(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransferExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExemptionId)
+	if l > 0 {
+		n += 1 + l + sovExemption(uint64(l))
+	}
+	if len(m.FromAddresses) > 0 {
+		for _, s := range m.FromAddresses {
+			l = len(s)
+			n += 1 + l + sovExemption(uint64(l))
+		}
+	}
+	if len(m.ToAddresses) > 0 {
+		for _, s := range m.ToAddresses {
+			l = len(s)
+			n += 1 + l + sovExemption(uint64(l))
+		}
+	}
+	if len(m.AmountPerEpoch) > 0 {
+		for _, e := range m.AmountPerEpoch {
+			l = e.Size()
+			n += 1 + l + sovExemption(uint64(l))
+		}
+	}
+	if m.EpochLengthBlocks != 0 {
+		n += 1 + sovExemption(uint64(m.EpochLengthBlocks))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovExemption(uint64(m.ExpiryHeight))
+	}
+	if m.Revoked {
+		n += 2
+	}
+	if m.RevokedHeight != 0 {
+		n += 1 + sovExemption(uint64(m.RevokedHeight))
+	}
+	l = len(m.Justification)
+	if l > 0 {
+		n += 1 + l + sovExemption(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferExemptionUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExemptionId)
+	if l > 0 {
+		n += 1 + l + sovExemption(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovExemption(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovExemption(uint64(m.Epoch))
+	}
+	if len(m.Used) > 0 {
+		for _, e := range m.Used {
+			l = e.Size()
+			n += 1 + l + sovExemption(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExemptionAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExemptionId)
+	if l > 0 {
+		n += 1 + l + sovExemption(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovExemption(uint64(m.Epoch))
+	}
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovExemption(uint64(l))
+		}
+	}
+	if m.EpochEndHeight != 0 {
+		n += 1 + sovExemption(uint64(m.EpochEndHeight))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovExemption(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func sovExemption(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExemption(x uint64) (n int) {
+	return sovExemption(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TransferExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExemption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddresses = append(m.FromAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddresses = append(m.ToAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountPerEpoch = append(m.AmountPerEpoch, types.Coin{})
+			if err := m.AmountPerEpoch[len(m.AmountPerEpoch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLengthBlocks", wireType)
+			}
+			m.EpochLengthBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLengthBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedHeight", wireType)
+			}
+			m.RevokedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevokedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Justification", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Justification = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferExemptionUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExemption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferExemptionUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferExemptionUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Used = append(m.Used, types.Coin{})
+			if err := m.Used[len(m.Used)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExemptionAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExemption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExemptionAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExemptionAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExemption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExemption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExemption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEndHeight", wireType)
+			}
+			m.EpochEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExemption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExemption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExemption(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExemption
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExemption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExemption
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExemption
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExemption
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExemption        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExemption          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExemption = fmt.Errorf("proto: unexpected end of group")
+)
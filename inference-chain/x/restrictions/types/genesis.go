@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -16,6 +22,24 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	exemptions := make(map[string]bool, len(gs.TransferExemptions))
+	for _, exemption := range gs.TransferExemptions {
+		if exemptions[exemption.ExemptionId] {
+			return fmt.Errorf("duplicated transfer exemption %s", exemption.ExemptionId)
+		}
+		if err := exemption.Validate(); err != nil {
+			return fmt.Errorf("invalid transfer exemption %s: %w", exemption.ExemptionId, err)
+		}
+		exemptions[exemption.ExemptionId] = true
+	}
+	for _, usage := range gs.TransferExemptionUsages {
+		if !exemptions[usage.ExemptionId] {
+			return fmt.Errorf("usage of unknown transfer exemption %s", usage.ExemptionId)
+		}
+		if _, err := sdk.AccAddressFromBech32(usage.AccountAddress); err != nil {
+			return fmt.Errorf("invalid transfer exemption usage account %s: %w", usage.AccountAddress, err)
+		}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
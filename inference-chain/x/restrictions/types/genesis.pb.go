@@ -27,7 +27,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the restrictions module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params                  Params                   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	TransferExemptions      []TransferExemption      `protobuf:"bytes,2,rep,name=transfer_exemptions,json=transferExemptions,proto3" json:"transfer_exemptions"`
+	TransferExemptionUsages []TransferExemptionUsage `protobuf:"bytes,3,rep,name=transfer_exemption_usages,json=transferExemptionUsages,proto3" json:"transfer_exemption_usages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +72,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTransferExemptions() []TransferExemption {
+	if m != nil {
+		return m.TransferExemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferExemptionUsages() []TransferExemptionUsage {
+	if m != nil {
+		return m.TransferExemptionUsages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "inference.restrictions.GenesisState")
 }
@@ -79,21 +95,27 @@ func init() {
 }
 
 var fileDescriptor_6455e94dec0e6cc6 = []byte{
-	// 220 bytes of a gzipped FileDescriptorProto
+	// 308 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0xcc, 0x4b, 0x4b,
 	0x2d, 0x4a, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x4a, 0x2d, 0x2e, 0x29, 0xca, 0x4c, 0x2e, 0xc9, 0xcc,
 	0xcf, 0x2b, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
 	0x17, 0x12, 0x83, 0xab, 0xd2, 0x43, 0x56, 0x25, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f,
 	0x26, 0x21, 0x4a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x2a, 0xaa,
-	0x8c, 0xc3, 0x9a, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x2d, 0x4a, 0x81, 0x5c, 0x3c, 0xee, 0x10,
-	0x6b, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x1c, 0xb9, 0xd8, 0x20, 0xf2, 0x12, 0x8c, 0x0a, 0x8c,
-	0x1a, 0xdc, 0x46, 0x72, 0x7a, 0xd8, 0x9d, 0xa1, 0x17, 0x00, 0x56, 0xe5, 0xc4, 0x79, 0xe2, 0x9e,
-	0x3c, 0xc3, 0x8a, 0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xa0, 0x1a, 0x9d, 0x82, 0x4e, 0x3c, 0x92, 0x63,
-	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
-	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x22, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39,
-	0x3f, 0x57, 0xbf, 0xa0, 0x28, 0x3f, 0xa5, 0x34, 0xb9, 0xa4, 0x38, 0x39, 0x13, 0xec, 0x42, 0x84,
-	0x5b, 0x2b, 0x50, 0x5d, 0x5b, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0xad, 0x31, 0x20,
-	0x00, 0x00, 0xff, 0xff, 0x92, 0x87, 0x1c, 0xd6, 0x3b, 0x01, 0x00, 0x00,
+	0x86, 0xc3, 0x9a, 0xd4, 0x8a, 0xd4, 0xdc, 0x02, 0x10, 0x13, 0xaa, 0x4e, 0x19, 0x87, 0xba, 0x82,
+	0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x6b, 0x94, 0x96, 0x32, 0x71, 0xf1, 0xb8, 0x43, 0xdc, 0x17, 0x5c,
+	0x92, 0x58, 0x92, 0x2a, 0xe4, 0xc8, 0xc5, 0x06, 0x51, 0x20, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d,
+	0x24, 0xa7, 0x87, 0xdd, 0xbd, 0x7a, 0x01, 0x60, 0x55, 0x4e, 0x9c, 0x27, 0xee, 0xc9, 0x33, 0xac,
+	0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xaa, 0x51, 0x28, 0x81, 0x4b, 0xb8, 0xa4, 0x28, 0x31, 0xaf,
+	0x38, 0x2d, 0xb5, 0x28, 0x1e, 0xee, 0xa8, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x4d,
+	0x5c, 0xe6, 0x85, 0x40, 0xb5, 0xb8, 0xc2, 0x74, 0x38, 0xb1, 0x80, 0x8c, 0x0e, 0x12, 0x2a, 0x41,
+	0x97, 0x28, 0x16, 0x2a, 0xe0, 0x92, 0xc4, 0xb4, 0x21, 0xbe, 0xb4, 0x38, 0x31, 0x3d, 0xb5, 0x58,
+	0x82, 0x19, 0x6c, 0x8f, 0x1e, 0xd1, 0xf6, 0x84, 0x82, 0xb4, 0x41, 0x2d, 0x13, 0x2f, 0xc1, 0x2a,
+	0x5b, 0xec, 0x14, 0x74, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
+	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x16, 0xe9,
+	0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x05, 0x45, 0xf9, 0x29, 0xa5, 0xc9,
+	0x25, 0xc5, 0xc9, 0x99, 0xe0, 0x60, 0x47, 0x44, 0x40, 0x05, 0x6a, 0x14, 0x94, 0x54, 0x16, 0xa4,
+	0x16, 0x27, 0xb1, 0x81, 0xa3, 0xc0, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x35, 0x01, 0x19, 0x65,
+	0x38, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -116,6 +138,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TransferExemptionUsages) > 0 {
+		for iNdEx := len(m.TransferExemptionUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferExemptionUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TransferExemptions) > 0 {
+		for iNdEx := len(m.TransferExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -148,6 +198,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TransferExemptions) > 0 {
+		for _, e := range m.TransferExemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferExemptionUsages) > 0 {
+		for _, e := range m.TransferExemptionUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -219,6 +281,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferExemptions = append(m.TransferExemptions, TransferExemption{})
+			if err := m.TransferExemptions[len(m.TransferExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferExemptionUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferExemptionUsages = append(m.TransferExemptionUsages, TransferExemptionUsage{})
+			if err := m.TransferExemptionUsages[len(m.TransferExemptionUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,10 +3,23 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/testutil/sample"
 	"github.com/productscience/inference/x/restrictions/types"
 	"github.com/stretchr/testify/require"
 )
 
+func transferExemption(id string) types.TransferExemption {
+	return types.TransferExemption{
+		ExemptionId:       id,
+		FromAddresses:     []string{sample.AccAddress()},
+		ToAddresses:       []string{"*"},
+		AmountPerEpoch:    sdk.NewCoins(sdk.NewInt64Coin("ngonka", 10)),
+		EpochLengthBlocks: 10,
+		ExpiryHeight:      100,
+	}
+}
+
 func TestGenesisState_Validate(t *testing.T) {
 	tests := []struct {
 		desc     string
@@ -26,6 +39,32 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated transfer exemption",
+			genState: &types.GenesisState{
+				Params:             types.DefaultParams(),
+				TransferExemptions: []types.TransferExemption{transferExemption("a"), transferExemption("a")},
+			},
+			valid: false,
+		},
+		{
+			desc: "usage of unknown transfer exemption",
+			genState: &types.GenesisState{
+				Params:                  types.DefaultParams(),
+				TransferExemptions:      []types.TransferExemption{transferExemption("a")},
+				TransferExemptionUsages: []types.TransferExemptionUsage{{ExemptionId: "b", AccountAddress: sample.AccAddress()}},
+			},
+			valid: false,
+		},
+		{
+			desc: "transfer exemption with usage",
+			genState: &types.GenesisState{
+				Params:                  types.DefaultParams(),
+				TransferExemptions:      []types.TransferExemption{transferExemption("a")},
+				TransferExemptionUsages: []types.TransferExemptionUsage{{ExemptionId: "a", AccountAddress: sample.AccAddress()}},
+			},
+			valid: true,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
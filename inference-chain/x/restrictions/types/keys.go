@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "restrictions"
@@ -14,12 +16,18 @@ const (
 var (
 	ParamsKey                  = []byte("p_restrictions")
 	KeyRestrictionUnregistered = []byte("restriction_unregistered")
+
+	TransferExemptionsPrefix      = collections.NewPrefix(1)
+	TransferExemptionUsagesPrefix = collections.NewPrefix(2)
 )
 
 // Event types and attribute keys
 const (
 	EventTypeEmergencyTransfer = "emergency_transfer"
 	EventTypeRestrictionLifted = "restriction_lifted"
+	EventTypeExemptionAdded    = "transfer_exemption_added"
+	EventTypeExemptionRevoked  = "transfer_exemption_revoked"
+	EventTypeExemptionUsed     = "transfer_exemption_used"
 
 	AttributeKeyExemptionId         = "exemption_id"
 	AttributeKeyFromAddress         = "from_address"
@@ -29,6 +37,8 @@ const (
 	AttributeKeyRemainingUses       = "remaining_uses"
 	AttributeKeyCurrentBlock        = "current_block"
 	AttributeKeyRestrictionEndBlock = "restriction_end_block"
+	AttributeKeyExpiryHeight        = "expiry_height"
+	AttributeKeyRemaining           = "remaining"
 )
 
 func KeyPrefix(p string) []byte {
@@ -0,0 +1,37 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgAddTransferExemption{}
+	_ sdk.Msg = &MsgRevokeTransferExemption{}
+)
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAddTransferExemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if m.Exemption.Revoked {
+		return errorsmod.Wrap(ErrInvalidExemption, "exemption cannot be added revoked")
+	}
+	if err := m.Exemption.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidExemption, err.Error())
+	}
+	return nil
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRevokeTransferExemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if m.ExemptionId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "exemption ID cannot be empty")
+	}
+	return nil
+}
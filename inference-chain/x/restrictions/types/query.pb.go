@@ -116,6 +116,8 @@ func (m *QueryParamsResponse) GetParams() Params {
 
 // QueryTransferRestrictionStatusRequest is request type for the Query/TransferRestrictionStatus RPC method.
 type QueryTransferRestrictionStatusRequest struct {
+	// account_address, if set, adds the account's remaining exemption allowances to the response
+	AccountAddress string `protobuf:"bytes,1,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
 }
 
 func (m *QueryTransferRestrictionStatusRequest) Reset()         { *m = QueryTransferRestrictionStatusRequest{} }
@@ -151,6 +153,13 @@ func (m *QueryTransferRestrictionStatusRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTransferRestrictionStatusRequest proto.InternalMessageInfo
 
+func (m *QueryTransferRestrictionStatusRequest) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
 // QueryTransferRestrictionStatusResponse is response type for the Query/TransferRestrictionStatus RPC method.
 type QueryTransferRestrictionStatusResponse struct {
 	// is_active indicates if transfer restrictions are currently active
@@ -161,6 +170,8 @@ type QueryTransferRestrictionStatusResponse struct {
 	CurrentBlockHeight uint64 `protobuf:"varint,3,opt,name=current_block_height,json=currentBlockHeight,proto3" json:"current_block_height,omitempty"`
 	// remaining_blocks is the number of blocks until restrictions end (0 if inactive)
 	RemainingBlocks uint64 `protobuf:"varint,4,opt,name=remaining_blocks,json=remainingBlocks,proto3" json:"remaining_blocks,omitempty"`
+	// allowances are the remaining allowances of account_address under the transfer exemptions that apply to it
+	Allowances []ExemptionAllowance `protobuf:"bytes,5,rep,name=allowances,proto3" json:"allowances"`
 }
 
 func (m *QueryTransferRestrictionStatusResponse) Reset() {
@@ -226,6 +237,13 @@ func (m *QueryTransferRestrictionStatusResponse) GetRemainingBlocks() uint64 {
 	return 0
 }
 
+func (m *QueryTransferRestrictionStatusResponse) GetAllowances() []ExemptionAllowance {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
 // QueryTransferExemptionsRequest is request type for the Query/TransferExemptions RPC method.
 type QueryTransferExemptionsRequest struct {
 	// include_expired indicates whether to include expired exemptions in the response
@@ -471,55 +489,57 @@ func init() {
 }
 
 var fileDescriptor_c0b6008a49286951 = []byte{
-	// 762 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x4f, 0x14, 0x4b,
-	0x14, 0x9d, 0xe2, 0x2b, 0x4c, 0x0d, 0x0f, 0xde, 0x2b, 0x78, 0x2f, 0xf3, 0x86, 0x97, 0x86, 0x37,
-	0x46, 0x40, 0x8c, 0xdd, 0x32, 0x44, 0xc4, 0x85, 0x24, 0x4c, 0x32, 0x7e, 0xec, 0xa0, 0xd5, 0x84,
-	0xb8, 0xe9, 0xd4, 0x74, 0x17, 0x4d, 0x45, 0xa6, 0xaa, 0xa9, 0xaa, 0x26, 0xb0, 0xf5, 0x17, 0x68,
-	0xdc, 0xf8, 0x13, 0x5c, 0xb2, 0x65, 0xe7, 0xc2, 0x05, 0x4b, 0x8c, 0x89, 0x71, 0x65, 0x0c, 0x98,
-	0xf8, 0x0b, 0xdc, 0x9b, 0xa9, 0x2a, 0x87, 0x19, 0x67, 0x86, 0xaf, 0xb8, 0x21, 0xcd, 0xad, 0x73,
-	0x4f, 0x9d, 0x73, 0xb8, 0x75, 0x81, 0x45, 0xca, 0xd6, 0x89, 0x20, 0x2c, 0x24, 0x9e, 0x20, 0x52,
-	0x09, 0x1a, 0x2a, 0xca, 0x99, 0xf4, 0xb6, 0x52, 0x22, 0x76, 0xdd, 0x44, 0x70, 0xc5, 0xd1, 0x3f,
-	0x0d, 0x8c, 0xdb, 0x8c, 0x29, 0xfc, 0x85, 0x6b, 0x94, 0x71, 0x4f, 0xff, 0x34, 0xd0, 0xc2, 0x58,
-	0xcc, 0x63, 0xae, 0x3f, 0xbd, 0xfa, 0x97, 0xad, 0xfe, 0x17, 0x73, 0x1e, 0x6f, 0x12, 0x0f, 0x27,
-	0xd4, 0xc3, 0x8c, 0x71, 0x85, 0x35, 0x81, 0x3d, 0x9d, 0x0d, 0xb9, 0xac, 0x71, 0xe9, 0x55, 0xb1,
-	0x24, 0xe6, 0x5e, 0x6f, 0x7b, 0xae, 0x4a, 0x14, 0x9e, 0xf3, 0x12, 0x1c, 0x53, 0xa6, 0xc1, 0x16,
-	0x7b, 0xa5, 0x8b, 0xdc, 0x04, 0x0b, 0x5c, 0xb3, 0x84, 0xc5, 0x31, 0x88, 0x56, 0xeb, 0x34, 0x2b,
-	0xba, 0xe8, 0x93, 0xad, 0x94, 0x48, 0x55, 0x5c, 0x83, 0xa3, 0x2d, 0x55, 0x99, 0x70, 0x26, 0x09,
-	0x5a, 0x86, 0x03, 0xa6, 0x39, 0x0f, 0x26, 0xc1, 0x4c, 0xae, 0xe4, 0xb8, 0x9d, 0xdd, 0xba, 0xa6,
-	0xaf, 0x9c, 0x3d, 0xf8, 0x3c, 0x91, 0x79, 0xf3, 0x6d, 0x6f, 0x16, 0xf8, 0xb6, 0xb1, 0x38, 0x0d,
-	0xaf, 0x6a, 0xe6, 0xc7, 0x02, 0x33, 0xb9, 0x4e, 0x84, 0x7f, 0xd2, 0xf6, 0x48, 0x61, 0x95, 0x36,
-	0x24, 0x7c, 0x04, 0x70, 0xea, 0x2c, 0xa4, 0x95, 0x35, 0x0e, 0xb3, 0x54, 0x06, 0x38, 0x54, 0x74,
-	0x9b, 0x68, 0x65, 0x83, 0xfe, 0x20, 0x95, 0xcb, 0xfa, 0x77, 0x54, 0x82, 0x7f, 0x37, 0x49, 0x0b,
-	0x08, 0x8b, 0x82, 0xea, 0x26, 0x0f, 0x9f, 0xe5, 0x7b, 0x26, 0xc1, 0x4c, 0x9f, 0x3f, 0xda, 0x74,
-	0x58, 0x61, 0x51, 0xb9, 0x7e, 0x84, 0x6e, 0xc2, 0xb1, 0x30, 0x15, 0x82, 0x30, 0x65, 0xb0, 0xc1,
-	0x06, 0xa1, 0xf1, 0x86, 0xca, 0xf7, 0xea, 0x16, 0x64, 0xcf, 0x34, 0xf6, 0x81, 0x3e, 0x41, 0xd7,
-	0xe0, 0x9f, 0x82, 0xd4, 0x30, 0x65, 0x94, 0xc5, 0xa6, 0x47, 0xe6, 0xfb, 0x34, 0x7a, 0xa4, 0x51,
-	0xd7, 0x78, 0x59, 0x7c, 0x09, 0xa0, 0xd3, 0x62, 0xac, 0xb2, 0x43, 0x6a, 0x89, 0xce, 0xcd, 0x7a,
-	0x47, 0xd3, 0x70, 0x84, 0xb2, 0x70, 0x33, 0x8d, 0x48, 0x40, 0x76, 0x12, 0x2a, 0x48, 0x64, 0x6d,
-	0x0d, 0xdb, 0x72, 0xc5, 0x54, 0xd1, 0x3d, 0x08, 0x4f, 0xfe, 0xec, 0xda, 0x51, 0xae, 0x34, 0xe5,
-	0x9a, 0x19, 0x71, 0xeb, 0x33, 0xe2, 0x9a, 0xd9, 0xb4, 0x33, 0xe2, 0xae, 0xe0, 0x98, 0xd8, 0x4b,
-	0xfc, 0xa6, 0xce, 0xe2, 0x3b, 0x00, 0x27, 0xba, 0x6a, 0xb2, 0x29, 0xaf, 0x41, 0x48, 0x1a, 0xd5,
-	0x3c, 0x98, 0xec, 0x9d, 0xc9, 0x95, 0x4a, 0xdd, 0x06, 0xa0, 0x52, 0x23, 0x22, 0x26, 0x2c, 0x6c,
-	0x27, 0x2c, 0xf7, 0xd5, 0x87, 0xc2, 0x6f, 0xe2, 0x42, 0xf7, 0x3b, 0xb8, 0x98, 0x3e, 0xd3, 0x85,
-	0x91, 0xd5, 0x62, 0x63, 0x0f, 0xc0, 0x82, 0xb6, 0xd1, 0xb8, 0xed, 0x89, 0x3c, 0x71, 0x8c, 0xfe,
-	0x87, 0x43, 0x8d, 0x5b, 0x03, 0x6a, 0x32, 0xcd, 0xfa, 0xb9, 0x46, 0xed, 0x61, 0x54, 0x4f, 0x1e,
-	0x87, 0x21, 0x4f, 0x99, 0x0a, 0x70, 0x14, 0x09, 0x22, 0xa5, 0xd6, 0x93, 0xf5, 0x87, 0x6d, 0x79,
-	0xd9, 0x54, 0x7f, 0x49, 0xbe, 0xf7, 0xd2, 0xc9, 0xef, 0x03, 0x38, 0xde, 0x51, 0xb2, 0x4d, 0x7d,
-	0x15, 0xfe, 0x91, 0xd6, 0x0b, 0x01, 0x61, 0x4a, 0x50, 0xf2, 0x33, 0xf8, 0xa9, 0xae, 0xc1, 0xb7,
-	0xd0, 0xd8, 0xb0, 0x87, 0x34, 0x45, 0xc5, 0x30, 0xfc, 0xb6, 0xb8, 0x4b, 0xdf, 0xfb, 0x61, 0xbf,
-	0xd6, 0x8e, 0x5e, 0x03, 0x38, 0x60, 0xde, 0x3c, 0x9a, 0xed, 0xa6, 0xac, 0x7d, 0xcd, 0x14, 0xae,
-	0x9f, 0x0b, 0x6b, 0x6e, 0x2e, 0xde, 0x7a, 0xfe, 0xe1, 0xeb, 0xab, 0x1e, 0x0f, 0xdd, 0xf0, 0x12,
-	0xc1, 0xa3, 0x34, 0x54, 0x32, 0xa4, 0x7a, 0xb9, 0x9d, 0xba, 0xe6, 0xd0, 0x7b, 0x00, 0xff, 0xed,
-	0xba, 0x42, 0xd0, 0xdd, 0x53, 0x15, 0x9c, 0xb5, 0xa4, 0x0a, 0x4b, 0x97, 0x6d, 0xbf, 0xa4, 0x27,
-	0x69, 0x54, 0xbf, 0x05, 0x10, 0xb5, 0xbf, 0x54, 0xb4, 0x70, 0x2e, 0x35, 0x6d, 0xeb, 0xa6, 0x70,
-	0xfb, 0xc2, 0x7d, 0x56, 0xfe, 0x1d, 0x2d, 0x7f, 0x1e, 0xcd, 0x9d, 0x53, 0x7e, 0xd3, 0x9b, 0xdf,
-	0x07, 0x70, 0xb8, 0x75, 0x56, 0x51, 0xe9, 0x54, 0x19, 0x1d, 0x9f, 0x74, 0x61, 0xfe, 0x42, 0x3d,
-	0x56, 0xf6, 0x92, 0x96, 0xbd, 0x88, 0x16, 0x2e, 0x2a, 0x3b, 0xd0, 0xef, 0xa8, 0xec, 0x1f, 0x1c,
-	0x39, 0xe0, 0xf0, 0xc8, 0x01, 0x5f, 0x8e, 0x1c, 0xf0, 0xe2, 0xd8, 0xc9, 0x1c, 0x1e, 0x3b, 0x99,
-	0x4f, 0xc7, 0x4e, 0xe6, 0xe9, 0x62, 0x4c, 0xd5, 0x46, 0x5a, 0x75, 0x43, 0x5e, 0xeb, 0xce, 0xbd,
-	0xd3, 0xca, 0xae, 0x76, 0x13, 0x22, 0xab, 0x03, 0xfa, 0xdf, 0xf1, 0xfc, 0x8f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x72, 0xd5, 0x81, 0x72, 0x64, 0x08, 0x00, 0x00,
+	// 800 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0xeb, 0x44,
+	0x14, 0x8d, 0xd3, 0x0f, 0xbd, 0x4e, 0x1e, 0x7d, 0x30, 0xaf, 0xa0, 0x90, 0x87, 0xdc, 0x62, 0x44,
+	0x5a, 0x8a, 0xb0, 0x69, 0x2a, 0x4a, 0x59, 0x50, 0x29, 0x91, 0xc2, 0xc7, 0x2e, 0x35, 0x20, 0x55,
+	0x6c, 0xac, 0x89, 0x3d, 0x75, 0x47, 0x24, 0x33, 0xee, 0xcc, 0xb8, 0xb4, 0x5b, 0x7e, 0x01, 0x88,
+	0x0d, 0x3f, 0xa1, 0xcb, 0x6e, 0xbb, 0x63, 0xc1, 0xa2, 0xcb, 0x22, 0x36, 0xac, 0x10, 0x6a, 0x91,
+	0xf8, 0x05, 0xec, 0x91, 0x67, 0x26, 0x89, 0x43, 0xe2, 0xa6, 0xad, 0xd8, 0x44, 0xce, 0x9d, 0x7b,
+	0xce, 0x3d, 0xf7, 0xf8, 0xce, 0x95, 0x81, 0x43, 0xe8, 0x21, 0xe6, 0x98, 0x86, 0xd8, 0xe3, 0x58,
+	0x48, 0x4e, 0x42, 0x49, 0x18, 0x15, 0xde, 0x71, 0x8a, 0xf9, 0x99, 0x9b, 0x70, 0x26, 0x19, 0x7c,
+	0x6d, 0x98, 0xe3, 0xe6, 0x73, 0x6a, 0xaf, 0xa0, 0x3e, 0xa1, 0xcc, 0x53, 0xbf, 0x3a, 0xb5, 0xb6,
+	0x12, 0xb3, 0x98, 0xa9, 0x47, 0x2f, 0x7b, 0x32, 0xd1, 0x37, 0x62, 0xc6, 0xe2, 0x1e, 0xf6, 0x50,
+	0x42, 0x3c, 0x44, 0x29, 0x93, 0x48, 0x11, 0x98, 0xd3, 0xcd, 0x90, 0x89, 0x3e, 0x13, 0x5e, 0x17,
+	0x09, 0xac, 0xeb, 0x7a, 0x27, 0x5b, 0x5d, 0x2c, 0xd1, 0x96, 0x97, 0xa0, 0x98, 0x50, 0x95, 0x6c,
+	0x72, 0xeb, 0x05, 0x72, 0xf1, 0x29, 0xee, 0x27, 0xb9, 0xbc, 0xb7, 0x0a, 0xf2, 0x12, 0xc4, 0x51,
+	0xdf, 0x14, 0x76, 0x56, 0x00, 0xdc, 0xcf, 0xca, 0x75, 0x54, 0xd0, 0xc7, 0xc7, 0x29, 0x16, 0xd2,
+	0x39, 0x00, 0xcf, 0xc7, 0xa2, 0x22, 0x61, 0x54, 0x60, 0xd8, 0x04, 0x8b, 0x1a, 0x5c, 0xb5, 0xd6,
+	0xac, 0x8d, 0x4a, 0xc3, 0x76, 0xa7, 0xbb, 0xe2, 0x6a, 0x5c, 0x6b, 0xe9, 0xea, 0x8f, 0xd5, 0xd2,
+	0xf9, 0xdf, 0x17, 0x9b, 0x96, 0x6f, 0x80, 0x4e, 0x07, 0xbc, 0xad, 0x98, 0xbf, 0xe4, 0x88, 0x8a,
+	0x43, 0xcc, 0xfd, 0x11, 0xec, 0x0b, 0x89, 0x64, 0x3a, 0x90, 0x00, 0xd7, 0xc1, 0x33, 0x14, 0x86,
+	0x2c, 0xa5, 0x32, 0x40, 0x51, 0xc4, 0xb1, 0xd0, 0x45, 0x97, 0xfc, 0x65, 0x13, 0x6e, 0xea, 0xa8,
+	0x73, 0x5e, 0x06, 0xf5, 0x59, 0x94, 0x46, 0xff, 0x0b, 0xb0, 0x44, 0x44, 0x80, 0x42, 0x49, 0x4e,
+	0xb0, 0x62, 0x7b, 0xe2, 0x3f, 0x21, 0xa2, 0xa9, 0xfe, 0xc3, 0x06, 0x78, 0x35, 0xd7, 0x43, 0x80,
+	0x69, 0x14, 0x74, 0x7b, 0x2c, 0xfc, 0xa6, 0x5a, 0x5e, 0xb3, 0x36, 0xe6, 0xfd, 0xe7, 0xb9, 0xc3,
+	0x36, 0x8d, 0x5a, 0xd9, 0x11, 0x7c, 0x1f, 0xac, 0x84, 0x29, 0xe7, 0x98, 0x4a, 0x9d, 0x1b, 0x1c,
+	0x61, 0x12, 0x1f, 0xc9, 0xea, 0x9c, 0x82, 0x40, 0x73, 0xa6, 0x72, 0x3f, 0x53, 0x27, 0xf0, 0x1d,
+	0xf0, 0x32, 0xc7, 0x7d, 0x44, 0x28, 0xa1, 0xb1, 0xc6, 0x88, 0xea, 0xbc, 0xca, 0x7e, 0x36, 0x8c,
+	0xab, 0x7c, 0x01, 0x3b, 0x00, 0xa0, 0x5e, 0x8f, 0x7d, 0x8b, 0x68, 0x88, 0x45, 0x75, 0x61, 0x6d,
+	0x6e, 0xa3, 0xd2, 0xd8, 0x2c, 0x72, 0xbc, 0x3d, 0x78, 0xf9, 0xcd, 0x01, 0xa4, 0x35, 0x9f, 0xb9,
+	0xef, 0xe7, 0x38, 0x9c, 0x1f, 0x2c, 0x60, 0x8f, 0x59, 0x35, 0x44, 0xe5, 0x6d, 0x27, 0x34, 0xec,
+	0xa5, 0x11, 0x0e, 0xf0, 0x69, 0x42, 0x38, 0x8e, 0x8c, 0x51, 0xcb, 0x26, 0xdc, 0xd6, 0x51, 0xf8,
+	0x09, 0x00, 0xa3, 0xc9, 0x54, 0x1e, 0x55, 0x1a, 0x75, 0x57, 0x8f, 0xb1, 0x9b, 0x8d, 0xb1, 0xab,
+	0xaf, 0x8f, 0x19, 0x63, 0xb7, 0x83, 0x62, 0x6c, 0x8a, 0xf8, 0x39, 0xa4, 0xf3, 0x8b, 0x05, 0x56,
+	0x0b, 0x35, 0x99, 0xf7, 0x76, 0x00, 0xc0, 0x70, 0xb8, 0xb3, 0x31, 0xc8, 0x9c, 0x68, 0x14, 0x3a,
+	0xd1, 0xc7, 0x3c, 0xc6, 0x34, 0x9c, 0x24, 0x1c, 0x38, 0x32, 0xe2, 0x82, 0x9f, 0x4e, 0xe9, 0x62,
+	0x7d, 0x66, 0x17, 0x5a, 0xd6, 0x58, 0x1b, 0x17, 0x16, 0xa8, 0xa9, 0x36, 0x86, 0xd5, 0xbe, 0x12,
+	0xa3, 0x8e, 0xe1, 0x9b, 0xe0, 0xe9, 0xb0, 0x6a, 0x40, 0x22, 0x33, 0xca, 0x95, 0x61, 0xec, 0xf3,
+	0x68, 0xda, 0xc0, 0x97, 0xa7, 0x0d, 0xfc, 0x7f, 0x9c, 0x9f, 0x7b, 0xb4, 0xf3, 0x97, 0x16, 0x78,
+	0x31, 0x55, 0xb2, 0x71, 0x7d, 0x1f, 0xbc, 0x94, 0x66, 0x81, 0x00, 0x53, 0xc9, 0x09, 0x1e, 0x18,
+	0x5f, 0x9f, 0x39, 0x82, 0x8a, 0xc6, 0x98, 0xfd, 0x54, 0x51, 0xb4, 0x35, 0xc3, 0xff, 0x66, 0x77,
+	0xe3, 0x9f, 0x05, 0xb0, 0xa0, 0xb4, 0xc3, 0x9f, 0x2c, 0xb0, 0xa8, 0xd7, 0x0d, 0x2c, 0xbc, 0x1c,
+	0x93, 0x1b, 0xae, 0xf6, 0xee, 0xbd, 0x72, 0x75, 0x65, 0xe7, 0x83, 0xef, 0x7e, 0xfb, 0xeb, 0xc7,
+	0xb2, 0x07, 0xdf, 0xf3, 0x12, 0xce, 0xa2, 0x34, 0x94, 0x22, 0x24, 0x6a, 0xaf, 0xde, 0xb9, 0x61,
+	0xe1, 0xaf, 0x16, 0x78, 0xbd, 0x70, 0x29, 0xc1, 0x8f, 0xef, 0x54, 0x30, 0x6b, 0x3f, 0xd6, 0xf6,
+	0x1e, 0x0b, 0x7f, 0x64, 0x4f, 0x42, 0xab, 0xfe, 0xd9, 0x02, 0x70, 0xf2, 0xa6, 0xc2, 0x9d, 0x7b,
+	0xa9, 0x99, 0x58, 0x37, 0xb5, 0x0f, 0x1f, 0x8c, 0x33, 0xf2, 0x3f, 0x52, 0xf2, 0xb7, 0xe1, 0xd6,
+	0x3d, 0xe5, 0xe7, 0xee, 0xfc, 0xa5, 0x05, 0x96, 0xc7, 0x67, 0x15, 0x36, 0xee, 0x94, 0x31, 0xf5,
+	0x4a, 0xd7, 0xb6, 0x1f, 0x84, 0x31, 0xb2, 0xf7, 0x94, 0xec, 0x5d, 0xb8, 0xf3, 0x50, 0xd9, 0x81,
+	0xba, 0x47, 0x2d, 0xff, 0xea, 0xc6, 0xb6, 0xae, 0x6f, 0x6c, 0xeb, 0xcf, 0x1b, 0xdb, 0xfa, 0xfe,
+	0xd6, 0x2e, 0x5d, 0xdf, 0xda, 0xa5, 0xdf, 0x6f, 0xed, 0xd2, 0xd7, 0xbb, 0x31, 0x91, 0x47, 0x69,
+	0xd7, 0x0d, 0x59, 0xbf, 0x98, 0xfb, 0x74, 0x9c, 0x5d, 0x9e, 0x25, 0x58, 0x74, 0x17, 0xd5, 0x97,
+	0xc0, 0xf6, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x36, 0x4a, 0x28, 0x39, 0x07, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -795,6 +815,13 @@ func (m *QueryTransferRestrictionStatusRequest) MarshalToSizedBuffer(dAtA []byte
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -818,6 +845,20 @@ func (m *QueryTransferRestrictionStatusResponse) MarshalToSizedBuffer(dAtA []byt
 	_ = i
 	var l int
 	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.RemainingBlocks != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingBlocks))
 		i--
@@ -1075,6 +1116,10 @@ func (m *QueryTransferRestrictionStatusRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1096,6 +1141,12 @@ func (m *QueryTransferRestrictionStatusResponse) Size() (n int) {
 	if m.RemainingBlocks != 0 {
 		n += 1 + sovQuery(uint64(m.RemainingBlocks))
 	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1342,6 +1393,38 @@ func (m *QueryTransferRestrictionStatusRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryTransferRestrictionStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1469,6 +1552,40 @@ func (m *QueryTransferRestrictionStatusResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, ExemptionAllowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
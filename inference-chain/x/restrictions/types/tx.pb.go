@@ -254,51 +254,243 @@ func (m *MsgExecuteEmergencyTransferResponse) GetRemainingUses() uint64 {
 	return 0
 }
 
+// MsgAddTransferExemption adds an address-scoped transfer exemption
+type MsgAddTransferExemption struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string            `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Exemption TransferExemption `protobuf:"bytes,2,opt,name=exemption,proto3" json:"exemption"`
+}
+
+func (m *MsgAddTransferExemption) Reset()         { *m = MsgAddTransferExemption{} }
+func (m *MsgAddTransferExemption) String() string { return proto.CompactTextString(m) }
+func (*MsgAddTransferExemption) ProtoMessage()    {}
+func (*MsgAddTransferExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec550e7ed935bdf, []int{4}
+}
+func (m *MsgAddTransferExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddTransferExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddTransferExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddTransferExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddTransferExemption.Merge(m, src)
+}
+func (m *MsgAddTransferExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddTransferExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddTransferExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddTransferExemption proto.InternalMessageInfo
+
+func (m *MsgAddTransferExemption) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddTransferExemption) GetExemption() TransferExemption {
+	if m != nil {
+		return m.Exemption
+	}
+	return TransferExemption{}
+}
+
+type MsgAddTransferExemptionResponse struct {
+}
+
+func (m *MsgAddTransferExemptionResponse) Reset()         { *m = MsgAddTransferExemptionResponse{} }
+func (m *MsgAddTransferExemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddTransferExemptionResponse) ProtoMessage()    {}
+func (*MsgAddTransferExemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec550e7ed935bdf, []int{5}
+}
+func (m *MsgAddTransferExemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddTransferExemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddTransferExemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddTransferExemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddTransferExemptionResponse.Merge(m, src)
+}
+func (m *MsgAddTransferExemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddTransferExemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddTransferExemptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddTransferExemptionResponse proto.InternalMessageInfo
+
+// MsgRevokeTransferExemption revokes a transfer exemption before its expiry
+type MsgRevokeTransferExemption struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ExemptionId string `protobuf:"bytes,2,opt,name=exemption_id,json=exemptionId,proto3" json:"exemption_id,omitempty"`
+}
+
+func (m *MsgRevokeTransferExemption) Reset()         { *m = MsgRevokeTransferExemption{} }
+func (m *MsgRevokeTransferExemption) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeTransferExemption) ProtoMessage()    {}
+func (*MsgRevokeTransferExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec550e7ed935bdf, []int{6}
+}
+func (m *MsgRevokeTransferExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeTransferExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeTransferExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeTransferExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeTransferExemption.Merge(m, src)
+}
+func (m *MsgRevokeTransferExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeTransferExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeTransferExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeTransferExemption proto.InternalMessageInfo
+
+func (m *MsgRevokeTransferExemption) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRevokeTransferExemption) GetExemptionId() string {
+	if m != nil {
+		return m.ExemptionId
+	}
+	return ""
+}
+
+type MsgRevokeTransferExemptionResponse struct {
+}
+
+func (m *MsgRevokeTransferExemptionResponse) Reset()         { *m = MsgRevokeTransferExemptionResponse{} }
+func (m *MsgRevokeTransferExemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeTransferExemptionResponse) ProtoMessage()    {}
+func (*MsgRevokeTransferExemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec550e7ed935bdf, []int{7}
+}
+func (m *MsgRevokeTransferExemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeTransferExemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeTransferExemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeTransferExemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeTransferExemptionResponse.Merge(m, src)
+}
+func (m *MsgRevokeTransferExemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeTransferExemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeTransferExemptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeTransferExemptionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "inference.restrictions.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "inference.restrictions.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgExecuteEmergencyTransfer)(nil), "inference.restrictions.MsgExecuteEmergencyTransfer")
 	proto.RegisterType((*MsgExecuteEmergencyTransferResponse)(nil), "inference.restrictions.MsgExecuteEmergencyTransferResponse")
+	proto.RegisterType((*MsgAddTransferExemption)(nil), "inference.restrictions.MsgAddTransferExemption")
+	proto.RegisterType((*MsgAddTransferExemptionResponse)(nil), "inference.restrictions.MsgAddTransferExemptionResponse")
+	proto.RegisterType((*MsgRevokeTransferExemption)(nil), "inference.restrictions.MsgRevokeTransferExemption")
+	proto.RegisterType((*MsgRevokeTransferExemptionResponse)(nil), "inference.restrictions.MsgRevokeTransferExemptionResponse")
 }
 
 func init() { proto.RegisterFile("inference/restrictions/tx.proto", fileDescriptor_5ec550e7ed935bdf) }
 
 var fileDescriptor_5ec550e7ed935bdf = []byte{
-	// 532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x4d, 0x8b, 0xd3, 0x40,
-	0x18, 0x6e, 0xba, 0xdb, 0x42, 0xa7, 0x55, 0x31, 0x94, 0xdd, 0x6c, 0x85, 0xec, 0xda, 0x45, 0x2c,
-	0x05, 0x13, 0xdc, 0x15, 0x95, 0xed, 0x69, 0x0b, 0x7b, 0x50, 0x2c, 0x48, 0x74, 0x2f, 0x5e, 0x4a,
-	0x36, 0x79, 0x3b, 0x9d, 0xc3, 0xcc, 0x84, 0x99, 0x89, 0xb4, 0x37, 0x11, 0xbc, 0x88, 0x07, 0x7f,
-	0x86, 0xc7, 0x1e, 0xfc, 0x11, 0xeb, 0x6d, 0xf1, 0xe4, 0x49, 0xa4, 0x3d, 0xf4, 0x6f, 0x48, 0x3e,
-	0x9a, 0xda, 0x62, 0xaa, 0xec, 0x25, 0xc9, 0xfb, 0xbc, 0x1f, 0xf3, 0x3c, 0xcf, 0x3b, 0x41, 0xfb,
-	0x84, 0x0d, 0x40, 0x00, 0xf3, 0xc0, 0x16, 0x20, 0x95, 0x20, 0x9e, 0x22, 0x9c, 0x49, 0x5b, 0x8d,
-	0xac, 0x40, 0x70, 0xc5, 0xf5, 0x9d, 0xac, 0xc0, 0xfa, 0xb3, 0xa0, 0x71, 0xdb, 0xa5, 0x84, 0x71,
-	0x3b, 0x7e, 0x26, 0xa5, 0x8d, 0x5d, 0x8f, 0x4b, 0xca, 0xa5, 0x4d, 0x25, 0xb6, 0xdf, 0x3e, 0x8c,
-	0x5e, 0x69, 0x62, 0x2f, 0x49, 0xf4, 0xe3, 0xc8, 0x4e, 0x82, 0x34, 0x55, 0xc7, 0x1c, 0xf3, 0x04,
-	0x8f, 0xbe, 0x52, 0xf4, 0x30, 0x87, 0x55, 0xe0, 0x0a, 0x97, 0xa6, 0xad, 0xcd, 0x6f, 0x1a, 0xba,
-	0xd5, 0x93, 0xf8, 0x3c, 0xf0, 0x5d, 0x05, 0x2f, 0xe3, 0x8c, 0xfe, 0x18, 0x55, 0xdc, 0x50, 0x0d,
-	0xb9, 0x20, 0x6a, 0x6c, 0x68, 0x07, 0x5a, 0xab, 0xd2, 0x35, 0xbe, 0x7f, 0x7d, 0x50, 0x4f, 0xcf,
-	0x3c, 0xf5, 0x7d, 0x01, 0x52, 0xbe, 0x52, 0x82, 0x30, 0xec, 0x2c, 0x4b, 0xf5, 0x53, 0x54, 0x4e,
-	0x66, 0x1b, 0xc5, 0x03, 0xad, 0x55, 0x3d, 0x32, 0xad, 0xbf, 0xcb, 0xb6, 0x92, 0x73, 0xba, 0x95,
-	0xcb, 0x9f, 0xfb, 0x85, 0x2f, 0xf3, 0x49, 0x5b, 0x73, 0xd2, 0xc6, 0x93, 0xce, 0xfb, 0xf9, 0xa4,
-	0xbd, 0x1c, 0xf9, 0x71, 0x3e, 0x69, 0xb7, 0x96, 0x32, 0x46, 0xab, 0x42, 0xd6, 0x78, 0x37, 0xf7,
-	0xd0, 0xee, 0x1a, 0xe4, 0x80, 0x0c, 0x38, 0x93, 0xd0, 0x9c, 0x14, 0xd1, 0x9d, 0x9e, 0xc4, 0x67,
-	0x23, 0xf0, 0x42, 0x05, 0x67, 0x14, 0x04, 0x06, 0xe6, 0x8d, 0x5f, 0x0b, 0x97, 0xc9, 0x01, 0x08,
-	0xfd, 0x2e, 0xaa, 0xc1, 0x08, 0x68, 0x10, 0x0d, 0xee, 0x13, 0x3f, 0x51, 0xed, 0x54, 0x33, 0xec,
-	0x99, 0xaf, 0x77, 0x50, 0x6d, 0x20, 0x38, 0xed, 0xbb, 0x89, 0xfc, 0x58, 0xe3, 0x26, 0x63, 0xaa,
-	0x51, 0x75, 0x0a, 0xe9, 0x4f, 0x10, 0x52, 0x3c, 0x6b, 0xdd, 0xfa, 0x97, 0xa7, 0x8a, 0x2f, 0x1a,
-	0x77, 0x50, 0xd9, 0xa5, 0x3c, 0x64, 0xca, 0xd8, 0x8e, 0x29, 0xa5, 0x91, 0x5e, 0x47, 0x25, 0x1f,
-	0x18, 0xa7, 0x46, 0x29, 0x86, 0x93, 0xe0, 0xe4, 0x79, 0x64, 0xdf, 0x0a, 0xcd, 0xc8, 0xc1, 0x47,
-	0x9b, 0x1c, 0xcc, 0xb3, 0xa4, 0xf9, 0x02, 0x1d, 0x6e, 0x48, 0x2f, 0x9c, 0xd5, 0xef, 0xa1, 0x9b,
-	0x02, 0xa8, 0x4b, 0x18, 0x61, 0xb8, 0x1f, 0x4a, 0x90, 0xb1, 0x77, 0xdb, 0xce, 0x8d, 0x0c, 0x3d,
-	0x97, 0x20, 0x8f, 0x3e, 0x14, 0xd1, 0x56, 0x4f, 0x62, 0x7d, 0x88, 0x6a, 0x2b, 0x77, 0xed, 0x7e,
-	0xde, 0x1d, 0x59, 0xdb, 0x64, 0xc3, 0xfe, 0xcf, 0xc2, 0x8c, 0xd8, 0x27, 0x0d, 0x19, 0xb9, 0xfb,
-	0x3e, 0xde, 0x30, 0x2d, 0xaf, 0xa9, 0xd1, 0xb9, 0x46, 0xd3, 0x82, 0x4e, 0xa3, 0xf4, 0x2e, 0xba,
-	0xe8, 0x5d, 0xe7, 0x72, 0x6a, 0x6a, 0x57, 0x53, 0x53, 0xfb, 0x35, 0x35, 0xb5, 0xcf, 0x33, 0xb3,
-	0x70, 0x35, 0x33, 0x0b, 0x3f, 0x66, 0x66, 0xe1, 0xcd, 0x53, 0x4c, 0xd4, 0x30, 0xbc, 0xb0, 0x3c,
-	0x4e, 0xed, 0x40, 0x70, 0x3f, 0xf4, 0x94, 0xf4, 0x48, 0xbc, 0xb5, 0xdc, 0xfd, 0xa9, 0x71, 0x00,
-	0xf2, 0xa2, 0x1c, 0xff, 0xca, 0xc7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x3a, 0xc2, 0x4e,
-	0x87, 0x04, 0x00, 0x00,
+	// 665 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x3d, 0x6f, 0xd3, 0x4e,
+	0x1c, 0xce, 0xf5, 0x4d, 0xca, 0xb5, 0xff, 0x3f, 0xc2, 0x8a, 0x5a, 0xd7, 0x48, 0x6e, 0xeb, 0xf2,
+	0x52, 0x2a, 0x11, 0x43, 0x8b, 0x28, 0x4a, 0xa7, 0x06, 0x05, 0x09, 0x44, 0x24, 0x64, 0xe8, 0xc2,
+	0x12, 0xb9, 0xf6, 0xc5, 0xb1, 0x90, 0xef, 0xac, 0xbb, 0x73, 0x95, 0x6c, 0x15, 0x1b, 0x88, 0x81,
+	0x81, 0x0f, 0xc1, 0x98, 0x81, 0x9d, 0xb5, 0x6c, 0x15, 0x13, 0x13, 0x42, 0xc9, 0x10, 0x89, 0x4f,
+	0x81, 0xfc, 0x9a, 0xe6, 0xe5, 0x0c, 0xad, 0x58, 0x92, 0xbb, 0xdf, 0xdb, 0x3d, 0xcf, 0x73, 0xcf,
+	0x25, 0x70, 0xcd, 0xc5, 0x4d, 0x44, 0x11, 0xb6, 0x90, 0x4e, 0x11, 0xe3, 0xd4, 0xb5, 0xb8, 0x4b,
+	0x30, 0xd3, 0x79, 0xbb, 0xec, 0x53, 0xc2, 0x89, 0xb4, 0x9c, 0x15, 0x94, 0xcf, 0x17, 0x28, 0x57,
+	0x4d, 0xcf, 0xc5, 0x44, 0x8f, 0x3e, 0xe3, 0x52, 0x65, 0xc5, 0x22, 0xcc, 0x23, 0x4c, 0xf7, 0x98,
+	0xa3, 0x1f, 0xdf, 0x0b, 0xbf, 0x92, 0xc4, 0x6a, 0x9c, 0x68, 0x44, 0x3b, 0x3d, 0xde, 0x24, 0xa9,
+	0x92, 0x43, 0x1c, 0x12, 0xc7, 0xc3, 0x55, 0x12, 0xbd, 0x29, 0x40, 0x85, 0xda, 0xc8, 0xf3, 0xc3,
+	0x65, 0x52, 0xb7, 0x29, 0xa8, 0xf3, 0x4d, 0x6a, 0x7a, 0xc9, 0x11, 0xda, 0x57, 0x00, 0xaf, 0xd4,
+	0x99, 0x73, 0xe8, 0xdb, 0x26, 0x47, 0xcf, 0xa3, 0x8c, 0xf4, 0x00, 0x16, 0xcd, 0x80, 0xb7, 0x08,
+	0x75, 0x79, 0x47, 0x06, 0xeb, 0x60, 0xab, 0x58, 0x95, 0xbf, 0x7d, 0xbe, 0x53, 0x4a, 0xb0, 0x1d,
+	0xd8, 0x36, 0x45, 0x8c, 0xbd, 0xe0, 0xd4, 0xc5, 0x8e, 0x31, 0x2c, 0x95, 0x0e, 0xe0, 0x42, 0x3c,
+	0x5b, 0x9e, 0x59, 0x07, 0x5b, 0x8b, 0x3b, 0x6a, 0x79, 0xba, 0x3c, 0xe5, 0xf8, 0x9c, 0x6a, 0xf1,
+	0xf4, 0xc7, 0x5a, 0xe1, 0xd3, 0xa0, 0xbb, 0x0d, 0x8c, 0xa4, 0xb1, 0xb2, 0xff, 0x66, 0xd0, 0xdd,
+	0x1e, 0x8e, 0x7c, 0x37, 0xe8, 0x6e, 0x6f, 0x0d, 0x69, 0xb4, 0x47, 0x89, 0x8c, 0xe1, 0xd6, 0x56,
+	0xe1, 0xca, 0x58, 0xc8, 0x40, 0xcc, 0x27, 0x98, 0x21, 0xad, 0x3b, 0x03, 0xaf, 0xd5, 0x99, 0x53,
+	0x6b, 0x23, 0x2b, 0xe0, 0xa8, 0xe6, 0x21, 0xea, 0x20, 0x6c, 0x75, 0x5e, 0x52, 0x13, 0xb3, 0x26,
+	0xa2, 0xd2, 0x06, 0x5c, 0xca, 0xe4, 0x6b, 0xb8, 0x76, 0xcc, 0xda, 0x58, 0xcc, 0x62, 0x4f, 0x6c,
+	0x69, 0x1f, 0x2e, 0x35, 0x29, 0xf1, 0x1a, 0x66, 0x4c, 0x3f, 0xe2, 0x98, 0x27, 0xcc, 0x62, 0x58,
+	0x9d, 0x84, 0xa4, 0x3d, 0x08, 0x39, 0xc9, 0x5a, 0x67, 0xff, 0xa4, 0x29, 0x27, 0x69, 0xe3, 0x32,
+	0x5c, 0x30, 0x3d, 0x12, 0x60, 0x2e, 0xcf, 0x45, 0x90, 0x92, 0x9d, 0x54, 0x82, 0xf3, 0x36, 0xc2,
+	0xc4, 0x93, 0xe7, 0xa3, 0x70, 0xbc, 0xa9, 0x3c, 0x0d, 0xe5, 0x1b, 0x81, 0x19, 0x2a, 0x78, 0x3f,
+	0x4f, 0x41, 0x91, 0x24, 0xda, 0x33, 0xb8, 0x99, 0x93, 0x4e, 0x95, 0x95, 0x6e, 0xc0, 0xff, 0x29,
+	0xf2, 0x4c, 0x17, 0xbb, 0xd8, 0x69, 0x04, 0x0c, 0xb1, 0x48, 0xbb, 0x39, 0xe3, 0xbf, 0x2c, 0x7a,
+	0xc8, 0x10, 0xd3, 0x7e, 0x81, 0xe8, 0x72, 0x0e, 0x6c, 0x3b, 0x9d, 0x50, 0x4b, 0xb5, 0xbd, 0xb4,
+	0xdf, 0x0c, 0x58, 0xcc, 0x2e, 0x28, 0xb1, 0xdc, 0x6d, 0x91, 0xe5, 0x26, 0x4e, 0x3d, 0xef, 0xbe,
+	0xe1, 0x98, 0xca, 0xa3, 0x49, 0x03, 0xde, 0xcd, 0x93, 0x6f, 0x1a, 0x21, 0x6d, 0x03, 0xae, 0x09,
+	0x52, 0x99, 0x21, 0xbf, 0x00, 0xa8, 0xd4, 0x99, 0x63, 0xa0, 0x63, 0xf2, 0x1a, 0xfd, 0x3b, 0x49,
+	0xc6, 0x7d, 0x3c, 0x33, 0xe1, 0xe3, 0xca, 0xe3, 0x49, 0x86, 0xbb, 0x79, 0x0c, 0x05, 0x10, 0xb5,
+	0xeb, 0x50, 0x13, 0x67, 0x53, 0x9e, 0x3b, 0x1f, 0xe7, 0xe0, 0x6c, 0x9d, 0x39, 0x52, 0x0b, 0x2e,
+	0x8d, 0xfc, 0xc6, 0xdc, 0x12, 0x5d, 0xd4, 0xd8, 0x0b, 0x56, 0xf4, 0xbf, 0x2c, 0xcc, 0x0c, 0xf9,
+	0x1e, 0x40, 0x59, 0xf8, 0xce, 0x77, 0x73, 0xa6, 0x89, 0x9a, 0x94, 0xfd, 0x4b, 0x34, 0x65, 0x70,
+	0x4e, 0x00, 0x2c, 0x4d, 0x75, 0x7d, 0x1e, 0xb1, 0x69, 0x0d, 0xca, 0xde, 0x05, 0x1b, 0x32, 0x08,
+	0x6f, 0x01, 0x5c, 0x11, 0x19, 0x6d, 0x27, 0x67, 0xa8, 0xa0, 0x47, 0xa9, 0x5c, 0xbc, 0x27, 0xc5,
+	0xa2, 0xcc, 0x9f, 0x84, 0x2f, 0xae, 0x6a, 0x9c, 0xf6, 0x54, 0x70, 0xd6, 0x53, 0xc1, 0xcf, 0x9e,
+	0x0a, 0x3e, 0xf4, 0xd5, 0xc2, 0x59, 0x5f, 0x2d, 0x7c, 0xef, 0xab, 0x85, 0x57, 0x0f, 0x1d, 0x97,
+	0xb7, 0x82, 0xa3, 0xb2, 0x45, 0x3c, 0xdd, 0xa7, 0xc4, 0x0e, 0x2c, 0xce, 0x2c, 0x37, 0xf2, 0xa6,
+	0xd0, 0xa5, 0xbc, 0xe3, 0x23, 0x76, 0xb4, 0x10, 0xfd, 0xa3, 0xed, 0xfe, 0x0e, 0x00, 0x00, 0xff,
+	0xff, 0xc7, 0xdf, 0xbf, 0xed, 0xb6, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -318,6 +510,10 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// ExecuteEmergencyTransfer allows users to execute governance-approved emergency transfers
 	ExecuteEmergencyTransfer(ctx context.Context, in *MsgExecuteEmergencyTransfer, opts ...grpc.CallOption) (*MsgExecuteEmergencyTransferResponse, error)
+	// AddTransferExemption defines a (governance) operation for adding an address-scoped transfer exemption
+	AddTransferExemption(ctx context.Context, in *MsgAddTransferExemption, opts ...grpc.CallOption) (*MsgAddTransferExemptionResponse, error)
+	// RevokeTransferExemption defines a (governance) operation for revoking a transfer exemption
+	RevokeTransferExemption(ctx context.Context, in *MsgRevokeTransferExemption, opts ...grpc.CallOption) (*MsgRevokeTransferExemptionResponse, error)
 }
 
 type msgClient struct {
@@ -346,6 +542,24 @@ func (c *msgClient) ExecuteEmergencyTransfer(ctx context.Context, in *MsgExecute
 	return out, nil
 }
 
+func (c *msgClient) AddTransferExemption(ctx context.Context, in *MsgAddTransferExemption, opts ...grpc.CallOption) (*MsgAddTransferExemptionResponse, error) {
+	out := new(MsgAddTransferExemptionResponse)
+	err := c.cc.Invoke(ctx, "/inference.restrictions.Msg/AddTransferExemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeTransferExemption(ctx context.Context, in *MsgRevokeTransferExemption, opts ...grpc.CallOption) (*MsgRevokeTransferExemptionResponse, error) {
+	out := new(MsgRevokeTransferExemptionResponse)
+	err := c.cc.Invoke(ctx, "/inference.restrictions.Msg/RevokeTransferExemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -353,6 +567,10 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// ExecuteEmergencyTransfer allows users to execute governance-approved emergency transfers
 	ExecuteEmergencyTransfer(context.Context, *MsgExecuteEmergencyTransfer) (*MsgExecuteEmergencyTransferResponse, error)
+	// AddTransferExemption defines a (governance) operation for adding an address-scoped transfer exemption
+	AddTransferExemption(context.Context, *MsgAddTransferExemption) (*MsgAddTransferExemptionResponse, error)
+	// RevokeTransferExemption defines a (governance) operation for revoking a transfer exemption
+	RevokeTransferExemption(context.Context, *MsgRevokeTransferExemption) (*MsgRevokeTransferExemptionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -365,6 +583,12 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) ExecuteEmergencyTransfer(ctx context.Context, req *MsgExecuteEmergencyTransfer) (*MsgExecuteEmergencyTransferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteEmergencyTransfer not implemented")
 }
+func (*UnimplementedMsgServer) AddTransferExemption(ctx context.Context, req *MsgAddTransferExemption) (*MsgAddTransferExemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTransferExemption not implemented")
+}
+func (*UnimplementedMsgServer) RevokeTransferExemption(ctx context.Context, req *MsgRevokeTransferExemption) (*MsgRevokeTransferExemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeTransferExemption not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -406,6 +630,42 @@ func _Msg_ExecuteEmergencyTransfer_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddTransferExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddTransferExemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddTransferExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.restrictions.Msg/AddTransferExemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddTransferExemption(ctx, req.(*MsgAddTransferExemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeTransferExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeTransferExemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeTransferExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.restrictions.Msg/RevokeTransferExemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeTransferExemption(ctx, req.(*MsgRevokeTransferExemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.restrictions.Msg",
@@ -419,6 +679,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExecuteEmergencyTransfer",
 			Handler:    _Msg_ExecuteEmergencyTransfer_Handler,
 		},
+		{
+			MethodName: "AddTransferExemption",
+			Handler:    _Msg_AddTransferExemption_Handler,
+		},
+		{
+			MethodName: "RevokeTransferExemption",
+			Handler:    _Msg_RevokeTransferExemption_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/restrictions/tx.proto",
@@ -573,57 +841,180 @@ func (m *MsgExecuteEmergencyTransferResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgAddTransferExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgAddTransferExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddTransferExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Exemption.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddTransferExemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddTransferExemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddTransferExemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecuteEmergencyTransfer) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevokeTransferExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeTransferExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeTransferExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ExemptionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ExemptionId) > 0 {
+		i -= len(m.ExemptionId)
+		copy(dAtA[i:], m.ExemptionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExemptionId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeTransferExemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeTransferExemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeTransferExemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecuteEmergencyTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExemptionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.Amount)
@@ -649,6 +1040,56 @@ func (m *MsgExecuteEmergencyTransferResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAddTransferExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Exemption.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddTransferExemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeTransferExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExemptionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeTransferExemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -665,28 +1106,289 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteEmergencyTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteEmergencyTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteEmergencyTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -714,13 +1416,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -730,24 +1432,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -770,7 +1471,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteEmergencyTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -793,12 +1494,31 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteEmergencyTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteEmergencyTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingUses", wireType)
+			}
+			m.RemainingUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingUses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -820,7 +1540,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteEmergencyTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgAddTransferExemption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -843,15 +1563,15 @@ func (m *MsgExecuteEmergencyTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteEmergencyTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddTransferExemption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteEmergencyTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddTransferExemption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExemptionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -879,13 +1599,13 @@ func (m *MsgExecuteEmergencyTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExemptionId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exemption", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -895,59 +1615,128 @@ func (m *MsgExecuteEmergencyTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			if err := m.Exemption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddTransferExemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddTransferExemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddTransferExemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeTransferExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeTransferExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeTransferExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -975,11 +1764,11 @@ func (m *MsgExecuteEmergencyTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1007,7 +1796,7 @@ func (m *MsgExecuteEmergencyTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ExemptionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1030,7 +1819,7 @@ func (m *MsgExecuteEmergencyTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteEmergencyTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeTransferExemptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1053,31 +1842,12 @@ func (m *MsgExecuteEmergencyTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteEmergencyTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeTransferExemptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteEmergencyTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeTransferExemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingUses", wireType)
-			}
-			m.RemainingUses = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RemainingUses |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/streamvesting/types"
+)
+
+const (
+	PenaltyDestinationBurn          = "burn"
+	PenaltyDestinationCommunityPool = "community_pool"
+)
+
+// AccelerationQuote is what unlocking the next epochs of a schedule right away pays and costs
+type AccelerationQuote struct {
+	Epochs   int
+	Unlocked sdk.Coins
+	Penalty  sdk.Coins
+	Paid     sdk.Coins
+}
+
+// QuoteAcceleration prices unlocking the next epochs of the schedule, at most all of them. The penalty is the
+// penalty fraction of every coin, rounded down. A nil fraction, as in params stored before the penalty
+// existed, is the default penalty rather than none.
+func QuoteAcceleration(schedule types.VestingSchedule, epochs uint64, penaltyFraction math.LegacyDec) AccelerationQuote {
+	if penaltyFraction.IsNil() {
+		penaltyFraction = types.DefaultParams().AccelerationPenalty
+	}
+	count := len(schedule.EpochAmounts)
+	if epochs < uint64(count) {
+		count = int(epochs)
+	}
+	unlocked := sdk.NewCoins()
+	for i := 0; i < count; i++ {
+		unlocked = unlocked.Add(schedule.EpochAmounts[i].Coins...)
+	}
+	penalty := sdk.NewCoins()
+	for _, coin := range unlocked {
+		penalty = penalty.Add(sdk.NewCoin(coin.Denom, math.LegacyNewDecFromInt(coin.Amount).Mul(penaltyFraction).TruncateInt()))
+	}
+	return AccelerationQuote{
+		Epochs:   count,
+		Unlocked: unlocked,
+		Penalty:  penalty,
+		Paid:     unlocked.Sub(penalty...),
+	}
+}
+
+func penaltyDestination(params types.Params) string {
+	if params.PenaltyToCommunityPool {
+		return PenaltyDestinationCommunityPool
+	}
+	return PenaltyDestinationBurn
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/streamvesting/types"
+)
+
+// SetEpochUnlock stores the unlocks made when an epoch completed
+func (k Keeper) SetEpochUnlock(ctx sdk.Context, unlock types.EpochUnlock) {
+	if err := k.EpochUnlocks.Set(ctx, unlock.EpochIndex, unlock); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllEpochUnlocks retrieves the unlock history of all epochs
+func (k Keeper) GetAllEpochUnlocks(ctx sdk.Context) []types.EpochUnlock {
+	iter, err := k.EpochUnlocks.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	values, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+	return values
+}
+
+// ProjectUnlocks estimates when the remaining epochs of the given schedules unlock, summing the schedules epoch
+// by epoch. The first epoch of a schedule unlocks when the next epoch completes; heights and times are
+// extrapolated from the last two completed epochs.
+func (k Keeper) ProjectUnlocks(ctx sdk.Context, schedules []types.VestingSchedule) []types.ProjectedUnlock {
+	var totals []sdk.Coins
+	for _, schedule := range schedules {
+		for i, epochAmount := range schedule.EpochAmounts {
+			if i == len(totals) {
+				totals = append(totals, sdk.NewCoins())
+			}
+			totals[i] = totals[i].Add(epochAmount.Coins...)
+		}
+	}
+
+	recent := k.recentEpochUnlocks(ctx, 2)
+	var last types.EpochUnlock
+	var blocksPerEpoch, secondsPerEpoch int64
+	if len(recent) > 0 {
+		last = recent[0]
+	}
+	if len(recent) == 2 {
+		epochs := int64(recent[0].EpochIndex - recent[1].EpochIndex)
+		blocksPerEpoch = (recent[0].Height - recent[1].Height) / epochs
+		secondsPerEpoch = (recent[0].Time - recent[1].Time) / epochs
+	}
+
+	unlocks := make([]types.ProjectedUnlock, 0, len(totals))
+	for i, amount := range totals {
+		if amount.IsZero() {
+			continue
+		}
+		unlock := types.ProjectedUnlock{Amount: amount}
+		if len(recent) > 0 {
+			unlock.EpochIndex = last.EpochIndex + uint64(i) + 1
+		}
+		if blocksPerEpoch > 0 {
+			unlock.EstimatedHeight = last.Height + int64(i+1)*blocksPerEpoch
+			unlock.EstimatedTime = last.Time + int64(i+1)*secondsPerEpoch
+		}
+		unlocks = append(unlocks, unlock)
+	}
+	return unlocks
+}
+
+// recentEpochUnlocks returns up to limit of the latest epoch unlocks, newest first
+func (k Keeper) recentEpochUnlocks(ctx sdk.Context, limit int) []types.EpochUnlock {
+	iter, err := k.EpochUnlocks.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		panic(err)
+	}
+	defer iter.Close()
+	var unlocks []types.EpochUnlock
+	for ; iter.Valid() && len(unlocks) < limit; iter.Next() {
+		unlock, err := iter.Value()
+		if err != nil {
+			panic(err)
+		}
+		unlocks = append(unlocks, unlock)
+	}
+	return unlocks
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/productscience/inference/x/streamvesting/types"
+)
+
+// RegisterInvariants registers the streamvesting module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-vesting", ModuleVestingInvariant(k))
+}
+
+// ModuleVestingInvariant checks that the streamvesting module account holds at least the sum of all vesting
+// schedules. The account is blocked from plain sends and AddVestedRewards spreads every coin it takes in over the
+// schedule's epochs, remainder included. Coins that reached it otherwise are reported as surplus.
+func ModuleVestingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		vesting := k.TotalVesting(ctx)
+		balance := k.bankKeeper.SpendableCoins(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		surplus, broken := balance.SafeSub(vesting...)
+		return sdk.FormatInvariant(types.ModuleName, "module-vesting", fmt.Sprintf(
+			"\tmodule account balance: %s\n\tsum of vesting schedules: %s\n\tsurplus: %s\n",
+			balance, vesting, surplus)), broken
+	}
+}
+
+// TotalVesting returns the sum of the epoch amounts of all vesting schedules.
+func (k Keeper) TotalVesting(ctx sdk.Context) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, schedule := range k.GetAllVestingSchedules(ctx) {
+		for _, epochAmount := range schedule.EpochAmounts {
+			total = total.Add(epochAmount.Coins...)
+		}
+	}
+	return total
+}
@@ -0,0 +1,25 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/streamvesting/types"
+)
+
+func (suite *KeeperTestSuite) TestTotalVesting() {
+	suite.Require().True(suite.keeper.TotalVesting(suite.ctx).IsZero())
+
+	suite.keeper.SetVestingSchedule(suite.ctx, types.VestingSchedule{
+		ParticipantAddress: testutil.Creator,
+		EpochAmounts: []types.EpochCoins{
+			{Coins: sdk.NewCoins(sdk.NewInt64Coin("ngonka", 100))},
+			{Coins: sdk.NewCoins(sdk.NewInt64Coin("ngonka", 100), sdk.NewInt64Coin("stake", 5))},
+		},
+	})
+	suite.keeper.SetVestingSchedule(suite.ctx, types.VestingSchedule{
+		ParticipantAddress: testutil.Requester,
+		EpochAmounts:       []types.EpochCoins{{Coins: sdk.NewCoins(sdk.NewInt64Coin("ngonka", 30))}},
+	})
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ngonka", 230), sdk.NewInt64Coin("stake", 5)), suite.keeper.TotalVesting(suite.ctx))
+}
@@ -27,11 +27,13 @@ type (
 
 		bankKeeper            types.BankKeeper
 		bookkeepingBankKeeper types.BookkeepingBankKeeper
+		distributionKeeper    types.DistributionKeeper
 
 		// Collections schema and stores
 		Schema           collections.Schema
 		params           collections.Item[types.Params]
 		VestingSchedules collections.Map[sdk.AccAddress, types.VestingSchedule]
+		EpochUnlocks     collections.Map[uint64, types.EpochUnlock]
 	}
 )
 
@@ -43,6 +45,7 @@ func NewKeeper(
 
 	bankKeeper types.BankKeeper,
 	bookkeepingBankKeeper types.BookkeepingBankKeeper,
+	distributionKeeper types.DistributionKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -58,11 +61,13 @@ func NewKeeper(
 
 		bankKeeper:            bankKeeper,
 		bookkeepingBankKeeper: bookkeepingBankKeeper,
+		distributionKeeper:    distributionKeeper,
 	}
 
 	// Wire collections stores
 	k.params = collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
 	k.VestingSchedules = collections.NewMap(sb, types.VestingScheduleKey, "vesting_schedules", sdk.AccAddressKey, codec.CollValue[types.VestingSchedule](cdc))
+	k.EpochUnlocks = collections.NewMap(sb, types.EpochUnlockKey, "epoch_unlocks", collections.Uint64Key, codec.CollValue[types.EpochUnlock](cdc))
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -184,11 +189,18 @@ func (k Keeper) AdvanceEpoch(ctx context.Context, completedEpoch uint64) error {
 	k.Logger().Info("Processing epoch advancement for streamvesting", "epoch", completedEpoch)
 
 	// Process token unlocks for the completed epoch
-	err := k.ProcessEpochUnlocks(sdkCtx)
+	unlocked, participantsUnlocked, err := k.processEpochUnlocks(sdkCtx)
 	if err != nil {
 		k.Logger().Error("Failed to process epoch unlocks", "epoch", completedEpoch, "error", err)
 		return fmt.Errorf("failed to process epoch unlocks for epoch %d: %w", completedEpoch, err)
 	}
+	k.SetEpochUnlock(sdkCtx, types.EpochUnlock{
+		EpochIndex:           completedEpoch,
+		Height:               sdkCtx.BlockHeight(),
+		Time:                 sdkCtx.BlockTime().Unix(),
+		Unlocked:             unlocked,
+		ParticipantsUnlocked: participantsUnlocked,
+	})
 
 	k.Logger().Info("Completed epoch advancement for streamvesting", "epoch", completedEpoch)
 	return nil
@@ -196,13 +208,19 @@ func (k Keeper) AdvanceEpoch(ctx context.Context, completedEpoch uint64) error {
 
 // ProcessEpochUnlocks processes all vesting schedules and unlocks the first epoch's tokens
 func (k Keeper) ProcessEpochUnlocks(ctx sdk.Context) error {
+	_, _, err := k.processEpochUnlocks(ctx)
+	return err
+}
+
+// processEpochUnlocks unlocks the first epoch's tokens of all vesting schedules and returns the total unlocked
+func (k Keeper) processEpochUnlocks(ctx sdk.Context) (sdk.Coins, uint64, error) {
 	// Get all vesting schedules
 	schedules := k.GetAllVestingSchedules(ctx)
 
 	// Track totals for summary event
 	totalUnlocked := sdk.NewCoins()
 	participantsProcessed := 0
-	participantsUnlocked := 0
+	participantsUnlocked := uint64(0)
 
 	for _, schedule := range schedules {
 		participantsProcessed++
@@ -280,7 +298,7 @@ func (k Keeper) ProcessEpochUnlocks(ctx sdk.Context) error {
 			"participants_processed", participantsProcessed)
 	}
 
-	return nil
+	return totalUnlocked, participantsUnlocked, nil
 }
 
 // SetVestingSchedule stores a vesting schedule for a participant
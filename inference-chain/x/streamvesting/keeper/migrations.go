@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/productscience/inference/x/streamvesting/types"
+)
+
+// SetDefaultAccelerationPenalty writes the default acceleration penalty into params stored before the penalty
+// existed. Params that already have a penalty, including a governance chosen zero, are left alone.
+func (k Keeper) SetDefaultAccelerationPenalty(ctx context.Context) error {
+	params := k.GetParams(ctx)
+	if !params.AccelerationPenalty.IsNil() {
+		return nil
+	}
+	params.AccelerationPenalty = types.DefaultParams().AccelerationPenalty
+	return k.SetParams(ctx, params)
+}
@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/streamvesting/types"
+)
+
+func TestSetDefaultAccelerationPenalty(t *testing.T) {
+	k, ctx, storeKey := keepertest.StreamvestingKeeperWithStoreKey(t)
+
+	// Params as stored before the penalty existed: only the vesting period and the transfer switch
+	oldParams := protowire.AppendTag(nil, 1, protowire.VarintType)
+	oldParams = protowire.AppendVarint(oldParams, 180)
+	ctx.KVStore(storeKey).Set(types.ParamsKey, oldParams)
+	require.True(t, k.GetParams(ctx).AccelerationPenalty.IsNil())
+
+	require.NoError(t, k.SetDefaultAccelerationPenalty(ctx))
+	params := k.GetParams(ctx)
+	require.Equal(t, types.DefaultParams().AccelerationPenalty, params.AccelerationPenalty)
+	require.Equal(t, uint64(180), params.RewardVestingPeriod)
+
+	// A penalty chosen by governance is kept
+	params.AccelerationPenalty = math.LegacyZeroDec()
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.SetDefaultAccelerationPenalty(ctx))
+	require.True(t, k.GetParams(ctx).AccelerationPenalty.IsZero())
+}
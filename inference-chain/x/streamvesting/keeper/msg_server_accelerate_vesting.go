@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/productscience/inference/x/streamvesting/types"
+)
+
+// AccelerateVesting unlocks the next epochs of the creator's schedule right away. The acceleration penalty is
+// kept back and burned, or sent to the community pool when governance chose so.
+func (k msgServer) AccelerateVesting(goCtx context.Context, msg *types.MsgAccelerateVesting) (*types.MsgAccelerateVestingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	if msg.Epochs == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidAcceleration, "epochs must be positive")
+	}
+	schedule, found := k.GetVestingSchedule(ctx, msg.Creator)
+	if !found {
+		return nil, types.ErrVestingScheduleNotFound
+	}
+	quote := QuoteAcceleration(schedule, msg.Epochs, params.AccelerationPenalty)
+	if quote.Unlocked.IsZero() {
+		return nil, errorsmod.Wrap(types.ErrInvalidAcceleration, "nothing vests in the accelerated epochs")
+	}
+
+	// Accelerated epochs are left empty, so the later epochs keep their place
+	for i := 0; i < quote.Epochs; i++ {
+		schedule.EpochAmounts[i].Coins = sdk.NewCoins()
+	}
+	remaining := sdk.NewCoins()
+	for _, epochAmount := range schedule.EpochAmounts {
+		remaining = remaining.Add(epochAmount.Coins...)
+	}
+	if remaining.IsZero() {
+		k.RemoveVestingSchedule(ctx, msg.Creator)
+	} else {
+		k.SetVestingSchedule(ctx, schedule)
+	}
+
+	for _, coin := range quote.Unlocked {
+		k.bookkeepingBankKeeper.LogSubAccountTransaction(ctx, msg.Creator, types.ModuleName, HoldingSubAccount, coin, "vesting accelerated")
+	}
+	if !quote.Paid.IsZero() {
+		recipient := sdk.MustAccAddressFromBech32(msg.Creator)
+		if err := k.bookkeepingBankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, quote.Paid, "vesting accelerated"); err != nil {
+			return nil, err
+		}
+	}
+	destination := penaltyDestination(params)
+	if !quote.Penalty.IsZero() {
+		if err := k.payAccelerationPenalty(ctx, msg.Creator, destination, quote.Penalty); err != nil {
+			return nil, err
+		}
+	}
+	k.Logger().Info("Vesting accelerated", "participant", msg.Creator, "epochs", quote.Epochs, "paid", quote.Paid, "penalty", quote.Penalty, "penaltyDestination", destination)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAccelerate,
+			sdk.NewAttribute(types.AttributeKeyParticipant, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyVestingEpochs, fmt.Sprintf("%d", quote.Epochs)),
+			sdk.NewAttribute(types.AttributeKeyUnlockedAmount, quote.Paid.String()),
+			sdk.NewAttribute(types.AttributeKeyPenalty, quote.Penalty.String()),
+			sdk.NewAttribute(types.AttributeKeyPenaltyDest, destination),
+		),
+	)
+
+	return &types.MsgAccelerateVestingResponse{Paid: quote.Paid, Penalty: quote.Penalty}, nil
+}
+
+func (k Keeper) payAccelerationPenalty(ctx sdk.Context, participant string, destination string, penalty sdk.Coins) error {
+	memo := "vesting acceleration penalty of " + participant
+	if destination == PenaltyDestinationBurn {
+		return k.bookkeepingBankKeeper.BurnCoins(ctx, types.ModuleName, penalty, memo)
+	}
+	if err := k.distributionKeeper.FundCommunityPool(ctx, penalty, authtypes.NewModuleAddress(types.ModuleName)); err != nil {
+		return err
+	}
+	// The distribution keeper moves the coins itself, so the bookkeeper only gets told about it
+	for _, coin := range penalty {
+		k.bookkeepingBankKeeper.LogSubAccountTransaction(ctx, distrtypes.ModuleName, types.ModuleName, "", coin, memo)
+	}
+	return nil
+}
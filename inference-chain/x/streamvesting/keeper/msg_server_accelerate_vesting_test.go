@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/streamvesting/keeper"
+	"github.com/productscience/inference/x/streamvesting/types"
+)
+
+func (suite *KeeperTestSuite) TestAccelerateVesting() {
+	ms := keeper.NewMsgServerImpl(suite.keeper)
+	msg := &types.MsgAccelerateVesting{Creator: testutil.Creator, Epochs: 2}
+
+	_, err := ms.AccelerateVesting(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrVestingScheduleNotFound)
+
+	suite.keeper.SetVestingSchedule(suite.ctx, types.VestingSchedule{
+		ParticipantAddress: testutil.Creator,
+		EpochAmounts:       epochCoins(100, 33, 100),
+	})
+
+	// The simulation shows the default penalty of a fifth, rounded down
+	sim, err := suite.keeper.SimulateAcceleration(suite.ctx, &types.QuerySimulateAccelerationRequest{ParticipantAddress: testutil.Creator, Epochs: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ngonka", 133)), sim.Unlocked)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ngonka", 26)), sim.Penalty)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ngonka", 107)), sim.Paid)
+	suite.Require().Equal(uint64(2), sim.Epochs)
+	suite.Require().Equal(keeper.PenaltyDestinationBurn, sim.PenaltyDestination)
+
+	creator := sdk.MustAccAddressFromBech32(testutil.Creator)
+	suite.mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), testutil.Creator, types.ModuleName, keeper.HoldingSubAccount, sdk.NewInt64Coin("ngonka", 133), gomock.Any())
+	suite.mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, creator, sim.Paid, gomock.Any()).Return(nil)
+	suite.mocks.BankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sim.Penalty, gomock.Any()).Return(nil)
+
+	resp, err := ms.AccelerateVesting(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sim.Paid, resp.Paid)
+	suite.Require().Equal(sim.Penalty, resp.Penalty)
+
+	// The last epoch keeps its place
+	schedule, found := suite.keeper.GetVestingSchedule(suite.ctx, testutil.Creator)
+	suite.Require().True(found)
+	suite.Require().Equal([]int64{0, 0, 100}, epochAmounts(schedule))
+
+	// Accelerated epochs are empty now, so accelerating them again is rejected
+	_, err = ms.AccelerateVesting(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidAcceleration)
+
+	// Without a penalty nothing is burned, and asking for more epochs than remain unlocks the whole schedule
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AccelerationPenalty = math.LegacyZeroDec()
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	suite.mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), testutil.Creator, types.ModuleName, keeper.HoldingSubAccount, sdk.NewInt64Coin("ngonka", 100), gomock.Any())
+	suite.mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, creator, sdk.NewCoins(sdk.NewInt64Coin("ngonka", 100)), gomock.Any()).Return(nil)
+
+	resp, err = ms.AccelerateVesting(suite.ctx, &types.MsgAccelerateVesting{Creator: testutil.Creator, Epochs: 10})
+	suite.Require().NoError(err)
+	suite.Require().True(resp.Penalty.IsZero())
+	_, found = suite.keeper.GetVestingSchedule(suite.ctx, testutil.Creator)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestQuoteAcceleration_NilPenaltyIsDefault() {
+	schedule := types.VestingSchedule{ParticipantAddress: testutil.Creator, EpochAmounts: epochCoins(100)}
+
+	quote := keeper.QuoteAcceleration(schedule, 1, math.LegacyDec{})
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ngonka", 20)), quote.Penalty)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ngonka", 80)), quote.Paid)
+}
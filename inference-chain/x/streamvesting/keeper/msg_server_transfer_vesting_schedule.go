@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/streamvesting/types"
+)
+
+// TransferVestingSchedule moves the upcoming epochs of the creator's schedule to the recipient, merging them
+// epoch by epoch into the recipient's schedule so every amount still unlocks at the same epoch.
+func (k msgServer) TransferVestingSchedule(goCtx context.Context, msg *types.MsgTransferVestingSchedule) (*types.MsgTransferVestingScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).AllowScheduleTransfers {
+		return nil, types.ErrScheduleTransfersDisabled
+	}
+	if msg.Creator == msg.Recipient {
+		return nil, errorsmod.Wrap(types.ErrInvalidScheduleTransfer, "recipient is the creator")
+	}
+	recipientAddr, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidScheduleTransfer, "invalid recipient address: %s", err)
+	}
+	// Coins unlocked for a blocked address, such as a module account, would be stuck there
+	if k.bankKeeper.BlockedAddr(recipientAddr) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidScheduleTransfer, "%s is not allowed to receive funds", msg.Recipient)
+	}
+	schedule, found := k.GetVestingSchedule(ctx, msg.Creator)
+	if !found {
+		return nil, types.ErrVestingScheduleNotFound
+	}
+
+	epochs := len(schedule.EpochAmounts)
+	if msg.Epochs > 0 && msg.Epochs < uint64(epochs) {
+		epochs = int(msg.Epochs)
+	}
+	recipientSchedule, found := k.GetVestingSchedule(ctx, msg.Recipient)
+	if !found {
+		recipientSchedule = types.VestingSchedule{
+			ParticipantAddress: msg.Recipient,
+			EpochAmounts:       []types.EpochCoins{},
+		}
+	}
+	for len(recipientSchedule.EpochAmounts) < epochs {
+		recipientSchedule.EpochAmounts = append(recipientSchedule.EpochAmounts, types.EpochCoins{
+			Coins: sdk.NewCoins(),
+		})
+	}
+
+	// Moved epochs are left empty in the creator's schedule, so its later epochs keep their place
+	transferred := sdk.NewCoins()
+	for i := 0; i < epochs; i++ {
+		recipientSchedule.EpochAmounts[i].Coins = recipientSchedule.EpochAmounts[i].Coins.Add(schedule.EpochAmounts[i].Coins...)
+		transferred = transferred.Add(schedule.EpochAmounts[i].Coins...)
+		schedule.EpochAmounts[i].Coins = sdk.NewCoins()
+	}
+	if transferred.IsZero() {
+		return nil, errorsmod.Wrap(types.ErrInvalidScheduleTransfer, "nothing vests in the transferred epochs")
+	}
+
+	remaining := sdk.NewCoins()
+	for _, epochAmount := range schedule.EpochAmounts {
+		remaining = remaining.Add(epochAmount.Coins...)
+	}
+	if remaining.IsZero() {
+		k.RemoveVestingSchedule(ctx, msg.Creator)
+	} else {
+		k.SetVestingSchedule(ctx, schedule)
+	}
+	k.SetVestingSchedule(ctx, recipientSchedule)
+
+	for _, coin := range transferred {
+		k.bookkeepingBankKeeper.LogSubAccountTransaction(ctx, msg.Creator, types.ModuleName, HoldingSubAccount, coin, "vesting transferred to "+msg.Recipient)
+		k.bookkeepingBankKeeper.LogSubAccountTransaction(ctx, types.ModuleName, msg.Recipient, HoldingSubAccount, coin, "vesting transferred from "+msg.Creator)
+	}
+	k.Logger().Info("Vesting schedule transferred", "from", msg.Creator, "to", msg.Recipient, "epochs", epochs, "amount", transferred)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferVest,
+			sdk.NewAttribute(types.AttributeKeyParticipant, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, transferred.String()),
+			sdk.NewAttribute(types.AttributeKeyVestingEpochs, fmt.Sprintf("%d", epochs)),
+		),
+	)
+
+	return &types.MsgTransferVestingScheduleResponse{Transferred: transferred}, nil
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/streamvesting/keeper"
+	"github.com/productscience/inference/x/streamvesting/types"
+)
+
+func (suite *KeeperTestSuite) TestTransferVestingSchedule() {
+	ms := keeper.NewMsgServerImpl(suite.keeper)
+	msg := &types.MsgTransferVestingSchedule{Creator: testutil.Creator, Recipient: testutil.Requester, Epochs: 2}
+
+	_, err := ms.TransferVestingSchedule(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrScheduleTransfersDisabled)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AllowScheduleTransfers = true
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	_, err = ms.TransferVestingSchedule(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrVestingScheduleNotFound)
+
+	// Module accounts can't receive a schedule
+	_, err = ms.TransferVestingSchedule(suite.ctx, &types.MsgTransferVestingSchedule{
+		Creator:   testutil.Creator,
+		Recipient: authtypes.NewModuleAddress(types.ModuleName).String(),
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidScheduleTransfer)
+
+	suite.keeper.SetVestingSchedule(suite.ctx, types.VestingSchedule{
+		ParticipantAddress: testutil.Creator,
+		EpochAmounts:       epochCoins(100, 100, 100),
+	})
+	suite.keeper.SetVestingSchedule(suite.ctx, types.VestingSchedule{
+		ParticipantAddress: testutil.Requester,
+		EpochAmounts:       epochCoins(10),
+	})
+	suite.mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), testutil.Creator, types.ModuleName, keeper.HoldingSubAccount, sdk.NewInt64Coin("ngonka", 200), gomock.Any())
+	suite.mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), types.ModuleName, testutil.Requester, keeper.HoldingSubAccount, sdk.NewInt64Coin("ngonka", 200), gomock.Any())
+
+	resp, err := ms.TransferVestingSchedule(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ngonka", 200)), resp.Transferred)
+
+	// The remaining epoch keeps its place in the creator's schedule
+	schedule, found := suite.keeper.GetVestingSchedule(suite.ctx, testutil.Creator)
+	suite.Require().True(found)
+	suite.Require().Equal([]int64{0, 0, 100}, epochAmounts(schedule))
+	schedule, found = suite.keeper.GetVestingSchedule(suite.ctx, testutil.Requester)
+	suite.Require().True(found)
+	suite.Require().Equal([]int64{110, 100}, epochAmounts(schedule))
+
+	// Moving all epochs removes the creator's schedule
+	suite.mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), gomock.Any(), gomock.Any(), keeper.HoldingSubAccount, sdk.NewInt64Coin("ngonka", 100), gomock.Any()).Times(2)
+	_, err = ms.TransferVestingSchedule(suite.ctx, &types.MsgTransferVestingSchedule{Creator: testutil.Creator, Recipient: testutil.Requester})
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetVestingSchedule(suite.ctx, testutil.Creator)
+	suite.Require().False(found)
+	schedule, _ = suite.keeper.GetVestingSchedule(suite.ctx, testutil.Requester)
+	suite.Require().Equal([]int64{110, 100, 100}, epochAmounts(schedule))
+}
+
+func (suite *KeeperTestSuite) TestProjectedUnlocksAndHistory() {
+	suite.keeper.SetVestingSchedule(suite.ctx, types.VestingSchedule{
+		ParticipantAddress: testutil.Creator,
+		EpochAmounts:       epochCoins(100, 0, 50),
+	})
+	suite.keeper.SetVestingSchedule(suite.ctx, types.VestingSchedule{
+		ParticipantAddress: testutil.Requester,
+		EpochAmounts:       epochCoins(10, 20, 30, 40),
+	})
+
+	// Without history the timing is unknown
+	resp, err := suite.keeper.ProjectedUnlocks(suite.ctx, &types.QueryProjectedUnlocksRequest{ParticipantAddress: testutil.Creator})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ProjectedUnlock{
+		{Amount: sdk.NewCoins(sdk.NewInt64Coin("ngonka", 100))},
+		{Amount: sdk.NewCoins(sdk.NewInt64Coin("ngonka", 50))},
+	}, resp.Unlocks)
+
+	suite.mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any(), "vesting payment").Return(nil).Times(2)
+	suite.mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), gomock.Any(), types.ModuleName, keeper.HoldingSubAccount, gomock.Any(), gomock.Any()).Times(2)
+	suite.keeper.SetEpochUnlock(suite.ctx, types.EpochUnlock{EpochIndex: 4, Height: 1_000, Time: 50_000})
+	ctx := suite.ctx.WithBlockHeight(1_200).WithBlockTime(time.Unix(51_000, 0))
+	suite.Require().NoError(suite.keeper.AdvanceEpoch(ctx, 5))
+
+	history, err := suite.keeper.UnlockHistory(ctx, &types.QueryUnlockHistoryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(history.Unlocks, 2)
+	suite.Require().Equal(types.EpochUnlock{
+		EpochIndex:           5,
+		Height:               1_200,
+		Time:                 51_000,
+		Unlocked:             sdk.NewCoins(sdk.NewInt64Coin("ngonka", 110)),
+		ParticipantsUnlocked: 2,
+	}, history.Unlocks[1])
+
+	// All participants, extrapolated at 200 blocks and 1000 seconds per epoch
+	resp, err = suite.keeper.ProjectedUnlocks(ctx, &types.QueryProjectedUnlocksRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ProjectedUnlock{
+		{EpochIndex: 6, EstimatedHeight: 1_400, EstimatedTime: 52_000, Amount: sdk.NewCoins(sdk.NewInt64Coin("ngonka", 20))},
+		{EpochIndex: 7, EstimatedHeight: 1_600, EstimatedTime: 53_000, Amount: sdk.NewCoins(sdk.NewInt64Coin("ngonka", 80))},
+		{EpochIndex: 8, EstimatedHeight: 1_800, EstimatedTime: 54_000, Amount: sdk.NewCoins(sdk.NewInt64Coin("ngonka", 40))},
+	}, resp.Unlocks)
+}
+
+func epochCoins(amounts ...int64) []types.EpochCoins {
+	epochs := make([]types.EpochCoins, 0, len(amounts))
+	for _, amount := range amounts {
+		epochs = append(epochs, types.EpochCoins{Coins: sdk.NewCoins(sdk.NewInt64Coin("ngonka", amount))})
+	}
+	return epochs
+}
+
+func epochAmounts(schedule types.VestingSchedule) []int64 {
+	amounts := make([]int64, 0, len(schedule.EpochAmounts))
+	for _, epochAmount := range schedule.EpochAmounts {
+		amounts = append(amounts, epochAmount.Coins.AmountOf("ngonka").Int64())
+	}
+	return amounts
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -69,3 +70,76 @@ func (k Keeper) TotalVestingAmount(goCtx context.Context, req *types.QueryTotalV
 		TotalAmount: totalAmount,
 	}, nil
 }
+
+// ProjectedUnlocks queries the upcoming unlocks of a participant, or of all participants if none is given
+func (k Keeper) ProjectedUnlocks(goCtx context.Context, req *types.QueryProjectedUnlocksRequest) (*types.QueryProjectedUnlocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var schedules []types.VestingSchedule
+	if req.ParticipantAddress == "" {
+		schedules = k.GetAllVestingSchedules(ctx)
+	} else if schedule, found := k.GetVestingSchedule(ctx, req.ParticipantAddress); found {
+		schedules = []types.VestingSchedule{schedule}
+	}
+
+	return &types.QueryProjectedUnlocksResponse{
+		Unlocks: k.ProjectUnlocks(ctx, schedules),
+	}, nil
+}
+
+// UnlockHistory queries the unlocks of past epochs
+func (k Keeper) UnlockHistory(goCtx context.Context, req *types.QueryUnlockHistoryRequest) (*types.QueryUnlockHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	unlocks, pageRes, err := query.CollectionPaginate(ctx, k.EpochUnlocks, req.Pagination,
+		func(_ uint64, unlock types.EpochUnlock) (types.EpochUnlock, error) {
+			return unlock, nil
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnlockHistoryResponse{
+		Unlocks:    unlocks,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SimulateAcceleration shows what accelerating the next epochs of a participant's schedule would pay and cost
+func (k Keeper) SimulateAcceleration(goCtx context.Context, req *types.QuerySimulateAccelerationRequest) (*types.QuerySimulateAccelerationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ParticipantAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "participant address cannot be empty")
+	}
+	if req.Epochs == 0 {
+		return nil, status.Error(codes.InvalidArgument, "epochs must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	schedule, found := k.GetVestingSchedule(ctx, req.ParticipantAddress)
+	if !found {
+		return nil, status.Error(codes.NotFound, "vesting schedule not found")
+	}
+	quote := QuoteAcceleration(schedule, req.Epochs, params.AccelerationPenalty)
+
+	return &types.QuerySimulateAccelerationResponse{
+		Unlocked:           quote.Unlocked,
+		Penalty:            quote.Penalty,
+		Paid:               quote.Paid,
+		Epochs:             uint64(quote.Epochs),
+		PenaltyDestination: penaltyDestination(params),
+	}, nil
+}
@@ -33,6 +33,25 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "participant_address"},
 					},
 				},
+				{
+					RpcMethod: "ProjectedUnlocks",
+					Use:       "projected-unlocks",
+					Short:     "Shows the upcoming unlocks of a participant, or of all participants",
+				},
+				{
+					RpcMethod: "UnlockHistory",
+					Use:       "unlock-history",
+					Short:     "Shows the unlocks of past epochs",
+				},
+				{
+					RpcMethod: "SimulateAcceleration",
+					Use:       "simulate-acceleration [participant-address] [epochs]",
+					Short:     "Shows what unlocking the next epochs of a vesting schedule right away would pay and cost",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "participant_address"},
+						{ProtoField: "epochs"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -44,6 +63,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "TransferVestingSchedule",
+					Use:       "transfer-vesting-schedule [recipient]",
+					Short:     "Moves the upcoming epochs of your vesting schedule to another address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "recipient"},
+					},
+				},
+				{
+					RpcMethod: "AccelerateVesting",
+					Use:       "accelerate-vesting [epochs]",
+					Short:     "Unlocks the next epochs of your vesting schedule right away, less the acceleration penalty",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "epochs"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -13,6 +13,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.VestingScheduleList {
 		k.SetVestingSchedule(ctx, elem)
 	}
+	for _, unlock := range genState.EpochUnlocks {
+		k.SetEpochUnlock(ctx, unlock)
+	}
 
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
@@ -28,6 +31,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// Export all vesting schedules
 	vestingSchedules := k.GetAllVestingSchedules(ctx)
 	genesis.VestingScheduleList = vestingSchedules
+	genesis.EpochUnlocks = k.GetAllEpochUnlocks(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
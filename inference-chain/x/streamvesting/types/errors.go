@@ -10,4 +10,9 @@ import (
 var (
 	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+
+	ErrScheduleTransfersDisabled = sdkerrors.Register(ModuleName, 1102, "vesting schedule transfers are disabled")
+	ErrVestingScheduleNotFound   = sdkerrors.Register(ModuleName, 1103, "vesting schedule not found")
+	ErrInvalidScheduleTransfer   = sdkerrors.Register(ModuleName, 1104, "invalid vesting schedule transfer")
+	ErrInvalidAcceleration       = sdkerrors.Register(ModuleName, 1105, "invalid vesting acceleration")
 )
@@ -4,6 +4,8 @@ package types
 const (
 	EventTypeVestReward   = "vest_reward"
 	EventTypeUnlockTokens = "unlock_tokens"
+	EventTypeTransferVest = "transfer_vesting_schedule"
+	EventTypeAccelerate   = "accelerate_vesting"
 )
 
 // Event attributes
@@ -13,4 +15,7 @@ const (
 	AttributeKeyVestingEpochs  = "vesting_epochs"
 	AttributeKeyUnlockedAmount = "unlocked_amount"
 	AttributeKeyEpoch          = "epoch"
+	AttributeKeyRecipient      = "recipient"
+	AttributeKeyPenalty        = "penalty"
+	AttributeKeyPenaltyDest    = "penalty_destination"
 )
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -16,6 +18,13 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	epochs := make(map[uint64]bool)
+	for _, unlock := range gs.EpochUnlocks {
+		if epochs[unlock.EpochIndex] {
+			return fmt.Errorf("duplicate epoch unlock for epoch %d", unlock.EpochIndex)
+		}
+		epochs[unlock.EpochIndex] = true
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -30,6 +30,7 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// vesting_schedule_list contains all vesting schedules
 	VestingScheduleList []VestingSchedule `protobuf:"bytes,2,rep,name=vesting_schedule_list,json=vestingScheduleList,proto3" json:"vesting_schedule_list"`
+	EpochUnlocks        []EpochUnlock     `protobuf:"bytes,3,rep,name=epoch_unlocks,json=epochUnlocks,proto3" json:"epoch_unlocks"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +80,13 @@ func (m *GenesisState) GetVestingScheduleList() []VestingSchedule {
 	return nil
 }
 
+func (m *GenesisState) GetEpochUnlocks() []EpochUnlock {
+	if m != nil {
+		return m.EpochUnlocks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "inference.streamvesting.GenesisState")
 }
@@ -88,25 +96,27 @@ func init() {
 }
 
 var fileDescriptor_1ef629f2498081bf = []byte{
-	// 278 bytes of a gzipped FileDescriptorProto
+	// 318 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0xcc, 0x4b, 0x4b,
 	0x2d, 0x4a, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x2e, 0x29, 0x4a, 0x4d, 0xcc, 0x2d, 0x4b, 0x2d, 0x2e,
 	0xc9, 0xcc, 0x4b, 0xd7, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x12, 0x87, 0x2b, 0xd3, 0x43, 0x51, 0x26, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf,
 	0x0f, 0x26, 0x21, 0x6a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x2a,
 	0xaa, 0x82, 0xcb, 0xa2, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x3d, 0x52, 0x7a, 0xb8, 0x54, 0x41,
-	0xe9, 0xf8, 0xe2, 0xe4, 0x8c, 0xd4, 0x94, 0xd2, 0x9c, 0x54, 0x88, 0x7a, 0xa5, 0xdd, 0x8c, 0x5c,
-	0x3c, 0xee, 0x10, 0x97, 0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x39, 0x71, 0xb1, 0x41, 0x0c, 0x94,
-	0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd7, 0xc3, 0xe1, 0x72, 0xbd, 0x00, 0xb0, 0x32, 0x27,
-	0xce, 0x13, 0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd5, 0x29, 0x94, 0xce,
-	0x25, 0x8a, 0x6e, 0x5d, 0x7c, 0x4e, 0x66, 0x71, 0x89, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7, 0x91,
-	0x06, 0x4e, 0x23, 0xc3, 0x20, 0x74, 0x30, 0x54, 0x13, 0xb2, 0xd9, 0xc2, 0x65, 0xa8, 0x72, 0x3e,
-	0x99, 0xc5, 0x25, 0x4e, 0xc1, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91,
-	0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65,
-	0x99, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x5f, 0x50, 0x94, 0x9f, 0x52,
-	0x9a, 0x5c, 0x52, 0x9c, 0x9c, 0x09, 0x0e, 0x17, 0x44, 0x08, 0x55, 0xa0, 0x85, 0x51, 0x49, 0x65,
-	0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x64, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbb, 0x8e,
-	0xe7, 0xe8, 0xda, 0x01, 0x00, 0x00,
+	0xe9, 0xf8, 0xe2, 0xe4, 0x8c, 0xd4, 0x94, 0xd2, 0x9c, 0x54, 0x88, 0x7a, 0xa5, 0x1e, 0x26, 0x2e,
+	0x1e, 0x77, 0x88, 0x4b, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x9c, 0xb8, 0xd8, 0x20, 0x06, 0x4a,
+	0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xeb, 0xe1, 0x70, 0xb9, 0x5e, 0x00, 0x58, 0x99, 0x13,
+	0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0x82, 0xea, 0x14, 0x4a, 0xe7,
+	0x12, 0x45, 0xb7, 0x2e, 0x3e, 0x27, 0xb3, 0xb8, 0x44, 0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48,
+	0x03, 0xa7, 0x91, 0x61, 0x10, 0x3a, 0x18, 0xaa, 0x09, 0xd9, 0x6c, 0xe1, 0x32, 0x54, 0x39, 0x9f,
+	0xcc, 0xe2, 0x12, 0x21, 0x7f, 0x2e, 0xde, 0xd4, 0x82, 0xfc, 0xe4, 0x8c, 0xf8, 0xd2, 0xbc, 0x9c,
+	0xfc, 0xe4, 0xec, 0x62, 0x09, 0x66, 0xb0, 0x05, 0x2a, 0x38, 0x2d, 0x70, 0x05, 0xa9, 0x0e, 0x05,
+	0x2b, 0x76, 0x62, 0x01, 0x19, 0x1e, 0xc4, 0x93, 0x8a, 0x10, 0x2a, 0x76, 0x0a, 0x3e, 0xf1, 0x48,
+	0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0,
+	0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xcb, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd,
+	0xe4, 0xfc, 0x5c, 0xfd, 0x82, 0xa2, 0xfc, 0x94, 0xd2, 0xe4, 0x92, 0xe2, 0xe4, 0x4c, 0x70, 0x40,
+	0x23, 0x82, 0xbc, 0x02, 0x2d, 0xd0, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x41, 0x6d,
+	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xff, 0x2e, 0x28, 0x2b, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +139,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EpochUnlocks) > 0 {
+		for iNdEx := len(m.EpochUnlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochUnlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.VestingScheduleList) > 0 {
 		for iNdEx := len(m.VestingScheduleList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -181,6 +205,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.EpochUnlocks) > 0 {
+		for _, e := range m.EpochUnlocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -286,6 +316,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochUnlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochUnlocks = append(m.EpochUnlocks, EpochUnlock{})
+			if err := m.EpochUnlocks[len(m.EpochUnlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
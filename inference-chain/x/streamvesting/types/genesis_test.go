@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
 	"github.com/productscience/inference/x/streamvesting/types"
 	"github.com/stretchr/testify/require"
 )
@@ -26,6 +28,21 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated epoch unlock",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				EpochUnlocks: []types.EpochUnlock{{EpochIndex: 3}, {EpochIndex: 3}},
+			},
+			valid: false,
+		},
+		{
+			desc: "acceleration penalty of one",
+			genState: &types.GenesisState{
+				Params: types.NewParams(180, false, math.LegacyOneDec(), false),
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -19,4 +19,7 @@ var (
 
 	// VestingScheduleKey is the collections prefix for storing vesting schedules (Map)
 	VestingScheduleKey = collections.NewPrefix(1)
+
+	// EpochUnlockKey is the collections prefix for the unlock history by epoch (Map)
+	EpochUnlockKey = collections.NewPrefix(2)
 )
@@ -0,0 +1,26 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAccelerateVesting{}
+
+func NewMsgAccelerateVesting(creator string, epochs uint64) *MsgAccelerateVesting {
+	return &MsgAccelerateVesting{
+		Creator: creator,
+		Epochs:  epochs,
+	}
+}
+
+func (msg *MsgAccelerateVesting) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Epochs == 0 {
+		return errorsmod.Wrap(ErrInvalidAcceleration, "epochs must be positive")
+	}
+	return nil
+}
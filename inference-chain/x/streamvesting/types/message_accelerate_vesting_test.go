@@ -0,0 +1,41 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/testutil/sample"
+)
+
+func TestMsgAccelerateVesting_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAccelerateVesting
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg:  MsgAccelerateVesting{Creator: "invalid_address", Epochs: 1},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no epochs",
+			msg:  MsgAccelerateVesting{Creator: sample.AccAddress()},
+			err:  ErrInvalidAcceleration,
+		}, {
+			name: "valid",
+			msg:  MsgAccelerateVesting{Creator: sample.AccAddress(), Epochs: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgTransferVestingSchedule{}
+
+func NewMsgTransferVestingSchedule(creator string, recipient string, epochs uint64) *MsgTransferVestingSchedule {
+	return &MsgTransferVestingSchedule{
+		Creator:   creator,
+		Recipient: recipient,
+		Epochs:    epochs,
+	}
+}
+
+func (msg *MsgTransferVestingSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if msg.Creator == msg.Recipient {
+		return errorsmod.Wrap(ErrInvalidScheduleTransfer, "recipient is the creator")
+	}
+	return nil
+}
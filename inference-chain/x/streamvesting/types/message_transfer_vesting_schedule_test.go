@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/testutil/sample"
+)
+
+func TestMsgTransferVestingSchedule_ValidateBasic(t *testing.T) {
+	creator := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgTransferVestingSchedule
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg:  MsgTransferVestingSchedule{Creator: "invalid_address", Recipient: sample.AccAddress()},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid recipient",
+			msg:  MsgTransferVestingSchedule{Creator: creator, Recipient: "invalid_address"},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "recipient is creator",
+			msg:  MsgTransferVestingSchedule{Creator: creator, Recipient: creator},
+			err:  ErrInvalidScheduleTransfer,
+		}, {
+			name: "valid",
+			msg:  MsgTransferVestingSchedule{Creator: creator, Recipient: sample.AccAddress(), Epochs: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -10,7 +11,10 @@ var _ paramtypes.ParamSet = (*Params)(nil)
 
 // Parameter store keys
 var (
-	KeyRewardVestingPeriod = []byte("RewardVestingPeriod")
+	KeyRewardVestingPeriod    = []byte("RewardVestingPeriod")
+	KeyAllowScheduleTransfers = []byte("AllowScheduleTransfers")
+	KeyAccelerationPenalty    = []byte("AccelerationPenalty")
+	KeyPenaltyToCommunityPool = []byte("PenaltyToCommunityPool")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -19,21 +23,28 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(rewardVestingPeriod uint64) Params {
+func NewParams(rewardVestingPeriod uint64, allowScheduleTransfers bool, accelerationPenalty math.LegacyDec, penaltyToCommunityPool bool) Params {
 	return Params{
-		RewardVestingPeriod: rewardVestingPeriod,
+		RewardVestingPeriod:    rewardVestingPeriod,
+		AllowScheduleTransfers: allowScheduleTransfers,
+		AccelerationPenalty:    accelerationPenalty,
+		PenaltyToCommunityPool: penaltyToCommunityPool,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(180) // Default 180 epochs
+	// Default 180 epochs, transfers enabled by governance, a fifth of an accelerated unlock burned
+	return NewParams(180, false, math.LegacyNewDecWithPrec(2, 1), false)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyRewardVestingPeriod, &p.RewardVestingPeriod, validateRewardVestingPeriod),
+		paramtypes.NewParamSetPair(KeyAllowScheduleTransfers, &p.AllowScheduleTransfers, validateAllowScheduleTransfers),
+		paramtypes.NewParamSetPair(KeyAccelerationPenalty, &p.AccelerationPenalty, validateAccelerationPenalty),
+		paramtypes.NewParamSetPair(KeyPenaltyToCommunityPool, &p.PenaltyToCommunityPool, validatePenaltyToCommunityPool),
 	}
 }
 
@@ -42,6 +53,9 @@ func (p Params) Validate() error {
 	if err := validateRewardVestingPeriod(p.RewardVestingPeriod); err != nil {
 		return err
 	}
+	if err := validateAccelerationPenalty(p.AccelerationPenalty); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -58,3 +72,37 @@ func validateRewardVestingPeriod(v interface{}) error {
 
 	return nil
 }
+
+// validateAllowScheduleTransfers validates the AllowScheduleTransfers param
+func validateAllowScheduleTransfers(v interface{}) error {
+	if _, ok := v.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateAccelerationPenalty validates the AccelerationPenalty param
+func validateAccelerationPenalty(v interface{}) error {
+	penalty, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	// Params stored before the penalty existed have none until the v2 migration; acceleration charges the default
+	if penalty.IsNil() {
+		return nil
+	}
+	if penalty.IsNegative() || penalty.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("acceleration penalty must be in [0, 1): %s", penalty)
+	}
+
+	return nil
+}
+
+// validatePenaltyToCommunityPool validates the PenaltyToCommunityPool param
+func validatePenaltyToCommunityPool(v interface{}) error {
+	if _, ok := v.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
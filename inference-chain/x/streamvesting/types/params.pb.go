@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -29,6 +31,12 @@ type Params struct {
 	// reward_vesting_period defines how many epochs rewards vest for
 	// Default is 180 epochs, but can be overridden to 2 in tests
 	RewardVestingPeriod uint64 `protobuf:"varint,1,opt,name=reward_vesting_period,json=rewardVestingPeriod,proto3" json:"reward_vesting_period,omitempty"`
+	// allow_schedule_transfers lets participants move their vesting schedule to another address
+	AllowScheduleTransfers bool `protobuf:"varint,2,opt,name=allow_schedule_transfers,json=allowScheduleTransfers,proto3" json:"allow_schedule_transfers,omitempty"`
+	// acceleration_penalty is the fraction of an accelerated unlock that the participant gives up
+	AccelerationPenalty cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=acceleration_penalty,json=accelerationPenalty,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"acceleration_penalty"`
+	// penalty_to_community_pool sends acceleration penalties to the community pool instead of burning them
+	PenaltyToCommunityPool bool `protobuf:"varint,4,opt,name=penalty_to_community_pool,json=penaltyToCommunityPool,proto3" json:"penalty_to_community_pool,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -71,6 +79,20 @@ func (m *Params) GetRewardVestingPeriod() uint64 {
 	return 0
 }
 
+func (m *Params) GetAllowScheduleTransfers() bool {
+	if m != nil {
+		return m.AllowScheduleTransfers
+	}
+	return false
+}
+
+func (m *Params) GetPenaltyToCommunityPool() bool {
+	if m != nil {
+		return m.PenaltyToCommunityPool
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "inference.streamvesting.Params")
 }
@@ -80,21 +102,32 @@ func init() {
 }
 
 var fileDescriptor_dc75bb0dccfb5f98 = []byte{
-	// 221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0xcc, 0x4b, 0x4b,
-	0x2d, 0x4a, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x2e, 0x29, 0x4a, 0x4d, 0xcc, 0x2d, 0x4b, 0x2d, 0x2e,
-	0xc9, 0xcc, 0x4b, 0xd7, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x87, 0xab, 0xd2, 0x43, 0x51, 0x25, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f,
-	0x26, 0x21, 0x6a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa,
-	0x94, 0xce, 0xc5, 0x16, 0x00, 0x36, 0x51, 0xc8, 0x88, 0x4b, 0xb4, 0x28, 0xb5, 0x3c, 0xb1, 0x28,
-	0x25, 0x1e, 0x6a, 0x48, 0x7c, 0x41, 0x6a, 0x51, 0x66, 0x7e, 0x8a, 0x04, 0xa3, 0x02, 0xa3, 0x06,
-	0x4b, 0x90, 0x30, 0x44, 0x32, 0x0c, 0x22, 0x17, 0x00, 0x96, 0xb2, 0xd2, 0x7c, 0xb1, 0x40, 0x9e,
-	0xb1, 0xeb, 0xf9, 0x06, 0x2d, 0x05, 0x84, 0x73, 0x2b, 0xd0, 0x1c, 0x0c, 0x31, 0xde, 0x29, 0xf8,
-	0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x2c, 0xd3, 0x33, 0x4b, 0x32, 0x4a,
-	0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x0b, 0x8a, 0xf2, 0x53, 0x4a, 0x93, 0x4b, 0x8a, 0x93, 0x33,
-	0xc1, 0x66, 0xe1, 0x36, 0xb5, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x09, 0x63, 0x40,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xd6, 0x6d, 0x88, 0x2e, 0x01, 0x00, 0x00,
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xbf, 0x8e, 0xd3, 0x40,
+	0x10, 0xc6, 0xbd, 0xc7, 0xe9, 0x04, 0xee, 0xf0, 0x1d, 0xe0, 0x3b, 0x24, 0xc7, 0x42, 0x14, 0xe1,
+	0x24, 0x6c, 0x09, 0x24, 0xc4, 0x51, 0x1e, 0x29, 0x29, 0xac, 0x24, 0xa2, 0xa0, 0x59, 0x6d, 0xd6,
+	0x13, 0x67, 0x85, 0x77, 0xc7, 0xda, 0x5d, 0x13, 0xfc, 0x0a, 0x54, 0x3c, 0x02, 0x25, 0x65, 0x0a,
+	0xde, 0x81, 0x94, 0x11, 0x15, 0xa2, 0x88, 0x50, 0x52, 0x84, 0xc7, 0x40, 0x78, 0x1d, 0xfe, 0x49,
+	0xd7, 0xac, 0x76, 0xe6, 0xf7, 0xe9, 0x9b, 0x9d, 0xfd, 0xfc, 0xfb, 0x42, 0x4d, 0x41, 0x83, 0xe2,
+	0x90, 0x1a, 0xab, 0x81, 0xc9, 0x37, 0x60, 0xac, 0x50, 0x45, 0x5a, 0x31, 0xcd, 0xa4, 0x49, 0x2a,
+	0x8d, 0x16, 0x83, 0x3b, 0xbf, 0x55, 0xc9, 0x3f, 0xaa, 0xb3, 0x9b, 0x4c, 0x0a, 0x85, 0x69, 0x7b,
+	0x3a, 0xed, 0xd9, 0x29, 0x47, 0x23, 0xd1, 0xd0, 0xb6, 0x4a, 0x5d, 0xd1, 0xa1, 0x93, 0x02, 0x0b,
+	0x74, 0xfd, 0x5f, 0x37, 0xd7, 0xbd, 0xf7, 0xf9, 0xc0, 0x3f, 0xca, 0xda, 0x69, 0xc1, 0x23, 0xff,
+	0x96, 0x86, 0x39, 0xd3, 0x39, 0xed, 0x06, 0xd0, 0x0a, 0xb4, 0xc0, 0x3c, 0x24, 0x31, 0xe9, 0x1f,
+	0x0e, 0x8f, 0x1d, 0x7c, 0xe9, 0x58, 0xd6, 0xa2, 0xe0, 0xa9, 0x1f, 0xb2, 0xb2, 0xc4, 0x39, 0x35,
+	0x7c, 0x06, 0x79, 0x5d, 0x02, 0xb5, 0x9a, 0x29, 0x33, 0x05, 0x6d, 0xc2, 0x83, 0x98, 0xf4, 0xaf,
+	0x0f, 0x6f, 0xb7, 0x7c, 0xd4, 0xe1, 0xf1, 0x9e, 0x06, 0xc2, 0x3f, 0x61, 0x9c, 0x43, 0x09, 0x9a,
+	0x59, 0x81, 0x8a, 0x56, 0xa0, 0x58, 0x69, 0x9b, 0xf0, 0x5a, 0x4c, 0xfa, 0x37, 0x2e, 0x9f, 0x2c,
+	0xd7, 0x3d, 0xef, 0xdb, 0xba, 0x77, 0xd7, 0xad, 0x60, 0xf2, 0xd7, 0x89, 0xc0, 0x54, 0x32, 0x3b,
+	0x4b, 0x5e, 0x40, 0xc1, 0x78, 0x33, 0x00, 0xfe, 0xe5, 0xd3, 0x43, 0xbf, 0xdb, 0x70, 0x00, 0xfc,
+	0xe3, 0x6e, 0x71, 0x4e, 0x86, 0xc7, 0x7f, 0x7b, 0x66, 0xce, 0x32, 0xb8, 0xf0, 0x4f, 0x3b, 0x77,
+	0x6a, 0x91, 0x72, 0x94, 0xb2, 0x56, 0xc2, 0x36, 0xb4, 0x42, 0x2c, 0xc3, 0x43, 0xf7, 0xca, 0x4e,
+	0x30, 0xc6, 0xe7, 0x7b, 0x9c, 0x21, 0x96, 0xcf, 0x1e, 0xfc, 0xf8, 0xd0, 0x23, 0xef, 0x76, 0x8b,
+	0xf3, 0xf8, 0x4f, 0x54, 0x6f, 0xff, 0x0b, 0xcb, 0x7d, 0xdf, 0xe5, 0x68, 0xb9, 0x89, 0xc8, 0x6a,
+	0x13, 0x91, 0xef, 0x9b, 0x88, 0xbc, 0xdf, 0x46, 0xde, 0x6a, 0x1b, 0x79, 0x5f, 0xb7, 0x91, 0xf7,
+	0xea, 0xa2, 0x10, 0x76, 0x56, 0x4f, 0x12, 0x8e, 0x32, 0xad, 0x34, 0xe6, 0x35, 0xb7, 0x86, 0x8b,
+	0xd6, 0xeb, 0x6a, 0x57, 0xdb, 0x54, 0x60, 0x26, 0x47, 0x6d, 0x4a, 0x8f, 0x7f, 0x06, 0x00, 0x00,
+	0xff, 0xff, 0x29, 0x95, 0x7b, 0x36, 0x2a, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -119,6 +152,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.RewardVestingPeriod != that1.RewardVestingPeriod {
 		return false
 	}
+	if this.AllowScheduleTransfers != that1.AllowScheduleTransfers {
+		return false
+	}
+	if !this.AccelerationPenalty.Equal(that1.AccelerationPenalty) {
+		return false
+	}
+	if this.PenaltyToCommunityPool != that1.PenaltyToCommunityPool {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -141,6 +183,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PenaltyToCommunityPool {
+		i--
+		if m.PenaltyToCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.AccelerationPenalty.Size()
+		i -= size
+		if _, err := m.AccelerationPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.AllowScheduleTransfers {
+		i--
+		if m.AllowScheduleTransfers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.RewardVestingPeriod != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.RewardVestingPeriod))
 		i--
@@ -169,6 +241,14 @@ func (m *Params) Size() (n int) {
 	if m.RewardVestingPeriod != 0 {
 		n += 1 + sovParams(uint64(m.RewardVestingPeriod))
 	}
+	if m.AllowScheduleTransfers {
+		n += 2
+	}
+	l = m.AccelerationPenalty.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.PenaltyToCommunityPool {
+		n += 2
+	}
 	return n
 }
 
@@ -226,6 +306,80 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowScheduleTransfers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowScheduleTransfers = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccelerationPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccelerationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyToCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PenaltyToCommunityPool = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -8,7 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -296,6 +296,321 @@ func (m *QueryTotalVestingAmountResponse) GetTotalAmount() github_com_cosmos_cos
 	return nil
 }
 
+type QueryProjectedUnlocksRequest struct {
+	ParticipantAddress string `protobuf:"bytes,1,opt,name=participant_address,json=participantAddress,proto3" json:"participant_address,omitempty"`
+}
+
+func (m *QueryProjectedUnlocksRequest) Reset()         { *m = QueryProjectedUnlocksRequest{} }
+func (m *QueryProjectedUnlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedUnlocksRequest) ProtoMessage()    {}
+func (*QueryProjectedUnlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f667e3499a650297, []int{6}
+}
+func (m *QueryProjectedUnlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedUnlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedUnlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedUnlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedUnlocksRequest.Merge(m, src)
+}
+func (m *QueryProjectedUnlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedUnlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedUnlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedUnlocksRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedUnlocksRequest) GetParticipantAddress() string {
+	if m != nil {
+		return m.ParticipantAddress
+	}
+	return ""
+}
+
+type QueryProjectedUnlocksResponse struct {
+	Unlocks []ProjectedUnlock `protobuf:"bytes,1,rep,name=unlocks,proto3" json:"unlocks"`
+}
+
+func (m *QueryProjectedUnlocksResponse) Reset()         { *m = QueryProjectedUnlocksResponse{} }
+func (m *QueryProjectedUnlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedUnlocksResponse) ProtoMessage()    {}
+func (*QueryProjectedUnlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f667e3499a650297, []int{7}
+}
+func (m *QueryProjectedUnlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedUnlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedUnlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedUnlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedUnlocksResponse.Merge(m, src)
+}
+func (m *QueryProjectedUnlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedUnlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedUnlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedUnlocksResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedUnlocksResponse) GetUnlocks() []ProjectedUnlock {
+	if m != nil {
+		return m.Unlocks
+	}
+	return nil
+}
+
+type QueryUnlockHistoryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnlockHistoryRequest) Reset()         { *m = QueryUnlockHistoryRequest{} }
+func (m *QueryUnlockHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlockHistoryRequest) ProtoMessage()    {}
+func (*QueryUnlockHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f667e3499a650297, []int{8}
+}
+func (m *QueryUnlockHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlockHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlockHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnlockHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlockHistoryRequest.Merge(m, src)
+}
+func (m *QueryUnlockHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlockHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlockHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnlockHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryUnlockHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryUnlockHistoryResponse struct {
+	Unlocks    []EpochUnlock       `protobuf:"bytes,1,rep,name=unlocks,proto3" json:"unlocks"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnlockHistoryResponse) Reset()         { *m = QueryUnlockHistoryResponse{} }
+func (m *QueryUnlockHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnlockHistoryResponse) ProtoMessage()    {}
+func (*QueryUnlockHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f667e3499a650297, []int{9}
+}
+func (m *QueryUnlockHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnlockHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnlockHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnlockHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnlockHistoryResponse.Merge(m, src)
+}
+func (m *QueryUnlockHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnlockHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnlockHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnlockHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryUnlockHistoryResponse) GetUnlocks() []EpochUnlock {
+	if m != nil {
+		return m.Unlocks
+	}
+	return nil
+}
+
+func (m *QueryUnlockHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySimulateAccelerationRequest struct {
+	ParticipantAddress string `protobuf:"bytes,1,opt,name=participant_address,json=participantAddress,proto3" json:"participant_address,omitempty"`
+	Epochs             uint64 `protobuf:"varint,2,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *QuerySimulateAccelerationRequest) Reset()         { *m = QuerySimulateAccelerationRequest{} }
+func (m *QuerySimulateAccelerationRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateAccelerationRequest) ProtoMessage()    {}
+func (*QuerySimulateAccelerationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f667e3499a650297, []int{10}
+}
+func (m *QuerySimulateAccelerationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateAccelerationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateAccelerationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateAccelerationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateAccelerationRequest.Merge(m, src)
+}
+func (m *QuerySimulateAccelerationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateAccelerationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateAccelerationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateAccelerationRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateAccelerationRequest) GetParticipantAddress() string {
+	if m != nil {
+		return m.ParticipantAddress
+	}
+	return ""
+}
+
+func (m *QuerySimulateAccelerationRequest) GetEpochs() uint64 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+type QuerySimulateAccelerationResponse struct {
+	// unlocked is the amount of the accelerated epochs before the penalty
+	Unlocked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=unlocked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked"`
+	Penalty  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=penalty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"penalty"`
+	Paid     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=paid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid"`
+	// epochs is the number of epochs that would be unlocked, at most the length of the schedule
+	Epochs uint64 `protobuf:"varint,4,opt,name=epochs,proto3" json:"epochs,omitempty"`
+	// penalty_destination is "burn" or "community_pool"
+	PenaltyDestination string `protobuf:"bytes,5,opt,name=penalty_destination,json=penaltyDestination,proto3" json:"penalty_destination,omitempty"`
+}
+
+func (m *QuerySimulateAccelerationResponse) Reset()         { *m = QuerySimulateAccelerationResponse{} }
+func (m *QuerySimulateAccelerationResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateAccelerationResponse) ProtoMessage()    {}
+func (*QuerySimulateAccelerationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f667e3499a650297, []int{11}
+}
+func (m *QuerySimulateAccelerationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateAccelerationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateAccelerationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateAccelerationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateAccelerationResponse.Merge(m, src)
+}
+func (m *QuerySimulateAccelerationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateAccelerationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateAccelerationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateAccelerationResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateAccelerationResponse) GetUnlocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unlocked
+	}
+	return nil
+}
+
+func (m *QuerySimulateAccelerationResponse) GetPenalty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Penalty
+	}
+	return nil
+}
+
+func (m *QuerySimulateAccelerationResponse) GetPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Paid
+	}
+	return nil
+}
+
+func (m *QuerySimulateAccelerationResponse) GetEpochs() uint64 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+func (m *QuerySimulateAccelerationResponse) GetPenaltyDestination() string {
+	if m != nil {
+		return m.PenaltyDestination
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "inference.streamvesting.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "inference.streamvesting.QueryParamsResponse")
@@ -303,6 +618,12 @@ func init() {
 	proto.RegisterType((*QueryVestingScheduleResponse)(nil), "inference.streamvesting.QueryVestingScheduleResponse")
 	proto.RegisterType((*QueryTotalVestingAmountRequest)(nil), "inference.streamvesting.QueryTotalVestingAmountRequest")
 	proto.RegisterType((*QueryTotalVestingAmountResponse)(nil), "inference.streamvesting.QueryTotalVestingAmountResponse")
+	proto.RegisterType((*QueryProjectedUnlocksRequest)(nil), "inference.streamvesting.QueryProjectedUnlocksRequest")
+	proto.RegisterType((*QueryProjectedUnlocksResponse)(nil), "inference.streamvesting.QueryProjectedUnlocksResponse")
+	proto.RegisterType((*QueryUnlockHistoryRequest)(nil), "inference.streamvesting.QueryUnlockHistoryRequest")
+	proto.RegisterType((*QueryUnlockHistoryResponse)(nil), "inference.streamvesting.QueryUnlockHistoryResponse")
+	proto.RegisterType((*QuerySimulateAccelerationRequest)(nil), "inference.streamvesting.QuerySimulateAccelerationRequest")
+	proto.RegisterType((*QuerySimulateAccelerationResponse)(nil), "inference.streamvesting.QuerySimulateAccelerationResponse")
 }
 
 func init() {
@@ -310,44 +631,66 @@ func init() {
 }
 
 var fileDescriptor_f667e3499a650297 = []byte{
-	// 583 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xce, 0xf8, 0x51, 0xe8, 0x44, 0xa8, 0x4e, 0x0b, 0xd6, 0x58, 0x36, 0x65, 0xf5, 0x10, 0xaa,
-	0xce, 0xd8, 0x68, 0xfd, 0x38, 0x36, 0x1e, 0xc5, 0xd2, 0x26, 0x52, 0xd0, 0x4b, 0x98, 0x6c, 0xc6,
-	0xed, 0x60, 0x76, 0x66, 0xbb, 0x33, 0x1b, 0x2c, 0xe2, 0xc5, 0x5f, 0x20, 0x08, 0x5e, 0xfc, 0x03,
-	0xe2, 0xc9, 0x9f, 0xd1, 0x63, 0x41, 0x10, 0x0f, 0x7e, 0x91, 0x08, 0xfe, 0x0d, 0xd9, 0x99, 0x89,
-	0x24, 0x69, 0x36, 0xa4, 0x5e, 0xb2, 0x9b, 0xf7, 0x7d, 0xde, 0xf7, 0x79, 0x9e, 0x99, 0x87, 0x85,
-	0x57, 0xb8, 0x78, 0xc6, 0x12, 0x26, 0x02, 0x46, 0x94, 0x4e, 0x18, 0x8d, 0xba, 0x4c, 0x69, 0x2e,
-	0x42, 0xb2, 0x9f, 0xb2, 0xe4, 0x00, 0xc7, 0x89, 0xd4, 0x12, 0x5d, 0xfc, 0x07, 0xc2, 0x23, 0xa0,
-	0xd2, 0x05, 0x1a, 0x71, 0x21, 0x89, 0xf9, 0xb5, 0xd8, 0xd2, 0x52, 0x28, 0x43, 0x69, 0x5e, 0x49,
-	0xf6, 0xe6, 0xaa, 0x2b, 0xa1, 0x94, 0x61, 0x87, 0x11, 0x1a, 0x73, 0x42, 0x85, 0x90, 0x9a, 0x6a,
-	0x2e, 0x85, 0x72, 0xdd, 0xb5, 0x40, 0xaa, 0x48, 0x2a, 0xd2, 0xa2, 0x8a, 0x59, 0x62, 0xd2, 0x5d,
-	0x6f, 0x31, 0x4d, 0xd7, 0x49, 0x4c, 0x43, 0x2e, 0x0c, 0xd8, 0x61, 0xbd, 0x61, 0xec, 0x00, 0x15,
-	0x48, 0x3e, 0xe8, 0x5f, 0xcd, 0x33, 0x14, 0xd3, 0x84, 0x46, 0x03, 0x46, 0x9c, 0x87, 0x72, 0xcf,
-	0xa6, 0x0a, 0xf6, 0x58, 0x3b, 0xed, 0x30, 0x8b, 0xf7, 0x97, 0x20, 0xda, 0xc9, 0x74, 0x6d, 0x9b,
-	0x25, 0x75, 0xb6, 0x9f, 0x32, 0xa5, 0xfd, 0x27, 0x70, 0x71, 0xa4, 0xaa, 0x62, 0x29, 0x14, 0x43,
-	0x35, 0x38, 0x67, 0xc9, 0x96, 0xc1, 0x2a, 0xa8, 0x14, 0xab, 0x65, 0x9c, 0x73, 0x7e, 0xd8, 0x0e,
-	0xd6, 0xe6, 0x0f, 0x7f, 0x94, 0x0b, 0x1f, 0xfe, 0x7c, 0x5a, 0x03, 0x75, 0x37, 0xe9, 0x6f, 0xc1,
-	0xcb, 0x66, 0xf5, 0xae, 0x45, 0x36, 0x9c, 0x1c, 0xc7, 0x8c, 0x08, 0x5c, 0x8c, 0x69, 0xa2, 0x79,
-	0xc0, 0x63, 0x2a, 0x74, 0x93, 0xb6, 0xdb, 0x09, 0x53, 0x96, 0x6f, 0xbe, 0x8e, 0x86, 0x5a, 0x9b,
-	0xb6, 0xe3, 0x2b, 0xb8, 0x32, 0x79, 0x9f, 0xd3, 0xdc, 0x80, 0xe7, 0xc7, 0xad, 0x3b, 0xf5, 0x95,
-	0x5c, 0xf5, 0xe3, 0xbb, 0x16, 0xba, 0xa3, 0x05, 0x7f, 0x07, 0x7a, 0x86, 0xf4, 0xb1, 0xd4, 0xb4,
-	0xe3, 0xd0, 0x9b, 0x91, 0x4c, 0x85, 0xfe, 0x6f, 0x1f, 0xef, 0x00, 0x2c, 0xe7, 0xee, 0x74, 0x5e,
-	0x14, 0x3c, 0xa7, 0xb3, 0x6e, 0x93, 0x9a, 0xfa, 0x32, 0x58, 0x3d, 0x5d, 0x29, 0x56, 0x2f, 0x61,
-	0x9b, 0x1c, 0x9c, 0x25, 0x07, 0xbb, 0xe4, 0xe0, 0x07, 0x92, 0x8b, 0xda, 0x46, 0x76, 0xfe, 0x1f,
-	0x7f, 0x96, 0x2b, 0x21, 0xd7, 0x7b, 0x69, 0x0b, 0x07, 0x32, 0x22, 0x2e, 0x66, 0xf6, 0x71, 0x43,
-	0xb5, 0x9f, 0x13, 0x7d, 0x10, 0x33, 0x65, 0x06, 0x94, 0xbd, 0xab, 0xa2, 0x61, 0xb1, 0xe4, 0xd5,
-	0xef, 0x67, 0xe0, 0x59, 0x23, 0x0c, 0xbd, 0x07, 0x70, 0xce, 0x5e, 0x2c, 0xba, 0x96, 0x7b, 0x76,
-	0xc7, 0xd3, 0x54, 0xba, 0x3e, 0x1b, 0xd8, 0x9a, 0xf4, 0xef, 0xbc, 0xfe, 0xfc, 0xfb, 0xed, 0xa9,
-	0x9b, 0x08, 0x93, 0x38, 0x91, 0xed, 0x34, 0xd0, 0x2a, 0xe0, 0x26, 0xcf, 0xd3, 0xf3, 0x8f, 0xbe,
-	0x00, 0xb8, 0x30, 0x76, 0x71, 0xe8, 0xf6, 0x74, 0xe6, 0xc9, 0x19, 0x2c, 0x6d, 0x9c, 0x70, 0xca,
-	0x09, 0xdf, 0x35, 0xc2, 0xb7, 0xd1, 0xd6, 0xac, 0xc2, 0xc7, 0x73, 0x49, 0x5e, 0x4e, 0x88, 0xcc,
-	0x2b, 0xf4, 0x0d, 0x40, 0x74, 0x3c, 0x14, 0xe8, 0xee, 0x74, 0x95, 0xb9, 0xd1, 0x2c, 0xdd, 0x3b,
-	0xf9, 0xa0, 0x73, 0xd8, 0x30, 0x0e, 0x1f, 0xa1, 0x87, 0xb3, 0x3a, 0xb4, 0x69, 0x1d, 0xfc, 0x9b,
-	0x68, 0xaf, 0xd6, 0x38, 0xec, 0x79, 0xe0, 0xa8, 0xe7, 0x81, 0x5f, 0x3d, 0x0f, 0xbc, 0xe9, 0x7b,
-	0x85, 0xa3, 0xbe, 0x57, 0xf8, 0xda, 0xf7, 0x0a, 0x4f, 0xef, 0x0f, 0xa5, 0x36, 0x97, 0xf0, 0xc5,
-	0x38, 0x65, 0x16, 0xe6, 0xd6, 0x9c, 0xf9, 0xba, 0xdd, 0xfa, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xbd,
-	0xfa, 0xe6, 0xad, 0x06, 0x06, 0x00, 0x00,
+	// 932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x8e, 0xdb, 0x6c, 0x96, 0x4e, 0x41, 0xbb, 0xcc, 0x56, 0x90, 0x35, 0x8b, 0x53, 0xcc, 0x0a,
+	0xa2, 0x05, 0x6c, 0x36, 0xcb, 0x96, 0x2d, 0x48, 0x48, 0x09, 0x0b, 0xac, 0x84, 0x58, 0xb2, 0x09,
+	0xac, 0x04, 0x42, 0x44, 0x13, 0x7b, 0x70, 0x66, 0xeb, 0x78, 0xbc, 0x9e, 0x71, 0x45, 0x54, 0xf5,
+	0x86, 0x27, 0x40, 0x42, 0xe2, 0x86, 0x17, 0x40, 0xc0, 0x05, 0x97, 0x3c, 0x00, 0x17, 0xbd, 0xac,
+	0x84, 0x40, 0x5c, 0x20, 0x40, 0x2d, 0x12, 0x0f, 0xc0, 0x0b, 0x20, 0xcf, 0x8c, 0xdb, 0xc4, 0xb5,
+	0xd3, 0x24, 0x52, 0x6f, 0x1a, 0x7b, 0xce, 0xcf, 0xf7, 0x7d, 0x73, 0x8e, 0xcf, 0x29, 0x78, 0x96,
+	0x04, 0x9f, 0xe1, 0x08, 0x07, 0x0e, 0xb6, 0x19, 0x8f, 0x30, 0x1a, 0x6e, 0x63, 0xc6, 0x49, 0xe0,
+	0xd9, 0x0f, 0x63, 0x1c, 0x8d, 0xac, 0x30, 0xa2, 0x9c, 0xc2, 0x27, 0x8f, 0x9c, 0xac, 0x09, 0x27,
+	0xfd, 0x71, 0x34, 0x24, 0x01, 0xb5, 0xc5, 0x5f, 0xe9, 0xab, 0xaf, 0x79, 0xd4, 0xa3, 0xe2, 0xd1,
+	0x4e, 0x9e, 0xd4, 0xe9, 0x15, 0x8f, 0x52, 0xcf, 0xc7, 0x36, 0x0a, 0x89, 0x8d, 0x82, 0x80, 0x72,
+	0xc4, 0x09, 0x0d, 0x98, 0xb2, 0x5e, 0x73, 0x28, 0x1b, 0x52, 0x66, 0xf7, 0x11, 0xc3, 0x12, 0xd8,
+	0xde, 0xbe, 0xde, 0xc7, 0x1c, 0x5d, 0xb7, 0x43, 0xe4, 0x91, 0x40, 0x38, 0x2b, 0x5f, 0x63, 0xdc,
+	0x37, 0xf5, 0x72, 0x28, 0x49, 0xed, 0x57, 0x8b, 0x04, 0x85, 0x28, 0x42, 0xc3, 0x14, 0xd1, 0x2a,
+	0xf2, 0x52, 0xbf, 0x3d, 0xe6, 0x0c, 0xb0, 0x1b, 0xfb, 0x58, 0xfa, 0x9b, 0x6b, 0x00, 0xde, 0x4b,
+	0x78, 0xb5, 0x45, 0x92, 0x0e, 0x7e, 0x18, 0x63, 0xc6, 0xcd, 0x8f, 0xc0, 0xa5, 0x89, 0x53, 0x16,
+	0xd2, 0x80, 0x61, 0xd8, 0x02, 0x15, 0x09, 0x56, 0xd5, 0xd6, 0xb5, 0xfa, 0x6a, 0xa3, 0x66, 0x15,
+	0xdc, 0x9f, 0x25, 0x03, 0x5b, 0x2b, 0x7b, 0x7f, 0xd6, 0x4a, 0xdf, 0xfe, 0xfb, 0xe3, 0x35, 0xad,
+	0xa3, 0x22, 0xcd, 0xbb, 0xe0, 0x29, 0x91, 0xfa, 0xbe, 0xf4, 0xec, 0x2a, 0x3a, 0x0a, 0x19, 0xda,
+	0xe0, 0x52, 0x88, 0x22, 0x4e, 0x1c, 0x12, 0xa2, 0x80, 0xf7, 0x90, 0xeb, 0x46, 0x98, 0x49, 0xbc,
+	0x95, 0x0e, 0x1c, 0x33, 0x35, 0xa5, 0xc5, 0x64, 0xe0, 0x4a, 0x7e, 0x3e, 0xc5, 0xb9, 0x0b, 0x2e,
+	0x66, 0xa5, 0x2b, 0xf6, 0xf5, 0x42, 0xf6, 0xd9, 0x5c, 0x17, 0xb6, 0x27, 0x0f, 0xcc, 0x7b, 0xc0,
+	0x10, 0xa0, 0x1f, 0x50, 0x8e, 0x7c, 0xe5, 0xdd, 0x1c, 0xd2, 0x38, 0xe0, 0x0b, 0xeb, 0xf8, 0x5a,
+	0x03, 0xb5, 0xc2, 0x9c, 0x4a, 0x0b, 0x03, 0x8f, 0xf2, 0xc4, 0xda, 0x43, 0xe2, 0xbc, 0xaa, 0xad,
+	0x2f, 0xd7, 0x57, 0x1b, 0x97, 0x2d, 0xd9, 0x39, 0x56, 0xd2, 0x39, 0x96, 0xea, 0x1c, 0xeb, 0x4d,
+	0x4a, 0x82, 0xd6, 0xcd, 0xe4, 0xfe, 0xbf, 0xfb, 0xab, 0x56, 0xf7, 0x08, 0x1f, 0xc4, 0x7d, 0xcb,
+	0xa1, 0x43, 0x5b, 0xb5, 0x99, 0xfc, 0x79, 0x89, 0xb9, 0x5b, 0x36, 0x1f, 0x85, 0x98, 0x89, 0x00,
+	0x26, 0x6b, 0xb5, 0x2a, 0x50, 0x24, 0xb8, 0xf9, 0xbe, 0xba, 0xe0, 0x76, 0x44, 0x1f, 0x60, 0x87,
+	0x63, 0xf7, 0xc3, 0xc0, 0xa7, 0xce, 0x16, 0x5b, 0x58, 0x29, 0x01, 0x4f, 0x17, 0x24, 0x54, 0x32,
+	0xef, 0x80, 0xf3, 0xb1, 0x3c, 0x52, 0x0a, 0x8b, 0x2b, 0x95, 0xc9, 0xd1, 0x2a, 0x27, 0x82, 0x3b,
+	0x69, 0xb8, 0xe9, 0x80, 0xcb, 0x02, 0x4a, 0x5a, 0xef, 0x10, 0xc6, 0x69, 0x34, 0x4a, 0x89, 0xbf,
+	0x0d, 0xc0, 0xf1, 0x47, 0xa8, 0x7a, 0xe2, 0xb9, 0x89, 0xbb, 0x94, 0xa3, 0x22, 0xbd, 0xd1, 0x36,
+	0xf2, 0xd2, 0x36, 0xed, 0x8c, 0x45, 0x9a, 0xdf, 0x6b, 0x40, 0xcf, 0x43, 0x51, 0x6a, 0x6e, 0x67,
+	0xd5, 0x5c, 0x2d, 0x54, 0xf3, 0x56, 0x48, 0x9d, 0x41, 0xae, 0x12, 0xf8, 0xce, 0x04, 0xd9, 0x25,
+	0x41, 0xf6, 0xf9, 0x53, 0xc9, 0x4a, 0x0a, 0x13, 0x6c, 0xb7, 0xc0, 0xba, 0x20, 0xdb, 0x25, 0xc3,
+	0xd8, 0x47, 0x1c, 0x37, 0x1d, 0x07, 0xfb, 0x38, 0x12, 0xc6, 0x45, 0x4b, 0x0a, 0x9f, 0x00, 0x15,
+	0x9c, 0x70, 0x67, 0x82, 0x59, 0xb9, 0xa3, 0xde, 0xcc, 0x1f, 0x96, 0xc1, 0x33, 0x53, 0xd0, 0xd4,
+	0x0d, 0xf9, 0xe0, 0x11, 0x29, 0x13, 0xbb, 0x67, 0xd6, 0xd2, 0x47, 0x08, 0xf0, 0x01, 0x38, 0x1f,
+	0xe2, 0x00, 0xf9, 0x7c, 0x54, 0x5d, 0x3a, 0x23, 0xb0, 0x14, 0x00, 0xba, 0xa0, 0x1c, 0x22, 0xe2,
+	0x56, 0x97, 0xcf, 0x08, 0x48, 0x64, 0x1f, 0xbb, 0xfd, 0xf2, 0xf8, 0xed, 0x8b, 0x32, 0x4a, 0x22,
+	0x3d, 0x57, 0x74, 0x98, 0x6c, 0x9e, 0x73, 0xaa, 0x8c, 0xd2, 0x74, 0xfb, 0xd8, 0xd2, 0xf8, 0x75,
+	0x05, 0x9c, 0x13, 0xe5, 0x82, 0xdf, 0x68, 0xa0, 0x22, 0x67, 0x38, 0x7c, 0xa1, 0xb0, 0x5d, 0x4f,
+	0x2e, 0x0e, 0xfd, 0xc5, 0xd9, 0x9c, 0x65, 0xe1, 0xcd, 0x8d, 0x2f, 0x7e, 0xf9, 0xe7, 0xab, 0xa5,
+	0x97, 0xa1, 0x65, 0x87, 0x11, 0x75, 0x63, 0x87, 0x33, 0x87, 0x88, 0xd5, 0x35, 0x7d, 0xd5, 0xc1,
+	0xdf, 0x34, 0x70, 0x21, 0x33, 0xa3, 0xe1, 0x2b, 0xd3, 0x91, 0xf3, 0xd7, 0x8d, 0x7e, 0x73, 0xce,
+	0x28, 0x45, 0xfc, 0xbe, 0x20, 0xde, 0x86, 0x77, 0x67, 0x25, 0x9e, 0x5d, 0x41, 0xf6, 0x4e, 0xce,
+	0x07, 0xb6, 0x0b, 0xff, 0xd0, 0x00, 0x3c, 0x39, 0xff, 0xe1, 0xab, 0xd3, 0x59, 0x16, 0x6e, 0x21,
+	0xfd, 0xd6, 0xfc, 0x81, 0x4a, 0x61, 0x57, 0x28, 0x7c, 0x0f, 0xbe, 0x3b, 0xab, 0x42, 0xb9, 0x98,
+	0xd2, 0xb7, 0x7c, 0x79, 0x3f, 0x6b, 0xe0, 0x62, 0x76, 0xea, 0xc3, 0x53, 0x4a, 0x50, 0xb0, 0x76,
+	0xf4, 0x8d, 0x79, 0xc3, 0x94, 0xb0, 0xa6, 0x10, 0xf6, 0x3a, 0xdc, 0x9c, 0xb9, 0xe7, 0xd2, 0x4c,
+	0xbd, 0x74, 0x16, 0xff, 0xa4, 0x81, 0xc7, 0x26, 0x66, 0x3d, 0x6c, 0x4c, 0x27, 0x93, 0xb7, 0x7e,
+	0xf4, 0x1b, 0x73, 0xc5, 0x28, 0xf6, 0x6f, 0x08, 0xf6, 0xb7, 0xe0, 0xc6, 0xac, 0xec, 0x25, 0xe7,
+	0xde, 0x40, 0x11, 0xfd, 0x4f, 0x03, 0x6b, 0x79, 0xb3, 0x18, 0x6e, 0x4e, 0x67, 0x33, 0x65, 0x5b,
+	0xe8, 0xaf, 0x2d, 0x12, 0xaa, 0xf4, 0xb8, 0x42, 0xcf, 0xa7, 0xf0, 0x93, 0x59, 0xf5, 0x30, 0x95,
+	0xad, 0x87, 0xc6, 0xd2, 0xe5, 0xb7, 0x9b, 0xbd, 0x23, 0xe7, 0xe0, 0x6e, 0xab, 0xbb, 0x77, 0x60,
+	0x68, 0xfb, 0x07, 0x86, 0xf6, 0xf7, 0x81, 0xa1, 0x7d, 0x79, 0x68, 0x94, 0xf6, 0x0f, 0x8d, 0xd2,
+	0xef, 0x87, 0x46, 0xe9, 0xe3, 0xcd, 0xb1, 0x79, 0x5b, 0xc8, 0xe0, 0xf3, 0x6c, 0xab, 0x27, 0x63,
+	0xb8, 0x5f, 0x11, 0xff, 0x40, 0xdf, 0xf8, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x20, 0xbe, 0xbd, 0x6a,
+	0x69, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -368,6 +711,12 @@ type QueryClient interface {
 	VestingSchedule(ctx context.Context, in *QueryVestingScheduleRequest, opts ...grpc.CallOption) (*QueryVestingScheduleResponse, error)
 	// TotalVestingAmount queries the total vesting amount for a participant.
 	TotalVestingAmount(ctx context.Context, in *QueryTotalVestingAmountRequest, opts ...grpc.CallOption) (*QueryTotalVestingAmountResponse, error)
+	// ProjectedUnlocks returns the upcoming unlocks of a participant, or of all participants if none is given.
+	ProjectedUnlocks(ctx context.Context, in *QueryProjectedUnlocksRequest, opts ...grpc.CallOption) (*QueryProjectedUnlocksResponse, error)
+	// UnlockHistory returns the unlocks of past epochs, oldest first.
+	UnlockHistory(ctx context.Context, in *QueryUnlockHistoryRequest, opts ...grpc.CallOption) (*QueryUnlockHistoryResponse, error)
+	// SimulateAcceleration shows what accelerating the next epochs of a participant's schedule would pay and cost.
+	SimulateAcceleration(ctx context.Context, in *QuerySimulateAccelerationRequest, opts ...grpc.CallOption) (*QuerySimulateAccelerationResponse, error)
 }
 
 type queryClient struct {
@@ -405,6 +754,33 @@ func (c *queryClient) TotalVestingAmount(ctx context.Context, in *QueryTotalVest
 	return out, nil
 }
 
+func (c *queryClient) ProjectedUnlocks(ctx context.Context, in *QueryProjectedUnlocksRequest, opts ...grpc.CallOption) (*QueryProjectedUnlocksResponse, error) {
+	out := new(QueryProjectedUnlocksResponse)
+	err := c.cc.Invoke(ctx, "/inference.streamvesting.Query/ProjectedUnlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnlockHistory(ctx context.Context, in *QueryUnlockHistoryRequest, opts ...grpc.CallOption) (*QueryUnlockHistoryResponse, error) {
+	out := new(QueryUnlockHistoryResponse)
+	err := c.cc.Invoke(ctx, "/inference.streamvesting.Query/UnlockHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateAcceleration(ctx context.Context, in *QuerySimulateAccelerationRequest, opts ...grpc.CallOption) (*QuerySimulateAccelerationResponse, error) {
+	out := new(QuerySimulateAccelerationResponse)
+	err := c.cc.Invoke(ctx, "/inference.streamvesting.Query/SimulateAcceleration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -413,6 +789,12 @@ type QueryServer interface {
 	VestingSchedule(context.Context, *QueryVestingScheduleRequest) (*QueryVestingScheduleResponse, error)
 	// TotalVestingAmount queries the total vesting amount for a participant.
 	TotalVestingAmount(context.Context, *QueryTotalVestingAmountRequest) (*QueryTotalVestingAmountResponse, error)
+	// ProjectedUnlocks returns the upcoming unlocks of a participant, or of all participants if none is given.
+	ProjectedUnlocks(context.Context, *QueryProjectedUnlocksRequest) (*QueryProjectedUnlocksResponse, error)
+	// UnlockHistory returns the unlocks of past epochs, oldest first.
+	UnlockHistory(context.Context, *QueryUnlockHistoryRequest) (*QueryUnlockHistoryResponse, error)
+	// SimulateAcceleration shows what accelerating the next epochs of a participant's schedule would pay and cost.
+	SimulateAcceleration(context.Context, *QuerySimulateAccelerationRequest) (*QuerySimulateAccelerationResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -428,6 +810,15 @@ func (*UnimplementedQueryServer) VestingSchedule(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) TotalVestingAmount(ctx context.Context, req *QueryTotalVestingAmountRequest) (*QueryTotalVestingAmountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalVestingAmount not implemented")
 }
+func (*UnimplementedQueryServer) ProjectedUnlocks(ctx context.Context, req *QueryProjectedUnlocksRequest) (*QueryProjectedUnlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedUnlocks not implemented")
+}
+func (*UnimplementedQueryServer) UnlockHistory(ctx context.Context, req *QueryUnlockHistoryRequest) (*QueryUnlockHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockHistory not implemented")
+}
+func (*UnimplementedQueryServer) SimulateAcceleration(ctx context.Context, req *QuerySimulateAccelerationRequest) (*QuerySimulateAccelerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateAcceleration not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -487,6 +878,60 @@ func _Query_TotalVestingAmount_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectedUnlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedUnlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedUnlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.streamvesting.Query/ProjectedUnlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedUnlocks(ctx, req.(*QueryProjectedUnlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnlockHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnlockHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnlockHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.streamvesting.Query/UnlockHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnlockHistory(ctx, req.(*QueryUnlockHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateAcceleration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateAccelerationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateAcceleration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.streamvesting.Query/SimulateAcceleration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateAcceleration(ctx, req.(*QuerySimulateAccelerationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.streamvesting.Query",
@@ -504,6 +949,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalVestingAmount",
 			Handler:    _Query_TotalVestingAmount_Handler,
 		},
+		{
+			MethodName: "ProjectedUnlocks",
+			Handler:    _Query_ProjectedUnlocks_Handler,
+		},
+		{
+			MethodName: "UnlockHistory",
+			Handler:    _Query_UnlockHistory_Handler,
+		},
+		{
+			MethodName: "SimulateAcceleration",
+			Handler:    _Query_SimulateAcceleration_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/streamvesting/query.proto",
@@ -697,8 +1154,271 @@ func (m *QueryTotalVestingAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
+func (m *QueryProjectedUnlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedUnlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedUnlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ParticipantAddress) > 0 {
+		i -= len(m.ParticipantAddress)
+		copy(dAtA[i:], m.ParticipantAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ParticipantAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedUnlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedUnlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedUnlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unlocks) > 0 {
+		for iNdEx := len(m.Unlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlockHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlockHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlockHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnlockHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnlockHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnlockHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Unlocks) > 0 {
+		for iNdEx := len(m.Unlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateAccelerationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateAccelerationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateAccelerationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ParticipantAddress) > 0 {
+		i -= len(m.ParticipantAddress)
+		copy(dAtA[i:], m.ParticipantAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ParticipantAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateAccelerationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateAccelerationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateAccelerationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PenaltyDestination) > 0 {
+		i -= len(m.PenaltyDestination)
+		copy(dAtA[i:], m.PenaltyDestination)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PenaltyDestination)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Epochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Paid) > 0 {
+		for iNdEx := len(m.Paid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Penalty) > 0 {
+		for iNdEx := len(m.Penalty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Penalty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Unlocked) > 0 {
+		for iNdEx := len(m.Unlocked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unlocked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -779,16 +1499,593 @@ func (m *QueryTotalVestingAmountResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryProjectedUnlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParticipantAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProjectedUnlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unlocks) > 0 {
+		for _, e := range m.Unlocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnlockHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnlockHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unlocks) > 0 {
+		for _, e := range m.Unlocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateAccelerationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParticipantAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovQuery(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *QuerySimulateAccelerationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unlocked) > 0 {
+		for _, e := range m.Unlocked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Penalty) > 0 {
+		for _, e := range m.Penalty {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Paid) > 0 {
+		for _, e := range m.Paid {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovQuery(uint64(m.Epochs))
+	}
+	l = len(m.PenaltyDestination)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VestingSchedule == nil {
+				m.VestingSchedule = &VestingSchedule{}
+			}
+			if err := m.VestingSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalVestingAmountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalVestingAmountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalVestingAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalVestingAmountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalVestingAmountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalVestingAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalAmount = append(m.TotalAmount, types.Coin{})
+			if err := m.TotalAmount[len(m.TotalAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectedUnlocksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -811,12 +2108,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectedUnlocksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectedUnlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -838,7 +2167,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectedUnlocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -861,15 +2190,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectedUnlocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectedUnlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -896,7 +2225,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unlocks = append(m.Unlocks, ProjectedUnlock{})
+			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -921,7 +2251,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVestingScheduleRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnlockHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -944,17 +2274,17 @@ func (m *QueryVestingScheduleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVestingScheduleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnlockHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVestingScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnlockHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -964,23 +2294,27 @@ func (m *QueryVestingScheduleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParticipantAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1003,7 +2337,7 @@ func (m *QueryVestingScheduleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVestingScheduleResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnlockHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1026,15 +2360,15 @@ func (m *QueryVestingScheduleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVestingScheduleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnlockHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVestingScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnlockHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1061,10 +2395,44 @@ func (m *QueryVestingScheduleResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VestingSchedule == nil {
-				m.VestingSchedule = &VestingSchedule{}
+			m.Unlocks = append(m.Unlocks, EpochUnlock{})
+			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.VestingSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1089,7 +2457,7 @@ func (m *QueryVestingScheduleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalVestingAmountRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateAccelerationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1112,10 +2480,10 @@ func (m *QueryTotalVestingAmountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalVestingAmountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateAccelerationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalVestingAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateAccelerationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1150,6 +2518,25 @@ func (m *QueryTotalVestingAmountRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ParticipantAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1171,7 +2558,7 @@ func (m *QueryTotalVestingAmountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalVestingAmountResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateAccelerationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1194,15 +2581,15 @@ func (m *QueryTotalVestingAmountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalVestingAmountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateAccelerationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalVestingAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateAccelerationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocked", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1229,11 +2616,130 @@ func (m *QueryTotalVestingAmountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalAmount = append(m.TotalAmount, types.Coin{})
-			if err := m.TotalAmount[len(m.TotalAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unlocked = append(m.Unlocked, types.Coin{})
+			if err := m.Unlocked[len(m.Unlocked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Penalty = append(m.Penalty, types.Coin{})
+			if err := m.Penalty[len(m.Penalty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paid = append(m.Paid, types.Coin{})
+			if err := m.Paid[len(m.Paid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PenaltyDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
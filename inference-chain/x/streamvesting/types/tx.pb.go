@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -126,38 +128,274 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgTransferVestingSchedule moves the upcoming epochs of the creator's vesting schedule to the recipient. They
+// keep unlocking at the same epochs.
+type MsgTransferVestingSchedule struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// epochs is the number of upcoming epochs to move, 0 moves all of them
+	Epochs uint64 `protobuf:"varint,3,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *MsgTransferVestingSchedule) Reset()         { *m = MsgTransferVestingSchedule{} }
+func (m *MsgTransferVestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferVestingSchedule) ProtoMessage()    {}
+func (*MsgTransferVestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f3863e46541da3, []int{2}
+}
+func (m *MsgTransferVestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferVestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferVestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferVestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferVestingSchedule.Merge(m, src)
+}
+func (m *MsgTransferVestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferVestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferVestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferVestingSchedule proto.InternalMessageInfo
+
+func (m *MsgTransferVestingSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgTransferVestingSchedule) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgTransferVestingSchedule) GetEpochs() uint64 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+type MsgTransferVestingScheduleResponse struct {
+	Transferred github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=transferred,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"transferred"`
+}
+
+func (m *MsgTransferVestingScheduleResponse) Reset()         { *m = MsgTransferVestingScheduleResponse{} }
+func (m *MsgTransferVestingScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferVestingScheduleResponse) ProtoMessage()    {}
+func (*MsgTransferVestingScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f3863e46541da3, []int{3}
+}
+func (m *MsgTransferVestingScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferVestingScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferVestingScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferVestingScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferVestingScheduleResponse.Merge(m, src)
+}
+func (m *MsgTransferVestingScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferVestingScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferVestingScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferVestingScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgTransferVestingScheduleResponse) GetTransferred() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Transferred
+	}
+	return nil
+}
+
+// MsgAccelerateVesting unlocks the next epochs of the creator's vesting schedule right away, less the
+// acceleration penalty.
+type MsgAccelerateVesting struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// epochs is the number of upcoming epochs to unlock
+	Epochs uint64 `protobuf:"varint,2,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *MsgAccelerateVesting) Reset()         { *m = MsgAccelerateVesting{} }
+func (m *MsgAccelerateVesting) String() string { return proto.CompactTextString(m) }
+func (*MsgAccelerateVesting) ProtoMessage()    {}
+func (*MsgAccelerateVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f3863e46541da3, []int{4}
+}
+func (m *MsgAccelerateVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAccelerateVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAccelerateVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAccelerateVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAccelerateVesting.Merge(m, src)
+}
+func (m *MsgAccelerateVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAccelerateVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAccelerateVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAccelerateVesting proto.InternalMessageInfo
+
+func (m *MsgAccelerateVesting) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAccelerateVesting) GetEpochs() uint64 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+type MsgAccelerateVestingResponse struct {
+	// paid is the amount sent to the creator
+	Paid    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=paid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid"`
+	Penalty github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=penalty,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"penalty"`
+}
+
+func (m *MsgAccelerateVestingResponse) Reset()         { *m = MsgAccelerateVestingResponse{} }
+func (m *MsgAccelerateVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAccelerateVestingResponse) ProtoMessage()    {}
+func (*MsgAccelerateVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f3863e46541da3, []int{5}
+}
+func (m *MsgAccelerateVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAccelerateVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAccelerateVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAccelerateVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAccelerateVestingResponse.Merge(m, src)
+}
+func (m *MsgAccelerateVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAccelerateVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAccelerateVestingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAccelerateVestingResponse proto.InternalMessageInfo
+
+func (m *MsgAccelerateVestingResponse) GetPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Paid
+	}
+	return nil
+}
+
+func (m *MsgAccelerateVestingResponse) GetPenalty() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Penalty
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "inference.streamvesting.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "inference.streamvesting.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgTransferVestingSchedule)(nil), "inference.streamvesting.MsgTransferVestingSchedule")
+	proto.RegisterType((*MsgTransferVestingScheduleResponse)(nil), "inference.streamvesting.MsgTransferVestingScheduleResponse")
+	proto.RegisterType((*MsgAccelerateVesting)(nil), "inference.streamvesting.MsgAccelerateVesting")
+	proto.RegisterType((*MsgAccelerateVestingResponse)(nil), "inference.streamvesting.MsgAccelerateVestingResponse")
 }
 
 func init() { proto.RegisterFile("inference/streamvesting/tx.proto", fileDescriptor_50f3863e46541da3) }
 
 var fileDescriptor_50f3863e46541da3 = []byte{
-	// 354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0xcc, 0x4b, 0x4b,
-	0x2d, 0x4a, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x2e, 0x29, 0x4a, 0x4d, 0xcc, 0x2d, 0x4b, 0x2d, 0x2e,
-	0xc9, 0xcc, 0x4b, 0xd7, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xab,
-	0xd0, 0x43, 0x51, 0x21, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x6a, 0xa5,
-	0xc4, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x73, 0x8b, 0xd3, 0xf5, 0xcb, 0x0c, 0x41, 0x14,
-	0x54, 0x42, 0x12, 0x22, 0x11, 0x0f, 0xe6, 0xe9, 0x43, 0x38, 0x50, 0x29, 0x91, 0xf4, 0xfc, 0xf4,
-	0x7c, 0x88, 0x38, 0x88, 0x05, 0x15, 0x55, 0xc1, 0xe5, 0xae, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8,
-	0x5e, 0xa5, 0xd3, 0x8c, 0x5c, 0xfc, 0xbe, 0xc5, 0xe9, 0xa1, 0x05, 0x29, 0x89, 0x25, 0xa9, 0x01,
-	0x60, 0x19, 0x21, 0x33, 0x2e, 0xce, 0xc4, 0xd2, 0x92, 0x8c, 0xfc, 0xa2, 0xcc, 0x92, 0x4a, 0x09,
-	0x46, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0x89, 0x4b, 0x5b, 0x74, 0x45, 0xa0, 0x96, 0x3a, 0xa6, 0xa4,
-	0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07, 0x21, 0x94, 0x0a, 0x39, 0x71,
-	0xb1, 0x41, 0xcc, 0x96, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd7, 0xc3, 0xe1, 0x71, 0x3d,
-	0x88, 0x45, 0x4e, 0x9c, 0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xaa,
-	0xd3, 0xca, 0xa6, 0xe9, 0xf9, 0x06, 0x2d, 0x84, 0x99, 0x5d, 0xcf, 0x37, 0x68, 0x69, 0x22, 0x3c,
-	0x52, 0x81, 0xe6, 0x15, 0x34, 0x97, 0x2b, 0x49, 0x72, 0x89, 0xa3, 0x09, 0x05, 0xa5, 0x16, 0x17,
-	0xe4, 0xe7, 0x15, 0xa7, 0x1a, 0x55, 0x70, 0x31, 0xfb, 0x16, 0xa7, 0x0b, 0x65, 0x71, 0xf1, 0xa0,
-	0xf8, 0x55, 0x03, 0xa7, 0x1b, 0xd1, 0x0c, 0x92, 0x32, 0x20, 0x56, 0x25, 0xcc, 0x4a, 0x29, 0xd6,
-	0x06, 0x90, 0xd7, 0x9c, 0x82, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca,
-	0x32, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0xa0, 0x28, 0x3f, 0xa5,
-	0x34, 0xb9, 0xa4, 0x38, 0x39, 0x13, 0xec, 0x53, 0xdc, 0x7e, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
-	0x62, 0x03, 0x47, 0x9f, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x3b, 0x7a, 0x6b, 0x7e, 0x02,
-	0x00, 0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x35, 0xa5, 0x55, 0xaf, 0x48, 0xa8, 0x56, 0x45, 0x5c, 0x0b, 0xb9, 0x91, 0xc5, 0x60,
+	0x2a, 0xd5, 0xa6, 0x29, 0x45, 0xa2, 0x20, 0xa1, 0x06, 0x31, 0x46, 0x42, 0x29, 0x30, 0xb0, 0xa0,
+	0xcb, 0xf9, 0xd5, 0xb9, 0x52, 0xfb, 0xac, 0xbb, 0x4b, 0xd5, 0x6c, 0xc0, 0x08, 0x0b, 0x23, 0x3b,
+	0x0b, 0x62, 0xea, 0xc0, 0x8f, 0xa8, 0xc4, 0x52, 0x31, 0x31, 0x01, 0x6a, 0x87, 0xac, 0xfc, 0x04,
+	0x64, 0xfb, 0x92, 0xb4, 0x29, 0x0e, 0x50, 0xa9, 0x4b, 0x9c, 0xbb, 0xf7, 0xdd, 0xf7, 0xde, 0xf7,
+	0xbd, 0x77, 0x87, 0xab, 0x2c, 0xde, 0x02, 0x01, 0x31, 0x05, 0x5f, 0x2a, 0x01, 0x24, 0xda, 0x05,
+	0xa9, 0x58, 0x1c, 0xfa, 0x6a, 0xcf, 0x4b, 0x04, 0x57, 0xdc, 0xa8, 0x0c, 0x10, 0xde, 0x29, 0x84,
+	0x35, 0x47, 0x22, 0x16, 0x73, 0x3f, 0xfb, 0xcd, 0xb1, 0x56, 0x85, 0x72, 0x19, 0x71, 0xe9, 0x47,
+	0x32, 0xf4, 0x77, 0x57, 0xd2, 0x8f, 0x0e, 0x2c, 0xe4, 0x81, 0xe7, 0xd9, 0xca, 0xcf, 0x17, 0x3a,
+	0x34, 0x1f, 0xf2, 0x90, 0xe7, 0xfb, 0xe9, 0x3f, 0xbd, 0x6b, 0x6b, 0xa6, 0x16, 0x91, 0xe0, 0xef,
+	0xae, 0xb4, 0x40, 0x91, 0x15, 0x9f, 0x72, 0x16, 0xeb, 0xf8, 0xf5, 0xa2, 0xba, 0x13, 0x22, 0x48,
+	0xa4, 0xb9, 0x9d, 0x2f, 0x08, 0x5f, 0x69, 0xc8, 0xf0, 0x49, 0x12, 0x10, 0x05, 0x8f, 0xb2, 0x88,
+	0x71, 0x1b, 0xcf, 0x90, 0x8e, 0x6a, 0x73, 0xc1, 0x54, 0xd7, 0x44, 0x55, 0xe4, 0xce, 0xd4, 0xcd,
+	0xaf, 0x9f, 0x97, 0xe7, 0x75, 0x51, 0x1b, 0x41, 0x20, 0x40, 0xca, 0x4d, 0x25, 0x58, 0x1c, 0x36,
+	0x87, 0x50, 0xa3, 0x8e, 0xa7, 0x72, 0x6e, 0x73, 0xa2, 0x8a, 0xdc, 0xd9, 0xda, 0xa2, 0x57, 0x60,
+	0x8c, 0x97, 0x27, 0xaa, 0xcf, 0x1c, 0x7c, 0x5f, 0x2c, 0x7d, 0xec, 0xed, 0x2f, 0xa1, 0xa6, 0x3e,
+	0xb9, 0x7e, 0xef, 0x75, 0x6f, 0x7f, 0x69, 0xc8, 0xf9, 0xa6, 0xb7, 0xbf, 0x74, 0x63, 0x28, 0x64,
+	0x6f, 0x44, 0xca, 0x48, 0xe5, 0xce, 0x02, 0xae, 0x8c, 0x6c, 0x35, 0x41, 0x26, 0x3c, 0x96, 0xe0,
+	0xf4, 0x10, 0xb6, 0x1a, 0x32, 0x7c, 0x2c, 0x48, 0x2c, 0xb7, 0x40, 0x3c, 0xcd, 0x19, 0x36, 0x69,
+	0x1b, 0x82, 0xce, 0x0e, 0x18, 0x35, 0x3c, 0x4d, 0x05, 0x10, 0xc5, 0xc5, 0x5f, 0x15, 0xf7, 0x81,
+	0xa9, 0x4f, 0x02, 0x28, 0x4b, 0x18, 0xc4, 0x2a, 0x93, 0x3c, 0xd6, 0xa7, 0x01, 0xd4, 0xb8, 0x8a,
+	0xa7, 0x20, 0xe1, 0xb4, 0x2d, 0xcd, 0x72, 0x15, 0xb9, 0x93, 0x4d, 0xbd, 0x5a, 0x7f, 0x98, 0x6a,
+	0xef, 0xb3, 0xa7, 0xca, 0x6f, 0x8d, 0x55, 0x5e, 0x20, 0xc5, 0x79, 0x8f, 0xb0, 0x53, 0x1c, 0xee,
+	0x1b, 0x62, 0x08, 0x3c, 0xab, 0x34, 0x44, 0x40, 0x60, 0xa2, 0x6a, 0xd9, 0x9d, 0xad, 0x2d, 0x78,
+	0xba, 0xf8, 0x74, 0xaa, 0x3c, 0x3d, 0x55, 0xde, 0x03, 0xce, 0xe2, 0xfa, 0x5a, 0xda, 0xac, 0x4f,
+	0x3f, 0x16, 0xdd, 0x90, 0xa9, 0x76, 0xa7, 0xe5, 0x51, 0x1e, 0xe9, 0x31, 0xd5, 0x9f, 0x65, 0x19,
+	0xbc, 0xf0, 0x55, 0x37, 0x01, 0x99, 0x1d, 0x90, 0x79, 0x63, 0x4f, 0x26, 0x71, 0x3e, 0x20, 0x3c,
+	0xdf, 0x90, 0xe1, 0x06, 0xa5, 0xb0, 0x03, 0x82, 0x28, 0xd0, 0xc5, 0x9d, 0xcb, 0xfe, 0xa1, 0x8d,
+	0x13, 0xa7, 0x6c, 0xbc, 0x3f, 0x6a, 0xa3, 0x37, 0xd6, 0xc6, 0x33, 0xc5, 0x38, 0xbf, 0x10, 0xbe,
+	0xf6, 0xa7, 0xc0, 0xc0, 0xba, 0x00, 0x4f, 0x26, 0x84, 0x5d, 0x9c, 0x67, 0x19, 0xbb, 0xb1, 0x8d,
+	0xa7, 0x13, 0x88, 0xc9, 0x8e, 0xea, 0x9a, 0x13, 0x17, 0x94, 0xa8, 0x9f, 0xa0, 0xf6, 0xaa, 0x8c,
+	0xcb, 0x0d, 0x19, 0x1a, 0xdb, 0xf8, 0xf2, 0xa9, 0xa7, 0xc0, 0x2d, 0xbc, 0xc2, 0x23, 0xf7, 0xcc,
+	0xba, 0xf9, 0xaf, 0xc8, 0x81, 0x8b, 0x6f, 0x11, 0xae, 0x14, 0x5d, 0xc7, 0xd5, 0x71, 0x6c, 0x05,
+	0x87, 0xac, 0xbb, 0xe7, 0x38, 0x34, 0xa8, 0xa6, 0x8b, 0xe7, 0xce, 0x8e, 0xe5, 0xf2, 0x38, 0xc6,
+	0x33, 0x70, 0x6b, 0xed, 0xbf, 0xe0, 0xfd, 0xd4, 0xd6, 0xa5, 0x97, 0x69, 0x33, 0xea, 0x9b, 0x07,
+	0x47, 0x36, 0x3a, 0x3c, 0xb2, 0xd1, 0xcf, 0x23, 0x1b, 0xbd, 0x3b, 0xb6, 0x4b, 0x87, 0xc7, 0x76,
+	0xe9, 0xdb, 0xb1, 0x5d, 0x7a, 0x76, 0xe7, 0x44, 0x57, 0x13, 0xc1, 0x83, 0x0e, 0x55, 0x92, 0xb2,
+	0x6c, 0xa0, 0x8b, 0x47, 0x3b, 0x6b, 0x76, 0x6b, 0x2a, 0x7b, 0xe6, 0x57, 0x7f, 0x07, 0x00, 0x00,
+	0xff, 0xff, 0x75, 0xb6, 0xd7, 0xd8, 0xc6, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -175,6 +413,8 @@ type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	TransferVestingSchedule(ctx context.Context, in *MsgTransferVestingSchedule, opts ...grpc.CallOption) (*MsgTransferVestingScheduleResponse, error)
+	AccelerateVesting(ctx context.Context, in *MsgAccelerateVesting, opts ...grpc.CallOption) (*MsgAccelerateVestingResponse, error)
 }
 
 type msgClient struct {
@@ -194,11 +434,31 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) TransferVestingSchedule(ctx context.Context, in *MsgTransferVestingSchedule, opts ...grpc.CallOption) (*MsgTransferVestingScheduleResponse, error) {
+	out := new(MsgTransferVestingScheduleResponse)
+	err := c.cc.Invoke(ctx, "/inference.streamvesting.Msg/TransferVestingSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AccelerateVesting(ctx context.Context, in *MsgAccelerateVesting, opts ...grpc.CallOption) (*MsgAccelerateVestingResponse, error) {
+	out := new(MsgAccelerateVestingResponse)
+	err := c.cc.Invoke(ctx, "/inference.streamvesting.Msg/AccelerateVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	TransferVestingSchedule(context.Context, *MsgTransferVestingSchedule) (*MsgTransferVestingScheduleResponse, error)
+	AccelerateVesting(context.Context, *MsgAccelerateVesting) (*MsgAccelerateVestingResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -208,6 +468,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) TransferVestingSchedule(ctx context.Context, req *MsgTransferVestingSchedule) (*MsgTransferVestingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferVestingSchedule not implemented")
+}
+func (*UnimplementedMsgServer) AccelerateVesting(ctx context.Context, req *MsgAccelerateVesting) (*MsgAccelerateVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccelerateVesting not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -231,6 +497,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferVestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferVestingSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferVestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.streamvesting.Msg/TransferVestingSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferVestingSchedule(ctx, req.(*MsgTransferVestingSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AccelerateVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAccelerateVesting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AccelerateVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.streamvesting.Msg/AccelerateVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AccelerateVesting(ctx, req.(*MsgAccelerateVesting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.streamvesting.Msg",
@@ -240,6 +542,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "TransferVestingSchedule",
+			Handler:    _Msg_TransferVestingSchedule_Handler,
+		},
+		{
+			MethodName: "AccelerateVesting",
+			Handler:    _Msg_AccelerateVesting_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/streamvesting/tx.proto",
@@ -308,61 +618,298 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgTransferVestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgTransferVestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferVestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Epochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTransferVestingScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferVestingScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferVestingScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Transferred) > 0 {
+		for iNdEx := len(m.Transferred) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transferred[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgAccelerateVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgAccelerateVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
+
+func (m *MsgAccelerateVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAccelerateVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAccelerateVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAccelerateVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Penalty) > 0 {
+		for iNdEx := len(m.Penalty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Penalty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Paid) > 0 {
+		for iNdEx := len(m.Paid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferVestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovTx(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *MsgTransferVestingScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transferred) > 0 {
+		for _, e := range m.Transferred {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAccelerateVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovTx(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *MsgAccelerateVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Paid) > 0 {
+		for _, e := range m.Paid {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Penalty) > 0 {
+		for _, e := range m.Penalty {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
@@ -514,6 +1061,442 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTransferVestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferVestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferVestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferVestingScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferVestingScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferVestingScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transferred", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transferred = append(m.Transferred, types.Coin{})
+			if err := m.Transferred[len(m.Transferred)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAccelerateVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAccelerateVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAccelerateVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAccelerateVestingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAccelerateVestingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAccelerateVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paid = append(m.Paid, types.Coin{})
+			if err := m.Paid[len(m.Paid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Penalty = append(m.Penalty, types.Coin{})
+			if err := m.Penalty[len(m.Penalty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
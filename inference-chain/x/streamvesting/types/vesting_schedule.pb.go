@@ -128,9 +128,160 @@ func (m *VestingSchedule) GetEpochAmounts() []EpochCoins {
 	return nil
 }
 
+// EpochUnlock records the unlocks made when an epoch completed.
+type EpochUnlock struct {
+	EpochIndex uint64 `protobuf:"varint,1,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	Height     int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time in unix seconds
+	Time                 int64                                    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	Unlocked             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=unlocked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked"`
+	ParticipantsUnlocked uint64                                   `protobuf:"varint,5,opt,name=participants_unlocked,json=participantsUnlocked,proto3" json:"participants_unlocked,omitempty"`
+}
+
+func (m *EpochUnlock) Reset()         { *m = EpochUnlock{} }
+func (m *EpochUnlock) String() string { return proto.CompactTextString(m) }
+func (*EpochUnlock) ProtoMessage()    {}
+func (*EpochUnlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb8c6db5760cec1, []int{2}
+}
+func (m *EpochUnlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochUnlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochUnlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochUnlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochUnlock.Merge(m, src)
+}
+func (m *EpochUnlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochUnlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochUnlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochUnlock proto.InternalMessageInfo
+
+func (m *EpochUnlock) GetEpochIndex() uint64 {
+	if m != nil {
+		return m.EpochIndex
+	}
+	return 0
+}
+
+func (m *EpochUnlock) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EpochUnlock) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *EpochUnlock) GetUnlocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unlocked
+	}
+	return nil
+}
+
+func (m *EpochUnlock) GetParticipantsUnlocked() uint64 {
+	if m != nil {
+		return m.ParticipantsUnlocked
+	}
+	return 0
+}
+
+// ProjectedUnlock is an upcoming unlock. The height and time are estimated from the pace of past epochs and are
+// zero until two epochs have completed.
+type ProjectedUnlock struct {
+	EpochIndex      uint64 `protobuf:"varint,1,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	EstimatedHeight int64  `protobuf:"varint,2,opt,name=estimated_height,json=estimatedHeight,proto3" json:"estimated_height,omitempty"`
+	// estimated_time is in unix seconds
+	EstimatedTime int64                                    `protobuf:"varint,3,opt,name=estimated_time,json=estimatedTime,proto3" json:"estimated_time,omitempty"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ProjectedUnlock) Reset()         { *m = ProjectedUnlock{} }
+func (m *ProjectedUnlock) String() string { return proto.CompactTextString(m) }
+func (*ProjectedUnlock) ProtoMessage()    {}
+func (*ProjectedUnlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb8c6db5760cec1, []int{3}
+}
+func (m *ProjectedUnlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectedUnlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectedUnlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectedUnlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectedUnlock.Merge(m, src)
+}
+func (m *ProjectedUnlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectedUnlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectedUnlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectedUnlock proto.InternalMessageInfo
+
+func (m *ProjectedUnlock) GetEpochIndex() uint64 {
+	if m != nil {
+		return m.EpochIndex
+	}
+	return 0
+}
+
+func (m *ProjectedUnlock) GetEstimatedHeight() int64 {
+	if m != nil {
+		return m.EstimatedHeight
+	}
+	return 0
+}
+
+func (m *ProjectedUnlock) GetEstimatedTime() int64 {
+	if m != nil {
+		return m.EstimatedTime
+	}
+	return 0
+}
+
+func (m *ProjectedUnlock) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EpochCoins)(nil), "inference.streamvesting.EpochCoins")
 	proto.RegisterType((*VestingSchedule)(nil), "inference.streamvesting.VestingSchedule")
+	proto.RegisterType((*EpochUnlock)(nil), "inference.streamvesting.EpochUnlock")
+	proto.RegisterType((*ProjectedUnlock)(nil), "inference.streamvesting.ProjectedUnlock")
 }
 
 func init() {
@@ -138,30 +289,39 @@ func init() {
 }
 
 var fileDescriptor_9eb8c6db5760cec1 = []byte{
-	// 359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xcd, 0x4a, 0xf3, 0x40,
-	0x14, 0xcd, 0x7c, 0x7f, 0xd0, 0x7c, 0x8a, 0x18, 0x05, 0x6b, 0x17, 0xa9, 0xd4, 0x4d, 0x11, 0x9c,
-	0xa1, 0x8a, 0x0b, 0xdd, 0xb5, 0xe2, 0x0b, 0xb4, 0xe0, 0xc2, 0x4d, 0x99, 0x4c, 0xa6, 0xe9, 0xa0,
-	0x99, 0x09, 0xb9, 0x93, 0xa2, 0x3e, 0x85, 0x2f, 0x20, 0xb8, 0x14, 0x57, 0x3e, 0x46, 0x97, 0x5d,
-	0xba, 0x52, 0x69, 0x17, 0xfa, 0x18, 0x32, 0x33, 0xc1, 0x56, 0xc1, 0x4d, 0xee, 0x25, 0xe7, 0x9c,
-	0x3b, 0xe7, 0xdc, 0xeb, 0x63, 0x21, 0x07, 0x3c, 0xe7, 0x92, 0x71, 0x02, 0x3a, 0xe7, 0x34, 0x1d,
-	0x71, 0xd0, 0x42, 0x26, 0xa4, 0xac, 0x7d, 0x60, 0x43, 0x1e, 0x17, 0x17, 0x1c, 0x67, 0xb9, 0xd2,
-	0x2a, 0xd8, 0xf8, 0xe4, 0xe3, 0x2f, 0xfc, 0xda, 0x2a, 0x4d, 0x85, 0x54, 0xc4, 0x7e, 0x1d, 0xb7,
-	0xb6, 0x9e, 0xa8, 0x44, 0xd9, 0x96, 0x98, 0xae, 0xfc, 0x1b, 0x32, 0x05, 0xa9, 0x02, 0x12, 0x51,
-	0xe0, 0x64, 0xd4, 0x8a, 0xb8, 0xa6, 0x2d, 0xc2, 0x94, 0x90, 0x0e, 0x6f, 0x5c, 0xfb, 0xfe, 0x49,
-	0xa6, 0xd8, 0xf0, 0x58, 0x09, 0x09, 0xc1, 0xc0, 0xff, 0x6b, 0x30, 0xa8, 0xa2, 0xad, 0xdf, 0xcd,
-	0xff, 0x7b, 0x9b, 0xd8, 0xa9, 0xb1, 0x51, 0xe3, 0x52, 0x8d, 0x0d, 0xb5, 0x73, 0x30, 0x7e, 0xae,
-	0x7b, 0x0f, 0x2f, 0xf5, 0x66, 0x22, 0xf4, 0xb0, 0x88, 0x30, 0x53, 0x29, 0x29, 0x9f, 0x72, 0x65,
-	0x17, 0xe2, 0x73, 0xa2, 0xaf, 0x32, 0x0e, 0x56, 0x00, 0xf7, 0x6f, 0x8f, 0x3b, 0xa8, 0xeb, 0xc6,
-	0x1f, 0xfd, 0x79, 0xbf, 0xab, 0xa3, 0xc6, 0x2d, 0xf2, 0x57, 0x4e, 0x5d, 0xa0, 0x5e, 0x99, 0x3b,
-	0x20, 0xfe, 0x5a, 0x46, 0x73, 0x2d, 0x98, 0xc8, 0xa8, 0xd4, 0x7d, 0x1a, 0xc7, 0x39, 0x07, 0xe3,
-	0x07, 0x35, 0x2b, 0xdd, 0x60, 0x01, 0x6a, 0x3b, 0x24, 0xe8, 0xf9, 0xcb, 0xdc, 0x04, 0xe8, 0xd3,
-	0x54, 0x15, 0x52, 0x43, 0xf5, 0x97, 0xb5, 0xbe, 0x8d, 0x7f, 0x58, 0x1d, 0x9e, 0xc7, 0xed, 0x54,
-	0x4c, 0x08, 0x67, 0x6c, 0xc9, 0x0e, 0x69, 0xbb, 0x19, 0xce, 0x5f, 0xa7, 0x37, 0x9e, 0x86, 0x68,
-	0x32, 0x0d, 0xd1, 0xeb, 0x34, 0x44, 0x37, 0xb3, 0xd0, 0x9b, 0xcc, 0x42, 0xef, 0x69, 0x16, 0x7a,
-	0x67, 0x87, 0x0b, 0xa9, 0xb3, 0x5c, 0xc5, 0x05, 0xd3, 0xc0, 0x84, 0xbd, 0xeb, 0xfc, 0xc2, 0x97,
-	0xdf, 0x6e, 0x6c, 0x97, 0x11, 0xfd, 0xb3, 0x7b, 0xdf, 0xff, 0x08, 0x00, 0x00, 0xff, 0xff, 0x33,
-	0x66, 0x54, 0x1c, 0x0b, 0x02, 0x00, 0x00,
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xb1, 0x6e, 0x13, 0x41,
+	0x10, 0xf5, 0x26, 0x8e, 0x45, 0xd6, 0x04, 0xc3, 0x12, 0xc0, 0xa4, 0x38, 0x47, 0x46, 0x48, 0x06,
+	0x89, 0x5d, 0x85, 0x88, 0x02, 0xba, 0x18, 0x21, 0x41, 0x87, 0x6c, 0x42, 0x41, 0x63, 0x9d, 0xf7,
+	0x26, 0x77, 0x4b, 0x7c, 0xbb, 0xa7, 0xdb, 0xbd, 0x28, 0xf0, 0x15, 0xfc, 0x00, 0x12, 0x25, 0xa2,
+	0xe2, 0x33, 0x52, 0xa6, 0xa4, 0x02, 0x64, 0x17, 0x50, 0xf1, 0x07, 0x48, 0x68, 0x77, 0x4f, 0xe7,
+	0x0b, 0x12, 0x12, 0x4d, 0x1a, 0xef, 0x78, 0xe6, 0xcd, 0x9b, 0x79, 0xef, 0x34, 0x98, 0x0a, 0x79,
+	0x00, 0x39, 0x48, 0x0e, 0x4c, 0x9b, 0x1c, 0xc2, 0xf4, 0x08, 0xb4, 0x11, 0x32, 0x66, 0xe5, 0x3b,
+	0xd1, 0x3c, 0x81, 0xa8, 0x98, 0x01, 0xcd, 0x72, 0x65, 0x14, 0xb9, 0x51, 0xe1, 0xe9, 0x19, 0xfc,
+	0xd6, 0x95, 0x30, 0x15, 0x52, 0x31, 0xf7, 0xeb, 0xb1, 0x5b, 0x9b, 0xb1, 0x8a, 0x95, 0x0b, 0x99,
+	0x8d, 0xca, 0x6c, 0xc0, 0x95, 0x4e, 0x95, 0x66, 0xd3, 0x50, 0x03, 0x3b, 0xda, 0x99, 0x82, 0x09,
+	0x77, 0x18, 0x57, 0x42, 0xfa, 0x7a, 0xff, 0x2d, 0xc6, 0x4f, 0x32, 0xc5, 0x93, 0xc7, 0x4a, 0x48,
+	0x4d, 0x0e, 0xf0, 0x9a, 0xad, 0xe9, 0x2e, 0xda, 0x5e, 0x1d, 0xb4, 0xef, 0xdf, 0xa4, 0xbe, 0x9b,
+	0xda, 0x6e, 0x5a, 0x76, 0x53, 0x0b, 0x1d, 0x3e, 0x38, 0xf9, 0xda, 0x6b, 0x7c, 0xfa, 0xd6, 0x1b,
+	0xc4, 0xc2, 0x24, 0xc5, 0x94, 0x72, 0x95, 0xb2, 0x72, 0x94, 0x7f, 0xee, 0xe9, 0xe8, 0x90, 0x99,
+	0x37, 0x19, 0x68, 0xd7, 0xa0, 0x3f, 0xfe, 0xf8, 0x7c, 0x17, 0x8d, 0x3c, 0xfd, 0xa3, 0xe6, 0xcf,
+	0x0f, 0x3d, 0xd4, 0x7f, 0x8f, 0x70, 0xe7, 0xa5, 0x17, 0x34, 0x2e, 0x75, 0x13, 0x86, 0xaf, 0x66,
+	0x61, 0x6e, 0x04, 0x17, 0x59, 0x28, 0xcd, 0x24, 0x8c, 0xa2, 0x1c, 0xb4, 0xdd, 0x07, 0x0d, 0xd6,
+	0x47, 0xa4, 0x56, 0xda, 0xf3, 0x15, 0x32, 0xc6, 0x1b, 0x60, 0x05, 0x4c, 0xc2, 0x54, 0x15, 0xd2,
+	0xe8, 0xee, 0x8a, 0x5b, 0xfd, 0x16, 0xfd, 0x87, 0x75, 0x74, 0x29, 0x77, 0xb8, 0x6e, 0x45, 0xf8,
+	0xc5, 0x2e, 0x3a, 0x92, 0x3d, 0xcf, 0x51, 0xee, 0xf7, 0x1b, 0xe1, 0xb6, 0x43, 0xef, 0xcb, 0x99,
+	0xe2, 0x87, 0xa4, 0x87, 0xdb, 0x7e, 0x94, 0x90, 0x11, 0x1c, 0xbb, 0x9d, 0x9a, 0x23, 0xec, 0x52,
+	0xcf, 0x6c, 0x86, 0x5c, 0xc7, 0xad, 0x04, 0x44, 0x9c, 0x98, 0xee, 0xca, 0x36, 0x1a, 0xac, 0x8e,
+	0xca, 0x7f, 0x84, 0xe0, 0xa6, 0x11, 0x29, 0x74, 0x57, 0x5d, 0xd6, 0xc5, 0x64, 0x86, 0x2f, 0x14,
+	0x8e, 0x16, 0xa2, 0x6e, 0xf3, 0x9c, 0xdc, 0xae, 0x26, 0x90, 0x5d, 0x7c, 0xad, 0xe6, 0x9d, 0x9e,
+	0x54, 0xa3, 0xd7, 0x9c, 0x88, 0xcd, 0x7a, 0x71, 0xbf, 0xac, 0xf5, 0x7f, 0x21, 0xdc, 0x79, 0x9e,
+	0xab, 0xd7, 0xc0, 0x0d, 0x44, 0xff, 0xeb, 0xc1, 0x1d, 0x7c, 0xd9, 0x1a, 0x9d, 0x86, 0x06, 0xa2,
+	0xc9, 0x19, 0x37, 0x3a, 0x55, 0xfe, 0xa9, 0xb7, 0xe5, 0x36, 0xbe, 0xb4, 0x84, 0xd6, 0x0c, 0xda,
+	0xa8, 0xb2, 0x2f, 0xac, 0x53, 0x09, 0x6e, 0xf9, 0x6f, 0x7b, 0x6e, 0x3e, 0x95, 0xfc, 0xc3, 0xf1,
+	0xc9, 0x3c, 0x40, 0xa7, 0xf3, 0x00, 0x7d, 0x9f, 0x07, 0xe8, 0xdd, 0x22, 0x68, 0x9c, 0x2e, 0x82,
+	0xc6, 0x97, 0x45, 0xd0, 0x78, 0xf5, 0xb0, 0x46, 0x98, 0xe5, 0x2a, 0x2a, 0xb8, 0xd1, 0x5c, 0xb8,
+	0x43, 0x5e, 0x9e, 0xf4, 0xf1, 0x5f, 0x47, 0xed, 0xe6, 0x4c, 0x5b, 0xee, 0xd0, 0x76, 0xff, 0x04,
+	0x00, 0x00, 0xff, 0xff, 0xd4, 0x0b, 0xc0, 0xb7, 0xfc, 0x03, 0x00, 0x00,
 }
 
 func (this *EpochCoins) Equal(that interface{}) bool {
@@ -306,6 +466,115 @@ func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochUnlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochUnlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParticipantsUnlocked != 0 {
+		i = encodeVarintVestingSchedule(dAtA, i, uint64(m.ParticipantsUnlocked))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Unlocked) > 0 {
+		for iNdEx := len(m.Unlocked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unlocked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVestingSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Time != 0 {
+		i = encodeVarintVestingSchedule(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintVestingSchedule(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochIndex != 0 {
+		i = encodeVarintVestingSchedule(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectedUnlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectedUnlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectedUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVestingSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EstimatedTime != 0 {
+		i = encodeVarintVestingSchedule(dAtA, i, uint64(m.EstimatedTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EstimatedHeight != 0 {
+		i = encodeVarintVestingSchedule(dAtA, i, uint64(m.EstimatedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochIndex != 0 {
+		i = encodeVarintVestingSchedule(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVestingSchedule(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVestingSchedule(v)
 	base := offset
@@ -351,6 +620,57 @@ func (m *VestingSchedule) Size() (n int) {
 	return n
 }
 
+func (m *EpochUnlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochIndex != 0 {
+		n += 1 + sovVestingSchedule(uint64(m.EpochIndex))
+	}
+	if m.Height != 0 {
+		n += 1 + sovVestingSchedule(uint64(m.Height))
+	}
+	if m.Time != 0 {
+		n += 1 + sovVestingSchedule(uint64(m.Time))
+	}
+	if len(m.Unlocked) > 0 {
+		for _, e := range m.Unlocked {
+			l = e.Size()
+			n += 1 + l + sovVestingSchedule(uint64(l))
+		}
+	}
+	if m.ParticipantsUnlocked != 0 {
+		n += 1 + sovVestingSchedule(uint64(m.ParticipantsUnlocked))
+	}
+	return n
+}
+
+func (m *ProjectedUnlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochIndex != 0 {
+		n += 1 + sovVestingSchedule(uint64(m.EpochIndex))
+	}
+	if m.EstimatedHeight != 0 {
+		n += 1 + sovVestingSchedule(uint64(m.EstimatedHeight))
+	}
+	if m.EstimatedTime != 0 {
+		n += 1 + sovVestingSchedule(uint64(m.EstimatedTime))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovVestingSchedule(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovVestingSchedule(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -557,6 +877,307 @@ func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EpochUnlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVestingSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochUnlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			}
+			m.EpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocked = append(m.Unlocked, types.Coin{})
+			if err := m.Unlocked[len(m.Unlocked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantsUnlocked", wireType)
+			}
+			m.ParticipantsUnlocked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantsUnlocked |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVestingSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVestingSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectedUnlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVestingSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectedUnlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectedUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			}
+			m.EpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedHeight", wireType)
+			}
+			m.EstimatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedTime", wireType)
+			}
+			m.EstimatedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVestingSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVestingSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVestingSchedule(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0